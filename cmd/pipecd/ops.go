@@ -35,6 +35,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/ops/orphancommandcleaner"
 	"github.com/pipe-cd/pipecd/pkg/app/ops/pipedstatsbuilder"
 	"github.com/pipe-cd/pipecd/pkg/app/ops/planpreviewoutputcleaner"
+	"github.com/pipe-cd/pipecd/pkg/app/ops/stageartifactcleaner"
 	"github.com/pipe-cd/pipecd/pkg/app/ops/staledpipedstatcleaner"
 	"github.com/pipe-cd/pipecd/pkg/cache/rediscache"
 	"github.com/pipe-cd/pipecd/pkg/cli"
@@ -172,6 +173,14 @@ func (s *ops) run(ctx context.Context, input cli.Input) error {
 		})
 	}
 
+	// Start running stage artifact cleaner.
+	{
+		cleaner := stageartifactcleaner.NewCleaner(fs, cfg.StageArtifacts.TTLDuration(), input.Logger)
+		group.Go(func() error {
+			return cleaner.Run(ctx)
+		})
+	}
+
 	// Start runnning apiKeyLastUsedTime updater.
 	{
 		updater := apikeylastusedtimeupdater.NewAPIKeyLastUsedTimeUpdater(ds, rd, input.Logger)
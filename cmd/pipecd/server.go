@@ -33,12 +33,20 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/server/apikeyverifier"
 	"github.com/pipe-cd/pipecd/pkg/app/server/applicationlivestatestore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/commandoutputstore"
+	"github.com/pipe-cd/pipecd/pkg/app/server/commandstore"
+	"github.com/pipe-cd/pipecd/pkg/app/server/deploymentdiffstore"
+	"github.com/pipe-cd/pipecd/pkg/app/server/deploymentrisk"
+	"github.com/pipe-cd/pipecd/pkg/app/server/deploymenttoken"
 	"github.com/pipe-cd/pipecd/pkg/app/server/grpcapi"
 	"github.com/pipe-cd/pipecd/pkg/app/server/grpcapi/grpcapimetrics"
 	"github.com/pipe-cd/pipecd/pkg/app/server/httpapi"
 	"github.com/pipe-cd/pipecd/pkg/app/server/httpapi/httpapimetrics"
 	"github.com/pipe-cd/pipecd/pkg/app/server/pipedverifier"
+	"github.com/pipe-cd/pipecd/pkg/app/server/projectquota"
+	"github.com/pipe-cd/pipecd/pkg/app/server/projectwebhook"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/webservice"
+	"github.com/pipe-cd/pipecd/pkg/app/server/stageartifactstore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/stagelogstore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/unregisteredappstore"
 	"github.com/pipe-cd/pipecd/pkg/cache"
@@ -84,6 +92,8 @@ type server struct {
 	cacheAddress   string
 	gracePeriod    time.Duration
 
+	deploymentTokenTTL time.Duration
+
 	tls            bool
 	certFile       string
 	keyFile        string
@@ -107,6 +117,8 @@ func NewServerCommand() *cobra.Command {
 		staticDir:      "web/static",
 		cacheAddress:   "cache:6379",
 		gracePeriod:    30 * time.Second,
+
+		deploymentTokenTTL: time.Hour,
 	}
 	cmd := &cobra.Command{
 		Use:   "server",
@@ -123,6 +135,7 @@ func NewServerCommand() *cobra.Command {
 	cmd.Flags().StringVar(&s.staticDir, "static-dir", s.staticDir, "The directory where contains static assets.")
 	cmd.Flags().StringVar(&s.cacheAddress, "cache-address", s.cacheAddress, "The address to cache service.")
 	cmd.Flags().DurationVar(&s.gracePeriod, "grace-period", s.gracePeriod, "How long to wait for graceful shutdown.")
+	cmd.Flags().DurationVar(&s.deploymentTokenTTL, "deployment-token-ttl", s.deploymentTokenTTL, "How long the deployment-scoped tokens issued to pipeds are valid.")
 
 	cmd.Flags().BoolVar(&s.tls, "tls", s.tls, "Whether running the gRPC server with TLS or not.")
 	cmd.Flags().StringVar(&s.certFile, "cert-file", s.certFile, "The path to the TLS certificate file.")
@@ -196,13 +209,37 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 		sls                  = stagelogstore.NewStore(fs, cache, input.Logger)
 		alss                 = applicationlivestatestore.NewStore(fs, cache, input.Logger)
 		las                  = analysisresultstore.NewStore(fs, input.Logger)
+		dds                  = deploymentdiffstore.NewStore(fs, input.Logger)
+		sas                  = stageartifactstore.NewStore(fs, input.Logger)
 		insightStore         = insightstore.NewStore(fs, cfg.InsightCollector.Deployment.ChunkMaxCount, rd, input.Logger)
 		insightProvider      = insight.NewProvider(insightStore)
 		cmdOutputStore       = commandoutputstore.NewStore(fs, input.Logger)
 		statCache            = rediscache.NewHashCache(rd, defaultPipedStatHashKey)
 		unregisteredAppStore = unregisteredappstore.NewStore(rd, input.Logger)
 		apiKeyLastUsedCache  = rediscache.NewHashCache(rd, apiKeyLastUsedCacheHashKey)
+		quotaChecker         = projectquota.NewChecker(cfg.ProjectQuotas, ds, rd, input.Logger)
+		riskScorer           = deploymentrisk.NewScorer(cfg.DeploymentRisk, ds, dds, input.Logger)
+	)
+
+	deploymentTokenManager, err := deploymenttoken.NewManager(
+		s.encryptionKeyFile,
+		s.deploymentTokenTTL,
+		rediscache.NewTTLCache(rd, s.deploymentTokenTTL),
+		input.Logger,
 	)
+	if err != nil {
+		input.Logger.Error("failed to create a new deployment token manager", zap.Error(err))
+		return err
+	}
+
+	webhookNotifier, err := projectwebhook.NewNotifier(cfg.ProjectWebhooks, input.Logger)
+	if err != nil {
+		input.Logger.Error("failed to create a new project webhook notifier", zap.Error(err))
+		return err
+	}
+	group.Go(func() error {
+		return webhookNotifier.Run(ctx)
+	})
 
 	// Start a gRPC server for handling PipedAPI requests.
 	{
@@ -215,13 +252,13 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 				datastore.NewPipedStore(ds, datastore.PipedCommander),
 				input.Logger,
 			)
-			service = grpcapi.NewPipedAPI(ctx, ds, cache, sls, alss, las, statCache, cmdOutputStore, unregisteredAppStore, cfg.Address, input.Logger)
+			service = grpcapi.NewPipedAPI(ctx, ds, cache, sls, alss, las, dds, sas, statCache, cmdOutputStore, unregisteredAppStore, quotaChecker, riskScorer, deploymentTokenManager, cfg.Address, input.Logger)
 			opts    = []rpc.Option{
 				rpc.WithPort(s.pipedAPIPort),
 				rpc.WithGracePeriod(s.gracePeriod),
 				rpc.WithLogger(input.Logger),
 				rpc.WithLogUnaryInterceptor(input.Logger),
-				rpc.WithPipedTokenAuthUnaryInterceptor(verifier, input.Logger),
+				rpc.WithPipedOrDeploymentTokenAuthUnaryInterceptor(verifier, deploymentTokenManager, pipedservice.DeploymentScopedMethods, input.Logger),
 				rpc.WithRequestValidationUnaryInterceptor(),
 			}
 		)
@@ -243,6 +280,12 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 
 	// Start a gRPC server for handling external API requests.
 	{
+		approverVerifier, err := createApproverVerifier(cfg.ApprovalIssuers)
+		if err != nil {
+			input.Logger.Error("failed to create a new approver verifier", zap.Error(err))
+			return err
+		}
+
 		var (
 			verifier = apikeyverifier.NewVerifier(
 				ctx,
@@ -251,13 +294,16 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 				input.Logger,
 			)
 
-			service = grpcapi.NewAPI(ctx, ds, fs, cache, cmdOutputStore, statCache, cfg.Address, input.Logger)
-			opts    = []rpc.Option{
+			// The tokens are rejected before they expire from this store because their lifetime is limited up to an hour.
+			approverTokenStore = rediscache.NewTTLCache(rd, time.Hour)
+			service            = grpcapi.NewAPI(ctx, ds, fs, cache, cmdOutputStore, statCache, cfg.Address, quotaChecker, webhookNotifier, approverVerifier, approverTokenStore, input.Logger)
+			opts               = []rpc.Option{
 				rpc.WithPort(s.apiPort),
 				rpc.WithGracePeriod(s.gracePeriod),
 				rpc.WithLogger(input.Logger),
 				rpc.WithLogUnaryInterceptor(input.Logger),
 				rpc.WithAPIKeyAuthUnaryInterceptor(verifier, input.Logger),
+				rpc.WithAPIKeyAuthStreamInterceptor(verifier, input.Logger),
 				rpc.WithRequestValidationUnaryInterceptor(),
 			}
 		)
@@ -293,6 +339,8 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 			ds,
 			cache,
 			sls,
+			dds,
+			sas,
 			alss,
 			unregisteredAppStore,
 			apiKeyLastUsedCache,
@@ -300,6 +348,8 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 			statCache,
 			cfg.ProjectMap(),
 			encryptDecrypter,
+			quotaChecker,
+			webhookNotifier,
 			input.Logger,
 		)
 		opts := []rpc.Option{
@@ -336,6 +386,21 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 			return err
 		}
 
+		var gitlabWebhook http.Handler
+		if len(cfg.GitLabIntegrations) > 0 {
+			gitlabWebhook, err = httpapi.NewGitLabWebhookHandler(
+				cfg.GitLabIntegrations,
+				datastore.NewDeploymentStore(ds, datastore.WebCommander),
+				commandstore.NewStore(datastore.WebCommander, ds, cache, input.Logger),
+				input.Logger,
+			)
+			if err != nil {
+				input.Logger.Error("failed to create the gitlab webhook handler", zap.Error(err))
+				return err
+			}
+		}
+
+		projectStore := datastore.NewProjectStore(ds, datastore.WebCommander)
 		h := httpapi.NewHandler(
 			signer,
 			s.staticDir,
@@ -344,8 +409,11 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 			cfg.StateKey,
 			cfg.ProjectMap(),
 			cfg.SharedSSOConfigMap(),
-			datastore.NewProjectStore(ds, datastore.WebCommander),
+			projectStore,
+			projectStore,
+			cfg.SSOProvisioning,
 			!s.insecureCookie,
+			gitlabWebhook,
 			input.Logger,
 		)
 		httpServer := &http.Server{
@@ -462,6 +530,26 @@ func loadConfig(file string) (*config.ControlPlaneSpec, error) {
 	return cfg.ControlPlaneSpec, nil
 }
 
+// createApproverVerifier returns a verifier for the tokens signed by the given issuers.
+// It returns nil when no issuer is configured so that the external approval is disabled.
+func createApproverVerifier(issuers []config.ControlPlaneApprovalIssuer) (jwt.ExternalVerifier, error) {
+	if len(issuers) == 0 {
+		return nil, nil
+	}
+	externalIssuers := make([]jwt.ExternalIssuer, 0, len(issuers))
+	for _, iss := range issuers {
+		externalIssuers = append(externalIssuers, jwt.ExternalIssuer{
+			Issuer:        iss.Issuer,
+			Audience:      iss.Audience,
+			SigningMethod: iss.SigningMethod,
+			KeyFile:       iss.KeyFile,
+			IdentityClaim: iss.IdentityClaim,
+			MaxTTL:        iss.MaxTokenTTL.Duration(),
+		})
+	}
+	return jwt.NewExternalVerifier(externalIssuers)
+}
+
 func createDatastore(ctx context.Context, cfg *config.ControlPlaneSpec, fs filestore.Store, c cache.Cache, logger *zap.Logger) (datastore.DataStore, error) {
 	switch cfg.Datastore.Type {
 	case model.DataStoreFirestore:
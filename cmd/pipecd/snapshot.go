@@ -0,0 +1,175 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/ops/snapshot"
+	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+)
+
+// NewSnapshotCommand returns the command to export and import
+// the control-plane data of a project.
+func NewSnapshotCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Export or import the data of a project to migrate it between control-plane instances.",
+	}
+	cmd.AddCommand(
+		newSnapshotExportCommand(),
+		newSnapshotImportCommand(),
+	)
+	return cmd
+}
+
+type snapshotExport struct {
+	configFile string
+	projectID  string
+	output     string
+}
+
+func newSnapshotExportCommand() *cobra.Command {
+	s := &snapshotExport{}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the applications, pipeds and deployments of a project to an archive file.",
+		RunE:  cli.WithContext(s.run),
+	}
+	cmd.Flags().StringVar(&s.configFile, "config-file", s.configFile, "The path to the configuration file.")
+	cmd.Flags().StringVar(&s.projectID, "project", s.projectID, "The ID of the project to be exported.")
+	cmd.Flags().StringVar(&s.output, "output", s.output, "The path to the archive file to be written.")
+	cmd.MarkFlagRequired("config-file")
+	cmd.MarkFlagRequired("project")
+	cmd.MarkFlagRequired("output")
+	return cmd
+}
+
+func (s *snapshotExport) run(ctx context.Context, input cli.Input) error {
+	return withSnapshotStores(ctx, s.configFile, input.Logger, func(stores snapshot.Stores) (err error) {
+		f, err := os.Create(s.output)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer func() {
+			if cerr := f.Close(); cerr != nil && err == nil {
+				err = cerr
+			}
+		}()
+
+		md, err := snapshot.Export(ctx, stores, s.projectID, f, input.Logger)
+		if err != nil {
+			input.Logger.Error("failed to export snapshot", zap.Error(err))
+			return err
+		}
+		input.Logger.Info("successfully exported snapshot",
+			zap.String("project", md.ProjectID),
+			zap.String("output", s.output),
+			zap.Int("pipeds", md.PipedCount),
+			zap.Int("applications", md.ApplicationCount),
+			zap.Int("deployments", md.DeploymentCount),
+		)
+		return nil
+	})
+}
+
+type snapshotImport struct {
+	configFile string
+	input      string
+}
+
+func newSnapshotImportCommand() *cobra.Command {
+	s := &snapshotImport{}
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import an archive file written by the export command. Existing entities are left untouched.",
+		RunE:  cli.WithContext(s.run),
+	}
+	cmd.Flags().StringVar(&s.configFile, "config-file", s.configFile, "The path to the configuration file.")
+	cmd.Flags().StringVar(&s.input, "input", s.input, "The path to the archive file to be imported.")
+	cmd.MarkFlagRequired("config-file")
+	cmd.MarkFlagRequired("input")
+	return cmd
+}
+
+func (s *snapshotImport) run(ctx context.Context, input cli.Input) error {
+	return withSnapshotStores(ctx, s.configFile, input.Logger, func(stores snapshot.Stores) error {
+		f, err := os.Open(s.input)
+		if err != nil {
+			return fmt.Errorf("failed to open input file: %w", err)
+		}
+		defer f.Close()
+
+		md, result, err := snapshot.Import(ctx, stores, f, input.Logger)
+		if err != nil {
+			input.Logger.Error("failed to import snapshot", zap.Error(err))
+			return err
+		}
+		input.Logger.Info("successfully imported snapshot",
+			zap.String("project", md.ProjectID),
+			zap.Any("imported", result.Imported),
+			zap.Any("skipped", result.Skipped),
+		)
+		return nil
+	})
+}
+
+func withSnapshotStores(ctx context.Context, configFile string, logger *zap.Logger, f func(snapshot.Stores) error) error {
+	cfg, err := loadConfig(configFile)
+	if err != nil {
+		logger.Error("failed to load control-plane configuration",
+			zap.String("config-file", configFile),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	fs, err := createFilestore(ctx, cfg, logger)
+	if err != nil {
+		logger.Error("failed to create filestore", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err := fs.Close(); err != nil {
+			logger.Error("failed to close filestore client", zap.Error(err))
+		}
+	}()
+
+	// The snapshot commands run only once so an in-memory cache is enough.
+	ds, err := createDatastore(ctx, cfg, fs, memorycache.NewCache(), logger)
+	if err != nil {
+		logger.Error("failed to create datastore", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err := ds.Close(); err != nil {
+			logger.Error("failed to close datastore client", zap.Error(err))
+		}
+	}()
+
+	return f(snapshot.Stores{
+		Project:     datastore.NewProjectStore(ds, datastore.OpsCommander),
+		Piped:       datastore.NewPipedStore(ds, datastore.OpsCommander),
+		Application: datastore.NewApplicationStore(ds, datastore.OpsCommander),
+		Deployment:  datastore.NewDeploymentStore(ds, datastore.OpsCommander),
+	})
+}
@@ -0,0 +1,283 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package snapshot provides the functions to export the data of a project
+// from the control-plane datastore to a portable archive and import it into another one.
+// Stage logs and other data in the filestore are not included.
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// FormatVersion is the version of the archive format.
+	FormatVersion = 1
+
+	metadataFile     = "metadata.json"
+	projectFile      = "project.json"
+	pipedsFile       = "pipeds.json"
+	applicationsFile = "applications.json"
+	deploymentsFile  = "deployments.json"
+
+	listLimit = 500
+)
+
+// Stores contains the datastore stores used to export and import a snapshot.
+type Stores struct {
+	Project     datastore.ProjectStore
+	Piped       datastore.PipedStore
+	Application datastore.ApplicationStore
+	Deployment  datastore.DeploymentStore
+}
+
+// Metadata describes the content of an archive.
+type Metadata struct {
+	FormatVersion    int    `json:"formatVersion"`
+	ProjectID        string `json:"projectId"`
+	CreatedAt        int64  `json:"createdAt"`
+	PipedCount       int    `json:"pipedCount"`
+	ApplicationCount int    `json:"applicationCount"`
+	DeploymentCount  int    `json:"deploymentCount"`
+}
+
+// Result represents the number of imported and skipped entities of each kind.
+type Result struct {
+	Imported map[string]int
+	Skipped  map[string]int
+}
+
+// Export writes the data of the given project to w as a gzipped tar archive.
+func Export(ctx context.Context, stores Stores, projectID string, w io.Writer, logger *zap.Logger) (*Metadata, error) {
+	project, err := stores.Project.Get(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project %s: %w", projectID, err)
+	}
+
+	projectFilter := []datastore.ListFilter{
+		{
+			Field:    "ProjectId",
+			Operator: datastore.OperatorEqual,
+			Value:    projectID,
+		},
+	}
+	pipeds, err := stores.Piped.List(ctx, datastore.ListOptions{Filters: projectFilter})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pipeds: %w", err)
+	}
+	logger.Info(fmt.Sprintf("exporting %d pipeds", len(pipeds)))
+
+	applications, err := listAll(ctx, projectFilter, stores.Application.List)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+	logger.Info(fmt.Sprintf("exporting %d applications", len(applications)))
+
+	deployments, err := listAll(ctx, projectFilter, stores.Deployment.List)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+	logger.Info(fmt.Sprintf("exporting %d deployments", len(deployments)))
+
+	md := &Metadata{
+		FormatVersion:    FormatVersion,
+		ProjectID:        projectID,
+		CreatedAt:        time.Now().Unix(),
+		PipedCount:       len(pipeds),
+		ApplicationCount: len(applications),
+		DeploymentCount:  len(deployments),
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	files := []struct {
+		name string
+		data interface{}
+	}{
+		{metadataFile, md},
+		{projectFile, project},
+		{pipedsFile, pipeds},
+		{applicationsFile, applications},
+		{deploymentsFile, deployments},
+	}
+	for _, f := range files {
+		if err := writeJSON(tw, f.name, f.data); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", f.name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	return md, nil
+}
+
+// Import reads an archive written by Export from r and adds its entities to the datastore.
+// The entities which already exist are skipped, so importing the same archive again is safe.
+func Import(ctx context.Context, stores Stores, r io.Reader, logger *zap.Logger) (*Metadata, *Result, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid snapshot archive: %w", err)
+	}
+	defer gr.Close()
+
+	var (
+		md           Metadata
+		project      model.Project
+		pipeds       []*model.Piped
+		applications []*model.Application
+		deployments  []*model.Deployment
+		targets      = map[string]interface{}{
+			metadataFile:     &md,
+			projectFile:      &project,
+			pipedsFile:       &pipeds,
+			applicationsFile: &applications,
+			deploymentsFile:  &deployments,
+		}
+		found = make(map[string]struct{}, len(targets))
+	)
+
+	tr := tar.NewReader(gr)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid snapshot archive: %w", err)
+		}
+		target, ok := targets[h.Name]
+		if !ok {
+			logger.Warn("ignore unknown file in snapshot archive", zap.String("file", h.Name))
+			continue
+		}
+		if err := json.NewDecoder(tr).Decode(target); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode %s: %w", h.Name, err)
+		}
+		found[h.Name] = struct{}{}
+	}
+	for name := range targets {
+		if _, ok := found[name]; !ok {
+			return nil, nil, fmt.Errorf("invalid snapshot archive: missing %s", name)
+		}
+	}
+	if md.FormatVersion != FormatVersion {
+		return nil, nil, fmt.Errorf("unsupported snapshot format version %d", md.FormatVersion)
+	}
+
+	result := &Result{
+		Imported: make(map[string]int),
+		Skipped:  make(map[string]int),
+	}
+	add := func(kind, id string, f func() error) error {
+		err := f()
+		switch {
+		case err == nil:
+			result.Imported[kind]++
+			return nil
+		case errors.Is(err, datastore.ErrAlreadyExists):
+			logger.Info(fmt.Sprintf("skip importing %s %s since it already exists", kind, id))
+			result.Skipped[kind]++
+			return nil
+		default:
+			return fmt.Errorf("failed to import %s %s: %w", kind, id, err)
+		}
+	}
+
+	// Import in the order of dependencies.
+	if err := add("project", project.Id, func() error { return stores.Project.Add(ctx, &project) }); err != nil {
+		return nil, nil, err
+	}
+	for _, p := range pipeds {
+		if err := add("piped", p.Id, func() error { return stores.Piped.Add(ctx, p) }); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, a := range applications {
+		if err := add("application", a.Id, func() error { return stores.Application.Add(ctx, a) }); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, d := range deployments {
+		if err := add("deployment", d.Id, func() error { return stores.Deployment.Add(ctx, d) }); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return &md, result, nil
+}
+
+func listAll[T any](ctx context.Context, filters []datastore.ListFilter, list func(context.Context, datastore.ListOptions) ([]T, string, error)) ([]T, error) {
+	var (
+		out    []T
+		cursor string
+		orders = []datastore.Order{
+			{
+				Field:     "UpdatedAt",
+				Direction: datastore.Desc,
+			},
+			{
+				Field:     "Id",
+				Direction: datastore.Asc,
+			},
+		}
+	)
+	for {
+		items, next, err := list(ctx, datastore.ListOptions{
+			Limit:   listLimit,
+			Cursor:  cursor,
+			Filters: filters,
+			Orders:  orders,
+		})
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, items...)
+		if next == "" || len(items) == 0 {
+			return out, nil
+		}
+		cursor = next
+	}
+}
+
+func writeJSON(tw *tar.Writer, name string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}); err != nil {
+		return err
+	}
+	_, err = tw.Write(data)
+	return err
+}
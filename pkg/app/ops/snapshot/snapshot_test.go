@@ -0,0 +1,104 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/datastore/datastoretest"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestExportImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	project := &model.Project{Id: "project-1", Desc: "desc"}
+	pipeds := []*model.Piped{{Id: "piped-1", Name: "piped", ProjectId: "project-1"}}
+	apps := []*model.Application{
+		{Id: "app-1", Name: "app-1", ProjectId: "project-1", PipedId: "piped-1"},
+		{Id: "app-2", Name: "app-2", ProjectId: "project-1", PipedId: "piped-1"},
+	}
+	deployments := []*model.Deployment{{Id: "deployment-1", ApplicationId: "app-1", ProjectId: "project-1"}}
+
+	src := Stores{
+		Project:     datastoretest.NewMockProjectStore(ctrl),
+		Piped:       datastoretest.NewMockPipedStore(ctrl),
+		Application: datastoretest.NewMockApplicationStore(ctrl),
+		Deployment:  datastoretest.NewMockDeploymentStore(ctrl),
+	}
+	src.Project.(*datastoretest.MockProjectStore).EXPECT().Get(gomock.Any(), "project-1").Return(project, nil)
+	src.Piped.(*datastoretest.MockPipedStore).EXPECT().List(gomock.Any(), gomock.Any()).Return(pipeds, nil)
+	// Applications are returned in two pages to verify the pagination.
+	gomock.InOrder(
+		src.Application.(*datastoretest.MockApplicationStore).EXPECT().List(gomock.Any(), gomock.Any()).Return(apps[:1], "cursor", nil),
+		src.Application.(*datastoretest.MockApplicationStore).EXPECT().List(gomock.Any(), gomock.Any()).Return(apps[1:], "", nil),
+	)
+	src.Deployment.(*datastoretest.MockDeploymentStore).EXPECT().List(gomock.Any(), gomock.Any()).Return(deployments, "", nil)
+
+	var buf bytes.Buffer
+	md, err := Export(ctx, src, "project-1", &buf, logger)
+	require.NoError(t, err)
+	assert.Equal(t, 1, md.PipedCount)
+	assert.Equal(t, 2, md.ApplicationCount)
+	assert.Equal(t, 1, md.DeploymentCount)
+
+	dst := Stores{
+		Project:     datastoretest.NewMockProjectStore(ctrl),
+		Piped:       datastoretest.NewMockPipedStore(ctrl),
+		Application: datastoretest.NewMockApplicationStore(ctrl),
+		Deployment:  datastoretest.NewMockDeploymentStore(ctrl),
+	}
+	var imported []string
+	record := func(_ context.Context, m interface{ GetId() string }) {
+		imported = append(imported, m.GetId())
+	}
+	dst.Project.(*datastoretest.MockProjectStore).EXPECT().Add(gomock.Any(), gomock.Any()).Return(datastore.ErrAlreadyExists)
+	dst.Piped.(*datastoretest.MockPipedStore).EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, p *model.Piped) error {
+		record(ctx, p)
+		return nil
+	})
+	dst.Application.(*datastoretest.MockApplicationStore).EXPECT().Add(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(func(ctx context.Context, a *model.Application) error {
+		record(ctx, a)
+		return nil
+	})
+	dst.Deployment.(*datastoretest.MockDeploymentStore).EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, d *model.Deployment) error {
+		record(ctx, d)
+		return nil
+	})
+
+	md, result, err := Import(ctx, dst, &buf, logger)
+	require.NoError(t, err)
+	assert.Equal(t, "project-1", md.ProjectID)
+	assert.Equal(t, []string{"piped-1", "app-1", "app-2", "deployment-1"}, imported)
+	assert.Equal(t, map[string]int{"piped": 1, "application": 2, "deployment": 1}, result.Imported)
+	assert.Equal(t, map[string]int{"project": 1}, result.Skipped)
+}
+
+func TestImportInvalidArchive(t *testing.T) {
+	_, _, err := Import(context.Background(), Stores{}, bytes.NewBufferString("invalid"), zap.NewNop())
+	assert.Error(t, err)
+}
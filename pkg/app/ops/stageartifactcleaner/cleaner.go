@@ -0,0 +1,103 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stageartifactcleaner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/filestore"
+)
+
+const (
+	cronSchedule = "0 10 * * *" // Run at 10:00 every day.
+	prefix       = "stage-artifacts/"
+)
+
+type store interface {
+	filestore.Lister
+	filestore.Deleter
+}
+
+type Cleaner struct {
+	store  store
+	ttl    time.Duration
+	logger *zap.Logger
+}
+
+func NewCleaner(s store, ttl time.Duration, logger *zap.Logger) *Cleaner {
+	return &Cleaner{
+		store:  s,
+		ttl:    ttl,
+		logger: logger.Named("stage-artifact-cleaner"),
+	}
+}
+
+func (c *Cleaner) Run(ctx context.Context) error {
+	c.logger.Info("start running stage artifact cleaner")
+
+	cr := cron.New()
+	if _, err := cr.AddFunc(cronSchedule, func() { c.clean(ctx) }); err != nil {
+		return err
+	}
+
+	cr.Start()
+	<-ctx.Done()
+	cr.Stop()
+
+	c.logger.Info("stage artifact cleaner has been stopped")
+	return nil
+}
+
+func (c *Cleaner) clean(ctx context.Context) error {
+	c.logger.Info("will find expired stage artifacts to delete")
+
+	objects, err := c.store.List(ctx, prefix)
+	if err != nil {
+		c.logger.Error("failed to list stage artifact objects",
+			zap.String("prefix", prefix),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	ttl := c.ttl.Seconds()
+	now := time.Now()
+	deletes := 0
+
+	for _, obj := range objects {
+		if float64(now.Unix()-obj.UpdatedAt) <= ttl {
+			continue
+		}
+		if err := c.store.Delete(ctx, obj.Path); err != nil {
+			c.logger.Error("failed to delete stage artifact object",
+				zap.String("path", obj.Path),
+				zap.Error(err),
+			)
+			continue
+		}
+		c.logger.Info("successfully deleted an expired stage artifact",
+			zap.String("path", obj.Path),
+		)
+		deletes++
+	}
+
+	c.logger.Info(fmt.Sprintf("deleted %d/%d expired stage artifacts", deletes, len(objects)))
+	return nil
+}
@@ -26,11 +26,16 @@ import (
 )
 
 // SyncApplication sents a command to sync a given application and waits until it has been triggered.
+// The given parameters are passed to the deployment parameters defined in the application configuration.
+// When promoteFromDeploymentID is given, the triggered deployment promotes the artifact of that deployment.
 // The deployment ID will be returned or an error.
 func SyncApplication(
 	ctx context.Context,
 	cli apiservice.Client,
 	appID string,
+	parameters map[string]string,
+	dryRun bool,
+	promoteFromDeploymentID string,
 	checkInterval, timeout time.Duration,
 	logger *zap.Logger,
 ) (string, error) {
@@ -38,7 +43,10 @@ func SyncApplication(
 	defer cancel()
 
 	req := &apiservice.SyncApplicationRequest{
-		ApplicationId: appID,
+		ApplicationId:           appID,
+		Parameters:              parameters,
+		DryRun:                  dryRun,
+		PromoteFromDeploymentId: promoteFromDeploymentID,
 	}
 	resp, err := cli.SyncApplication(ctx, req)
 	if err != nil {
@@ -47,56 +55,5 @@ func SyncApplication(
 
 	logger.Info("Sent a request to sync application and waiting to be accepted...")
 
-	ticker := time.NewTicker(checkInterval)
-	defer ticker.Stop()
-
-	check := func() (deploymentID string, shouldRetry bool) {
-		cmd, err := getCommand(ctx, cli, resp.CommandId)
-		if err != nil {
-			logger.Error(fmt.Sprintf("Failed while retrieving command information. Try again. (%v)", err))
-			shouldRetry = true
-			return
-		}
-
-		if cmd.Type != model.Command_SYNC_APPLICATION {
-			logger.Error(fmt.Sprintf("Unexpected command type, want: %s, got: %s", model.Command_SYNC_APPLICATION.String(), cmd.Type.String()))
-			return
-		}
-
-		switch cmd.Status {
-		case model.CommandStatus_COMMAND_SUCCEEDED:
-			deploymentID = cmd.Metadata[model.MetadataKeyTriggeredDeploymentID]
-			return
-
-		case model.CommandStatus_COMMAND_FAILED:
-			logger.Error("The request was unable to handle")
-			return
-
-		case model.CommandStatus_COMMAND_TIMEOUT:
-			logger.Error("The request was timed out")
-			return
-
-		default:
-			shouldRetry = true
-			return
-		}
-	}
-
-	for {
-		select {
-		case <-ctx.Done():
-			return "", ctx.Err()
-
-		case <-ticker.C:
-			deploymentID, shouldRetry := check()
-			if shouldRetry {
-				logger.Info("...")
-				continue
-			}
-			if deploymentID == "" {
-				return "", fmt.Errorf("failed to detect the triggered deployment ID")
-			}
-			return deploymentID, nil
-		}
-	}
+	return waitTriggeredDeployment(ctx, cli, resp.CommandId, model.Command_SYNC_APPLICATION, checkInterval, logger)
 }
@@ -18,9 +18,11 @@ import (
 	"context"
 	"crypto/tls"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/spf13/cobra"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/credentials"
 
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
@@ -109,3 +111,67 @@ func getCommand(ctx context.Context, cli apiservice.Client, cmdID string) (*mode
 	}
 	return resp.Command, nil
 }
+
+// waitTriggeredDeployment waits until the given command has been handled by piped
+// and returns the ID of the deployment triggered by that command.
+func waitTriggeredDeployment(
+	ctx context.Context,
+	cli apiservice.Client,
+	cmdID string,
+	cmdType model.Command_Type,
+	checkInterval time.Duration,
+	logger *zap.Logger,
+) (string, error) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	check := func() (deploymentID string, shouldRetry bool) {
+		cmd, err := getCommand(ctx, cli, cmdID)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Failed while retrieving command information. Try again. (%v)", err))
+			shouldRetry = true
+			return
+		}
+
+		if cmd.Type != cmdType {
+			logger.Error(fmt.Sprintf("Unexpected command type, want: %s, got: %s", cmdType.String(), cmd.Type.String()))
+			return
+		}
+
+		switch cmd.Status {
+		case model.CommandStatus_COMMAND_SUCCEEDED:
+			deploymentID = cmd.Metadata[model.MetadataKeyTriggeredDeploymentID]
+			return
+
+		case model.CommandStatus_COMMAND_FAILED:
+			logger.Error("The request was unable to handle")
+			return
+
+		case model.CommandStatus_COMMAND_TIMEOUT:
+			logger.Error("The request was timed out")
+			return
+
+		default:
+			shouldRetry = true
+			return
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+
+		case <-ticker.C:
+			deploymentID, shouldRetry := check()
+			if shouldRetry {
+				logger.Info("...")
+				continue
+			}
+			if deploymentID == "" {
+				return "", fmt.Errorf("failed to detect the triggered deployment ID")
+			}
+			return deploymentID, nil
+		}
+	}
+}
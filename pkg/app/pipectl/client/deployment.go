@@ -25,6 +25,31 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
+// RetryDeployment sends a command to retry a given failed deployment and waits until the new deployment has been triggered.
+// The ID of the new deployment will be returned or an error.
+func RetryDeployment(
+	ctx context.Context,
+	cli apiservice.Client,
+	deploymentID string,
+	checkInterval, timeout time.Duration,
+	logger *zap.Logger,
+) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req := &apiservice.RetryDeploymentRequest{
+		DeploymentId: deploymentID,
+	}
+	resp, err := cli.RetryDeployment(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to retry deployment %w", err)
+	}
+
+	logger.Info("Sent a request to retry deployment and waiting to be accepted...")
+
+	return waitTriggeredDeployment(ctx, cli, resp.CommandId, model.Command_RETRY_DEPLOYMENT, checkInterval, logger)
+}
+
 // WaitDeploymentStatuses waits a given deployment until it reaches one of the specified statuses.
 func WaitDeploymentStatuses(
 	ctx context.Context,
@@ -40,6 +40,8 @@ func NewCommand() *cobra.Command {
 		newListCommand(c),
 		newDeleteCommand(c),
 		newDisableCommand(c),
+		newMigrateCommand(c),
+		newLabelCommand(c),
 	)
 
 	c.clientOptions.RegisterPersistentFlags(cmd)
@@ -0,0 +1,116 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+)
+
+type label struct {
+	root *command
+
+	appIDs                 []string
+	selector               []string
+	addLabels              []string
+	removeLabels           []string
+	propagateToDeployments bool
+	dryRun                 bool
+}
+
+func newLabelCommand(root *command) *cobra.Command {
+	c := &label{
+		root: root,
+	}
+	cmd := &cobra.Command{
+		Use:   "label",
+		Short: "Add or remove labels of multiple applications at once.",
+		Example: `  pipectl application label --selector team:a --add env:prod --remove stage
+  pipectl application label --app-id=app-1 --app-id=app-2 --add team:b --propagate-to-deployments`,
+		RunE: cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringSliceVar(&c.appIDs, "app-id", c.appIDs, "The IDs of the target applications.")
+	cmd.Flags().StringSliceVar(&c.selector, "selector", c.selector, "The labels to select the target applications. Expect input in the form KEY:VALUE.")
+	cmd.Flags().StringSliceVar(&c.addLabels, "add", c.addLabels, "The labels to be added. Expect input in the form KEY:VALUE.")
+	cmd.Flags().StringSliceVar(&c.removeLabels, "remove", c.removeLabels, "The keys of the labels to be removed.")
+	cmd.Flags().BoolVar(&c.propagateToDeployments, "propagate-to-deployments", c.propagateToDeployments, "Whether to update the labels of the deployments of the target applications as well.")
+	cmd.Flags().BoolVar(&c.dryRun, "dry-run", c.dryRun, "Show the applications which would be updated without updating them.")
+
+	return cmd
+}
+
+func (c *label) run(ctx context.Context, input cli.Input) error {
+	if len(c.appIDs) == 0 && len(c.selector) == 0 {
+		return errors.New("either --app-id or --selector must be specified")
+	}
+	selector, err := parseLabels(c.selector)
+	if err != nil {
+		return fmt.Errorf("invalid selector: %w", err)
+	}
+	addLabels, err := parseLabels(c.addLabels)
+	if err != nil {
+		return fmt.Errorf("invalid labels to be added: %w", err)
+	}
+
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	req := &apiservice.UpdateApplicationLabelsRequest{
+		ApplicationIds:         c.appIDs,
+		Selector:               selector,
+		AddLabels:              addLabels,
+		RemoveLabels:           c.removeLabels,
+		PropagateToDeployments: c.propagateToDeployments,
+		DryRun:                 c.dryRun,
+	}
+
+	resp, err := cli.UpdateApplicationLabels(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to update application labels: %w", err)
+	}
+
+	if c.dryRun {
+		input.Logger.Info(fmt.Sprintf("%d applications would be updated: %s", len(resp.ApplicationIds), strings.Join(resp.ApplicationIds, ", ")))
+		return nil
+	}
+	input.Logger.Info(fmt.Sprintf("Successfully updated labels of %d applications and %d deployments", len(resp.ApplicationIds), resp.UpdatedDeploymentCount))
+	return nil
+}
+
+func parseLabels(labels []string) (map[string]string, error) {
+	if len(labels) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]string, len(labels))
+	for _, l := range labels {
+		sp := strings.SplitN(l, ":", 2)
+		if len(sp) != 2 || sp[0] == "" {
+			return nil, fmt.Errorf("label %q must be in the form KEY:VALUE", l)
+		}
+		out[sp[0]] = sp[1]
+	}
+	return out, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+)
+
+type migrate struct {
+	root *command
+
+	appID            string
+	pipedID          string
+	platformProvider string
+}
+
+func newMigrateCommand(root *command) *cobra.Command {
+	c := &migrate{
+		root: root,
+	}
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Move an application to another piped while keeping its deployment history.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.appID, "app-id", c.appID, "The application ID.")
+	cmd.Flags().StringVar(&c.pipedID, "piped-id", c.pipedID, "The ID of the piped to move the application to.")
+	cmd.Flags().StringVar(&c.platformProvider, "platform-provider", c.platformProvider, "The name of the platform provider of the new piped. Empty means the current one is kept.")
+
+	cmd.MarkFlagRequired("app-id")
+	cmd.MarkFlagRequired("piped-id")
+
+	return cmd
+}
+
+func (c *migrate) run(ctx context.Context, input cli.Input) error {
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	req := &apiservice.MigrateApplicationRequest{
+		ApplicationId:    c.appID,
+		PipedId:          c.pipedID,
+		PlatformProvider: c.platformProvider,
+	}
+
+	resp, err := cli.MigrateApplication(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to migrate application: %w", err)
+	}
+
+	input.Logger.Info(fmt.Sprintf("Successfully migrated application id = %s to piped id = %s", resp.ApplicationId, c.pipedID))
+	return nil
+}
@@ -31,6 +31,9 @@ type sync struct {
 	root *command
 
 	appID         string
+	parameters    map[string]string
+	dryRun        bool
+	promoteFrom   string
 	statuses      []string
 	checkInterval time.Duration
 	timeout       time.Duration
@@ -49,6 +52,9 @@ func newSyncCommand(root *command) *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&c.appID, "app-id", c.appID, "The application ID.")
+	cmd.Flags().StringToStringVar(&c.parameters, "parameter", c.parameters, "The value of a deployment parameter defined in the application configuration (key=value). Can be specified multiple times.")
+	cmd.Flags().BoolVar(&c.dryRun, "dry-run", c.dryRun, "Whether to simulate the whole pipeline without mutating the platform resources.")
+	cmd.Flags().StringVar(&c.promoteFrom, "promote-from-deployment-id", c.promoteFrom, "The ID of the successful deployment of another application whose artifact should be promoted to this application.")
 	cmd.Flags().StringSliceVar(&c.statuses, "wait-status", c.statuses, fmt.Sprintf("The list of waiting statuses. Empty means returning immediately after triggered. (%s)", strings.Join(model.DeploymentStatusStrings(), "|")))
 	cmd.Flags().DurationVar(&c.checkInterval, "check-interval", c.checkInterval, "The interval of checking the requested command.")
 	cmd.Flags().DurationVar(&c.timeout, "timeout", c.timeout, "Maximum execution time.")
@@ -70,7 +76,7 @@ func (c *sync) run(ctx context.Context, input cli.Input) error {
 	}
 	defer cli.Close()
 
-	deploymentID, err := client.SyncApplication(ctx, cli, c.appID, c.checkInterval, c.timeout, input.Logger)
+	deploymentID, err := client.SyncApplication(ctx, cli, c.appID, c.parameters, c.dryRun, c.promoteFrom, c.checkInterval, c.timeout, input.Logger)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,202 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// approvalSelector holds the flags to select the WAIT_APPROVAL stages waiting for an approval.
+type approvalSelector struct {
+	deploymentIDs []string
+	appIDs        []string
+	appName       string
+	appKinds      []string
+	labels        []string
+}
+
+func (s *approvalSelector) registerFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&s.deploymentIDs, "deployment-id", s.deploymentIDs, "The list of deployment ids to filter.")
+	cmd.Flags().StringSliceVar(&s.appIDs, "app-id", s.appIDs, "The list of application ids to filter.")
+	cmd.Flags().StringVar(&s.appName, "app-name", s.appName, "The application name to filter.")
+	cmd.Flags().StringSliceVar(&s.appKinds, "app-kind", s.appKinds, fmt.Sprintf("The list of application kinds to filter. (%s)", strings.Join(model.ApplicationKindStrings(), "|")))
+	cmd.Flags().StringSliceVar(&s.labels, "label", s.labels, "The list of labels to filter. Expect input in the form KEY:VALUE.")
+}
+
+func (s *approvalSelector) build() (*apiservice.ApprovalSelector, error) {
+	for _, kind := range s.appKinds {
+		if _, ok := model.ApplicationKind_value[kind]; !ok {
+			return nil, fmt.Errorf("%s is invalid application kind", kind)
+		}
+	}
+
+	labels := make(map[string]string, len(s.labels))
+	for _, label := range s.labels {
+		sp := strings.SplitN(label, ":", 2)
+		if len(sp) != 2 {
+			return nil, fmt.Errorf("invalid label %q, expect input in the form KEY:VALUE", label)
+		}
+		labels[sp[0]] = sp[1]
+	}
+
+	return &apiservice.ApprovalSelector{
+		DeploymentIds:   s.deploymentIDs,
+		ApplicationIds:  s.appIDs,
+		ApplicationName: s.appName,
+		Kinds:           s.appKinds,
+		Labels:          labels,
+	}, nil
+}
+
+type listApprovals struct {
+	root *command
+
+	selector approvalSelector
+	stdout   io.Writer
+}
+
+func newListApprovalsCommand(root *command) *cobra.Command {
+	c := &listApprovals{
+		root:   root,
+		stdout: os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "list-approvals",
+		Short: "Show the list of WAIT_APPROVAL stages waiting for an approval across the project.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	c.selector.registerFlags(cmd)
+
+	return cmd
+}
+
+func (c *listApprovals) run(ctx context.Context, _ cli.Input) error {
+	selector, err := c.selector.build()
+	if err != nil {
+		return err
+	}
+
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	resp, err := cli.ListPendingApprovals(ctx, &apiservice.ListPendingApprovalsRequest{
+		Selector: selector,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list pending approvals: %w", err)
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("failed to marshal pending approvals: %w", err)
+	}
+
+	fmt.Fprintln(c.stdout, string(bytes))
+	return nil
+}
+
+type approveAll struct {
+	root *command
+
+	selector          approvalSelector
+	reject            bool
+	comment           string
+	approverToken     string
+	approverTokenFile string
+	stdout            io.Writer
+}
+
+func newApproveAllCommand(root *command) *cobra.Command {
+	c := &approveAll{
+		root:   root,
+		stdout: os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "approve-all",
+		Short: "Approve or reject all WAIT_APPROVAL stages matching the given filters on behalf of the approver identified by a signed token.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	c.selector.registerFlags(cmd)
+	cmd.Flags().BoolVar(&c.reject, "reject", c.reject, "Whether to reject the stages instead of approving them. Rejecting a stage cancels its deployment.")
+	cmd.Flags().StringVar(&c.comment, "comment", c.comment, "The comment shared by all of the approvals or rejections.")
+	cmd.Flags().StringVar(&c.approverToken, "approver-token", c.approverToken, "The JWT signed by one of the approval issuers configured in the control plane.")
+	cmd.Flags().StringVar(&c.approverTokenFile, "approver-token-file", c.approverTokenFile, "The path to the file containing the approver token.")
+
+	cmd.MarkFlagsMutuallyExclusive("approver-token", "approver-token-file")
+
+	return cmd
+}
+
+func (c *approveAll) run(ctx context.Context, input cli.Input) error {
+	token, err := loadApproverToken(c.approverToken, c.approverTokenFile)
+	if err != nil {
+		return err
+	}
+	selector, err := c.selector.build()
+	if err != nil {
+		return err
+	}
+
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	resp, err := cli.ApproveStages(ctx, &apiservice.ApproveStagesRequest{
+		Selector:      selector,
+		ApproverToken: token,
+		Reject:        c.reject,
+		Comment:       c.comment,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to approve the stages: %w", err)
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the results: %w", err)
+	}
+	fmt.Fprintln(c.stdout, string(bytes))
+
+	var failed int
+	for _, r := range resp.Results {
+		if r.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to handle %d of %d stages", failed, len(resp.Results))
+	}
+
+	input.Logger.Info(fmt.Sprintf("Successfully handled %d stages", len(resp.Results)))
+	return nil
+}
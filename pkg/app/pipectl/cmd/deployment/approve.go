@@ -0,0 +1,99 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+)
+
+type approve struct {
+	root *command
+
+	deploymentID      string
+	stageID           string
+	approverToken     string
+	approverTokenFile string
+}
+
+func newApproveCommand(root *command) *cobra.Command {
+	c := &approve{
+		root: root,
+	}
+	cmd := &cobra.Command{
+		Use:   "approve",
+		Short: "Approve a WAIT_APPROVAL stage on behalf of the approver identified by a signed token.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.deploymentID, "deployment-id", c.deploymentID, "The ID of the running deployment.")
+	cmd.Flags().StringVar(&c.stageID, "stage-id", c.stageID, "The ID of the WAIT_APPROVAL stage.")
+	cmd.Flags().StringVar(&c.approverToken, "approver-token", c.approverToken, "The JWT signed by one of the approval issuers configured in the control plane.")
+	cmd.Flags().StringVar(&c.approverTokenFile, "approver-token-file", c.approverTokenFile, "The path to the file containing the approver token.")
+
+	cmd.MarkFlagRequired("deployment-id")
+	cmd.MarkFlagRequired("stage-id")
+	cmd.MarkFlagsMutuallyExclusive("approver-token", "approver-token-file")
+
+	return cmd
+}
+
+func (c *approve) run(ctx context.Context, input cli.Input) error {
+	token, err := loadApproverToken(c.approverToken, c.approverTokenFile)
+	if err != nil {
+		return err
+	}
+
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	req := &apiservice.ApproveStageRequest{
+		DeploymentId:  c.deploymentID,
+		StageId:       c.stageID,
+		ApproverToken: token,
+	}
+	resp, err := cli.ApproveStage(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to approve the stage: %w", err)
+	}
+
+	input.Logger.Info(fmt.Sprintf("Successfully approved stage %s with command %s", c.stageID, resp.CommandId))
+	return nil
+}
+
+// loadApproverToken returns the approver token given directly or through the file.
+func loadApproverToken(token, file string) (string, error) {
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read the approver token file: %w", err)
+		}
+		token = strings.TrimSpace(string(data))
+	}
+	if token == "" {
+		return "", fmt.Errorf("either approver-token or approver-token-file must be set")
+	}
+	return token, nil
+}
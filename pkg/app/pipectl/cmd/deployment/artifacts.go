@@ -0,0 +1,113 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+)
+
+type artifacts struct {
+	root *command
+
+	deploymentID string
+	stageID      string
+	name         string
+	output       string
+	stdout       io.Writer
+}
+
+func newArtifactsCommand(root *command) *cobra.Command {
+	c := &artifacts{
+		root:   root,
+		stdout: os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "artifacts",
+		Short: "List or download the artifacts produced while executing a stage.",
+		Long:  "List the artifacts of the given stage, or download the content of the artifact given by --name.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.deploymentID, "deployment-id", c.deploymentID, "The deployment ID.")
+	cmd.Flags().StringVar(&c.stageID, "stage-id", c.stageID, "The stage ID.")
+	cmd.Flags().StringVar(&c.name, "name", c.name, "The name of the artifact to download. Empty means listing all artifacts of the stage.")
+	cmd.Flags().StringVar(&c.output, "output", c.output, "The path to the file where the downloaded artifact is written. Empty means the standard output.")
+
+	cmd.MarkFlagRequired("deployment-id")
+	cmd.MarkFlagRequired("stage-id")
+
+	return cmd
+}
+
+func (c *artifacts) run(ctx context.Context, input cli.Input) error {
+	if c.output != "" && c.name == "" {
+		return fmt.Errorf("--output can be used only with --name")
+	}
+
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	if c.name == "" {
+		resp, err := cli.ListStageArtifacts(ctx, &apiservice.ListStageArtifactsRequest{
+			DeploymentId: c.deploymentID,
+			StageId:      c.stageID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list stage artifacts: %w", err)
+		}
+
+		bytes, err := json.Marshal(resp)
+		if err != nil {
+			return fmt.Errorf("failed to marshal stage artifacts: %w", err)
+		}
+		fmt.Fprintln(c.stdout, string(bytes))
+		return nil
+	}
+
+	resp, err := cli.GetStageArtifact(ctx, &apiservice.GetStageArtifactRequest{
+		DeploymentId: c.deploymentID,
+		StageId:      c.stageID,
+		Name:         c.name,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get stage artifact: %w", err)
+	}
+
+	if c.output == "" {
+		_, err = c.stdout.Write(resp.Content)
+		return err
+	}
+	if err := os.WriteFile(c.output, resp.Content, 0644); err != nil {
+		return fmt.Errorf("failed to write stage artifact to %s: %w", c.output, err)
+	}
+	input.Logger.Info("Successfully downloaded stage artifact",
+		zap.String("name", c.name),
+		zap.String("output", c.output),
+	)
+	return nil
+}
@@ -36,6 +36,12 @@ func NewCommand() *cobra.Command {
 	cmd.AddCommand(newWaitStatusCommand(c))
 	cmd.AddCommand(newLogsCommand(c))
 	cmd.AddCommand(newListCommand(c))
+	cmd.AddCommand(newRetryCommand(c))
+	cmd.AddCommand(newReportStageResultCommand(c))
+	cmd.AddCommand(newApproveCommand(c))
+	cmd.AddCommand(newListApprovalsCommand(c))
+	cmd.AddCommand(newApproveAllCommand(c))
+	cmd.AddCommand(newArtifactsCommand(c))
 
 	c.clientOptions.RegisterPersistentFlags(cmd)
 
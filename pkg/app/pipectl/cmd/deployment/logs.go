@@ -17,9 +17,11 @@ package deployment
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -31,6 +33,8 @@ type logs struct {
 	root *command
 
 	deploymentID string
+	follow       bool
+	stageName    string
 	stdout       io.Writer
 }
 
@@ -40,25 +44,47 @@ func newLogsCommand(root *command) *cobra.Command {
 		stdout: os.Stdout,
 	}
 	cmd := &cobra.Command{
-		Use:   "logs",
+		Use:   "logs [DEPLOYMENT_ID]",
 		Short: "Get deployment stage logs.",
-		RunE:  cli.WithContext(c.run),
+		Args:  cobra.MaximumNArgs(1),
+		PreRunE: func(_ *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return nil
+			}
+			if c.deploymentID != "" && c.deploymentID != args[0] {
+				return fmt.Errorf("deployment ID is specified by both the argument and --deployment-id")
+			}
+			c.deploymentID = args[0]
+			return nil
+		},
+		RunE: cli.WithContext(c.run),
 	}
 
-	cmd.Flags().StringVar(&c.deploymentID, "deployment-id", c.deploymentID, "The deployment ID.")
-
-	cmd.MarkFlagRequired("deployment-id")
+	cmd.Flags().StringVar(&c.deploymentID, "deployment-id", c.deploymentID, "The deployment ID. It can also be given as an argument.")
+	cmd.Flags().BoolVarP(&c.follow, "follow", "f", c.follow, "Whether to stream the stage logs in near real time until the deployment is completed.")
+	cmd.Flags().StringVar(&c.stageName, "stage", c.stageName, "The name of the stage to stream logs. Empty means all stages. Used with --follow.")
 
 	return cmd
 }
 
 func (c *logs) run(ctx context.Context, input cli.Input) error {
+	if c.deploymentID == "" {
+		return fmt.Errorf("deployment ID is required")
+	}
+	if c.stageName != "" && !c.follow {
+		return fmt.Errorf("--stage can be used only with --follow")
+	}
+
 	cli, err := c.root.clientOptions.NewClient(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to initialize client: %w", err)
 	}
 	defer cli.Close()
 
+	if c.follow {
+		return c.streamLogs(ctx, cli)
+	}
+
 	req := &apiservice.ListStageLogsRequest{
 		DeploymentId: c.deploymentID,
 	}
@@ -76,3 +102,38 @@ func (c *logs) run(ctx context.Context, input cli.Input) error {
 	fmt.Fprintln(c.stdout, string(bytes))
 	return nil
 }
+
+func (c *logs) streamLogs(ctx context.Context, cli apiservice.Client) error {
+	stream, err := cli.StreamStageLogs(ctx, &apiservice.StreamStageLogsRequest{
+		DeploymentId: c.deploymentID,
+		StageName:    c.stageName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stream stage logs: %w", err)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to stream stage logs: %w", err)
+		}
+		c.printLogBlocks(resp)
+	}
+}
+
+// printLogBlocks prints the log blocks as is to keep their ANSI color codes.
+// Each line is prefixed with the stage name when the logs of all stages are streamed.
+func (c *logs) printLogBlocks(resp *apiservice.StreamStageLogsResponse) {
+	for _, b := range resp.Blocks {
+		for _, line := range strings.Split(strings.TrimSuffix(b.Log, "\n"), "\n") {
+			if c.stageName == "" {
+				fmt.Fprintf(c.stdout, "[%s] %s\n", resp.StageName, line)
+				continue
+			}
+			fmt.Fprintln(c.stdout, line)
+		}
+	}
+}
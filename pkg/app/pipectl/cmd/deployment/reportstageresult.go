@@ -0,0 +1,93 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type reportStageResult struct {
+	root *command
+
+	deploymentID string
+	stageID      string
+	result       string
+	message      string
+}
+
+func newReportStageResultCommand(root *command) *cobra.Command {
+	c := &reportStageResult{
+		root: root,
+	}
+	cmd := &cobra.Command{
+		Use:   "report-stage-result",
+		Short: "Report the result of a job executed outside piped to an EXTERNAL_WAIT stage.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.deploymentID, "deployment-id", c.deploymentID, "The ID of the running deployment.")
+	cmd.Flags().StringVar(&c.stageID, "stage-id", c.stageID, "The ID of the EXTERNAL_WAIT stage.")
+	cmd.Flags().StringVar(&c.result, "result", c.result, fmt.Sprintf("The result of the external job. (%s)", strings.Join(resultStrings(), "|")))
+	cmd.Flags().StringVar(&c.message, "message", c.message, "The message describing the result.")
+
+	cmd.MarkFlagRequired("deployment-id")
+	cmd.MarkFlagRequired("stage-id")
+	cmd.MarkFlagRequired("result")
+
+	return cmd
+}
+
+func (c *reportStageResult) run(ctx context.Context, input cli.Input) error {
+	result, ok := model.Command_ReportStageResult_Result_value[strings.ToUpper(c.result)]
+	if !ok {
+		return fmt.Errorf("invalid result %q, must be one of %s", c.result, strings.Join(resultStrings(), ", "))
+	}
+
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	req := &apiservice.ReportStageResultRequest{
+		DeploymentId: c.deploymentID,
+		StageId:      c.stageID,
+		Result:       model.Command_ReportStageResult_Result(result),
+		Message:      c.message,
+	}
+	resp, err := cli.ReportStageResult(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to report the stage result: %w", err)
+	}
+
+	input.Logger.Info(fmt.Sprintf("Successfully reported the result of stage %s with command %s", c.stageID, resp.CommandId))
+	return nil
+}
+
+func resultStrings() []string {
+	out := make([]string, 0, len(model.Command_ReportStageResult_Result_name))
+	for i := 0; i < len(model.Command_ReportStageResult_Result_name); i++ {
+		out = append(out, model.Command_ReportStageResult_Result_name[int32(i)])
+	}
+	return out
+}
@@ -43,6 +43,9 @@ type register struct {
 	commitURL       string
 	commitAuthor    string
 	commitTimestamp int64
+
+	// URL of the CI run that sends the event
+	runURL string
 }
 
 func newRegisterCommand(root *command) *cobra.Command {
@@ -66,6 +69,7 @@ func newRegisterCommand(root *command) *cobra.Command {
 	cmd.Flags().StringVar(&r.commitURL, "commit-url", r.commitURL, "The URL of commit that triggers the event.")
 	cmd.Flags().StringVar(&r.commitAuthor, "commit-author", r.commitAuthor, "The author of commit that triggers the event.")
 	cmd.Flags().Int64Var(&r.commitTimestamp, "commit-timestamp", r.commitTimestamp, "The timestamp of commit that triggers the event.")
+	cmd.Flags().StringVar(&r.runURL, "run-url", r.runURL, "The URL of the CI run that sends the event.")
 
 	cmd.MarkFlagRequired("name")
 	cmd.MarkFlagRequired("data")
@@ -95,6 +99,7 @@ func (r *register) run(ctx context.Context, input cli.Input) error {
 		CommitUrl:       r.commitURL,
 		CommitAuthor:    r.commitAuthor,
 		CommitTimestamp: r.commitTimestamp,
+		RunUrl:          r.runURL,
 	}
 
 	res, err := cli.RegisterEvent(ctx, req)
@@ -0,0 +1,132 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migrate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	configv1 "github.com/pipe-cd/pipecd/pkg/configv1"
+)
+
+// errAlreadyMigrated is returned when the given application config is already using the plugin-based schema.
+var errAlreadyMigrated = errors.New("application config is already migrated")
+
+// defaultPluginNames is the name of the plugin used to deploy each kind of application by default.
+var defaultPluginNames = map[config.Kind]string{
+	config.KindKubernetesApp: "kubernetes",
+	config.KindTerraformApp:  "terraform",
+	config.KindCloudRunApp:   "cloudrun",
+	config.KindLambdaApp:     "lambda",
+	config.KindECSApp:        "ecs",
+}
+
+// genericSpecFields is the set of the fields defined in the generic application spec of pipedv1.
+// These fields are kept as is, and the other fields are moved to the plugin config while converting.
+var genericSpecFields = jsonFieldNames(reflect.TypeOf(configv1.GenericApplicationSpec{}))
+
+func jsonFieldNames(t reflect.Type) map[string]struct{} {
+	names := make(map[string]struct{}, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("json")
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		names[name] = struct{}{}
+	}
+	return names
+}
+
+// convertApplicationConfig converts the given v0 application config (e.g. ECSApp)
+// into the plugin-based schema used by pipedv1.
+// The kind-specific fields are moved under spec.plugins.<plugin-name>,
+// where the plugin name is looked up from the given map, or from defaultPluginNames if not specified.
+// Both of the given and the converted configs are validated.
+func convertApplicationConfig(data []byte, pluginNames map[config.Kind]string) ([]byte, error) {
+	js, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse application config: %w", err)
+	}
+
+	var raw struct {
+		Kind config.Kind                `json:"kind"`
+		Spec map[string]json.RawMessage `json:"spec"`
+	}
+	if err := json.Unmarshal(js, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse application config: %w", err)
+	}
+	if raw.Kind == config.Kind(configv1.KindApplication) {
+		return nil, errAlreadyMigrated
+	}
+
+	cfg, err := config.DecodeYAML(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid application config: %w", err)
+	}
+	if _, ok := cfg.Kind.ToApplicationKind(); !ok {
+		return nil, fmt.Errorf("kind %s is not an application kind", cfg.Kind)
+	}
+
+	pluginName := pluginNames[cfg.Kind]
+	if pluginName == "" {
+		pluginName = defaultPluginNames[cfg.Kind]
+	}
+	if pluginName == "" {
+		return nil, fmt.Errorf("no plugin is specified for kind %s", cfg.Kind)
+	}
+
+	var (
+		spec         = make(map[string]json.RawMessage, len(raw.Spec))
+		pluginConfig = make(map[string]json.RawMessage)
+	)
+	for k, v := range raw.Spec {
+		if _, ok := genericSpecFields[k]; ok {
+			spec[k] = v
+			continue
+		}
+		pluginConfig[k] = v
+	}
+
+	plugins, err := json.Marshal(map[string]any{pluginName: pluginConfig})
+	if err != nil {
+		return nil, err
+	}
+	spec["plugins"] = plugins
+
+	out, err := json.Marshal(map[string]any{
+		"apiVersion": configv1.VersionV1Beta1,
+		"kind":       configv1.KindApplication,
+		"spec":       spec,
+	})
+	if err != nil {
+		return nil, err
+	}
+	converted, err := yaml.JSONToYAML(out)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := configv1.DecodeYAML[*configv1.GenericApplicationSpec](converted); err != nil {
+		return nil, fmt.Errorf("converted application config is invalid: %w", err)
+	}
+	return converted, nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestConvertApplicationConfig(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name         string
+		input        string
+		expectedFile string
+	}{
+		{
+			name:         "ECS application",
+			input:        "ecs-app.yaml",
+			expectedFile: "ecs-app.converted.yaml",
+		},
+		{
+			name:         "Kubernetes application",
+			input:        "kubernetes-app.yaml",
+			expectedFile: "kubernetes-app.converted.yaml",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := os.ReadFile(filepath.Join("testdata", tc.input))
+			require.NoError(t, err)
+			expected, err := os.ReadFile(filepath.Join("testdata", tc.expectedFile))
+			require.NoError(t, err)
+
+			got, err := convertApplicationConfig(data, nil)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(got))
+		})
+	}
+}
+
+func TestConvertApplicationConfig_PluginName(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`
+apiVersion: pipecd.dev/v1beta1
+kind: LambdaApp
+spec:
+  name: hello
+  input:
+    functionManifestFile: function.yaml
+`)
+	expected := `apiVersion: pipecd.dev/v1beta1
+kind: Application
+spec:
+  name: hello
+  plugins:
+    my-lambda:
+      input:
+        functionManifestFile: function.yaml
+`
+	got, err := convertApplicationConfig(data, map[config.Kind]string{config.KindLambdaApp: "my-lambda"})
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(got))
+}
+
+func TestConvertApplicationConfig_Error(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		data        string
+		expectedErr error
+	}{
+		{
+			name: "already migrated",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: Application
+spec:
+  name: simple
+  plugins:
+    kubernetes: {}
+`,
+			expectedErr: errAlreadyMigrated,
+		},
+		{
+			name: "not an application kind",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: EventWatcher
+spec:
+  events: []
+`,
+		},
+		{
+			name: "unknown field",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: ECSApp
+spec:
+  name: simple
+  unknown: true
+`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := convertApplicationConfig([]byte(tc.data), nil)
+			require.Error(t, err)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestFindApplicationConfigFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for _, f := range []string{
+		"app.pipecd.yaml",
+		"foo/bar.pipecd.yaml",
+		"foo/deployment.yaml",
+		".git/app.pipecd.yaml",
+	} {
+		p := filepath.Join(dir, f)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, nil, 0644))
+	}
+
+	files, err := findApplicationConfigFiles(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "app.pipecd.yaml"),
+		filepath.Join(dir, "foo", "bar.pipecd.yaml"),
+	}, files)
+}
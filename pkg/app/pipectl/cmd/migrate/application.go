@@ -0,0 +1,284 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v29/github"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+
+	"github.com/pipe-cd/pipecd/pkg/cli"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type applicationConfig struct {
+	path        string
+	outputDir   string
+	inPlace     bool
+	pluginNames map[string]string
+
+	openPullRequest  bool
+	repoDir          string
+	remote           string
+	baseBranch       string
+	branch           string
+	githubToken      string
+	githubRepository string
+	pullRequestTitle string
+
+	stdout io.Writer
+}
+
+func newApplicationConfigCommand() *cobra.Command {
+	c := &applicationConfig{
+		repoDir:          ".",
+		remote:           "origin",
+		baseBranch:       "main",
+		branch:           "pipecd-migrate-application-config",
+		pullRequestTitle: "Migrate application configs to the plugin-based schema",
+		stdout:           os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "application-config",
+		Short: "Convert application configs into the schema of plugin-architectured piped.",
+		Long: "Convert v0 application configs (e.g. KubernetesApp, ECSApp) into the plugin-based schema (kind: Application) used by plugin-architectured piped.\n" +
+			"The kind-specific fields are moved under spec.plugins.<plugin-name>, and the converted configs are validated before being output.\n" +
+			"By default, the converted configs are printed to stdout.",
+		RunE: cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.path, "path", c.path, "The path to an application config file or a directory containing application config files.")
+	cmd.Flags().StringVar(&c.outputDir, "output-dir", c.outputDir, "The directory to write the converted configs to. The directory structure under --path is kept.")
+	cmd.Flags().BoolVar(&c.inPlace, "in-place", c.inPlace, "Whether to overwrite the original files with the converted configs.")
+	cmd.Flags().StringToStringVar(&c.pluginNames, "plugin-names", c.pluginNames, "The name of the plugin used for each application kind. e.g. ECSApp=ecs,KubernetesApp=kubernetes")
+
+	cmd.Flags().BoolVar(&c.openPullRequest, "open-pull-request", c.openPullRequest, "Whether to commit the converted configs to a new branch and open a pull request on GitHub.")
+	cmd.Flags().StringVar(&c.repoDir, "repo-dir", c.repoDir, "The path to the local git repository containing the application configs. Used with --open-pull-request.")
+	cmd.Flags().StringVar(&c.remote, "remote", c.remote, "The git remote to push the branch to. Used with --open-pull-request.")
+	cmd.Flags().StringVar(&c.baseBranch, "base-branch", c.baseBranch, "The base branch of the pull request. Used with --open-pull-request.")
+	cmd.Flags().StringVar(&c.branch, "branch", c.branch, "The branch to commit the converted configs to. Used with --open-pull-request.")
+	cmd.Flags().StringVar(&c.githubToken, "github-token", c.githubToken, "The GitHub token used to open the pull request. Used with --open-pull-request.")
+	cmd.Flags().StringVar(&c.githubRepository, "github-repository", c.githubRepository, "The GitHub repository in owner/name format. Used with --open-pull-request.")
+	cmd.Flags().StringVar(&c.pullRequestTitle, "pull-request-title", c.pullRequestTitle, "The title of the pull request. Used with --open-pull-request.")
+
+	cmd.MarkFlagRequired("path")
+	cmd.MarkFlagsMutuallyExclusive("output-dir", "in-place", "open-pull-request")
+	return cmd
+}
+
+func (c *applicationConfig) run(ctx context.Context, input cli.Input) error {
+	if c.openPullRequest {
+		if c.githubToken == "" || c.githubRepository == "" {
+			return fmt.Errorf("--github-token and --github-repository are required to open a pull request")
+		}
+		if _, _, ok := strings.Cut(c.githubRepository, "/"); !ok {
+			return fmt.Errorf("invalid --github-repository %q: must be in owner/name format", c.githubRepository)
+		}
+	}
+
+	pluginNames := make(map[config.Kind]string, len(c.pluginNames))
+	for k, v := range c.pluginNames {
+		pluginNames[config.Kind(k)] = v
+	}
+
+	files, err := findApplicationConfigFiles(c.path)
+	if err != nil {
+		input.Logger.Error("failed to find application config files", zap.Error(err))
+		return err
+	}
+
+	converted := make(map[string][]byte, len(files))
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return err
+		}
+		out, err := convertApplicationConfig(data, pluginNames)
+		if errors.Is(err, errAlreadyMigrated) {
+			input.Logger.Info("skip converting application config because it is already migrated", zap.String("file", f))
+			continue
+		}
+		if err != nil {
+			input.Logger.Error("failed to convert application config", zap.String("file", f), zap.Error(err))
+			return fmt.Errorf("failed to convert %s: %w", f, err)
+		}
+		converted[f] = out
+	}
+
+	if len(converted) == 0 {
+		input.Logger.Info("no application config to convert")
+		return nil
+	}
+	input.Logger.Info(fmt.Sprintf("successfully converted %d application configs", len(converted)))
+
+	switch {
+	case c.openPullRequest:
+		return c.commitAndOpenPullRequest(ctx, converted, input.Logger)
+	case c.inPlace:
+		for f, out := range converted {
+			if err := os.WriteFile(f, out, 0644); err != nil {
+				return err
+			}
+		}
+		return nil
+	case c.outputDir != "":
+		return c.writeToOutputDir(converted)
+	default:
+		return c.print(converted)
+	}
+}
+
+func (c *applicationConfig) print(converted map[string][]byte) error {
+	files := make([]string, 0, len(converted))
+	for f := range converted {
+		files = append(files, f)
+	}
+	sort.Strings(files)
+
+	for i, f := range files {
+		if i > 0 {
+			fmt.Fprintln(c.stdout, "---")
+		}
+		fmt.Fprintf(c.stdout, "# Source: %s\n%s", f, converted[f])
+	}
+	return nil
+}
+
+func (c *applicationConfig) writeToOutputDir(converted map[string][]byte) error {
+	base := c.path
+	if info, err := os.Stat(base); err == nil && !info.IsDir() {
+		base = filepath.Dir(base)
+	}
+	for f, out := range converted {
+		rel, err := filepath.Rel(base, f)
+		if err != nil {
+			return err
+		}
+		dest := filepath.Join(c.outputDir, rel)
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(dest, out, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *applicationConfig) commitAndOpenPullRequest(ctx context.Context, converted map[string][]byte, logger *zap.Logger) error {
+	repoDir, err := filepath.Abs(c.repoDir)
+	if err != nil {
+		return err
+	}
+
+	changes := make(map[string][]byte, len(converted))
+	for f, out := range converted {
+		abs, err := filepath.Abs(f)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(repoDir, abs)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			return fmt.Errorf("%s is not inside the repository %s", f, repoDir)
+		}
+		changes[rel] = out
+	}
+
+	repo := git.NewRepo(repoDir, "git", c.remote, c.baseBranch, nil)
+	msg := fmt.Sprintf("%s\n\nConverted by `pipectl migrate application-config`.", c.pullRequestTitle)
+	if err := repo.CommitChanges(ctx, c.branch, msg, true, changes, nil); err != nil {
+		logger.Error("failed to commit the converted configs", zap.Error(err))
+		return err
+	}
+	if err := repo.Push(ctx, c.branch); err != nil {
+		logger.Error("failed to push the branch", zap.String("branch", c.branch), zap.Error(err))
+		return err
+	}
+
+	owner, name, _ := strings.Cut(c.githubRepository, "/")
+	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: c.githubToken})
+	client := github.NewClient(oauth2.NewClient(ctx, ts))
+	pr, _, err := client.PullRequests.Create(ctx, owner, name, &github.NewPullRequest{
+		Title: github.String(c.pullRequestTitle),
+		Head:  github.String(c.branch),
+		Base:  github.String(c.baseBranch),
+		Body:  github.String(pullRequestBody(changes)),
+	})
+	if err != nil {
+		logger.Error("failed to open pull request", zap.Error(err))
+		return err
+	}
+
+	logger.Info("successfully opened pull request", zap.String("url", pr.GetHTMLURL()))
+	return nil
+}
+
+func pullRequestBody(changes map[string][]byte) string {
+	files := make([]string, 0, len(changes))
+	for f := range changes {
+		files = append(files, f)
+	}
+	sort.Strings(files)
+
+	var b strings.Builder
+	b.WriteString("This PR converts the following application configs into the plugin-based schema used by plugin-architectured piped.\n\n")
+	for _, f := range files {
+		fmt.Fprintf(&b, "- `%s`\n", f)
+	}
+	return b.String()
+}
+
+// findApplicationConfigFiles returns the application config files at the given path.
+// When the path is a directory, it is walked recursively.
+func findApplicationConfigFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if model.IsApplicationConfigFile(d.Name()) {
+			files = append(files, p)
+		}
+		return nil
+	})
+	return files, err
+}
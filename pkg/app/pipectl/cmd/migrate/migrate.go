@@ -33,7 +33,10 @@ func NewCommand() *cobra.Command {
 		Short: "Do migration tasks.",
 	}
 
-	cmd.AddCommand(newDatabaseCommand(c))
+	cmd.AddCommand(
+		newDatabaseCommand(c),
+		newApplicationConfigCommand(),
+	)
 
 	c.clientOptions.RegisterPersistentFlags(cmd)
 
@@ -0,0 +1,105 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package piped
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/preflight"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+type checkAnalysisProvider struct {
+	root *command
+
+	configFile string
+	names      []string
+	timeout    time.Duration
+	stdout     io.Writer
+}
+
+func newCheckAnalysisProviderCommand(root *command) *cobra.Command {
+	c := &checkAnalysisProvider{
+		root:    root,
+		timeout: preflight.DefaultTimeout,
+		stdout:  os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "check-analysis-provider",
+		Short: "Check the connectivity of the analysis providers configured in a Piped config.",
+		Long: `Check the analysis providers configured in the given Piped config.
+For each provider, its credentials are loaded and a sample query is sent to make sure
+that it is reachable and the request is accepted. Run this command from where Piped is running
+to find a misconfigured provider before it fails in the middle of the ANALYSIS stage.`,
+		Example: `  pipectl piped check-analysis-provider --config-file piped.yaml
+  pipectl piped check-analysis-provider --config-file piped.yaml --name prometheus-dev`,
+		RunE: cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.configFile, "config-file", c.configFile, "The path to the Piped configuration file.")
+	cmd.Flags().StringSliceVar(&c.names, "name", c.names, "The names of the analysis providers to check. Empty means all of them.")
+	cmd.Flags().DurationVar(&c.timeout, "timeout", c.timeout, "How long to wait for the response of each provider.")
+
+	cmd.MarkFlagRequired("config-file")
+
+	return cmd
+}
+
+func (c *checkAnalysisProvider) run(ctx context.Context, input cli.Input) error {
+	cfg, err := config.LoadFromYAML(c.configFile)
+	if err != nil {
+		return fmt.Errorf("failed to load the config file: %w", err)
+	}
+	if cfg.Kind != config.KindPiped {
+		return fmt.Errorf("wrong configuration kind for piped: %v", cfg.Kind)
+	}
+
+	providers := make([]config.PipedAnalysisProvider, 0, len(cfg.PipedSpec.AnalysisProviders))
+	for _, name := range c.names {
+		p, ok := cfg.PipedSpec.GetAnalysisProvider(name)
+		if !ok {
+			return fmt.Errorf("analysis provider %q was not found in the config file", name)
+		}
+		providers = append(providers, p)
+	}
+	if len(c.names) == 0 {
+		providers = append(providers, cfg.PipedSpec.AnalysisProviders...)
+	}
+	if len(providers) == 0 {
+		fmt.Fprintln(c.stdout, "No analysis provider is configured")
+		return nil
+	}
+
+	var failed int
+	for _, r := range preflight.CheckAll(ctx, providers, c.timeout, input.Logger) {
+		if r.OK() {
+			fmt.Fprintf(c.stdout, "OK    %s (%s)\n", r.Provider, r.Type)
+			continue
+		}
+		failed++
+		fmt.Fprintf(c.stdout, "ERROR %s (%s): %v\n", r.Provider, r.Type, r.Err)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d analysis providers are not usable", failed, len(providers))
+	}
+	return nil
+}
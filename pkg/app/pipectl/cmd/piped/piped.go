@@ -36,6 +36,8 @@ func NewCommand() *cobra.Command {
 	cmd.AddCommand(
 		newEnableCommand(c),
 		newDisableCommand(c),
+		newUpgradeCommand(c),
+		newCheckAnalysisProviderCommand(c),
 	)
 
 	c.clientOptions.RegisterPersistentFlags(cmd)
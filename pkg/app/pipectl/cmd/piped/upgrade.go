@@ -0,0 +1,329 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package piped
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type upgrade struct {
+	root *command
+
+	version           string
+	pipedIDs          []string
+	selector          []string
+	steps             []int
+	readyTimeout      time.Duration
+	observationPeriod time.Duration
+	checkInterval     time.Duration
+	maxFailureRate    float64
+	minDeployments    int
+	rollback          bool
+	dryRun            bool
+}
+
+func newUpgradeCommand(root *command) *cobra.Command {
+	c := &upgrade{
+		root:              root,
+		steps:             []int{10, 50, 100},
+		readyTimeout:      10 * time.Minute,
+		observationPeriod: 10 * time.Minute,
+		checkInterval:     30 * time.Second,
+		maxFailureRate:    0.2,
+		rollback:          true,
+	}
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Gradually upgrade a subset of pipeds to a given version.",
+		Long: `Gradually upgrade the target pipeds to a given version.
+At each step, the desired version of the given percentage of the target pipeds is updated.
+Then the upgraded pipeds are observed, and the upgrade proceeds to the next step
+only when all of them are running the version and the rate of their failed deployments
+is not above the threshold. Otherwise, the upgraded pipeds are rolled back to their previous versions.`,
+		Example: `  pipectl piped upgrade --version v0.50.0 --selector env:dev --steps 10,50,100
+  pipectl piped upgrade --version v0.50.0 --piped-id piped-1 --piped-id piped-2 --steps 100`,
+		RunE: cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.version, "version", c.version, "The version the target pipeds should run.")
+	cmd.Flags().StringSliceVar(&c.pipedIDs, "piped-id", c.pipedIDs, "The IDs of the target pipeds.")
+	cmd.Flags().StringSliceVar(&c.selector, "selector", c.selector, "The labels to select the target pipeds. Expect input in the form KEY:VALUE.")
+	cmd.Flags().IntSliceVar(&c.steps, "steps", c.steps, "The percentages of the target pipeds to be upgraded at each step.")
+	cmd.Flags().DurationVar(&c.readyTimeout, "ready-timeout", c.readyTimeout, "How long to wait for the upgraded pipeds to run the version at each step.")
+	cmd.Flags().DurationVar(&c.observationPeriod, "observation-period", c.observationPeriod, "How long to observe the upgraded pipeds before proceeding to the next step.")
+	cmd.Flags().DurationVar(&c.checkInterval, "check-interval", c.checkInterval, "How often to check the status of the upgraded pipeds.")
+	cmd.Flags().Float64Var(&c.maxFailureRate, "max-failure-rate", c.maxFailureRate, "The maximum rate of the failed deployments among the ones completed by the upgraded pipeds.")
+	cmd.Flags().IntVar(&c.minDeployments, "min-deployments", c.minDeployments, "The minimum number of the completed deployments required to evaluate the failure rate.")
+	cmd.Flags().BoolVar(&c.rollback, "rollback", c.rollback, "Whether to roll the upgraded pipeds back to their previous versions when the upgrade failed.")
+	cmd.Flags().BoolVar(&c.dryRun, "dry-run", c.dryRun, "Show the pipeds which would be upgraded at the first step without upgrading them.")
+
+	cmd.MarkFlagRequired("version")
+
+	return cmd
+}
+
+func (c *upgrade) run(ctx context.Context, input cli.Input) error {
+	if err := c.validate(); err != nil {
+		return err
+	}
+	selector, err := parseLabels(c.selector)
+	if err != nil {
+		return fmt.Errorf("invalid selector: %w", err)
+	}
+
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	if c.dryRun {
+		resp, err := cli.UpgradePipeds(ctx, &apiservice.UpgradePipedsRequest{
+			Version:    c.version,
+			PipedIds:   c.pipedIDs,
+			Selector:   selector,
+			Percentage: int32(c.steps[0]),
+			DryRun:     true,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to upgrade pipeds: %w", err)
+		}
+		input.Logger.Info(fmt.Sprintf("%d pipeds would be upgraded at the first step: %s", len(resp.PipedIds), strings.Join(resp.PipedIds, ", ")))
+		return nil
+	}
+
+	// Remember the running versions to roll the pipeds back to.
+	statuses, err := c.listStatuses(ctx, cli, selector)
+	if err != nil {
+		return err
+	}
+	previousVersions := make(map[string]string, len(statuses))
+	for _, s := range statuses {
+		previousVersions[s.PipedId] = s.Version
+	}
+
+	var upgraded []string
+	for _, step := range c.steps {
+		resp, err := cli.UpgradePipeds(ctx, &apiservice.UpgradePipedsRequest{
+			Version:    c.version,
+			PipedIds:   c.pipedIDs,
+			Selector:   selector,
+			Percentage: int32(step),
+		})
+		if err != nil {
+			return c.fail(ctx, cli, input.Logger, upgraded, previousVersions, fmt.Errorf("failed to upgrade pipeds: %w", err))
+		}
+		upgraded = append(upgraded, resp.PipedIds...)
+		input.Logger.Info(fmt.Sprintf("step %d%%: updated the desired version of %d pipeds: %s", step, len(resp.PipedIds), strings.Join(resp.PipedIds, ", ")))
+
+		if err := c.waitReady(ctx, cli, input.Logger, selector); err != nil {
+			return c.fail(ctx, cli, input.Logger, upgraded, previousVersions, err)
+		}
+		if err := c.observe(ctx, cli, input.Logger, selector); err != nil {
+			return c.fail(ctx, cli, input.Logger, upgraded, previousVersions, err)
+		}
+		input.Logger.Info(fmt.Sprintf("step %d%%: all upgraded pipeds are healthy", step))
+	}
+
+	input.Logger.Info(fmt.Sprintf("Successfully upgraded pipeds to %s", c.version))
+	return nil
+}
+
+func (c *upgrade) validate() error {
+	if len(c.steps) == 0 {
+		return errors.New("at least one step must be specified")
+	}
+	prev := 0
+	for _, s := range c.steps {
+		if s <= prev || s > 100 {
+			return fmt.Errorf("steps must be increasing percentages between 1 and 100, but got %v", c.steps)
+		}
+		prev = s
+	}
+	if c.maxFailureRate < 0 || c.maxFailureRate > 1 {
+		return fmt.Errorf("max-failure-rate must be between 0 and 1, but got %v", c.maxFailureRate)
+	}
+	if c.checkInterval <= 0 {
+		return errors.New("check-interval must be positive")
+	}
+	return nil
+}
+
+func (c *upgrade) listStatuses(ctx context.Context, cli apiservice.Client, selector map[string]string) ([]*apiservice.PipedUpgradeStatus, error) {
+	resp, err := cli.ListPipedUpgradeStatuses(ctx, &apiservice.ListPipedUpgradeStatusesRequest{
+		Version:  c.version,
+		PipedIds: c.pipedIDs,
+		Selector: selector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list piped upgrade statuses: %w", err)
+	}
+	return resp.Statuses, nil
+}
+
+// waitReady waits until all of the upgraded pipeds are online and running the version.
+func (c *upgrade) waitReady(ctx context.Context, cli apiservice.Client, logger *zap.Logger, selector map[string]string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.readyTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(c.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		statuses, err := c.listStatuses(ctx, cli, selector)
+		if err != nil {
+			return err
+		}
+		result := evaluateUpgrade(statuses, c.version, c.maxFailureRate, c.minDeployments)
+		if result.err != nil {
+			return result.err
+		}
+		if len(result.pending) == 0 {
+			return nil
+		}
+		logger.Info(fmt.Sprintf("waiting for %d pipeds to run %s: %s", len(result.pending), c.version, strings.Join(result.pending, ", ")))
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for pipeds to run %s: %s", c.version, strings.Join(result.pending, ", "))
+		case <-ticker.C:
+		}
+	}
+}
+
+// observe checks the health of the upgraded pipeds during the observation period.
+func (c *upgrade) observe(ctx context.Context, cli apiservice.Client, logger *zap.Logger, selector map[string]string) error {
+	deadline := time.Now().Add(c.observationPeriod)
+	ticker := time.NewTicker(c.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		statuses, err := c.listStatuses(ctx, cli, selector)
+		if err != nil {
+			return err
+		}
+		result := evaluateUpgrade(statuses, c.version, c.maxFailureRate, c.minDeployments)
+		if result.err != nil {
+			return result.err
+		}
+		if len(result.pending) > 0 {
+			return fmt.Errorf("pipeds stopped running %s: %s", c.version, strings.Join(result.pending, ", "))
+		}
+		if !time.Now().Before(deadline) {
+			return nil
+		}
+		logger.Info(fmt.Sprintf("observing upgraded pipeds: %d of %d deployments failed", result.failed, result.completed))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// fail rolls the upgraded pipeds back to their previous versions if enabled, and returns the given error.
+func (c *upgrade) fail(ctx context.Context, cli apiservice.Client, logger *zap.Logger, upgraded []string, previousVersions map[string]string, cause error) error {
+	if !c.rollback || len(upgraded) == 0 {
+		return cause
+	}
+	logger.Error("the upgrade failed, rolling the upgraded pipeds back", zap.Error(cause))
+
+	byVersion := make(map[string][]string)
+	for _, id := range upgraded {
+		v := previousVersions[id]
+		if v == "" || v == c.version {
+			logger.Warn(fmt.Sprintf("no previous version of piped %s to roll back to", id))
+			continue
+		}
+		byVersion[v] = append(byVersion[v], id)
+	}
+
+	versions := make([]string, 0, len(byVersion))
+	for v := range byVersion {
+		versions = append(versions, v)
+	}
+	sort.Strings(versions)
+
+	for _, v := range versions {
+		if _, err := cli.UpgradePipeds(ctx, &apiservice.UpgradePipedsRequest{
+			Version:  v,
+			PipedIds: byVersion[v],
+		}); err != nil {
+			return fmt.Errorf("%w (failed to roll pipeds %s back to %s: %v)", cause, strings.Join(byVersion[v], ", "), v, err)
+		}
+		logger.Info(fmt.Sprintf("rolled pipeds back to %s: %s", v, strings.Join(byVersion[v], ", ")))
+	}
+	return cause
+}
+
+type upgradeResult struct {
+	// The IDs of the upgraded pipeds which are not online with the version.
+	pending   []string
+	completed int32
+	failed    int32
+	err       error
+}
+
+// evaluateUpgrade evaluates the statuses of the pipeds whose desired version is the given one.
+// An error is set when the rate of their failed deployments exceeds the threshold.
+func evaluateUpgrade(statuses []*apiservice.PipedUpgradeStatus, version string, maxFailureRate float64, minDeployments int) upgradeResult {
+	var r upgradeResult
+	for _, s := range statuses {
+		if s.DesiredVersion != version {
+			continue
+		}
+		if s.Version != version || s.Status != model.Piped_ONLINE {
+			r.pending = append(r.pending, s.PipedId)
+		}
+		r.completed += s.CompletedDeployments
+		r.failed += s.FailedDeployments
+	}
+
+	if r.completed == 0 || int(r.completed) < minDeployments {
+		return r
+	}
+	if rate := float64(r.failed) / float64(r.completed); rate > maxFailureRate {
+		r.err = fmt.Errorf("%d of %d deployments handled by the upgraded pipeds failed, exceeding the max failure rate %v", r.failed, r.completed, maxFailureRate)
+	}
+	return r
+}
+
+func parseLabels(labels []string) (map[string]string, error) {
+	if len(labels) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]string, len(labels))
+	for _, l := range labels {
+		sp := strings.SplitN(l, ":", 2)
+		if len(sp) != 2 || sp[0] == "" {
+			return nil, fmt.Errorf("label %q must be in the form KEY:VALUE", l)
+		}
+		out[sp[0]] = sp[1]
+	}
+	return out, nil
+}
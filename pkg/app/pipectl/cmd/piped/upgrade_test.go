@@ -0,0 +1,100 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package piped
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestEvaluateUpgrade(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name            string
+		statuses        []*apiservice.PipedUpgradeStatus
+		minDeployments  int
+		expectedPending []string
+		expectedErr     bool
+	}{
+		{
+			name: "not upgraded pipeds are ignored",
+			statuses: []*apiservice.PipedUpgradeStatus{
+				{PipedId: "piped-1", Version: "v0.1.0", DesiredVersion: "v0.1.0", Status: model.Piped_OFFLINE, CompletedDeployments: 1, FailedDeployments: 1},
+			},
+		},
+		{
+			name: "upgraded pipeds are pending",
+			statuses: []*apiservice.PipedUpgradeStatus{
+				{PipedId: "piped-1", Version: "v0.1.0", DesiredVersion: "v0.2.0", Status: model.Piped_ONLINE},
+				{PipedId: "piped-2", Version: "v0.2.0", DesiredVersion: "v0.2.0", Status: model.Piped_OFFLINE},
+				{PipedId: "piped-3", Version: "v0.2.0", DesiredVersion: "v0.2.0", Status: model.Piped_ONLINE},
+			},
+			expectedPending: []string{"piped-1", "piped-2"},
+		},
+		{
+			name: "failure rate within the threshold",
+			statuses: []*apiservice.PipedUpgradeStatus{
+				{PipedId: "piped-1", Version: "v0.2.0", DesiredVersion: "v0.2.0", Status: model.Piped_ONLINE, CompletedDeployments: 5, FailedDeployments: 1},
+			},
+		},
+		{
+			name: "failure rate exceeds the threshold",
+			statuses: []*apiservice.PipedUpgradeStatus{
+				{PipedId: "piped-1", Version: "v0.2.0", DesiredVersion: "v0.2.0", Status: model.Piped_ONLINE, CompletedDeployments: 3, FailedDeployments: 1},
+				{PipedId: "piped-2", Version: "v0.2.0", DesiredVersion: "v0.2.0", Status: model.Piped_ONLINE, CompletedDeployments: 1, FailedDeployments: 1},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "not enough deployments to evaluate",
+			statuses: []*apiservice.PipedUpgradeStatus{
+				{PipedId: "piped-1", Version: "v0.2.0", DesiredVersion: "v0.2.0", Status: model.Piped_ONLINE, CompletedDeployments: 1, FailedDeployments: 1},
+			},
+			minDeployments: 3,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := evaluateUpgrade(tc.statuses, "v0.2.0", 0.2, tc.minDeployments)
+			assert.Equal(t, tc.expectedPending, got.pending)
+			assert.Equal(t, tc.expectedErr, got.err != nil)
+		})
+	}
+}
+
+func TestUpgradeValidate(t *testing.T) {
+	t.Parallel()
+
+	c := &upgrade{steps: []int{10, 50, 100}, maxFailureRate: 0.2, checkInterval: 1}
+	assert.NoError(t, c.validate())
+
+	c.steps = []int{50, 10}
+	assert.Error(t, c.validate())
+
+	c.steps = []int{10, 150}
+	assert.Error(t, c.validate())
+
+	c.steps = []int{100}
+	c.maxFailureRate = 1.5
+	assert.Error(t, c.validate())
+}
@@ -0,0 +1,190 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planpreview
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// gitlabNoteMarker is put at the beginning of the notes posted by this command
+	// to find the ones which should be resolved by the next run.
+	gitlabNoteMarker = "<!-- pipecd-plan-preview -->"
+
+	gitlabRequestTimeout = 30 * time.Second
+	gitlabPageSize       = 100
+)
+
+// gitlabOptions configures posting the plan-preview result to a GitLab merge request.
+// The values default to the predefined variables of GitLab CI/CD merge request pipelines.
+type gitlabOptions struct {
+	enabled         bool
+	apiURL          string
+	projectID       string
+	mergeRequestIID int
+	token           string
+	tokenFile       string
+}
+
+func newGitLabOptions() *gitlabOptions {
+	iid, _ := strconv.Atoi(os.Getenv("CI_MERGE_REQUEST_IID"))
+	return &gitlabOptions{
+		apiURL:          os.Getenv("CI_API_V4_URL"),
+		projectID:       os.Getenv("CI_PROJECT_ID"),
+		mergeRequestIID: iid,
+	}
+}
+
+func (o *gitlabOptions) registerFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.enabled, "gitlab-note", o.enabled, "Whether to post the result as a discussion of the GitLab merge request. The discussions posted by the previous runs are resolved.")
+	cmd.Flags().StringVar(&o.apiURL, "gitlab-api-url", o.apiURL, "The URL of GitLab API v4. Default is the value of CI_API_V4_URL.")
+	cmd.Flags().StringVar(&o.projectID, "gitlab-project-id", o.projectID, "The ID or path of the GitLab project. Default is the value of CI_PROJECT_ID.")
+	cmd.Flags().IntVar(&o.mergeRequestIID, "gitlab-merge-request-iid", o.mergeRequestIID, "The IID of the GitLab merge request. Default is the value of CI_MERGE_REQUEST_IID.")
+	cmd.Flags().StringVar(&o.token, "gitlab-token", o.token, "The GitLab access token with the api scope used to post the result.")
+	cmd.Flags().StringVar(&o.tokenFile, "gitlab-token-file", o.tokenFile, "Path to the file containing the GitLab access token.")
+}
+
+func (o *gitlabOptions) newClient() (*gitlabClient, error) {
+	if o.apiURL == "" || o.projectID == "" || o.mergeRequestIID == 0 {
+		return nil, errors.New("gitlab-api-url, gitlab-project-id and gitlab-merge-request-iid must be set to post the result to GitLab")
+	}
+	token := o.token
+	if o.tokenFile != "" {
+		data, err := os.ReadFile(o.tokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the gitlab token file: %w", err)
+		}
+		token = strings.TrimSpace(string(data))
+	}
+	if token == "" {
+		return nil, errors.New("either gitlab-token or gitlab-token-file must be set to post the result to GitLab")
+	}
+	return &gitlabClient{
+		baseURL:    fmt.Sprintf("%s/projects/%s/merge_requests/%d", strings.TrimSuffix(o.apiURL, "/"), url.PathEscape(o.projectID), o.mergeRequestIID),
+		token:      token,
+		httpClient: &http.Client{Timeout: gitlabRequestTimeout},
+	}, nil
+}
+
+// gitlabClient calls the merge request discussion APIs of GitLab.
+// See https://docs.gitlab.com/ee/api/discussions.html#merge-requests
+type gitlabClient struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+type gitlabDiscussion struct {
+	ID    string `json:"id"`
+	Notes []struct {
+		Body       string `json:"body"`
+		Resolvable bool   `json:"resolvable"`
+		Resolved   bool   `json:"resolved"`
+	} `json:"notes"`
+}
+
+// postResult resolves the discussions posted by the previous runs
+// and then starts a new discussion for the given result.
+func (c *gitlabClient) postResult(ctx context.Context, r ReadableResult, headCommit string) error {
+	discussions, err := c.listDiscussions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, d := range discussions {
+		if len(d.Notes) == 0 || !strings.HasPrefix(d.Notes[0].Body, gitlabNoteMarker) {
+			continue
+		}
+		if !d.Notes[0].Resolvable || d.Notes[0].Resolved {
+			continue
+		}
+		form := url.Values{"resolved": {"true"}}
+		if err := c.do(ctx, http.MethodPut, "/discussions/"+url.PathEscape(d.ID), form, nil); err != nil {
+			return fmt.Errorf("failed to resolve the previous plan-preview discussion %s: %w", d.ID, err)
+		}
+	}
+
+	form := url.Values{"body": {makeGitLabNoteBody(r, headCommit)}}
+	if err := c.do(ctx, http.MethodPost, "/discussions", form, nil); err != nil {
+		return fmt.Errorf("failed to post the plan-preview result: %w", err)
+	}
+	return nil
+}
+
+func (c *gitlabClient) listDiscussions(ctx context.Context) ([]gitlabDiscussion, error) {
+	var all []gitlabDiscussion
+	for page := 1; ; page++ {
+		var discussions []gitlabDiscussion
+		path := fmt.Sprintf("/discussions?per_page=%d&page=%d", gitlabPageSize, page)
+		if err := c.do(ctx, http.MethodGet, path, nil, &discussions); err != nil {
+			return nil, fmt.Errorf("failed to list the discussions of the merge request: %w", err)
+		}
+		all = append(all, discussions...)
+		if len(discussions) < gitlabPageSize {
+			return all, nil
+		}
+	}
+}
+
+func (c *gitlabClient) do(ctx context.Context, method, path string, form url.Values, out interface{}) error {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("PRIVATE-TOKEN", c.token)
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func makeGitLabNoteBody(r ReadableResult, headCommit string) string {
+	var b strings.Builder
+	b.WriteString(gitlabNoteMarker)
+	fmt.Fprintf(&b, "\n#### Plan-preview result at commit %s\n\n", headCommit)
+	b.WriteString("~~~\n")
+	b.WriteString(strings.TrimSpace(r.String()))
+	b.WriteString("\n~~~\n")
+	return b.String()
+}
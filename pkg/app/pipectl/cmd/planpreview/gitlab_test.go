@@ -0,0 +1,98 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planpreview
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitLabClientPostResult(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		resolved []string
+		posted   []string
+	)
+	discussions := []map[string]interface{}{
+		{"id": "previous", "notes": []map[string]interface{}{{"body": gitlabNoteMarker + "\nold result", "resolvable": true, "resolved": false}}},
+		{"id": "already-resolved", "notes": []map[string]interface{}{{"body": gitlabNoteMarker + "\nolder result", "resolvable": true, "resolved": true}}},
+		{"id": "review", "notes": []map[string]interface{}{{"body": "LGTM", "resolvable": true, "resolved": false}}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		assert.Equal(t, "token", r.Header.Get("PRIVATE-TOKEN"))
+		const base = "/api/v4/projects/group%2Frepo/merge_requests/7/discussions"
+		switch {
+		case r.Method == http.MethodGet && r.URL.EscapedPath() == base:
+			json.NewEncoder(w).Encode(discussions)
+		case r.Method == http.MethodPut && strings.HasPrefix(r.URL.EscapedPath(), base+"/"):
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "true", r.PostForm.Get("resolved"))
+			resolved = append(resolved, strings.TrimPrefix(r.URL.EscapedPath(), base+"/"))
+		case r.Method == http.MethodPost && r.URL.EscapedPath() == base:
+			require.NoError(t, r.ParseForm())
+			posted = append(posted, r.PostForm.Get("body"))
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	o := &gitlabOptions{
+		enabled:         true,
+		apiURL:          server.URL + "/api/v4/",
+		projectID:       "group/repo",
+		mergeRequestIID: 7,
+		token:           "token",
+	}
+	c, err := o.newClient()
+	require.NoError(t, err)
+
+	err = c.postResult(context.Background(), ReadableResult{}, "abc123")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"previous"}, resolved)
+	require.Len(t, posted, 1)
+	assert.True(t, strings.HasPrefix(posted[0], gitlabNoteMarker))
+	assert.Contains(t, posted[0], "Plan-preview result at commit abc123")
+	assert.Contains(t, posted[0], "There are no updated applications.")
+}
+
+func TestGitLabOptionsNewClient(t *testing.T) {
+	t.Parallel()
+
+	_, err := (&gitlabOptions{apiURL: "https://gitlab.com/api/v4", projectID: "1", mergeRequestIID: 1}).newClient()
+	assert.Error(t, err)
+
+	_, err = (&gitlabOptions{apiURL: "https://gitlab.com/api/v4", projectID: "1", token: "token"}).newClient()
+	assert.Error(t, err)
+
+	c, err := (&gitlabOptions{apiURL: "https://gitlab.com/api/v4/", projectID: "1", mergeRequestIID: 2, token: "token"}).newClient()
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitlab.com/api/v4/projects/1/merge_requests/2", c.baseURL)
+}
@@ -53,11 +53,13 @@ type command struct {
 	sortLabelKeys      []string
 
 	clientOptions *client.Options
+	gitlab        *gitlabOptions
 }
 
 func NewCommand() *cobra.Command {
 	c := &command{
 		clientOptions:      &client.Options{},
+		gitlab:             newGitLabOptions(),
 		pipedHandleTimeout: defaultPipedHandleTimeout,
 		timeout:            defaultTimeout,
 		checkInterval:      defaultCheckInterval,
@@ -69,6 +71,7 @@ func NewCommand() *cobra.Command {
 	}
 
 	c.clientOptions.RegisterPersistentFlags(cmd)
+	c.gitlab.registerFlags(cmd)
 
 	cmd.Flags().StringVar(&c.repoRemoteURL, "repo-remote-url", c.repoRemoteURL, "The remote URL of Git repository.")
 	cmd.Flags().StringVar(&c.headBranch, "head-branch", c.headBranch, "The head branch of the change.")
@@ -91,6 +94,14 @@ func (c *command) run(ctx context.Context, _ cli.Input) error {
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
+	var gitlab *gitlabClient
+	if c.gitlab.enabled {
+		var err error
+		if gitlab, err = c.gitlab.newClient(); err != nil {
+			return err
+		}
+	}
+
 	cli, err := c.clientOptions.NewClient(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to initialize client: %w", err)
@@ -151,7 +162,18 @@ func (c *command) run(ctx context.Context, _ cli.Input) error {
 				return err
 			}
 			sortResults(results, c.sortLabelKeys)
-			return printResults(results, os.Stdout, c.out)
+			if err := printResults(results, os.Stdout, c.out); err != nil {
+				return err
+			}
+			if gitlab == nil {
+				return nil
+			}
+			if err := gitlab.postResult(ctx, convert(results), c.headCommit); err != nil {
+				fmt.Printf("Failed to post plan-preview result to GitLab: %v\n", err)
+				return err
+			}
+			fmt.Println("Posted plan-preview result to the GitLab merge request")
+			return nil
 		}
 	}
 }
@@ -221,18 +243,39 @@ func convert(results []*model.PlanPreviewCommandResult) ReadableResult {
 			}
 			if a.Error != "" {
 				out.FailureApplications = append(out.FailureApplications, FailureApplication{
-					ApplicationInfo: appInfo,
-					Reason:          a.Error,
-					PlanDetails:     string(a.PlanDetails),
+					ApplicationInfo:      appInfo,
+					Reason:               a.Error,
+					PlanDetails:          string(a.PlanDetails),
+					PlanDetailsSyntax:    a.PlanDetailsSyntax,
+					PlanDetailsTruncated: a.PlanDetailsTruncated,
 				})
 				continue
 			}
+			resourceChanges := make([]ResourceChange, 0, len(a.ResourceChanges))
+			for _, c := range a.ResourceChanges {
+				resourceChanges = append(resourceChanges, ResourceChange{
+					Resource: c.Resource,
+					Action:   c.Action.String(),
+				})
+			}
+			stages := make([]Stage, 0, len(a.Stages))
+			for _, s := range a.Stages {
+				stages = append(stages, Stage{
+					Name:        s.Name,
+					Description: s.Desc,
+					Parameters:  s.Parameters,
+				})
+			}
 			out.Applications = append(out.Applications, ApplicationResult{
-				ApplicationInfo: appInfo,
-				SyncStrategy:    a.SyncStrategy.String(),
-				PlanSummary:     string(a.PlanSummary),
-				PlanDetails:     string(a.PlanDetails),
-				NoChange:        a.NoChange,
+				ApplicationInfo:      appInfo,
+				SyncStrategy:         a.SyncStrategy.String(),
+				PlanSummary:          string(a.PlanSummary),
+				PlanDetails:          string(a.PlanDetails),
+				PlanDetailsSyntax:    a.PlanDetailsSyntax,
+				PlanDetailsTruncated: a.PlanDetailsTruncated,
+				ResourceChanges:      resourceChanges,
+				Stages:               stages,
+				NoChange:             a.NoChange,
 			})
 		}
 	}
@@ -248,10 +291,26 @@ type ReadableResult struct {
 
 type ApplicationResult struct {
 	ApplicationInfo
-	SyncStrategy string // QUICK_SYNC, PIPELINE
-	PlanSummary  string
-	PlanDetails  string
-	NoChange     bool
+	SyncStrategy         string // QUICK_SYNC, PIPELINE
+	PlanSummary          string
+	PlanDetails          string
+	PlanDetailsSyntax    string // diff, hcl
+	PlanDetailsTruncated bool
+	ResourceChanges      []ResourceChange
+	Stages               []Stage
+	NoChange             bool
+}
+
+type ResourceChange struct {
+	Resource string
+	Action   string // ADD, CHANGE, DELETE
+}
+
+// Stage represents a stage that will be run by the deployment.
+type Stage struct {
+	Name        string
+	Description string
+	Parameters  []string // e.g. "duration: 10m0s"
 }
 
 type FailurePiped struct {
@@ -261,8 +320,10 @@ type FailurePiped struct {
 
 type FailureApplication struct {
 	ApplicationInfo
-	Reason      string
-	PlanDetails string
+	Reason               string
+	PlanDetails          string
+	PlanDetailsSyntax    string // diff, hcl
+	PlanDetailsTruncated bool
 }
 
 type PipedInfo struct {
@@ -302,6 +363,22 @@ func (r ReadableResult) String() string {
 			b.WriteString(title)
 			fmt.Fprintf(&b, "  sync strategy: %s\n", app.SyncStrategy)
 			fmt.Fprintf(&b, "  summary: %s\n", app.PlanSummary)
+			if len(app.ResourceChanges) > 0 {
+				fmt.Fprintf(&b, "  resource changes:\n")
+				for _, c := range app.ResourceChanges {
+					fmt.Fprintf(&b, "    - %s: %s\n", c.Action, c.Resource)
+				}
+			}
+			if len(app.Stages) > 0 {
+				fmt.Fprintf(&b, "  pipeline:\n")
+				for j, s := range app.Stages {
+					fmt.Fprintf(&b, "    %d. %s", j+1, s.Name)
+					if len(s.Parameters) > 0 {
+						fmt.Fprintf(&b, " (%s)", strings.Join(s.Parameters, ", "))
+					}
+					b.WriteString("\n")
+				}
+			}
 			fmt.Fprintf(&b, "  details:\n\n  ---DETAILS_BEGIN---\n%s\n  ---DETAILS_END---\n", app.PlanDetails)
 		}
 	}
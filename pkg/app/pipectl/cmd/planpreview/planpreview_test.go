@@ -112,6 +112,56 @@ NOTE: An error occurred while building plan-preview for applications of the foll
 
 1. piped: piped-name-1 (piped-1)
   reason: failed to clone
+`,
+		},
+		{
+			name: "there is a plannable application with resource changes",
+			results: []*model.PlanPreviewCommandResult{
+				{
+					CommandId: "command-3",
+					PipedId:   "piped-3",
+					PipedUrl:  "https://pipecd.dev/piped-3",
+					Results: []*model.ApplicationPlanPreviewResult{
+						{
+							ApplicationId:     "app-3",
+							ApplicationName:   "app-3",
+							ApplicationUrl:    "https://pipecd.dev/app-3",
+							ApplicationKind:   model.ApplicationKind_TERRAFORM,
+							SyncStrategy:      model.SyncStrategy_PIPELINE,
+							PlanSummary:       []byte("0 to import, 1 to add, 1 to change, 0 to destroy"),
+							PlanDetails:       []byte("changes-3"),
+							PlanDetailsSyntax: "hcl",
+							ResourceChanges: []*model.PlanPreviewResourceChange{
+								{Resource: "aws_instance.web", Action: model.PlanPreviewResourceChange_ADD},
+								{Resource: "aws_vpc.main", Action: model.PlanPreviewResourceChange_CHANGE},
+							},
+							Stages: []*model.PlanPreviewStage{
+								{Name: "TERRAFORM_PLAN"},
+								{Name: "WAIT_APPROVAL", Parameters: []string{"minApproverNum: 1", "timeout: 6h0m0s"}},
+								{Name: "TERRAFORM_APPLY"},
+							},
+						},
+					},
+				},
+			},
+			expected: `
+Here are plan-preview for 1 application:
+
+1. app: app-3, kind: TERRAFORM
+  sync strategy: PIPELINE
+  summary: 0 to import, 1 to add, 1 to change, 0 to destroy
+  resource changes:
+    - ADD: aws_instance.web
+    - CHANGE: aws_vpc.main
+  pipeline:
+    1. TERRAFORM_PLAN
+    2. WAIT_APPROVAL (minApproverNum: 1, timeout: 6h0m0s)
+    3. TERRAFORM_APPLY
+  details:
+
+  ---DETAILS_BEGIN---
+changes-3
+  ---DETAILS_END---
 `,
 		},
 		{
@@ -0,0 +1,137 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package preflight provides a way to check whether the configured analysis providers
+// are reachable and usable before they are used while running the ANALYSIS stage.
+package preflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	logfactory "github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/log/factory"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics"
+	metricsfactory "github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics/factory"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	DefaultTimeout = 30 * time.Second
+	// The range of the sample query.
+	sampleQueryRange = 5 * time.Minute
+)
+
+// sampleQueries is the list of queries that are expected to be accepted by any provider of each type.
+var sampleQueries = map[model.AnalysisProviderType]string{
+	model.AnalysisProviderPrometheus: "vector(1)",
+	model.AnalysisProviderDatadog:    "avg:datadog.estimated_usage.hosts{*}",
+}
+
+// Result represents the result of checking an analysis provider.
+type Result struct {
+	// The name of the checked provider.
+	Provider string
+	// The type of the checked provider.
+	Type model.AnalysisProviderType
+	// The sample query sent to the provider.
+	// Empty means no query was sent.
+	Query string
+	// The reason why the provider is not usable.
+	// Nil means the provider is usable.
+	Err error
+}
+
+func (r Result) OK() bool {
+	return r.Err == nil
+}
+
+// CheckAll checks all of the given analysis providers one by one.
+func CheckAll(ctx context.Context, providers []config.PipedAnalysisProvider, timeout time.Duration, logger *zap.Logger) []Result {
+	results := make([]Result, 0, len(providers))
+	for i := range providers {
+		results = append(results, Check(ctx, &providers[i], timeout, logger))
+	}
+	return results
+}
+
+// Check validates the configuration and the credentials of the given analysis provider
+// and runs a sample query against it to make sure that it is reachable.
+func Check(ctx context.Context, cfg *config.PipedAnalysisProvider, timeout time.Duration, logger *zap.Logger) Result {
+	result := Result{
+		Provider: cfg.Name,
+		Type:     cfg.Type,
+	}
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	switch cfg.Type {
+	case model.AnalysisProviderPrometheus, model.AnalysisProviderDatadog:
+		provider, err := metricsfactory.NewProvider(&config.TemplatableAnalysisMetrics{
+			AnalysisMetrics: config.AnalysisMetrics{Timeout: config.Duration(timeout)},
+		}, cfg, logger)
+		if err != nil {
+			result.Err = fmt.Errorf("failed to initialize the provider, check its configuration and credential files: %w", err)
+			return result
+		}
+		result.Query = sampleQueries[cfg.Type]
+		now := time.Now()
+		_, err = provider.QueryPoints(ctx, result.Query, metrics.QueryRange{
+			From: now.Add(-sampleQueryRange),
+			To:   now,
+		})
+		// No data means that the provider accepted the query.
+		if err != nil && !errors.Is(err, metrics.ErrNoDataFound) {
+			result.Err = withHint(err)
+		}
+
+	case model.AnalysisProviderStackdriver:
+		// Querying logs is not supported yet, so only the credential is verified.
+		if _, err := logfactory.NewProvider(cfg, logger); err != nil {
+			result.Err = fmt.Errorf("failed to initialize the provider, check the service account file: %w", err)
+		}
+
+	default:
+		result.Err = fmt.Errorf("unsupported analysis provider type %q", cfg.Type)
+	}
+	return result
+}
+
+// withHint adds a suggestion about what to check to the given error returned from a provider.
+func withHint(err error) error {
+	var (
+		dnsErr *net.DNSError
+		opErr  *net.OpError
+		msg    = err.Error()
+	)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), strings.Contains(msg, "Client.Timeout exceeded"):
+		return fmt.Errorf("%w (hint: the provider did not respond in time, check the address and whether it is reachable from piped)", err)
+	case errors.As(err, &dnsErr), errors.As(err, &opErr):
+		return fmt.Errorf("%w (hint: could not connect to the provider, check the address and whether it is reachable from piped)", err)
+	case strings.Contains(msg, "401"), strings.Contains(msg, "403"), strings.Contains(msg, "Unauthorized"), strings.Contains(msg, "Forbidden"):
+		return fmt.Errorf("%w (hint: the provider rejected the request, check the credentials and their permissions)", err)
+	case strings.Contains(msg, "404"):
+		return fmt.Errorf("%w (hint: the API was not found, check the address of the provider)", err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preflight
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok/api/v1/query_range":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"status":"success","data":{"resultType":"matrix","result":[]}}`))
+		case "/unauthorized/api/v1/query_range":
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte("Unauthorized"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	prometheus := func(address string) *config.PipedAnalysisProvider {
+		return &config.PipedAnalysisProvider{
+			Name:             "prometheus",
+			Type:             model.AnalysisProviderPrometheus,
+			PrometheusConfig: &config.AnalysisProviderPrometheusConfig{Address: address},
+		}
+	}
+
+	testcases := []struct {
+		name        string
+		cfg         *config.PipedAnalysisProvider
+		wantErr     bool
+		wantErrHint string
+	}{
+		{
+			name: "reachable provider",
+			cfg:  prometheus(server.URL + "/ok"),
+		},
+		{
+			name:        "unauthorized",
+			cfg:         prometheus(server.URL + "/unauthorized"),
+			wantErr:     true,
+			wantErrHint: "check the credentials",
+		},
+		{
+			name:        "unreachable provider",
+			cfg:         prometheus("http://127.0.0.1:1"),
+			wantErr:     true,
+			wantErrHint: "whether it is reachable from piped",
+		},
+		{
+			name: "missing credential file",
+			cfg: &config.PipedAnalysisProvider{
+				Name: "datadog",
+				Type: model.AnalysisProviderDatadog,
+				DatadogConfig: &config.AnalysisProviderDatadogConfig{
+					APIKeyFile:         "testdata/not-found",
+					ApplicationKeyFile: "testdata/not-found",
+				},
+			},
+			wantErr:     true,
+			wantErrHint: "check its configuration and credential files",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			r := Check(context.Background(), tc.cfg, 5*time.Second, zap.NewNop())
+			assert.Equal(t, tc.cfg.Name, r.Provider)
+			assert.Equal(t, tc.wantErr, !r.OK())
+			if tc.wantErr {
+				assert.Contains(t, r.Err.Error(), tc.wantErrHint)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commandstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+var (
+	ErrCommandNotFound = errors.New("command not found")
+	ErrNotRetryable    = errors.New("command is not retryable")
+)
+
+// Queue helps inspect how the commands received from the control plane are handled
+// and retry handling the stuck or failed ones.
+type Queue interface {
+	// ListQueuedCommands returns all commands received recently ordered by the received time.
+	ListQueuedCommands() []QueuedCommand
+	// Retry retries handling the given command.
+	// The command whose result could not be reported is reported again,
+	// and the command failed to be handled or waiting longer than waitingCommandRetryThreshold
+	// is listed again to be handled.
+	Retry(ctx context.Context, commandID string) error
+}
+
+// waitingCommandRetryThreshold is how long a command has to be waiting
+// before it can be retried, to avoid handling the command being handled twice.
+var waitingCommandRetryThreshold = 5 * time.Minute
+
+type QueuedCommandStatus string
+
+const (
+	// The command is waiting to be handled.
+	QueuedCommandWaiting QueuedCommandStatus = "WAITING"
+	// The command was handled and its result was reported to the control plane.
+	QueuedCommandHandled QueuedCommandStatus = "HANDLED"
+	// The command was handled but its result could not be reported to the control plane.
+	QueuedCommandReportFailed QueuedCommandStatus = "REPORT_FAILED"
+)
+
+// QueuedCommand represents the handling status of a received command.
+type QueuedCommand struct {
+	ID            string              `json:"id"`
+	Type          string              `json:"type"`
+	ApplicationID string              `json:"applicationId,omitempty"`
+	DeploymentID  string              `json:"deploymentId,omitempty"`
+	StageID       string              `json:"stageId,omitempty"`
+	Commander     string              `json:"commander,omitempty"`
+	Status        QueuedCommandStatus `json:"status"`
+	// The result of handling the command.
+	Result     string    `json:"result,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	Retries    int       `json:"retries"`
+	ReceivedAt time.Time `json:"receivedAt"`
+	HandledAt  time.Time `json:"handledAt,omitempty"`
+}
+
+type commandRecord struct {
+	command    *model.Command
+	receivedAt time.Time
+	updatedAt  time.Time
+	handledAt  time.Time
+	result     model.CommandStatus
+	metadata   map[string]string
+	output     []byte
+	reportErr  error
+	retries    int
+}
+
+func (r *commandRecord) status() QueuedCommandStatus {
+	switch {
+	case r.handledAt.IsZero():
+		return QueuedCommandWaiting
+	case r.reportErr != nil:
+		return QueuedCommandReportFailed
+	default:
+		return QueuedCommandHandled
+	}
+}
+
+// recordReceived records the given command listed by the control plane.
+// This must be called while holding the lock.
+func (s *store) recordReceived(cmd *model.Command, now time.Time) {
+	if r, ok := s.records[cmd.Id]; ok {
+		r.updatedAt = now
+		return
+	}
+	s.records[cmd.Id] = &commandRecord{
+		command:    cmd,
+		receivedAt: now,
+		updatedAt:  now,
+	}
+}
+
+// recordHandled records the result of handling the given command.
+// This must be called while holding the lock.
+func (s *store) recordHandled(cmd *model.Command, now time.Time, result model.CommandStatus, metadata map[string]string, output []byte) *commandRecord {
+	r, ok := s.records[cmd.Id]
+	if !ok {
+		r = &commandRecord{
+			command:    cmd,
+			receivedAt: now,
+		}
+		s.records[cmd.Id] = r
+	}
+	r.updatedAt = now
+	r.handledAt = now
+	r.result = result
+	r.metadata = metadata
+	r.output = output
+	r.reportErr = nil
+	return r
+}
+
+// cleanRecords removes the records which have not been updated for a while.
+func (s *store) cleanRecords(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, r := range s.records {
+		if _, ok := s.requeuedCommands[id]; ok {
+			continue
+		}
+		if now.Sub(r.updatedAt) > staleCommandPeriod {
+			delete(s.records, id)
+		}
+	}
+}
+
+func (s *store) ListQueuedCommands() []QueuedCommand {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	commands := make([]QueuedCommand, 0, len(s.records))
+	for _, r := range s.records {
+		c := QueuedCommand{
+			ID:            r.command.Id,
+			Type:          r.command.Type.String(),
+			ApplicationID: r.command.ApplicationId,
+			DeploymentID:  r.command.DeploymentId,
+			StageID:       r.command.StageId,
+			Commander:     r.command.Commander,
+			Status:        r.status(),
+			Retries:       r.retries,
+			ReceivedAt:    r.receivedAt,
+			HandledAt:     r.handledAt,
+		}
+		if !r.handledAt.IsZero() {
+			c.Result = r.result.String()
+		}
+		if r.reportErr != nil {
+			c.Error = r.reportErr.Error()
+		}
+		commands = append(commands, c)
+	}
+	sort.Slice(commands, func(i, j int) bool {
+		return commands[i].ReceivedAt.Before(commands[j].ReceivedAt)
+	})
+	return commands
+}
+
+func (s *store) Retry(ctx context.Context, commandID string) error {
+	s.mu.Lock()
+	r, ok := s.records[commandID]
+	if !ok {
+		s.mu.Unlock()
+		return fmt.Errorf("%w: %s", ErrCommandNotFound, commandID)
+	}
+
+	switch {
+	case r.status() == QueuedCommandReportFailed:
+		r.retries++
+		s.mu.Unlock()
+		return s.sendReport(ctx, r)
+
+	case r.status() == QueuedCommandHandled && r.result == model.CommandStatus_COMMAND_FAILED,
+		r.status() == QueuedCommandWaiting && time.Since(r.receivedAt) > waitingCommandRetryThreshold:
+		r.retries++
+		r.handledAt = time.Time{}
+		r.updatedAt = time.Now()
+		delete(s.handledCommands, commandID)
+		s.requeuedCommands[commandID] = r.command
+		s.mu.Unlock()
+		return nil
+
+	case r.status() == QueuedCommandWaiting:
+		s.mu.Unlock()
+		return fmt.Errorf("%w: %s has been waiting for less than %v", ErrNotRetryable, commandID, waitingCommandRetryThreshold)
+
+	default:
+		status := r.status()
+		s.mu.Unlock()
+		return fmt.Errorf("%w: %s is %s", ErrNotRetryable, commandID, status)
+	}
+}
+
+// NewQueueHandler returns the HTTP handler for inspecting the given queue.
+// It lists the received commands for GET requests to the root path
+// and retries handling the command given by the id query parameter for POST requests to /retry.
+// Since it has no authentication, it must be served only by the admin server
+// which must not be exposed outside of the cluster.
+func NewQueueHandler(q Queue) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(q.ListQueuedCommands())
+	})
+	mux.HandleFunc("/retry", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		err := q.Retry(r.Context(), r.URL.Query().Get("id"))
+		switch {
+		case err == nil:
+			w.Write([]byte("ok"))
+		case errors.Is(err, ErrCommandNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case errors.Is(err, ErrNotRetryable):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+	return mux
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commandstore
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeAPIClient struct {
+	commands  []*model.Command
+	reportErr error
+	reported  []*pipedservice.ReportCommandHandledRequest
+}
+
+func (c *fakeAPIClient) ListUnhandledCommands(_ context.Context, _ *pipedservice.ListUnhandledCommandsRequest, _ ...grpc.CallOption) (*pipedservice.ListUnhandledCommandsResponse, error) {
+	return &pipedservice.ListUnhandledCommandsResponse{Commands: c.commands}, nil
+}
+
+func (c *fakeAPIClient) ReportCommandHandled(_ context.Context, in *pipedservice.ReportCommandHandledRequest, _ ...grpc.CallOption) (*pipedservice.ReportCommandHandledResponse, error) {
+	c.reported = append(c.reported, in)
+	return &pipedservice.ReportCommandHandledResponse{}, c.reportErr
+}
+
+func TestQueueRetry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	api := &fakeAPIClient{
+		commands: []*model.Command{
+			{Id: "sync", Type: model.Command_SYNC_APPLICATION, ApplicationId: "app"},
+			{Id: "cancel", Type: model.Command_CANCEL_DEPLOYMENT, DeploymentId: "deployment"},
+		},
+	}
+	s := NewStore(api, 0, zap.NewNop()).(*store)
+	require.NoError(t, s.sync(ctx))
+
+	cmds := s.Queue().ListQueuedCommands()
+	require.Len(t, cmds, 2)
+	for _, c := range cmds {
+		assert.Equal(t, QueuedCommandWaiting, c.Status)
+	}
+	assert.ErrorIs(t, s.Retry(ctx, "sync"), ErrNotRetryable)
+	assert.ErrorIs(t, s.Retry(ctx, "unknown"), ErrCommandNotFound)
+
+	// Reporting the result failed.
+	api.reportErr = errors.New("unavailable")
+	sync := s.ListApplicationCommands()[0]
+	require.Error(t, sync.Report(ctx, model.CommandStatus_COMMAND_SUCCEEDED, nil, nil))
+	assert.Empty(t, s.ListApplicationCommands())
+	assert.Equal(t, QueuedCommandReportFailed, findQueuedCommand(t, s, "sync").Status)
+
+	api.reportErr = nil
+	require.NoError(t, s.Retry(ctx, "sync"))
+	got := findQueuedCommand(t, s, "sync")
+	assert.Equal(t, QueuedCommandHandled, got.Status)
+	assert.Equal(t, 1, got.Retries)
+	assert.Len(t, api.reported, 2)
+	assert.ErrorIs(t, s.Retry(ctx, "sync"), ErrNotRetryable)
+
+	// Handling the command failed.
+	cancel := s.ListDeploymentCommands()[0]
+	require.NoError(t, cancel.Report(ctx, model.CommandStatus_COMMAND_FAILED, nil, nil))
+	api.commands = nil
+	require.NoError(t, s.sync(ctx))
+	assert.Empty(t, s.ListDeploymentCommands())
+
+	require.NoError(t, s.Retry(ctx, "cancel"))
+	assert.Equal(t, QueuedCommandWaiting, findQueuedCommand(t, s, "cancel").Status)
+	require.NoError(t, s.sync(ctx))
+	requeued := s.ListDeploymentCommands()
+	require.Len(t, requeued, 1)
+	assert.Equal(t, "cancel", requeued[0].Id)
+
+	require.NoError(t, requeued[0].Report(ctx, model.CommandStatus_COMMAND_SUCCEEDED, nil, nil))
+	require.NoError(t, s.sync(ctx))
+	assert.Empty(t, s.ListDeploymentCommands())
+	assert.Equal(t, model.CommandStatus_COMMAND_SUCCEEDED.String(), findQueuedCommand(t, s, "cancel").Result)
+}
+
+func TestQueueRetryWaitingCommand(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	api := &fakeAPIClient{
+		commands: []*model.Command{
+			{Id: "sync", Type: model.Command_SYNC_APPLICATION, ApplicationId: "app"},
+		},
+	}
+	s := NewStore(api, 0, zap.NewNop()).(*store)
+	require.NoError(t, s.sync(ctx))
+	assert.ErrorIs(t, s.Retry(ctx, "sync"), ErrNotRetryable)
+
+	// The command has been stuck and is no longer listed by the control plane.
+	s.records["sync"].receivedAt = time.Now().Add(-waitingCommandRetryThreshold - time.Minute)
+	api.commands = nil
+	require.NoError(t, s.sync(ctx))
+	assert.Empty(t, s.ListApplicationCommands())
+
+	require.NoError(t, s.Retry(ctx, "sync"))
+	require.NoError(t, s.sync(ctx))
+	requeued := s.ListApplicationCommands()
+	require.Len(t, requeued, 1)
+	assert.Equal(t, "sync", requeued[0].Id)
+	assert.Equal(t, 1, findQueuedCommand(t, s, "sync").Retries)
+}
+
+func TestQueueHandler(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	api := &fakeAPIClient{
+		commands: []*model.Command{
+			{Id: "sync", Type: model.Command_SYNC_APPLICATION, ApplicationId: "app"},
+		},
+	}
+	s := NewStore(api, 0, zap.NewNop())
+	require.NoError(t, s.(*store).sync(ctx))
+	h := NewQueueHandler(s.Queue())
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"id":"sync"`)
+	assert.Contains(t, rec.Body.String(), `"status":"WAITING"`)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/retry?id=sync", nil))
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/retry?id=unknown", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/retry?id=sync", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func findQueuedCommand(t *testing.T, s *store, id string) QueuedCommand {
+	t.Helper()
+	for _, c := range s.ListQueuedCommands() {
+		if c.ID == id {
+			return c
+		}
+	}
+	require.FailNow(t, "command not found", id)
+	return QueuedCommand{}
+}
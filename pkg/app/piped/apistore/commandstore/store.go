@@ -34,6 +34,7 @@ type apiClient interface {
 type Store interface {
 	Run(ctx context.Context) error
 	Lister() Lister
+	Queue() Queue
 }
 
 // Lister helps list commands.
@@ -57,9 +58,13 @@ type store struct {
 	planPreviewCommands []model.ReportableCommand
 	pipedCommands       []model.ReportableCommand
 	handledCommands     map[string]time.Time
-	mu                  sync.RWMutex
-	gracePeriod         time.Duration
-	logger              *zap.Logger
+	// All received commands with their handling status.
+	records map[string]*commandRecord
+	// The commands requested to be handled again after being handled.
+	requeuedCommands map[string]*model.Command
+	mu               sync.RWMutex
+	gracePeriod      time.Duration
+	logger           *zap.Logger
 }
 
 var (
@@ -72,11 +77,13 @@ var (
 // and then notifies them to the registered subscribers.
 func NewStore(apiClient apiClient, gracePeriod time.Duration, logger *zap.Logger) Store {
 	return &store{
-		apiClient:       apiClient,
-		syncInterval:    defaultSyncInterval,
-		handledCommands: make(map[string]time.Time),
-		gracePeriod:     gracePeriod,
-		logger:          logger.Named("command-store"),
+		apiClient:        apiClient,
+		syncInterval:     defaultSyncInterval,
+		handledCommands:  make(map[string]time.Time),
+		records:          make(map[string]*commandRecord),
+		requeuedCommands: make(map[string]*model.Command),
+		gracePeriod:      gracePeriod,
+		logger:           logger.Named("command-store"),
 	}
 }
 
@@ -97,6 +104,7 @@ func (s *store) Run(ctx context.Context) error {
 
 		case now := <-cleanHandledCommandTicker.C:
 			s.cleanHandledCommands(now)
+			s.cleanRecords(now)
 
 		case <-ctx.Done():
 			s.logger.Info("command store has been stopped")
@@ -109,6 +117,10 @@ func (s *store) Lister() Lister {
 	return s
 }
 
+func (s *store) Queue() Queue {
+	return s
+}
+
 func (s *store) sync(ctx context.Context) error {
 	resp, err := s.apiClient.ListUnhandledCommands(ctx, &pipedservice.ListUnhandledCommandsRequest{})
 	if err != nil {
@@ -116,6 +128,23 @@ func (s *store) sync(ctx context.Context) error {
 		return err
 	}
 
+	now := time.Now()
+	commands := resp.Commands
+
+	s.mu.Lock()
+	listed := make(map[string]struct{}, len(resp.Commands))
+	for _, cmd := range resp.Commands {
+		listed[cmd.Id] = struct{}{}
+		s.recordReceived(cmd, now)
+	}
+	// The requeued commands are no longer returned by the control plane since they were reported as handled.
+	for id, cmd := range s.requeuedCommands {
+		if _, ok := listed[id]; !ok {
+			commands = append(commands, cmd)
+		}
+	}
+	s.mu.Unlock()
+
 	var (
 		applicationCommands = make([]model.ReportableCommand, 0)
 		deploymentCommands  = make([]model.ReportableCommand, 0)
@@ -123,13 +152,13 @@ func (s *store) sync(ctx context.Context) error {
 		planPreviewCommands = make([]model.ReportableCommand, 0)
 		pipedCommands       = make([]model.ReportableCommand, 0)
 	)
-	for _, cmd := range resp.Commands {
+	for _, cmd := range commands {
 		switch cmd.Type {
-		case model.Command_SYNC_APPLICATION, model.Command_UPDATE_APPLICATION_CONFIG, model.Command_CHAIN_SYNC_APPLICATION:
+		case model.Command_SYNC_APPLICATION, model.Command_UPDATE_APPLICATION_CONFIG, model.Command_CHAIN_SYNC_APPLICATION, model.Command_RETRY_DEPLOYMENT, model.Command_DELETE_APPLICATION:
 			applicationCommands = append(applicationCommands, s.makeReportableCommand(cmd))
 		case model.Command_CANCEL_DEPLOYMENT:
 			deploymentCommands = append(deploymentCommands, s.makeReportableCommand(cmd))
-		case model.Command_APPROVE_STAGE, model.Command_SKIP_STAGE:
+		case model.Command_APPROVE_STAGE, model.Command_SKIP_STAGE, model.Command_REPORT_STAGE_RESULT:
 			stageCommands = append(stageCommands, s.makeReportableCommand(cmd))
 		case model.Command_BUILD_PLAN_PREVIEW:
 			planPreviewCommands = append(planPreviewCommands, s.makeReportableCommand(cmd))
@@ -253,14 +282,31 @@ func (s *store) reportCommandHandled(ctx context.Context, c *model.Command, stat
 
 	s.mu.Lock()
 	s.handledCommands[c.Id] = now
+	delete(s.requeuedCommands, c.Id)
+	r := s.recordHandled(c, now, status, metadata, output)
+	s.mu.Unlock()
+
+	return s.sendReport(ctx, r)
+}
+
+// sendReport reports the handling result of the given record to the control plane
+// and records the error to be retried when it failed.
+func (s *store) sendReport(ctx context.Context, r *commandRecord) error {
+	s.mu.RLock()
+	req := &pipedservice.ReportCommandHandledRequest{
+		CommandId: r.command.Id,
+		Status:    r.result,
+		Metadata:  r.metadata,
+		HandledAt: r.handledAt.Unix(),
+		Output:    r.output,
+	}
+	s.mu.RUnlock()
+
+	_, err := s.apiClient.ReportCommandHandled(ctx, req)
+
+	s.mu.Lock()
+	r.reportErr = err
 	s.mu.Unlock()
 
-	_, err := s.apiClient.ReportCommandHandled(ctx, &pipedservice.ReportCommandHandledRequest{
-		CommandId: c.Id,
-		Status:    status,
-		Metadata:  metadata,
-		HandledAt: now.Unix(),
-		Output:    output,
-	})
 	return err
 }
@@ -0,0 +1,111 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package appcleaner provides a piped component
+// that cleans up the resources bound to the lifecycle of deleted applications.
+package appcleaner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	commandCheckInterval = 10 * time.Second
+)
+
+type commandLister interface {
+	ListApplicationCommands() []model.ReportableCommand
+}
+
+type Cleaner interface {
+	Run(ctx context.Context) error
+}
+
+type cleaner struct {
+	commandLister    commandLister
+	pipedConfig      *config.PipedSpec
+	deleteNamespaces func(ctx context.Context, cp config.PlatformProviderKubernetesConfig, appID string) error
+	logger           *zap.Logger
+}
+
+func NewCleaner(cl commandLister, cfg *config.PipedSpec, logger *zap.Logger) Cleaner {
+	return &cleaner{
+		commandLister:    cl,
+		pipedConfig:      cfg,
+		deleteNamespaces: provider.DeleteManagedNamespaces,
+		logger:           logger.Named("app-cleaner"),
+	}
+}
+
+func (c *cleaner) Run(ctx context.Context) error {
+	c.logger.Info("start running application cleaner")
+
+	ticker := time.NewTicker(commandCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.handleCommands(ctx)
+		case <-ctx.Done():
+			c.logger.Info("application cleaner has been stopped")
+			return nil
+		}
+	}
+}
+
+func (c *cleaner) handleCommands(ctx context.Context) {
+	for _, cmd := range c.commandLister.ListApplicationCommands() {
+		if !cmd.IsDeleteApplicationCmd() {
+			continue
+		}
+
+		status := model.CommandStatus_COMMAND_SUCCEEDED
+		if err := c.clean(ctx, cmd.GetDeleteApplication()); err != nil {
+			c.logger.Error("failed to clean up resources of the deleted application",
+				zap.String("command", cmd.Id),
+				zap.String("app-id", cmd.ApplicationId),
+				zap.Error(err),
+			)
+			status = model.CommandStatus_COMMAND_FAILED
+		}
+		if err := cmd.Report(ctx, status, nil, nil); err != nil {
+			c.logger.Error("failed to report command status", zap.String("command", cmd.Id), zap.Error(err))
+		}
+	}
+}
+
+// clean deletes the namespaces that were marked to be deleted together with the given application.
+// Since the configuration of the deleted application is no longer available,
+// they are looked up by their labels in the platform provider of the application.
+func (c *cleaner) clean(ctx context.Context, cmd *model.Command_DeleteApplication) error {
+	cp, ok := c.pipedConfig.FindPlatformProvider(cmd.PlatformProvider, model.ApplicationKind_KUBERNETES)
+	if !ok {
+		return fmt.Errorf("platform provider %s was not found", cmd.PlatformProvider)
+	}
+
+	c.logger.Info("deleting the namespaces managed by the deleted application",
+		zap.String("app-id", cmd.ApplicationId),
+		zap.String("platform-provider", cp.Name),
+	)
+	return c.deleteNamespaces(ctx, *cp.KubernetesConfig, cmd.ApplicationId)
+}
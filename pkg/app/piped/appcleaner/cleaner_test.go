@@ -0,0 +1,104 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appcleaner
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeCommandLister struct {
+	commands []model.ReportableCommand
+}
+
+func (l *fakeCommandLister) ListApplicationCommands() []model.ReportableCommand {
+	return l.commands
+}
+
+func TestHandleCommands(t *testing.T) {
+	t.Parallel()
+
+	reported := make(map[string]model.CommandStatus)
+	makeCommand := func(cmd *model.Command) model.ReportableCommand {
+		return model.ReportableCommand{
+			Command: cmd,
+			Report: func(_ context.Context, status model.CommandStatus, _ map[string]string, _ []byte) error {
+				reported[cmd.Id] = status
+				return nil
+			},
+		}
+	}
+	lister := &fakeCommandLister{
+		commands: []model.ReportableCommand{
+			makeCommand(&model.Command{
+				Id:              "sync",
+				ApplicationId:   "app-1",
+				SyncApplication: &model.Command_SyncApplication{ApplicationId: "app-1"},
+			}),
+			makeCommand(&model.Command{
+				Id:                "delete-1",
+				ApplicationId:     "app-1",
+				DeleteApplication: &model.Command_DeleteApplication{ApplicationId: "app-1", PlatformProvider: "kubernetes-default"},
+			}),
+			makeCommand(&model.Command{
+				Id:                "delete-2",
+				ApplicationId:     "app-2",
+				DeleteApplication: &model.Command_DeleteApplication{ApplicationId: "app-2", PlatformProvider: "unknown"},
+			}),
+			makeCommand(&model.Command{
+				Id:                "delete-3",
+				ApplicationId:     "app-3",
+				DeleteApplication: &model.Command_DeleteApplication{ApplicationId: "app-3", PlatformProvider: "kubernetes-default"},
+			}),
+		},
+	}
+
+	var deleted []string
+	c := &cleaner{
+		commandLister: lister,
+		pipedConfig: &config.PipedSpec{
+			PlatformProviders: []config.PipedPlatformProvider{
+				{
+					Name:             "kubernetes-default",
+					Type:             model.PlatformProviderKubernetes,
+					KubernetesConfig: &config.PlatformProviderKubernetesConfig{},
+				},
+			},
+		},
+		deleteNamespaces: func(_ context.Context, _ config.PlatformProviderKubernetesConfig, appID string) error {
+			if appID == "app-3" {
+				return errors.New("kubectl failed")
+			}
+			deleted = append(deleted, appID)
+			return nil
+		},
+		logger: zap.NewNop(),
+	}
+	c.handleCommands(context.Background())
+
+	assert.Equal(t, []string{"app-1"}, deleted)
+	assert.Equal(t, map[string]model.CommandStatus{
+		"delete-1": model.CommandStatus_COMMAND_SUCCEEDED,
+		"delete-2": model.CommandStatus_COMMAND_FAILED,
+		"delete-3": model.CommandStatus_COMMAND_FAILED,
+	}, reported)
+}
@@ -375,7 +375,7 @@ func (r *Reporter) readApplicationInfo(repoDir, repoID, cfgRelPath string) (*mod
 	if err != nil {
 		return nil, fmt.Errorf("failed to open the configuration file: %w", err)
 	}
-	cfg, err := config.DecodeYAML(b)
+	cfg, err := config.DecodeYAMLWithValues(b, config.RenderValues{Env: r.config.AppEnv})
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode configuration file: %w", err)
 	}
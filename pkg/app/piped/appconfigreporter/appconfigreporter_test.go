@@ -50,6 +50,7 @@ func TestReporter_findRegisteredApps(t *testing.T) {
 		{
 			name: "no app registered",
 			reporter: &Reporter{
+				config:            &config.PipedSpec{},
 				applicationLister: &fakeApplicationLister{},
 				logger:            zap.NewNop(),
 			},
@@ -63,6 +64,7 @@ func TestReporter_findRegisteredApps(t *testing.T) {
 		{
 			name: "no app registered in the repo",
 			reporter: &Reporter{
+				config: &config.PipedSpec{},
 				applicationLister: &fakeApplicationLister{apps: []*model.Application{
 					{Id: "id-1", Name: "app-1", Labels: map[string]string{"key-1": "value-1"}, GitPath: &model.ApplicationGitPath{Repo: &model.ApplicationGitRepository{Id: "different-repo"}, Path: "app-1", ConfigFilename: "app.pipecd.yaml"}},
 				}},
@@ -78,6 +80,7 @@ func TestReporter_findRegisteredApps(t *testing.T) {
 		{
 			name: "invalid app config is contained",
 			reporter: &Reporter{
+				config: &config.PipedSpec{},
 				applicationLister: &fakeApplicationLister{apps: []*model.Application{
 					{Id: "id-1", Name: "app-1", Labels: map[string]string{"key-1": "value-1"}, GitPath: &model.ApplicationGitPath{Repo: &model.ApplicationGitRepository{Id: "repo-1"}, Path: "app-1", ConfigFilename: "app.pipecd.yaml"}},
 				}},
@@ -12,13 +12,16 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package chartrepo manages a list of configured helm repositories.
+// Package chartrepo manages a list of configured helm repositories and registries.
 package chartrepo
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os/exec"
+	"strings"
+	"sync"
 
 	"go.uber.org/zap"
 	"golang.org/x/sync/singleflight"
@@ -26,7 +29,15 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/config"
 )
 
-var updateGroup = &singleflight.Group{}
+var (
+	updateGroup = &singleflight.Group{}
+	loginGroup  = &singleflight.Group{}
+
+	// registries holds the Helm chart registries given to Login
+	// to log in to them again when their credentials were expired.
+	registries   []config.HelmChartRegistry
+	registriesMu sync.RWMutex
+)
 
 type registry interface {
 	Helm(ctx context.Context, version string) (string, bool, error)
@@ -85,3 +96,80 @@ func update(ctx context.Context, reg registry, logger *zap.Logger) error {
 	logger.Info("successfully updated Helm chart repositories")
 	return nil
 }
+
+// Login logs in to all specified Helm chart registries that have credentials.
+// The registries are kept to log in to them again by Relogin.
+// https://helm.sh/docs/topics/registries/
+// helm registry login registry.example.com --username my-username --password-stdin
+func Login(ctx context.Context, regs []config.HelmChartRegistry, reg registry, logger *zap.Logger) error {
+	registriesMu.Lock()
+	registries = regs
+	registriesMu.Unlock()
+
+	return login(ctx, regs, reg, logger)
+}
+
+// Relogin logs in again to the registries given to Login
+// to refresh the credentials such as short-lived access tokens.
+func Relogin(ctx context.Context, reg registry, logger *zap.Logger) error {
+	_, err, _ := loginGroup.Do("login", func() (interface{}, error) {
+		registriesMu.RLock()
+		regs := registries
+		registriesMu.RUnlock()
+
+		return nil, login(ctx, regs, reg, logger)
+	})
+	return err
+}
+
+func login(ctx context.Context, regs []config.HelmChartRegistry, reg registry, logger *zap.Logger) error {
+	if len(regs) == 0 {
+		return nil
+	}
+
+	helm, _, err := reg.Helm(ctx, "")
+	if err != nil {
+		return fmt.Errorf("failed to find helm to login to registries (%w)", err)
+	}
+
+	for _, r := range regs {
+		if !r.IsOCI() {
+			return fmt.Errorf("unsupported Helm chart registry type: %s", r.Type)
+		}
+		if !r.HasCredentials() {
+			continue
+		}
+
+		username, password, err := r.LoadCredentials()
+		if err != nil {
+			return fmt.Errorf("failed to load credentials of Helm chart registry %s (%w)", r.Address, err)
+		}
+
+		address := strings.TrimPrefix(r.Address, config.OCIChartRepositoryPrefix)
+		args := []string{"registry", "login", address, "--username", username, "--password-stdin"}
+		if r.Insecure {
+			args = append(args, "--insecure")
+		}
+		cmd := exec.CommandContext(ctx, helm, args...)
+		cmd.Stdin = strings.NewReader(password)
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to login to Helm chart registry %s: %s (%w)", r.Address, stderr.String(), err)
+		}
+		logger.Info("successfully logged in to Helm chart registry", zap.String("address", r.Address))
+	}
+	return nil
+}
+
+// IsAuthError returns true if the given error of a helm command
+// seems to be caused by missing or expired registry credentials.
+func IsAuthError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, s := range []string{"unauthorized", "authentication required", "denied", "401", "403"} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
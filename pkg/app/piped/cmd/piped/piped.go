@@ -51,15 +51,19 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/pipe-cd/pipecd/pkg/admin"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/preflight"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/apistore/analysisresultstore"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/apistore/applicationstore"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/apistore/commandstore"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/apistore/deploymentstore"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/apistore/eventstore"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/appcleaner"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/appconfigreporter"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/chartrepo"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/controller"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/controller/controllermetrics"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/diskjanitor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/diskjanitor/diskjanitormetrics"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/driftdetector"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/eventwatcher"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatereporter"
@@ -68,6 +72,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/piped/notifier"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/planpreview"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/planpreview/planpreviewmetrics"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/awsapi/awsapimetrics"
 	k8scloudprovidermetrics "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes/kubernetesmetrics"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/statsreporter"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/toolregistry"
@@ -110,6 +115,7 @@ type piped struct {
 	launcherVersion                      string
 	maxRecvMsgSize                       int
 	appManifestCacheCount                int
+	checkAnalysisProviders               bool
 }
 
 func NewCommand() *cobra.Command {
@@ -118,11 +124,12 @@ func NewCommand() *cobra.Command {
 		panic(fmt.Sprintf("failed to detect the current user's home directory: %v", err))
 	}
 	p := &piped{
-		adminPort:             9085,
-		toolsDir:              path.Join(home, ".piped", "tools"),
-		gracePeriod:           30 * time.Second,
-		maxRecvMsgSize:        1024 * 1024 * 10, // 10MB
-		appManifestCacheCount: 150,
+		adminPort:              9085,
+		toolsDir:               path.Join(home, ".piped", "tools"),
+		gracePeriod:            30 * time.Second,
+		maxRecvMsgSize:         1024 * 1024 * 10, // 10MB
+		appManifestCacheCount:  150,
+		checkAnalysisProviders: true,
 	}
 	cmd := &cobra.Command{
 		Use:   "piped",
@@ -150,6 +157,8 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().DurationVar(&p.gracePeriod, "grace-period", p.gracePeriod, "How long to wait for graceful shutdown.")
 	cmd.Flags().IntVar(&p.appManifestCacheCount, "app-manifest-cache-count", p.appManifestCacheCount, "The number of app manifests to cache. The cache-key contains the commit hash. The default is 150.")
 
+	cmd.Flags().BoolVar(&p.checkAnalysisProviders, "check-analysis-providers", p.checkAnalysisProviders, "Whether to check the connectivity of the configured analysis providers at startup.")
+
 	cmd.Flags().StringVar(&p.launcherVersion, "launcher-version", p.launcherVersion, "The version of launcher which initialized this Piped.")
 
 	return cmd
@@ -210,27 +219,9 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 	// Login to chart registries.
 	if regs := cfg.ChartRegistries; len(regs) > 0 {
 		reg := toolregistry.DefaultRegistry()
-		helm, _, err := reg.Helm(ctx, "")
-		if err != nil {
-			return fmt.Errorf("failed to find helm while login to chart registries (%w)", err)
-		}
-
-		for _, r := range regs {
-			switch r.Type {
-			case config.OCIHelmChartRegistry:
-				if r.Username == "" || r.Password == "" {
-					continue
-				}
-
-				if err := loginToOCIRegistry(ctx, helm, r.Address, r.Username, r.Password); err != nil {
-					input.Logger.Error(fmt.Sprintf("failed to login to %s Helm chart registry", r.Address), zap.Error(err))
-					return err
-				}
-				input.Logger.Info("successfully logged in to Helm chart registry", zap.String("address", r.Address))
-
-			default:
-				return fmt.Errorf("unsupported Helm chart registry type: %s", r.Type)
-			}
+		if err := chartrepo.Login(ctx, regs, reg, input.Logger); err != nil {
+			input.Logger.Error("failed to login to Helm chart registries", zap.Error(err))
+			return err
 		}
 	}
 
@@ -276,6 +267,39 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 		return notifier.Run(ctx)
 	})
 
+	// Check the configured analysis providers to surface their misconfiguration early.
+	// The result is only logged since a provider being temporarily unavailable must not block piped.
+	if p.checkAnalysisProviders && len(cfg.AnalysisProviders) > 0 {
+		group.Go(func() error {
+			for _, r := range preflight.CheckAll(ctx, cfg.AnalysisProviders, preflight.DefaultTimeout, input.Logger) {
+				if !r.OK() {
+					input.Logger.Warn("analysis provider is not usable",
+						zap.String("name", r.Provider),
+						zap.String("type", string(r.Type)),
+						zap.Error(r.Err),
+					)
+					continue
+				}
+				input.Logger.Info("analysis provider is usable", zap.String("name", r.Provider))
+			}
+			return nil
+		})
+	}
+
+	// Start running command store.
+	var (
+		commandLister commandstore.Lister
+		commandQueue  commandstore.Queue
+	)
+	{
+		store := commandstore.NewStore(apiClient, p.gracePeriod, input.Logger)
+		group.Go(func() error {
+			return store.Run(ctx)
+		})
+		commandLister = store.Lister()
+		commandQueue = store.Queue()
+	}
+
 	// Start running admin server.
 	{
 		var (
@@ -290,6 +314,7 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 			w.Write([]byte("ok"))
 		})
 		admin.Handle("/metrics", input.PrometheusMetricsHandlerFor(registry))
+		admin.Handle("/commands/", http.StripPrefix("/commands", commandstore.NewQueueHandler(commandQueue)))
 		admin.HandleFunc("/debug/pprof/", pprof.Index)
 		admin.HandleFunc("/debug/pprof/profile", pprof.Profile)
 		admin.HandleFunc("/debug/pprof/trace", pprof.Trace)
@@ -314,12 +339,20 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 		return err
 	}
 
+	gitCacheDir, err := os.MkdirTemp("", "gitcache")
+	if err != nil {
+		input.Logger.Error("failed to create a temporary directory for git cache", zap.Error(err))
+		return err
+	}
+
 	// Initialize git client.
 	gitOptions := []git.Option{
 		git.WithUserName(cfg.Git.Username),
 		git.WithEmail(cfg.Git.Email),
 		git.WithLogger(input.Logger),
 		git.WithPassword(password),
+		git.WithCacheDir(gitCacheDir),
+		git.WithMaxConcurrentFetches(cfg.Git.MaxConcurrentFetches),
 	}
 	for _, repo := range cfg.GitHelmChartRepositories() {
 		if f := repo.SSHKeyFile; f != "" {
@@ -341,6 +374,24 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 		input.Logger.Info("successfully cleaned gitClient")
 	}()
 
+	// Start running disk janitor.
+	if cfg.DiskUsage != nil {
+		targets, err := p.diskJanitorTargets(cfg.DiskUsage, gitCacheDir, gitClient)
+		if err != nil {
+			input.Logger.Error("failed to configure disk janitor", zap.Error(err))
+			return err
+		}
+		j := diskjanitor.NewJanitor(
+			targets,
+			cfg.DiskUsage.CheckInterval.Duration(),
+			cfg.DiskUsage.MinEntryAge.Duration(),
+			input.Logger,
+		)
+		group.Go(func() error {
+			return j.Run(ctx)
+		})
+	}
+
 	// Start running application store.
 	var applicationLister applicationstore.Lister
 	{
@@ -361,16 +412,6 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 		deploymentLister = store.Lister()
 	}
 
-	// Start running command store.
-	var commandLister commandstore.Lister
-	{
-		store := commandstore.NewStore(apiClient, p.gracePeriod, input.Logger)
-		group.Go(func() error {
-			return store.Run(ctx)
-		})
-		commandLister = store.Lister()
-	}
-
 	// Start running event store.
 	var eventLister eventstore.Lister
 	{
@@ -424,6 +465,7 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 			appManifestsCache,
 			cfg,
 			decrypter,
+			notifier,
 			input.Logger,
 		)
 		if err != nil {
@@ -484,6 +526,14 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 		})
 	}
 
+	// Start running application cleaner.
+	{
+		c := appcleaner.NewCleaner(commandLister, cfg, input.Logger)
+		group.Go(func() error {
+			return c.Run(ctx)
+		})
+	}
+
 	// Start running event watcher.
 	{
 		w := eventwatcher.NewWatcher(
@@ -513,6 +563,7 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 			git.WithEmail(cfg.Git.Email),
 			git.WithLogger(input.Logger),
 			git.WithPassword(password),
+			git.WithMaxConcurrentFetches(cfg.Git.MaxConcurrentFetches),
 		)
 		if err != nil {
 			input.Logger.Error("failed to initialize git client for plan-preview", zap.Error(err))
@@ -605,6 +656,7 @@ func (p *piped) createAPIClient(ctx context.Context, address, projectID, pipedID
 		options = []rpcclient.DialOption{
 			rpcclient.WithBlock(),
 			rpcclient.WithPerRPCCredentials(creds),
+			rpcclient.WithUnaryInterceptor(pipedservice.DeploymentTokenUnaryClientInterceptor(logger)),
 			rpcclient.WithMaxRecvMsgSize(p.maxRecvMsgSize),
 		}
 	)
@@ -798,6 +850,7 @@ func (p *piped) sendPipedMeta(ctx context.Context, client pipedservice.Client, c
 			Config:            string(maskedCfg),
 			Repositories:      repos,
 			PlatformProviders: make([]*model.Piped_PlatformProvider, 0, len(cfg.PlatformProviders)),
+			Labels:            cfg.Labels,
 		}
 		retry = pipedservice.NewRetry(5)
 	)
@@ -959,6 +1012,59 @@ func (p *piped) getConfigDataFromAWSSsmParameterStore(ctx context.Context) ([]by
 	return decoded, nil
 }
 
+// diskJanitorTargets returns the directories to be managed by the disk janitor.
+func (p *piped) diskJanitorTargets(cfg *config.PipedDiskUsage, gitCacheDir string, gitClient git.Client) ([]diskjanitor.Target, error) {
+	gitCacheQuota, helmCacheQuota, workspaceQuota, err := cfg.Quotas()
+	if err != nil {
+		return nil, err
+	}
+
+	helmCacheDir := os.Getenv("HELM_CACHE_HOME")
+	if helmCacheDir == "" {
+		dir, err := os.UserCacheDir()
+		if err != nil {
+			return nil, fmt.Errorf("unable to determine the cache directory of helm: %w", err)
+		}
+		helmCacheDir = filepath.Join(dir, "helm")
+	}
+
+	return []diskjanitor.Target{
+		{
+			Name:  "git-cache",
+			Dir:   gitCacheDir,
+			Quota: gitCacheQuota,
+			// The cached repositories shared by the live worktrees must be kept.
+			Evict: func(path string) (bool, error) {
+				return gitClient.RemoveCache(filepath.Base(path))
+			},
+		},
+		{
+			Name:  "helm-cache",
+			Dir:   filepath.Join(helmCacheDir, "repository"),
+			Quota: helmCacheQuota,
+		},
+		{
+			// The temporary directories created for each operation.
+			Name: "workspace",
+			Dir:  os.TempDir(),
+			Patterns: []string{
+				"git[0-9]*",
+				"plan-preview-builder-*",
+				"detector-git-processing*",
+				"helm-remote-chart*",
+				"helm-external-values*",
+				"*-install[0-9]*",
+			},
+			Quota: workspaceQuota,
+		},
+		{
+			// The installed tools are in use by the tool registry, so only the usage is reported.
+			Name: "tools",
+			Dir:  p.toolsDir,
+		},
+	}, nil
+}
+
 func registerMetrics(pipedID, projectID, launcherVersion string) *prometheus.Registry {
 	r := prometheus.NewRegistry()
 	wrapped := prometheus.WrapRegistererWith(
@@ -976,33 +1082,14 @@ func registerMetrics(pipedID, projectID, launcherVersion string) *prometheus.Reg
 
 	k8scloudprovidermetrics.Register(wrapped)
 	k8slivestatestoremetrics.Register(wrapped)
+	awsapimetrics.Register(wrapped)
 	planpreviewmetrics.Register(wrapped)
 	controllermetrics.Register(wrapped)
+	diskjanitormetrics.Register(wrapped)
 
 	return r
 }
 
-func loginToOCIRegistry(ctx context.Context, execPath, address, username, password string) error {
-	args := []string{
-		"registry",
-		"login",
-		"-u",
-		username,
-		"-p",
-		password,
-		address,
-	}
-
-	var stderr bytes.Buffer
-	cmd := exec.CommandContext(ctx, execPath, args...)
-	cmd.Stderr = &stderr
-
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("%w: %s", err, stderr.String())
-	}
-	return nil
-}
-
 func stopCommandHandler(ctx context.Context, cmdLister commandstore.Lister, logger *zap.Logger) (bool, error) {
 	logger.Debug("fetch unhandled piped commands")
 
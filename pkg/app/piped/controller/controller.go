@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/controller/controllermetrics"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/logpersister"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
@@ -58,6 +60,11 @@ type apiClient interface {
 	ReportStageLogsFromLastCheckpoint(ctx context.Context, in *pipedservice.ReportStageLogsFromLastCheckpointRequest, opts ...grpc.CallOption) (*pipedservice.ReportStageLogsFromLastCheckpointResponse, error)
 
 	InChainDeploymentPlannable(ctx context.Context, in *pipedservice.InChainDeploymentPlannableRequest, opts ...grpc.CallOption) (*pipedservice.InChainDeploymentPlannableResponse, error)
+	GetPromotionArtifact(ctx context.Context, req *pipedservice.GetPromotionArtifactRequest, opts ...grpc.CallOption) (*pipedservice.GetPromotionArtifactResponse, error)
+	ReportDeploymentDiff(ctx context.Context, req *pipedservice.ReportDeploymentDiffRequest, opts ...grpc.CallOption) (*pipedservice.ReportDeploymentDiffResponse, error)
+	UploadStageArtifact(ctx context.Context, req *pipedservice.UploadStageArtifactRequest, opts ...grpc.CallOption) (*pipedservice.UploadStageArtifactResponse, error)
+	GetDeploymentRisk(ctx context.Context, req *pipedservice.GetDeploymentRiskRequest, opts ...grpc.CallOption) (*pipedservice.GetDeploymentRiskResponse, error)
+	RegisterEvent(ctx context.Context, req *pipedservice.RegisterEventRequest, opts ...grpc.CallOption) (*pipedservice.RegisterEventResponse, error)
 }
 
 type gitClient interface {
@@ -103,6 +110,8 @@ type DeploymentController interface {
 var (
 	plannerStaleDuration   = time.Hour
 	schedulerStaleDuration = time.Hour
+	// The maximum number of prepared deploy sources kept in the cache.
+	deploySourceCacheSize = 20
 )
 
 type controller struct {
@@ -117,6 +126,7 @@ type controller struct {
 	secretDecrypter     secretDecrypter
 	pipedConfig         *config.PipedSpec
 	appManifestsCache   cache.Cache
+	deploySourceCache   *deploysource.Cache
 	logPersister        logpersister.Persister
 
 	// Map from application ID to the planner
@@ -216,6 +226,10 @@ func (c *controller) Run(ctx context.Context) error {
 	c.workspaceDir = dir
 	c.logger.Info(fmt.Sprintf("workspace directory was configured to %s", c.workspaceDir))
 
+	// The prepared deploy sources are shared between planners and schedulers
+	// so they are stored outside of their working directories.
+	c.deploySourceCache = deploysource.NewCache(filepath.Join(c.workspaceDir, "deploysource-cache"), deploySourceCacheSize)
+
 	// Start running log persister to buffer and flush the log blocks.
 	// We do not use the passed ctx directly because we want log persister
 	// component to be stopped at the last order to avoid lossing log from other components.
@@ -507,6 +521,7 @@ func (c *controller) startNewPlanner(ctx context.Context, d *model.Deployment) (
 		c.secretDecrypter,
 		c.pipedConfig,
 		c.appManifestsCache,
+		c.deploySourceCache,
 		c.logger,
 		c.tracerProvider,
 	)
@@ -600,7 +615,11 @@ func (c *controller) syncSchedulers(ctx context.Context) {
 					zap.String("handling-deployment", s.ID()),
 					zap.String("deployment", d.Id),
 				)
+				continue
 			}
+			// Forward the reloaded deployment to notify the comments
+			// newly left on it as notification events.
+			s.NotifyNewComments(d)
 			continue
 		}
 		s, err := c.startNewScheduler(ctx, d)
@@ -650,6 +669,7 @@ func (c *controller) startNewScheduler(ctx context.Context, d *model.Deployment)
 		c.secretDecrypter,
 		c.pipedConfig,
 		c.appManifestsCache,
+		c.deploySourceCache,
 		c.logger,
 		c.tracerProvider,
 	)
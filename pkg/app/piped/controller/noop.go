@@ -0,0 +1,96 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"reflect"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/metadatastore"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	noOpSummary      = "No-op because the artifacts are identical to the running ones"
+	noOpStatusReason = "The deployment was completed as a no-op because the artifacts are identical to the running ones"
+
+	configOnlySummary      = "Config-only sync because only the application configuration was changed from the running one"
+	configOnlyStatusReason = "The deployment was completed as a config-only sync without changing any resource because only the application configuration was changed"
+
+	noOpSyncSummary      = "No-op sync because the rendered manifests are identical to the running ones"
+	noOpSyncStatusReason = "The deployment was completed as a no-op sync because the rendered manifests are identical to the running ones"
+)
+
+// isNoOpCandidate reports whether the deployment can be completed as a no-op.
+// The deployments whose sync strategy was forced by the trigger are never skipped,
+// and the first deployment of an application always runs its pipeline.
+func isNoOpCandidate(trigger *model.DeploymentTrigger, runningCommitHash string) bool {
+	if runningCommitHash == "" {
+		return false
+	}
+	return trigger.SyncStrategy == model.SyncStrategy_AUTO
+}
+
+// applicationConfigChanged reports whether the application configuration was changed
+// from the running one. Changes that do not affect the loaded configuration, such as comments, are ignored.
+func applicationConfigChanged(running, target *config.Config) bool {
+	return !reflect.DeepEqual(running, target)
+}
+
+// skipStagesAsNoOp marks all the visible stages except the rollback one as skipped for the given reason
+// so that the scheduler completes the deployment without executing them.
+func skipStagesAsNoOp(stages []*model.PipelineStage, reason string) {
+	for _, s := range stages {
+		if !s.Visible || s.Name == model.StageRollback.String() {
+			continue
+		}
+		s.Status = model.StageStatus_STAGE_SKIPPED
+		s.StatusReason = reason
+	}
+}
+
+// isNoOpDeployment reports whether all the visible stages of the given pipeline
+// were skipped by the planner as a no-op.
+func isNoOpDeployment(stages []*model.PipelineStage) bool {
+	var found bool
+	for _, s := range stages {
+		if !s.Visible || s.Name == model.StageRollback.String() {
+			continue
+		}
+		if s.Status != model.StageStatus_STAGE_SKIPPED {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+// noOpDeploymentStatusReason returns the status reason of the deployment
+// whose stages were all skipped by the planner.
+func noOpDeploymentStatusReason(stages []*model.PipelineStage) string {
+	for _, s := range stages {
+		if s.Visible && s.StatusReason == configOnlySummary {
+			return configOnlyStatusReason
+		}
+	}
+	return noOpStatusReason
+}
+
+// isNoOpSync reports whether an executor skipped applying the manifests of the deployment
+// because they were identical to the running ones.
+func isNoOpSync(store metadatastore.Getter) bool {
+	v, ok := store.Get(model.MetadataKeyDeploymentNoOpSync)
+	return ok && v == "true"
+}
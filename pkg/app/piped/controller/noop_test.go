@@ -0,0 +1,209 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestIsNoOpCandidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name          string
+		trigger       *model.DeploymentTrigger
+		runningCommit string
+		expected      bool
+	}{
+		{
+			name:          "auto sync",
+			trigger:       &model.DeploymentTrigger{SyncStrategy: model.SyncStrategy_AUTO},
+			runningCommit: "running",
+			expected:      true,
+		},
+		{
+			name:          "first deployment",
+			trigger:       &model.DeploymentTrigger{SyncStrategy: model.SyncStrategy_AUTO},
+			runningCommit: "",
+			expected:      false,
+		},
+		{
+			name:          "forced quick sync",
+			trigger:       &model.DeploymentTrigger{SyncStrategy: model.SyncStrategy_QUICK_SYNC},
+			runningCommit: "running",
+			expected:      false,
+		},
+		{
+			name:          "forced pipeline",
+			trigger:       &model.DeploymentTrigger{SyncStrategy: model.SyncStrategy_PIPELINE},
+			runningCommit: "running",
+			expected:      false,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, isNoOpCandidate(tc.trigger, tc.runningCommit))
+		})
+	}
+}
+
+func TestSkipStagesAsNoOp(t *testing.T) {
+	t.Parallel()
+
+	stages := []*model.PipelineStage{
+		{Id: "stage-0", Name: model.StageK8sCanaryRollout.String(), Visible: true},
+		{Id: "stage-1", Name: model.StageK8sPrimaryRollout.String(), Visible: true},
+		{Id: "rollback", Name: model.StageRollback.String(), Predefined: true},
+	}
+	assert.False(t, isNoOpDeployment(stages))
+
+	skipStagesAsNoOp(stages, noOpSummary)
+	assert.Equal(t, model.StageStatus_STAGE_SKIPPED, stages[0].Status)
+	assert.Equal(t, model.StageStatus_STAGE_SKIPPED, stages[1].Status)
+	assert.Equal(t, model.StageStatus_STAGE_NOT_STARTED_YET, stages[2].Status)
+	assert.True(t, isNoOpDeployment(stages))
+}
+
+func TestIsNoOpDeployment(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		stages   []*model.PipelineStage
+		expected bool
+	}{
+		{
+			name:     "no stage",
+			expected: false,
+		},
+		{
+			name: "only invisible stages",
+			stages: []*model.PipelineStage{
+				{Id: "rollback", Name: model.StageRollback.String(), Status: model.StageStatus_STAGE_SKIPPED},
+			},
+			expected: false,
+		},
+		{
+			name: "partially skipped",
+			stages: []*model.PipelineStage{
+				{Id: "stage-0", Name: model.StageWait.String(), Visible: true, Status: model.StageStatus_STAGE_SKIPPED},
+				{Id: "stage-1", Name: model.StageK8sSync.String(), Visible: true, Status: model.StageStatus_STAGE_SUCCESS},
+			},
+			expected: false,
+		},
+		{
+			name: "all skipped",
+			stages: []*model.PipelineStage{
+				{Id: "stage-0", Name: model.StageWait.String(), Visible: true, Status: model.StageStatus_STAGE_SKIPPED},
+				{Id: "stage-1", Name: model.StageK8sSync.String(), Visible: true, Status: model.StageStatus_STAGE_SKIPPED},
+				{Id: "rollback", Name: model.StageRollback.String()},
+			},
+			expected: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, isNoOpDeployment(tc.stages))
+		})
+	}
+}
+
+type mapStore map[string]string
+
+func (m mapStore) Get(key string) (string, bool) { v, ok := m[key]; return v, ok }
+
+func TestIsNoOpSync(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		metadata map[string]string
+		expected bool
+	}{
+		{
+			name:     "not recorded",
+			expected: false,
+		},
+		{
+			name:     "recorded",
+			metadata: map[string]string{model.MetadataKeyDeploymentNoOpSync: "true"},
+			expected: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, isNoOpSync(mapStore(tc.metadata)))
+		})
+	}
+}
+
+func TestApplicationConfigChanged(t *testing.T) {
+	t.Parallel()
+
+	running := &config.Config{
+		Kind:       config.KindKubernetesApp,
+		APIVersion: config.VersionV1Beta1,
+		KubernetesApplicationSpec: &config.KubernetesApplicationSpec{
+			GenericApplicationSpec: config.GenericApplicationSpec{Name: "simple"},
+		},
+	}
+	same := &config.Config{
+		Kind:       config.KindKubernetesApp,
+		APIVersion: config.VersionV1Beta1,
+		KubernetesApplicationSpec: &config.KubernetesApplicationSpec{
+			GenericApplicationSpec: config.GenericApplicationSpec{Name: "simple"},
+		},
+	}
+	changed := &config.Config{
+		Kind:       config.KindKubernetesApp,
+		APIVersion: config.VersionV1Beta1,
+		KubernetesApplicationSpec: &config.KubernetesApplicationSpec{
+			GenericApplicationSpec: config.GenericApplicationSpec{
+				Name: "simple",
+				Pipeline: &config.DeploymentPipeline{
+					Stages: []config.PipelineStage{{Name: model.StageWait}},
+				},
+			},
+		},
+	}
+
+	assert.False(t, applicationConfigChanged(running, same))
+	assert.True(t, applicationConfigChanged(running, changed))
+}
+
+func TestNoOpDeploymentStatusReason(t *testing.T) {
+	t.Parallel()
+
+	stages := []*model.PipelineStage{
+		{Id: "stage-0", Name: model.StageK8sSync.String(), Visible: true},
+		{Id: "rollback", Name: model.StageRollback.String(), Predefined: true},
+	}
+	skipStagesAsNoOp(stages, noOpSummary)
+	assert.Equal(t, noOpStatusReason, noOpDeploymentStatusReason(stages))
+
+	skipStagesAsNoOp(stages, configOnlySummary)
+	assert.Equal(t, configOnlyStatusReason, noOpDeploymentStatusReason(stages))
+}
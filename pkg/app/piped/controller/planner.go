@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"path/filepath"
 	"time"
 
@@ -59,6 +60,7 @@ type planner struct {
 	plannerRegistry              registry.Registry
 	pipedConfig                  *config.PipedSpec
 	appManifestsCache            cache.Cache
+	deploySourceCache            *deploysource.Cache
 	logger                       *zap.Logger
 	tracer                       trace.Tracer
 
@@ -82,6 +84,7 @@ func newPlanner(
 	sd secretDecrypter,
 	pipedConfig *config.PipedSpec,
 	appManifestsCache cache.Cache,
+	deploySourceCache *deploysource.Cache,
 	logger *zap.Logger,
 	tracerProvider trace.TracerProvider,
 ) *planner {
@@ -107,6 +110,7 @@ func newPlanner(
 		pipedConfig:                  pipedConfig,
 		plannerRegistry:              registry.DefaultRegistry(),
 		appManifestsCache:            appManifestsCache,
+		deploySourceCache:            deploySourceCache,
 		doneDeploymentStatus:         d.Status,
 		cancelledCh:                  make(chan *model.ReportableCommand, 1),
 		nowFunc:                      time.Now,
@@ -182,6 +186,7 @@ func (p *planner) Run(ctx context.Context) error {
 		AppManifestsCache:              p.appManifestsCache,
 		RegexPool:                      regexpool.DefaultPool(),
 		GitClient:                      p.gitClient,
+		APIClient:                      p.apiClient,
 		Logger:                         p.logger,
 	}
 
@@ -190,6 +195,9 @@ func (p *planner) Run(ctx context.Context) error {
 		deploysource.NewGitSourceCloner(p.gitClient, repoCfg, "target", p.deployment.Trigger.Commit.Hash),
 		*p.deployment.GitPath,
 		p.secretDecrypter,
+		deploysource.WithCache(p.deploySourceCache, p.deployment.ApplicationId),
+		deploysource.WithParameters(p.deployment.Trigger.Parameters),
+		deploysource.WithEnv(p.pipedConfig.AppEnv),
 	)
 
 	if p.lastSuccessfulCommitHash != "" {
@@ -201,6 +209,8 @@ func (p *planner) Run(ctx context.Context) error {
 			deploysource.NewGitSourceCloner(p.gitClient, repoCfg, "running", p.lastSuccessfulCommitHash),
 			gp,
 			p.secretDecrypter,
+			deploysource.WithCache(p.deploySourceCache, p.deployment.ApplicationId),
+			deploysource.WithEnv(p.pipedConfig.AppEnv),
 		)
 	}
 
@@ -236,9 +246,86 @@ func (p *planner) Run(ctx context.Context) error {
 		return p.reportDeploymentFailed(ctx, fmt.Sprintf("Unable to plan the deployment (%v)", err))
 	}
 
+	var diff *model.DeploymentDiff
+	if differ, ok := planner.(pln.Differ); ok {
+		diff = p.renderDeploymentDiff(ctx, differ, in)
+	}
+	if diff != nil && diff.NoChange {
+		if summary := p.noOpSummary(ctx, in); summary != "" {
+			p.logger.Info("skip all stages because the artifacts are identical to the running ones", zap.String("summary", summary))
+			skipStagesAsNoOp(out.Stages, summary)
+			out.Summary = summary
+		}
+	}
+
 	span.SetStatus(codes.Ok, "The deployment has been planned")
 	p.doneDeploymentStatus = model.DeploymentStatus_DEPLOYMENT_PLANNED
-	return p.reportDeploymentPlanned(ctx, out)
+	if err := p.reportDeploymentPlanned(ctx, out); err != nil {
+		return err
+	}
+
+	if diff != nil {
+		p.reportDeploymentDiff(ctx, diff)
+	}
+	return nil
+}
+
+// noOpSummary returns the summary of the deployment completed without running any stage
+// since its artifacts are identical to the running ones, or empty when the application
+// is not configured to do so. It is a config-only sync when the application configuration was changed.
+func (p *planner) noOpSummary(ctx context.Context, in pln.Input) string {
+	if !isNoOpCandidate(&in.Trigger, in.MostRecentSuccessfulCommitHash) || in.RunningDSP == nil {
+		return ""
+	}
+	target, err := in.TargetDSP.GetReadOnly(ctx, io.Discard)
+	if err != nil {
+		p.logger.Warn("failed to load the application configuration to check skipIfUnchanged", zap.Error(err))
+		return ""
+	}
+	running, err := in.RunningDSP.GetReadOnly(ctx, io.Discard)
+	if err != nil {
+		p.logger.Warn("failed to load the running application configuration to check skipIfUnchanged", zap.Error(err))
+		return ""
+	}
+
+	opts := target.GenericApplicationConfig.Planner
+	if applicationConfigChanged(running.ApplicationConfig, target.ApplicationConfig) {
+		if opts.SkipIfUnchanged || opts.ConfigOnlySync {
+			return configOnlySummary
+		}
+		return ""
+	}
+	if opts.SkipIfUnchanged {
+		return noOpSummary
+	}
+	return ""
+}
+
+// renderDeploymentDiff renders the diff of this deployment.
+// Since the diff is just informational, any error is only logged.
+func (p *planner) renderDeploymentDiff(ctx context.Context, differ pln.Differ, in pln.Input) *model.DeploymentDiff {
+	diff, err := differ.Diff(ctx, in)
+	if err != nil {
+		p.logger.Warn("failed to render the diff of deployment", zap.Error(err))
+		return nil
+	}
+	return diff
+}
+
+// reportDeploymentDiff saves the rendered diff of this deployment to the control-plane.
+// Since the diff is just informational, any error is only logged.
+func (p *planner) reportDeploymentDiff(ctx context.Context, diff *model.DeploymentDiff) {
+
+	reporter := deploymentDiffReporter{
+		apiClient:         p.apiClient,
+		deploymentID:      p.deployment.Id,
+		runningCommitHash: p.lastSuccessfulCommitHash,
+		targetCommitHash:  p.deployment.Trigger.Commit.Hash,
+		nowFunc:           p.nowFunc,
+	}
+	if err := reporter.ReportDeploymentDiff(ctx, diff); err != nil {
+		p.logger.Warn("failed to report the diff of deployment", zap.Error(err))
+	}
 }
 
 func (p *planner) reportDeploymentPlanned(ctx context.Context, out pln.Output) error {
@@ -256,6 +343,7 @@ func (p *planner) reportDeploymentPlanned(ctx context.Context, out pln.Output) e
 			Stages:                    out.Stages,
 			DeploymentChainId:         p.deployment.DeploymentChainId,
 			DeploymentChainBlockIndex: p.deployment.DeploymentChainBlockIndex,
+			Artifact:                  out.Artifact,
 		}
 	)
 
@@ -59,6 +59,7 @@ type scheduler struct {
 	secretDecrypter     secretDecrypter
 	pipedConfig         *config.PipedSpec
 	appManifestsCache   cache.Cache
+	deploySourceCache   *deploysource.Cache
 	logger              *zap.Logger
 	tracer              trace.Tracer
 
@@ -79,6 +80,10 @@ type scheduler struct {
 	cancelled            bool
 	cancelledCh          chan *model.ReportableCommand
 
+	// Set of IDs of the deployment comments that have been notified.
+	// This is only accessed by the controller goroutine.
+	notifiedComments map[string]struct{}
+
 	nowFunc func() time.Time
 }
 
@@ -96,6 +101,7 @@ func newScheduler(
 	sd secretDecrypter,
 	pipedConfig *config.PipedSpec,
 	appManifestsCache cache.Cache,
+	deploySourceCache *deploysource.Cache,
 	logger *zap.Logger,
 	tracerProvider trace.TracerProvider,
 ) *scheduler {
@@ -123,6 +129,7 @@ func newScheduler(
 		secretDecrypter:      sd,
 		pipedConfig:          pipedConfig,
 		appManifestsCache:    appManifestsCache,
+		deploySourceCache:    deploySourceCache,
 		doneDeploymentStatus: d.Status,
 		cancelledCh:          make(chan *model.ReportableCommand, 1),
 		logger:               logger,
@@ -136,6 +143,13 @@ func newScheduler(
 		s.stageStatuses[stage.Id] = stage.Status
 	}
 
+	// The comments left before this scheduler was created
+	// are treated as notified to avoid sending them again after restarting.
+	s.notifiedComments = make(map[string]struct{}, len(d.Comments))
+	for _, c := range d.Comments {
+		s.notifiedComments[c.Id] = struct{}{}
+	}
+
 	return s
 }
 
@@ -188,6 +202,35 @@ func (s *scheduler) Cancel(cmd model.ReportableCommand) {
 	close(s.cancelledCh)
 }
 
+// NotifyNewComments sends a notification event for each comment of the given deployment
+// that has not been notified yet.
+// The given deployment is the latest one reloaded from the control-plane,
+// so it is used in the events instead of the one this scheduler was created with,
+// which contains neither the new comments nor the current stage statuses.
+func (s *scheduler) NotifyNewComments(d *model.Deployment) {
+	for _, c := range d.Comments {
+		if _, ok := s.notifiedComments[c.Id]; ok {
+			continue
+		}
+		s.notifiedComments[c.Id] = struct{}{}
+
+		users, groups, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_COMMENTED)
+		if err != nil {
+			s.logger.Error("failed to get the list of users or groups", zap.Error(err))
+		}
+
+		s.notifier.Notify(model.NotificationEvent{
+			Type: model.NotificationEventType_EVENT_DEPLOYMENT_COMMENTED,
+			Metadata: &model.NotificationEventDeploymentCommented{
+				Deployment:        d,
+				Comment:           c,
+				MentionedAccounts: users,
+				MentionedGroups:   groups,
+			},
+		})
+	}
+}
+
 // Run starts running the scheduler.
 // It determines what stage should be executed next by which executor.
 // The returning error does not mean that the pipeline was failed,
@@ -252,6 +295,9 @@ func (s *scheduler) Run(ctx context.Context) error {
 		deploysource.NewGitSourceCloner(s.gitClient, repoCfg, "target", s.deployment.Trigger.Commit.Hash),
 		*s.deployment.GitPath,
 		s.secretDecrypter,
+		deploysource.WithCache(s.deploySourceCache, s.deployment.ApplicationId),
+		deploysource.WithParameters(s.deployment.Trigger.Parameters),
+		deploysource.WithEnv(s.pipedConfig.AppEnv),
 	)
 
 	if s.deployment.RunningCommitHash != "" {
@@ -263,6 +309,8 @@ func (s *scheduler) Run(ctx context.Context) error {
 			deploysource.NewGitSourceCloner(s.gitClient, repoCfg, "running", s.deployment.RunningCommitHash),
 			gp,
 			s.secretDecrypter,
+			deploysource.WithCache(s.deploySourceCache, s.deployment.ApplicationId),
+			deploysource.WithEnv(s.pipedConfig.AppEnv),
 		)
 	}
 
@@ -275,6 +323,9 @@ func (s *scheduler) Run(ctx context.Context) error {
 		deploysource.NewGitSourceCloner(s.gitClient, repoCfg, "target", s.deployment.Trigger.Commit.Hash),
 		*s.deployment.GitPath,
 		nil,
+		deploysource.WithCache(s.deploySourceCache, s.deployment.ApplicationId),
+		deploysource.WithParameters(s.deployment.Trigger.Parameters),
+		deploysource.WithEnv(s.pipedConfig.AppEnv),
 	)
 	ds, err := configDSP.GetReadOnly(ctx, io.Discard)
 	if err != nil {
@@ -304,14 +355,22 @@ func (s *scheduler) Run(ctx context.Context) error {
 		span.End()
 	}()
 
-	timer := time.NewTimer(s.genericApplicationConfig.Timeout.Duration())
+	// The deadline is calculated from the time the deployment was planned
+	// to keep enforcing the timeout after this piped has been restarted.
+	timeout := s.deploymentTimeout()
+	timer := time.NewTimer(remainingTimeout(s.deployment.Stages, timeout, s.nowFunc()))
 	defer timer.Stop()
 
+	// All stages were skipped by the planner because nothing but the application configuration was changed.
+	if isNoOpDeployment(s.deployment.Stages) {
+		statusReason = noOpDeploymentStatusReason(s.deployment.Stages)
+	}
+
 	// Iterate all the stages and execute the uncompleted ones.
 	for i, ps := range s.deployment.Stages {
 		lastStage = s.deployment.Stages[i]
 
-		if ps.Status == model.StageStatus_STAGE_SUCCESS {
+		if ps.Status == model.StageStatus_STAGE_SUCCESS || ps.Status == model.StageStatus_STAGE_SKIPPED {
 			continue
 		}
 		if !ps.Visible || ps.Name == model.StageRollback.String() {
@@ -400,6 +459,9 @@ func (s *scheduler) Run(ctx context.Context) error {
 		if result == model.StageStatus_STAGE_CANCELLED {
 			deploymentStatus = model.DeploymentStatus_DEPLOYMENT_CANCELLED
 			statusReason = fmt.Sprintf("Cancelled by %s while executing stage %s", cancelCommander, ps.Id)
+			if cancelCommand != nil && cancelCommand.Metadata[model.MetadataKeyComment] != "" {
+				statusReason = fmt.Sprintf("%s: %s", statusReason, cancelCommand.Metadata[model.MetadataKeyComment])
+			}
 			break
 		}
 
@@ -407,7 +469,8 @@ func (s *scheduler) Run(ctx context.Context) error {
 			deploymentStatus = model.DeploymentStatus_DEPLOYMENT_FAILURE
 			// The stage was failed because of timing out.
 			if sig.Signal() == executor.StopSignalTimeout {
-				statusReason = fmt.Sprintf("Timed out while executing stage %s", ps.Id)
+				statusReason = fmt.Sprintf("Timed out after %s while executing stage %s", timeout, ps.Id)
+				s.notifyDeploymentTimedOut(timeout)
 			} else {
 				statusReason = fmt.Sprintf("Failed while executing stage %s", ps.Id)
 			}
@@ -485,10 +548,17 @@ func (s *scheduler) Run(ctx context.Context) error {
 		}
 	}
 
+	// Applying the manifests was skipped by the executor because nothing was changed.
+	summary := s.deployment.Summary
+	if deploymentStatus == model.DeploymentStatus_DEPLOYMENT_SUCCESS && isNoOpSync(s.metadataStore.Shared()) {
+		statusReason = noOpSyncStatusReason
+		summary = noOpSyncSummary
+	}
+
 	if deploymentStatus.IsCompleted() {
 		err := s.reportDeploymentCompleted(ctx, deploymentStatus, statusReason, cancelCommander)
-		if err == nil && deploymentStatus == model.DeploymentStatus_DEPLOYMENT_SUCCESS {
-			s.reportMostRecentlySuccessfulDeployment(ctx)
+		if err == nil && deploymentStatus == model.DeploymentStatus_DEPLOYMENT_SUCCESS && !s.deployment.Trigger.DryRun {
+			s.reportMostRecentlySuccessfulDeployment(ctx, summary)
 		}
 	}
 
@@ -590,23 +660,71 @@ func (s *scheduler) executeStage(sig executor.StopSignal, ps model.PipelineStage
 		store:         s.analysisResultStore,
 		applicationID: app.Id,
 	}
+	ddReporter := deploymentDiffReporter{
+		apiClient:         s.apiClient,
+		deploymentID:      s.deployment.Id,
+		runningCommitHash: s.deployment.RunningCommitHash,
+		targetCommitHash:  s.deployment.Trigger.Commit.Hash,
+		nowFunc:           s.nowFunc,
+	}
+	saUploader := stageArtifactUploader{
+		apiClient:    s.apiClient,
+		deploymentID: s.deployment.Id,
+		stageID:      ps.Id,
+	}
+	drGetter := deploymentRiskGetter{
+		apiClient:    s.apiClient,
+		deploymentID: s.deployment.Id,
+	}
+	eRegisterer := eventRegisterer{
+		apiClient:  s.apiClient,
+		deployment: s.deployment,
+	}
 	input := executor.Input{
-		Stage:                 &ps,
-		StageConfig:           stageConfig,
-		Deployment:            s.deployment,
-		Application:           app,
-		PipedConfig:           s.pipedConfig,
-		TargetDSP:             s.targetDSP,
-		RunningDSP:            s.runningDSP,
-		GitClient:             s.gitClient,
-		CommandLister:         cmdLister,
-		LogPersister:          lp,
-		MetadataStore:         s.metadataStore,
-		AppManifestsCache:     s.appManifestsCache,
-		AppLiveResourceLister: alrLister,
-		AnalysisResultStore:   aStore,
-		Logger:                s.logger,
-		Notifier:              s.notifier,
+		Stage:                  &ps,
+		StageConfig:            stageConfig,
+		Deployment:             s.deployment,
+		Application:            app,
+		PipedConfig:            s.pipedConfig,
+		TargetDSP:              s.targetDSP,
+		RunningDSP:             s.runningDSP,
+		GitClient:              s.gitClient,
+		CommandLister:          cmdLister,
+		LogPersister:           lp,
+		MetadataStore:          s.metadataStore,
+		AppManifestsCache:      s.appManifestsCache,
+		AppLiveResourceLister:  alrLister,
+		AnalysisResultStore:    aStore,
+		DeploymentDiffReporter: ddReporter,
+		StageArtifactUploader:  saUploader,
+		DeploymentRiskGetter:   drGetter,
+		EventRegisterer:        eRegisterer,
+		Logger:                 s.logger,
+		Notifier:               s.notifier,
+	}
+
+	// Skip the stage if it has already been completed in the retried deployment.
+	if r := s.succeededInRetriedDeployment(&ps); r != nil {
+		if err := s.metadataStore.Stage(ps.Id).Put(ctx, model.MetadataKeyStageReusedFrom, r.DeploymentId); err != nil {
+			s.logger.Error("failed to store the reused deployment to the stage metadata", zap.Error(err))
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if err := s.reportStageStatus(ctx, ps.Id, model.StageStatus_STAGE_SKIPPED, ps.Requires); err != nil {
+			s.logger.Error("failed to report stage status", zap.Error(err))
+			return model.StageStatus_STAGE_FAILURE
+		}
+		lp.Infof("The stage was skipped because it had been completed successfully in the deployment %s (attempt %d).", r.DeploymentId, r.Attempt)
+		return model.StageStatus_STAGE_SKIPPED
+	}
+
+	// Skip the stage if it cannot be simulated in the dry-run deployment.
+	if s.notDryRunnable(&ps) {
+		if err := s.reportStageStatus(ctx, ps.Id, model.StageStatus_STAGE_SKIPPED, ps.Requires); err != nil {
+			s.logger.Error("failed to report stage status", zap.Error(err))
+			return model.StageStatus_STAGE_FAILURE
+		}
+		lp.Info("The stage was skipped because it does not support the dry-run mode.")
+		return model.StageStatus_STAGE_SKIPPED
 	}
 
 	// Skip the stage if needed based on the skip config.
@@ -792,6 +910,64 @@ func (s *scheduler) reportDeploymentCompleted(ctx context.Context, status model.
 	return err
 }
 
+// deploymentTimeout returns the maximum length of time to execute this deployment.
+// The pipeline-level timeout takes precedence over the application-level one
+// when the deployment is running the pipeline.
+func (s *scheduler) deploymentTimeout() time.Duration {
+	if p := s.genericApplicationConfig.Pipeline; p != nil && p.Timeout > 0 && isPipelineDeployment(s.deployment.Stages) {
+		return p.Timeout.Duration()
+	}
+	return s.genericApplicationConfig.Timeout.Duration()
+}
+
+// isPipelineDeployment reports whether the given stages were planned from the pipeline configuration
+// instead of being the predefined ones such as quick sync.
+func isPipelineDeployment(stages []*model.PipelineStage) bool {
+	for _, s := range stages {
+		if !s.Predefined {
+			return true
+		}
+	}
+	return false
+}
+
+// remainingTimeout returns the remaining time until the given timeout is exceeded,
+// counting from the time the stages were planned.
+func remainingTimeout(stages []*model.PipelineStage, timeout time.Duration, now time.Time) time.Duration {
+	var plannedAt int64
+	for _, s := range stages {
+		if s.CreatedAt > 0 && (plannedAt == 0 || s.CreatedAt < plannedAt) {
+			plannedAt = s.CreatedAt
+		}
+	}
+	if plannedAt == 0 {
+		return timeout
+	}
+
+	remaining := time.Unix(plannedAt, 0).Add(timeout).Sub(now)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+func (s *scheduler) notifyDeploymentTimedOut(timeout time.Duration) {
+	users, groups, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_TIMED_OUT)
+	if err != nil {
+		s.logger.Error("failed to get the list of users or groups", zap.Error(err))
+	}
+
+	s.notifier.Notify(model.NotificationEvent{
+		Type: model.NotificationEventType_EVENT_DEPLOYMENT_TIMED_OUT,
+		Metadata: &model.NotificationEventDeploymentTimedOut{
+			Deployment:        s.deployment,
+			Timeout:           timeout.String(),
+			MentionedAccounts: users,
+			MentionedGroups:   groups,
+		},
+	})
+}
+
 // getApplicationNotificationMentions returns the list of users groups who should be mentioned in the notification.
 func (s *scheduler) getApplicationNotificationMentions(event model.NotificationEventType) ([]string, []string, error) {
 	n, ok := s.metadataStore.Shared().Get(model.MetadataKeyDeploymentNotification)
@@ -806,7 +982,7 @@ func (s *scheduler) getApplicationNotificationMentions(event model.NotificationE
 	return notification.FindSlackUsers(event), notification.FindSlackGroups(event), nil
 }
 
-func (s *scheduler) reportMostRecentlySuccessfulDeployment(ctx context.Context) error {
+func (s *scheduler) reportMostRecentlySuccessfulDeployment(ctx context.Context, summary string) error {
 	var (
 		err error
 		req = &pipedservice.ReportApplicationMostRecentDeploymentRequest{
@@ -815,7 +991,7 @@ func (s *scheduler) reportMostRecentlySuccessfulDeployment(ctx context.Context)
 			Deployment: &model.ApplicationDeploymentReference{
 				DeploymentId:   s.deployment.Id,
 				Trigger:        s.deployment.Trigger,
-				Summary:        s.deployment.Summary,
+				Summary:        summary,
 				Version:        s.deployment.Version,
 				Versions:       s.deployment.Versions,
 				ConfigFilename: s.deployment.GitPath.GetApplicationConfigFilename(),
@@ -859,6 +1035,77 @@ func (a appAnalysisResultStore) PutLatestAnalysisResult(ctx context.Context, ana
 	return a.store.PutLatestAnalysisResult(ctx, a.applicationID, analysisResult)
 }
 
+type deploymentDiffReporter struct {
+	apiClient         apiClient
+	deploymentID      string
+	runningCommitHash string
+	targetCommitHash  string
+	nowFunc           func() time.Time
+}
+
+func (r deploymentDiffReporter) ReportDeploymentDiff(ctx context.Context, diff *model.DeploymentDiff) error {
+	diff.RunningCommitHash = r.runningCommitHash
+	diff.TargetCommitHash = r.targetCommitHash
+	diff.CreatedAt = r.nowFunc().Unix()
+
+	_, err := r.apiClient.ReportDeploymentDiff(ctx, &pipedservice.ReportDeploymentDiffRequest{
+		DeploymentId: r.deploymentID,
+		Diff:         diff,
+	})
+	return err
+}
+
+type stageArtifactUploader struct {
+	apiClient    apiClient
+	deploymentID string
+	stageID      string
+}
+
+func (u stageArtifactUploader) UploadStageArtifact(ctx context.Context, name string, content []byte) error {
+	_, err := u.apiClient.UploadStageArtifact(ctx, &pipedservice.UploadStageArtifactRequest{
+		DeploymentId: u.deploymentID,
+		StageId:      u.stageID,
+		Name:         name,
+		Content:      content,
+	})
+	return err
+}
+
+type eventRegisterer struct {
+	apiClient  apiClient
+	deployment *model.Deployment
+}
+
+// RegisterEvent registers the event with the contexts telling which deployment produced it.
+func (r eventRegisterer) RegisterEvent(ctx context.Context, name, data string, labels map[string]string) error {
+	_, err := r.apiClient.RegisterEvent(ctx, &pipedservice.RegisterEventRequest{
+		DeploymentId: r.deployment.Id,
+		Name:         name,
+		Data:         data,
+		Labels:       labels,
+		Contexts: map[string]string{
+			"Source-Application-Name": r.deployment.ApplicationName,
+			"Source-Deployment-Id":    r.deployment.Id,
+		},
+	})
+	return err
+}
+
+type deploymentRiskGetter struct {
+	apiClient    apiClient
+	deploymentID string
+}
+
+func (g deploymentRiskGetter) GetDeploymentRisk(ctx context.Context) (*model.DeploymentRisk, error) {
+	resp, err := g.apiClient.GetDeploymentRisk(ctx, &pipedservice.GetDeploymentRiskRequest{
+		DeploymentId: g.deploymentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Risk, nil
+}
+
 // notifyStageStartEvent sends notification evnet STAGE_STARTED
 func (s *scheduler) notifyStageStartEvent(stage *model.PipelineStage) {
 	s.notifier.Notify(model.NotificationEvent{
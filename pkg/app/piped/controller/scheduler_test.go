@@ -0,0 +1,181 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/metadatastore"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestDeploymentTimeout(t *testing.T) {
+	t.Parallel()
+
+	pipelineStages := []*model.PipelineStage{
+		{Id: "stage-0", Name: model.StageK8sCanaryRollout.String()},
+		{Id: "rollback", Name: model.StageRollback.String(), Predefined: true},
+	}
+	quickSyncStages := []*model.PipelineStage{
+		{Id: "sync", Name: model.StageK8sSync.String(), Predefined: true},
+		{Id: "rollback", Name: model.StageRollback.String(), Predefined: true},
+	}
+
+	testcases := []struct {
+		name     string
+		cfg      config.GenericApplicationSpec
+		stages   []*model.PipelineStage
+		expected time.Duration
+	}{
+		{
+			name: "no pipeline",
+			cfg: config.GenericApplicationSpec{
+				Timeout: config.Duration(time.Hour),
+			},
+			stages:   quickSyncStages,
+			expected: time.Hour,
+		},
+		{
+			name: "pipeline without timeout",
+			cfg: config.GenericApplicationSpec{
+				Timeout:  config.Duration(time.Hour),
+				Pipeline: &config.DeploymentPipeline{},
+			},
+			stages:   pipelineStages,
+			expected: time.Hour,
+		},
+		{
+			name: "pipeline timeout is used for pipeline deployment",
+			cfg: config.GenericApplicationSpec{
+				Timeout:  config.Duration(time.Hour),
+				Pipeline: &config.DeploymentPipeline{Timeout: config.Duration(10 * time.Minute)},
+			},
+			stages:   pipelineStages,
+			expected: 10 * time.Minute,
+		},
+		{
+			name: "pipeline timeout is not used for quick sync",
+			cfg: config.GenericApplicationSpec{
+				Timeout:  config.Duration(time.Hour),
+				Pipeline: &config.DeploymentPipeline{Timeout: config.Duration(10 * time.Minute)},
+			},
+			stages:   quickSyncStages,
+			expected: time.Hour,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			s := &scheduler{
+				deployment:               &model.Deployment{Stages: tc.stages},
+				genericApplicationConfig: tc.cfg,
+			}
+			assert.Equal(t, tc.expected, s.deploymentTimeout())
+		})
+	}
+}
+
+func TestRemainingTimeout(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	testcases := []struct {
+		name     string
+		stages   []*model.PipelineStage
+		timeout  time.Duration
+		expected time.Duration
+	}{
+		{
+			name:     "no planned stages",
+			timeout:  time.Hour,
+			expected: time.Hour,
+		},
+		{
+			name: "counted from the earliest stage",
+			stages: []*model.PipelineStage{
+				{Id: "stage-0", CreatedAt: now.Add(-10 * time.Minute).Unix()},
+				{Id: "stage-1", CreatedAt: now.Add(-20 * time.Minute).Unix()},
+			},
+			timeout:  time.Hour,
+			expected: 40 * time.Minute,
+		},
+		{
+			name: "already exceeded",
+			stages: []*model.PipelineStage{
+				{Id: "stage-0", CreatedAt: now.Add(-2 * time.Hour).Unix()},
+			},
+			timeout:  time.Hour,
+			expected: 0,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, remainingTimeout(tc.stages, tc.timeout, now))
+		})
+	}
+}
+
+type fakeNotifier struct {
+	events []model.NotificationEvent
+}
+
+func (n *fakeNotifier) Notify(event model.NotificationEvent) {
+	n.events = append(n.events, event)
+}
+
+func TestNotifyNewComments(t *testing.T) {
+	t.Parallel()
+
+	old := &model.Deployment{
+		Id:       "deployment-1",
+		Status:   model.DeploymentStatus_DEPLOYMENT_PLANNED,
+		Comments: []*model.DeploymentComment{{Id: "comment-1", Comment: "left before starting"}},
+	}
+	n := &fakeNotifier{}
+	s := &scheduler{
+		deployment:       old,
+		notifier:         n,
+		metadataStore:    metadatastore.NewMetadataStore(nil, old),
+		notifiedComments: map[string]struct{}{"comment-1": {}},
+		logger:           zap.NewNop(),
+	}
+
+	reloaded := &model.Deployment{
+		Id:     "deployment-1",
+		Status: model.DeploymentStatus_DEPLOYMENT_RUNNING,
+		Comments: []*model.DeploymentComment{
+			{Id: "comment-1", Comment: "left before starting"},
+			{Id: "comment-2", Comment: "paused for DB check"},
+		},
+	}
+	s.NotifyNewComments(reloaded)
+	// The same comments must not be notified again.
+	s.NotifyNewComments(reloaded)
+
+	require.Len(t, n.events, 1)
+	md, ok := n.events[0].Metadata.(*model.NotificationEventDeploymentCommented)
+	require.True(t, ok)
+	assert.Equal(t, "comment-2", md.Comment.Id)
+	assert.Same(t, reloaded, md.Deployment)
+}
@@ -67,6 +67,54 @@ func (s *scheduler) shouldSkipStage(ctx context.Context, in executor.Input) (ski
 	return skip, err
 }
 
+// succeededInRetriedDeployment returns the successful result of the stage which can be reused
+// because it was completed successfully in an earlier attempt of this retry deployment.
+// Only the stages declared as idempotent by their executors can be skipped, and only while
+// all of their preceding stages are also reused, since a re-executed stage invalidates the
+// results of the stages following it.
+func (s *scheduler) succeededInRetriedDeployment(ps *model.PipelineStage) *model.DeploymentTriggerRetry_SucceededStage {
+	retry := s.deployment.Trigger.GetRetry()
+	if retry == nil || ps.Rollback {
+		return nil
+	}
+	for _, st := range s.deployment.Stages {
+		if st.Rollback {
+			continue
+		}
+		r := reusableResult(retry, st)
+		if r == nil || !s.executorRegistry.IsIdempotent(model.Stage(st.Name)) {
+			return nil
+		}
+		if st.Id == ps.Id {
+			return r
+		}
+	}
+	return nil
+}
+
+// reusableResult returns the successful result recorded for the same stage in an earlier attempt.
+func reusableResult(retry *model.DeploymentTriggerRetry, ps *model.PipelineStage) *model.DeploymentTriggerRetry_SucceededStage {
+	for _, r := range retry.SucceededStages {
+		if r.Id == ps.Id && r.Name == ps.Name && r.Index == ps.Index && r.Attempt < retry.Attempt {
+			return r
+		}
+	}
+	return nil
+}
+
+// notDryRunnable checks whether the stage must be skipped because it cannot be
+// simulated in this dry-run deployment.
+// The rollback stages are never executed since nothing was deployed by a dry-run deployment.
+func (s *scheduler) notDryRunnable(ps *model.PipelineStage) bool {
+	if !s.deployment.Trigger.DryRun {
+		return false
+	}
+	if ps.Rollback {
+		return true
+	}
+	return !s.executorRegistry.IsDryRunnable(model.Stage(ps.Name))
+}
+
 // skipByCommitMessagePrefixes returns true if the commit message has ANY one of the specified prefixes.
 func skipByCommitMessagePrefixes(ctx context.Context, opt config.SkipOptions, repo git.Repo, targetRev string) (skip bool, err error) {
 	if len(opt.CommitMessagePrefixes) == 0 {
@@ -16,14 +16,17 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/registry"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/git"
 	"github.com/pipe-cd/pipecd/pkg/git/gittest"
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 func TestSkipByCommitMessagePrefixes(t *testing.T) {
@@ -156,3 +159,162 @@ func TestSkipByPathPattern(t *testing.T) {
 		})
 	}
 }
+
+func TestSucceededInRetriedDeployment(t *testing.T) {
+	t.Parallel()
+	stages := []*model.PipelineStage{
+		{Id: "stage-0", Name: model.StageWait.String(), Index: 0},
+		{Id: "stage-1", Name: model.StageWait.String(), Index: 1},
+		{Id: "stage-2", Name: model.StageWaitApproval.String(), Index: 2},
+		{Id: "stage-3", Name: model.StageWait.String(), Index: 3},
+		{Id: "stage-4", Name: model.StageWait.String(), Index: 4},
+		{Id: "stage-5", Name: model.StageRollback.String(), Index: 5, Rollback: true},
+	}
+	succeeded := func(id string, index int32, name model.Stage, attempt int32) *model.DeploymentTriggerRetry_SucceededStage {
+		return &model.DeploymentTriggerRetry_SucceededStage{
+			Id:           id,
+			Name:         name.String(),
+			Index:        index,
+			DeploymentId: fmt.Sprintf("deployment-%d", attempt),
+			Attempt:      attempt,
+		}
+	}
+	retry := &model.DeploymentTriggerRetry{
+		DeploymentId: "deployment-1",
+		Attempt:      2,
+		SucceededStages: []*model.DeploymentTriggerRetry_SucceededStage{
+			succeeded("stage-0", 0, model.StageWait, 0),
+			succeeded("stage-1", 1, model.StageWait, 1),
+			succeeded("stage-2", 2, model.StageWaitApproval, 1),
+			succeeded("stage-3", 3, model.StageWait, 1),
+		},
+	}
+	testcases := []struct {
+		name     string
+		retry    *model.DeploymentTriggerRetry
+		stage    *model.PipelineStage
+		expected *model.DeploymentTriggerRetry_SucceededStage
+	}{
+		{
+			name:  "not a retry deployment",
+			stage: stages[0],
+		},
+		{
+			name:     "idempotent stage succeeded in the original deployment",
+			retry:    retry,
+			stage:    stages[0],
+			expected: retry.SucceededStages[0],
+		},
+		{
+			name:     "idempotent stage succeeded in the retried deployment",
+			retry:    retry,
+			stage:    stages[1],
+			expected: retry.SucceededStages[1],
+		},
+		{
+			name:  "non-idempotent stage succeeded in the retried deployment",
+			retry: retry,
+			stage: stages[2],
+		},
+		{
+			name:  "idempotent stage following a re-executed stage",
+			retry: retry,
+			stage: stages[3],
+		},
+		{
+			name:  "idempotent stage not succeeded in the retried deployment",
+			retry: retry,
+			stage: stages[4],
+		},
+		{
+			name:  "rollback stage",
+			retry: retry,
+			stage: stages[5],
+		},
+		{
+			name: "stage changed since the retried deployment",
+			retry: &model.DeploymentTriggerRetry{
+				DeploymentId: "deployment-0",
+				Attempt:      1,
+				SucceededStages: []*model.DeploymentTriggerRetry_SucceededStage{
+					succeeded("stage-0", 0, model.StageAnalysis, 0),
+				},
+			},
+			stage: stages[0],
+		},
+		{
+			name: "result recorded by a later attempt",
+			retry: &model.DeploymentTriggerRetry{
+				DeploymentId: "deployment-0",
+				Attempt:      1,
+				SucceededStages: []*model.DeploymentTriggerRetry_SucceededStage{
+					succeeded("stage-0", 0, model.StageWait, 1),
+				},
+			},
+			stage: stages[0],
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			s := &scheduler{
+				deployment: &model.Deployment{
+					Trigger: &model.DeploymentTrigger{Retry: tc.retry},
+					Stages:  stages,
+				},
+				executorRegistry: registry.DefaultRegistry(),
+			}
+			assert.Equal(t, tc.expected, s.succeededInRetriedDeployment(tc.stage))
+		})
+	}
+}
+
+func TestNotDryRunnable(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		name   string
+		dryRun bool
+		stage  *model.PipelineStage
+		skip   bool
+	}{
+		{
+			name:  "not a dry-run deployment",
+			stage: &model.PipelineStage{Id: "stage-0", Name: model.StageScriptRun.String()},
+			skip:  false,
+		},
+		{
+			name:   "dry-runnable stage",
+			dryRun: true,
+			stage:  &model.PipelineStage{Id: "stage-0", Name: model.StageK8sCanaryRollout.String()},
+			skip:   false,
+		},
+		{
+			name:   "stage not supporting dry-run",
+			dryRun: true,
+			stage:  &model.PipelineStage{Id: "stage-0", Name: model.StageScriptRun.String()},
+			skip:   true,
+		},
+		{
+			name:   "rollback stage",
+			dryRun: true,
+			stage:  &model.PipelineStage{Id: "stage-0", Name: model.StageRollback.String(), Rollback: true},
+			skip:   true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			s := &scheduler{
+				deployment: &model.Deployment{
+					Trigger: &model.DeploymentTrigger{DryRun: tc.dryRun},
+				},
+				executorRegistry: registry.DefaultRegistry(),
+			}
+			assert.Equal(t, tc.skip, s.notDryRunnable(tc.stage))
+		})
+	}
+}
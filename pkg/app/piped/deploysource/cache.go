@@ -0,0 +1,265 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploysource
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// Cache stores the prepared deploy sources keyed by application and commit
+// so that the planner, all stages and the rollback of a deployment can reuse them
+// instead of cloning and processing the same commit again.
+// Each entry is verified by the digest of its files before being reused,
+// and the least recently used entries are evicted when the cache is full.
+// The sources containing decrypted secrets are never stored so that no plaintext secret is left on disk.
+type Cache struct {
+	dir        string
+	maxEntries int
+
+	entries map[string]*cacheEntry
+	// The keys of all entries ordered from the least to the most recently used.
+	keys []string
+	// The locks held while preparing the source of each key.
+	keyLocks map[string]*keyLock
+	mu       sync.Mutex
+}
+
+type cacheEntry struct {
+	repoDir                  string
+	digest                   string
+	applicationConfig        *config.Config
+	genericApplicationConfig config.GenericApplicationSpec
+
+	// The number of the loads copying the files of this entry.
+	// The files are deleted when the last one finishes after the entry was removed.
+	readers int
+	removed bool
+}
+
+type keyLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// NewCache creates a new deploy source cache storing its data inside the given directory.
+func NewCache(dir string, maxEntries int) *Cache {
+	return &Cache{
+		dir:        dir,
+		maxEntries: maxEntries,
+		entries:    make(map[string]*cacheEntry, maxEntries),
+		keyLocks:   make(map[string]*keyLock),
+	}
+}
+
+func makeCacheKey(appID, revision, configFilePath string) string {
+	return fmt.Sprintf("%s/%s/%s", appID, revision, configFilePath)
+}
+
+// lock blocks until no other provider is preparing the source of the given key,
+// so that the same commit is cloned and processed only once.
+// The returned function must be called to release the lock.
+func (c *Cache) lock(key string) func() {
+	c.mu.Lock()
+	l, ok := c.keyLocks[key]
+	if !ok {
+		l = &keyLock{}
+		c.keyLocks[key] = l
+	}
+	l.refs++
+	c.mu.Unlock()
+
+	l.mu.Lock()
+	return func() {
+		l.mu.Unlock()
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if l.refs--; l.refs == 0 {
+			delete(c.keyLocks, key)
+		}
+	}
+}
+
+// load copies the cached source of the given key to dest.
+// The entry is evicted and false is returned when its files were modified after being stored.
+// The files are copied without holding the lock of the whole cache so that the other keys are not blocked.
+func (c *Cache) load(key, dest string) (*cacheEntry, bool) {
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if !ok {
+		c.mu.Unlock()
+		return nil, false
+	}
+	e.readers++
+	c.mu.Unlock()
+
+	digest, err := digestDir(e.repoDir)
+	valid := err == nil && digest == e.digest
+	if valid {
+		valid = copyDir(e.repoDir, dest) == nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e.readers--
+	if digest != e.digest && c.entries[key] == e {
+		c.remove(key)
+	}
+	if e.removed && e.readers == 0 {
+		os.RemoveAll(filepath.Dir(e.repoDir))
+	}
+	if !valid {
+		return nil, false
+	}
+	if c.entries[key] == e {
+		c.touch(key)
+	}
+	return e, true
+}
+
+// store copies the given prepared source into the cache.
+func (c *Cache) store(key string, ds *DeploySource) error {
+	c.mu.Lock()
+	if _, ok := c.entries[key]; ok {
+		c.touch(key)
+		c.mu.Unlock()
+		return nil
+	}
+	c.mu.Unlock()
+
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return err
+	}
+	dir, err := os.MkdirTemp(c.dir, "deploysource")
+	if err != nil {
+		return err
+	}
+
+	repoDir := filepath.Join(dir, "repo")
+	if err := copyDir(ds.RepoDir, repoDir); err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+	digest, err := digestDir(repoDir)
+	if err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; ok {
+		// Another provider has already stored the same source.
+		os.RemoveAll(dir)
+		c.touch(key)
+		return nil
+	}
+	c.entries[key] = &cacheEntry{
+		repoDir:                  repoDir,
+		digest:                   digest,
+		applicationConfig:        ds.ApplicationConfig,
+		genericApplicationConfig: ds.GenericApplicationConfig,
+	}
+	c.keys = append(c.keys, key)
+
+	for len(c.keys) > c.maxEntries {
+		c.remove(c.keys[0])
+	}
+	return nil
+}
+
+// touch marks the given key as the most recently used one.
+func (c *Cache) touch(key string) {
+	for i, k := range c.keys {
+		if k == key {
+			c.keys = append(c.keys[:i], c.keys[i+1:]...)
+			break
+		}
+	}
+	c.keys = append(c.keys, key)
+}
+
+// remove deletes the entry of the given key.
+// Its files are deleted as well unless they are being copied by some loads.
+func (c *Cache) remove(key string) {
+	e, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	delete(c.entries, key)
+	for i, k := range c.keys {
+		if k == key {
+			c.keys = append(c.keys[:i], c.keys[i+1:]...)
+			break
+		}
+	}
+	e.removed = true
+	if e.readers == 0 {
+		os.RemoveAll(filepath.Dir(e.repoDir))
+	}
+}
+
+func copyDir(src, dest string) error {
+	out, err := exec.Command("cp", "-rf", src, dest).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to copy %s to %s (%w, %s)", src, dest, err, string(out))
+	}
+	return nil
+}
+
+// digestDir calculates the digest of all files inside the given directory except the .git directory.
+func digestDir(dir string) (string, error) {
+	h := sha256.New()
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "%s\x00", rel)
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(h, f)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploysource
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func newTestSource(t *testing.T, content string) *DeploySource {
+	t.Helper()
+
+	repoDir := filepath.Join(t.TempDir(), "repo")
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, "app"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "app", "deployment.yaml"), []byte(content), 0600))
+
+	return &DeploySource{
+		RepoDir:           repoDir,
+		AppDir:            filepath.Join(repoDir, "app"),
+		ApplicationConfig: &config.Config{Kind: config.KindKubernetesApp},
+	}
+}
+
+func TestCacheStoreAndLoad(t *testing.T) {
+	t.Parallel()
+
+	c := NewCache(t.TempDir(), 2)
+	key := makeCacheKey("app-1", "commit-1", "app/app.pipecd.yaml")
+
+	_, ok := c.load(key, filepath.Join(t.TempDir(), "repo"))
+	assert.False(t, ok)
+
+	ds := newTestSource(t, "replicas: 2")
+	require.NoError(t, c.store(key, ds))
+
+	dest := filepath.Join(t.TempDir(), "repo")
+	e, ok := c.load(key, dest)
+	require.True(t, ok)
+	assert.Equal(t, ds.ApplicationConfig, e.applicationConfig)
+
+	data, err := os.ReadFile(filepath.Join(dest, "app", "deployment.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "replicas: 2", string(data))
+
+	// Modifying the loaded copy must not affect the cached one.
+	require.NoError(t, os.WriteFile(filepath.Join(dest, "app", "deployment.yaml"), []byte("replicas: 3"), 0600))
+	_, ok = c.load(key, filepath.Join(t.TempDir(), "repo"))
+	assert.True(t, ok)
+}
+
+func TestCacheLoadTamperedEntry(t *testing.T) {
+	t.Parallel()
+
+	c := NewCache(t.TempDir(), 2)
+	key := makeCacheKey("app-1", "commit-1", "app/app.pipecd.yaml")
+	require.NoError(t, c.store(key, newTestSource(t, "replicas: 2")))
+
+	e := c.entries[key]
+	require.NoError(t, os.WriteFile(filepath.Join(e.repoDir, "app", "deployment.yaml"), []byte("replicas: 3"), 0600))
+
+	_, ok := c.load(key, filepath.Join(t.TempDir(), "repo"))
+	assert.False(t, ok)
+	assert.NotContains(t, c.entries, key)
+
+	_, err := os.Stat(e.repoDir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestCacheEviction(t *testing.T) {
+	t.Parallel()
+
+	c := NewCache(t.TempDir(), 2)
+	var (
+		key1 = makeCacheKey("app-1", "commit-1", "app.pipecd.yaml")
+		key2 = makeCacheKey("app-1", "commit-2", "app.pipecd.yaml")
+		key3 = makeCacheKey("app-2", "commit-1", "app.pipecd.yaml")
+	)
+	require.NoError(t, c.store(key1, newTestSource(t, "a")))
+	require.NoError(t, c.store(key2, newTestSource(t, "b")))
+
+	// Loading key1 makes key2 the least recently used one.
+	_, ok := c.load(key1, filepath.Join(t.TempDir(), "repo"))
+	require.True(t, ok)
+
+	require.NoError(t, c.store(key3, newTestSource(t, "c")))
+	assert.Equal(t, []string{key1, key3}, c.keys)
+	assert.NotContains(t, c.entries, key2)
+}
+
+func TestCacheRemoveWhileLoading(t *testing.T) {
+	t.Parallel()
+
+	c := NewCache(t.TempDir(), 2)
+	key := makeCacheKey("app-1", "commit-1", "app.pipecd.yaml")
+	require.NoError(t, c.store(key, newTestSource(t, "a")))
+
+	// The files are kept while being copied by a load.
+	e := c.entries[key]
+	e.readers++
+	c.remove(key)
+	_, err := os.Stat(e.repoDir)
+	require.NoError(t, err)
+
+	e.readers--
+	_, ok := c.load(key, filepath.Join(t.TempDir(), "repo"))
+	assert.False(t, ok)
+}
+
+func TestCacheLock(t *testing.T) {
+	t.Parallel()
+
+	c := NewCache(t.TempDir(), 2)
+	key := makeCacheKey("app-1", "commit-1", "app.pipecd.yaml")
+
+	unlock := c.lock(key)
+	var (
+		locked = make(chan struct{})
+		done   = make(chan struct{})
+	)
+	go func() {
+		unlock := c.lock(key)
+		close(locked)
+		unlock()
+		close(done)
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("the lock of the same key must not be acquired twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// The lock of another key is not blocked.
+	c.lock(makeCacheKey("app-2", "commit-1", "app.pipecd.yaml"))()
+
+	unlock()
+	<-locked
+	<-done
+	assert.Empty(t, c.keyLocks)
+}
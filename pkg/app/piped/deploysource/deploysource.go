@@ -53,6 +53,10 @@ type provider struct {
 	revision        string
 	appGitPath      model.ApplicationGitPath
 	secretDecrypter secretDecrypter
+	cache           *Cache
+	appID           string
+	parameters      map[string]string
+	env             map[string]string
 
 	done    bool
 	source  *DeploySource
@@ -66,9 +70,10 @@ func NewProvider(
 	cloner SourceCloner,
 	appGitPath model.ApplicationGitPath,
 	sd secretDecrypter,
+	opts ...ProviderOption,
 ) Provider {
 
-	return &provider{
+	p := &provider{
 		workingDir:      workingDir,
 		cloner:          cloner,
 		revisionName:    cloner.RevisionName(),
@@ -76,6 +81,36 @@ func NewProvider(
 		appGitPath:      appGitPath,
 		secretDecrypter: sd,
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+type ProviderOption func(*provider)
+
+// WithCache makes the provider reuse the deploy source of the given application
+// prepared by other providers at the same commit.
+func WithCache(c *Cache, appID string) ProviderOption {
+	return func(p *provider) {
+		p.cache = c
+		p.appID = appID
+	}
+}
+
+// WithParameters makes the provider render the deployment parameters with the given values.
+// The cache is not used in that case because the source depends on the values.
+func WithParameters(params map[string]string) ProviderOption {
+	return func(p *provider) {
+		p.parameters = params
+	}
+}
+
+// WithEnv makes the provider use the given values as the defaults of the application env.
+func WithEnv(env map[string]string) ProviderOption {
+	return func(p *provider) {
+		p.env = env
+	}
 }
 
 func (p *provider) Revision() string {
@@ -142,6 +177,31 @@ func (p *provider) prepare(ctx context.Context, lw io.Writer) (*DeploySource, er
 	repoDir := filepath.Join(dir, "repo")
 	appDir := filepath.Join(repoDir, p.appGitPath.Path)
 
+	var (
+		cfgFileRelPath = p.appGitPath.GetApplicationConfigFilePath()
+		cfgFileAbsPath = filepath.Join(repoDir, cfgFileRelPath)
+		cacheKey       = makeCacheKey(p.appID, p.revision, cfgFileRelPath)
+		useCache       = p.cache != nil && len(p.parameters) == 0
+	)
+
+	// Reuse the source prepared by another provider at the same commit if available.
+	if useCache {
+		// Wait for the other provider preparing the same source to reuse it.
+		unlock := p.cache.lock(cacheKey)
+		defer unlock()
+
+		if e, ok := p.cache.load(cacheKey, repoDir); ok {
+			fmt.Fprintf(lw, "Reused the cached deploy source of the %s commit\n", p.revisionName)
+			return &DeploySource{
+				RepoDir:                  repoDir,
+				AppDir:                   appDir,
+				Revision:                 p.revision,
+				ApplicationConfig:        e.applicationConfig,
+				GenericApplicationConfig: e.genericApplicationConfig,
+			}, nil
+		}
+	}
+
 	// Clone the specified revision of the repository.
 	if err := p.cloner.Clone(ctx, repoDir); err != nil {
 		fmt.Fprintf(lw, "Unable to clone the %s commit (%v)\n", p.revisionName, err)
@@ -150,11 +210,10 @@ func (p *provider) prepare(ctx context.Context, lw io.Writer) (*DeploySource, er
 	fmt.Fprintf(lw, "Successfully cloned the %s commit\n", p.revisionName)
 
 	// Load the application configuration file.
-	var (
-		cfgFileRelPath = p.appGitPath.GetApplicationConfigFilePath()
-		cfgFileAbsPath = filepath.Join(repoDir, cfgFileRelPath)
-	)
-	cfg, err := config.LoadFromYAML(cfgFileAbsPath)
+	cfg, err := config.LoadFromYAMLWithValues(cfgFileAbsPath, config.RenderValues{
+		Parameters: p.parameters,
+		Env:        p.env,
+	})
 	if err != nil {
 		fmt.Fprintf(lw, "Unable to load the application configuration file at %s (%v)\n", cfgFileRelPath, err)
 
@@ -180,6 +239,10 @@ func (p *provider) prepare(ctx context.Context, lw io.Writer) (*DeploySource, er
 	if gac.Attachment != nil {
 		templProcessors = append(templProcessors, sourceprocesser.NewAttachmentProcessor(gac.Attachment))
 	}
+	// Render the deployment parameters if needed.
+	if gac.Parameters != nil && len(gac.Parameters.Targets) > 0 {
+		templProcessors = append(templProcessors, sourceprocesser.NewParametersProcessor(gac.Parameters, p.parameters))
+	}
 
 	// Process templating source files.
 	if len(templProcessors) > 0 {
@@ -191,13 +254,22 @@ func (p *provider) prepare(ctx context.Context, lw io.Writer) (*DeploySource, er
 		fmt.Fprintln(lw, "Successfully processed the source files")
 	}
 
-	return &DeploySource{
+	ds := &DeploySource{
 		RepoDir:                  repoDir,
 		AppDir:                   appDir,
 		Revision:                 p.revision,
 		ApplicationConfig:        cfg,
 		GenericApplicationConfig: gac,
-	}, nil
+	}
+
+	// The source containing the decrypted secrets is not cached to avoid leaving them on disk.
+	// The one containing the encrypted secrets is not cached either because it must be decrypted when reused with a decrypter.
+	if useCache && gac.Encryption == nil {
+		if err := p.cache.store(cacheKey, ds); err != nil {
+			fmt.Fprintf(lw, "Unable to cache the deploy source (%v)\n", err)
+		}
+	}
+	return ds, nil
 }
 
 func (p *provider) copy(lw io.Writer) (*DeploySource, error) {
@@ -0,0 +1,73 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diskjanitormetrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	targetKey = "target"
+)
+
+var (
+	diskUsageBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "disk_usage_bytes",
+			Help: "The disk usage in bytes of the piped workspace.",
+		},
+		[]string{targetKey},
+	)
+	diskQuotaBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "disk_quota_bytes",
+			Help: "The configured disk quota in bytes of the piped workspace. 0 means no limit.",
+		},
+		[]string{targetKey},
+	)
+	diskEvictedBytesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "disk_evicted_bytes_total",
+			Help: "Total number of bytes evicted from the piped workspace.",
+		},
+		[]string{targetKey},
+	)
+	diskEvictedEntriesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "disk_evicted_entries_total",
+			Help: "Total number of entries evicted from the piped workspace.",
+		},
+		[]string{targetKey},
+	)
+)
+
+func UpdateUsage(target string, usage, quota int64) {
+	diskUsageBytes.With(prometheus.Labels{targetKey: target}).Set(float64(usage))
+	diskQuotaBytes.With(prometheus.Labels{targetKey: target}).Set(float64(quota))
+}
+
+func Evicted(target string, bytes int64) {
+	diskEvictedBytesTotal.With(prometheus.Labels{targetKey: target}).Add(float64(bytes))
+	diskEvictedEntriesTotal.With(prometheus.Labels{targetKey: target}).Inc()
+}
+
+func Register(r prometheus.Registerer) {
+	r.MustRegister(
+		diskUsageBytes,
+		diskQuotaBytes,
+		diskEvictedBytesTotal,
+		diskEvictedEntriesTotal,
+	)
+}
@@ -0,0 +1,247 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diskjanitor provides a piped component
+// that keeps the disk usage of the piped workspace under the configured quotas
+// by evicting the least recently used entries.
+package diskjanitor
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/diskjanitor/diskjanitormetrics"
+)
+
+// Target represents a directory whose direct children are managed as cache entries.
+type Target struct {
+	// The name used in logs and metrics.
+	Name string
+	// The directory containing the entries.
+	Dir string
+	// The patterns of the base name of the managed entries.
+	// Empty means all entries are managed.
+	Patterns []string
+	// The maximum total size in bytes of the entries.
+	// Zero means no limit, only the usage is reported.
+	Quota int64
+	// The function to evict an entry, which returns false when the entry is in use and was kept.
+	// Nil means the entry is removed.
+	Evict func(path string) (bool, error)
+}
+
+type Janitor interface {
+	Run(ctx context.Context) error
+}
+
+type janitor struct {
+	targets  []Target
+	interval time.Duration
+	minAge   time.Duration
+	nowFunc  func() time.Time
+	logger   *zap.Logger
+}
+
+type entry struct {
+	path     string
+	size     int64
+	lastUsed time.Time
+}
+
+func NewJanitor(targets []Target, interval, minAge time.Duration, logger *zap.Logger) Janitor {
+	return &janitor{
+		targets:  targets,
+		interval: interval,
+		minAge:   minAge,
+		nowFunc:  time.Now,
+		logger:   logger.Named("disk-janitor"),
+	}
+}
+
+func (j *janitor) Run(ctx context.Context) error {
+	j.logger.Info("start running disk janitor")
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	j.clean()
+	for {
+		select {
+		case <-ctx.Done():
+			j.logger.Info("disk janitor has been stopped")
+			return nil
+
+		case <-ticker.C:
+			j.clean()
+		}
+	}
+}
+
+func (j *janitor) clean() {
+	for _, t := range j.targets {
+		usage, err := j.cleanTarget(t)
+		if err != nil {
+			j.logger.Error("failed to clean disk usage",
+				zap.String("target", t.Name),
+				zap.String("dir", t.Dir),
+				zap.Error(err),
+			)
+			continue
+		}
+		diskjanitormetrics.UpdateUsage(t.Name, usage, t.Quota)
+	}
+}
+
+// cleanTarget evicts the least recently used entries of the given target until its usage fits the quota,
+// and returns the usage after that.
+func (j *janitor) cleanTarget(t Target) (int64, error) {
+	entries, err := listEntries(t)
+	if err != nil {
+		return 0, err
+	}
+
+	var usage int64
+	for _, e := range entries {
+		usage += e.size
+	}
+	if t.Quota <= 0 || usage <= t.Quota {
+		return usage, nil
+	}
+
+	sort.Slice(entries, func(i, k int) bool {
+		return entries[i].lastUsed.Before(entries[k].lastUsed)
+	})
+
+	now := j.nowFunc()
+	for _, e := range entries {
+		if usage <= t.Quota {
+			break
+		}
+		if now.Sub(e.lastUsed) < j.minAge {
+			j.logger.Warn("unable to keep disk usage under the quota since all remaining entries were used recently",
+				zap.String("target", t.Name),
+				zap.Int64("usage", usage),
+				zap.Int64("quota", t.Quota),
+			)
+			break
+		}
+		evicted, err := evict(t, e.path)
+		if err != nil {
+			j.logger.Error("failed to evict entry", zap.String("target", t.Name), zap.String("path", e.path), zap.Error(err))
+			continue
+		}
+		if !evicted {
+			j.logger.Info("skipped evicting entry since it is in use", zap.String("target", t.Name), zap.String("path", e.path))
+			continue
+		}
+		usage -= e.size
+		diskjanitormetrics.Evicted(t.Name, e.size)
+		j.logger.Info("evicted entry to keep disk usage under the quota",
+			zap.String("target", t.Name),
+			zap.String("path", e.path),
+			zap.Int64("size", e.size),
+			zap.Time("last-used", e.lastUsed),
+		)
+	}
+	return usage, nil
+}
+
+func evict(t Target, path string) (bool, error) {
+	if t.Evict != nil {
+		return t.Evict(path)
+	}
+	if err := os.RemoveAll(path); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func listEntries(t Target) ([]entry, error) {
+	des, err := os.ReadDir(t.Dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	entries := make([]entry, 0, len(des))
+	for _, de := range des {
+		if !matchPatterns(de.Name(), t.Patterns) {
+			continue
+		}
+		path := filepath.Join(t.Dir, de.Name())
+		size, lastUsed, err := entryStat(path)
+		if err != nil {
+			// The entry may be removed by others while listing.
+			continue
+		}
+		entries = append(entries, entry{
+			path:     path,
+			size:     size,
+			lastUsed: lastUsed,
+		})
+	}
+	return entries, nil
+}
+
+func matchPatterns(name string, patterns []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if ok, _ := filepath.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// entryStat returns the total size of the files in the given path
+// and the latest modification time of the path and its direct children.
+func entryStat(path string) (int64, time.Time, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	var (
+		size     int64
+		lastUsed = info.ModTime()
+	)
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Ignore the files removed while walking.
+			return nil
+		}
+		fi, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		if fi.Mode().IsRegular() {
+			size += fi.Size()
+		}
+		if filepath.Dir(p) == path && fi.ModTime().After(lastUsed) {
+			lastUsed = fi.ModTime()
+		}
+		return nil
+	})
+	return size, lastUsed, err
+}
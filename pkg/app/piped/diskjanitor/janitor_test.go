@@ -0,0 +1,151 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diskjanitor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func createEntry(t *testing.T, dir, name string, size int, lastUsed time.Time) {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(path, 0o755))
+	file := filepath.Join(path, "data")
+	require.NoError(t, os.WriteFile(file, make([]byte, size), 0o644))
+	require.NoError(t, os.Chtimes(file, lastUsed, lastUsed))
+	require.NoError(t, os.Chtimes(path, lastUsed, lastUsed))
+}
+
+func TestCleanTarget(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	testcases := []struct {
+		name          string
+		target        Target
+		minAge        time.Duration
+		expectedUsage int64
+		expectedLeft  []string
+	}{
+		{
+			name:          "no quota",
+			target:        Target{Name: "test"},
+			expectedUsage: 600,
+			expectedLeft:  []string{"new", "old", "older", "other"},
+		},
+		{
+			name:          "under quota",
+			target:        Target{Name: "test", Quota: 600},
+			expectedUsage: 600,
+			expectedLeft:  []string{"new", "old", "older", "other"},
+		},
+		{
+			name:          "evict least recently used entries",
+			target:        Target{Name: "test", Quota: 350},
+			expectedUsage: 300,
+			expectedLeft:  []string{"new", "other"},
+		},
+		{
+			name:          "recently used entries are kept",
+			target:        Target{Name: "test", Quota: 100},
+			minAge:        90 * time.Minute,
+			expectedUsage: 300,
+			expectedLeft:  []string{"new", "other"},
+		},
+		{
+			name:          "only matched entries are managed",
+			target:        Target{Name: "test", Quota: 100, Patterns: []string{"old*"}},
+			expectedUsage: 0,
+			expectedLeft:  []string{"new", "other"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			createEntry(t, dir, "older", 100, now.Add(-3*time.Hour))
+			createEntry(t, dir, "old", 200, now.Add(-2*time.Hour))
+			createEntry(t, dir, "other", 100, now.Add(-time.Hour))
+			createEntry(t, dir, "new", 200, now)
+
+			tc.target.Dir = dir
+			j := &janitor{
+				minAge:  tc.minAge,
+				nowFunc: func() time.Time { return now },
+				logger:  zap.NewNop(),
+			}
+			usage, err := j.cleanTarget(tc.target)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedUsage, usage)
+
+			des, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			left := make([]string, 0, len(des))
+			for _, de := range des {
+				left = append(left, de.Name())
+			}
+			assert.Equal(t, tc.expectedLeft, left)
+		})
+	}
+}
+
+func TestCleanTargetKeepsEntriesInUse(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	dir := t.TempDir()
+	createEntry(t, dir, "older", 100, now.Add(-3*time.Hour))
+	createEntry(t, dir, "old", 200, now.Add(-2*time.Hour))
+	createEntry(t, dir, "new", 200, now)
+
+	j := &janitor{nowFunc: func() time.Time { return now }, logger: zap.NewNop()}
+	usage, err := j.cleanTarget(Target{
+		Name:  "test",
+		Dir:   dir,
+		Quota: 300,
+		Evict: func(path string) (bool, error) {
+			if filepath.Base(path) == "older" {
+				return false, nil
+			}
+			return true, os.RemoveAll(path)
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(300), usage)
+
+	des, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	left := make([]string, 0, len(des))
+	for _, de := range des {
+		left = append(left, de.Name())
+	}
+	assert.Equal(t, []string{"new", "older"}, left)
+}
+
+func TestCleanTargetMissingDir(t *testing.T) {
+	t.Parallel()
+
+	j := &janitor{nowFunc: time.Now, logger: zap.NewNop()}
+	usage, err := j.cleanTarget(Target{Name: "test", Dir: filepath.Join(t.TempDir(), "missing"), Quota: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), usage)
+}
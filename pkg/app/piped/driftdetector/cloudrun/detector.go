@@ -47,7 +47,7 @@ type secretDecrypter interface {
 }
 
 type reporter interface {
-	ReportApplicationSyncState(ctx context.Context, appID string, state model.ApplicationSyncState) error
+	ReportApplicationSyncState(ctx context.Context, appID, commit string, state model.ApplicationSyncState) error
 }
 
 type Detector interface {
@@ -219,7 +219,7 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 
 	state := makeSyncState(result, headCommit.Hash)
 
-	return d.reporter.ReportApplicationSyncState(ctx, app.Id, state)
+	return d.reporter.ReportApplicationSyncState(ctx, app.Id, headCommit.Hash, state)
 }
 
 func (d *detector) loadHeadServiceManifest(app *model.Application, repo git.Worktree, headCommit git.Commit) (provider.ServiceManifest, error) {
@@ -249,11 +249,12 @@ func (d *detector) loadHeadServiceManifest(app *model.Application, repo git.Work
 		var (
 			encryptionUsed = d.secretDecrypter != nil && gds.Encryption != nil
 			attachmentUsed = gds.Attachment != nil
+			parametersUsed = gds.Parameters != nil && len(gds.Parameters.Targets) > 0
 		)
 
 		// We have to copy repository into another directory because
 		// decrypting the sealed secrets or attaching files might change the git repository.
-		if attachmentUsed || encryptionUsed {
+		if attachmentUsed || encryptionUsed || parametersUsed {
 			dir, err := os.MkdirTemp("", "detector-git-processing")
 			if err != nil {
 				return provider.ServiceManifest{}, fmt.Errorf("failed to prepare a temporary directory for git repository (%w)", err)
@@ -279,6 +280,10 @@ func (d *detector) loadHeadServiceManifest(app *model.Application, repo git.Work
 		if attachmentUsed {
 			templProcessors = append(templProcessors, sourceprocesser.NewAttachmentProcessor(gds.Attachment))
 		}
+		// Then rendering the deployment parameters with the values of the last successful deployment.
+		if parametersUsed {
+			templProcessors = append(templProcessors, sourceprocesser.NewParametersProcessor(gds.Parameters, gds.Parameters.Defined(app.DeployedParameters())))
+		}
 		if len(templProcessors) > 0 {
 			sp := sourceprocesser.NewSourceProcessor(appDir, templProcessors...)
 			if err := sp.Process(); err != nil {
@@ -302,7 +307,11 @@ func (d *detector) loadHeadServiceManifest(app *model.Application, repo git.Work
 
 func (d *detector) loadApplicationConfiguration(repoPath string, app *model.Application) (*config.Config, error) {
 	path := filepath.Join(repoPath, app.GitPath.GetApplicationConfigFilePath())
-	cfg, err := config.LoadFromYAML(path)
+	cfg, err := config.LoadFromYAMLWithValues(path, config.RenderValues{
+		Parameters:                app.DeployedParameters(),
+		IgnoreUndefinedParameters: true,
+		Env:                       d.config.AppEnv,
+	})
 	if err != nil {
 		return nil, err
 	}
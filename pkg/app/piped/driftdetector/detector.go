@@ -20,6 +20,7 @@ package driftdetector
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -40,8 +41,13 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
+// maxNotificationDiffLength is the maximum length of the diff
+// included in the notification sent when a drift was detected.
+const maxNotificationDiffLength = 3000
+
 type applicationLister interface {
 	ListByPlatformProvider(name string) []*model.Application
+	Get(id string) (*model.Application, bool)
 }
 
 type deploymentLister interface {
@@ -56,6 +62,10 @@ type apiClient interface {
 	ReportApplicationSyncState(ctx context.Context, req *pipedservice.ReportApplicationSyncStateRequest, opts ...grpc.CallOption) (*pipedservice.ReportApplicationSyncStateResponse, error)
 }
 
+type notifier interface {
+	Notify(event model.NotificationEvent)
+}
+
 type secretDecrypter interface {
 	Decrypt(string) (string, error)
 }
@@ -66,10 +76,20 @@ type Detector interface {
 
 type detector struct {
 	apiClient  apiClient
+	appLister  applicationLister
+	notifier   notifier
 	detectors  []providerDetector
 	syncStates map[string]model.ApplicationSyncState
-	mu         sync.RWMutex
-	logger     *zap.Logger
+	// The last sync state of each application at its deployed commit,
+	// used to notify only the changes made outside of the deployments.
+	deployedStates map[string]deployedSyncState
+	mu             sync.RWMutex
+	logger         *zap.Logger
+}
+
+type deployedSyncState struct {
+	commit string
+	status model.ApplicationSyncStatus
 }
 
 type providerDetector interface {
@@ -85,14 +105,18 @@ func NewDetector(
 	appManifestsCache cache.Cache,
 	cfg *config.PipedSpec,
 	sd secretDecrypter,
+	notifier notifier,
 	logger *zap.Logger,
 ) (Detector, error) {
 
 	d := &detector{
-		apiClient:  apiClient,
-		detectors:  make([]providerDetector, 0, len(cfg.PlatformProviders)),
-		syncStates: make(map[string]model.ApplicationSyncState),
-		logger:     logger.Named("drift-detector"),
+		apiClient:      apiClient,
+		appLister:      appLister,
+		notifier:       notifier,
+		detectors:      make([]providerDetector, 0, len(cfg.PlatformProviders)),
+		syncStates:     make(map[string]model.ApplicationSyncState),
+		deployedStates: make(map[string]deployedSyncState),
+		logger:         logger.Named("drift-detector"),
 	}
 
 	const format = "unable to find live state getter for platform provider: %s"
@@ -219,7 +243,9 @@ func (d *detector) Run(ctx context.Context) error {
 	return nil
 }
 
-func (d *detector) ReportApplicationSyncState(ctx context.Context, appID string, state model.ApplicationSyncState) error {
+// ReportApplicationSyncState reports the given sync state of the application
+// which was detected by comparing its live state with the given commit.
+func (d *detector) ReportApplicationSyncState(ctx context.Context, appID, commit string, state model.ApplicationSyncState) error {
 	d.mu.RLock()
 	curState, ok := d.syncStates[appID]
 	d.mu.RUnlock()
@@ -245,5 +271,74 @@ func (d *detector) ReportApplicationSyncState(ctx context.Context, appID string,
 	d.syncStates[appID] = state
 	d.mu.Unlock()
 
+	d.notifySyncStateChange(appID, commit, &state)
+
 	return nil
 }
+
+// notifySyncStateChange sends a notification when the application at its deployed commit
+// became OUT_OF_SYNC or came back to SYNCED from OUT_OF_SYNC.
+// The states detected at the commits not deployed yet are not notified because they are
+// the pending changes rather than drifts. Since a successful deployment leaves the application
+// SYNCED, the first state detected at a newly deployed commit is compared with SYNCED,
+// and the state known by the control plane is used when no state was detected since piped started.
+func (d *detector) notifySyncStateChange(appID, commit string, state *model.ApplicationSyncState) {
+	app, ok := d.appLister.Get(appID)
+	if !ok {
+		return
+	}
+	if commit == "" || commit != app.MostRecentlySuccessfulDeployment.GetTrigger().GetCommit().GetHash() {
+		return
+	}
+
+	d.mu.Lock()
+	prev, ok := d.deployedStates[appID]
+	d.deployedStates[appID] = deployedSyncState{commit: commit, status: state.Status}
+	d.mu.Unlock()
+
+	var prevStatus model.ApplicationSyncStatus
+	switch {
+	case !ok:
+		prevStatus = app.SyncState.GetStatus()
+	case prev.commit != commit:
+		prevStatus = model.ApplicationSyncStatus_SYNCED
+	default:
+		prevStatus = prev.status
+	}
+
+	switch {
+	case state.Status == model.ApplicationSyncStatus_OUT_OF_SYNC && prevStatus != model.ApplicationSyncStatus_OUT_OF_SYNC:
+		diff, truncated := truncateDiff(state.Reason, maxNotificationDiffLength)
+		d.notifier.Notify(model.NotificationEvent{
+			Type: model.NotificationEventType_EVENT_APPLICATION_OUT_OF_SYNC,
+			Metadata: &model.NotificationEventApplicationOutOfSync{
+				Application:   app,
+				State:         state,
+				Diff:          diff,
+				DiffTruncated: truncated,
+			},
+		})
+
+	case state.Status == model.ApplicationSyncStatus_SYNCED && prevStatus == model.ApplicationSyncStatus_OUT_OF_SYNC:
+		d.notifier.Notify(model.NotificationEvent{
+			Type: model.NotificationEventType_EVENT_APPLICATION_SYNCED,
+			Metadata: &model.NotificationEventApplicationSynced{
+				Application: app,
+				State:       state,
+			},
+		})
+	}
+}
+
+// truncateDiff returns the leading lines of the given diff fitting in the given length.
+// The second returned value reports whether the diff was truncated.
+func truncateDiff(diff string, length int) (string, bool) {
+	if len(diff) <= length {
+		return diff, false
+	}
+	truncated := diff[:length]
+	if i := strings.LastIndex(truncated, "\n"); i > 0 {
+		truncated = truncated[:i+1]
+	}
+	return truncated, true
+}
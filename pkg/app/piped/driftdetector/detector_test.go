@@ -0,0 +1,156 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package driftdetector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeAPIClient struct{}
+
+func (c *fakeAPIClient) ReportApplicationSyncState(_ context.Context, _ *pipedservice.ReportApplicationSyncStateRequest, _ ...grpc.CallOption) (*pipedservice.ReportApplicationSyncStateResponse, error) {
+	return &pipedservice.ReportApplicationSyncStateResponse{}, nil
+}
+
+type fakeApplicationLister struct {
+	apps map[string]*model.Application
+}
+
+func (l *fakeApplicationLister) ListByPlatformProvider(_ string) []*model.Application {
+	return nil
+}
+
+func (l *fakeApplicationLister) Get(id string) (*model.Application, bool) {
+	app, ok := l.apps[id]
+	return app, ok
+}
+
+type fakeNotifier struct {
+	events []model.NotificationEvent
+}
+
+func (n *fakeNotifier) Notify(event model.NotificationEvent) {
+	n.events = append(n.events, event)
+}
+
+func TestReportApplicationSyncStateNotification(t *testing.T) {
+	t.Parallel()
+
+	deployedAt := func(commit string) *model.ApplicationDeploymentReference {
+		return &model.ApplicationDeploymentReference{
+			Trigger: &model.DeploymentTrigger{Commit: &model.Commit{Hash: commit}},
+		}
+	}
+	app := &model.Application{
+		Id:                               "app-1",
+		Name:                             "app-1",
+		SyncState:                        &model.ApplicationSyncState{Status: model.ApplicationSyncStatus_SYNCED},
+		MostRecentlySuccessfulDeployment: deployedAt("commit-1"),
+	}
+	n := &fakeNotifier{}
+	d := &detector{
+		apiClient:      &fakeAPIClient{},
+		appLister:      &fakeApplicationLister{apps: map[string]*model.Application{"app-1": app}},
+		notifier:       n,
+		syncStates:     make(map[string]model.ApplicationSyncState),
+		deployedStates: make(map[string]deployedSyncState),
+		logger:         zap.NewNop(),
+	}
+	ctx := context.Background()
+
+	// Becoming OUT_OF_SYNC sends a drift notification with the diff.
+	err := d.ReportApplicationSyncState(ctx, "app-1", "commit-1", model.ApplicationSyncState{
+		Status:      model.ApplicationSyncStatus_OUT_OF_SYNC,
+		ShortReason: "There are 1 manifests not synced",
+		Reason:      "-replicas: 3\n+replicas: 300\n",
+		Timestamp:   1,
+	})
+	require.NoError(t, err)
+	require.Len(t, n.events, 1)
+	assert.Equal(t, model.NotificationEventType_EVENT_APPLICATION_OUT_OF_SYNC, n.events[0].Type)
+	md := n.events[0].Metadata.(*model.NotificationEventApplicationOutOfSync)
+	assert.Equal(t, "-replicas: 3\n+replicas: 300\n", md.Diff)
+	assert.False(t, md.DiffTruncated)
+
+	// Staying OUT_OF_SYNC with another diff sends nothing.
+	err = d.ReportApplicationSyncState(ctx, "app-1", "commit-1", model.ApplicationSyncState{
+		Status:      model.ApplicationSyncStatus_OUT_OF_SYNC,
+		ShortReason: "There are 1 manifests not synced",
+		Reason:      "-replicas: 3\n+replicas: 30\n",
+		Timestamp:   2,
+	})
+	require.NoError(t, err)
+	require.Len(t, n.events, 1)
+
+	// Coming back to SYNCED sends a synced notification.
+	err = d.ReportApplicationSyncState(ctx, "app-1", "commit-1", model.ApplicationSyncState{
+		Status:    model.ApplicationSyncStatus_SYNCED,
+		Timestamp: 3,
+	})
+	require.NoError(t, err)
+	require.Len(t, n.events, 2)
+	assert.Equal(t, model.NotificationEventType_EVENT_APPLICATION_SYNCED, n.events[1].Type)
+
+	// The pending changes of the commit not deployed yet are not notified.
+	err = d.ReportApplicationSyncState(ctx, "app-1", "commit-2", model.ApplicationSyncState{
+		Status:      model.ApplicationSyncStatus_OUT_OF_SYNC,
+		ShortReason: "There are 1 manifests not synced",
+		Reason:      "-replicas: 3\n+replicas: 5\n",
+		Timestamp:   4,
+	})
+	require.NoError(t, err)
+	require.Len(t, n.events, 2)
+
+	// Becoming SYNCED by deploying the commit is not notified.
+	app.MostRecentlySuccessfulDeployment = deployedAt("commit-2")
+	err = d.ReportApplicationSyncState(ctx, "app-1", "commit-2", model.ApplicationSyncState{
+		Status:    model.ApplicationSyncStatus_SYNCED,
+		Timestamp: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, n.events, 2)
+
+	// Drifting from the newly deployed commit is notified.
+	err = d.ReportApplicationSyncState(ctx, "app-1", "commit-2", model.ApplicationSyncState{
+		Status:      model.ApplicationSyncStatus_OUT_OF_SYNC,
+		ShortReason: "There are 1 manifests not synced",
+		Reason:      "-replicas: 5\n+replicas: 50\n",
+		Timestamp:   6,
+	})
+	require.NoError(t, err)
+	require.Len(t, n.events, 3)
+	assert.Equal(t, model.NotificationEventType_EVENT_APPLICATION_OUT_OF_SYNC, n.events[2].Type)
+}
+
+func TestTruncateDiff(t *testing.T) {
+	t.Parallel()
+
+	diff, truncated := truncateDiff("line1\nline2\n", 100)
+	assert.Equal(t, "line1\nline2\n", diff)
+	assert.False(t, truncated)
+
+	diff, truncated = truncateDiff("line1\nline2\nline3\n", 14)
+	assert.Equal(t, "line1\nline2\n", diff)
+	assert.True(t, truncated)
+}
@@ -51,7 +51,7 @@ type secretDecrypter interface {
 }
 
 type reporter interface {
-	ReportApplicationSyncState(ctx context.Context, appID string, state model.ApplicationSyncState) error
+	ReportApplicationSyncState(ctx context.Context, appID, commit string, state model.ApplicationSyncState) error
 }
 
 type Detector interface {
@@ -197,18 +197,25 @@ func (d *detector) listGroupedApplication() map[string][]*model.Application {
 }
 
 func (d *detector) checkApplication(ctx context.Context, app *model.Application, repo git.Repo, headCommit git.Commit) error {
-	headManifests, err := d.loadConfigs(app, repo, headCommit)
-	if err != nil {
-		return err
-	}
-	d.logger.Info(fmt.Sprintf("application %s has ecs definition files at commit %s", app.Id, headCommit.Hash))
-
 	liveManifests, ok := d.stateGetter.GetECSManifests(app.Id)
 	if !ok {
 		return fmt.Errorf("failed to get live ecs definition files")
 	}
 	d.logger.Info(fmt.Sprintf("application %s has live ecs definition files", app.Id))
 
+	// The definition templates are rendered with the commit hash of the live resources
+	// so that the fields referring to CommitHash are not reported as drift on every new commit.
+	templateCommit := deployedCommitHash(liveManifests)
+	if templateCommit == "" {
+		templateCommit = headCommit.Hash
+	}
+
+	headManifests, err := d.loadConfigs(app, repo, headCommit, templateCommit)
+	if err != nil {
+		return err
+	}
+	d.logger.Info(fmt.Sprintf("application %s has ecs definition files at commit %s", app.Id, headCommit.Hash))
+
 	// Ignore some fields whech are not necessary or unable to detect diff.
 	live, head := ignoreParameters(liveManifests, headManifests)
 
@@ -225,7 +232,7 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 
 	state := makeSyncState(result, headCommit.Hash)
 
-	return d.reporter.ReportApplicationSyncState(ctx, app.Id, state)
+	return d.reporter.ReportApplicationSyncState(ctx, app.Id, headCommit.Hash, state)
 }
 
 // ignoreParameters adjusts the fields to ignore unnecessary diff.
@@ -239,14 +246,19 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 //   - service.DeploymentConfiguration
 //   - service.PlatformVersion
 //   - service.RoleArn
+//   - service.AvailabilityZoneRebalancing
+//   - taskDefinition.ContainerDefinitions[].VersionConsistency
 func ignoreParameters(liveManifests provider.ECSManifests, headManifests provider.ECSManifests) (live, head provider.ECSManifests) {
 	liveService := *liveManifests.ServiceDefinition
 	liveService.CreatedAt = nil
 	liveService.CreatedBy = nil
+	liveService.CurrentServiceDeployment = nil
+	liveService.CurrentServiceRevisions = nil
 	liveService.Events = nil
 	liveService.LoadBalancers = nil // TODO: We should set values in headService from the head manifests .
 	liveService.PendingCount = 0
 	liveService.PlatformFamily = nil // Users cannot specify PlatformFamily in a service definition file. It is automatically set by ECS.
+	liveService.ResourceManagementType = ""
 	liveService.RunningCount = 0
 	liveService.ServiceArn = nil
 	liveService.Status = nil         // Service's Status is shown on the WebUI as healthStatus. So we don't need Status in the driftdetection.
@@ -257,6 +269,7 @@ func ignoreParameters(liveManifests provider.ECSManifests, headManifests provide
 	if liveManifests.TaskDefinition != nil {
 		// When liveTask does not exist, e.g. right after the service is created.
 		liveTask = *liveManifests.TaskDefinition
+		liveTask.DeleteRequestedAt = nil
 		liveTask.RegisteredAt = nil
 		liveTask.RegisteredBy = nil
 		liveTask.RequiresAttributes = nil
@@ -283,6 +296,11 @@ func ignoreParameters(liveManifests provider.ECSManifests, headManifests provide
 		// See https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_CreateService.html#ECS-CreateService-request-role.
 		headService.RoleArn = liveService.RoleArn
 	}
+	if len(headService.AvailabilityZoneRebalancing) == 0 {
+		// The default value differs between creating and updating the service.
+		// See https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_CreateService.html#ECS-CreateService-request-availabilityZoneRebalancing.
+		headService.AvailabilityZoneRebalancing = liveService.AvailabilityZoneRebalancing
+	}
 	if headService.NetworkConfiguration != nil && headService.NetworkConfiguration.AwsvpcConfiguration != nil {
 		awsvpcCfg := *headService.NetworkConfiguration.AwsvpcConfiguration
 		awsvpcCfg.Subnets = slices.Clone(awsvpcCfg.Subnets)
@@ -317,6 +335,11 @@ func ignoreParameters(liveManifests provider.ECSManifests, headManifests provide
 		headTask.Compatibilities = liveTask.Compatibilities // Users can specify Compatibilities in a task definition file, but it is not used when registering a task definition.
 	}
 
+	liveVersionConsistencies := make(map[string]types.VersionConsistency, len(liveTask.ContainerDefinitions))
+	for _, cd := range liveTask.ContainerDefinitions {
+		liveVersionConsistencies[aws.ToString(cd.Name)] = cd.VersionConsistency
+	}
+
 	headTask.ContainerDefinitions = slices.Clone(headManifests.TaskDefinition.ContainerDefinitions)
 	for i := range headTask.ContainerDefinitions {
 		cd := &headTask.ContainerDefinitions[i]
@@ -324,6 +347,10 @@ func ignoreParameters(liveManifests provider.ECSManifests, headManifests provide
 			// Essential is true by default. See https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_ContainerDefinition.html#ECS-Type-ContainerDefinition-es.
 			cd.Essential = aws.Bool(true)
 		}
+		if len(cd.VersionConsistency) == 0 {
+			// Whether ECS returns the default value depends on when the task definition was registered.
+			cd.VersionConsistency = liveVersionConsistencies[aws.ToString(cd.Name)]
+		}
 
 		cd.Environment = sortKeyPairs(cd.Environment)
 
@@ -343,18 +370,32 @@ func ignoreParameters(liveManifests provider.ECSManifests, headManifests provide
 	return live, head
 }
 
-func (d *detector) loadConfigs(app *model.Application, repo git.Worktree, headCommit git.Commit) (provider.ECSManifests, error) {
+// deployedCommitHash returns the commit hash tagged to the live service by the last deployment.
+func deployedCommitHash(liveManifests provider.ECSManifests) string {
+	if liveManifests.ServiceDefinition == nil {
+		return ""
+	}
+	for _, tag := range liveManifests.ServiceDefinition.Tags {
+		if aws.ToString(tag.Key) == provider.LabelCommitHash {
+			return aws.ToString(tag.Value)
+		}
+	}
+	return ""
+}
+
+func (d *detector) loadConfigs(app *model.Application, repo git.Worktree, headCommit git.Commit, templateCommit string) (provider.ECSManifests, error) {
 	var (
 		manifestCache = provider.ECSManifestsCache{
 			AppID:  app.Id,
 			Cache:  d.appManifestsCache,
 			Logger: d.logger,
 		}
-		repoDir = repo.GetPath()
-		appDir  = filepath.Join(repoDir, app.GitPath.Path)
+		repoDir  = repo.GetPath()
+		appDir   = filepath.Join(repoDir, app.GitPath.Path)
+		cacheKey = fmt.Sprintf("%s/%s", headCommit.Hash, templateCommit)
 	)
 
-	manifests, ok := manifestCache.Get(headCommit.Hash)
+	manifests, ok := manifestCache.Get(cacheKey)
 	if ok {
 		return manifests, nil
 	}
@@ -372,11 +413,12 @@ func (d *detector) loadConfigs(app *model.Application, repo git.Worktree, headCo
 	var (
 		encryptionUsed = d.secretDecrypter != nil && gds.Encryption != nil
 		attachmentUsed = gds.Attachment != nil
+		parametersUsed = gds.Parameters != nil && len(gds.Parameters.Targets) > 0
 	)
 
 	// We have to copy repository into another directory because
 	// decrypting the sealed secrets or attaching files might change the git repository.
-	if attachmentUsed || encryptionUsed {
+	if attachmentUsed || encryptionUsed || parametersUsed {
 		dir, err := os.MkdirTemp("", "detector-git-processing")
 		if err != nil {
 			return provider.ECSManifests{}, fmt.Errorf("failed to prepare a temporary directory for git repository (%w)", err)
@@ -402,6 +444,10 @@ func (d *detector) loadConfigs(app *model.Application, repo git.Worktree, headCo
 	if attachmentUsed {
 		templProcessors = append(templProcessors, sourceprocesser.NewAttachmentProcessor(gds.Attachment))
 	}
+	// Then rendering the deployment parameters with the values of the last successful deployment.
+	if parametersUsed {
+		templProcessors = append(templProcessors, sourceprocesser.NewParametersProcessor(gds.Parameters, gds.Parameters.Defined(app.DeployedParameters())))
+	}
 	if len(templProcessors) > 0 {
 		sp := sourceprocesser.NewSourceProcessor(appDir, templProcessors...)
 		if err := sp.Process(); err != nil {
@@ -415,11 +461,12 @@ func (d *detector) loadConfigs(app *model.Application, repo git.Worktree, headCo
 		serviceDefFile = cfg.ECSApplicationSpec.Input.ServiceDefinitionFile
 		taskDefFile = cfg.ECSApplicationSpec.Input.TaskDefinitionFile
 	}
-	serviceDef, err := provider.LoadServiceDefinition(appDir, serviceDefFile)
+	td := provider.NewTemplateData(cfg.ECSApplicationSpec, app.Id, app.PipedId, templateCommit)
+	serviceDef, err := provider.LoadServiceDefinition(appDir, serviceDefFile, td)
 	if err != nil {
 		return provider.ECSManifests{}, fmt.Errorf("failed to load new service definition: %w", err)
 	}
-	taskDef, err := provider.LoadTaskDefinition(appDir, taskDefFile)
+	taskDef, err := provider.LoadTaskDefinition(appDir, taskDefFile, td)
 	if err != nil {
 		return provider.ECSManifests{}, fmt.Errorf("failed to load new task definition: %w", err)
 	}
@@ -428,14 +475,18 @@ func (d *detector) loadConfigs(app *model.Application, repo git.Worktree, headCo
 		ServiceDefinition: &serviceDef,
 		TaskDefinition:    &taskDef,
 	}
-	manifestCache.Put(headCommit.Hash, manifests)
+	manifestCache.Put(cacheKey, manifests)
 
 	return manifests, nil
 }
 
 func (d *detector) loadApplicationConfiguration(repoPath string, app *model.Application) (*config.Config, error) {
 	path := filepath.Join(repoPath, app.GitPath.GetApplicationConfigFilePath())
-	cfg, err := config.LoadFromYAML(path)
+	cfg, err := config.LoadFromYAMLWithValues(path, config.RenderValues{
+		Parameters:                app.DeployedParameters(),
+		IgnoreUndefinedParameters: true,
+		Env:                       d.config.AppEnv,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -31,8 +31,10 @@ func TestIgnoreParameters(t *testing.T) {
 
 	livestate := provider.ECSManifests{
 		ServiceDefinition: &types.Service{
-			CreatedAt: aws.Time(time.Now()),
-			CreatedBy: aws.String("test-createdby"),
+			AvailabilityZoneRebalancing: types.AvailabilityZoneRebalancingEnabled,
+			CreatedAt:                   aws.Time(time.Now()),
+			CreatedBy:                   aws.String("test-createdby"),
+			CurrentServiceDeployment:    aws.String("test-service-deployment-arn"),
 			Events: []types.ServiceEvent{
 				{
 					Id: aws.String("test-event"),
@@ -54,13 +56,14 @@ func TestIgnoreParameters(t *testing.T) {
 				MaximumPercent:        aws.Int32(200),
 				MinimumHealthyPercent: aws.Int32(100),
 			},
-			PendingCount:    3,
-			PlatformFamily:  aws.String("LINUX"),
-			PlatformVersion: aws.String("1.4"),
-			RunningCount:    10,
-			RoleArn:         aws.String("test-role-arn"),
-			ServiceArn:      aws.String("test-service-arn"),
-			Status:          aws.String("ACTIVE"),
+			PendingCount:           3,
+			PlatformFamily:         aws.String("LINUX"),
+			PlatformVersion:        aws.String("1.4"),
+			ResourceManagementType: types.ResourceManagementTypeEcs,
+			RunningCount:           10,
+			RoleArn:                aws.String("test-role-arn"),
+			ServiceArn:             aws.String("test-service-arn"),
+			Status:                 aws.String("ACTIVE"),
 			Tags: []types.Tag{
 				{
 					Key:   aws.String("a_test-tag"),
@@ -98,7 +101,9 @@ func TestIgnoreParameters(t *testing.T) {
 			Compatibilities: []types.Compatibility{types.CompatibilityEc2, types.CompatibilityFargate},
 			ContainerDefinitions: []types.ContainerDefinition{
 				{
-					Essential: aws.Bool(false),
+					Name:               aws.String("app"),
+					Essential:          aws.Bool(false),
+					VersionConsistency: types.VersionConsistencyEnabled,
 					PortMappings: []types.PortMapping{
 						{
 							HostPort: aws.Int32(80),
@@ -121,7 +126,9 @@ func TestIgnoreParameters(t *testing.T) {
 					},
 				},
 				{
-					Essential: aws.Bool(true),
+					Name:               aws.String("sidecar"),
+					Essential:          aws.Bool(true),
+					VersionConsistency: types.VersionConsistencyDisabled,
 					PortMappings: []types.PortMapping{
 						{
 							HostPort: aws.Int32(80),
@@ -130,6 +137,7 @@ func TestIgnoreParameters(t *testing.T) {
 					},
 				},
 			},
+			DeleteRequestedAt:  aws.Time(time.Now()),
 			RegisteredAt:       aws.Time(time.Now()),
 			RegisteredBy:       aws.String("test-registeredby"),
 			RequiresAttributes: []types.Attribute{},
@@ -158,6 +166,7 @@ func TestIgnoreParameters(t *testing.T) {
 		TaskDefinition: &types.TaskDefinition{
 			ContainerDefinitions: []types.ContainerDefinition{
 				{
+					Name:      aws.String("app"),
 					Essential: aws.Bool(false),
 					PortMappings: []types.PortMapping{
 						// HostPort will be ignored
@@ -176,6 +185,8 @@ func TestIgnoreParameters(t *testing.T) {
 					},
 				},
 				{
+					Name:               aws.String("sidecar"),
+					VersionConsistency: types.VersionConsistencyDisabled,
 					// Use default value for 'Essential'
 					PortMappings: []types.PortMapping{
 						// HostPort will be ignored
@@ -288,3 +299,46 @@ func TestIgnoreAutoScalingDiff(t *testing.T) {
 	}
 
 }
+
+func TestDeployedCommitHash(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		manifests provider.ECSManifests
+		expected  string
+	}{
+		{
+			name:      "no service definition",
+			manifests: provider.ECSManifests{},
+			expected:  "",
+		},
+		{
+			name: "no commit hash tag",
+			manifests: provider.ECSManifests{
+				ServiceDefinition: &types.Service{
+					Tags: []types.Tag{{Key: aws.String(provider.LabelManagedBy), Value: aws.String(provider.ManagedByPiped)}},
+				},
+			},
+			expected: "",
+		},
+		{
+			name: "tagged by the last deployment",
+			manifests: provider.ECSManifests{
+				ServiceDefinition: &types.Service{
+					Tags: []types.Tag{
+						{Key: aws.String(provider.LabelManagedBy), Value: aws.String(provider.ManagedByPiped)},
+						{Key: aws.String(provider.LabelCommitHash), Value: aws.String("0123456789abcdef")},
+					},
+				},
+			},
+			expected: "0123456789abcdef",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, deployedCommitHash(tc.manifests))
+		})
+	}
+}
@@ -47,7 +47,7 @@ type secretDecrypter interface {
 }
 
 type reporter interface {
-	ReportApplicationSyncState(ctx context.Context, appID string, state model.ApplicationSyncState) error
+	ReportApplicationSyncState(ctx context.Context, appID, commit string, state model.ApplicationSyncState) error
 }
 
 type Detector interface {
@@ -216,7 +216,10 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 			key, ignoredPath := splited[0], splited[1]
 			ignoreConfig[key] = append(ignoreConfig[key], ignoredPath)
 		}
+		liveManifests = provider.NormalizeManifests(liveManifests, ddCfg.NormalizationRules)
+		headManifests = provider.NormalizeManifests(headManifests, ddCfg.NormalizationRules)
 	}
+	addRollingUpdateOverrideIgnoreFields(ignoreConfig, liveManifests)
 
 	result, err := provider.DiffList(
 		liveManifests,
@@ -233,7 +236,26 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 
 	state := makeSyncState(result, headCommit.Hash)
 
-	return d.reporter.ReportApplicationSyncState(ctx, app.Id, state)
+	return d.reporter.ReportApplicationSyncState(ctx, app.Id, headCommit.Hash, state)
+}
+
+// addRollingUpdateOverrideIgnoreFields adds the rolling update fields overridden by
+// the K8S_PRIMARY_ROLLOUT stage options to the given ignore config
+// since they are intentionally different from the ones defined in Git.
+func addRollingUpdateOverrideIgnoreFields(ignoreConfig map[string][]string, liveManifests []provider.Manifest) {
+	for _, m := range liveManifests {
+		overridden := m.GetAnnotations()[provider.AnnotationRolloutOverride]
+		if overridden == "" {
+			continue
+		}
+		key := m.Key.String()
+		for _, f := range strings.Split(overridden, ",") {
+			switch f {
+			case "maxSurge", "maxUnavailable":
+				ignoreConfig[key] = append(ignoreConfig[key], "spec.strategy.rollingUpdate."+f)
+			}
+		}
+	}
 }
 
 func (d *detector) loadHeadManifests(ctx context.Context, app *model.Application, repo git.Worktree, headCommit git.Commit, watchingResourceKinds []provider.APIVersionKind) ([]provider.Manifest, error) {
@@ -263,11 +285,12 @@ func (d *detector) loadHeadManifests(ctx context.Context, app *model.Application
 		var (
 			encryptionUsed = d.secretDecrypter != nil && gds.Encryption != nil
 			attachmentUsed = gds.Attachment != nil
+			parametersUsed = gds.Parameters != nil && len(gds.Parameters.Targets) > 0
 		)
 
 		// We have to copy repository into another directory because
 		// decrypting the sealed secrets or attaching files might change the git repository.
-		if attachmentUsed || encryptionUsed {
+		if attachmentUsed || encryptionUsed || parametersUsed {
 			dir, err := os.MkdirTemp("", "detector-git-processing")
 			if err != nil {
 				return nil, fmt.Errorf("failed to prepare a temporary directory for git repository (%w)", err)
@@ -293,6 +316,10 @@ func (d *detector) loadHeadManifests(ctx context.Context, app *model.Application
 		if attachmentUsed {
 			templProcessors = append(templProcessors, sourceprocesser.NewAttachmentProcessor(gds.Attachment))
 		}
+		// Then rendering the deployment parameters with the values of the last successful deployment.
+		if parametersUsed {
+			templProcessors = append(templProcessors, sourceprocesser.NewParametersProcessor(gds.Parameters, gds.Parameters.Defined(app.DeployedParameters())))
+		}
 		if len(templProcessors) > 0 {
 			sp := sourceprocesser.NewSourceProcessor(appDir, templProcessors...)
 			if err := sp.Process(); err != nil {
@@ -348,7 +375,11 @@ func (d *detector) listGroupedApplication() map[string][]*model.Application {
 
 func (d *detector) loadApplicationConfiguration(repoPath string, app *model.Application) (*config.Config, error) {
 	path := filepath.Join(repoPath, app.GitPath.GetApplicationConfigFilePath())
-	cfg, err := config.LoadFromYAML(path)
+	cfg, err := config.LoadFromYAMLWithValues(path, config.RenderValues{
+		Parameters:                app.DeployedParameters(),
+		IgnoreUndefinedParameters: true,
+		Env:                       d.config.AppEnv,
+	})
 	if err != nil {
 		return nil, err
 	}
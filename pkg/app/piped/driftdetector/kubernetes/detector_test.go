@@ -0,0 +1,122 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/diff"
+)
+
+func TestAddRollingUpdateOverrideIgnoreFields(t *testing.T) {
+	t.Parallel()
+
+	const head = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  replicas: 2
+  strategy:
+    rollingUpdate:
+      maxSurge: 1
+      maxUnavailable: 1
+`
+	testcases := []struct {
+		name     string
+		live     string
+		wantDiff bool
+	}{
+		{
+			name: "overridden by the stage options",
+			live: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+  annotations:
+    pipecd.dev/rollout-override: maxSurge,maxUnavailable
+spec:
+  replicas: 2
+  strategy:
+    rollingUpdate:
+      maxSurge: 50%
+      maxUnavailable: 0
+`,
+		},
+		{
+			name: "only maxUnavailable was overridden",
+			live: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+  annotations:
+    pipecd.dev/rollout-override: maxUnavailable
+spec:
+  replicas: 2
+  strategy:
+    rollingUpdate:
+      maxSurge: 50%
+      maxUnavailable: 0
+`,
+			wantDiff: true,
+		},
+		{
+			name: "changed without the stage options",
+			live: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  replicas: 2
+  strategy:
+    rollingUpdate:
+      maxSurge: 1
+      maxUnavailable: 0
+`,
+			wantDiff: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			heads, err := provider.ParseManifests(head)
+			require.NoError(t, err)
+			lives, err := provider.ParseManifests(tc.live)
+			require.NoError(t, err)
+
+			ignoreConfig := make(map[string][]string)
+			addRollingUpdateOverrideIgnoreFields(ignoreConfig, lives)
+
+			result, err := provider.DiffList(lives, heads, zap.NewNop(),
+				diff.WithEquateEmpty(),
+				diff.WithIgnoreAddingMapKeys(),
+				diff.WithIgnoreConfig(ignoreConfig),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantDiff, !result.NoChange())
+		})
+	}
+}
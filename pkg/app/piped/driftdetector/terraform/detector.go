@@ -48,7 +48,7 @@ type secretDecrypter interface {
 }
 
 type reporter interface {
-	ReportApplicationSyncState(ctx context.Context, appID string, state model.ApplicationSyncState) error
+	ReportApplicationSyncState(ctx context.Context, appID, commit string, state model.ApplicationSyncState) error
 }
 
 type Detector interface {
@@ -70,6 +70,14 @@ type detector struct {
 
 	gitRepos   map[string]git.Repo
 	syncStates map[string]model.ApplicationSyncState
+	// The values of the external variables fetched for each application,
+	// reused until the application is checked at another commit.
+	externalVars map[string]externalVars
+}
+
+type externalVars struct {
+	commit string
+	values []string
 }
 
 func NewDetector(
@@ -99,6 +107,7 @@ func NewDetector(
 		secretDecrypter:   sd,
 		gitRepos:          make(map[string]git.Repo),
 		syncStates:        make(map[string]model.ApplicationSyncState),
+		externalVars:      make(map[string]externalVars),
 		logger:            logger,
 	}
 }
@@ -177,7 +186,7 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 
 	// Load config
 	cpCfg := d.provider.TerraformConfig
-	cfg, err := loadApplicationConfiguration(repoDir, app)
+	cfg, err := loadApplicationConfiguration(repoDir, app, d.config.AppEnv)
 	if err != nil {
 		return fmt.Errorf("failed to load application configuration: %w", err)
 	}
@@ -191,11 +200,12 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 	var (
 		encryptionUsed = d.secretDecrypter != nil && gds.Encryption != nil
 		attachmentUsed = gds.Attachment != nil
+		parametersUsed = gds.Parameters != nil && len(gds.Parameters.Targets) > 0
 	)
 
 	// We have to copy repository into another directory because
 	// decrypting the sealed secrets or attaching files might change the git repository.
-	if attachmentUsed || encryptionUsed {
+	if attachmentUsed || encryptionUsed || parametersUsed {
 		dir, err := os.MkdirTemp("", "detector-git-processing")
 		if err != nil {
 			return fmt.Errorf("failed to prepare a temporary directory for git repository (%w)", err)
@@ -221,6 +231,10 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 	if attachmentUsed {
 		templProcessors = append(templProcessors, sourceprocesser.NewAttachmentProcessor(gds.Attachment))
 	}
+	// Then rendering the deployment parameters with the values of the last successful deployment.
+	if parametersUsed {
+		templProcessors = append(templProcessors, sourceprocesser.NewParametersProcessor(gds.Parameters, gds.Parameters.Defined(app.DeployedParameters())))
+	}
 	if len(templProcessors) > 0 {
 		sp := sourceprocesser.NewSourceProcessor(appDir, templProcessors...)
 		if err := sp.Process(); err != nil {
@@ -229,15 +243,36 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 	}
 
 	// Set up terraform
-	version := appCfg.Input.TerraformVersion
-	terraformPath, _, err := toolregistry.DefaultRegistry().Terraform(ctx, version)
+	var (
+		terraformPath string
+		openTofu      = appCfg.Input.OpenTofu != nil
+	)
+	if openTofu {
+		terraformPath, _, err = toolregistry.DefaultRegistry().OpenTofu(ctx, appCfg.Input.OpenTofu.Version)
+	} else {
+		terraformPath, _, err = toolregistry.DefaultRegistry().Terraform(ctx, appCfg.Input.TerraformVersion)
+	}
 	if err != nil {
 		return err
 	}
+	var (
+		terragruntPath   string
+		terragruntRunAll bool
+	)
+	if tg := appCfg.Input.Terragrunt; tg != nil {
+		terragruntRunAll = tg.RunAll
+		if terragruntPath, _, err = toolregistry.DefaultRegistry().Terragrunt(ctx, tg.Version); err != nil {
+			return err
+		}
+	}
 
 	vars := make([]string, 0, len(cpCfg.Vars)+len(appCfg.Input.Vars))
 	vars = append(vars, cpCfg.Vars...)
 	vars = append(vars, appCfg.Input.Vars...)
+	sensitiveVars, err := d.resolveExternalVars(ctx, app.Id, headCommit.Hash, appCfg.Input.ExternalVars)
+	if err != nil {
+		return err
+	}
 	flags := appCfg.Input.CommandFlags
 	envs := appCfg.Input.CommandEnvs
 
@@ -246,9 +281,13 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 		appDir,
 		provider.WithoutColor(),
 		provider.WithVars(vars),
+		provider.WithSensitiveVars(sensitiveVars),
 		provider.WithVarFiles(appCfg.Input.VarFiles),
 		provider.WithAdditionalFlags(flags.Shared, flags.Init, flags.Plan, flags.Apply),
 		provider.WithAdditionalEnvs(envs.Shared, envs.Init, envs.Plan, envs.Apply),
+		provider.WithPluginCacheConfig(cpCfg.PluginCache),
+		provider.WithOpenTofu(openTofu),
+		provider.WithTerragrunt(terragruntPath, terragruntRunAll),
 	)
 
 	buf := new(bytes.Buffer)
@@ -281,7 +320,7 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 		return err
 	}
 
-	return d.reporter.ReportApplicationSyncState(ctx, app.Id, *state)
+	return d.reporter.ReportApplicationSyncState(ctx, app.Id, headCommit.Hash, *state)
 }
 
 func makeSyncState(r provider.PlanResult, commit string) (*model.ApplicationSyncState, error) {
@@ -328,6 +367,27 @@ func (d *detector) cloneGitRepository(ctx context.Context, repoID string) (git.R
 
 // listGroupedApplication retrieves all applications those should be handled by this director
 // and then groups them by repoID.
+// resolveExternalVars returns the values of the given external variables of the application.
+// They are fetched only once for each commit to avoid calling AWS APIs on every check.
+func (d *detector) resolveExternalVars(ctx context.Context, appID, commit string, vars []config.TerraformExternalVar) ([]string, error) {
+	if len(vars) == 0 {
+		delete(d.externalVars, appID)
+		return nil, nil
+	}
+	if cached, ok := d.externalVars[appID]; ok && cached.commit == commit {
+		return cached.values, nil
+	}
+	values, err := provider.ResolveExternalVars(ctx, vars)
+	if err != nil {
+		return nil, err
+	}
+	d.externalVars[appID] = externalVars{
+		commit: commit,
+		values: values,
+	}
+	return values, nil
+}
+
 func (d *detector) listGroupedApplication() map[string][]*model.Application {
 	var (
 		apps = d.appLister.ListByPlatformProvider(d.provider.Name)
@@ -340,9 +400,13 @@ func (d *detector) listGroupedApplication() map[string][]*model.Application {
 	return m
 }
 
-func loadApplicationConfiguration(repoPath string, app *model.Application) (*config.Config, error) {
+func loadApplicationConfiguration(repoPath string, app *model.Application, env map[string]string) (*config.Config, error) {
 	path := filepath.Join(repoPath, app.GitPath.GetApplicationConfigFilePath())
-	cfg, err := config.LoadFromYAML(path)
+	cfg, err := config.LoadFromYAMLWithValues(path, config.RenderValues{
+		Parameters:                app.DeployedParameters(),
+		IgnoreUndefinedParameters: true,
+		Env:                       env,
+	})
 	if err != nil {
 		return nil, err
 	}
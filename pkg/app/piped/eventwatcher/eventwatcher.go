@@ -719,10 +719,9 @@ func (w *watcher) commitFiles(ctx context.Context, latestEvent *model.Event, eve
 	branch := makeBranchName(newBranch, eventName, repo.GetClonedBranch())
 	trailers := make(map[string]string)
 	maps.Copy(trailers, latestEvent.Contexts)
-	// Store the commit hash of the commit that trigger this event as trailer of the manifest commit.
-	if latestEvent.TriggerCommitHash != "" {
-		trailers[model.TraceTriggerCommitHashKey] = latestEvent.TriggerCommitHash
-	}
+	// Store what triggered this event as trailers of the manifest commit
+	// to be able to link the deployment back to it.
+	maps.Copy(trailers, latestEvent.MakeTraceTrailers())
 	if err := repo.CommitChanges(ctx, branch, commitMsg, newBranch, changes, trailers); err != nil {
 		w.logger.Error("failed to perform git commit",
 			zap.String("branch", branch),
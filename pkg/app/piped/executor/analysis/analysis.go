@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -44,10 +45,17 @@ type Executor struct {
 	config              *config.Config
 	startTime           time.Time
 	previousElapsedTime time.Duration
+	// Whether the analysis duration is counted only within the schedule window.
+	extendDuration bool
+	// The time spent within the schedule window.
+	windowElapsedTime atomic.Int64
+	// Whether the elapsed time is not stored to the stage metadata.
+	transient bool
 }
 
 type registerer interface {
 	Register(stage model.Stage, f executor.Factory) error
+	MarkDryRunnable(stage model.Stage)
 }
 
 func Register(r registerer) {
@@ -57,6 +65,23 @@ func Register(r registerer) {
 		}
 	}
 	r.Register(model.StageAnalysis, f)
+	r.MarkDryRunnable(model.StageAnalysis)
+}
+
+// Analyze runs the given analyses as a part of the stage executed with the given input,
+// e.g. between the steps of a traffic routing stage.
+// Unlike ANALYSIS stage, the elapsed time is not stored,
+// so the analyses start over when the stage is restarted.
+func Analyze(sig executor.StopSignal, in executor.Input, options *config.AnalysisStageOptions) model.StageStatus {
+	in.StageConfig = config.PipelineStage{
+		Name:                 model.StageAnalysis,
+		AnalysisStageOptions: options,
+	}
+	e := &Executor{
+		Input:     in,
+		transient: true,
+	}
+	return e.Execute(sig)
 }
 
 // Execute spawns and runs multiple analyzer that run a query at the regular time.
@@ -87,15 +112,38 @@ func (e *Executor) Execute(sig executor.StopSignal) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	var window *config.AnalysisWindow
+	if options.Schedule != nil {
+		if window, err = options.Schedule.Window(); err != nil {
+			e.LogPersister.Errorf("Invalid analysis schedule: %v", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		e.LogPersister.Infof("Analysis results will be counted only within the schedule (timezone: %s, days: %v, from: %q, to: %q)",
+			options.Schedule.Timezone, options.Schedule.Days, options.Schedule.StartTime, options.Schedule.EndTime)
+	}
+
 	timeout := time.Duration(options.Duration)
-	e.previousElapsedTime = e.retrievePreviousElapsedTime()
-	if e.previousElapsedTime > 0 {
-		// Restart from the middle.
-		timeout -= e.previousElapsedTime
+	if !e.transient {
+		e.previousElapsedTime = e.retrievePreviousElapsedTime()
+		if e.previousElapsedTime > 0 {
+			// Restart from the middle.
+			timeout -= e.previousElapsedTime
+		}
+		defer e.saveElapsedTime(ctx)
 	}
-	defer e.saveElapsedTime(ctx)
 
-	ctxWithTimeout, cancel := context.WithTimeout(ctx, timeout)
+	var (
+		ctxWithTimeout context.Context
+		cancel         context.CancelFunc
+	)
+	if window != nil && options.Schedule.ExtendDuration {
+		// The analysis lasts until the given duration has been spent within the window.
+		e.extendDuration = true
+		ctxWithTimeout, cancel = context.WithCancel(ctx)
+		go e.countWindowTime(ctxWithTimeout, window, timeout, cancel)
+	} else {
+		ctxWithTimeout, cancel = context.WithTimeout(ctx, timeout)
+	}
 	defer cancel()
 
 	eg, ctxWithTimeout := errgroup.WithContext(ctxWithTimeout)
@@ -141,6 +189,7 @@ func (e *Executor) Execute(sig executor.StopSignal) model.StageStatus {
 		id := fmt.Sprintf("metrics-%d", i)
 		args := e.buildAppArgs(options.Metrics[i].Template.AppArgs)
 		analyzer := newMetricsAnalyzer(id, *cfg, e.startTime, provider, e.AnalysisResultStore, args, e.Logger, e.LogPersister)
+		analyzer.window = window
 
 		eg.Go(func() error {
 			e.LogPersister.Infof("[%s] Start metrics analyzer every %s with query template: %q", analyzer.id, cfg.Interval.Duration(), cfg.Query)
@@ -154,6 +203,7 @@ func (e *Executor) Execute(sig executor.StopSignal) model.StageStatus {
 			e.LogPersister.Errorf("Failed to spawn analyzer for %s: %v", options.Logs[i].Provider, err)
 			return model.StageStatus_STAGE_FAILURE
 		}
+		analyzer.window = window
 		eg.Go(func() error {
 			e.LogPersister.Infof("[%s] Start log analyzer", analyzer.id)
 			return analyzer.run(ctxWithTimeout)
@@ -166,6 +216,7 @@ func (e *Executor) Execute(sig executor.StopSignal) model.StageStatus {
 			e.LogPersister.Errorf("Failed to spawn analyzer for HTTP: %v", err)
 			return model.StageStatus_STAGE_FAILURE
 		}
+		analyzer.window = window
 		eg.Go(func() error {
 			e.LogPersister.Infof("[%s] Start http analyzer", analyzer.id)
 			return analyzer.run(ctxWithTimeout)
@@ -199,6 +250,9 @@ const elapsedTimeKey = "elapsedTime"
 // that's why count should be stored.
 func (e *Executor) saveElapsedTime(ctx context.Context) {
 	elapsedTime := time.Since(e.startTime) + e.previousElapsedTime
+	if e.extendDuration {
+		elapsedTime = time.Duration(e.windowElapsedTime.Load()) + e.previousElapsedTime
+	}
 	metadata := map[string]string{
 		elapsedTimeKey: elapsedTime.String(),
 	}
@@ -207,6 +261,32 @@ func (e *Executor) saveElapsedTime(ctx context.Context) {
 	}
 }
 
+const windowCheckInterval = 10 * time.Second
+
+// countWindowTime counts the time spent within the given window
+// and calls cancel once it reaches the given duration.
+func (e *Executor) countWindowTime(ctx context.Context, window *config.AnalysisWindow, duration time.Duration, cancel context.CancelFunc) {
+	ticker := time.NewTicker(windowCheckInterval)
+	defer ticker.Stop()
+
+	last := time.Now()
+	for {
+		select {
+		case now := <-ticker.C:
+			if window.Contains(now) {
+				e.windowElapsedTime.Add(int64(now.Sub(last)))
+			}
+			last = now
+			if time.Duration(e.windowElapsedTime.Load()) >= duration {
+				cancel()
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // retrievePreviousElapsedTime sets the elapsed time of analysis stage by decoding metadata.
 func (e *Executor) retrievePreviousElapsedTime() time.Duration {
 	s, ok := e.MetadataStore.Stage(e.Stage.Id).Get(elapsedTimeKey)
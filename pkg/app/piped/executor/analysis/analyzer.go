@@ -24,6 +24,7 @@ import (
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/config"
 )
 
 // analyzer contains a query for an analysis provider.
@@ -36,6 +37,8 @@ type analyzer struct {
 	// The analysis will fail, if this value is exceeded,
 	failureLimit int
 	skipOnNoData bool
+	// The analysis is evaluated only within this window if specified.
+	window *config.AnalysisWindow
 
 	logger       *zap.Logger
 	logPersister executor.LogPersister
@@ -76,13 +79,27 @@ func (a *analyzer) run(ctx context.Context) error {
 	ticker := time.NewTicker(a.interval)
 	defer ticker.Stop()
 
-	failureCount := 0
+	var (
+		failureCount = 0
+		outOfWindow  = false
+	)
 	for {
 		select {
-		case <-ticker.C:
+		case now := <-ticker.C:
+			if a.window != nil && !a.window.Contains(now) {
+				if !outOfWindow {
+					a.logPersister.Infof("[%s] The evaluation is paused since it is outside the analysis schedule", a.id)
+				}
+				outOfWindow = true
+				continue
+			}
+			if outOfWindow {
+				a.logPersister.Infof("[%s] The evaluation is resumed since it is inside the analysis schedule", a.id)
+				outOfWindow = false
+			}
 			expected, reason, err := a.evaluate(ctx, a.query)
-			// Ignore parent's context deadline exceeded error, and return immediately.
-			if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == context.DeadlineExceeded {
+			// Ignore the error caused by the end of the parent's context, and return immediately.
+			if err != nil && ctx.Err() != nil {
 				return nil
 			}
 			if errors.Is(err, metrics.ErrNoDataFound) && a.skipOnNoData {
@@ -45,6 +45,8 @@ type metricsAnalyzer struct {
 	analysisResultStore executor.AnalysisResultStore
 	// Application-specific arguments using when rendering the query.
 	argsTemplate argsTemplate
+	// The analysis is evaluated only within this window if specified.
+	window       *config.AnalysisWindow
 	logger       *zap.Logger
 	logPersister executor.LogPersister
 }
@@ -70,10 +72,24 @@ func (a *metricsAnalyzer) run(ctx context.Context) error {
 	ticker := time.NewTicker(a.cfg.Interval.Duration())
 	defer ticker.Stop()
 
-	failureCount := 0
+	var (
+		failureCount = 0
+		outOfWindow  = false
+	)
 	for {
 		select {
-		case <-ticker.C:
+		case now := <-ticker.C:
+			if a.window != nil && !a.window.Contains(now) {
+				if !outOfWindow {
+					a.logPersister.Infof("[%s] The evaluation is paused since it is outside the analysis schedule", a.id)
+				}
+				outOfWindow = true
+				continue
+			}
+			if outOfWindow {
+				a.logPersister.Infof("[%s] The evaluation is resumed since it is inside the analysis schedule", a.id)
+				outOfWindow = false
+			}
 			var (
 				expected bool
 				err      error
@@ -95,8 +111,8 @@ func (a *metricsAnalyzer) run(ctx context.Context) error {
 			default:
 				return fmt.Errorf("unknown strategy %q given", a.cfg.Strategy)
 			}
-			// Ignore parent's context deadline exceeded error, and return immediately.
-			if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == context.DeadlineExceeded {
+			// Ignore the error caused by the end of the parent's context, and return immediately.
+			if err != nil && ctx.Err() != nil {
 				return nil
 			}
 			if errors.Is(err, metrics.ErrNoDataFound) && a.cfg.SkipOnNoData {
@@ -0,0 +1,91 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const trafficRoutingStepKey = "traffic-routing-step"
+
+// RouteTrafficBySteps shifts the traffic to CANARY variant progressively
+// by calling the given route function with the percentages of each step.
+// Between the steps, it waits for the interval or runs the analysis of the step,
+// and the stage fails as soon as any of the analyses does not pass.
+func RouteTrafficBySteps(sig executor.StopSignal, in executor.Input, options *config.TrafficRoutingSteps, route func(primary, canary int) bool) model.StageStatus {
+	ctx := sig.Context()
+	steps := options.Steps
+
+	// Resume from the step that was running when the stage was interrupted.
+	start := 0
+	if value, ok := in.MetadataStore.Stage(in.Stage.Id).Get(trafficRoutingStepKey); ok {
+		if i, err := strconv.Atoi(value); err == nil && i >= 0 && i < len(steps) {
+			start = i
+		} else {
+			in.Logger.Error("Unexpected traffic routing step is stored", zap.String("value", value), zap.Error(err))
+		}
+	}
+
+	for i := start; i < len(steps); i++ {
+		step := steps[i]
+		if err := in.MetadataStore.Stage(in.Stage.Id).Put(ctx, trafficRoutingStepKey, strconv.Itoa(i)); err != nil {
+			in.Logger.Error("Failed to store traffic routing step to metadata store", zap.Error(err))
+		}
+
+		canary := step.Canary.Int()
+		in.LogPersister.Infof("Step %d/%d: routing %d%% of traffic to CANARY variant", i+1, len(steps), canary)
+		if !route(100-canary, canary) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+
+		if step.Analysis != nil {
+			in.LogPersister.Infof("Step %d/%d: running the analysis for %v", i+1, len(steps), step.Analysis.Duration.Duration())
+			status := Analyze(sig, in, step.Analysis)
+			if status != model.StageStatus_STAGE_SUCCESS && status != model.StageStatus_STAGE_SKIPPED {
+				in.LogPersister.Errorf("Step %d/%d: the analysis did not pass", i+1, len(steps))
+				return status
+			}
+			continue
+		}
+
+		// No need to wait after the last step.
+		if i == len(steps)-1 {
+			break
+		}
+		interval := options.StepIntervalAt(i)
+		if interval <= 0 {
+			continue
+		}
+		in.LogPersister.Infof("Step %d/%d: waiting for %v before proceeding to the next step", i+1, len(steps), interval)
+		timer := time.NewTimer(interval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			in.LogPersister.Info("The stage was cancelled while waiting for the next traffic routing step")
+			return model.StageStatus_STAGE_CANCELLED
+		}
+	}
+
+	in.LogPersister.Successf("Routed %d%% of traffic to CANARY variant in %d steps", steps[len(steps)-1].Canary.Int(), len(steps))
+	return model.StageStatus_STAGE_SUCCESS
+}
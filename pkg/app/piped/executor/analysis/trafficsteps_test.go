@@ -0,0 +1,112 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/metadatastore"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type mapMetadataStore map[string]string
+
+func (m mapMetadataStore) Shared() metadatastore.Store              { return m }
+func (m mapMetadataStore) Stage(_ string) metadatastore.Store       { return m }
+func (m mapMetadataStore) Get(key string) (string, bool)            { v, ok := m[key]; return v, ok }
+func (m mapMetadataStore) Put(_ context.Context, k, v string) error { m[k] = v; return nil }
+func (m mapMetadataStore) PutMulti(_ context.Context, md map[string]string) error {
+	for k, v := range md {
+		m[k] = v
+	}
+	return nil
+}
+
+func TestRouteTrafficBySteps(t *testing.T) {
+	t.Parallel()
+
+	steps := &config.TrafficRoutingSteps{
+		Steps: []config.TrafficRoutingStep{
+			{Canary: config.Percentage{Number: 10}},
+			{Canary: config.Percentage{Number: 50}},
+			{Canary: config.Percentage{Number: 100}},
+		},
+	}
+	testcases := []struct {
+		name     string
+		metadata mapMetadataStore
+		failAt   int
+		want     model.StageStatus
+		wantSeen []int
+	}{
+		{
+			name:     "all steps are routed",
+			metadata: mapMetadataStore{},
+			failAt:   -1,
+			want:     model.StageStatus_STAGE_SUCCESS,
+			wantSeen: []int{10, 50, 100},
+		},
+		{
+			name:     "resume from the stored step",
+			metadata: mapMetadataStore{trafficRoutingStepKey: "1"},
+			failAt:   -1,
+			want:     model.StageStatus_STAGE_SUCCESS,
+			wantSeen: []int{50, 100},
+		},
+		{
+			name:     "start over when the stored step is invalid",
+			metadata: mapMetadataStore{trafficRoutingStepKey: "5"},
+			failAt:   -1,
+			want:     model.StageStatus_STAGE_SUCCESS,
+			wantSeen: []int{10, 50, 100},
+		},
+		{
+			name:     "stop at the failed step",
+			metadata: mapMetadataStore{},
+			failAt:   50,
+			want:     model.StageStatus_STAGE_FAILURE,
+			wantSeen: []int{10, 50},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			in := executor.Input{
+				Stage:         &model.PipelineStage{Id: "stage-id"},
+				MetadataStore: tc.metadata,
+				LogPersister:  &fakeLogPersister{},
+				Logger:        zap.NewNop(),
+			}
+			sig, _ := executor.NewStopSignal()
+
+			var seen []int
+			got := RouteTrafficBySteps(sig, in, steps, func(primary, canary int) bool {
+				assert.Equal(t, 100, primary+canary)
+				seen = append(seen, canary)
+				return canary != tc.failAt
+			})
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantSeen, seen)
+		})
+	}
+}
@@ -232,6 +232,38 @@ func revisionExists(ctx context.Context, client provider.Client, revisionName st
 	return false, err
 }
 
+// addDefaultLabels adds the platform provider's default labels
+// which are not defined in the service manifest yet to both Service and Revision.
+func addDefaultLabels(sm provider.ServiceManifest, labels map[string]string, revisionName string, lp executor.LogPersister) bool {
+	if len(labels) == 0 {
+		return true
+	}
+	serviceLabels := sm.Labels()
+	missing := make(map[string]string, len(labels))
+	for k, v := range labels {
+		if _, ok := serviceLabels[k]; !ok {
+			missing[k] = v
+		}
+	}
+	sm.AddLabels(missing)
+
+	if revisionName == "" {
+		return true
+	}
+	revisionLabels := sm.RevisionLabels()
+	missing = make(map[string]string, len(labels))
+	for k, v := range labels {
+		if _, ok := revisionLabels[k]; !ok {
+			missing[k] = v
+		}
+	}
+	if err := sm.AddRevisionLabels(missing); err != nil {
+		lp.Errorf("Unable to add default revision labels for the service manifest %s (%v)", sm.Name, err)
+		return false
+	}
+	return true
+}
+
 func addBuiltinLabels(sm provider.ServiceManifest, hash, pipedID, appID, revisionName string, lp executor.LogPersister) bool {
 	labels := map[string]string{
 		provider.LabelManagedBy:   provider.ManagedByPiped,
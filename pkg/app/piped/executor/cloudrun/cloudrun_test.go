@@ -91,3 +91,29 @@ func TestAddBuiltinLabels(t *testing.T) {
 	got = sm.RevisionLabels()
 	assert.Equal(t, want, got)
 }
+
+func TestAddDefaultLabels(t *testing.T) {
+	t.Parallel()
+
+	sm, err := provider.ParseServiceManifest([]byte(serviceManifest))
+	require.NoError(t, err)
+	sm.AddLabels(map[string]string{"owner": "team-a"})
+
+	ok := addDefaultLabels(sm, map[string]string{
+		"owner":       "platform",
+		"cost-center": "cc-123",
+	}, "revision-name", nil)
+	require.True(t, ok)
+
+	want := map[string]string{
+		"owner":       "team-a",
+		"cost-center": "cc-123",
+	}
+	assert.Equal(t, want, sm.Labels())
+
+	want = map[string]string{
+		"owner":       "platform",
+		"cost-center": "cc-123",
+	}
+	assert.Equal(t, want, sm.RevisionLabels())
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/analysis"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/cloudrun"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -37,9 +38,10 @@ const (
 type deployExecutor struct {
 	executor.Input
 
-	deploySource *deploysource.DeploySource
-	appCfg       *config.CloudRunApplicationSpec
-	client       provider.Client
+	deploySource  *deploysource.DeploySource
+	appCfg        *config.CloudRunApplicationSpec
+	client        provider.Client
+	defaultLabels config.ResourceTags
 }
 
 func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
@@ -67,6 +69,7 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 		e.LogPersister.Errorf("Unable to create ClourRun client for the provider (%v)", err)
 		return model.StageStatus_STAGE_FAILURE
 	}
+	e.defaultLabels = cpCfg.DefaultLabels
 
 	var (
 		originalStatus = e.Stage.Status
@@ -78,7 +81,7 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 		status = e.ensureSync(ctx)
 
 	case model.StageCloudRunPromote:
-		status = e.ensurePromote(ctx)
+		status = e.ensurePromote(sig)
 
 	default:
 		e.LogPersister.Errorf("Unsupported stage %s for cloudrun application", e.Stage.Name)
@@ -111,6 +114,9 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 
 	// Add builtin labels for tracking application live state
 	commit := e.Deployment.CommitHash()
+	if !addDefaultLabels(sm, e.ResolveResourceTags(e.defaultLabels, commit), revision, e.LogPersister) {
+		return model.StageStatus_STAGE_FAILURE
+	}
 	if !addBuiltinLabels(sm, commit, e.PipedConfig.PipedID, e.Deployment.ApplicationId, revision, e.LogPersister) {
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -133,14 +139,27 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 	return model.StageStatus_STAGE_SUCCESS
 }
 
-func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
+func (e *deployExecutor) ensurePromote(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
 	options := e.StageConfig.CloudRunPromoteStageOptions
 	if options == nil {
 		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
 		return model.StageStatus_STAGE_FAILURE
 	}
+
+	if len(options.Steps) > 0 {
+		return analysis.RouteTrafficBySteps(sig, e.Input, &options.TrafficRoutingSteps, func(_, canary int) bool {
+			return e.promote(ctx, canary) == model.StageStatus_STAGE_SUCCESS
+		})
+	}
+	return e.promote(ctx, options.Percent.Int())
+}
+
+// promote routes the given percentage of traffic to the revision at the target commit
+// and the rest to the last deployed one.
+func (e *deployExecutor) promote(ctx context.Context, percent int) model.StageStatus {
 	metadata := map[string]string{
-		promotePercentageMetadataKey: strconv.FormatInt(int64(options.Percent.Int()), 10),
+		promotePercentageMetadataKey: strconv.FormatInt(int64(percent), 10),
 	}
 	if err := e.MetadataStore.Stage(e.Stage.Id).PutMulti(ctx, metadata); err != nil {
 		e.Logger.Error("failed to save routing percentages to metadata", zap.Error(err))
@@ -188,11 +207,11 @@ func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
 	traffics := []provider.RevisionTraffic{
 		{
 			RevisionName: revision,
-			Percent:      options.Percent.Int(),
+			Percent:      percent,
 		},
 		{
 			RevisionName: lastDeployedRevision,
-			Percent:      100 - options.Percent.Int(),
+			Percent:      100 - percent,
 		},
 	}
 
@@ -212,6 +231,9 @@ func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
 	}
 
 	commit := e.Deployment.CommitHash()
+	if !addDefaultLabels(sm, e.ResolveResourceTags(e.defaultLabels, commit), newRevision, e.LogPersister) {
+		return model.StageStatus_STAGE_FAILURE
+	}
 	if !addBuiltinLabels(sm, commit, e.PipedConfig.PipedID, e.Deployment.ApplicationId, newRevision, e.LogPersister) {
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -19,12 +19,14 @@ import (
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/cloudrun"
+	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 type rollbackExecutor struct {
 	executor.Input
-	client provider.Client
+	client        provider.Client
+	defaultLabels config.ResourceTags
 }
 
 func (e *rollbackExecutor) Execute(sig executor.StopSignal) model.StageStatus {
@@ -45,6 +47,7 @@ func (e *rollbackExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 		e.LogPersister.Errorf("Unable to create ClourRun client for the provider (%v)", err)
 		return model.StageStatus_STAGE_FAILURE
 	}
+	e.defaultLabels = cpCfg.DefaultLabels
 
 	switch model.Stage(e.Stage.Name) {
 	case model.StageRollback:
@@ -98,6 +101,9 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 	}
 
 	// Add builtin labels for tracking application live state)
+	if !addDefaultLabels(sm, e.ResolveResourceTags(e.defaultLabels, e.Deployment.RunningCommitHash), revision, e.LogPersister) {
+		return model.StageStatus_STAGE_FAILURE
+	}
 	if !addBuiltinLabels(sm, e.Deployment.RunningCommitHash, e.PipedConfig.PipedID, e.Deployment.ApplicationId, revision, e.LogPersister) {
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -15,12 +15,13 @@
 package customsync
 
 import (
+	"context"
 	"os"
-	"os/exec"
 	"strings"
 	"time"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/sandbox"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -65,9 +66,13 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 
 	timeout := e.StageConfig.CustomSyncOptions.Timeout.Duration()
 
+	// The running commands will be killed when this stage was finished.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	c := make(chan model.StageStatus, 1)
 	go func() {
-		c <- e.executeCommand()
+		c <- e.executeCommand(ctx)
 	}()
 
 	timer := time.NewTimer(timeout)
@@ -94,7 +99,7 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 	}
 }
 
-func (e *deployExecutor) executeCommand() model.StageStatus {
+func (e *deployExecutor) executeCommand(ctx context.Context) model.StageStatus {
 	opts := e.StageConfig.CustomSyncOptions
 
 	e.LogPersister.Infof("Runnnig commands...")
@@ -109,12 +114,15 @@ func (e *deployExecutor) executeCommand() model.StageStatus {
 		envs = append(envs, key+"="+value)
 	}
 
-	cmd := exec.Command("/bin/sh", "-l", "-c", opts.Run)
-	cmd.Dir = e.appDir
-	cmd.Env = append(os.Environ(), envs...)
-	cmd.Stdout = e.LogPersister
-	cmd.Stderr = e.LogPersister
-	if err := cmd.Run(); err != nil {
+	cmd := sandbox.Command{
+		Script:    opts.Run,
+		Dir:       e.appDir,
+		Env:       append(os.Environ(), envs...),
+		Stdout:    e.LogPersister,
+		Stderr:    e.LogPersister,
+		Resources: opts.Resources,
+	}
+	if err := sandbox.Run(ctx, cmd); err != nil {
 		return model.StageStatus_STAGE_FAILURE
 	}
 	return model.StageStatus_STAGE_SUCCESS
@@ -17,10 +17,10 @@ package customsync
 import (
 	"context"
 	"os"
-	"os/exec"
 	"strings"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/sandbox"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -75,10 +75,10 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 	}
 	e.LogPersister.Infof("Start rollback for custom sync")
 
-	return e.executeCommand(runningDS.GenericApplicationConfig.Pipeline.Stages[0])
+	return e.executeCommand(ctx, runningDS.GenericApplicationConfig.Pipeline.Stages[0])
 }
 
-func (e *rollbackExecutor) executeCommand(config config.PipelineStage) model.StageStatus {
+func (e *rollbackExecutor) executeCommand(ctx context.Context, config config.PipelineStage) model.StageStatus {
 	opts := config.CustomSyncOptions
 
 	e.LogPersister.Infof("Runnnig commands...")
@@ -93,12 +93,15 @@ func (e *rollbackExecutor) executeCommand(config config.PipelineStage) model.Sta
 		envs = append(envs, key+"="+value)
 	}
 
-	cmd := exec.Command("/bin/sh", "-l", "-c", opts.Run)
-	cmd.Dir = e.appDir
-	cmd.Env = append(os.Environ(), envs...)
-	cmd.Stdout = e.LogPersister
-	cmd.Stderr = e.LogPersister
-	if err := cmd.Run(); err != nil {
+	cmd := sandbox.Command{
+		Script:    opts.Run,
+		Dir:       e.appDir,
+		Env:       append(os.Environ(), envs...),
+		Stdout:    e.LogPersister,
+		Stderr:    e.LogPersister,
+		Resources: opts.Resources,
+	}
+	if err := sandbox.Run(ctx, cmd); err != nil {
 		return model.StageStatus_STAGE_FAILURE
 	}
 	return model.StageStatus_STAGE_SUCCESS
@@ -0,0 +1,103 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const meshRoutedKey = "app-mesh-routed"
+
+// withServiceRegistry returns the service definition whose task sets are registered to the given registry,
+// e.g. the AWS Cloud Map service of the App Mesh virtual node of a variant.
+func withServiceRegistry(serviceDefinition types.Service, registryArn string) types.Service {
+	var registry types.ServiceRegistry
+	if len(serviceDefinition.ServiceRegistries) > 0 {
+		registry = serviceDefinition.ServiceRegistries[0]
+	}
+	registry.RegistryArn = aws.String(registryArn)
+	serviceDefinition.ServiceRegistries = []types.ServiceRegistry{registry}
+	return serviceDefinition
+}
+
+// routingMesh modifies the weights of the App Mesh route to forward the traffic to PRIMARY/CANARY variants.
+func routingMesh(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, mesh config.ECSAppMesh, primary, canary int) bool {
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
+		return false
+	}
+
+	metadataPercentage := map[string]string{
+		trafficRoutePrimaryMetadataKey: strconv.FormatInt(int64(primary), 10),
+		trafficRouteCanaryMetadataKey:  strconv.FormatInt(int64(canary), 10),
+	}
+	if err := in.MetadataStore.Stage(in.Stage.Id).PutMulti(ctx, metadataPercentage); err != nil {
+		in.Logger.Error("Failed to store traffic routing config to metadata store", zap.Error(err))
+	}
+	// Persist to reset the route in rollback.
+	if err := in.MetadataStore.Shared().Put(ctx, meshRoutedKey, "true"); err != nil {
+		in.LogPersister.Errorf("Unable to store the routed App Mesh route to metadata store: %v", err)
+		return false
+	}
+
+	if err := client.ModifyMeshRouteWeights(ctx, mesh, primary, canary); err != nil {
+		in.LogPersister.Errorf("Failed to route traffic to PRIMARY/CANARY variants: %v", err)
+		return false
+	}
+	in.LogPersister.Infof("Modified route %s of virtual router %s to forward %d%% of traffic to %s (PRIMARY) and %d%% to %s (CANARY)",
+		mesh.RouteName, mesh.VirtualRouterName, primary, mesh.Primary.VirtualNodeName, canary, mesh.Canary.VirtualNodeName)
+	return true
+}
+
+// rollbackMesh routes all traffic of the App Mesh route back to PRIMARY variant
+// when it was modified by the deployment.
+func rollbackMesh(ctx context.Context, in *executor.Input, client provider.Client, mesh config.ECSAppMesh) bool {
+	if _, ok := in.MetadataStore.Shared().Get(meshRoutedKey); !ok {
+		in.LogPersister.Infof("Skip rolling back App Mesh route because it seems the deployment failed before updating it")
+		return true
+	}
+	if err := client.ModifyMeshRouteWeights(ctx, mesh, 100, 0); err != nil {
+		in.LogPersister.Errorf("Failed to route traffic back to PRIMARY variant: %v", err)
+		return false
+	}
+	in.LogPersister.Infof("Successfully rolled back route %s of virtual router %s to forward all traffic to %s (PRIMARY)", mesh.RouteName, mesh.VirtualRouterName, mesh.Primary.VirtualNodeName)
+	return true
+}
+
+// checkMeshCanaryNotServing returns false when the App Mesh route is still forwarding traffic
+// to the virtual node of CANARY variant or the check could not be done.
+func checkMeshCanaryNotServing(ctx context.Context, in *executor.Input, client provider.Client, mesh config.ECSAppMesh) bool {
+	weights, err := client.GetMeshRouteWeights(ctx, mesh)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to determine the traffic forwarded to CANARY virtual node %s: %v", mesh.Canary.VirtualNodeName, err)
+		return false
+	}
+	if w := weights[mesh.Canary.VirtualNodeName]; w > 0 {
+		in.LogPersister.Errorf("CANARY virtual node %s is still receiving traffic (weight: %d). Route all traffic back to PRIMARY variant before cleaning CANARY variant, or set force option to clean it anyway", mesh.Canary.VirtualNodeName, w)
+		return false
+	}
+	in.LogPersister.Infof("Confirmed that CANARY virtual node %s is not receiving traffic", mesh.Canary.VirtualNodeName)
+	return true
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// recordServiceAutoScaling stores the Application Auto Scaling settings of the given service
+// into the shared metadata so that the rollback can restore them.
+// Like recordPrimaryTaskDefinition, it records exactly once per deployment before any change is made,
+// and stores an empty value when the settings cannot be fetched.
+// The returned value is false only when the result could not be stored.
+func recordServiceAutoScaling(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, serviceDefinition types.Service) bool {
+	if _, ok := in.MetadataStore.Shared().Get(serviceAutoScalingKey); ok {
+		return true
+	}
+
+	data := getServiceAutoScaling(ctx, in, platformProviderName, platformProviderCfg, serviceDefinition)
+	if err := in.MetadataStore.Shared().Put(ctx, serviceAutoScalingKey, data); err != nil {
+		in.LogPersister.Errorf("Failed to store the auto scaling settings to metadata store: %v", err)
+		return false
+	}
+	return true
+}
+
+// getServiceAutoScaling returns the marshaled Application Auto Scaling settings of the given service,
+// or an empty string when they are unavailable.
+// Not fail the deployment in that case because the auto scaling settings are not always used and
+// piped may not be permitted to access Application Auto Scaling.
+func getServiceAutoScaling(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, serviceDefinition types.Service) string {
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.Logger.Error("Unable to create ECS client to record the auto scaling settings", zap.Error(err))
+		return ""
+	}
+
+	settings, err := client.GetServiceAutoScaling(ctx, serviceDefinition)
+	if err != nil {
+		in.LogPersister.Infof("Unable to record the auto scaling settings of ECS service %s, they will not be restored on rollback: %v", *serviceDefinition.ServiceName, err)
+		return ""
+	}
+
+	data, err := json.Marshal(settings)
+	if err != nil {
+		in.Logger.Error("Failed to marshal the auto scaling settings", zap.Error(err))
+		return ""
+	}
+	in.LogPersister.Infof("Recorded %d scalable target(s) and %d scaling policy(ies) of ECS service %s", len(settings.ScalableTargets), len(settings.ScalingPolicies), *serviceDefinition.ServiceName)
+	return string(data)
+}
+
+// applyServiceAutoScaling makes the Application Auto Scaling settings of the given service the ones specified by the application configuration.
+func applyServiceAutoScaling(ctx context.Context, in *executor.Input, client provider.Client, service types.Service, cfg config.ECSAutoScaling) bool {
+	settings := provider.MakeServiceAutoScaling(service, cfg)
+	in.LogPersister.Infof("Start applying the auto scaling settings of ECS service %s", *service.ServiceName)
+	if err := client.PutServiceAutoScaling(ctx, service, settings); err != nil {
+		in.LogPersister.Errorf("Failed to apply the auto scaling settings of ECS service %s: %v", *service.ServiceName, err)
+		return false
+	}
+
+	in.LogPersister.Infof("Successfully applied %d scalable target(s) and %d scaling policy(ies) of ECS service %s", len(settings.ScalableTargets), len(settings.ScalingPolicies), *service.ServiceName)
+	return true
+}
+
+// restoreServiceAutoScaling restores the Application Auto Scaling settings recorded at the beginning of the deployment.
+func restoreServiceAutoScaling(ctx context.Context, in *executor.Input, client provider.Client, serviceDefinition types.Service) bool {
+	data, ok := in.MetadataStore.Shared().Get(serviceAutoScalingKey)
+	if !ok || data == "" {
+		in.LogPersister.Infof("Skip restoring the auto scaling settings because they were not recorded")
+		return true
+	}
+
+	var settings provider.ServiceAutoScaling
+	if err := json.Unmarshal([]byte(data), &settings); err != nil {
+		in.LogPersister.Errorf("Failed to load the recorded auto scaling settings of ECS service %s: %v", *serviceDefinition.ServiceName, err)
+		return false
+	}
+
+	if err := client.PutServiceAutoScaling(ctx, serviceDefinition, settings); err != nil {
+		in.LogPersister.Errorf("Failed to restore the auto scaling settings of ECS service %s: %v", *serviceDefinition.ServiceName, err)
+		return false
+	}
+
+	in.LogPersister.Infof("Successfully restored %d scalable target(s) and %d scaling policy(ies) of ECS service %s", len(settings.ScalableTargets), len(settings.ScalingPolicies), *serviceDefinition.ServiceName)
+	return true
+}
@@ -0,0 +1,229 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	cdtypes "github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	defaultCodeDeployTimeout      = time.Hour
+	codeDeployCheckInterval       = 15 * time.Second
+	stopCodeDeployDeploymentLimit = time.Minute
+)
+
+// codeDeploy deploys the given task definition to the given service by a CodeDeploy blue/green deployment,
+// and waits until the deployment is completed while reporting its lifecycle events.
+func codeDeploy(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, cdCfg config.ECSCodeDeployInput, taskDefinition types.TaskDefinition, serviceDefinition types.Service, targetGroup *types.LoadBalancer) bool {
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
+		return false
+	}
+
+	// The service can not be created by PipeCD because it only creates the services using EXTERNAL deployment controller.
+	found, err := client.ServiceExists(ctx, *serviceDefinition.ClusterArn, *serviceDefinition.ServiceName)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to validate service name %s: %v", *serviceDefinition.ServiceName, err)
+		return false
+	}
+	if !found {
+		in.LogPersister.Errorf("ECS service %s must be created with CODE_DEPLOY deployment controller before deploying it by CodeDeploy", *serviceDefinition.ServiceName)
+		return false
+	}
+
+	in.LogPersister.Infof("Start applying the ECS task definition")
+	td, err := applyTaskDefinition(ctx, client, taskDefinition)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to apply ECS task definition: %v", err)
+		return false
+	}
+
+	in.LogPersister.Infof("Start applying the ECS service definition")
+	if _, err := applyServiceDefinition(ctx, client, serviceDefinition); err != nil {
+		in.LogPersister.Errorf("Failed to apply service %s: %v", *serviceDefinition.ServiceName, err)
+		return false
+	}
+
+	group := cdCfg.DeploymentGroupName
+	if group == "" {
+		group, err = client.FindCodeDeployDeploymentGroup(ctx, cdCfg.ApplicationName, serviceDefinition)
+		if errors.Is(err, platformprovider.ErrNotFound) {
+			in.LogPersister.Errorf("No deployment group of CodeDeploy application %s deploys ECS service %s", cdCfg.ApplicationName, *serviceDefinition.ServiceName)
+			return false
+		}
+		if err != nil {
+			in.LogPersister.Errorf("Failed to find the deployment group of ECS service %s: %v", *serviceDefinition.ServiceName, err)
+			return false
+		}
+		in.LogPersister.Infof("Found deployment group %s deploying ECS service %s", group, *serviceDefinition.ServiceName)
+	}
+
+	appSpec, err := provider.MakeCodeDeployAppSpec(*td.TaskDefinitionArn, serviceDefinition, cdCfg, targetGroup)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to generate AppSpec for CodeDeploy: %v", err)
+		return false
+	}
+
+	desc := fmt.Sprintf("Deployed by PipeCD deployment %s at commit %s", in.Deployment.Id, in.Deployment.CommitHash())
+	id, err := client.CreateCodeDeployDeployment(ctx, cdCfg.ApplicationName, group, cdCfg.DeploymentConfigName, appSpec, desc)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to create CodeDeploy deployment: %v", err)
+		return false
+	}
+	in.LogPersister.Infof("Created CodeDeploy deployment %s deploying task definition %s", id, *td.TaskDefinitionArn)
+
+	if err := in.MetadataStore.Shared().Put(ctx, codeDeployDeploymentIDKey, id); err != nil {
+		in.LogPersister.Errorf("Unable to store the CodeDeploy deployment ID to metadata store: %v", err)
+	}
+
+	timeout := cdCfg.Timeout.Duration()
+	if timeout <= 0 {
+		timeout = defaultCodeDeployTimeout
+	}
+	return waitCodeDeployDeployment(ctx, in, client, id, timeout)
+}
+
+// waitCodeDeployDeployment waits until the given deployment is completed.
+// The deployment is stopped and rolled back by CodeDeploy when it is not completed before the timeout
+// or the stage is cancelled.
+func waitCodeDeployDeployment(ctx context.Context, in *executor.Input, client provider.Client, id string, timeout time.Duration) bool {
+	in.LogPersister.Infof("Waiting for CodeDeploy deployment %s to be completed (timeout: %v)", id, timeout)
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(codeDeployCheckInterval)
+	defer ticker.Stop()
+
+	var (
+		status   cdtypes.DeploymentStatus
+		reported = make(map[string]cdtypes.LifecycleEventStatus)
+	)
+	for {
+		d, err := client.GetCodeDeployDeployment(ctx, id)
+		if err != nil {
+			// Keep waiting because the deployment is proceeded by CodeDeploy regardless of this error.
+			in.LogPersister.Infof("Unable to get the status of CodeDeploy deployment %s, will retry: %v", id, err)
+		} else {
+			if d.Status != status {
+				status = d.Status
+				in.LogPersister.Infof("CodeDeploy deployment %s is %s", id, status)
+			}
+			for _, e := range changedLifecycleEvents(reported, d.LifecycleEvents) {
+				in.LogPersister.Infof("- lifecycle event %s is %s", e.Name, e.Status)
+			}
+			if d.IsCompleted() {
+				return reportCodeDeployResult(in, d)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-timer.C:
+			in.LogPersister.Errorf("CodeDeploy deployment %s was not completed in %v", id, timeout)
+			stopCodeDeployDeployment(in, client, id)
+			return false
+		case <-ctx.Done():
+			in.LogPersister.Info("The stage was cancelled while waiting for CodeDeploy deployment")
+			stopCodeDeployDeployment(in, client, id)
+			return false
+		}
+	}
+}
+
+// changedLifecycleEvents returns the lifecycle events whose status was changed from the reported one,
+// and records their current status as reported.
+func changedLifecycleEvents(reported map[string]cdtypes.LifecycleEventStatus, events []provider.CodeDeployLifecycleEvent) []provider.CodeDeployLifecycleEvent {
+	changed := make([]provider.CodeDeployLifecycleEvent, 0)
+	for _, e := range events {
+		// Not report the events which have not been started yet.
+		if e.Status == "" || e.Status == cdtypes.LifecycleEventStatusPending {
+			continue
+		}
+		if reported[e.Name] == e.Status {
+			continue
+		}
+		reported[e.Name] = e.Status
+		changed = append(changed, e)
+	}
+	return changed
+}
+
+func reportCodeDeployResult(in *executor.Input, d *provider.CodeDeployDeployment) bool {
+	if d.Status == cdtypes.DeploymentStatusSucceeded {
+		in.LogPersister.Successf("Successfully completed CodeDeploy deployment %s", d.ID)
+		return true
+	}
+
+	in.LogPersister.Errorf("CodeDeploy deployment %s was %s: %s", d.ID, d.Status, d.ErrorMessage)
+	for _, e := range d.LifecycleEvents {
+		if e.Status == cdtypes.LifecycleEventStatusFailed && e.Message != "" {
+			in.LogPersister.Errorf("- lifecycle event %s failed: %s", e.Name, e.Message)
+		}
+	}
+	return false
+}
+
+func stopCodeDeployDeployment(in *executor.Input, client provider.Client, id string) {
+	// Use a new context since the given one could be already cancelled.
+	ctx, cancel := context.WithTimeout(context.Background(), stopCodeDeployDeploymentLimit)
+	defer cancel()
+
+	in.LogPersister.Infof("Stopping CodeDeploy deployment %s and rolling it back", id)
+	if err := client.StopCodeDeployDeployment(ctx, id, true); err != nil {
+		in.LogPersister.Errorf("Failed to stop CodeDeploy deployment %s: %v", id, err)
+		return
+	}
+	in.LogPersister.Infof("Successfully requested to stop CodeDeploy deployment %s", id)
+}
+
+// rollbackCodeDeploy deploys the given task definition of the last deployment by CodeDeploy.
+// The CodeDeploy deployment created by this deployment is stopped beforehand when it is still in progress.
+func rollbackCodeDeploy(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, cdCfg config.ECSCodeDeployInput, taskDefinition types.TaskDefinition, serviceDefinition types.Service, targetGroup *types.LoadBalancer) bool {
+	if id, ok := in.MetadataStore.Shared().Get(codeDeployDeploymentIDKey); ok && id != "" {
+		client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+		if err != nil {
+			in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
+			return false
+		}
+		d, err := client.GetCodeDeployDeployment(ctx, id)
+		if err != nil {
+			in.LogPersister.Errorf("Failed to get CodeDeploy deployment %s: %v", id, err)
+			return false
+		}
+		if !d.IsCompleted() {
+			// Not roll back by CodeDeploy since the last deployed task definition is deployed below.
+			in.LogPersister.Infof("Stopping CodeDeploy deployment %s which is still %s", id, d.Status)
+			if err := client.StopCodeDeployDeployment(ctx, id, false); err != nil {
+				in.LogPersister.Errorf("Failed to stop CodeDeploy deployment %s: %v", id, err)
+				return false
+			}
+		}
+	}
+
+	in.LogPersister.Infof("Start rolling back ECS service %s to task definition family %s by CodeDeploy", *serviceDefinition.ServiceName, *taskDefinition.Family)
+	return codeDeploy(ctx, in, platformProviderName, platformProviderCfg, cdCfg, taskDefinition, serviceDefinition, targetGroup)
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	cdtypes "github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/stretchr/testify/assert"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+)
+
+func TestChangedLifecycleEvents(t *testing.T) {
+	t.Parallel()
+
+	reported := make(map[string]cdtypes.LifecycleEventStatus)
+
+	got := changedLifecycleEvents(reported, []provider.CodeDeployLifecycleEvent{
+		{Name: "BeforeInstall", Status: cdtypes.LifecycleEventStatusSucceeded},
+		{Name: "Install", Status: cdtypes.LifecycleEventStatusInProgress},
+		{Name: "AfterInstall", Status: cdtypes.LifecycleEventStatusPending},
+	})
+	assert.Equal(t, []provider.CodeDeployLifecycleEvent{
+		{Name: "BeforeInstall", Status: cdtypes.LifecycleEventStatusSucceeded},
+		{Name: "Install", Status: cdtypes.LifecycleEventStatusInProgress},
+	}, got)
+
+	got = changedLifecycleEvents(reported, []provider.CodeDeployLifecycleEvent{
+		{Name: "BeforeInstall", Status: cdtypes.LifecycleEventStatusSucceeded},
+		{Name: "Install", Status: cdtypes.LifecycleEventStatusSucceeded},
+		{Name: "AfterInstall", Status: cdtypes.LifecycleEventStatusFailed, Message: "hook failed"},
+	})
+	assert.Equal(t, []provider.CodeDeployLifecycleEvent{
+		{Name: "Install", Status: cdtypes.LifecycleEventStatusSucceeded},
+		{Name: "AfterInstall", Status: cdtypes.LifecycleEventStatusFailed, Message: "hook failed"},
+	}, got)
+}
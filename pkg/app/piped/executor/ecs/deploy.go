@@ -21,6 +21,8 @@ import (
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/analysis"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -32,6 +34,7 @@ type deployExecutor struct {
 	appCfg               *config.ECSApplicationSpec
 	platformProviderName string
 	platformProviderCfg  *config.PlatformProviderECSConfig
+	serviceConnect       *types.ServiceConnectConfiguration
 }
 
 func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
@@ -55,11 +58,39 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if !e.appCfg.Input.IsStandaloneTask() {
+		serviceConnect, ok := loadServiceConnectConfiguration(&e.Input, e.appCfg.Input, ds)
+		if !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		e.serviceConnect = serviceConnect
+	}
+
+	// Record the state to be restored on rollback before the first stage changes anything.
+	// The task sets of the service deployed by CodeDeploy are rolled back by CodeDeploy.
+	if !e.appCfg.Input.IsStandaloneTask() && e.appCfg.Input.CodeDeploy == nil {
+		serviceDefinition, ok := loadServiceDefinition(&e.Input, e.appCfg.Input.ServiceDefinitionFile, ds)
+		if !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if !recordServiceAutoScaling(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, serviceDefinition) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if e.appCfg.Input.ReusePrimaryTaskDefinitionOnRollback && !recordPrimaryTaskDefinition(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, serviceDefinition) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
 	var (
 		originalStatus = e.Stage.Status
 		status         model.StageStatus
 	)
 
+	if e.Deployment.Trigger.DryRun {
+		status = e.ensureDryRun(ctx)
+		return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+	}
+
 	switch model.Stage(e.Stage.Name) {
 	case model.StageECSSync:
 		status = e.ensureSync(ctx)
@@ -70,7 +101,13 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 	case model.StageECSCanaryClean:
 		status = e.ensureCanaryClean(ctx)
 	case model.StageECSTrafficRouting:
-		status = e.ensureTrafficRouting(ctx)
+		status = e.ensureTrafficRouting(sig)
+	case model.StageECSMaintenanceOn:
+		status = e.ensureMaintenanceOn(ctx)
+	case model.StageECSMaintenanceOff:
+		status = e.ensureMaintenanceOff(ctx)
+	case model.StageECSTargetGroupHealthCheck:
+		status = e.ensureTargetGroupHealthCheck(ctx)
 	default:
 		e.LogPersister.Errorf("Unsupported stage %s for ECS application", e.Stage.Name)
 		return model.StageStatus_STAGE_FAILURE
@@ -94,6 +131,12 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 		return model.StageStatus_STAGE_SUCCESS
 	}
 
+	if e.appCfg.QuickSync.SkipApplyIfUnchanged && e.definitionsUnchanged(ctx) {
+		markNoOpSync(ctx, &e.Input)
+		e.LogPersister.Success("Skipped updating the service since the definitions are identical to the ones at the running commit")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
 	servicedefinition, ok := loadServiceDefinition(&e.Input, ecsInput.ServiceDefinitionFile, e.deploySource)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
@@ -107,9 +150,26 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 			return model.StageStatus_STAGE_FAILURE
 		}
 	}
+	if ecsInput.IsAccessedViaAppMesh() {
+		servicedefinition = withServiceRegistry(servicedefinition, ecsInput.AppMesh.Primary.RegistryArn)
+	}
+
+	if ecsInput.CodeDeploy != nil {
+		if !codeDeploy(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, *ecsInput.CodeDeploy, taskDefinition, servicedefinition, primary) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	if ecsInput.RollingUpdate != nil {
+		if !rollingUpdate(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, *ecsInput.RollingUpdate, taskDefinition, servicedefinition, e.serviceConnect, ecsInput.AutoScaling) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		return model.StageStatus_STAGE_SUCCESS
+	}
 
 	recreate := e.appCfg.QuickSync.Recreate
-	if !sync(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, recreate, taskDefinition, servicedefinition, primary) {
+	if !sync(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, recreate, taskDefinition, servicedefinition, primary, ecsInput.AutoScaling) {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
@@ -145,6 +205,12 @@ func (e *deployExecutor) ensurePrimaryRollout(ctx context.Context) model.StageSt
 		if !rollout(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, taskDefinition, servicedefinition, nil) {
 			return model.StageStatus_STAGE_FAILURE
 		}
+	case config.AccessTypeAppMesh:
+		// Register the tasks to the service discovery of the PRIMARY virtual node.
+		servicedefinition = withServiceRegistry(servicedefinition, e.appCfg.Input.AppMesh.Primary.RegistryArn)
+		if !rollout(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, taskDefinition, servicedefinition, nil) {
+			return model.StageStatus_STAGE_FAILURE
+		}
 	default:
 		e.LogPersister.Errorf("Unsupported access type %s in stage %s for ECS application", e.appCfg.Input.AccessType, e.Stage.Name)
 		return model.StageStatus_STAGE_FAILURE
@@ -182,6 +248,12 @@ func (e *deployExecutor) ensureCanaryRollout(ctx context.Context) model.StageSta
 		if !rollout(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, taskDefinition, servicedefinition, nil) {
 			return model.StageStatus_STAGE_FAILURE
 		}
+	case config.AccessTypeAppMesh:
+		// Register the tasks to the service discovery of the CANARY virtual node.
+		servicedefinition = withServiceRegistry(servicedefinition, e.appCfg.Input.AppMesh.Canary.RegistryArn)
+		if !rollout(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, taskDefinition, servicedefinition, nil) {
+			return model.StageStatus_STAGE_FAILURE
+		}
 	default:
 		e.LogPersister.Errorf("Unsupported access type %s in stage %s for ECS application", e.appCfg.Input.AccessType, e.Stage.Name)
 		return model.StageStatus_STAGE_FAILURE
@@ -190,33 +262,73 @@ func (e *deployExecutor) ensureCanaryRollout(ctx context.Context) model.StageSta
 	return model.StageStatus_STAGE_SUCCESS
 }
 
-func (e *deployExecutor) ensureTrafficRouting(ctx context.Context) model.StageStatus {
-	// Traffic Routing is not supported for other kinds than ELB.
-	if !e.appCfg.Input.IsAccessedViaELB() {
-		e.LogPersister.Errorf("Unsupported access type %s in stage %s for ECS application", e.appCfg.Input.AccessType, e.Stage.Name)
+func (e *deployExecutor) ensureTrafficRouting(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
+	options := e.StageConfig.ECSTrafficRoutingStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	primary, canary, ok := loadTargetGroups(&e.Input, e.appCfg, e.deploySource)
-	if !ok {
-		return model.StageStatus_STAGE_FAILURE
-	}
-	if primary == nil || canary == nil {
-		e.LogPersister.Error("Primary/Canary target group are required to enable traffic routing")
+	// route modifies the weights of the traffic forwarded to PRIMARY/CANARY variants.
+	var route func(primary, canary int) bool
+	switch e.appCfg.Input.AccessType {
+	case config.AccessTypeELB:
+		primary, canary, ok := loadTargetGroups(&e.Input, e.appCfg, e.deploySource)
+		if !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if primary == nil || canary == nil {
+			e.LogPersister.Error("Primary/Canary target group are required to enable traffic routing")
+			return model.StageStatus_STAGE_FAILURE
+		}
+
+		// Persist to identify targetGroup in rollback.
+		e.Input.MetadataStore.Shared().Put(ctx, canaryTargetGroupArnKey, *canary.TargetGroupArn)
+
+		route = func(primaryWeight, canaryWeight int) bool {
+			return routing(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, *primary, *canary, e.appCfg.Input.TargetGroups, primaryWeight, canaryWeight)
+		}
+	case config.AccessTypeAppMesh:
+		mesh := *e.appCfg.Input.AppMesh
+		route = func(primaryWeight, canaryWeight int) bool {
+			return routingMesh(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, mesh, primaryWeight, canaryWeight)
+		}
+	default:
+		// Traffic Routing is not supported for other kinds than ELB and App Mesh.
+		e.LogPersister.Errorf("Unsupported access type %s in stage %s for ECS application", e.appCfg.Input.AccessType, e.Stage.Name)
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	// Persist to identify targetGroup in rollback.
-	e.Input.MetadataStore.Shared().Put(ctx, canaryTargetGroupArnKey, *canary.TargetGroupArn)
+	if len(options.Steps) > 0 {
+		return analysis.RouteTrafficBySteps(sig, e.Input, &options.TrafficRoutingSteps, route)
+	}
 
-	if !routing(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, *primary, *canary) {
+	primary, canary := options.Percentage()
+	if options.ABTesting != nil {
+		// Only the requests matching the A/B testing rules are routed to CANARY variant.
+		primary, canary = 100, 0
+	}
+	if !route(primary, canary) {
 		return model.StageStatus_STAGE_FAILURE
 	}
 	return model.StageStatus_STAGE_SUCCESS
 }
 
 func (e *deployExecutor) ensureCanaryClean(ctx context.Context) model.StageStatus {
-	if !clean(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg) {
+	options := e.StageConfig.ECSCanaryCleanStageOptions
+	force := options != nil && options.Force
+	if mesh := e.appCfg.Input.AppMesh; mesh != nil && !force {
+		client, err := provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+		if err != nil {
+			e.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", e.platformProviderName, err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if !checkMeshCanaryNotServing(ctx, &e.Input, client, *mesh) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+	if !clean(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, force) {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
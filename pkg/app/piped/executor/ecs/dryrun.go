@@ -0,0 +1,78 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// ensureDryRun simulates the stage of a dry-run deployment.
+// It loads the definitions the stage would deploy and only describes the current
+// resources on ECS, so nothing is changed on the platform.
+func (e *deployExecutor) ensureDryRun(ctx context.Context) model.StageStatus {
+	ecsInput := e.appCfg.Input
+
+	taskDefinition, ok := loadTaskDefinition(&e.Input, ecsInput.TaskDefinitionFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	e.LogPersister.Infof("Loaded the task definition of family %s", aws.ToString(taskDefinition.Family))
+
+	if ecsInput.IsStandaloneTask() {
+		e.LogPersister.Successf("A standalone task of family %s would be run on cluster %s", aws.ToString(taskDefinition.Family), ecsInput.ClusterArn)
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	serviceDefinition, ok := loadServiceDefinition(&e.Input, ecsInput.ServiceDefinitionFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	client, err := provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		clusterArn  = aws.ToString(serviceDefinition.ClusterArn)
+		serviceName = aws.ToString(serviceDefinition.ServiceName)
+	)
+	found, err := client.ServiceExists(ctx, clusterArn, serviceName)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to describe ECS service %s: %v", serviceName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if found {
+		e.LogPersister.Infof("ECS service %s exists on cluster %s", serviceName, clusterArn)
+	} else {
+		e.LogPersister.Infof("ECS service %s does not exist on cluster %s yet", serviceName, clusterArn)
+	}
+	if sc := e.serviceConnect; sc != nil && sc.Enabled {
+		if err := client.ValidateServiceConnectNamespace(ctx, serviceDefinition, *sc); err != nil {
+			e.LogPersister.Errorf("Invalid Service Connect configuration of ECS service %s: %v", serviceName, err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		e.LogPersister.Infof("The namespace of Service Connect of ECS service %s exists", serviceName)
+	}
+
+	e.LogPersister.Successf("Stage %s would be executed for ECS service %s, but nothing was changed since this is a dry-run deployment", e.Stage.Name, serviceName)
+	return model.StageStatus_STAGE_SUCCESS
+}
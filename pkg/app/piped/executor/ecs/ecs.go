@@ -19,14 +19,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -41,11 +45,18 @@ const (
 	canaryScaleMetadataKey         = "canary-scale"
 	currentListenersKey            = "current-listeners"
 	canaryTargetGroupArnKey        = "canary-target-group-arn"
+	currentWeightsKey              = "current-weights"
+	maintenanceListenersKey        = "maintenance-listeners"
+	serviceAutoScalingKey          = "service-autoscaling"
+	primaryTaskDefinitionArnKey    = "primary-task-definition-arn"
+	scheduledTaskDefinitionsKey    = "scheduled-task-definitions"
+	codeDeployDeploymentIDKey      = "codedeploy-deployment-id"
 )
 
 type registerer interface {
 	Register(stage model.Stage, f executor.Factory) error
 	RegisterRollback(kind model.RollbackKind, f executor.Factory) error
+	MarkDryRunnable(stage model.Stage)
 }
 
 func Register(r registerer) {
@@ -59,6 +70,19 @@ func Register(r registerer) {
 	r.Register(model.StageECSPrimaryRollout, f)
 	r.Register(model.StageECSCanaryClean, f)
 	r.Register(model.StageECSTrafficRouting, f)
+	r.Register(model.StageECSMaintenanceOn, f)
+	r.Register(model.StageECSMaintenanceOff, f)
+	r.Register(model.StageECSTargetGroupHealthCheck, f)
+
+	// Every stage only describes the current resources in a dry-run deployment.
+	r.MarkDryRunnable(model.StageECSSync)
+	r.MarkDryRunnable(model.StageECSCanaryRollout)
+	r.MarkDryRunnable(model.StageECSPrimaryRollout)
+	r.MarkDryRunnable(model.StageECSCanaryClean)
+	r.MarkDryRunnable(model.StageECSTrafficRouting)
+	r.MarkDryRunnable(model.StageECSMaintenanceOn)
+	r.MarkDryRunnable(model.StageECSMaintenanceOff)
+	r.MarkDryRunnable(model.StageECSTargetGroupHealthCheck)
 
 	r.RegisterRollback(model.RollbackKind_Rollback_ECS, func(in executor.Input) executor.Executor {
 		return &rollbackExecutor{
@@ -85,15 +109,34 @@ func findPlatformProvider(in *executor.Input) (name string, cfg *config.Platform
 	return
 }
 
+// loadServiceConnectConfiguration returns the Service Connect configuration in the service definition of the given input,
+// or nil when it is not specified. It fails when Service Connect is enabled for the service not deployed by rolling updates.
+func loadServiceConnectConfiguration(in *executor.Input, ecsInput config.ECSDeploymentInput, ds *deploysource.DeploySource) (*types.ServiceConnectConfiguration, bool) {
+	sc, err := provider.LoadServiceConnectConfiguration(ds.AppDir, ecsInput.ServiceDefinitionFile, newTemplateData(in, ds))
+	if err != nil {
+		in.LogPersister.Errorf("Failed to load the Service Connect configuration of ECS service definition (%v)", err)
+		return nil, false
+	}
+	if sc != nil && sc.Enabled && ecsInput.RollingUpdate == nil {
+		in.LogPersister.Errorf("Invalid ECS service definition: %v", provider.ErrServiceConnectNotSupported)
+		return nil, false
+	}
+	return sc, true
+}
+
 func loadServiceDefinition(in *executor.Input, serviceDefinitionFile string, ds *deploysource.DeploySource) (types.Service, bool) {
 	in.LogPersister.Infof("Loading service manifest at commit %s", ds.Revision)
 
-	serviceDefinition, err := provider.LoadServiceDefinition(ds.AppDir, serviceDefinitionFile)
+	serviceDefinition, err := provider.LoadServiceDefinition(ds.AppDir, serviceDefinitionFile, newTemplateData(in, ds))
 	if err != nil {
 		in.LogPersister.Errorf("Failed to load ECS service definition (%v)", err)
 		return types.Service{}, false
 	}
 
+	if cp, ok := in.PipedConfig.FindPlatformProvider(in.Application.PlatformProvider, model.ApplicationKind_ECS); ok && cp.ECSConfig != nil {
+		defaultTags := in.ResolveResourceTags(cp.ECSConfig.DefaultTags, in.Deployment.CommitHash())
+		serviceDefinition.Tags = withDefaultTags(serviceDefinition.Tags, defaultTags)
+	}
 	serviceDefinition.Tags = append(
 		serviceDefinition.Tags,
 		provider.MakeTags(map[string]string{
@@ -108,10 +151,29 @@ func loadServiceDefinition(in *executor.Input, serviceDefinitionFile string, ds
 	return serviceDefinition, true
 }
 
+// withDefaultTags appends the given default tags whose keys are not defined in tags yet.
+func withDefaultTags(tags []types.Tag, defaultTags map[string]string) []types.Tag {
+	defined := make(map[string]struct{}, len(tags))
+	for _, t := range tags {
+		defined[aws.ToString(t.Key)] = struct{}{}
+	}
+	keys := make([]string, 0, len(defaultTags))
+	for k := range defaultTags {
+		if _, ok := defined[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		tags = append(tags, types.Tag{Key: aws.String(k), Value: aws.String(defaultTags[k])})
+	}
+	return tags
+}
+
 func loadTaskDefinition(in *executor.Input, taskDefinitionFile string, ds *deploysource.DeploySource) (types.TaskDefinition, bool) {
 	in.LogPersister.Infof("Loading task definition manifest at commit %s", ds.Revision)
 
-	taskDefinition, err := provider.LoadTaskDefinition(ds.AppDir, taskDefinitionFile)
+	taskDefinition, err := provider.LoadTaskDefinition(ds.AppDir, taskDefinitionFile, newTemplateData(in, ds))
 	if err != nil {
 		in.LogPersister.Errorf("Failed to load ECS task definition (%v)", err)
 		return types.TaskDefinition{}, false
@@ -121,6 +183,13 @@ func loadTaskDefinition(in *executor.Input, taskDefinitionFile string, ds *deplo
 	return taskDefinition, true
 }
 
+func newTemplateData(in *executor.Input, ds *deploysource.DeploySource) *provider.TemplateData {
+	if ds.ApplicationConfig == nil {
+		return nil
+	}
+	return provider.NewTemplateData(ds.ApplicationConfig.ECSApplicationSpec, in.Deployment.ApplicationId, in.PipedConfig.PipedID, ds.Revision)
+}
+
 func loadTargetGroups(in *executor.Input, appCfg *config.ECSApplicationSpec, ds *deploysource.DeploySource) (*types.LoadBalancer, *types.LoadBalancer, bool) {
 	in.LogPersister.Infof("Loading target groups config at the commit %s", ds.Revision)
 
@@ -160,23 +229,10 @@ func applyServiceDefinition(ctx context.Context, cli provider.Client, serviceDef
 			return nil, fmt.Errorf("failed to update ECS service %s: %w", *serviceDefinition.ServiceName, err)
 		}
 
-		currentTags, err := cli.ListTags(ctx, *service.ServiceArn)
-		if err != nil {
-			return nil, fmt.Errorf("failed to list existing tags for ECS service %s: %w", *serviceDefinition.ServiceName, err)
+		if err := updateServiceTags(ctx, cli, service, serviceDefinition.Tags); err != nil {
+			return nil, err
 		}
 
-		tagsToRemove := findRemovedTags(currentTags, serviceDefinition.Tags)
-		if len(tagsToRemove) > 0 {
-			if err := cli.UntagResource(ctx, *service.ServiceArn, tagsToRemove); err != nil {
-				return nil, fmt.Errorf("failed to untag ECS service %s: %w", *serviceDefinition.ServiceName, err)
-			}
-		}
-		if err := cli.TagResource(ctx, *service.ServiceArn, serviceDefinition.Tags); err != nil {
-			return nil, fmt.Errorf("failed to update tags of ECS service %s: %w", *serviceDefinition.ServiceName, err)
-		}
-		// Re-assign tags to service object because UpdateService API doesn't return tags.
-		service.Tags = serviceDefinition.Tags
-
 	} else {
 		service, err = cli.CreateService(ctx, serviceDefinition)
 		if err != nil {
@@ -187,6 +243,27 @@ func applyServiceDefinition(ctx context.Context, cli provider.Client, serviceDef
 	return service, nil
 }
 
+// updateServiceTags makes the tags of the given service the same as the desired ones.
+func updateServiceTags(ctx context.Context, cli provider.Client, service *types.Service, desiredTags []types.Tag) error {
+	currentTags, err := cli.ListTags(ctx, *service.ServiceArn)
+	if err != nil {
+		return fmt.Errorf("failed to list existing tags for ECS service %s: %w", *service.ServiceName, err)
+	}
+
+	tagsToRemove := findRemovedTags(currentTags, desiredTags)
+	if len(tagsToRemove) > 0 {
+		if err := cli.UntagResource(ctx, *service.ServiceArn, tagsToRemove); err != nil {
+			return fmt.Errorf("failed to untag ECS service %s: %w", *service.ServiceName, err)
+		}
+	}
+	if err := cli.TagResource(ctx, *service.ServiceArn, desiredTags); err != nil {
+		return fmt.Errorf("failed to update tags of ECS service %s: %w", *service.ServiceName, err)
+	}
+	// Re-assign tags to service object because UpdateService API doesn't return tags.
+	service.Tags = desiredTags
+	return nil
+}
+
 func findRemovedTags(currentTags, desiredTags []types.Tag) []string {
 	var tagsToRemove []string
 
@@ -227,18 +304,29 @@ func runStandaloneTask(
 	}
 
 	in.LogPersister.Infof("Start applying the ECS task definition")
-	tags := provider.MakeTags(map[string]string{
+	tags := withDefaultTags(nil, in.ResolveResourceTags(cloudProviderCfg.DefaultTags, in.Deployment.CommitHash()))
+	tags = append(tags, provider.MakeTags(map[string]string{
 		provider.LabelManagedBy:   provider.ManagedByPiped,
 		provider.LabelPiped:       in.PipedConfig.PipedID,
 		provider.LabelApplication: in.Deployment.ApplicationId,
 		provider.LabelCommitHash:  in.Deployment.CommitHash(),
-	})
+	})...)
 	td, err := applyTaskDefinition(ctx, client, taskDefinition)
 	if err != nil {
 		in.LogPersister.Errorf("Failed to apply ECS task definition: %v", err)
 		return false
 	}
 
+	if ecsInput.ScheduledTask != nil {
+		prev, ok := updateScheduledTask(ctx, in, client, *ecsInput.ScheduledTask, *td.TaskDefinitionArn)
+		if !ok {
+			return false
+		}
+		if !recordScheduledTaskDefinitions(ctx, in, prev) {
+			return false
+		}
+	}
+
 	if !*ecsInput.RunStandaloneTask {
 		in.LogPersister.Infof("Skipped running task")
 		return true
@@ -259,6 +347,81 @@ func runStandaloneTask(
 	return true
 }
 
+// updateScheduledTask makes the ECS targets of the EventBridge rule, or the one of the given target ID, run the given task definition on its schedule.
+// It returns the task definitions the updated targets ran before, keyed by the target ID.
+func updateScheduledTask(ctx context.Context, in *executor.Input, client provider.Client, scheduledTask config.ECSScheduledTask, taskDefinitionArn string) (map[string]string, bool) {
+	in.LogPersister.Infof("Updating the ECS targets of EventBridge rule %s to run task definition %s", scheduledTask.RuleName, taskDefinitionArn)
+	prev, err := client.UpdateScheduledTaskDefinition(ctx, scheduledTask.EventBusName, scheduledTask.RuleName, scheduledTask.TargetID, taskDefinitionArn)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to update the scheduled task: %v", err)
+		return nil, false
+	}
+	ids := make([]string, 0, len(prev))
+	for id := range prev {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		in.LogPersister.Infof("Successfully updated the target %s of EventBridge rule %s from task definition %s", id, scheduledTask.RuleName, prev[id])
+	}
+	return prev, true
+}
+
+// recordScheduledTaskDefinitions stores the task definitions the ECS targets of the EventBridge rule ran
+// before the deployment into the shared metadata so that the rollback can restore each of them.
+// It records only once per deployment so that the task definition deployed by this deployment is never recorded.
+func recordScheduledTaskDefinitions(ctx context.Context, in *executor.Input, taskDefinitionArns map[string]string) bool {
+	if _, ok := in.MetadataStore.Shared().Get(scheduledTaskDefinitionsKey); ok {
+		return true
+	}
+	data, err := json.Marshal(taskDefinitionArns)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to marshal the task definitions of the scheduled task: %v", err)
+		return false
+	}
+	if err := in.MetadataStore.Shared().Put(ctx, scheduledTaskDefinitionsKey, string(data)); err != nil {
+		in.LogPersister.Errorf("Failed to store the task definitions of the scheduled task to metadata store: %v", err)
+		return false
+	}
+	return true
+}
+
+// restoreScheduledTask makes each ECS target of the EventBridge rule run the task definition recorded by recordScheduledTaskDefinitions.
+// The second returned value is false when nothing was recorded.
+func restoreScheduledTask(ctx context.Context, in *executor.Input, client provider.Client, scheduledTask config.ECSScheduledTask) (restored, recorded bool) {
+	data, ok := in.MetadataStore.Shared().Get(scheduledTaskDefinitionsKey)
+	if !ok || data == "" {
+		return false, false
+	}
+	var taskDefinitionArns map[string]string
+	if err := json.Unmarshal([]byte(data), &taskDefinitionArns); err != nil {
+		in.LogPersister.Errorf("Failed to load the recorded task definitions of the scheduled task: %v", err)
+		return false, true
+	}
+	if len(taskDefinitionArns) == 0 {
+		return false, false
+	}
+
+	ids := make([]string, 0, len(taskDefinitionArns))
+	for id := range taskDefinitionArns {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		arn := taskDefinitionArns[id]
+		if arn == "" {
+			in.LogPersister.Infof("Skip restoring the target %s of EventBridge rule %s because it ran no task definition", id, scheduledTask.RuleName)
+			continue
+		}
+		st := scheduledTask
+		st.TargetID = id
+		if _, ok := updateScheduledTask(ctx, in, client, st, arn); !ok {
+			return false, true
+		}
+	}
+	return true, true
+}
+
 func createPrimaryTaskSet(ctx context.Context, client provider.Client, service types.Service, taskDef types.TaskDefinition, targetGroup *types.LoadBalancer) error {
 	// Get current PRIMARY/ACTIVE task sets.
 	prevTaskSets, err := client.GetServiceTaskSets(ctx, service)
@@ -291,7 +454,7 @@ func createPrimaryTaskSet(ctx context.Context, client provider.Client, service t
 	return nil
 }
 
-func sync(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, recreate bool, taskDefinition types.TaskDefinition, serviceDefinition types.Service, targetGroup *types.LoadBalancer) bool {
+func sync(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, recreate bool, taskDefinition types.TaskDefinition, serviceDefinition types.Service, targetGroup *types.LoadBalancer, autoScaling *config.ECSAutoScaling) bool {
 	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
 	if err != nil {
 		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
@@ -345,6 +508,11 @@ func sync(ctx context.Context, in *executor.Input, platformProviderName string,
 	in.LogPersister.Infof("Wait service to reach stable state")
 	if err := client.WaitServiceStable(ctx, *service); err != nil {
 		in.LogPersister.Errorf("Failed to wait service %s to reach stable state: %v", *serviceDefinition.ServiceName, err)
+		reportStoppedTasks(ctx, in, client, *service, *td)
+		return false
+	}
+
+	if autoScaling != nil && !applyServiceAutoScaling(ctx, in, client, *service, *autoScaling) {
 		return false
 	}
 
@@ -359,6 +527,14 @@ func rollout(ctx context.Context, in *executor.Input, platformProviderName strin
 		return false
 	}
 
+	// The CANARY task set created by a previous ECS_CANARY_ROLLOUT stage of this deployment
+	// is scaled instead of creating another one, so that CANARY can be rolled out gradually.
+	if in.StageConfig.Name == model.StageECSCanaryRollout {
+		if taskSet, ok := loadCanaryTaskSet(in); ok {
+			return scaleCanaryTaskSet(ctx, in, client, *taskSet, serviceDefinition)
+		}
+	}
+
 	in.LogPersister.Infof("Start applying the ECS task definition")
 	td, err := applyTaskDefinition(ctx, client, taskDefinition)
 	if err != nil {
@@ -389,26 +565,21 @@ func rollout(ctx context.Context, in *executor.Input, platformProviderName strin
 			return false
 		}
 
-		metadata := map[string]string{
-			canaryScaleMetadataKey: strconv.FormatInt(int64(options.Scale.Int()), 10),
-		}
-		if err := in.MetadataStore.Stage(in.Stage.Id).PutMulti(ctx, metadata); err != nil {
-			in.Logger.Error("Failed to store canary scale infor to metadata store", zap.Error(err))
-		}
+		storeCanaryScale(ctx, in, options.Scale.Int())
 
 		// Create ACTIVE task set in case of Canary rollout.
-		taskSet, err := client.CreateTaskSet(ctx, *service, *td, targetGroup, options.Scale.Int())
-		if err != nil {
-			in.LogPersister.Errorf("Failed to create ECS task set for service %s: %v", *serviceDefinition.ServiceName, err)
-			return false
+		canaryService := *service
+		if len(options.CapacityProviderStrategy) > 0 {
+			canaryService = withCapacityProviderStrategy(canaryService, options.CapacityProviderStrategy)
+			in.LogPersister.Infof("Launch CANARY task set with capacity provider strategy %s", formatCapacityProviderStrategy(options.CapacityProviderStrategy))
 		}
-		// Store created ACTIVE TaskSet (CANARY variant) to delete later.
-		taskSetObjData, err := json.Marshal(taskSet)
+		taskSet, err := client.CreateTaskSet(ctx, canaryService, *td, targetGroup, options.Scale.Int())
 		if err != nil {
-			in.LogPersister.Errorf("Unable to store created active taskSet to metadata store: %v", err)
+			in.LogPersister.Errorf("Failed to create ECS task set for service %s: %v", *serviceDefinition.ServiceName, err)
 			return false
 		}
-		if err := in.MetadataStore.Shared().Put(ctx, canaryTaskSetKeyName, string(taskSetObjData)); err != nil {
+		// Store created ACTIVE TaskSet (CANARY variant) to scale or delete later.
+		if err := storeCanaryTaskSet(ctx, in, taskSet); err != nil {
 			in.LogPersister.Errorf("Unable to store created active taskSet to metadata store: %v", err)
 			return false
 		}
@@ -417,6 +588,7 @@ func rollout(ctx context.Context, in *executor.Input, platformProviderName strin
 	in.LogPersister.Infof("Wait service to reach stable state")
 	if err := client.WaitServiceStable(ctx, *service); err != nil {
 		in.LogPersister.Errorf("Failed to wait service %s to reach stable state: %v", *serviceDefinition.ServiceName, err)
+		reportStoppedTasks(ctx, in, client, *service, *td)
 		return false
 	}
 
@@ -424,7 +596,104 @@ func rollout(ctx context.Context, in *executor.Input, platformProviderName strin
 	return true
 }
 
-func clean(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig) bool {
+// withCapacityProviderStrategy returns the copy of the given service
+// whose task sets are launched by the given capacity provider strategy instead of the launch type.
+func withCapacityProviderStrategy(service types.Service, strategy []config.ECSCapacityProviderStrategyItem) types.Service {
+	items := make([]types.CapacityProviderStrategyItem, 0, len(strategy))
+	for _, s := range strategy {
+		items = append(items, types.CapacityProviderStrategyItem{
+			CapacityProvider: aws.String(s.CapacityProvider),
+			Weight:           int32(s.Weight),
+			Base:             int32(s.Base),
+		})
+	}
+	service.LaunchType = ""
+	service.CapacityProviderStrategy = items
+	return service
+}
+
+func formatCapacityProviderStrategy(strategy []config.ECSCapacityProviderStrategyItem) string {
+	items := make([]string, 0, len(strategy))
+	for _, s := range strategy {
+		items = append(items, fmt.Sprintf("%s(weight=%d, base=%d)", s.CapacityProvider, s.Weight, s.Base))
+	}
+	return strings.Join(items, ", ")
+}
+
+// scaleCanaryTaskSet updates the scale of the existing CANARY task set
+// to the one configured in the current ECS_CANARY_ROLLOUT stage.
+func scaleCanaryTaskSet(ctx context.Context, in *executor.Input, client provider.Client, taskSet types.TaskSet, serviceDefinition types.Service) bool {
+	options := in.StageConfig.ECSCanaryRolloutStageOptions
+	if options == nil {
+		in.LogPersister.Errorf("Malformed configuration for stage %s", in.Stage.Name)
+		return false
+	}
+	storeCanaryScale(ctx, in, options.Scale.Int())
+
+	in.LogPersister.Infof("Start scaling CANARY task set %s to %d%%", *taskSet.TaskSetArn, options.Scale.Int())
+	updated, err := client.UpdateTaskSetScale(ctx, taskSet, options.Scale.Int())
+	if err != nil {
+		in.LogPersister.Errorf("Failed to scale CANARY task set %s: %v", *taskSet.TaskSetArn, err)
+		return false
+	}
+	if err := storeCanaryTaskSet(ctx, in, updated); err != nil {
+		in.LogPersister.Errorf("Unable to store scaled active taskSet to metadata store: %v", err)
+		return false
+	}
+
+	in.LogPersister.Infof("Wait service to reach stable state")
+	service := types.Service{
+		ClusterArn:  taskSet.ClusterArn,
+		ServiceArn:  taskSet.ServiceArn,
+		ServiceName: serviceDefinition.ServiceName,
+	}
+	if err := client.WaitServiceStable(ctx, service); err != nil {
+		in.LogPersister.Errorf("Failed to wait service %s to reach stable state: %v", *serviceDefinition.ServiceName, err)
+		if td, err := client.GetTaskDefinition(ctx, aws.ToString(taskSet.TaskDefinition)); err == nil {
+			reportStoppedTasks(ctx, in, client, service, *td)
+		}
+		return false
+	}
+
+	in.LogPersister.Infof("Successfully scaled CANARY task set %s to %d%%", *taskSet.TaskSetArn, options.Scale.Int())
+	return true
+}
+
+func storeCanaryScale(ctx context.Context, in *executor.Input, scale int) {
+	metadata := map[string]string{
+		canaryScaleMetadataKey: strconv.FormatInt(int64(scale), 10),
+	}
+	if err := in.MetadataStore.Stage(in.Stage.Id).PutMulti(ctx, metadata); err != nil {
+		in.Logger.Error("Failed to store canary scale infor to metadata store", zap.Error(err))
+	}
+}
+
+func storeCanaryTaskSet(ctx context.Context, in *executor.Input, taskSet *types.TaskSet) error {
+	taskSetObjData, err := json.Marshal(taskSet)
+	if err != nil {
+		return err
+	}
+	return in.MetadataStore.Shared().Put(ctx, canaryTaskSetKeyName, string(taskSetObjData))
+}
+
+// loadCanaryTaskSet returns the CANARY task set created by a previous stage of the deployment.
+func loadCanaryTaskSet(in *executor.Input) (*types.TaskSet, bool) {
+	taskSetObjData, ok := in.MetadataStore.Shared().Get(canaryTaskSetKeyName)
+	if !ok || taskSetObjData == "" {
+		return nil, false
+	}
+	taskSet := &types.TaskSet{}
+	if err := json.Unmarshal([]byte(taskSetObjData), taskSet); err != nil {
+		in.Logger.Error("Failed to restore canary task set from metadata store", zap.Error(err))
+		return nil, false
+	}
+	if taskSet.TaskSetArn == nil {
+		return nil, false
+	}
+	return taskSet, true
+}
+
+func clean(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, force bool) bool {
 	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
 	if err != nil {
 		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
@@ -443,6 +712,19 @@ func clean(ctx context.Context, in *executor.Input, platformProviderName string,
 		return false
 	}
 
+	// Delete A/B testing rules forwarding to the canary task set if present.
+	if value, ok := in.MetadataStore.Shared().Get(currentListenersKey); ok {
+		if !deleteABTestingRules(ctx, in.LogPersister, client, strings.Split(value, ",")) {
+			return false
+		}
+	}
+
+	if force {
+		in.LogPersister.Info("Skipped checking the traffic forwarded to CANARY task set because force option was set")
+	} else if !checkCanaryNotServing(ctx, in, client, *taskSet) {
+		return false
+	}
+
 	// Delete canary task set if present.
 	in.LogPersister.Infof("Cleaning CANARY task set %s from service %s", *taskSet.TaskSetArn, *taskSet.ServiceArn)
 	if err := client.DeleteTaskSet(ctx, *taskSet); err != nil {
@@ -454,7 +736,46 @@ func clean(ctx context.Context, in *executor.Input, platformProviderName string,
 	return true
 }
 
-func routing(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, primaryTargetGroup types.LoadBalancer, canaryTargetGroup types.LoadBalancer) bool {
+// checkCanaryNotServing checks the ELB listener rules forwarding to the target group of the given CANARY task set
+// and returns false when they are still forwarding traffic to it or the check could not be done.
+func checkCanaryNotServing(ctx context.Context, in *executor.Input, client provider.Client, taskSet types.TaskSet) bool {
+	if len(taskSet.LoadBalancers) == 0 || taskSet.LoadBalancers[0].TargetGroupArn == nil {
+		in.LogPersister.Info("CANARY task set is not registered to any target group, so it is considered as not receiving traffic")
+		return true
+	}
+	targetGroup := taskSet.LoadBalancers[0]
+
+	var listenerArns []string
+	if value, ok := in.MetadataStore.Shared().Get(currentListenersKey); ok {
+		listenerArns = strings.Split(value, ",")
+	} else {
+		arns, err := client.GetListenerArns(ctx, targetGroup)
+		if errors.Is(err, platformprovider.ErrNotFound) {
+			in.LogPersister.Infof("Target group %s of CANARY task set is not attached to any listener, so it is considered as not receiving traffic", *targetGroup.TargetGroupArn)
+			return true
+		}
+		if err != nil {
+			in.LogPersister.Errorf("Failed to get the listeners of target group %s: %v", *targetGroup.TargetGroupArn, err)
+			return false
+		}
+		listenerArns = arns
+	}
+
+	weight, err := client.GetTargetGroupWeight(ctx, listenerArns, *targetGroup.TargetGroupArn)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to determine the traffic forwarded to CANARY target group %s: %v", *targetGroup.TargetGroupArn, err)
+		return false
+	}
+	if weight > 0 {
+		in.LogPersister.Errorf("CANARY target group %s is still receiving traffic (weight: %d). Route all traffic back to PRIMARY variant before cleaning CANARY variant, or set force option to clean it anyway", *targetGroup.TargetGroupArn, weight)
+		return false
+	}
+
+	in.LogPersister.Infof("Confirmed that CANARY target group %s is not receiving traffic", *targetGroup.TargetGroupArn)
+	return true
+}
+
+func routing(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, primaryTargetGroup types.LoadBalancer, canaryTargetGroup types.LoadBalancer, targetGroups config.ECSTargetGroups, primary, canary int) bool {
 	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
 	if err != nil {
 		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
@@ -466,7 +787,6 @@ func routing(ctx context.Context, in *executor.Input, platformProviderName strin
 		in.LogPersister.Errorf("Malformed configuration for stage %s", in.Stage.Name)
 		return false
 	}
-	primary, canary := options.Percentage()
 	routingTrafficCfg := provider.RoutingTrafficConfig{
 		{
 			TargetGroupArn: *primaryTargetGroup.TargetGroupArn,
@@ -496,6 +816,11 @@ func routing(ctx context.Context, in *executor.Input, platformProviderName strin
 			in.LogPersister.Errorf("Failed to get current active listeners: %v", err)
 			return false
 		}
+		currListenerArns, err = provider.ScopeListeners(currListenerArns, targetGroups.Listeners)
+		if err != nil {
+			in.LogPersister.Errorf("Failed to scope the listeners to modify: %v", err)
+			return false
+		}
 	}
 
 	// Store created listeners to use later.
@@ -505,7 +830,21 @@ func routing(ctx context.Context, in *executor.Input, platformProviderName strin
 		return false
 	}
 
-	modifiedRules, err := client.ModifyListeners(ctx, currListenerArns, routingTrafficCfg)
+	// Remove the A/B testing rules added by the previous stages before updating the listeners.
+	if !deleteABTestingRules(ctx, in.LogPersister, client, currListenerArns) {
+		return false
+	}
+
+	scope := listenerRuleScope(in, targetGroups)
+	var stickinessDuration time.Duration
+	if st := options.Stickiness; st != nil {
+		stickinessDuration = st.Duration.Duration()
+		if !drainStickySessions(ctx, in, client, currListenerArns, routingTrafficCfg, scope, st) {
+			return false
+		}
+	}
+
+	modifiedRules, err := client.ModifyListeners(ctx, currListenerArns, routingTrafficCfg, scope, stickinessDuration)
 	if err != nil {
 		in.LogPersister.Errorf("Failed to routing traffic to PRIMARY/CANARY variants: %v", err)
 
@@ -517,6 +856,119 @@ func routing(ctx context.Context, in *executor.Input, platformProviderName strin
 
 	logModifiedRules(in.LogPersister, modifiedRules)
 
+	// Store the current weights to decide whether the sticky sessions should be drained at the next routing.
+	if err := in.MetadataStore.Shared().Put(ctx, currentWeightsKey, fmt.Sprintf("%d,%d", primary, canary)); err != nil {
+		in.Logger.Error("Failed to store current traffic weights to metadata store", zap.Error(err))
+	}
+
+	if options.ABTesting == nil {
+		return true
+	}
+
+	in.LogPersister.Infof("Start adding ELB listener rules to route requests matching %d A/B testing rules to CANARY variant", len(options.ABTesting.Rules))
+	createdRules, err := client.CreateABTestingRules(ctx, currListenerArns, routingTrafficCfg, targetGroups.ListenerRules, *canaryTargetGroup.TargetGroupArn, options.ABTesting.Rules)
+	for _, rule := range createdRules {
+		in.LogPersister.Infof("Created A/B testing ELB listener rule: %s", rule)
+	}
+	if err != nil {
+		in.LogPersister.Errorf("Failed to add A/B testing ELB listener rules: %v", err)
+		return false
+	}
+
+	return true
+}
+
+// listenerRuleScope returns the scope of the listener rules modified to route the traffic of the application.
+func listenerRuleScope(in *executor.Input, targetGroups config.ECSTargetGroups) provider.ListenerRuleScope {
+	scope := provider.ListenerRuleScope{
+		Selectors: targetGroups.ListenerRules,
+	}
+	if targetGroups.RuleOwnershipRequired() {
+		scope.Owner = in.Deployment.ApplicationId
+	}
+	return scope
+}
+
+// drainStickySessions keeps a small amount of traffic on the variant whose weight is being changed to 0
+// for the drain duration, so that the clients stuck to it can finish their sessions.
+func drainStickySessions(ctx context.Context, in *executor.Input, client provider.Client, listenerArns []string, routingTrafficCfg provider.RoutingTrafficConfig, scope provider.ListenerRuleScope, stickiness *config.ECSTrafficRoutingStickiness) bool {
+	drainDuration := stickiness.DrainDuration.Duration()
+	if drainDuration <= 0 {
+		return true
+	}
+
+	// PRIMARY variant receives all traffic before the first routing.
+	prevPrimary, prevCanary := 100, 0
+	if value, ok := in.MetadataStore.Shared().Get(currentWeightsKey); ok {
+		if _, err := fmt.Sscanf(value, "%d,%d", &prevPrimary, &prevCanary); err != nil {
+			in.Logger.Error("Failed to parse current traffic weights from metadata store", zap.String("value", value), zap.Error(err))
+		}
+	}
+
+	drainCfg, ok := makeDrainingRoutingTrafficConfig(routingTrafficCfg, prevPrimary, prevCanary)
+	if !ok {
+		return true
+	}
+
+	in.LogPersister.Infof("Draining sticky sessions for %v before the weight becomes 0: primary=%d, canary=%d", drainDuration, drainCfg[0].Weight, drainCfg[1].Weight)
+	modifiedRules, err := client.ModifyListeners(ctx, listenerArns, drainCfg, scope, stickiness.Duration.Duration())
+	if err != nil {
+		in.LogPersister.Errorf("Failed to routing traffic to drain sticky sessions: %v", err)
+
+		if len(modifiedRules) > 0 {
+			logModifiedRules(in.LogPersister, modifiedRules)
+		}
+		return false
+	}
+	logModifiedRules(in.LogPersister, modifiedRules)
+
+	timer := time.NewTimer(drainDuration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		in.LogPersister.Info("Finished draining sticky sessions")
+		return true
+	case <-ctx.Done():
+		in.LogPersister.Info("Draining sticky sessions was interrupted")
+		return false
+	}
+}
+
+// makeDrainingRoutingTrafficConfig returns the config keeping 1% of traffic on the variant
+// whose weight is being changed from a positive value to 0.
+func makeDrainingRoutingTrafficConfig(cfg provider.RoutingTrafficConfig, prevPrimary, prevCanary int) (provider.RoutingTrafficConfig, bool) {
+	primary, canary := cfg[0].Weight, cfg[1].Weight
+	switch {
+	case primary == 0 && prevPrimary > 0:
+		primary, canary = 1, 99
+	case canary == 0 && prevCanary > 0:
+		primary, canary = 99, 1
+	default:
+		return nil, false
+	}
+	return provider.RoutingTrafficConfig{
+		{
+			TargetGroupArn: cfg[0].TargetGroupArn,
+			Weight:         primary,
+		},
+		{
+			TargetGroupArn: cfg[1].TargetGroupArn,
+			Weight:         canary,
+		},
+	}, true
+}
+
+// deleteABTestingRules removes the ELB listener rules added for A/B testing routing.
+func deleteABTestingRules(ctx context.Context, logPersister executor.LogPersister, client provider.Client, listenerArns []string) bool {
+	deletedRules, err := client.DeleteABTestingRules(ctx, listenerArns)
+	for _, rule := range deletedRules {
+		logPersister.Infof("Deleted A/B testing ELB listener rule: %s", rule)
+	}
+	if err != nil {
+		logPersister.Errorf("Failed to delete A/B testing ELB listener rules: %v", err)
+		return false
+	}
 	return true
 }
 
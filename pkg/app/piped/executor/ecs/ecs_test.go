@@ -17,10 +17,12 @@ package ecs
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/stretchr/testify/assert"
 
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/config"
 )
 
 func TestFindRemovedTags(t *testing.T) {
@@ -41,6 +43,103 @@ func TestFindRemovedTags(t *testing.T) {
 	assert.ElementsMatch(t, []string{"region"}, got)
 }
 
+func TestWithDefaultTags(t *testing.T) {
+	tags := []types.Tag{
+		{Key: strPtr("owner"), Value: strPtr("team-a")},
+	}
+	defaultTags := map[string]string{
+		"owner":       "platform",
+		"cost-center": "cc-123",
+		"pipecd-app":  "demo",
+	}
+
+	got := withDefaultTags(tags, defaultTags)
+	assert.Equal(t, []types.Tag{
+		{Key: strPtr("owner"), Value: strPtr("team-a")},
+		{Key: strPtr("cost-center"), Value: strPtr("cc-123")},
+		{Key: strPtr("pipecd-app"), Value: strPtr("demo")},
+	}, got)
+}
+
 func strPtr(s string) *string {
 	return &s
 }
+
+func TestMakeDrainingRoutingTrafficConfig(t *testing.T) {
+	t.Parallel()
+
+	makeCfg := func(primary, canary int) provider.RoutingTrafficConfig {
+		return provider.RoutingTrafficConfig{
+			{TargetGroupArn: "primary", Weight: primary},
+			{TargetGroupArn: "canary", Weight: canary},
+		}
+	}
+	testcases := []struct {
+		name        string
+		cfg         provider.RoutingTrafficConfig
+		prevPrimary int
+		prevCanary  int
+		expected    provider.RoutingTrafficConfig
+		expectedOK  bool
+	}{
+		{
+			name:        "stop routing to canary",
+			cfg:         makeCfg(100, 0),
+			prevPrimary: 80,
+			prevCanary:  20,
+			expected:    makeCfg(99, 1),
+			expectedOK:  true,
+		},
+		{
+			name:        "stop routing to primary",
+			cfg:         makeCfg(0, 100),
+			prevPrimary: 50,
+			prevCanary:  50,
+			expected:    makeCfg(1, 99),
+			expectedOK:  true,
+		},
+		{
+			name:        "canary was already 0",
+			cfg:         makeCfg(100, 0),
+			prevPrimary: 100,
+			prevCanary:  0,
+		},
+		{
+			name:        "no variant becomes 0",
+			cfg:         makeCfg(50, 50),
+			prevPrimary: 100,
+			prevCanary:  0,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := makeDrainingRoutingTrafficConfig(tc.cfg, tc.prevPrimary, tc.prevCanary)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestWithCapacityProviderStrategy(t *testing.T) {
+	t.Parallel()
+
+	service := types.Service{
+		ServiceName: aws.String("web"),
+		LaunchType:  types.LaunchTypeFargate,
+	}
+	got := withCapacityProviderStrategy(service, []config.ECSCapacityProviderStrategyItem{
+		{CapacityProvider: "FARGATE", Base: 1, Weight: 1},
+		{CapacityProvider: "FARGATE_SPOT", Weight: 3},
+	})
+
+	assert.Equal(t, types.LaunchType(""), got.LaunchType)
+	assert.Equal(t, []types.CapacityProviderStrategyItem{
+		{CapacityProvider: aws.String("FARGATE"), Base: 1, Weight: 1},
+		{CapacityProvider: aws.String("FARGATE_SPOT"), Weight: 3},
+	}, got.CapacityProviderStrategy)
+	assert.Equal(t, "web", *got.ServiceName)
+
+	// The given service must not be modified.
+	assert.Equal(t, types.LaunchTypeFargate, service.LaunchType)
+	assert.Empty(t, service.CapacityProviderStrategy)
+}
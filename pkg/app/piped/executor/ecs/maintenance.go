@@ -0,0 +1,137 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func (e *deployExecutor) ensureMaintenanceOn(ctx context.Context) model.StageStatus {
+	// The maintenance response is returned by the ELB listeners.
+	if !e.appCfg.Input.IsAccessedViaELB() {
+		e.LogPersister.Errorf("Unsupported access type %s in stage %s for ECS application", e.appCfg.Input.AccessType, e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	options := e.StageConfig.ECSMaintenanceOnStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	primary, canary, ok := loadTargetGroups(&e.Input, e.appCfg, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if primary == nil {
+		e.LogPersister.Error("Primary target group is required to enable maintenance mode")
+		return model.StageStatus_STAGE_FAILURE
+	}
+	targetGroupArns := []string{*primary.TargetGroupArn}
+	if canary != nil {
+		targetGroupArns = append(targetGroupArns, *canary.TargetGroupArn)
+	}
+
+	client, err := provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	listenerArns, err := client.GetListenerArns(ctx, *primary)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to get current active listeners: %v", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	listenerArns, err = provider.ScopeListeners(listenerArns, e.appCfg.Input.TargetGroups.Listeners)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to scope the listeners to modify: %v", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// Store the listeners before adding the rules so that they can be removed by the rollback.
+	if err := e.MetadataStore.Shared().Put(ctx, maintenanceListenersKey, strings.Join(listenerArns, ",")); err != nil {
+		e.LogPersister.Errorf("Unable to store maintenance listeners to metadata store: %v", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// Remove the rules added by the previous stages to avoid duplicating them.
+	if !deleteMaintenanceRules(ctx, e.LogPersister, client, listenerArns) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Infof("Start adding ELB listener rules to return %d response to all requests", options.StatusCode)
+	scope := listenerRuleScope(&e.Input, e.appCfg.Input.TargetGroups)
+	createdRules, err := client.CreateMaintenanceRules(ctx, listenerArns, targetGroupArns, scope, provider.MaintenanceResponse{
+		StatusCode:  options.StatusCode,
+		ContentType: options.ContentType,
+		MessageBody: options.MessageBody,
+	})
+	for _, rule := range createdRules {
+		e.LogPersister.Infof("Created maintenance ELB listener rule: %s", rule)
+	}
+	if err != nil {
+		e.LogPersister.Errorf("Failed to add maintenance ELB listener rules: %v", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Success("Successfully turned on maintenance mode")
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureMaintenanceOff(ctx context.Context) model.StageStatus {
+	value, ok := e.MetadataStore.Shared().Get(maintenanceListenersKey)
+	if !ok || value == "" {
+		e.LogPersister.Info("Maintenance mode was not turned on in this deployment")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	client, err := provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !deleteMaintenanceRules(ctx, e.LogPersister, client, strings.Split(value, ",")) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// Mark the maintenance mode as turned off so that the rollback does not need to touch the listeners.
+	if err := e.MetadataStore.Shared().Put(ctx, maintenanceListenersKey, ""); err != nil {
+		e.LogPersister.Errorf("Unable to store maintenance listeners to metadata store: %v", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Success("Successfully turned off maintenance mode")
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// deleteMaintenanceRules removes the ELB listener rules added for the maintenance mode.
+func deleteMaintenanceRules(ctx context.Context, logPersister executor.LogPersister, client provider.Client, listenerArns []string) bool {
+	deletedRules, err := client.DeleteMaintenanceRules(ctx, listenerArns)
+	for _, rule := range deletedRules {
+		logPersister.Infof("Deleted maintenance ELB listener rule: %s", rule)
+	}
+	if err != nil {
+		logPersister.Errorf("Failed to delete maintenance ELB listener rules: %v", err)
+		return false
+	}
+	return true
+}
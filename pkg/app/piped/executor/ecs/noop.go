@@ -0,0 +1,146 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// definitionsUnchanged reports whether the task and service definitions rendered at the target commit
+// are identical to the ones rendered at the running commit, and the live service is still settled
+// as updated at the running commit.
+// The live service is checked since the deployments failed or cancelled after the last successful one
+// may have updated it partially.
+// Any error while rendering the running ones is treated as a change.
+// The deployments whose sync strategy was forced always update the service.
+func (e *deployExecutor) definitionsUnchanged(ctx context.Context) bool {
+	if e.Deployment.RunningCommitHash == "" || e.Deployment.Trigger.SyncStrategy != model.SyncStrategy_AUTO {
+		return false
+	}
+
+	runningDS, err := e.RunningDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Infof("Unable to prepare the running deploy source to compare (%v)", err)
+		return false
+	}
+
+	digest, err := definitionsDigest(&e.Input, e.deploySource)
+	if err != nil {
+		e.LogPersister.Infof("Unable to compute the digest of the definitions (%v)", err)
+		return false
+	}
+	runningDigest, err := definitionsDigest(&e.Input, runningDS)
+	if err != nil {
+		e.LogPersister.Infof("Unable to compute the digest of the definitions at the running commit (%v)", err)
+		return false
+	}
+	e.LogPersister.Infof("Digest of the rendered definitions: %s (running commit: %s)", digest, runningDigest)
+	if digest != runningDigest {
+		return false
+	}
+
+	serviceDefinition, ok := loadServiceDefinition(&e.Input, e.appCfg.Input.ServiceDefinitionFile, e.deploySource)
+	if !ok {
+		return false
+	}
+	client, err := provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Infof("Unable to create ECS client for the provider %s to compare with the live service (%v)", e.platformProviderName, err)
+		return false
+	}
+	service, err := client.GetService(ctx, aws.ToString(serviceDefinition.ClusterArn), aws.ToString(serviceDefinition.ServiceName))
+	if err != nil {
+		e.LogPersister.Infof("Unable to get the live service to compare (%v)", err)
+		return false
+	}
+	if reason := checkServiceSettledAtCommit(*service, e.Deployment.RunningCommitHash); reason != "" {
+		e.LogPersister.Infof("The live service is not the one updated at the running commit: %s", reason)
+		return false
+	}
+	return true
+}
+
+// checkServiceSettledAtCommit returns the reason why the given live service is not settled as updated at the given commit,
+// or an empty string when it was updated at the commit and has no other deployment or task set in progress.
+func checkServiceSettledAtCommit(service types.Service, commit string) string {
+	var serviceCommit string
+	for _, t := range service.Tags {
+		if aws.ToString(t.Key) == provider.LabelCommitHash {
+			serviceCommit = aws.ToString(t.Value)
+		}
+	}
+	if serviceCommit != commit {
+		return fmt.Sprintf("it was updated at commit %s", serviceCommit)
+	}
+
+	// The services deployed by task sets, e.g. canary or CodeDeploy, have no deployments.
+	if service.DeploymentController != nil && service.DeploymentController.Type != types.DeploymentControllerTypeEcs {
+		if len(service.TaskSets) != 1 || aws.ToString(service.TaskSets[0].Status) != "PRIMARY" {
+			return fmt.Sprintf("it has %d task sets", len(service.TaskSets))
+		}
+		return ""
+	}
+	if len(service.Deployments) > 1 {
+		return fmt.Sprintf("it has %d deployments in progress", len(service.Deployments))
+	}
+	return ""
+}
+
+// definitionsDigest computes the digest of the task and service definitions rendered from the given deploy source.
+// The builtin tags added while applying are not included since they contain the commit hash.
+func definitionsDigest(in *executor.Input, ds *deploysource.DeploySource) (string, error) {
+	spec := ds.ApplicationConfig.ECSApplicationSpec
+	if spec == nil {
+		return "", errors.New("missing ECSApplicationSpec")
+	}
+
+	taskDefinition, err := provider.LoadTaskDefinition(ds.AppDir, spec.Input.TaskDefinitionFile, newTemplateData(in, ds))
+	if err != nil {
+		return "", err
+	}
+	serviceDefinition, err := provider.LoadServiceDefinition(ds.AppDir, spec.Input.ServiceDefinitionFile, newTemplateData(in, ds))
+	if err != nil {
+		return "", err
+	}
+	serviceConnect, err := provider.LoadServiceConnectConfiguration(ds.AppDir, spec.Input.ServiceDefinitionFile, newTemplateData(in, ds))
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal([]interface{}{taskDefinition, serviceDefinition, serviceConnect})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(data)), nil
+}
+
+// markNoOpSync records that updating the service was skipped in this deployment.
+func markNoOpSync(ctx context.Context, in *executor.Input) {
+	if err := in.MetadataStore.Shared().Put(ctx, model.MetadataKeyDeploymentNoOpSync, "true"); err != nil {
+		in.LogPersister.Errorf("Failed to save the no-op sync to the metadata store (%v)", err)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestDefinitionsDigest(t *testing.T) {
+	t.Parallel()
+
+	in := &executor.Input{
+		Deployment:  &model.Deployment{ApplicationId: "app-id"},
+		PipedConfig: &config.PipedSpec{PipedID: "piped-id"},
+	}
+	newDS := func(revision, prefix string) *deploysource.DeploySource {
+		return &deploysource.DeploySource{
+			AppDir:   "../../platformprovider/ecs/testdata",
+			Revision: revision,
+			ApplicationConfig: &config.Config{
+				ECSApplicationSpec: &config.ECSApplicationSpec{
+					Input: config.ECSDeploymentInput{
+						TaskDefinitionFile:    prefix + "_taskdef.yaml",
+						ServiceDefinitionFile: prefix + "_servicedef.yaml",
+					},
+				},
+			},
+		}
+	}
+
+	digest, err := definitionsDigest(in, newDS("commit-1", "old"))
+	require.NoError(t, err)
+
+	same, err := definitionsDigest(in, newDS("commit-2", "old"))
+	require.NoError(t, err)
+	assert.Equal(t, digest, same)
+
+	changed, err := definitionsDigest(in, newDS("commit-2", "new"))
+	require.NoError(t, err)
+	assert.NotEqual(t, digest, changed)
+}
+
+func TestCheckServiceSettledAtCommit(t *testing.T) {
+	t.Parallel()
+
+	tags := func(commit string) []types.Tag {
+		return []types.Tag{
+			{Key: aws.String(provider.LabelApplication), Value: aws.String("app-id")},
+			{Key: aws.String(provider.LabelCommitHash), Value: aws.String(commit)},
+		}
+	}
+	external := &types.DeploymentController{Type: types.DeploymentControllerTypeExternal}
+
+	testcases := []struct {
+		name       string
+		service    types.Service
+		wantReason bool
+	}{
+		{
+			name: "rolling update settled",
+			service: types.Service{
+				Tags:        tags("running"),
+				Deployments: []types.Deployment{{Status: aws.String("PRIMARY")}},
+			},
+		},
+		{
+			name: "updated by a failed deployment",
+			service: types.Service{
+				Tags:        tags("failed"),
+				Deployments: []types.Deployment{{Status: aws.String("PRIMARY")}},
+			},
+			wantReason: true,
+		},
+		{
+			name: "rolling update in progress",
+			service: types.Service{
+				Tags:        tags("running"),
+				Deployments: []types.Deployment{{Status: aws.String("PRIMARY")}, {Status: aws.String("ACTIVE")}},
+			},
+			wantReason: true,
+		},
+		{
+			name: "task set settled",
+			service: types.Service{
+				Tags:                 tags("running"),
+				DeploymentController: external,
+				TaskSets:             []types.TaskSet{{Status: aws.String("PRIMARY")}},
+			},
+		},
+		{
+			name: "canary task set left",
+			service: types.Service{
+				Tags:                 tags("running"),
+				DeploymentController: external,
+				TaskSets:             []types.TaskSet{{Status: aws.String("PRIMARY")}, {Status: aws.String("ACTIVE")}},
+			},
+			wantReason: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			reason := checkServiceSettledAtCommit(tc.service, "running")
+			assert.Equal(t, tc.wantReason, reason != "")
+		})
+	}
+}
@@ -17,6 +17,7 @@ package ecs
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 
@@ -77,6 +78,14 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
+
+	if appCfg.Input.IsStandaloneTask() {
+		if !rollbackStandaloneTask(ctx, &e.Input, platformProviderName, platformProviderCfg, taskDefinition, appCfg.Input) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
 	serviceDefinition, ok := loadServiceDefinition(&e.Input, appCfg.Input.ServiceDefinitionFile, runningDS)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
@@ -87,14 +96,37 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	if !rollback(ctx, &e.Input, platformProviderName, platformProviderCfg, taskDefinition, serviceDefinition, primary, canary) {
+	if appCfg.Input.CodeDeploy != nil {
+		if !rollbackCodeDeploy(ctx, &e.Input, platformProviderName, platformProviderCfg, *appCfg.Input.CodeDeploy, taskDefinition, serviceDefinition, primary) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	if appCfg.Input.RollingUpdate != nil {
+		serviceConnect, ok := loadServiceConnectConfiguration(&e.Input, appCfg.Input, runningDS)
+		if !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if !rollbackRollingUpdate(ctx, &e.Input, platformProviderName, platformProviderCfg, *appCfg.Input.RollingUpdate, taskDefinition, serviceDefinition, serviceConnect) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	if mesh := appCfg.Input.AppMesh; mesh != nil {
+		// Register the tasks to the service discovery of the PRIMARY virtual node.
+		serviceDefinition = withServiceRegistry(serviceDefinition, mesh.Primary.RegistryArn)
+	}
+
+	if !rollback(ctx, &e.Input, platformProviderName, platformProviderCfg, taskDefinition, serviceDefinition, primary, canary, appCfg.Input.TargetGroups, appCfg.Input.AppMesh) {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
 	return model.StageStatus_STAGE_SUCCESS
 }
 
-func rollback(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, taskDefinition types.TaskDefinition, serviceDefinition types.Service, primaryTargetGroup *types.LoadBalancer, canaryTargetGroup *types.LoadBalancer) bool {
+func rollback(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, taskDefinition types.TaskDefinition, serviceDefinition types.Service, primaryTargetGroup *types.LoadBalancer, canaryTargetGroup *types.LoadBalancer, targetGroups config.ECSTargetGroups, mesh *config.ECSAppMesh) bool {
 	in.LogPersister.Infof("Start rollback the ECS service and task family: %s and %s to original stage", *serviceDefinition.ServiceName, *taskDefinition.Family)
 	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
 	if err != nil {
@@ -102,13 +134,30 @@ func rollback(ctx context.Context, in *executor.Input, platformProviderName stri
 		return false
 	}
 
-	// Re-register TaskDef to get TaskDefArn.
-	// Consider using DescribeServices and get services[0].taskSets[0].taskDefinition (taskDefinition of PRIMARY taskSet)
-	// then store it in metadata store and use for rollback instead.
-	td, err := client.RegisterTaskDefinition(ctx, taskDefinition)
-	if err != nil {
-		in.LogPersister.Errorf("Failed to register new revision of ECS task definition %s: %v", *taskDefinition.Family, err)
-		return false
+	// Cut the traffic to the CANARY variant immediately when it was judged as unhealthy by an analysis
+	// because the following steps take minutes until the recreated PRIMARY task set becomes stable.
+	if analysisFailed(in.Deployment) {
+		switch {
+		case primaryTargetGroup != nil:
+			if !cutCanary(ctx, in, client, func() bool { return rollbackELB(ctx, in, client, primaryTargetGroup, canaryTargetGroup, targetGroups) }) {
+				return false
+			}
+		case mesh != nil:
+			if !cutCanary(ctx, in, client, func() bool { return rollbackMesh(ctx, in, client, *mesh) }) {
+				return false
+			}
+		}
+	}
+
+	// Reuse the task definition of the PRIMARY task set recorded before the deployment if any,
+	// otherwise re-register TaskDef to get TaskDefArn.
+	td, ok := loadPrimaryTaskDefinition(ctx, in, client, taskDefinition)
+	if !ok {
+		td, err = client.RegisterTaskDefinition(ctx, taskDefinition)
+		if err != nil {
+			in.LogPersister.Errorf("Failed to register new revision of ECS task definition %s: %v", *taskDefinition.Family, err)
+			return false
+		}
 	}
 
 	// Rollback ECS service configuration to previous state including commit-hash of the tag.
@@ -141,7 +190,19 @@ func rollback(ctx context.Context, in *executor.Input, platformProviderName stri
 
 	// Reset routing in case of rolling back progressive pipeline.
 	if primaryTargetGroup != nil {
-		if !rollbackELB(ctx, in, client, primaryTargetGroup, canaryTargetGroup) {
+		if !rollbackELB(ctx, in, client, primaryTargetGroup, canaryTargetGroup, targetGroups) {
+			return false
+		}
+	}
+	if mesh != nil {
+		if !rollbackMesh(ctx, in, client, *mesh) {
+			return false
+		}
+	}
+
+	// Turn off the maintenance mode after the service was rolled back.
+	if value, ok := in.MetadataStore.Shared().Get(maintenanceListenersKey); ok && value != "" {
+		if !deleteMaintenanceRules(ctx, in.LogPersister, client, strings.Split(value, ",")) {
 			return false
 		}
 	}
@@ -156,11 +217,89 @@ func rollback(ctx context.Context, in *executor.Input, platformProviderName stri
 		}
 	}
 
+	// Restore the auto scaling settings which could be changed during the deployment.
+	if !restoreServiceAutoScaling(ctx, in, client, serviceDefinition) {
+		return false
+	}
+
 	in.LogPersister.Infof("Rolled back the ECS service %s and task definition %s configuration to original stage", *serviceDefinition.ServiceName, *taskDefinition.Family)
 	return true
 }
 
-func rollbackELB(ctx context.Context, in *executor.Input, client provider.Client, primaryTargetGroup *types.LoadBalancer, canaryTargetGroup *types.LoadBalancer) bool {
+// rollbackStandaloneTask makes each target of the scheduled task run the task definition it ran before the deployment.
+// When they were not recorded, it registers the task definition of the last deployment again and makes the scheduled task run it.
+// The task is not run because it was already run by the last deployment.
+func rollbackStandaloneTask(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, taskDefinition types.TaskDefinition, ecsInput config.ECSDeploymentInput) bool {
+	in.LogPersister.Infof("Start rollback the ECS task family %s to original stage", *taskDefinition.Family)
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
+		return false
+	}
+
+	if ecsInput.ScheduledTask != nil {
+		restored, recorded := restoreScheduledTask(ctx, in, client, *ecsInput.ScheduledTask)
+		if recorded {
+			if !restored {
+				return false
+			}
+			in.LogPersister.Infof("Rolled back the ECS task family %s to original stage", *taskDefinition.Family)
+			return true
+		}
+	}
+
+	td, err := applyTaskDefinition(ctx, client, taskDefinition)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to apply ECS task definition: %v", err)
+		return false
+	}
+
+	if ecsInput.ScheduledTask != nil {
+		if _, ok := updateScheduledTask(ctx, in, client, *ecsInput.ScheduledTask, *td.TaskDefinitionArn); !ok {
+			return false
+		}
+	}
+
+	in.LogPersister.Infof("Rolled back the ECS task family %s to original stage", *taskDefinition.Family)
+	return true
+}
+
+// analysisFailed returns true when an ANALYSIS stage of the given deployment failed.
+func analysisFailed(d *model.Deployment) bool {
+	for _, s := range d.Stages {
+		if s.Name == model.StageAnalysis.String() && s.Status == model.StageStatus_STAGE_FAILURE {
+			return true
+		}
+	}
+	return false
+}
+
+// cutCanary routes all traffic back to the PRIMARY variant by the given function and deletes the CANARY task set
+// so that the CANARY variant stops serving before the standard rollback starts.
+func cutCanary(ctx context.Context, in *executor.Input, client provider.Client, routeBack func() bool) bool {
+	in.LogPersister.Info("Cutting the traffic to the CANARY variant because the analysis failed")
+	if !routeBack() {
+		return false
+	}
+
+	taskSet, ok := loadCanaryTaskSet(in)
+	if !ok {
+		return true
+	}
+	in.LogPersister.Infof("Deleting CANARY task set %s", *taskSet.TaskSetArn)
+	if err := client.DeleteTaskSet(ctx, *taskSet); err != nil {
+		in.LogPersister.Errorf("Failed to delete CANARY task set %s: %v", *taskSet.TaskSetArn, err)
+		return false
+	}
+	// Clear the recorded CANARY task set since it no longer exists.
+	if err := in.MetadataStore.Shared().Put(ctx, canaryTaskSetKeyName, ""); err != nil {
+		in.LogPersister.Errorf("Failed to clear CANARY task set from metadata store: %v", err)
+	}
+	in.LogPersister.Infof("Successfully cut the traffic to the CANARY variant")
+	return true
+}
+
+func rollbackELB(ctx context.Context, in *executor.Input, client provider.Client, primaryTargetGroup *types.LoadBalancer, canaryTargetGroup *types.LoadBalancer, targetGroups config.ECSTargetGroups) bool {
 	var canaryTargetGroupArn string
 	if canaryTargetGroup == nil {
 		// Get the touched canary target group from a TRAFFIC_ROUTING stage.
@@ -193,8 +332,17 @@ func rollbackELB(ctx context.Context, in *executor.Input, client provider.Client
 		in.LogPersister.Errorf("Failed to get current active listeners: %v", err)
 		return false
 	}
+	currListenerArns, err = provider.ScopeListeners(currListenerArns, targetGroups.Listeners)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to scope the listeners to modify: %v", err)
+		return false
+	}
+
+	if !deleteABTestingRules(ctx, in.LogPersister, client, currListenerArns) {
+		return false
+	}
 
-	modifiedRules, err := client.ModifyListeners(ctx, currListenerArns, routingTrafficCfg)
+	modifiedRules, err := client.ModifyListeners(ctx, currListenerArns, routingTrafficCfg, listenerRuleScope(in, targetGroups), 0)
 	if err != nil {
 		in.LogPersister.Errorf("Failed to routing traffic to PRIMARY/CANARY variants: %v", err)
 
@@ -0,0 +1,67 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestAnalysisFailed(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name   string
+		stages []*model.PipelineStage
+		want   bool
+	}{
+		{
+			name: "no analysis stage",
+			stages: []*model.PipelineStage{
+				{Name: model.StageECSCanaryRollout.String(), Status: model.StageStatus_STAGE_FAILURE},
+			},
+			want: false,
+		},
+		{
+			name: "analysis stage succeeded",
+			stages: []*model.PipelineStage{
+				{Name: model.StageECSCanaryRollout.String(), Status: model.StageStatus_STAGE_SUCCESS},
+				{Name: model.StageAnalysis.String(), Status: model.StageStatus_STAGE_SUCCESS},
+				{Name: model.StageECSPrimaryRollout.String(), Status: model.StageStatus_STAGE_FAILURE},
+			},
+			want: false,
+		},
+		{
+			name: "analysis stage failed",
+			stages: []*model.PipelineStage{
+				{Name: model.StageECSCanaryRollout.String(), Status: model.StageStatus_STAGE_SUCCESS},
+				{Name: model.StageAnalysis.String(), Status: model.StageStatus_STAGE_FAILURE},
+				{Name: model.StageRollback.String(), Status: model.StageStatus_STAGE_RUNNING},
+			},
+			want: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := analysisFailed(&model.Deployment{Stages: tc.stages})
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	defaultRollingUpdateTimeout = 30 * time.Minute
+	rollingUpdateCheckInterval  = 15 * time.Second
+)
+
+// rollingUpdate deploys the given task definition to the given service by a rolling update of the ECS deployment controller,
+// and waits until the rolling update is completed.
+// The Service Connect configuration of the service is replaced with the given one unless it is nil.
+func rollingUpdate(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, ruCfg config.ECSRollingUpdateInput, taskDefinition types.TaskDefinition, serviceDefinition types.Service, serviceConnect *types.ServiceConnectConfiguration, autoScaling *config.ECSAutoScaling) bool {
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
+		return false
+	}
+
+	service, ok := deployRollingUpdate(ctx, in, client, ruCfg, taskDefinition, serviceDefinition, serviceConnect)
+	if !ok {
+		return false
+	}
+	if autoScaling != nil && !applyServiceAutoScaling(ctx, in, client, *service, *autoScaling) {
+		return false
+	}
+	return true
+}
+
+// rollbackRollingUpdate deploys the given task definition of the last deployment by a rolling update.
+// An in-progress rolling update started by this deployment is superseded by the new one.
+func rollbackRollingUpdate(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, ruCfg config.ECSRollingUpdateInput, taskDefinition types.TaskDefinition, serviceDefinition types.Service, serviceConnect *types.ServiceConnectConfiguration) bool {
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
+		return false
+	}
+
+	in.LogPersister.Infof("Start rolling back ECS service %s to task definition family %s by a rolling update", *serviceDefinition.ServiceName, *taskDefinition.Family)
+	if _, ok := deployRollingUpdate(ctx, in, client, ruCfg, taskDefinition, serviceDefinition, serviceConnect); !ok {
+		return false
+	}
+
+	// Restore the auto scaling settings which could be changed during the deployment.
+	return restoreServiceAutoScaling(ctx, in, client, serviceDefinition)
+}
+
+func deployRollingUpdate(ctx context.Context, in *executor.Input, client provider.Client, ruCfg config.ECSRollingUpdateInput, taskDefinition types.TaskDefinition, serviceDefinition types.Service, serviceConnect *types.ServiceConnectConfiguration) (*types.Service, bool) {
+	// The service can not be created by PipeCD because it only creates the services using EXTERNAL deployment controller.
+	found, err := client.ServiceExists(ctx, *serviceDefinition.ClusterArn, *serviceDefinition.ServiceName)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to validate service name %s: %v", *serviceDefinition.ServiceName, err)
+		return nil, false
+	}
+	if !found {
+		in.LogPersister.Errorf("ECS service %s must be created with ECS deployment controller before deploying it by rolling updates", *serviceDefinition.ServiceName)
+		return nil, false
+	}
+
+	if serviceConnect != nil && serviceConnect.Enabled {
+		if err := client.ValidateServiceConnectNamespace(ctx, serviceDefinition, *serviceConnect); err != nil {
+			in.LogPersister.Errorf("Invalid Service Connect configuration of ECS service %s: %v", *serviceDefinition.ServiceName, err)
+			return nil, false
+		}
+	}
+
+	in.LogPersister.Infof("Start applying the ECS task definition")
+	td, err := applyTaskDefinition(ctx, client, taskDefinition)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to apply ECS task definition: %v", err)
+		return nil, false
+	}
+
+	in.LogPersister.Infof("Start updating ECS service %s to task definition %s", *serviceDefinition.ServiceName, *td.TaskDefinitionArn)
+	service, id, err := client.UpdateServiceTaskDefinition(ctx, serviceDefinition, *td.TaskDefinitionArn, serviceConnect)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to update service %s: %v", *serviceDefinition.ServiceName, err)
+		return nil, false
+	}
+	if err := updateServiceTags(ctx, client, service, serviceDefinition.Tags); err != nil {
+		in.LogPersister.Errorf("Failed to apply service %s: %v", *serviceDefinition.ServiceName, err)
+		return nil, false
+	}
+	in.LogPersister.Infof("Started rolling update %s of ECS service %s", id, *serviceDefinition.ServiceName)
+
+	timeout := ruCfg.Timeout.Duration()
+	if timeout <= 0 {
+		timeout = defaultRollingUpdateTimeout
+	}
+	if !waitRollingUpdate(ctx, in, client, *service, *td, id, timeout) {
+		return nil, false
+	}
+	return service, true
+}
+
+// waitRollingUpdate waits until the given rolling update is completed.
+// ECS keeps the rolling update even when it is not completed before the timeout or the stage is cancelled.
+func waitRollingUpdate(ctx context.Context, in *executor.Input, client provider.Client, service types.Service, taskDefinition types.TaskDefinition, id string, timeout time.Duration) bool {
+	in.LogPersister.Infof("Waiting for rolling update %s to be completed (timeout: %v)", id, timeout)
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(rollingUpdateCheckInterval)
+	defer ticker.Stop()
+
+	var progress string
+	for {
+		d, err := client.GetRollingDeployment(ctx, service, id)
+		if errors.Is(err, platformprovider.ErrNotFound) {
+			in.LogPersister.Errorf("Rolling update %s does not exist anymore, it might be replaced by another update of the service", id)
+			return false
+		}
+		if err != nil {
+			// Keep waiting because the rolling update is proceeded by ECS regardless of this error.
+			in.LogPersister.Infof("Unable to get the status of rolling update %s, will retry: %v", id, err)
+		} else {
+			if p := rollingUpdateProgress(*d); p != progress {
+				progress = p
+				in.LogPersister.Info(progress)
+			}
+			if d.IsCompleted() {
+				return reportRollingUpdateResult(ctx, in, client, service, taskDefinition, d)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-timer.C:
+			in.LogPersister.Errorf("Rolling update %s was not completed in %v", id, timeout)
+			return false
+		case <-ctx.Done():
+			in.LogPersister.Info("The stage was cancelled while waiting for rolling update")
+			return false
+		}
+	}
+}
+
+// rollingUpdateProgress returns the message describing the progress of the given rolling update.
+func rollingUpdateProgress(d provider.RollingDeployment) string {
+	msg := fmt.Sprintf("Rolling update %s is %s: %d/%d tasks are running", d.ID, d.RolloutState, d.RunningCount, d.DesiredCount)
+	if d.FailedTasks > 0 {
+		msg += fmt.Sprintf(", %d tasks failed to start", d.FailedTasks)
+	}
+	return msg
+}
+
+func reportRollingUpdateResult(ctx context.Context, in *executor.Input, client provider.Client, service types.Service, taskDefinition types.TaskDefinition, d *provider.RollingDeployment) bool {
+	if d.RolloutState == types.DeploymentRolloutStateCompleted {
+		in.LogPersister.Successf("Successfully completed rolling update %s", d.ID)
+		return true
+	}
+
+	in.LogPersister.Errorf("Rolling update %s was %s: %s", d.ID, d.RolloutState, d.RolloutStateReason)
+	if d.CircuitBreakerRollback {
+		in.LogPersister.Info("ECS is rolling the service back to the last completed deployment by the deployment circuit breaker")
+	}
+	reportStoppedTasks(ctx, in, client, service, taskDefinition)
+	return false
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+)
+
+func TestRollingUpdateProgress(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name string
+		d    provider.RollingDeployment
+		want string
+	}{
+		{
+			name: "in progress",
+			d:    provider.RollingDeployment{ID: "ecs-svc/1", RolloutState: types.DeploymentRolloutStateInProgress, DesiredCount: 3, RunningCount: 1},
+			want: "Rolling update ecs-svc/1 is IN_PROGRESS: 1/3 tasks are running",
+		},
+		{
+			name: "with failed tasks",
+			d:    provider.RollingDeployment{ID: "ecs-svc/1", RolloutState: types.DeploymentRolloutStateFailed, DesiredCount: 3, FailedTasks: 2},
+			want: "Rolling update ecs-svc/1 is FAILED: 0/3 tasks are running, 2 tasks failed to start",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, rollingUpdateProgress(tc.d))
+		})
+	}
+}
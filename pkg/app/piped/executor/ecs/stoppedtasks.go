@@ -0,0 +1,70 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+)
+
+// reportStoppedTasks writes why the tasks of the given task definition stopped into the stage log
+// to help find out why the service did not reach stable state.
+func reportStoppedTasks(ctx context.Context, in *executor.Input, client provider.Client, service types.Service, taskDefinition types.TaskDefinition) {
+	// Nothing to report when the deployment was cancelled.
+	if ctx.Err() != nil {
+		return
+	}
+
+	tasks, err := client.ListStoppedTaskReasons(ctx, service, taskDefinition)
+	if err != nil {
+		in.LogPersister.Infof("Unable to find the stopped tasks of ECS service %s: %v", *service.ServiceName, err)
+		return
+	}
+	if len(tasks) == 0 {
+		in.LogPersister.Infof("No task of task definition %s has stopped", *taskDefinition.TaskDefinitionArn)
+		return
+	}
+
+	in.LogPersister.Errorf("Found %d recently stopped task(s) of task definition %s", len(tasks), *taskDefinition.TaskDefinitionArn)
+	for _, t := range tasks {
+		in.LogPersister.Errorf("Task %s stopped (%s): %s", t.TaskArn, t.StopCode, t.StoppedReason)
+		for _, c := range t.Containers {
+			in.LogPersister.Errorf("  Container %s exited with code %s: %s", c.Name, formatExitCode(c.ExitCode), c.Reason)
+			if c.LogError != "" {
+				in.LogPersister.Infof("  Unable to get the logs of container %s: %s", c.Name, c.LogError)
+				continue
+			}
+			if len(c.LastLogLines) == 0 {
+				continue
+			}
+			in.LogPersister.Infof("  Last %d log line(s) of container %s:", len(c.LastLogLines), c.Name)
+			for _, l := range c.LastLogLines {
+				in.LogPersister.Info("    " + l)
+			}
+		}
+	}
+}
+
+func formatExitCode(code *int32) string {
+	if code == nil {
+		return "unknown"
+	}
+	return fmt.Sprint(*code)
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func (e *deployExecutor) ensureTargetGroupHealthCheck(ctx context.Context) model.StageStatus {
+	if !e.appCfg.Input.IsAccessedViaELB() {
+		e.LogPersister.Errorf("Unsupported access type %s in stage %s for ECS application", e.appCfg.Input.AccessType, e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	options := e.StageConfig.ECSTargetGroupHealthCheckStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	primary, canary, ok := loadTargetGroups(&e.Input, e.appCfg, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	configured := make(map[string]string, 2)
+	if primary != nil {
+		configured[config.ECSTargetGroupPrimary] = *primary.TargetGroupArn
+	}
+	if canary != nil {
+		configured[config.ECSTargetGroupCanary] = *canary.TargetGroupArn
+	}
+
+	names := options.TargetGroups
+	if len(names) == 0 {
+		names = []string{config.ECSTargetGroupPrimary, config.ECSTargetGroupCanary}
+	}
+	targetGroupArns := make([]string, 0, len(names))
+	for _, name := range names {
+		arn, ok := configured[name]
+		if !ok {
+			if len(options.TargetGroups) > 0 {
+				e.LogPersister.Errorf("The %s target group is not configured in the application", name)
+				return model.StageStatus_STAGE_FAILURE
+			}
+			continue
+		}
+		targetGroupArns = append(targetGroupArns, arn)
+	}
+	if len(targetGroupArns) == 0 {
+		e.LogPersister.Error("No target group is configured in the application")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	client, err := provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !e.waitTargetGroupsHealthy(ctx, client, targetGroupArns, options) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Success("All target groups have enough healthy targets")
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// waitTargetGroupsHealthy waits until all of the given target groups have enough healthy targets.
+func (e *deployExecutor) waitTargetGroupsHealthy(ctx context.Context, client provider.Client, targetGroupArns []string, options *config.ECSTargetGroupHealthCheckStageOptions) bool {
+	timeout := options.Timeout.Duration()
+	e.LogPersister.Infof("Waiting for at least %d%% and %d of the targets in each target group to be healthy (timeout: %v)", options.MinHealthyPercentage, options.MinHealthyCount, timeout)
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(options.Interval.Duration())
+	defer ticker.Stop()
+
+	last := make(map[string]*provider.TargetGroupHealth, len(targetGroupArns))
+	for {
+		passed := true
+		for _, arn := range targetGroupArns {
+			h, err := client.GetTargetGroupHealth(ctx, arn)
+			if err != nil {
+				// Keep waiting because the error might be temporary.
+				e.LogPersister.Infof("Unable to get the health of target group %s, will retry: %v", arn, err)
+				passed = false
+				continue
+			}
+			if prev, ok := last[arn]; !ok || prev.Total != h.Total || prev.Healthy != h.Healthy {
+				e.LogPersister.Infof("Target group %s: %d/%d targets are healthy", arn, h.Healthy, h.Total)
+			}
+			last[arn] = h
+			if !isTargetGroupHealthy(h, options) {
+				passed = false
+			}
+		}
+		if passed {
+			return true
+		}
+
+		select {
+		case <-ticker.C:
+		case <-timer.C:
+			e.LogPersister.Errorf("Target groups did not have enough healthy targets in %v", timeout)
+			for _, arn := range targetGroupArns {
+				h, ok := last[arn]
+				if !ok || isTargetGroupHealthy(h, options) {
+					continue
+				}
+				e.LogPersister.Errorf("Target group %s: %d/%d targets are healthy", arn, h.Healthy, h.Total)
+				targets := make([]string, 0, len(h.Unhealthy))
+				for t := range h.Unhealthy {
+					targets = append(targets, t)
+				}
+				sort.Strings(targets)
+				for _, t := range targets {
+					e.LogPersister.Errorf("  Target %s is %s", t, h.Unhealthy[t])
+				}
+			}
+			return false
+		case <-ctx.Done():
+			e.LogPersister.Info("The stage was cancelled while waiting for the targets to be healthy")
+			return false
+		}
+	}
+}
+
+// isTargetGroupHealthy reports whether the target group has enough healthy targets.
+func isTargetGroupHealthy(h *provider.TargetGroupHealth, options *config.ECSTargetGroupHealthCheckStageOptions) bool {
+	if h.Healthy < options.MinHealthyCount {
+		return false
+	}
+	return h.Healthy*100 >= options.MinHealthyPercentage*h.Total
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestIsTargetGroupHealthy(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		health     provider.TargetGroupHealth
+		percentage int
+		count      int
+		want       bool
+	}{
+		{
+			name:       "all targets are healthy",
+			health:     provider.TargetGroupHealth{Total: 3, Healthy: 3},
+			percentage: 100,
+			count:      1,
+			want:       true,
+		},
+		{
+			name:       "not enough percentage",
+			health:     provider.TargetGroupHealth{Total: 4, Healthy: 2},
+			percentage: 75,
+			count:      1,
+			want:       false,
+		},
+		{
+			name:       "enough percentage",
+			health:     provider.TargetGroupHealth{Total: 4, Healthy: 3},
+			percentage: 75,
+			count:      1,
+			want:       true,
+		},
+		{
+			name:       "not enough count",
+			health:     provider.TargetGroupHealth{Total: 1, Healthy: 1},
+			percentage: 100,
+			count:      2,
+			want:       false,
+		},
+		{
+			name:       "no target is registered",
+			health:     provider.TargetGroupHealth{},
+			percentage: 100,
+			count:      1,
+			want:       false,
+		},
+		{
+			name:       "no target is required",
+			health:     provider.TargetGroupHealth{},
+			percentage: 100,
+			count:      0,
+			want:       true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := isTargetGroupHealthy(&tc.health, &config.ECSTargetGroupHealthCheckStageOptions{
+				MinHealthyPercentage: tc.percentage,
+				MinHealthyCount:      tc.count,
+			})
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// recordPrimaryTaskDefinition stores the ARN of the task definition used by the PRIMARY task set
+// of the given service into the shared metadata so that the rollback can reuse it instead of
+// registering a new revision.
+// It must be called by the first stage of the deployment before any change is made. It records
+// exactly once per deployment: when the ARN cannot be fetched, an empty value is stored instead
+// so that the following stages never record the task definition deployed by this deployment.
+// The returned value is false only when the result could not be stored.
+func recordPrimaryTaskDefinition(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, serviceDefinition types.Service) bool {
+	if _, ok := in.MetadataStore.Shared().Get(primaryTaskDefinitionArnKey); ok {
+		return true
+	}
+
+	arn := getPrimaryTaskDefinitionArn(ctx, in, platformProviderName, platformProviderCfg, serviceDefinition)
+	if err := in.MetadataStore.Shared().Put(ctx, primaryTaskDefinitionArnKey, arn); err != nil {
+		in.LogPersister.Errorf("Failed to store the task definition of the PRIMARY task set to metadata store: %v", err)
+		return false
+	}
+	if arn != "" {
+		in.LogPersister.Infof("Recorded the task definition %s of the PRIMARY task set of ECS service %s", arn, *serviceDefinition.ServiceName)
+	}
+	return true
+}
+
+// getPrimaryTaskDefinitionArn returns the ARN of the task definition used by the PRIMARY task set
+// of the given service, or an empty string when it is unavailable.
+// Not fail the deployment in that case because a new revision is registered on rollback.
+func getPrimaryTaskDefinitionArn(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, serviceDefinition types.Service) string {
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.Logger.Error("Unable to create ECS client to record the task definition of the PRIMARY task set", zap.Error(err))
+		return ""
+	}
+
+	arn, err := client.GetPrimaryTaskDefinitionArn(ctx, serviceDefinition)
+	if errors.Is(err, platformprovider.ErrNotFound) {
+		in.LogPersister.Infof("ECS service %s has no PRIMARY task set yet, a new revision of the task definition will be registered on rollback", *serviceDefinition.ServiceName)
+		return ""
+	}
+	if err != nil {
+		in.LogPersister.Infof("Unable to record the task definition of the PRIMARY task set of ECS service %s, a new revision will be registered on rollback: %v", *serviceDefinition.ServiceName, err)
+		return ""
+	}
+	return arn
+}
+
+// loadPrimaryTaskDefinition returns the task definition recorded by recordPrimaryTaskDefinition.
+// The second returned value is false when it was not recorded, or when it is no longer usable
+// for rolling back to the given task definition, e.g. its family was changed.
+func loadPrimaryTaskDefinition(ctx context.Context, in *executor.Input, client provider.Client, taskDefinition types.TaskDefinition) (*types.TaskDefinition, bool) {
+	arn, ok := in.MetadataStore.Shared().Get(primaryTaskDefinitionArnKey)
+	if !ok || arn == "" {
+		return nil, false
+	}
+
+	td, err := client.GetTaskDefinition(ctx, arn)
+	if err != nil {
+		in.LogPersister.Infof("Unable to get the recorded task definition %s, a new revision will be registered instead: %v", arn, err)
+		return nil, false
+	}
+	if td.Status == types.TaskDefinitionStatusInactive {
+		in.LogPersister.Infof("The recorded task definition %s is inactive, a new revision will be registered instead", arn)
+		return nil, false
+	}
+	if aws.ToString(td.Family) != aws.ToString(taskDefinition.Family) {
+		in.LogPersister.Infof("The family of the recorded task definition %s is different from %s, a new revision will be registered instead", arn, aws.ToString(taskDefinition.Family))
+		return nil, false
+	}
+
+	in.LogPersister.Infof("Reuse the task definition %s of the PRIMARY task set before the deployment", arn)
+	return td, true
+}
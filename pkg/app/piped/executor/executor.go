@@ -16,6 +16,7 @@ package executor
 
 import (
 	"context"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -54,6 +55,29 @@ type AppLiveResourceLister interface {
 	ListKubernetesResources() ([]provider.Manifest, bool)
 }
 
+type DeploymentDiffReporter interface {
+	// ReportDeploymentDiff saves the rendered diff of the deployment.
+	// Only the summary, details, no-change flag and resource counts of the given diff are used.
+	ReportDeploymentDiff(ctx context.Context, diff *model.DeploymentDiff) error
+}
+
+type StageArtifactUploader interface {
+	// UploadStageArtifact saves a file produced while executing the current stage.
+	// The previously uploaded artifact with the same name will be overwritten.
+	UploadStageArtifact(ctx context.Context, name string, content []byte) error
+}
+
+type EventRegisterer interface {
+	// RegisterEvent registers an event produced by the deployment
+	// so that the applications watching it by EventWatcher are updated with the given data.
+	RegisterEvent(ctx context.Context, name, data string, labels map[string]string) error
+}
+
+type DeploymentRiskGetter interface {
+	// GetDeploymentRisk returns the risk score of the deployment computed by the control plane.
+	GetDeploymentRisk(ctx context.Context) (*model.DeploymentRisk, error)
+}
+
 type AnalysisResultStore interface {
 	GetLatestAnalysisResult(ctx context.Context) (*model.AnalysisResult, error)
 	PutLatestAnalysisResult(ctx context.Context, analysisResult *model.AnalysisResult) error
@@ -77,16 +101,20 @@ type Input struct {
 	// Deploy source at target commit
 	TargetDSP deploysource.Provider
 	// Deploy source at running commit
-	RunningDSP            deploysource.Provider
-	GitClient             GitClient
-	CommandLister         CommandLister
-	LogPersister          LogPersister
-	MetadataStore         metadatastore.MetadataStore
-	AppManifestsCache     cache.Cache
-	AppLiveResourceLister AppLiveResourceLister
-	AnalysisResultStore   AnalysisResultStore
-	Logger                *zap.Logger
-	Notifier              Notifier
+	RunningDSP             deploysource.Provider
+	GitClient              GitClient
+	CommandLister          CommandLister
+	LogPersister           LogPersister
+	MetadataStore          metadatastore.MetadataStore
+	AppManifestsCache      cache.Cache
+	AppLiveResourceLister  AppLiveResourceLister
+	AnalysisResultStore    AnalysisResultStore
+	DeploymentDiffReporter DeploymentDiffReporter
+	StageArtifactUploader  StageArtifactUploader
+	DeploymentRiskGetter   DeploymentRiskGetter
+	EventRegisterer        EventRegisterer
+	Logger                 *zap.Logger
+	Notifier               Notifier
 }
 
 func DetermineStageStatus(sig StopSignalType, ori, got model.StageStatus) model.StageStatus {
@@ -102,3 +130,23 @@ func DetermineStageStatus(sig StopSignalType, ori, got model.StageStatus) model.
 	}
 	return model.StageStatus_STAGE_FAILURE
 }
+
+// ResolveResourceTags returns a copy of the given platform provider's default tags
+// with the placeholders in their values replaced by the information of this deployment.
+// The available placeholders are ${APP_ID}, ${APP_NAME}, ${PIPED_ID} and ${COMMIT_HASH}.
+func (in *Input) ResolveResourceTags(tags map[string]string, commit string) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	r := strings.NewReplacer(
+		"${APP_ID}", in.Deployment.ApplicationId,
+		"${APP_NAME}", in.Deployment.ApplicationName,
+		"${PIPED_ID}", in.PipedConfig.PipedID,
+		"${COMMIT_HASH}", commit,
+	)
+	out := make(map[string]string, len(tags))
+	for k, v := range tags {
+		out[k] = r.Replace(v)
+	}
+	return out
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externalwait
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	reportedByKey      = "ReportedBy"
+	reportedMessageKey = "ReportedMessage"
+)
+
+type Executor struct {
+	executor.Input
+}
+
+type registerer interface {
+	Register(stage model.Stage, f executor.Factory) error
+	MarkDryRunnable(stage model.Stage)
+}
+
+// Register registers this executor factory into a given registerer.
+func Register(r registerer) {
+	f := func(in executor.Input) executor.Executor {
+		return &Executor{
+			Input: in,
+		}
+	}
+	r.Register(model.StageExternalWait, f)
+	r.MarkDryRunnable(model.StageExternalWait)
+}
+
+// Execute starts waiting until an external system reports
+// the final result of its job for this stage.
+func (e *Executor) Execute(sig executor.StopSignal) model.StageStatus {
+	var (
+		originalStatus = e.Stage.Status
+		ctx            = sig.Context()
+		ticker         = time.NewTicker(5 * time.Second)
+	)
+	defer ticker.Stop()
+	timeout := e.StageConfig.ExternalWaitStageOptions.Timeout.Duration()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	e.LogPersister.Infof("Waiting for the result of stage %s reported by an external system...", e.Stage.Id)
+	for {
+		select {
+		case <-ticker.C:
+			if status, ok := e.checkResult(ctx); ok {
+				return status
+			}
+
+		case s := <-sig.Ch():
+			switch s {
+			case executor.StopSignalCancel:
+				return model.StageStatus_STAGE_CANCELLED
+			case executor.StopSignalTerminate:
+				return originalStatus
+			default:
+				return model.StageStatus_STAGE_FAILURE
+			}
+		case <-timer.C:
+			e.LogPersister.Errorf("Timed out %v", timeout)
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+}
+
+// checkResult handles all reported results in order and returns the stage status
+// once a final one was reported. The second returned value is false while pending.
+func (e *Executor) checkResult(ctx context.Context) (model.StageStatus, bool) {
+	var (
+		status   model.StageStatus
+		finished bool
+	)
+	for _, cmd := range e.CommandLister.ListCommands() {
+		r := cmd.GetReportStageResult()
+		if r == nil {
+			continue
+		}
+		if !finished {
+			status, finished = e.handleResult(ctx, cmd.Commander, r)
+		}
+		if err := cmd.Report(ctx, model.CommandStatus_COMMAND_SUCCEEDED, nil, nil); err != nil {
+			e.Logger.Error("failed to report handled command", zap.Error(err))
+		}
+	}
+	return status, finished
+}
+
+func (e *Executor) handleResult(ctx context.Context, reporter string, r *model.Command_ReportStageResult) (model.StageStatus, bool) {
+	if r.Message != "" {
+		if err := e.MetadataStore.Stage(e.Stage.Id).PutMulti(ctx, map[string]string{
+			reportedByKey:      reporter,
+			reportedMessageKey: r.Message,
+		}); err != nil {
+			e.LogPersister.Errorf("Unable to save the reported result to deployment, %v", err)
+		}
+	}
+
+	switch r.Result {
+	case model.Command_ReportStageResult_SUCCESS:
+		e.LogPersister.Successf("Got a success result from %q: %s", reporter, r.Message)
+		return model.StageStatus_STAGE_SUCCESS, true
+	case model.Command_ReportStageResult_FAILURE:
+		e.LogPersister.Errorf("Got a failure result from %q: %s", reporter, r.Message)
+		return model.StageStatus_STAGE_FAILURE, true
+	default:
+		e.LogPersister.Infof("Got a pending result from %q: %s", reporter, r.Message)
+		return model.StageStatus_STAGE_RUNNING, false
+	}
+}
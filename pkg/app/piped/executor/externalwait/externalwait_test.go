@@ -0,0 +1,146 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externalwait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/metadatastore"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeLogPersister struct{}
+
+func (l *fakeLogPersister) Write(_ []byte) (int, error)         { return 0, nil }
+func (l *fakeLogPersister) Info(_ string)                       {}
+func (l *fakeLogPersister) Infof(_ string, _ ...interface{})    {}
+func (l *fakeLogPersister) Success(_ string)                    {}
+func (l *fakeLogPersister) Successf(_ string, _ ...interface{}) {}
+func (l *fakeLogPersister) Error(_ string)                      {}
+func (l *fakeLogPersister) Errorf(_ string, _ ...interface{})   {}
+
+type fakeAPIClient struct{}
+
+func (c *fakeAPIClient) SaveDeploymentMetadata(_ context.Context, _ *pipedservice.SaveDeploymentMetadataRequest, _ ...grpc.CallOption) (*pipedservice.SaveDeploymentMetadataResponse, error) {
+	return &pipedservice.SaveDeploymentMetadataResponse{}, nil
+}
+
+func (c *fakeAPIClient) SaveStageMetadata(_ context.Context, _ *pipedservice.SaveStageMetadataRequest, _ ...grpc.CallOption) (*pipedservice.SaveStageMetadataResponse, error) {
+	return &pipedservice.SaveStageMetadataResponse{}, nil
+}
+
+type fakeCommandLister struct {
+	commands []model.ReportableCommand
+	reported int
+}
+
+func (l *fakeCommandLister) ListCommands() []model.ReportableCommand {
+	return l.commands
+}
+
+func (l *fakeCommandLister) add(result model.Command_ReportStageResult_Result) {
+	l.commands = append(l.commands, model.ReportableCommand{
+		Command: &model.Command{
+			Commander: "api-key-1",
+			ReportStageResult: &model.Command_ReportStageResult{
+				DeploymentId: "deployment-1",
+				StageId:      "stage-1",
+				Result:       result,
+				Message:      result.String(),
+			},
+		},
+		Report: func(_ context.Context, _ model.CommandStatus, _ map[string]string, _ []byte) error {
+			l.reported++
+			return nil
+		},
+	})
+}
+
+func TestCheckResult(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name         string
+		results      []model.Command_ReportStageResult_Result
+		wantStatus   model.StageStatus
+		wantFinished bool
+	}{
+		{
+			name: "no result reported",
+		},
+		{
+			name:       "only pending results",
+			results:    []model.Command_ReportStageResult_Result{model.Command_ReportStageResult_PENDING, model.Command_ReportStageResult_PENDING},
+			wantStatus: model.StageStatus_STAGE_RUNNING,
+		},
+		{
+			name:         "success after pending",
+			results:      []model.Command_ReportStageResult_Result{model.Command_ReportStageResult_PENDING, model.Command_ReportStageResult_SUCCESS},
+			wantStatus:   model.StageStatus_STAGE_SUCCESS,
+			wantFinished: true,
+		},
+		{
+			name:         "failure",
+			results:      []model.Command_ReportStageResult_Result{model.Command_ReportStageResult_FAILURE},
+			wantStatus:   model.StageStatus_STAGE_FAILURE,
+			wantFinished: true,
+		},
+		{
+			name:         "the first final result wins",
+			results:      []model.Command_ReportStageResult_Result{model.Command_ReportStageResult_FAILURE, model.Command_ReportStageResult_SUCCESS},
+			wantStatus:   model.StageStatus_STAGE_FAILURE,
+			wantFinished: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			lister := &fakeCommandLister{}
+			for _, r := range tc.results {
+				lister.add(r)
+			}
+			e := &Executor{
+				Input: executor.Input{
+					Stage: &model.PipelineStage{
+						Id: "stage-1",
+					},
+					CommandLister: lister,
+					LogPersister:  &fakeLogPersister{},
+					MetadataStore: metadatastore.NewMetadataStore(&fakeAPIClient{}, &model.Deployment{
+						Stages: []*model.PipelineStage{
+							{
+								Id:       "stage-1",
+								Metadata: map[string]string{},
+							},
+						},
+					}),
+					Logger: zap.NewNop(),
+				},
+			}
+			status, finished := e.checkResult(context.Background())
+			assert.Equal(t, tc.wantStatus, status)
+			assert.Equal(t, tc.wantFinished, finished)
+			assert.Equal(t, len(tc.results), lister.reported)
+		})
+	}
+}
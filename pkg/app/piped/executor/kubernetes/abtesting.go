@@ -0,0 +1,173 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	istiov1beta1 "istio.io/api/networking/v1beta1"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const abTestingRouteName = "pipecd-ab-testing"
+
+// generateABTestingVirtualServiceManifest generates a VirtualService manifest
+// where each editable route is preceded by a route sending the requests matching the A/B testing rules to CANARY variant.
+// All other requests of the editable routes are split by the given percentages,
+// which means they are all sent to PRIMARY variant when both percentages are zero.
+func (e *deployExecutor) generateABTestingVirtualServiceManifest(m provider.Manifest, host string, editableRoutes []string, ab *config.ABTestingRouting, canaryPercent, baselinePercent int32) (provider.Manifest, error) {
+	if strings.HasSuffix(m.Key.APIVersion, "/v1alpha3") {
+		return m, fmt.Errorf("A/B testing routing is not supported for %s, use networking.istio.io/v1beta1 instead", m.Key.APIVersion)
+	}
+
+	// Split the traffic of the editable routes by the percentages first.
+	m, err := e.generateVirtualServiceManifest(m, host, editableRoutes, canaryPercent, baselinePercent)
+	if err != nil {
+		return m, err
+	}
+
+	spec, err := m.GetSpec()
+	if err != nil {
+		return m, err
+	}
+
+	vs := istiov1beta1.VirtualService{}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return m, err
+	}
+	if err := json.Unmarshal(data, &vs); err != nil {
+		return m, err
+	}
+
+	editableMap := make(map[string]struct{}, len(editableRoutes))
+	for _, r := range editableRoutes {
+		editableMap[r] = struct{}{}
+	}
+
+	routes := make([]*istiov1beta1.HTTPRoute, 0, len(vs.Http)*2)
+	for _, http := range vs.Http {
+		if len(editableMap) > 0 {
+			if _, ok := editableMap[http.Name]; !ok {
+				routes = append(routes, http)
+				continue
+			}
+		}
+
+		abRoute := http.DeepCopy()
+		abRoute.Name = abTestingRouteName
+		if http.Name != "" {
+			abRoute.Name = http.Name + "-" + abTestingRouteName
+		}
+		abRoute.Match = makeABTestingMatches(http.Match, ab.Rules)
+
+		// The weights of all variants are given to CANARY variant.
+		var (
+			variantsWeight  int32
+			otherHostRoutes = make([]*istiov1beta1.HTTPRouteDestination, 0, len(abRoute.Route))
+		)
+		for _, r := range abRoute.Route {
+			if r.Destination != nil && r.Destination.Host == host {
+				variantsWeight += r.Weight
+				continue
+			}
+			otherHostRoutes = append(otherHostRoutes, r)
+		}
+		abRoute.Route = append([]*istiov1beta1.HTTPRouteDestination{
+			{
+				Destination: &istiov1beta1.Destination{
+					Host:   host,
+					Subset: e.appCfg.VariantLabel.CanaryValue,
+				},
+				Weight: variantsWeight,
+			},
+		}, otherHostRoutes...)
+		routes = append(routes, abRoute, http)
+	}
+	vs.Http = routes
+
+	if err := m.SetStructuredSpec(vs); err != nil {
+		return m, err
+	}
+
+	return m, nil
+}
+
+// makeABTestingMatches returns the match conditions satisfied when both one of the original ones
+// and one of the A/B testing rules are matched.
+func makeABTestingMatches(base []*istiov1beta1.HTTPMatchRequest, rules []config.ABTestingRule) []*istiov1beta1.HTTPMatchRequest {
+	if len(base) == 0 {
+		base = []*istiov1beta1.HTTPMatchRequest{{}}
+	}
+
+	out := make([]*istiov1beta1.HTTPMatchRequest, 0, len(base)*len(rules))
+	for _, b := range base {
+		for _, r := range rules {
+			m := b.DeepCopy()
+			if len(r.SourceLabels) > 0 {
+				if m.SourceLabels == nil {
+					m.SourceLabels = make(map[string]string, len(r.SourceLabels))
+				}
+				for k, v := range r.SourceLabels {
+					m.SourceLabels[k] = v
+				}
+				out = append(out, m)
+				continue
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]*istiov1beta1.StringMatch, 1)
+			}
+			name, match := makeABTestingHeaderMatch(r)
+			m.Headers[name] = match
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+func makeABTestingHeaderMatch(r config.ABTestingRule) (string, *istiov1beta1.StringMatch) {
+	if r.Cookie != "" {
+		// Cookies are matched against the Cookie header like "a=1; b=2".
+		value := regexp.QuoteMeta(r.Value)
+		if r.MatchType == config.ABTestingMatchTypePrefix {
+			value += "[^;]*"
+		}
+		regex := fmt.Sprintf(`^(.*?;\s*)?%s=%s(;.*)?$`, regexp.QuoteMeta(r.Cookie), value)
+		return "cookie", &istiov1beta1.StringMatch{
+			MatchType: &istiov1beta1.StringMatch_Regex{Regex: regex},
+		}
+	}
+
+	name := strings.ToLower(r.Header)
+	switch r.MatchType {
+	case config.ABTestingMatchTypePrefix:
+		return name, &istiov1beta1.StringMatch{
+			MatchType: &istiov1beta1.StringMatch_Prefix{Prefix: r.Value},
+		}
+	case config.ABTestingMatchTypeRegex:
+		return name, &istiov1beta1.StringMatch{
+			MatchType: &istiov1beta1.StringMatch_Regex{Regex: r.Value},
+		}
+	default:
+		return name, &istiov1beta1.StringMatch{
+			MatchType: &istiov1beta1.StringMatch_Exact{Exact: r.Value},
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/diff"
+)
+
+// adoptedResource represents a live resource taken over from another tool by applying the manifest.
+type adoptedResource struct {
+	live  provider.Manifest
+	owner provider.PreviousOwner
+	// Whether the resource has not been applied by PipeCD for the application yet.
+	first bool
+}
+
+// adoptResources prepares the given manifests to take over their live resources managed by another tool.
+// The manifests are annotated so that the previous tool keeps the resources, and are not replaced
+// to avoid recreating the resources. The changes to the resources adopted for the first time are
+// written into the stage log. This must be called after adding the builtin annotations.
+func (e *deployExecutor) adoptResources(ctx context.Context, manifests []provider.Manifest) error {
+	e.LogPersister.Info("Start finding the live resources managed by other tools to adopt")
+	adopted, err := findAdoptedResources(ctx, e.applierGetter, manifests, e.Deployment.ApplicationId)
+	if err != nil {
+		return err
+	}
+
+	var (
+		lives    = make([]provider.Manifest, 0, len(adopted))
+		desireds = make([]provider.Manifest, 0, len(adopted))
+	)
+	for i := range manifests {
+		r, ok := adopted[manifests[i].Key]
+		if !ok {
+			continue
+		}
+		manifests[i].AddAnnotations(r.owner.KeepAnnotations(manifests[i]))
+		if manifests[i].GetAnnotations()[provider.LabelForceSyncReplace] == provider.UseReplaceEnabled {
+			e.LogPersister.Infof("- %s will be applied instead of forcefully replaced to keep the adopted resource", manifests[i].Key.ReadableString())
+			manifests[i].AddAnnotations(map[string]string{provider.LabelForceSyncReplace: "disabled"})
+		}
+		if !r.first {
+			continue
+		}
+		e.LogPersister.Infof("- will adopt: %s (managed by %s)", manifests[i].Key.ReadableString(), r.owner)
+		lives = append(lives, r.live)
+		desireds = append(desireds, manifests[i])
+	}
+	if len(lives) == 0 {
+		e.LogPersister.Info("There are no live resources to be adopted for the first time")
+		return nil
+	}
+
+	result, err := provider.DiffList(
+		lives,
+		desireds,
+		e.Logger,
+		diff.WithEquateEmpty(),
+		diff.WithIgnoreAddingMapKeys(),
+		diff.WithCompareNumberAndNumericString(),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to compare the adopted resources with the manifests (%w)", err)
+	}
+	if result.NoChange() {
+		e.LogPersister.Infof("%d live resources will be adopted without changes", len(lives))
+		return nil
+	}
+	e.LogPersister.Infof("%d live resources will be adopted with the following changes:\n%s", len(lives), result.Render(provider.DiffRenderOptions{
+		MaskSecret: true,
+	}))
+	return nil
+}
+
+// findAdoptedResources returns the live resources of the given manifests which are managed by another tool.
+// The resources applied by PipeCD for another application are never adopted.
+func findAdoptedResources(ctx context.Context, ag applierGetter, manifests []provider.Manifest, appID string) (map[provider.ResourceKey]adoptedResource, error) {
+	adopted := make(map[provider.ResourceKey]adoptedResource)
+	for _, m := range manifests {
+		applier, err := ag.Get(m.Key)
+		if err != nil {
+			return nil, err
+		}
+		live, err := applier.GetManifest(ctx, m.Key)
+		if errors.Is(err, provider.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to get live resource %s (%w)", m.Key.ReadableString(), err)
+		}
+
+		owner, ok := provider.FindPreviousOwner(live)
+		if !ok {
+			continue
+		}
+		annotations := live.GetAnnotations()
+		managed := annotations[provider.LabelManagedBy] == provider.ManagedByPiped
+		if managed && annotations[provider.LabelApplication] != appID {
+			return nil, fmt.Errorf("live resource %s is managed by another application %s", m.Key.ReadableString(), annotations[provider.LabelApplication])
+		}
+		adopted[m.Key] = adoptedResource{
+			live:  live,
+			owner: owner,
+			first: !managed,
+		}
+	}
+	return adopted, nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes/kubernetestest"
+)
+
+func TestFindAdoptedResources(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := provider.ParseManifests(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: helm
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: adopted
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: plain
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: new
+`)
+	require.NoError(t, err)
+	lives, err := provider.ParseManifests(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: helm
+  annotations:
+    meta.helm.sh/release-name: simple
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: adopted
+  annotations:
+    meta.helm.sh/release-name: simple
+    pipecd.dev/managed-by: piped
+    pipecd.dev/application: app-id
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: plain
+`)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	applier := kubernetestest.NewMockApplier(ctrl)
+	for _, l := range lives {
+		applier.EXPECT().GetManifest(gomock.Any(), l.Key).Return(l, nil)
+	}
+	applier.EXPECT().GetManifest(gomock.Any(), manifests[3].Key).Return(provider.Manifest{}, provider.ErrNotFound)
+
+	got, err := findAdoptedResources(context.Background(), &applierGroup{defaultApplier: applier}, manifests, "app-id")
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.True(t, got[manifests[0].Key].first)
+	assert.Equal(t, "Helm release simple", got[manifests[0].Key].owner.String())
+	assert.False(t, got[manifests[1].Key].first)
+}
+
+func TestFindAdoptedResourcesOfAnotherApplication(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := provider.ParseManifests(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: simple
+  annotations:
+    meta.helm.sh/release-name: simple
+    pipecd.dev/managed-by: piped
+    pipecd.dev/application: another-app-id
+`)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	applier := kubernetestest.NewMockApplier(ctrl)
+	applier.EXPECT().GetManifest(gomock.Any(), manifests[0].Key).Return(manifests[0], nil)
+
+	_, err = findAdoptedResources(context.Background(), &applierGroup{defaultApplier: applier}, manifests, "app-id")
+	assert.Error(t, err)
+}
@@ -16,6 +16,7 @@ package kubernetes
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -102,7 +103,7 @@ func (e *deployExecutor) ensureCanaryRollout(ctx context.Context) model.StageSta
 
 	// Start rolling out the resources for CANARY variant.
 	e.LogPersister.Info("Start rolling out CANARY variant...")
-	if err := applyManifests(ctx, e.applierGetter, canaryManifests, e.appCfg.Input.Namespace, e.LogPersister); err != nil {
+	if err := applyManifests(ctx, e.applierGetter, canaryManifests, e.appCfg.Input.Namespace, e.appCfg.Input.CRDReadyTimeout.Duration(), e.LogPersister); err != nil {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
@@ -117,6 +118,12 @@ func (e *deployExecutor) ensureCanaryClean(ctx context.Context) model.StageStatu
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if options := e.StageConfig.K8sCanaryCleanStageOptions; options != nil && options.Force {
+		e.LogPersister.Info("Skipped checking the traffic routed to CANARY variant because force option was set")
+	} else if !e.checkCanaryNotServing(ctx) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
 	resources := strings.Split(value, ",")
 	if err := removeCanaryResources(ctx, e.applierGetter, resources, e.LogPersister); err != nil {
 		e.LogPersister.Errorf("Unable to remove canary resources: %v", err)
@@ -125,6 +132,66 @@ func (e *deployExecutor) ensureCanaryClean(ctx context.Context) model.StageStatu
 	return model.StageStatus_STAGE_SUCCESS
 }
 
+// checkCanaryNotServing checks the live traffic routing resource of the application
+// and returns false when CANARY variant is still receiving traffic or the check could not be done.
+func (e *deployExecutor) checkCanaryNotServing(ctx context.Context) bool {
+	manifests, err := loadManifests(
+		ctx,
+		e.Deployment.ApplicationId,
+		e.commit,
+		e.AppManifestsCache,
+		e.loader,
+		e.Logger,
+	)
+	if err != nil {
+		e.LogPersister.Errorf("Failed while loading manifests (%v)", err)
+		return false
+	}
+
+	trafficRoutingManifests, err := findTrafficRoutingManifests(manifests, e.appCfg.Service.Name, e.appCfg.TrafficRouting)
+	if err != nil {
+		e.LogPersister.Errorf("Failed while finding traffic routing manifest: (%v)", err)
+		return false
+	}
+	if len(trafficRoutingManifests) == 0 {
+		e.LogPersister.Info("No traffic routing resource was found, so CANARY variant is considered as not receiving traffic")
+		return true
+	}
+
+	key := trafficRoutingManifests[0].Key
+	// In case of routing by NGINX Ingress, the traffic is routed to CANARY variant by the generated canary Ingress.
+	if config.DetermineKubernetesTrafficRoutingMethod(e.appCfg.TrafficRouting) == config.KubernetesTrafficRoutingMethodNginx {
+		key.Name = makeSuffixedName(key.Name, e.appCfg.VariantLabel.CanaryValue)
+	}
+	applier, err := e.applierGetter.Get(key)
+	if err != nil {
+		e.LogPersister.Error(err.Error())
+		return false
+	}
+	live, err := applier.GetManifest(ctx, key)
+	if errors.Is(err, provider.ErrNotFound) {
+		e.LogPersister.Infof("Traffic routing resource %s does not exist, so CANARY variant is considered as not receiving traffic", key.ReadableString())
+		return true
+	}
+	if err != nil {
+		e.LogPersister.Errorf("Unable to get the live traffic routing resource %s (%v)", key.ReadableString(), err)
+		return false
+	}
+
+	weight, err := e.canaryTrafficWeight(live)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to determine the traffic routed to CANARY variant by %s (%v)", key.ReadableString(), err)
+		return false
+	}
+	if weight > 0 {
+		e.LogPersister.Errorf("CANARY variant is still receiving traffic routed by %s (weight: %d). Route all traffic back to PRIMARY variant before cleaning CANARY variant, or set force option to clean it anyway", key.ReadableString(), weight)
+		return false
+	}
+
+	e.LogPersister.Infof("Confirmed that CANARY variant is not receiving traffic routed by %s", key.ReadableString())
+	return true
+}
+
 func (e *deployExecutor) generateCanaryManifests(manifests []provider.Manifest, opts config.K8sCanaryRolloutStageOptions, variantLabel, variant string) ([]provider.Manifest, error) {
 	suffix := variant
 	if opts.Suffix != "" {
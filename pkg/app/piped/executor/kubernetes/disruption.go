@@ -0,0 +1,256 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	defaultPodDisruptionBudgetTimeout = 10 * time.Minute
+	podDisruptionBudgetCheckInterval  = 10 * time.Second
+)
+
+// defaultMaxUnavailable is the default maxUnavailable of the rolling update strategy of Deployment.
+var defaultMaxUnavailable = intstr.FromString("25%")
+
+// overrideRollingUpdate overrides the maxSurge and maxUnavailable of the rolling update strategy
+// of the given Deployments with the ones specified in the stage options.
+// The overridden fields are recorded in an annotation so that the drift detector
+// does not report them as drifted from the values defined in Git.
+func overrideRollingUpdate(workloads []provider.Manifest, opts config.K8sPrimaryRolloutStageOptions) error {
+	if opts.MaxSurge == nil && opts.MaxUnavailable == nil {
+		return nil
+	}
+	overridden := make([]string, 0, 2)
+	if opts.MaxSurge != nil {
+		overridden = append(overridden, "maxSurge")
+	}
+	if opts.MaxUnavailable != nil {
+		overridden = append(overridden, "maxUnavailable")
+	}
+	for _, m := range workloads {
+		if m.Key.Kind != provider.KindDeployment {
+			continue
+		}
+		d := &appsv1.Deployment{}
+		if err := m.ConvertToStructuredObject(d); err != nil {
+			return err
+		}
+		if d.Spec.Strategy.Type == appsv1.RecreateDeploymentStrategyType {
+			return fmt.Errorf("maxSurge and maxUnavailable can not be applied to %s because it is using %s strategy", m.Key.ReadableString(), appsv1.RecreateDeploymentStrategyType)
+		}
+		if opts.MaxSurge != nil {
+			if err := m.SetNestedField(intOrPercentValue(*opts.MaxSurge), "spec", "strategy", "rollingUpdate", "maxSurge"); err != nil {
+				return err
+			}
+		}
+		if opts.MaxUnavailable != nil {
+			if err := m.SetNestedField(intOrPercentValue(*opts.MaxUnavailable), "spec", "strategy", "rollingUpdate", "maxUnavailable"); err != nil {
+				return err
+			}
+		}
+		m.AddAnnotations(map[string]string{
+			provider.AnnotationRolloutOverride: strings.Join(overridden, ","),
+		})
+	}
+	return nil
+}
+
+func intOrPercentValue(r config.Replicas) interface{} {
+	if r.IsPercentage {
+		return r.String()
+	}
+	return int64(r.Number)
+}
+
+// waitForPodDisruptionBudgets blocks until all PodDisruptionBudgets selecting the given workloads
+// allow as many disruptions as the pods that could become unavailable while rolling out them.
+// It returns false when they are still violated after the timeout or the context is done.
+func (e *deployExecutor) waitForPodDisruptionBudgets(ctx context.Context, manifests, workloads []provider.Manifest, opts config.K8sPodDisruptionBudgetCheck) bool {
+	keys := podDisruptionBudgetKeys(manifests, opts.Names, e.appCfg.Input.Namespace)
+	if len(keys) == 0 {
+		e.LogPersister.Info("There are no PodDisruptionBudgets to check")
+		return true
+	}
+
+	timeout := opts.Timeout.Duration()
+	if timeout <= 0 {
+		timeout = defaultPodDisruptionBudgetTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	e.LogPersister.Infof("Checking %d PodDisruptionBudgets before rolling out PRIMARY variant (timeout: %v)", len(keys), timeout)
+	ticker := time.NewTicker(podDisruptionBudgetCheckInterval)
+	defer ticker.Stop()
+
+	var reported string
+	for {
+		violations, err := checkPodDisruptionBudgets(ctx, e.applierGetter, keys, workloads)
+		if err != nil {
+			e.LogPersister.Errorf("Failed while checking PodDisruptionBudgets (%v)", err)
+			return false
+		}
+		if len(violations) == 0 {
+			e.LogPersister.Success("All PodDisruptionBudgets allow rolling out PRIMARY variant")
+			return true
+		}
+
+		// Only report when the situation was changed to avoid flooding the stage log.
+		if r := strings.Join(violations, "\n"); r != reported {
+			reported = r
+			e.LogPersister.Infof("Pausing the rollout because it would violate %d PodDisruptionBudgets:", len(violations))
+			for _, v := range violations {
+				e.LogPersister.Infof("- %s", v)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			e.LogPersister.Errorf("PodDisruptionBudgets were still violated after %v, consider lowering maxUnavailable or scaling out the workloads:", timeout)
+			for _, v := range violations {
+				e.LogPersister.Errorf("- %s", v)
+			}
+			return false
+		}
+	}
+}
+
+// podDisruptionBudgetKeys returns the keys of the PodDisruptionBudgets defined in the given manifests
+// and the ones with the given names in the given namespace.
+func podDisruptionBudgetKeys(manifests []provider.Manifest, names []string, namespace string) []provider.ResourceKey {
+	var (
+		keys  = make([]provider.ResourceKey, 0, len(names))
+		added = make(map[string]struct{}, len(names))
+	)
+	for _, m := range findManifests(provider.KindPodDisruptionBudget, "", manifests) {
+		keys = append(keys, m.Key)
+		added[m.Key.Name] = struct{}{}
+	}
+	for _, name := range names {
+		if _, ok := added[name]; ok {
+			continue
+		}
+		keys = append(keys, provider.ResourceKey{
+			APIVersion: "policy/v1",
+			Kind:       provider.KindPodDisruptionBudget,
+			Namespace:  namespace,
+			Name:       name,
+		})
+		added[name] = struct{}{}
+	}
+	return keys
+}
+
+// checkPodDisruptionBudgets returns the descriptions of the live PodDisruptionBudgets
+// that would be violated by rolling out the given workloads.
+// The PodDisruptionBudgets that do not exist yet are ignored.
+func checkPodDisruptionBudgets(ctx context.Context, ag applierGetter, keys []provider.ResourceKey, workloads []provider.Manifest) ([]string, error) {
+	violations := make([]string, 0)
+	for _, k := range keys {
+		applier, err := ag.Get(k)
+		if err != nil {
+			return nil, err
+		}
+		live, err := applier.GetManifest(ctx, k)
+		if errors.Is(err, provider.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to get PodDisruptionBudget %s (%w)", k.ReadableString(), err)
+		}
+
+		pdb := &policyv1.PodDisruptionBudget{}
+		if err := live.ConvertToStructuredObject(pdb); err != nil {
+			return nil, err
+		}
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector of PodDisruptionBudget %s (%w)", k.ReadableString(), err)
+		}
+
+		for _, w := range workloads {
+			podLabels, err := w.GetNestedStringMap("spec", "template", "metadata", "labels")
+			if err != nil {
+				return nil, err
+			}
+			if selector.Empty() || !selector.Matches(labels.Set(podLabels)) {
+				continue
+			}
+			unavailable, err := maxUnavailablePods(w)
+			if err != nil {
+				return nil, err
+			}
+			if pdb.Status.DisruptionsAllowed >= int32(unavailable) {
+				continue
+			}
+			violations = append(violations, fmt.Sprintf(
+				"PodDisruptionBudget %s allows %d disruptions (%d/%d pods healthy) but rolling out %s may make %d pods unavailable",
+				k.Name,
+				pdb.Status.DisruptionsAllowed,
+				pdb.Status.CurrentHealthy,
+				pdb.Status.DesiredHealthy,
+				w.Key.ReadableString(),
+				unavailable,
+			))
+		}
+	}
+	sort.Strings(violations)
+	return violations, nil
+}
+
+// maxUnavailablePods returns the number of pods of the given workload
+// that may become unavailable at once while rolling it out.
+func maxUnavailablePods(m provider.Manifest) (int, error) {
+	// Other workloads such as StatefulSet replace their pods one by one by default.
+	if m.Key.Kind != provider.KindDeployment {
+		return 1, nil
+	}
+
+	d := &appsv1.Deployment{}
+	if err := m.ConvertToStructuredObject(d); err != nil {
+		return 0, err
+	}
+	replicas := 1
+	if d.Spec.Replicas != nil {
+		replicas = int(*d.Spec.Replicas)
+	}
+	if d.Spec.Strategy.Type == appsv1.RecreateDeploymentStrategyType {
+		return replicas, nil
+	}
+
+	maxUnavailable := &defaultMaxUnavailable
+	if ru := d.Spec.Strategy.RollingUpdate; ru != nil && ru.MaxUnavailable != nil {
+		maxUnavailable = ru.MaxUnavailable
+	}
+	// Same as Kubernetes, the percentage is rounded down.
+	return intstr.GetScaledValueFromIntOrPercent(maxUnavailable, replicas, false)
+}
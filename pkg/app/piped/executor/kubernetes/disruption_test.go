@@ -0,0 +1,258 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes/kubernetestest"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const disruptionTestDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  replicas: 4
+  selector:
+    matchLabels:
+      app: simple
+  template:
+    metadata:
+      labels:
+        app: simple
+    spec:
+      containers:
+      - name: helloworld
+        image: gcr.io/pipecd/helloworld:v0.1.0
+`
+
+func TestOverrideRollingUpdate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name               string
+		opts               config.K8sPrimaryRolloutStageOptions
+		wantMaxSurge       interface{}
+		wantMaxUnavailable interface{}
+		wantAnnotation     string
+	}{
+		{
+			name: "no override",
+		},
+		{
+			name: "override both",
+			opts: config.K8sPrimaryRolloutStageOptions{
+				MaxSurge:       &config.Replicas{Number: 50, IsPercentage: true},
+				MaxUnavailable: &config.Replicas{Number: 0},
+			},
+			wantMaxSurge:       "50%",
+			wantMaxUnavailable: int64(0),
+			wantAnnotation:     "maxSurge,maxUnavailable",
+		},
+		{
+			name: "override maxUnavailable only",
+			opts: config.K8sPrimaryRolloutStageOptions{
+				MaxUnavailable: &config.Replicas{Number: 1},
+			},
+			wantMaxUnavailable: int64(1),
+			wantAnnotation:     "maxUnavailable",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := provider.ParseManifests(disruptionTestDeployment)
+			require.NoError(t, err)
+
+			err = overrideRollingUpdate(manifests, tc.opts)
+			require.NoError(t, err)
+
+			ru, err := manifests[0].GetNestedMap("spec", "strategy", "rollingUpdate")
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantMaxSurge, ru["maxSurge"])
+			assert.Equal(t, tc.wantMaxUnavailable, ru["maxUnavailable"])
+			assert.Equal(t, tc.wantAnnotation, manifests[0].GetAnnotations()[provider.AnnotationRolloutOverride])
+		})
+	}
+}
+
+func TestOverrideRollingUpdate_Recreate(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := provider.ParseManifests(disruptionTestDeployment + `
+  strategy:
+    type: Recreate
+`)
+	require.NoError(t, err)
+
+	err = overrideRollingUpdate(manifests, config.K8sPrimaryRolloutStageOptions{
+		MaxSurge: &config.Replicas{Number: 1},
+	})
+	assert.Error(t, err)
+}
+
+func TestMaxUnavailablePods(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		strategy string
+		want     int
+	}{
+		{
+			name: "default 25%",
+			want: 1,
+		},
+		{
+			name: "percentage is rounded down",
+			strategy: `
+  strategy:
+    rollingUpdate:
+      maxUnavailable: 60%
+`,
+			want: 2,
+		},
+		{
+			name: "absolute number",
+			strategy: `
+  strategy:
+    rollingUpdate:
+      maxUnavailable: 3
+`,
+			want: 3,
+		},
+		{
+			name: "recreate",
+			strategy: `
+  strategy:
+    type: Recreate
+`,
+			want: 4,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := provider.ParseManifests(disruptionTestDeployment + tc.strategy)
+			require.NoError(t, err)
+
+			got, err := maxUnavailablePods(manifests[0])
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCheckPodDisruptionBudgets(t *testing.T) {
+	t.Parallel()
+
+	const pdb = `
+apiVersion: policy/v1
+kind: PodDisruptionBudget
+metadata:
+  name: simple
+spec:
+  minAvailable: 3
+  selector:
+    matchLabels:
+      app: %s
+status:
+  currentHealthy: 4
+  desiredHealthy: 3
+  disruptionsAllowed: %d
+`
+
+	testcases := []struct {
+		name           string
+		selectorApp    string
+		allowed        int
+		maxUnavailable *config.Replicas
+		notFound       bool
+		wantViolations int
+	}{
+		{
+			name:           "enough disruptions are allowed",
+			selectorApp:    "simple",
+			allowed:        1,
+			wantViolations: 0,
+		},
+		{
+			name:           "would be violated",
+			selectorApp:    "simple",
+			allowed:        1,
+			maxUnavailable: &config.Replicas{Number: 2},
+			wantViolations: 1,
+		},
+		{
+			name:           "not selecting the workload",
+			selectorApp:    "other",
+			allowed:        0,
+			wantViolations: 0,
+		},
+		{
+			name:           "not exist yet",
+			notFound:       true,
+			wantViolations: 0,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			workloads, err := provider.ParseManifests(disruptionTestDeployment)
+			require.NoError(t, err)
+			if tc.maxUnavailable != nil {
+				err = overrideRollingUpdate(workloads, config.K8sPrimaryRolloutStageOptions{
+					MaxUnavailable: tc.maxUnavailable,
+				})
+				require.NoError(t, err)
+			}
+
+			key := provider.ResourceKey{
+				APIVersion: "policy/v1",
+				Kind:       provider.KindPodDisruptionBudget,
+				Name:       "simple",
+			}
+			applier := kubernetestest.NewMockApplier(ctrl)
+			if tc.notFound {
+				applier.EXPECT().GetManifest(gomock.Any(), key).Return(provider.Manifest{}, provider.ErrNotFound)
+			} else {
+				live, err := provider.ParseManifests(fmt.Sprintf(pdb, tc.selectorApp, tc.allowed))
+				require.NoError(t, err)
+				applier.EXPECT().GetManifest(gomock.Any(), key).Return(live[0], nil)
+			}
+
+			violations, err := checkPodDisruptionBudgets(context.Background(), &applierGroup{defaultApplier: applier}, []provider.ResourceKey{key}, workloads)
+			require.NoError(t, err)
+			assert.Len(t, violations, tc.wantViolations)
+		})
+	}
+}
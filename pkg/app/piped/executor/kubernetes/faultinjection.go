@@ -0,0 +1,296 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/analysis"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func (e *deployExecutor) ensureFaultInjection(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
+	options := e.StageConfig.K8sFaultInjectionStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if e.appCfg.MultiCluster != nil {
+		e.LogPersister.Errorf("Stage %s is not supported for multi-cluster applications", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	manifests, err := loadManifests(
+		ctx,
+		e.Deployment.ApplicationId,
+		e.commit,
+		e.AppManifestsCache,
+		e.loader,
+		e.Logger,
+	)
+	if err != nil {
+		e.LogPersister.Errorf("Failed while loading manifests (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if options.HTTP != nil {
+		restore, ok := e.injectHTTPFault(ctx, manifests, options.HTTP)
+		if !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		// The injection rules must be removed even when the stage was cancelled.
+		defer restore(context.WithoutCancel(ctx))
+	}
+
+	if options.PodKill != nil && !e.killCanaryPods(ctx, manifests, options.PodKill) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Infof("Running the analysis for %v to verify the recovery of CANARY variant", options.Analysis.Duration.Duration())
+	status := analysis.Analyze(sig, e.Input, options.Analysis)
+	if status != model.StageStatus_STAGE_SUCCESS && status != model.StageStatus_STAGE_SKIPPED {
+		e.LogPersister.Error("CANARY variant did not recover from the injected faults")
+	}
+	return status
+}
+
+// injectHTTPFault adds the given fault into the routes of the live VirtualService sending traffic to CANARY variant.
+// The returned function restores the VirtualService to the state before the injection.
+func (e *deployExecutor) injectHTTPFault(ctx context.Context, manifests []provider.Manifest, fault *config.K8sHTTPFault) (func(context.Context), bool) {
+	if method := config.DetermineKubernetesTrafficRoutingMethod(e.appCfg.TrafficRouting); method != config.KubernetesTrafficRoutingMethodIstio {
+		e.LogPersister.Errorf("HTTP fault is only available for %s traffic routing method", config.KubernetesTrafficRoutingMethodIstio)
+		return nil, false
+	}
+
+	trafficRoutingManifests, err := findTrafficRoutingManifests(manifests, e.appCfg.Service.Name, e.appCfg.TrafficRouting)
+	if err != nil {
+		e.LogPersister.Errorf("Failed while finding traffic routing manifest: (%v)", err)
+		return nil, false
+	}
+	if len(trafficRoutingManifests) != 1 {
+		e.LogPersister.Errorf("Unable to inject HTTP fault because exactly one VirtualService is required but found %d", len(trafficRoutingManifests))
+		return nil, false
+	}
+	m := trafficRoutingManifests[0]
+
+	applier, err := e.applierGetter.Get(m.Key)
+	if err != nil {
+		e.LogPersister.Error(err.Error())
+		return nil, false
+	}
+	live, err := applier.GetManifest(ctx, m.Key)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to get the live VirtualService %s (%v)", m.Key.ReadableString(), err)
+		return nil, false
+	}
+	original, err := live.GetNestedMap("spec")
+	if err != nil {
+		e.LogPersister.Errorf("Unable to read the spec of the live VirtualService %s (%v)", m.Key.ReadableString(), err)
+		return nil, false
+	}
+	faulted, err := live.GetNestedMap("spec")
+	if err != nil {
+		e.LogPersister.Errorf("Unable to read the spec of the live VirtualService %s (%v)", m.Key.ReadableString(), err)
+		return nil, false
+	}
+
+	injected, err := addHTTPFaultToCanaryRoutes(faulted, e.appCfg.VariantLabel.CanaryValue, fault)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to inject HTTP fault into VirtualService %s (%v)", m.Key.ReadableString(), err)
+		return nil, false
+	}
+	if injected == 0 {
+		e.LogPersister.Errorf("No HTTP route of VirtualService %s is sending traffic to CANARY variant. Route traffic to CANARY variant before injecting HTTP fault", m.Key.ReadableString())
+		return nil, false
+	}
+
+	e.LogPersister.Infof("Injecting HTTP fault into %d routes of VirtualService %s", injected, m.Key.ReadableString())
+	if !e.applyVirtualServiceSpec(ctx, m, faulted) {
+		return nil, false
+	}
+	e.LogPersister.Success("Successfully injected HTTP fault")
+
+	restore := func(ctx context.Context) {
+		e.LogPersister.Infof("Removing the injected HTTP fault from VirtualService %s", m.Key.ReadableString())
+		if e.applyVirtualServiceSpec(ctx, m, original) {
+			e.LogPersister.Success("Successfully removed the injected HTTP fault")
+		}
+	}
+	return restore, true
+}
+
+// applyVirtualServiceSpec applies a copy of the given VirtualService manifest whose spec is replaced by the given one.
+func (e *deployExecutor) applyVirtualServiceSpec(ctx context.Context, m provider.Manifest, spec map[string]interface{}) bool {
+	// Because the loaded manifests are read-only
+	// so we duplicate them to avoid updating the shared manifests data in cache.
+	m = duplicateManifest(m, "")
+	if err := m.SetNestedField(spec, "spec"); err != nil {
+		e.LogPersister.Errorf("Unable to generate VirtualService manifest (%v)", err)
+		return false
+	}
+	addBuiltinAnnotations(
+		[]provider.Manifest{m},
+		e.appCfg.VariantLabel.Key,
+		e.appCfg.VariantLabel.PrimaryValue,
+		e.commit,
+		e.PipedConfig.PipedID,
+		e.Deployment.ApplicationId,
+	)
+	err := applyManifests(ctx, e.applierGetter, []provider.Manifest{m}, e.appCfg.Input.Namespace, e.appCfg.Input.CRDReadyTimeout.Duration(), e.LogPersister)
+	return err == nil
+}
+
+// addHTTPFaultToCanaryRoutes sets the given fault to every HTTP route of the given VirtualService spec
+// that has a destination of the given CANARY subset, and returns the number of those routes.
+func addHTTPFaultToCanaryRoutes(spec map[string]interface{}, canarySubset string, fault *config.K8sHTTPFault) (int, error) {
+	routes, ok := spec["http"].([]interface{})
+	if !ok {
+		return 0, nil
+	}
+
+	percentage := map[string]interface{}{
+		"value": float64(fault.Percentage.Int()),
+	}
+	injection := make(map[string]interface{}, 2)
+	if d := fault.Delay.Duration(); d > 0 {
+		injection["delay"] = map[string]interface{}{
+			"percentage": percentage,
+			// Istio accepts the duration in the JSON format of protobuf Duration.
+			"fixedDelay": strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s",
+		}
+	}
+	if fault.AbortHTTPStatus != 0 {
+		injection["abort"] = map[string]interface{}{
+			"percentage": percentage,
+			"httpStatus": int64(fault.AbortHTTPStatus),
+		}
+	}
+
+	var count int
+	for i, r := range routes {
+		route, ok := r.(map[string]interface{})
+		if !ok {
+			return 0, fmt.Errorf("unexpected http route at index %d", i)
+		}
+		if !routesToSubset(route, canarySubset) {
+			continue
+		}
+		route["fault"] = injection
+		count++
+	}
+	return count, nil
+}
+
+// routesToSubset reports whether the given HTTP route has a destination of the given subset with non-zero weight.
+func routesToSubset(route map[string]interface{}, subset string) bool {
+	destinations, _ := route["route"].([]interface{})
+	for _, d := range destinations {
+		destination, ok := d.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		dest, _ := destination["destination"].(map[string]interface{})
+		if s, _ := dest["subset"].(string); s != subset {
+			continue
+		}
+		// The weight can be omitted when there is only one destination.
+		weight, ok := destination["weight"]
+		if !ok || weight != int64(0) && weight != float64(0) {
+			return true
+		}
+	}
+	return false
+}
+
+// killCanaryPods deletes the given percentage of the pods of every CANARY workload.
+func (e *deployExecutor) killCanaryPods(ctx context.Context, manifests []provider.Manifest, fault *config.K8sPodKillFault) bool {
+	workloads := findWorkloadManifests(manifests, e.appCfg.Workloads)
+	if len(workloads) == 0 {
+		e.LogPersister.Error("Unable to find any workload to kill the pods of CANARY variant")
+		return false
+	}
+
+	for _, w := range workloads {
+		selector, err := w.GetNestedStringMap("spec", "selector", "matchLabels")
+		if err != nil {
+			e.LogPersister.Errorf("Unable to read the selector of workload %s (%v)", w.Key.ReadableString(), err)
+			return false
+		}
+		if selector == nil {
+			selector = make(map[string]string, 1)
+		}
+		selector[e.appCfg.VariantLabel.Key] = e.appCfg.VariantLabel.CanaryValue
+
+		applier, err := e.applierGetter.Get(w.Key)
+		if err != nil {
+			e.LogPersister.Error(err.Error())
+			return false
+		}
+		pods, err := applier.ListPods(ctx, w.Key, selector)
+		if err != nil {
+			e.LogPersister.Errorf("Unable to list the CANARY pods of workload %s (%v)", w.Key.ReadableString(), err)
+			return false
+		}
+		targets := selectPodsToKill(pods, fault.Percentage.Int())
+		if len(targets) == 0 {
+			e.LogPersister.Errorf("No running pod of CANARY variant was found for workload %s", w.Key.ReadableString())
+			return false
+		}
+
+		e.LogPersister.Infof("Killing %d of %d CANARY pods of workload %s", len(targets), len(pods), w.Key.ReadableString())
+		for _, p := range targets {
+			key := provider.ResourceKey{
+				APIVersion: "v1",
+				Kind:       provider.KindPod,
+				Namespace:  p.Namespace,
+				Name:       p.Name,
+			}
+			if err := applier.DeletePod(ctx, key); err != nil && !errors.Is(err, provider.ErrNotFound) {
+				e.LogPersister.Errorf("Unable to kill pod %s (%v)", p.Name, err)
+				return false
+			}
+			e.LogPersister.Infof("- killed pod %s", p.Name)
+		}
+	}
+
+	e.LogPersister.Success("Successfully killed the pods of CANARY variant")
+	return true
+}
+
+// selectPodsToKill returns the given percentage of the pods which are not being deleted, rounded up.
+func selectPodsToKill(pods []corev1.Pod, percentage int) []corev1.Pod {
+	alive := make([]corev1.Pod, 0, len(pods))
+	for _, p := range pods {
+		if p.DeletionTimestamp == nil {
+			alive = append(alive, p)
+		}
+	}
+	sort.Slice(alive, func(i, j int) bool {
+		return alive[i].Name < alive[j].Name
+	})
+
+	n := (len(alive)*percentage + 99) / 100
+	return alive[:n]
+}
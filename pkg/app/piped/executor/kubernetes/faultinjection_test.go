@@ -0,0 +1,108 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestAddHTTPFaultToCanaryRoutes(t *testing.T) {
+	t.Parallel()
+
+	route := func(name string, canaryWeight int64) map[string]interface{} {
+		return map[string]interface{}{
+			"name": name,
+			"route": []interface{}{
+				map[string]interface{}{
+					"destination": map[string]interface{}{"host": "helloworld", "subset": "primary"},
+					"weight":      100 - canaryWeight,
+				},
+				map[string]interface{}{
+					"destination": map[string]interface{}{"host": "helloworld", "subset": "canary"},
+					"weight":      canaryWeight,
+				},
+			},
+		}
+	}
+	spec := map[string]interface{}{
+		"http": []interface{}{route("serving", 20), route("idle", 0)},
+	}
+	fault := &config.K8sHTTPFault{
+		Percentage:      config.Percentage{Number: 30},
+		Delay:           config.Duration(1500 * time.Millisecond),
+		AbortHTTPStatus: 503,
+	}
+
+	count, err := addHTTPFaultToCanaryRoutes(spec, "canary", fault)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	routes := spec["http"].([]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"delay": map[string]interface{}{
+			"percentage": map[string]interface{}{"value": float64(30)},
+			"fixedDelay": "1.5s",
+		},
+		"abort": map[string]interface{}{
+			"percentage": map[string]interface{}{"value": float64(30)},
+			"httpStatus": int64(503),
+		},
+	}, routes[0].(map[string]interface{})["fault"])
+	assert.NotContains(t, routes[1].(map[string]interface{}), "fault")
+}
+
+func TestSelectPodsToKill(t *testing.T) {
+	t.Parallel()
+
+	pod := func(name string, deleting bool) corev1.Pod {
+		p := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if deleting {
+			now := metav1.Now()
+			p.DeletionTimestamp = &now
+		}
+		return p
+	}
+	pods := []corev1.Pod{pod("c", false), pod("a", false), pod("b", true), pod("d", false)}
+
+	testcases := []struct {
+		name       string
+		percentage int
+		want       []string
+	}{
+		{name: "rounded up to one pod", percentage: 10, want: []string{"a"}},
+		{name: "rounded up", percentage: 50, want: []string{"a", "c"}},
+		{name: "all pods", percentage: 100, want: []string{"a", "c", "d"}},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := selectPodsToKill(pods, tc.percentage)
+			names := make([]string, 0, len(got))
+			for _, p := range got {
+				names = append(names, p.Name)
+			}
+			assert.Equal(t, tc.want, names)
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	appsv1 "k8s.io/api/apps/v1"
@@ -33,6 +34,8 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/yamlprocessor"
 )
 
+const defaultCRDReadyTimeout = 2 * time.Minute
+
 type deployExecutor struct {
 	executor.Input
 
@@ -46,6 +49,7 @@ type deployExecutor struct {
 type registerer interface {
 	Register(stage model.Stage, f executor.Factory) error
 	RegisterRollback(kind model.RollbackKind, f executor.Factory) error
+	MarkDryRunnable(stage model.Stage)
 }
 
 // Register registers this executor factory into a given registerer.
@@ -63,6 +67,23 @@ func Register(r registerer) {
 	r.Register(model.StageK8sBaselineRollout, f)
 	r.Register(model.StageK8sBaselineClean, f)
 	r.Register(model.StageK8sTrafficRouting, f)
+	r.Register(model.StageK8sMaintenanceOn, f)
+	r.Register(model.StageK8sMaintenanceOff, f)
+	r.Register(model.StageK8sWaitRollout, f)
+	r.Register(model.StageK8sFaultInjection, f)
+
+	// Every stage sends its requests to the API server with server-side dry-run in a dry-run deployment.
+	r.MarkDryRunnable(model.StageK8sSync)
+	r.MarkDryRunnable(model.StageK8sPrimaryRollout)
+	r.MarkDryRunnable(model.StageK8sCanaryRollout)
+	r.MarkDryRunnable(model.StageK8sCanaryClean)
+	r.MarkDryRunnable(model.StageK8sBaselineRollout)
+	r.MarkDryRunnable(model.StageK8sBaselineClean)
+	r.MarkDryRunnable(model.StageK8sTrafficRouting)
+	r.MarkDryRunnable(model.StageK8sMaintenanceOn)
+	r.MarkDryRunnable(model.StageK8sMaintenanceOff)
+	r.MarkDryRunnable(model.StageK8sWaitRollout)
+	r.MarkDryRunnable(model.StageK8sFaultInjection)
 
 	r.RegisterRollback(model.RollbackKind_Rollback_KUBERNETES, func(in executor.Input) executor.Executor {
 		return &rollbackExecutor{
@@ -94,14 +115,13 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 		}
 	}
 
-	if e.appCfg.Input.KubectlVersion != "" {
-		e.LogPersister.Infof("kubectl version %s will be used.", e.appCfg.Input.KubectlVersion)
+	if e.Deployment.Trigger.DryRun {
+		e.appCfg.Input.DryRun = true
+		e.LogPersister.Info("All changes will be sent to the cluster with server-side dry-run since this is a dry-run deployment.")
 	}
 
-	e.applierGetter, err = newApplierGroup(e.Deployment.PlatformProvider, *e.appCfg, e.PipedConfig, e.Logger)
-	if err != nil {
-		e.LogPersister.Error(err.Error())
-		return model.StageStatus_STAGE_FAILURE
+	if e.appCfg.Input.KubectlVersion != "" {
+		e.LogPersister.Infof("kubectl version %s will be used.", e.appCfg.Input.KubectlVersion)
 	}
 
 	e.loader = provider.NewLoader(
@@ -124,6 +144,32 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 		status         model.StageStatus
 	)
 
+	if mc := e.appCfg.MultiCluster; mc != nil {
+		status = e.executeMultiCluster(sig, mc)
+		return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+	}
+
+	e.applierGetter, err = newApplierGroup(e.Deployment.PlatformProvider, *e.appCfg, e.PipedConfig, e.Logger)
+	if err != nil {
+		e.LogPersister.Error(err.Error())
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	status = e.executeStage(sig)
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+// executeStage executes the stage by using the current applier getter.
+func (e *deployExecutor) executeStage(sig executor.StopSignal) (status model.StageStatus) {
+	ctx := sig.Context()
+	switch model.Stage(e.Stage.Name) {
+	case model.StageK8sSync, model.StageK8sPrimaryRollout, model.StageK8sCanaryRollout, model.StageK8sBaselineRollout:
+		if err := ensureManagedNamespace(ctx, e.applierGetter, e.appCfg.Input, e.PipedConfig.PipedID, e.Deployment.ApplicationId, e.LogPersister); err != nil {
+			e.LogPersister.Errorf("Failed to ensure the managed namespace (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
 	switch model.Stage(e.Stage.Name) {
 	case model.StageK8sSync:
 		status = e.ensureSync(ctx)
@@ -144,14 +190,26 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 		status = e.ensureBaselineClean(ctx)
 
 	case model.StageK8sTrafficRouting:
-		status = e.ensureTrafficRouting(ctx)
+		status = e.ensureTrafficRouting(sig)
+
+	case model.StageK8sMaintenanceOn:
+		status = e.ensureMaintenanceOn(ctx)
+
+	case model.StageK8sMaintenanceOff:
+		status = e.ensureMaintenanceOff(ctx)
+
+	case model.StageK8sWaitRollout:
+		status = e.ensureWaitRollout(ctx)
+
+	case model.StageK8sFaultInjection:
+		status = e.ensureFaultInjection(sig)
 
 	default:
 		e.LogPersister.Errorf("Unsupported stage %s for kubernetes application", e.Stage.Name)
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+	return status
 }
 
 func (e *deployExecutor) loadRunningManifests(ctx context.Context) (manifests []provider.Manifest, err error) {
@@ -226,65 +284,116 @@ func addBuiltinAnnotations(manifests []provider.Manifest, variantLabel, variant,
 	}
 }
 
-func applyManifests(ctx context.Context, ag applierGetter, manifests []provider.Manifest, namespace string, lp executor.LogPersister) error {
+func applyManifests(ctx context.Context, ag applierGetter, manifests []provider.Manifest, namespace string, crdReadyTimeout time.Duration, lp executor.LogPersister) error {
 	if namespace == "" {
 		lp.Infof("Start applying %d manifests", len(manifests))
 	} else {
 		lp.Infof("Start applying %d manifests to %q namespace", len(manifests), namespace)
 	}
 
+	// CustomResourceDefinitions must be applied and established before
+	// the custom resources depending on them could be applied.
+	crds := make([]provider.Manifest, 0)
+	others := make([]provider.Manifest, 0, len(manifests))
 	for _, m := range manifests {
-		applier, err := ag.Get(m.Key)
-		if err != nil {
-			lp.Error(err.Error())
-			return err
+		if m.Key.IsCustomResourceDefinition() {
+			crds = append(crds, m)
+			continue
 		}
+		others = append(others, m)
+	}
 
-		// The force annotation has higher priority, so we need to check the annotation in the following order:
-		// 1. force-sync-by-replace
-		// 2. sync-by-replace
-		// 3. others
-		if annotation := m.GetAnnotations()[provider.LabelForceSyncReplace]; annotation == provider.UseReplaceEnabled {
-			// Always try to replace first and create if it fails due to resource not found error.
-			// This is because we cannot know whether resource already exists before executing command.
-			err = applier.ForceReplaceManifest(ctx, m)
-			if errors.Is(err, provider.ErrNotFound) {
-				lp.Infof("Specified resource does not exist, so create the resource: %s (%w)", m.Key.ReadableString(), err)
-				err = applier.CreateManifest(ctx, m)
-			}
-			if err != nil {
-				lp.Errorf("Failed to forcefully replace or create manifest: %s (%w)", m.Key.ReadableString(), err)
+	if len(crds) > 0 {
+		for _, m := range crds {
+			if err := applyManifest(ctx, ag, m, lp); err != nil {
 				return err
 			}
-			lp.Successf("- forcefully replaced or created manifest: %s", m.Key.ReadableString())
-			continue
 		}
+		if err := waitForCRDsReady(ctx, ag, crds, crdReadyTimeout, lp); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range others {
+		if err := applyManifest(ctx, ag, m, lp); err != nil {
+			return err
+		}
+	}
+	lp.Successf("Successfully applied %d manifests", len(manifests))
+	return nil
+}
 
-		if annotation := m.GetAnnotations()[provider.LabelSyncReplace]; annotation == provider.UseReplaceEnabled {
-			// Always try to replace first and create if it fails due to resource not found error.
-			// This is because we cannot know whether resource already exists before executing command.
-			err = applier.ReplaceManifest(ctx, m)
-			if errors.Is(err, provider.ErrNotFound) {
-				lp.Infof("Specified resource does not exist, so create the resource: %s (%w)", m.Key.ReadableString(), err)
-				err = applier.CreateManifest(ctx, m)
-			}
-			if err != nil {
-				lp.Errorf("Failed to replace or create manifest: %s (%w)", m.Key.ReadableString(), err)
-				return err
-			}
-			lp.Successf("- replaced or created manifest: %s", m.Key.ReadableString())
-			continue
+func applyManifest(ctx context.Context, ag applierGetter, m provider.Manifest, lp executor.LogPersister) error {
+	applier, err := ag.Get(m.Key)
+	if err != nil {
+		lp.Error(err.Error())
+		return err
+	}
+
+	// The force annotation has higher priority, so we need to check the annotation in the following order:
+	// 1. force-sync-by-replace
+	// 2. sync-by-replace
+	// 3. others
+	if annotation := m.GetAnnotations()[provider.LabelForceSyncReplace]; annotation == provider.UseReplaceEnabled {
+		// Always try to replace first and create if it fails due to resource not found error.
+		// This is because we cannot know whether resource already exists before executing command.
+		err = applier.ForceReplaceManifest(ctx, m)
+		if errors.Is(err, provider.ErrNotFound) {
+			lp.Infof("Specified resource does not exist, so create the resource: %s (%w)", m.Key.ReadableString(), err)
+			err = applier.CreateManifest(ctx, m)
 		}
+		if err != nil {
+			lp.Errorf("Failed to forcefully replace or create manifest: %s (%w)", m.Key.ReadableString(), err)
+			return err
+		}
+		lp.Successf("- forcefully replaced or created manifest: %s", m.Key.ReadableString())
+		return nil
+	}
 
-		if err := applier.ApplyManifest(ctx, m); err != nil {
-			lp.Errorf("Failed to apply manifest: %s (%v)", m.Key.ReadableString(), err)
+	if annotation := m.GetAnnotations()[provider.LabelSyncReplace]; annotation == provider.UseReplaceEnabled {
+		// Always try to replace first and create if it fails due to resource not found error.
+		// This is because we cannot know whether resource already exists before executing command.
+		err = applier.ReplaceManifest(ctx, m)
+		if errors.Is(err, provider.ErrNotFound) {
+			lp.Infof("Specified resource does not exist, so create the resource: %s (%w)", m.Key.ReadableString(), err)
+			err = applier.CreateManifest(ctx, m)
+		}
+		if err != nil {
+			lp.Errorf("Failed to replace or create manifest: %s (%w)", m.Key.ReadableString(), err)
 			return err
 		}
-		lp.Successf("- applied manifest: %s", m.Key.ReadableString())
-		continue
+		lp.Successf("- replaced or created manifest: %s", m.Key.ReadableString())
+		return nil
+	}
 
+	if err := applier.ApplyManifest(ctx, m); err != nil {
+		lp.Errorf("Failed to apply manifest: %s (%v)", m.Key.ReadableString(), err)
+		return err
+	}
+	lp.Successf("- applied manifest: %s", m.Key.ReadableString())
+	return nil
+}
+
+func waitForCRDsReady(ctx context.Context, ag applierGetter, crds []provider.Manifest, timeout time.Duration, lp executor.LogPersister) error {
+	if timeout <= 0 {
+		timeout = defaultCRDReadyTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	lp.Infof("Waiting for %d CustomResourceDefinitions to be established (timeout: %v)", len(crds), timeout)
+	for _, m := range crds {
+		applier, err := ag.Get(m.Key)
+		if err != nil {
+			lp.Error(err.Error())
+			return err
+		}
+		if err := applier.WaitForCRDReady(ctx, m.Key); err != nil {
+			lp.Errorf("CustomResourceDefinition %s is not ready to serve its custom resources (%v)", m.Key.Name, err)
+			return err
+		}
+		lp.Successf("- CustomResourceDefinition %s is established", m.Key.Name)
 	}
-	lp.Successf("Successfully applied %d manifests", len(manifests))
 	return nil
 }
 
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -577,7 +578,71 @@ spec:
 			manifests, err := provider.ParseManifests(tc.manifest)
 			require.NoError(t, err)
 			ag := &applierGroup{defaultApplier: tc.applier}
-			err = applyManifests(ctx, ag, manifests, tc.namespace, &fakeLogPersister{})
+			err = applyManifests(ctx, ag, manifests, tc.namespace, time.Minute, &fakeLogPersister{})
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestApplyManifestsWithCRDs(t *testing.T) {
+	t.Parallel()
+
+	const manifest = `
+apiVersion: example.com/v1
+kind: Foo
+metadata:
+  name: foo
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+spec:
+  group: example.com
+`
+	testcases := []struct {
+		name    string
+		applier func(ctrl *gomock.Controller) provider.Applier
+		wantErr bool
+	}{
+		{
+			name: "CRDs are applied and established before custom resources",
+			applier: func(ctrl *gomock.Controller) provider.Applier {
+				p := kubernetestest.NewMockApplier(ctrl)
+				gomock.InOrder(
+					p.EXPECT().ApplyManifest(gomock.Any(), gomock.Cond(func(x any) bool {
+						return x.(provider.Manifest).Key.IsCustomResourceDefinition()
+					})).Return(nil),
+					p.EXPECT().WaitForCRDReady(gomock.Any(), gomock.Any()).Return(nil),
+					p.EXPECT().ApplyManifest(gomock.Any(), gomock.Cond(func(x any) bool {
+						return x.(provider.Manifest).Key.Kind == "Foo"
+					})).Return(nil),
+				)
+				return p
+			},
+			wantErr: false,
+		},
+		{
+			name: "custom resources are not applied when CRDs are not established",
+			applier: func(ctrl *gomock.Controller) provider.Applier {
+				p := kubernetestest.NewMockApplier(ctrl)
+				gomock.InOrder(
+					p.EXPECT().ApplyManifest(gomock.Any(), gomock.Any()).Return(nil),
+					p.EXPECT().WaitForCRDReady(gomock.Any(), gomock.Any()).Return(context.DeadlineExceeded),
+				)
+				return p
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			manifests, err := provider.ParseManifests(manifest)
+			require.NoError(t, err)
+			ag := &applierGroup{defaultApplier: tc.applier(ctrl)}
+			err = applyManifests(context.Background(), ag, manifests, "", time.Minute, &fakeLogPersister{})
 			assert.Equal(t, tc.wantErr, err != nil)
 		})
 	}
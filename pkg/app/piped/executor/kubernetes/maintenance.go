@@ -0,0 +1,151 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// maintenanceSelectorMetadataKey is the shared metadata key of the selector
+// of the maintenance pods while the maintenance mode is turned on.
+const maintenanceSelectorMetadataKey = "maintenance-selector"
+
+func (e *deployExecutor) ensureMaintenanceOn(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.K8sMaintenanceOnStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if method := config.DetermineKubernetesTrafficRoutingMethod(e.appCfg.TrafficRouting); method != config.KubernetesTrafficRoutingMethodPodSelector {
+		e.LogPersister.Errorf("Maintenance mode is only available for %s traffic routing method", config.KubernetesTrafficRoutingMethodPodSelector)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	selector, err := json.Marshal(options.Selector)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to marshal the maintenance selector (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	// The selector is kept in the shared metadata so that the following stages
+	// applying the Service manifest do not end the maintenance mode.
+	if err := e.MetadataStore.Shared().Put(ctx, maintenanceSelectorMetadataKey, string(selector)); err != nil {
+		e.LogPersister.Errorf("Unable to store the maintenance selector to metadata store (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Infof("Start routing all traffic to the maintenance pods selected by %s", string(selector))
+	if !e.applyServiceManifest(ctx) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Success("Successfully turned on maintenance mode")
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureMaintenanceOff(ctx context.Context) model.StageStatus {
+	if value, ok := e.MetadataStore.Shared().Get(maintenanceSelectorMetadataKey); !ok || value == "" {
+		e.LogPersister.Info("Maintenance mode was not turned on in this deployment")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	if err := e.MetadataStore.Shared().Put(ctx, maintenanceSelectorMetadataKey, ""); err != nil {
+		e.LogPersister.Errorf("Unable to clear the maintenance selector in metadata store (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Info("Start routing traffic back to the application pods")
+	if !e.applyServiceManifest(ctx) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Success("Successfully turned off maintenance mode")
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// applyServiceManifest applies the Service manifest of the application at the triggered commit
+// with the selector of the maintenance pods while the maintenance mode is turned on.
+func (e *deployExecutor) applyServiceManifest(ctx context.Context) bool {
+	e.LogPersister.Infof("Loading manifests at commit %s for handling", e.commit)
+	manifests, err := loadManifests(
+		ctx,
+		e.Deployment.ApplicationId,
+		e.commit,
+		e.AppManifestsCache,
+		e.loader,
+		e.Logger,
+	)
+	if err != nil {
+		e.LogPersister.Errorf("Failed while loading manifests (%v)", err)
+		return false
+	}
+	e.LogPersister.Successf("Successfully loaded %d manifests", len(manifests))
+
+	services := findManifests(provider.KindService, e.appCfg.Service.Name, manifests)
+	if len(services) == 0 {
+		e.LogPersister.Errorf("Unable to find any service for name=%q", e.appCfg.Service.Name)
+		return false
+	}
+
+	// Because the loaded manifests are read-only
+	// we duplicate them to avoid updating the shared manifests data in cache.
+	service := duplicateManifest(services[0], "")
+	if err := e.keepMaintenanceSelector([]provider.Manifest{service}); err != nil {
+		e.LogPersister.Errorf("Unable to set the maintenance selector into service %s (%v)", service.Key.ReadableString(), err)
+		return false
+	}
+
+	// Add builtin annotations for tracking application live state.
+	addBuiltinAnnotations(
+		[]provider.Manifest{service},
+		e.appCfg.VariantLabel.Key,
+		e.appCfg.VariantLabel.PrimaryValue,
+		e.commit,
+		e.PipedConfig.PipedID,
+		e.Deployment.ApplicationId,
+	)
+
+	return applyManifests(ctx, e.applierGetter, []provider.Manifest{service}, e.appCfg.Input.Namespace, e.appCfg.Input.CRDReadyTimeout.Duration(), e.LogPersister) == nil
+}
+
+// keepMaintenanceSelector replaces the selector of the application Service found in the given manifests
+// with the selector of the maintenance pods while the maintenance mode is turned on.
+// The given manifests must be the duplicated ones.
+func (e *deployExecutor) keepMaintenanceSelector(manifests []provider.Manifest) error {
+	if !e.appCfg.HasStage(model.StageK8sMaintenanceOn) {
+		return nil
+	}
+
+	value, ok := e.MetadataStore.Shared().Get(maintenanceSelectorMetadataKey)
+	if !ok || value == "" {
+		return nil
+	}
+
+	var selector map[string]string
+	if err := json.Unmarshal([]byte(value), &selector); err != nil {
+		return fmt.Errorf("malformed maintenance selector %q: %w", value, err)
+	}
+
+	services := findManifests(provider.KindService, e.appCfg.Service.Name, manifests)
+	if len(services) == 0 {
+		return nil
+	}
+	return services[0].SetNestedStringMap(selector, "spec", "selector")
+}
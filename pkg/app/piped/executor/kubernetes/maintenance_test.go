@@ -0,0 +1,132 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/metadatastore"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type mapMetadataStore map[string]string
+
+func (m mapMetadataStore) Shared() metadatastore.Store              { return m }
+func (m mapMetadataStore) Stage(_ string) metadatastore.Store       { return m }
+func (m mapMetadataStore) Get(key string) (string, bool)            { v, ok := m[key]; return v, ok }
+func (m mapMetadataStore) Put(_ context.Context, k, v string) error { m[k] = v; return nil }
+func (m mapMetadataStore) PutMulti(_ context.Context, md map[string]string) error {
+	for k, v := range md {
+		m[k] = v
+	}
+	return nil
+}
+
+func TestKeepMaintenanceSelector(t *testing.T) {
+	t.Parallel()
+
+	const manifest = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: helloworld
+spec:
+  selector:
+    app: helloworld
+    pipecd.dev/variant: primary
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: other
+spec:
+  selector:
+    app: other
+`
+	testcases := []struct {
+		name     string
+		metadata mapMetadataStore
+		service  string
+		expected map[string]string
+	}{
+		{
+			name:     "maintenance mode is not turned on",
+			metadata: mapMetadataStore{},
+			service:  "helloworld",
+			expected: map[string]string{"app": "helloworld", "pipecd.dev/variant": "primary"},
+		},
+		{
+			name:     "maintenance mode was turned off",
+			metadata: mapMetadataStore{maintenanceSelectorMetadataKey: ""},
+			service:  "helloworld",
+			expected: map[string]string{"app": "helloworld", "pipecd.dev/variant": "primary"},
+		},
+		{
+			name:     "maintenance mode is turned on",
+			metadata: mapMetadataStore{maintenanceSelectorMetadataKey: `{"app":"maintenance"}`},
+			service:  "helloworld",
+			expected: map[string]string{"app": "maintenance"},
+		},
+		{
+			name:     "the first service is used when no name is configured",
+			metadata: mapMetadataStore{maintenanceSelectorMetadataKey: `{"app":"maintenance"}`},
+			expected: map[string]string{"app": "maintenance"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := provider.ParseManifests(manifest)
+			require.NoError(t, err)
+			manifests = duplicateManifests(manifests, "")
+
+			e := &deployExecutor{
+				Input: executor.Input{
+					MetadataStore: tc.metadata,
+				},
+				appCfg: &config.KubernetesApplicationSpec{
+					GenericApplicationSpec: config.GenericApplicationSpec{
+						Pipeline: &config.DeploymentPipeline{
+							Stages: []config.PipelineStage{
+								{Name: model.StageK8sMaintenanceOn},
+								{Name: model.StageK8sSync},
+								{Name: model.StageK8sMaintenanceOff},
+							},
+						},
+					},
+					Service: config.K8sResourceReference{Name: tc.service},
+				},
+			}
+			require.NoError(t, e.keepMaintenanceSelector(manifests))
+
+			selector, err := manifests[0].GetNestedStringMap("spec", "selector")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, selector)
+
+			// Other services are never changed.
+			selector, err = manifests[1].GetNestedStringMap("spec", "selector")
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"app": "other"}, selector)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/metadatastore"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// multiClusterTargetsMetadataKey is the key of the shared metadata storing
+// the comma-separated list of clusters touched by the deployment.
+const multiClusterTargetsMetadataKey = "k8s-multi-cluster-targets"
+
+// executeMultiCluster executes the current stage against all target clusters.
+// The clusters are handled wave by wave in ascending order, the ones in the same wave in parallel.
+// The remaining waves are not started once the stage failed in any cluster.
+func (e *deployExecutor) executeMultiCluster(sig executor.StopSignal, mc *config.KubernetesMultiCluster) model.StageStatus {
+	ctx := sig.Context()
+	for _, wave := range mc.Waves() {
+		if err := e.recordTouchedClusters(ctx, wave); err != nil {
+			e.LogPersister.Errorf("Failed to save the touched clusters to the metadata store (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+
+		statuses := make([]model.StageStatus, len(wave))
+		var wg sync.WaitGroup
+		for i := range wave {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				statuses[i] = e.executeCluster(sig, wave[i])
+			}(i)
+		}
+		wg.Wait()
+
+		for i, status := range statuses {
+			if status != model.StageStatus_STAGE_SUCCESS {
+				e.LogPersister.Errorf("Stage %s was not completed in cluster %s, the remaining clusters will not be handled", e.Stage.Name, wave[i].Provider)
+				return status
+			}
+		}
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// executeCluster executes the current stage against the given cluster only.
+func (e *deployExecutor) executeCluster(sig executor.StopSignal, target config.KubernetesClusterTarget) model.StageStatus {
+	ce := *e
+	ce.LogPersister = newClusterLogPersister(e.LogPersister, target.Provider)
+
+	ag, err := newApplierGroup(target.Provider, *e.appCfg, e.PipedConfig, e.Logger)
+	if err != nil {
+		ce.LogPersister.Error(err.Error())
+		return model.StageStatus_STAGE_FAILURE
+	}
+	ce.applierGetter = ag
+
+	ce.LogPersister.Infof("Start executing stage %s", e.Stage.Name)
+	return ce.executeStage(sig)
+}
+
+// recordTouchedClusters adds the given clusters to the list of clusters
+// that must be reverted while rolling back the deployment.
+func (e *deployExecutor) recordTouchedClusters(ctx context.Context, targets []config.KubernetesClusterTarget) error {
+	touched := loadTouchedClusters(e.MetadataStore.Shared())
+	for _, t := range targets {
+		touched[t.Provider] = struct{}{}
+	}
+
+	providers := make([]string, 0, len(touched))
+	for p := range touched {
+		providers = append(providers, p)
+	}
+	sort.Strings(providers)
+	return e.MetadataStore.Shared().Put(ctx, multiClusterTargetsMetadataKey, strings.Join(providers, ","))
+}
+
+func loadTouchedClusters(md metadatastore.Getter) map[string]struct{} {
+	touched := make(map[string]struct{})
+	value, ok := md.Get(multiClusterTargetsMetadataKey)
+	if !ok || value == "" {
+		return touched
+	}
+	for _, p := range strings.Split(value, ",") {
+		touched[p] = struct{}{}
+	}
+	return touched
+}
+
+// clusterLogPersister prefixes all logs with the name of the cluster
+// so that the logs of the clusters handled in parallel can be told apart.
+type clusterLogPersister struct {
+	executor.LogPersister
+	prefix string
+}
+
+func newClusterLogPersister(lp executor.LogPersister, provider string) clusterLogPersister {
+	return clusterLogPersister{
+		LogPersister: lp,
+		prefix:       fmt.Sprintf("[%s] ", provider),
+	}
+}
+
+func (lp clusterLogPersister) Write(log []byte) (int, error) {
+	if _, err := lp.LogPersister.Write(append([]byte(lp.prefix), log...)); err != nil {
+		return 0, err
+	}
+	return len(log), nil
+}
+
+func (lp clusterLogPersister) Info(log string) {
+	lp.LogPersister.Info(lp.prefix + log)
+}
+
+func (lp clusterLogPersister) Infof(format string, a ...interface{}) {
+	lp.LogPersister.Info(lp.prefix + fmt.Sprintf(format, a...))
+}
+
+func (lp clusterLogPersister) Success(log string) {
+	lp.LogPersister.Success(lp.prefix + log)
+}
+
+func (lp clusterLogPersister) Successf(format string, a ...interface{}) {
+	lp.LogPersister.Success(lp.prefix + fmt.Sprintf(format, a...))
+}
+
+func (lp clusterLogPersister) Error(log string) {
+	lp.LogPersister.Error(lp.prefix + log)
+}
+
+func (lp clusterLogPersister) Errorf(format string, a ...interface{}) {
+	lp.LogPersister.Error(lp.prefix + fmt.Sprintf(format, a...))
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestRecordTouchedClusters(t *testing.T) {
+	t.Parallel()
+
+	md := mapMetadataStore{}
+	e := &deployExecutor{
+		Input: executor.Input{
+			MetadataStore: md,
+		},
+	}
+	ctx := context.Background()
+
+	assert.Empty(t, loadTouchedClusters(md))
+
+	err := e.recordTouchedClusters(ctx, []config.KubernetesClusterTarget{{Provider: "cluster-2"}})
+	require.NoError(t, err)
+	err = e.recordTouchedClusters(ctx, []config.KubernetesClusterTarget{{Provider: "cluster-3"}, {Provider: "cluster-1"}, {Provider: "cluster-2"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, "cluster-1,cluster-2,cluster-3", md[multiClusterTargetsMetadataKey])
+	assert.Equal(t, map[string]struct{}{
+		"cluster-1": {},
+		"cluster-2": {},
+		"cluster-3": {},
+	}, loadTouchedClusters(md))
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// ensureManagedNamespace creates or updates the namespace configured by input.managedNamespace
+// so that it exists with the desired labels and annotations before applying manifests into it.
+func ensureManagedNamespace(ctx context.Context, ag applierGetter, input config.KubernetesDeploymentInput, pipedID, appID string, lp executor.LogPersister) error {
+	if input.ManagedNamespace == nil {
+		return nil
+	}
+	lp.Infof("Ensuring namespace %q with the configured labels and annotations", input.Namespace)
+	m := makeManagedNamespaceManifest(input.Namespace, *input.ManagedNamespace, pipedID, appID)
+	return applyManifest(ctx, ag, m, lp)
+}
+
+// makeManagedNamespaceManifest builds the Namespace manifest managed by the given application.
+// The builtin application annotations are not added intentionally
+// to keep the namespace out of the live state of the application,
+// otherwise it would be pruned while syncing because it is not defined in Git.
+func makeManagedNamespaceManifest(name string, cfg config.KubernetesManagedNamespace, pipedID, appID string) provider.Manifest {
+	labels := make(map[string]string, len(cfg.Labels)+3)
+	for k, v := range cfg.Labels {
+		labels[k] = v
+	}
+	labels[provider.LabelManagedBy] = provider.ManagedByPiped
+	labels[provider.LabelNamespaceOwner] = appID
+	if cfg.DeleteOnAppDeletion {
+		labels[provider.LabelDeleteOnAppDeletion] = provider.DeleteOnAppDeletionTrue
+	}
+
+	annotations := make(map[string]string, len(cfg.Annotations)+1)
+	for k, v := range cfg.Annotations {
+		annotations[k] = v
+	}
+	annotations[provider.LabelPiped] = pipedID
+
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("v1")
+	u.SetKind("Namespace")
+	u.SetName(name)
+	u.SetLabels(labels)
+	u.SetAnnotations(annotations)
+
+	return provider.MakeManifest(provider.MakeResourceKey(u), u)
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestMakeManagedNamespaceManifest(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name            string
+		cfg             config.KubernetesManagedNamespace
+		wantLabels      map[string]string
+		wantAnnotations map[string]string
+	}{
+		{
+			name: "keep on application deletion",
+			cfg: config.KubernetesManagedNamespace{
+				Labels:      map[string]string{"istio-injection": "enabled"},
+				Annotations: map[string]string{"owner": "team-a"},
+			},
+			wantLabels: map[string]string{
+				"istio-injection":            "enabled",
+				provider.LabelManagedBy:      provider.ManagedByPiped,
+				provider.LabelNamespaceOwner: "app-id",
+			},
+			wantAnnotations: map[string]string{
+				"owner":             "team-a",
+				provider.LabelPiped: "piped-id",
+			},
+		},
+		{
+			name: "delete on application deletion",
+			cfg: config.KubernetesManagedNamespace{
+				Labels:              map[string]string{"pod-security.kubernetes.io/enforce": "restricted"},
+				DeleteOnAppDeletion: true,
+			},
+			wantLabels: map[string]string{
+				"pod-security.kubernetes.io/enforce": "restricted",
+				provider.LabelManagedBy:              provider.ManagedByPiped,
+				provider.LabelNamespaceOwner:         "app-id",
+				provider.LabelDeleteOnAppDeletion:    provider.DeleteOnAppDeletionTrue,
+			},
+			wantAnnotations: map[string]string{
+				provider.LabelPiped: "piped-id",
+			},
+		},
+		{
+			name: "builtin labels can not be overridden",
+			cfg: config.KubernetesManagedNamespace{
+				Labels: map[string]string{provider.LabelNamespaceOwner: "other-app-id"},
+			},
+			wantLabels: map[string]string{
+				provider.LabelManagedBy:      provider.ManagedByPiped,
+				provider.LabelNamespaceOwner: "app-id",
+			},
+			wantAnnotations: map[string]string{
+				provider.LabelPiped: "piped-id",
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			m := makeManagedNamespaceManifest("demo", tc.cfg, "piped-id", "app-id")
+			assert.Equal(t, "Namespace", m.Key.Kind)
+			assert.Equal(t, "demo", m.Key.Name)
+			labels, err := m.GetNestedStringMap("metadata", "labels")
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantLabels, labels)
+			assert.Equal(t, tc.wantAnnotations, m.GetAnnotations())
+		})
+	}
+}
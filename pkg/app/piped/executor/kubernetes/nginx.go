@@ -0,0 +1,216 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	nginxCanaryAnnotation              = "nginx.ingress.kubernetes.io/canary"
+	nginxCanaryWeightAnnotation        = "nginx.ingress.kubernetes.io/canary-weight"
+	nginxCanaryByHeaderAnnotation      = "nginx.ingress.kubernetes.io/canary-by-header"
+	nginxCanaryByHeaderValueAnnotation = "nginx.ingress.kubernetes.io/canary-by-header-value"
+	nginxCanaryByCookieAnnotation      = "nginx.ingress.kubernetes.io/canary-by-cookie"
+)
+
+// ensureNginxTrafficRouting routes traffic to CANARY variant by applying a canary Ingress
+// generated from the given PRIMARY one, or removes it when all traffic goes to PRIMARY variant.
+func (e *deployExecutor) ensureNginxTrafficRouting(ctx context.Context, manifests []provider.Manifest, ingress provider.Manifest, primaryPercent, canaryPercent, baselinePercent int) model.StageStatus {
+	var (
+		variantLabel   = e.appCfg.VariantLabel.Key
+		primaryVariant = e.appCfg.VariantLabel.PrimaryValue
+		canaryVariant  = e.appCfg.VariantLabel.CanaryValue
+	)
+	if baselinePercent > 0 {
+		e.LogPersister.Errorf("Traffic routing by %s supports only PRIMARY and CANARY variants (baseline=%d)", config.KubernetesTrafficRoutingMethodNginx, baselinePercent)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// The PRIMARY Service must not send traffic to CANARY pods.
+	services := findManifests(provider.KindService, e.appCfg.Service.Name, manifests)
+	for _, s := range services {
+		if err := checkVariantSelectorInService(s, variantLabel, primaryVariant); err != nil {
+			e.LogPersister.Errorf("Traffic routing by %s requires %q inside the selector of Service manifest but it was unable to check that field in manifest %s (%v)",
+				config.KubernetesTrafficRoutingMethodNginx,
+				variantLabel+": "+primaryVariant,
+				s.Key.ReadableString(),
+				err,
+			)
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
+	cfg := e.appCfg.TrafficRouting.Nginx
+	if cfg == nil {
+		cfg = &config.NginxTrafficRouting{}
+	}
+	canaryIngress, err := generateNginxCanaryIngressManifest(ingress, services, *cfg, canaryVariant, canaryPercent)
+	if err != nil {
+		e.LogPersister.Errorf("Unable generate canary Ingress manifest: (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if primaryPercent == 100 {
+		e.LogPersister.Info("Start removing the canary Ingress to route all traffic to PRIMARY variant")
+		if err := deleteResources(ctx, e.applierGetter, []provider.ResourceKey{canaryIngress.Key}, e.LogPersister); err != nil {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		e.LogPersister.Success("Successfully updated traffic routing")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	// Store the canary Ingress as a CANARY resource to remove it
+	// while cleaning CANARY variant or rolling back.
+	if err := e.addCanaryResource(ctx, canaryIngress.Key); err != nil {
+		e.LogPersister.Errorf("Unable to save deployment metadata (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	addBuiltinAnnotations(
+		[]provider.Manifest{canaryIngress},
+		variantLabel,
+		canaryVariant,
+		e.commit,
+		e.PipedConfig.PipedID,
+		e.Deployment.ApplicationId,
+	)
+
+	e.LogPersister.Infof("Start updating traffic routing to be percentages: primary=%d, canary=%d", primaryPercent, canaryPercent)
+	if err := applyManifests(ctx, e.applierGetter, []provider.Manifest{canaryIngress}, e.appCfg.Input.Namespace, e.appCfg.Input.CRDReadyTimeout.Duration(), e.LogPersister); err != nil {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Success("Successfully updated traffic routing")
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// addCanaryResource adds the given resource to the CANARY resources stored in the metadata.
+func (e *deployExecutor) addCanaryResource(ctx context.Context, key provider.ResourceKey) error {
+	var resources []string
+	if value, ok := e.MetadataStore.Shared().Get(addedCanaryResourcesMetadataKey); ok && value != "" {
+		resources = strings.Split(value, ",")
+	}
+	for _, r := range resources {
+		if r == key.String() {
+			return nil
+		}
+	}
+	resources = append(resources, key.String())
+	return e.MetadataStore.Shared().Put(ctx, addedCanaryResourcesMetadataKey, strings.Join(resources, ","))
+}
+
+func findNginxIngressManifests(manifests []provider.Manifest, ref config.K8sResourceReference) ([]provider.Manifest, error) {
+	if ref.Kind != "" && ref.Kind != provider.KindIngress {
+		return nil, fmt.Errorf("support only %q kind for nginx Ingress reference", provider.KindIngress)
+	}
+
+	out := make([]provider.Manifest, 0, len(manifests))
+	for _, m := range manifests {
+		if m.Key.Kind != provider.KindIngress {
+			continue
+		}
+		if ref.Name != "" && m.Key.Name != ref.Name {
+			continue
+		}
+		out = append(out, m)
+	}
+	return out, nil
+}
+
+// generateNginxCanaryIngressManifest generates the canary Ingress routing the given percentage
+// of the traffic for the PRIMARY Ingress to the Services of CANARY variant.
+func generateNginxCanaryIngressManifest(ingress provider.Manifest, services []provider.Manifest, cfg config.NginxTrafficRouting, canaryVariant string, canaryPercent int) (provider.Manifest, error) {
+	// Because the loaded manifests are read-only
+	// so we duplicate them to avoid updating the shared manifests data in cache.
+	if ingress.Key.APIVersion != "networking.k8s.io/v1" {
+		return ingress, fmt.Errorf("support only networking.k8s.io/v1 Ingress but got %s", ingress.Key.APIVersion)
+	}
+	m := duplicateManifest(ingress, canaryVariant)
+
+	ing := &networkingv1.Ingress{}
+	if err := m.ConvertToStructuredObject(ing); err != nil {
+		return m, err
+	}
+
+	serviceNames := make(map[string]struct{}, len(services))
+	for _, s := range services {
+		serviceNames[s.Key.Name] = struct{}{}
+	}
+	updateBackend := func(b *networkingv1.IngressBackend) {
+		if b == nil || b.Service == nil {
+			return
+		}
+		if _, ok := serviceNames[b.Service.Name]; ok {
+			b.Service.Name = makeSuffixedName(b.Service.Name, canaryVariant)
+		}
+	}
+	updateBackend(ing.Spec.DefaultBackend)
+	for _, r := range ing.Spec.Rules {
+		if r.HTTP == nil {
+			continue
+		}
+		for i := range r.HTTP.Paths {
+			updateBackend(&r.HTTP.Paths[i].Backend)
+		}
+	}
+
+	if ing.Annotations == nil {
+		ing.Annotations = make(map[string]string)
+	}
+	ing.Annotations[nginxCanaryAnnotation] = "true"
+	ing.Annotations[nginxCanaryWeightAnnotation] = strconv.Itoa(canaryPercent)
+	if cfg.CanaryByHeader != "" {
+		ing.Annotations[nginxCanaryByHeaderAnnotation] = cfg.CanaryByHeader
+	}
+	if cfg.CanaryByHeaderValue != "" {
+		ing.Annotations[nginxCanaryByHeaderValueAnnotation] = cfg.CanaryByHeaderValue
+	}
+	if cfg.CanaryByCookie != "" {
+		ing.Annotations[nginxCanaryByCookieAnnotation] = cfg.CanaryByCookie
+	}
+
+	return provider.ParseFromStructuredObject(ing)
+}
+
+// nginxCanaryWeight returns the weight of the traffic routed to CANARY variant by the given canary Ingress.
+// The header and cookie based routing is considered as routing all matching requests.
+func nginxCanaryWeight(m provider.Manifest) (int32, error) {
+	annotations := m.GetAnnotations()
+	if annotations[nginxCanaryAnnotation] != "true" {
+		return 0, nil
+	}
+	if annotations[nginxCanaryByHeaderAnnotation] != "" || annotations[nginxCanaryByCookieAnnotation] != "" {
+		return 100, nil
+	}
+	value := annotations[nginxCanaryWeightAnnotation]
+	if value == "" {
+		return 0, nil
+	}
+	weight, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s annotation %q: %w", nginxCanaryWeightAnnotation, value, err)
+	}
+	return int32(weight), nil
+}
@@ -0,0 +1,207 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const nginxTestManifests = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: helloworld
+spec:
+  selector:
+    app: helloworld
+    pipecd.dev/variant: primary
+  ports:
+  - port: 9085
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: helloworld
+  annotations:
+    kubernetes.io/ingress.class: nginx
+spec:
+  rules:
+  - host: helloworld.example.com
+    http:
+      paths:
+      - path: /
+        pathType: Prefix
+        backend:
+          service:
+            name: helloworld
+            port:
+              number: 9085
+      - path: /static
+        pathType: Prefix
+        backend:
+          service:
+            name: static
+            port:
+              number: 80
+`
+
+func TestGenerateNginxCanaryIngressManifest(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := provider.ParseManifests(nginxTestManifests)
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+
+	ingresses, err := findNginxIngressManifests(manifests, config.K8sResourceReference{})
+	require.NoError(t, err)
+	require.Len(t, ingresses, 1)
+
+	cfg := config.NginxTrafficRouting{
+		CanaryByHeader:      "X-Canary",
+		CanaryByHeaderValue: "enabled",
+	}
+	got, err := generateNginxCanaryIngressManifest(ingresses[0], manifests[:1], cfg, "canary", 20)
+	require.NoError(t, err)
+
+	assert.Equal(t, "helloworld-canary", got.Key.Name)
+	assert.Equal(t, map[string]string{
+		"kubernetes.io/ingress.class":                        "nginx",
+		"nginx.ingress.kubernetes.io/canary":                 "true",
+		"nginx.ingress.kubernetes.io/canary-weight":          "20",
+		"nginx.ingress.kubernetes.io/canary-by-header":       "X-Canary",
+		"nginx.ingress.kubernetes.io/canary-by-header-value": "enabled",
+	}, got.GetAnnotations())
+
+	ing := &networkingv1.Ingress{}
+	require.NoError(t, got.ConvertToStructuredObject(ing))
+	paths := ing.Spec.Rules[0].HTTP.Paths
+	assert.Equal(t, "helloworld-canary", paths[0].Backend.Service.Name)
+	assert.Equal(t, "static", paths[1].Backend.Service.Name)
+
+	// The original manifest must not be changed.
+	assert.Equal(t, "helloworld", ingresses[0].Key.Name)
+	assert.NotContains(t, ingresses[0].GetAnnotations(), nginxCanaryAnnotation)
+
+	weight, err := nginxCanaryWeight(got)
+	require.NoError(t, err)
+	assert.Equal(t, int32(100), weight)
+}
+
+func TestFindNginxIngressManifests(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := provider.ParseManifests(nginxTestManifests)
+	require.NoError(t, err)
+
+	got, err := findNginxIngressManifests(manifests, config.K8sResourceReference{Name: "helloworld"})
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+
+	got, err = findNginxIngressManifests(manifests, config.K8sResourceReference{Name: "other"})
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	_, err = findNginxIngressManifests(manifests, config.K8sResourceReference{Kind: "Service"})
+	assert.Error(t, err)
+}
+
+func TestNginxCanaryWeight(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		annotations string
+		expected    int32
+		wantErr     bool
+	}{
+		{
+			name:        "not a canary ingress",
+			annotations: `kubernetes.io/ingress.class: nginx`,
+			expected:    0,
+		},
+		{
+			name: "weighted",
+			annotations: `nginx.ingress.kubernetes.io/canary: "true"
+    nginx.ingress.kubernetes.io/canary-weight: "30"`,
+			expected: 30,
+		},
+		{
+			name: "by cookie",
+			annotations: `nginx.ingress.kubernetes.io/canary: "true"
+    nginx.ingress.kubernetes.io/canary-weight: "0"
+    nginx.ingress.kubernetes.io/canary-by-cookie: canary`,
+			expected: 100,
+		},
+		{
+			name: "invalid weight",
+			annotations: `nginx.ingress.kubernetes.io/canary: "true"
+    nginx.ingress.kubernetes.io/canary-weight: "abc"`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			manifests, err := provider.ParseManifests(`
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: helloworld-canary
+  annotations:
+    ` + tc.annotations + `
+spec: {}
+`)
+			require.NoError(t, err)
+			require.Len(t, manifests, 1)
+
+			weight, err := nginxCanaryWeight(manifests[0])
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.expected, weight)
+		})
+	}
+}
+
+func TestAddCanaryResource(t *testing.T) {
+	t.Parallel()
+
+	md := mapMetadataStore{
+		addedCanaryResourcesMetadataKey: "apps/v1:Deployment:default:helloworld-canary",
+	}
+	e := &deployExecutor{
+		Input: executor.Input{
+			MetadataStore: md,
+		},
+	}
+	key := provider.ResourceKey{
+		APIVersion: "networking.k8s.io/v1",
+		Kind:       provider.KindIngress,
+		Namespace:  "default",
+		Name:       "helloworld-canary",
+	}
+
+	require.NoError(t, e.addCanaryResource(context.Background(), key))
+	require.NoError(t, e.addCanaryResource(context.Background(), key))
+	assert.Equal(t, "apps/v1:Deployment:default:helloworld-canary,"+key.String(), md[addedCanaryResourcesMetadataKey])
+}
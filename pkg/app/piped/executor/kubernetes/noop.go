@@ -0,0 +1,141 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// manifestsUnchanged reports whether the given manifests rendered at the target commit
+// are identical to the ones rendered at the running commit, and all of them are still live
+// as applied at the running commit.
+// The live resources are checked since the deployments failed or cancelled after the last successful one
+// may have applied some manifests partially.
+// Any error while rendering the running ones is treated as a change.
+// The deployments whose sync strategy was forced always apply the manifests, e.g. to fix a drift.
+func (e *deployExecutor) manifestsUnchanged(ctx context.Context, manifests []provider.Manifest) bool {
+	if e.Deployment.RunningCommitHash == "" || e.Deployment.Trigger.SyncStrategy != model.SyncStrategy_AUTO {
+		return false
+	}
+
+	running, err := e.loadRunningManifestsWithRunningConfig(ctx)
+	if err != nil {
+		e.LogPersister.Infof("Unable to render the manifests at the running commit to compare (%v)", err)
+		return false
+	}
+
+	digest, err := manifestsDigest(manifests)
+	if err != nil {
+		e.LogPersister.Infof("Unable to compute the digest of the manifests (%v)", err)
+		return false
+	}
+	runningDigest, err := manifestsDigest(running)
+	if err != nil {
+		e.LogPersister.Infof("Unable to compute the digest of the manifests at the running commit (%v)", err)
+		return false
+	}
+	e.LogPersister.Infof("Digest of the rendered manifests: %s (running commit: %s)", digest, runningDigest)
+	if digest != runningDigest {
+		return false
+	}
+
+	live, ok := e.AppLiveResourceLister.ListKubernetesResources()
+	if !ok {
+		e.LogPersister.Info("Unable to compare with the live resources since there is no data about them")
+		return false
+	}
+	if reason := checkLiveResourcesAtCommit(manifests, live, e.Deployment.ApplicationId, e.Deployment.RunningCommitHash); reason != "" {
+		e.LogPersister.Infof("The live resources are not the ones applied at the running commit: %s", reason)
+		return false
+	}
+	return true
+}
+
+// checkLiveResourcesAtCommit returns the reason why the given manifests are not live as applied at the given commit,
+// or an empty string when all of them are live and were applied at the commit for the given application.
+func checkLiveResourcesAtCommit(manifests, live []provider.Manifest, appID, commit string) string {
+	commits := make(map[provider.ResourceKey]string, len(live))
+	for _, m := range filterManagedResources(live, appID) {
+		key := m.Key
+		key.Namespace = ""
+		commits[key] = m.GetAnnotations()[provider.LabelCommitHash]
+	}
+	for _, m := range manifests {
+		key := m.Key
+		key.Namespace = ""
+		c, ok := commits[key]
+		if !ok {
+			return fmt.Sprintf("%s does not exist", m.Key.ReadableString())
+		}
+		if c != commit {
+			return fmt.Sprintf("%s was applied at commit %s", m.Key.ReadableString(), c)
+		}
+	}
+	return ""
+}
+
+// loadRunningManifestsWithRunningConfig renders the manifests at the running commit
+// with the application configuration at that commit, so that the changes made
+// only in the configuration, e.g. helm values, are detected as well.
+// The result is not cached since the cached manifests are rendered with the target configuration.
+func (e *deployExecutor) loadRunningManifestsWithRunningConfig(ctx context.Context) ([]provider.Manifest, error) {
+	ds, err := e.RunningDSP.Get(ctx, e.LogPersister)
+	if err != nil {
+		return nil, err
+	}
+	spec := ds.ApplicationConfig.KubernetesApplicationSpec
+	if spec == nil {
+		return nil, fmt.Errorf("missing KubernetesApplicationSpec at the running commit")
+	}
+
+	loader := provider.NewLoader(
+		e.Deployment.ApplicationName,
+		ds.AppDir,
+		ds.RepoDir,
+		e.Deployment.GitPath.ConfigFilename,
+		spec.Input,
+		e.GitClient,
+		e.Logger,
+	)
+	return loader.LoadManifests(ctx)
+}
+
+// manifestsDigest computes the digest of the given manifests regardless of their order.
+func manifestsDigest(manifests []provider.Manifest) (string, error) {
+	sorted := make([]provider.Manifest, len(manifests))
+	copy(sorted, manifests)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Key.String() < sorted[j].Key.String()
+	})
+	return provider.HashManifests(sorted)
+}
+
+// markNoOpSync records that applying the manifests was skipped in this deployment.
+func (e *deployExecutor) markNoOpSync(ctx context.Context) {
+	if err := e.MetadataStore.Shared().Put(ctx, model.MetadataKeyDeploymentNoOpSync, "true"); err != nil {
+		e.LogPersister.Errorf("Failed to save the no-op sync to the metadata store (%v)", err)
+	}
+}
+
+// isNoOpSync reports whether applying the manifests was skipped in this deployment.
+func (e *deployExecutor) isNoOpSync() bool {
+	v, ok := e.MetadataStore.Shared().Get(model.MetadataKeyDeploymentNoOpSync)
+	return ok && v == "true"
+}
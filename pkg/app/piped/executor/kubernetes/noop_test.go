@@ -0,0 +1,139 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+)
+
+func TestManifestsDigest(t *testing.T) {
+	t.Parallel()
+
+	const (
+		deployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: foo
+spec:
+  replicas: 2
+`
+		service = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: foo
+`
+		scaled = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: foo
+spec:
+  replicas: 3
+`
+	)
+	parse := func(data string) []provider.Manifest {
+		manifests, err := provider.ParseManifests(data)
+		require.NoError(t, err)
+		return manifests
+	}
+
+	digest, err := manifestsDigest(parse(deployment + "---" + service))
+	require.NoError(t, err)
+
+	reordered, err := manifestsDigest(parse(service + "---" + deployment))
+	require.NoError(t, err)
+	assert.Equal(t, digest, reordered)
+
+	changed, err := manifestsDigest(parse(scaled + "---" + service))
+	require.NoError(t, err)
+	assert.NotEqual(t, digest, changed)
+}
+
+func TestCheckLiveResourcesAtCommit(t *testing.T) {
+	t.Parallel()
+
+	parse := func(data string) []provider.Manifest {
+		manifests, err := provider.ParseManifests(data)
+		require.NoError(t, err)
+		return manifests
+	}
+	live := func(name, app, commit string) string {
+		return fmt.Sprintf(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: %s
+  namespace: default
+  annotations:
+    pipecd.dev/managed-by: piped
+    pipecd.dev/application: %s
+    pipecd.dev/commit-hash: %s
+`, name, app, commit)
+	}
+	manifests := parse(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: foo
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: bar
+`)
+
+	testcases := []struct {
+		name       string
+		live       string
+		wantReason bool
+	}{
+		{
+			name: "all applied at the running commit",
+			live: live("foo", "app", "running") + "---" + live("bar", "app", "running"),
+		},
+		{
+			name:       "partially applied by a failed deployment",
+			live:       live("foo", "app", "running") + "---" + live("bar", "app", "failed"),
+			wantReason: true,
+		},
+		{
+			name:       "deleted",
+			live:       live("foo", "app", "running"),
+			wantReason: true,
+		},
+		{
+			name:       "owned by another application",
+			live:       live("foo", "app", "running") + "---" + live("bar", "other", "running"),
+			wantReason: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			reason := checkLiveResourcesAtCommit(manifests, parse(tc.live), "app", "running")
+			assert.Equal(t, tc.wantReason, reason != "")
+		})
+	}
+}
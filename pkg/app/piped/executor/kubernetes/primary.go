@@ -55,21 +55,17 @@ func (e *deployExecutor) ensurePrimaryRollout(ctx context.Context) model.StageSt
 	routingMethod := config.DetermineKubernetesTrafficRoutingMethod(e.appCfg.TrafficRouting)
 
 	switch routingMethod {
-	// In case of routing by Pod selector,
+	// In case of routing by Pod selector or NGINX Ingress,
 	// all manifests can be used as primary manifests.
-	case config.KubernetesTrafficRoutingMethodPodSelector:
+	case config.KubernetesTrafficRoutingMethodPodSelector, config.KubernetesTrafficRoutingMethodNginx:
 		primaryManifests = manifests
 
-	// In case of routing by Istio,
-	// VirtualService manifest will be used to manipulate the traffic ratio.
+	// In case of routing by Istio, Gateway API or SMI,
+	// the VirtualService, HTTPRoute or TrafficSplit manifest will be used to manipulate the traffic ratio.
 	// Other manifests can be used as primary manifests.
-	case config.KubernetesTrafficRoutingMethodIstio:
-		// Firstly, find the VirtualService manifests.
-		istioCfg := e.appCfg.TrafficRouting.Istio
-		if istioCfg == nil {
-			istioCfg = &config.IstioTrafficRouting{}
-		}
-		trafficRoutingManifests, err := findIstioVirtualServiceManifests(manifests, istioCfg.VirtualService)
+	case config.KubernetesTrafficRoutingMethodIstio, config.KubernetesTrafficRoutingMethodGatewayAPI, config.KubernetesTrafficRoutingMethodSMI:
+		// Firstly, find the traffic routing manifests.
+		trafficRoutingManifests, err := findTrafficRoutingManifests(manifests, e.appCfg.Service.Name, e.appCfg.TrafficRouting)
 		if err != nil {
 			e.LogPersister.Errorf("Failed while finding traffic routing manifest: (%v)", err)
 			return model.StageStatus_STAGE_FAILURE
@@ -92,7 +88,7 @@ func (e *deployExecutor) ensurePrimaryRollout(ctx context.Context) model.StageSt
 
 	// Check if the variant selector is in the workloads.
 	if !options.AddVariantLabelToSelector &&
-		routingMethod == config.KubernetesTrafficRoutingMethodPodSelector &&
+		(routingMethod == config.KubernetesTrafficRoutingMethodPodSelector || routingMethod == config.KubernetesTrafficRoutingMethodNginx || isWeightedBackendsMethod(routingMethod)) &&
 		e.appCfg.HasStage(model.StageK8sTrafficRouting) {
 		workloads := findWorkloadManifests(primaryManifests, e.appCfg.Workloads)
 		var invalid bool
@@ -115,6 +111,11 @@ func (e *deployExecutor) ensurePrimaryRollout(ctx context.Context) model.StageSt
 	}
 	e.LogPersister.Successf("Successfully generated %d manifests for PRIMARY variant", len(primaryManifests))
 
+	if err := e.keepMaintenanceSelector(primaryManifests); err != nil {
+		e.LogPersister.Errorf("Unable to keep the maintenance mode (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
 	// Add builtin annotations for tracking application live state.
 	addBuiltinAnnotations(
 		primaryManifests,
@@ -131,9 +132,16 @@ func (e *deployExecutor) ensurePrimaryRollout(ctx context.Context) model.StageSt
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if options.PodDisruptionBudget.Enabled {
+		workloads := findWorkloadManifests(primaryManifests, e.appCfg.Workloads)
+		if !e.waitForPodDisruptionBudgets(ctx, primaryManifests, workloads, options.PodDisruptionBudget) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
 	// Start applying all manifests to add or update running resources.
 	e.LogPersister.Info("Start rolling out PRIMARY variant...")
-	if err := applyManifests(ctx, e.applierGetter, primaryManifests, e.appCfg.Input.Namespace, e.LogPersister); err != nil {
+	if err := applyManifests(ctx, e.applierGetter, primaryManifests, e.appCfg.Input.Namespace, e.appCfg.Input.CRDReadyTimeout.Duration(), e.LogPersister); err != nil {
 		return model.StageStatus_STAGE_FAILURE
 	}
 	e.LogPersister.Success("Successfully rolled out PRIMARY variant")
@@ -224,6 +232,10 @@ func (e *deployExecutor) generatePrimaryManifests(manifests []provider.Manifest,
 		}
 	}
 
+	if err := overrideRollingUpdate(findWorkloadManifests(manifests, e.appCfg.Workloads), opts); err != nil {
+		return nil, err
+	}
+
 	// Find service manifests and duplicate them for PRIMARY variant.
 	if opts.CreateService {
 		serviceName := e.appCfg.Service.Name
@@ -20,12 +20,14 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"sync"
 
 	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/scriptrun"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -144,33 +146,89 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	ag, err := newApplierGroup(e.Deployment.PlatformProvider, *appCfg, e.PipedConfig, e.Logger)
+	if mc := appCfg.MultiCluster; mc != nil {
+		return e.rollbackMultiCluster(ctx, appCfg, mc, manifests)
+	}
+	return e.rollbackCluster(ctx, e.Deployment.PlatformProvider, appCfg, manifests, e.LogPersister)
+}
+
+// rollbackMultiCluster rolls back every cluster touched by the deployment independently,
+// so that a failure in one cluster does not prevent the others from being reverted.
+// All clusters at the running commit are handled when the touched ones are unknown.
+func (e *rollbackExecutor) rollbackMultiCluster(ctx context.Context, appCfg *config.KubernetesApplicationSpec, mc *config.KubernetesMultiCluster, manifests []provider.Manifest) model.StageStatus {
+	touched := loadTouchedClusters(e.MetadataStore.Shared())
+
+	targets := make([]config.KubernetesClusterTarget, 0, len(mc.Targets))
+	for _, t := range mc.Targets {
+		if len(touched) > 0 {
+			if _, ok := touched[t.Provider]; !ok {
+				continue
+			}
+			delete(touched, t.Provider)
+		}
+		targets = append(targets, t)
+	}
+	for p := range touched {
+		e.LogPersister.Infof("Skip rolling back cluster %s because it is not targeted at the running commit", p)
+	}
+
+	statuses := make([]model.StageStatus, len(targets))
+	var wg sync.WaitGroup
+	for i := range targets {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			lp := newClusterLogPersister(e.LogPersister, targets[i].Provider)
+			statuses[i] = e.rollbackCluster(ctx, targets[i].Provider, appCfg, manifests, lp)
+		}(i)
+	}
+	wg.Wait()
+
+	status := model.StageStatus_STAGE_SUCCESS
+	for i := range statuses {
+		if statuses[i] != model.StageStatus_STAGE_SUCCESS {
+			e.LogPersister.Errorf("Failed to roll back cluster %s", targets[i].Provider)
+			status = model.StageStatus_STAGE_FAILURE
+		}
+	}
+	return status
+}
+
+// rollbackCluster reverts the resources in the cluster of the given platform provider
+// to the given manifests and removes the CANARY and BASELINE variants from it.
+func (e *rollbackExecutor) rollbackCluster(ctx context.Context, platformProvider string, appCfg *config.KubernetesApplicationSpec, manifests []provider.Manifest, lp executor.LogPersister) model.StageStatus {
+	ag, err := newApplierGroup(platformProvider, *appCfg, e.PipedConfig, e.Logger)
 	if err != nil {
-		e.LogPersister.Error(err.Error())
+		lp.Error(err.Error())
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if err := ensureManagedNamespace(ctx, ag, appCfg.Input, e.PipedConfig.PipedID, e.Deployment.ApplicationId, lp); err != nil {
+		lp.Errorf("Failed to ensure the managed namespace (%v)", err)
 		return model.StageStatus_STAGE_FAILURE
 	}
 
 	// Start applying all manifests to add or update running resources.
-	if err := applyManifests(ctx, ag, manifests, appCfg.Input.Namespace, e.LogPersister); err != nil {
+	if err := applyManifests(ctx, ag, manifests, appCfg.Input.Namespace, appCfg.Input.CRDReadyTimeout.Duration(), lp); err != nil {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
 	var errs []error
 
 	// Next we delete all resources of CANARY variant.
-	e.LogPersister.Info("Start checking to ensure that the CANARY variant should be removed")
+	lp.Info("Start checking to ensure that the CANARY variant should be removed")
 	if value, ok := e.MetadataStore.Shared().Get(addedCanaryResourcesMetadataKey); ok {
 		resources := strings.Split(value, ",")
-		if err := removeCanaryResources(ctx, ag, resources, e.LogPersister); err != nil {
+		if err := removeCanaryResources(ctx, ag, resources, lp); err != nil {
 			errs = append(errs, err)
 		}
 	}
 
 	// Then delete all resources of BASELINE variant.
-	e.LogPersister.Info("Start checking to ensure that the BASELINE variant should be removed")
+	lp.Info("Start checking to ensure that the BASELINE variant should be removed")
 	if value, ok := e.MetadataStore.Shared().Get(addedBaselineResourcesMetadataKey); ok {
 		resources := strings.Split(value, ",")
-		if err := removeBaselineResources(ctx, ag, resources, e.LogPersister); err != nil {
+		if err := removeBaselineResources(ctx, ag, resources, lp); err != nil {
 			errs = append(errs, err)
 		}
 	}
@@ -16,12 +16,20 @@ package kubernetes
 
 import (
 	"context"
+	"errors"
+	"sort"
 	"time"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
+const (
+	crossplaneCheckInterval  = 10 * time.Second
+	defaultCrossplaneTimeout = 10 * time.Minute
+)
+
 func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 	// Load the manifests at the specified commit.
 	e.LogPersister.Infof("Loading manifests at commit %s for handling", e.commit)
@@ -39,6 +47,12 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 	}
 	e.LogPersister.Successf("Successfully loaded %d manifests", len(manifests))
 
+	if e.appCfg.QuickSync.SkipApplyIfUnchanged && e.manifestsUnchanged(ctx, manifests) {
+		e.markNoOpSync(ctx)
+		e.LogPersister.Success("Skipped applying the manifests since they are identical to the ones at the running commit")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
 	// Because the loaded manifests are read-only
 	// we duplicate them to avoid updating the shared manifests data in cache.
 	manifests = duplicateManifests(manifests, "")
@@ -59,6 +73,11 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 		}
 	}
 
+	if err := e.keepMaintenanceSelector(manifests); err != nil {
+		e.LogPersister.Errorf("Unable to keep the maintenance mode (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
 	// Add builtin annotations for tracking application live state.
 	addBuiltinAnnotations(
 		manifests,
@@ -75,11 +94,25 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if e.appCfg.QuickSync.AdoptResources {
+		if err := e.adoptResources(ctx, manifests); err != nil {
+			e.LogPersister.Errorf("Unable to adopt the live resources (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
 	// Start applying all manifests to add or update running resources.
-	if err := applyManifests(ctx, e.applierGetter, manifests, e.appCfg.Input.Namespace, e.LogPersister); err != nil {
+	if err := applyManifests(ctx, e.applierGetter, manifests, e.appCfg.Input.Namespace, e.appCfg.Input.CRDReadyTimeout.Duration(), e.LogPersister); err != nil {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if e.appCfg.QuickSync.WaitForCrossplaneResources && !e.appCfg.Input.DryRun {
+		if err := e.waitForCrossplaneResources(ctx, manifests); err != nil {
+			e.LogPersister.Errorf("Failed while waiting for Crossplane resources to be ready (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
 	if !e.appCfg.QuickSync.Prune {
 		e.LogPersister.Info("Resource GC was skipped because sync.prune was not configured")
 		return model.StageStatus_STAGE_SUCCESS
@@ -108,12 +141,20 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 		e.LogPersister.Successf("- loaded live resource: %s", m.Key.ReadableString())
 	}
 
+	// Never touch the resources which were not applied by PipeCD for this application.
+	liveResources = filterManagedResources(liveResources, e.Deployment.ApplicationId)
 	removeKeys := findRemoveResources(manifests, liveResources)
 	if len(removeKeys) == 0 {
 		e.LogPersister.Info("There are no live resources should be removed")
 		return model.StageStatus_STAGE_SUCCESS
 	}
 	e.LogPersister.Infof("Found %d live resources that are no longer defined in Git", len(removeKeys))
+	reportRemoveResources(liveResources, removeKeys, e.LogPersister)
+
+	if e.appCfg.QuickSync.PruneDryRun {
+		e.LogPersister.Info("Those resources were not removed because sync.pruneDryRun was configured")
+		return model.StageStatus_STAGE_SUCCESS
+	}
 
 	// Start deleting all running resources that are not defined in Git.
 	if err := deleteResources(ctx, e.applierGetter, removeKeys, e.LogPersister); err != nil {
@@ -123,6 +164,113 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 	return model.StageStatus_STAGE_SUCCESS
 }
 
+// waitForCrossplaneResources waits until all applied Crossplane resources are reported as Synced and Ready
+// by the live state store, or the configured timeout is exceeded.
+// The resources not found in the live state store yet are treated as not ready.
+func (e *deployExecutor) waitForCrossplaneResources(ctx context.Context, manifests []provider.Manifest) error {
+	applied := make(map[provider.ResourceKey]struct{}, len(manifests))
+	for _, m := range manifests {
+		if provider.IsCrossplaneManifest(m) {
+			applied[m.Key] = struct{}{}
+		}
+	}
+	if len(applied) == 0 {
+		return nil
+	}
+
+	timeout := e.appCfg.QuickSync.CrossplaneResourcesTimeout.Duration()
+	if timeout <= 0 {
+		timeout = defaultCrossplaneTimeout
+	}
+	e.LogPersister.Infof("Waiting for the applied Crossplane resources to be synced and ready (timeout: %v)", timeout)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(crossplaneCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.New("timed out waiting for Crossplane resources to be ready")
+		case <-ticker.C:
+		}
+
+		liveResources, ok := e.AppLiveResourceLister.ListKubernetesResources()
+		if !ok {
+			continue
+		}
+
+		if notReady := e.countNotReadyCrossplaneResources(applied, liveResources); notReady > 0 {
+			e.LogPersister.Infof("%d Crossplane resources are not ready yet", notReady)
+			continue
+		}
+		e.LogPersister.Success("All applied Crossplane resources are synced and ready")
+		return nil
+	}
+}
+
+// countNotReadyCrossplaneResources returns the number of the applied Crossplane resources
+// which are missing in the given live resources or not synced and ready yet.
+func (e *deployExecutor) countNotReadyCrossplaneResources(applied map[provider.ResourceKey]struct{}, liveResources []provider.Manifest) int {
+	found := make(map[provider.ResourceKey]struct{}, len(applied))
+	notReady := 0
+	for _, m := range liveResources {
+		if _, ok := applied[m.Key]; !ok {
+			continue
+		}
+		found[m.Key] = struct{}{}
+		if ready, reason := provider.IsCrossplaneManifestReady(m); !ready {
+			e.LogPersister.Infof("- %s: %s", m.Key.ReadableString(), reason)
+			notReady++
+		}
+	}
+	missing := make([]string, 0, len(applied)-len(found))
+	for k := range applied {
+		if _, ok := found[k]; !ok {
+			missing = append(missing, k.ReadableString())
+		}
+	}
+	sort.Strings(missing)
+	for _, k := range missing {
+		e.LogPersister.Infof("- %s: not found in the live state yet", k)
+	}
+	return notReady + len(missing)
+}
+
+// filterManagedResources returns the live resources applied by PipeCD for the given application.
+func filterManagedResources(liveResources []provider.Manifest, appID string) []provider.Manifest {
+	managed := make([]provider.Manifest, 0, len(liveResources))
+	for _, m := range liveResources {
+		annotations := m.GetAnnotations()
+		if annotations[provider.LabelManagedBy] != provider.ManagedByPiped {
+			continue
+		}
+		if annotations[provider.LabelApplication] != appID {
+			continue
+		}
+		managed = append(managed, m)
+	}
+	return managed
+}
+
+// reportRemoveResources writes the resources going to be removed along with
+// the commit which applied each of them last into the stage log.
+func reportRemoveResources(liveResources []provider.Manifest, removeKeys []provider.ResourceKey, lp executor.LogPersister) {
+	commits := make(map[provider.ResourceKey]string, len(liveResources))
+	for _, m := range liveResources {
+		commits[m.Key] = m.GetAnnotations()[provider.LabelCommitHash]
+	}
+	for _, k := range removeKeys {
+		if commit := commits[k]; commit != "" {
+			lp.Infof("- will remove: %s (last applied at commit %s)", k.ReadableString(), commit)
+			continue
+		}
+		lp.Infof("- will remove: %s", k.ReadableString())
+	}
+}
+
 func findRemoveResources(manifests []provider.Manifest, liveResources []provider.Manifest) []provider.ResourceKey {
 	var (
 		keys       = make(map[provider.ResourceKey]struct{}, len(manifests))
@@ -397,3 +397,101 @@ func TestFindRemoveResources(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterManagedResources(t *testing.T) {
+	t.Parallel()
+
+	liveResources, err := provider.ParseManifests(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: managed
+  annotations:
+    pipecd.dev/managed-by: piped
+    pipecd.dev/application: app-id
+    pipecd.dev/commit-hash: commit-hash
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: other-app
+  annotations:
+    pipecd.dev/managed-by: piped
+    pipecd.dev/application: other-app-id
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: not-managed
+`)
+	require.NoError(t, err)
+
+	got := filterManagedResources(liveResources, "app-id")
+	require.Len(t, got, 1)
+	assert.Equal(t, "managed", got[0].Key.Name)
+}
+
+func TestCountNotReadyCrossplaneResources(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := provider.ParseManifests(`
+apiVersion: database.example.org/v1alpha1
+kind: PostgreSQLInstance
+metadata:
+  name: ready
+  namespace: default
+spec:
+  compositionRef:
+    name: production
+---
+apiVersion: database.example.org/v1alpha1
+kind: PostgreSQLInstance
+metadata:
+  name: missing
+  namespace: default
+spec:
+  compositionRef:
+    name: production
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  namespace: default
+`)
+	require.NoError(t, err)
+	applied := make(map[provider.ResourceKey]struct{}, len(manifests))
+	for _, m := range manifests {
+		if provider.IsCrossplaneManifest(m) {
+			applied[m.Key] = struct{}{}
+		}
+	}
+	require.Len(t, applied, 2)
+
+	liveResources, err := provider.ParseManifests(`
+apiVersion: database.example.org/v1alpha1
+kind: PostgreSQLInstance
+metadata:
+  name: ready
+  namespace: default
+spec:
+  compositionRef:
+    name: production
+status:
+  conditions:
+  - type: Synced
+    status: "True"
+  - type: Ready
+    status: "True"
+`)
+	require.NoError(t, err)
+
+	e := &deployExecutor{
+		Input: executor.Input{
+			LogPersister: &fakeLogPersister{},
+		},
+	}
+	// The resources not found in the live state are not ready.
+	assert.Equal(t, 1, e.countNotReadyCrossplaneResources(applied, liveResources))
+	assert.Equal(t, 2, e.countNotReadyCrossplaneResources(applied, nil))
+}
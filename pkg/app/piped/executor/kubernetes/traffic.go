@@ -25,6 +25,8 @@ import (
 	istiov1alpha3 "istio.io/api/networking/v1alpha3"
 	istiov1beta1 "istio.io/api/networking/v1beta1"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/analysis"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -36,18 +38,51 @@ const (
 	baselineMetadataKey = "baseline-percentage"
 )
 
-func (e *deployExecutor) ensureTrafficRouting(ctx context.Context) model.StageStatus {
-	var (
-		commitHash     = e.Deployment.Trigger.Commit.Hash
-		options        = e.StageConfig.K8sTrafficRoutingStageOptions
-		variantLabel   = e.appCfg.VariantLabel.Key
-		primaryVariant = e.appCfg.VariantLabel.PrimaryValue
-	)
+func (e *deployExecutor) ensureTrafficRouting(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
+	options := e.StageConfig.K8sTrafficRoutingStageOptions
 	if options == nil {
 		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
 		return model.StageStatus_STAGE_FAILURE
 	}
 	method := config.DetermineKubernetesTrafficRoutingMethod(e.appCfg.TrafficRouting)
+	if options.ABTesting != nil && method != config.KubernetesTrafficRoutingMethodIstio {
+		e.LogPersister.Errorf("A/B testing routing is only available for %s traffic routing method", config.KubernetesTrafficRoutingMethodIstio)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if len(options.Steps) > 0 {
+		if e.appCfg.MultiCluster != nil {
+			e.LogPersister.Error("Traffic routing by steps is not available for multi-cluster applications")
+			return model.StageStatus_STAGE_FAILURE
+		}
+		// PodSelector can only route all traffic to one of the variants.
+		if method == config.KubernetesTrafficRoutingMethodPodSelector {
+			e.LogPersister.Errorf("Traffic routing by steps is not available for %s traffic routing method", method)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		return analysis.RouteTrafficBySteps(sig, e.Input, &options.TrafficRoutingSteps, func(primary, canary int) bool {
+			return e.routeTraffic(ctx, method, nil, primary, canary, 0) == model.StageStatus_STAGE_SUCCESS
+		})
+	}
+
+	// Decide traffic routing percentage for all variants.
+	primaryPercent, canaryPercent, baselinePercent := options.Percentages()
+	// A/B testing routing without any percentage sends all other requests to PRIMARY variant.
+	if options.ABTesting != nil && primaryPercent+canaryPercent+baselinePercent == 0 {
+		primaryPercent = 100
+	}
+	return e.routeTraffic(ctx, method, options.ABTesting, primaryPercent, canaryPercent, baselinePercent)
+}
+
+// routeTraffic updates the traffic routing manifest to route the traffic to the variants by the given percentages.
+// When abTesting is specified, the requests matching its rules are routed to CANARY variant.
+func (e *deployExecutor) routeTraffic(ctx context.Context, method config.KubernetesTrafficRoutingMethod, abTesting *config.ABTestingRouting, primaryPercent, canaryPercent, baselinePercent int) model.StageStatus {
+	var (
+		commitHash     = e.Deployment.Trigger.Commit.Hash
+		variantLabel   = e.appCfg.VariantLabel.Key
+		primaryVariant = e.appCfg.VariantLabel.PrimaryValue
+	)
 
 	// Load the manifests at the triggered commit.
 	e.LogPersister.Infof("Loading manifests at commit %s for handling", commitHash)
@@ -70,8 +105,6 @@ func (e *deployExecutor) ensureTrafficRouting(ctx context.Context) model.StageSt
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	// Decide traffic routing percentage for all variants.
-	primaryPercent, canaryPercent, baselinePercent := options.Percentages()
 	e.saveTrafficRoutingMetadata(ctx, primaryPercent, canaryPercent, baselinePercent)
 
 	// Find traffic routing manifests.
@@ -96,6 +129,12 @@ func (e *deployExecutor) ensureTrafficRouting(ctx context.Context) model.StageSt
 	}
 	trafficRoutingManifest := trafficRoutingManifests[0]
 
+	// In case we are routing by NGINX Ingress, the canary Ingress is handled
+	// instead of updating the traffic routing manifest.
+	if method == config.KubernetesTrafficRoutingMethodNginx {
+		return e.ensureNginxTrafficRouting(ctx, manifests, trafficRoutingManifest, primaryPercent, canaryPercent, baselinePercent)
+	}
+
 	// In case we are routing by PodSelector, the service manifest must contain variantLabel inside its selector.
 	if method == config.KubernetesTrafficRoutingMethodPodSelector {
 		if err := checkVariantSelectorInService(trafficRoutingManifest, variantLabel, primaryVariant); err != nil {
@@ -108,16 +147,61 @@ func (e *deployExecutor) ensureTrafficRouting(ctx context.Context) model.StageSt
 		}
 	}
 
-	trafficRoutingManifest, err = e.generateTrafficRoutingManifest(
-		trafficRoutingManifest,
-		primaryPercent,
-		canaryPercent,
-		baselinePercent,
-	)
+	// In case we are routing by the weights of backends, the Services of application are used as the backends
+	// of PRIMARY variant, so they must not send traffic to the other variants.
+	serviceNames := make(map[string]struct{})
+	if isWeightedBackendsMethod(method) {
+		for _, s := range findManifests(provider.KindService, e.appCfg.Service.Name, manifests) {
+			if err := checkVariantSelectorInService(s, variantLabel, primaryVariant); err != nil {
+				e.LogPersister.Errorf("Traffic routing by %s requires %q inside the selector of Service manifest but it was unable to check that field in manifest %s (%v)",
+					method,
+					variantLabel+": "+primaryVariant,
+					s.Key.ReadableString(),
+					err,
+				)
+				return model.StageStatus_STAGE_FAILURE
+			}
+			serviceNames[s.Key.Name] = struct{}{}
+		}
+	}
+
+	switch {
+	case abTesting != nil:
+		istioConfig := e.appCfg.TrafficRouting.Istio
+		if istioConfig == nil {
+			istioConfig = &config.IstioTrafficRouting{}
+		}
+		trafficRoutingManifest, err = e.generateABTestingVirtualServiceManifest(
+			trafficRoutingManifest,
+			istioConfig.Host,
+			istioConfig.EditableRoutes,
+			abTesting,
+			int32(canaryPercent),
+			int32(baselinePercent),
+		)
+	case isWeightedBackendsMethod(method):
+		trafficRoutingManifest, err = e.generateWeightedBackendsManifest(
+			trafficRoutingManifest,
+			serviceNames,
+			int64(canaryPercent),
+			int64(baselinePercent),
+		)
+	default:
+		trafficRoutingManifest, err = e.generateTrafficRoutingManifest(
+			trafficRoutingManifest,
+			primaryPercent,
+			canaryPercent,
+			baselinePercent,
+		)
+	}
 	if err != nil {
 		e.LogPersister.Errorf("Unable generate traffic routing manifest: (%v)", err)
 		return model.StageStatus_STAGE_FAILURE
 	}
+	if err := e.keepMaintenanceSelector([]provider.Manifest{trafficRoutingManifest}); err != nil {
+		e.LogPersister.Errorf("Unable to keep the maintenance mode (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
 
 	// Add builtin annotations for tracking application live state.
 	addBuiltinAnnotations(
@@ -129,12 +213,21 @@ func (e *deployExecutor) ensureTrafficRouting(ctx context.Context) model.StageSt
 		e.Deployment.ApplicationId,
 	)
 
-	e.LogPersister.Infof("Start updating traffic routing to be percentages: primary=%d, canary=%d, baseline=%d",
-		primaryPercent,
-		canaryPercent,
-		baselinePercent,
-	)
-	if err := applyManifests(ctx, e.applierGetter, []provider.Manifest{trafficRoutingManifest}, e.appCfg.Input.Namespace, e.LogPersister); err != nil {
+	if abTesting != nil {
+		e.LogPersister.Infof("Start updating traffic routing to route requests matching %d A/B testing rules to CANARY variant and the others by percentages: primary=%d, canary=%d, baseline=%d",
+			len(abTesting.Rules),
+			primaryPercent,
+			canaryPercent,
+			baselinePercent,
+		)
+	} else {
+		e.LogPersister.Infof("Start updating traffic routing to be percentages: primary=%d, canary=%d, baseline=%d",
+			primaryPercent,
+			canaryPercent,
+			baselinePercent,
+		)
+	}
+	if err := applyManifests(ctx, e.applierGetter, []provider.Manifest{trafficRoutingManifest}, e.appCfg.Input.Namespace, e.appCfg.Input.CRDReadyTimeout.Duration(), e.LogPersister); err != nil {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
@@ -156,11 +249,100 @@ func findTrafficRoutingManifests(manifests []provider.Manifest, serviceName stri
 		}
 		return findIstioVirtualServiceManifests(manifests, istioConfig.VirtualService)
 
+	case config.KubernetesTrafficRoutingMethodNginx:
+		nginxConfig := cfg.Nginx
+		if nginxConfig == nil {
+			nginxConfig = &config.NginxTrafficRouting{}
+		}
+		return findNginxIngressManifests(manifests, nginxConfig.Ingress)
+
+	case config.KubernetesTrafficRoutingMethodGatewayAPI:
+		gatewayAPIConfig := cfg.GatewayAPI
+		if gatewayAPIConfig == nil {
+			gatewayAPIConfig = &config.GatewayAPITrafficRouting{}
+		}
+		return findGatewayAPIHTTPRouteManifests(manifests, gatewayAPIConfig.HTTPRoute)
+
+	case config.KubernetesTrafficRoutingMethodSMI:
+		smiConfig := cfg.SMI
+		if smiConfig == nil {
+			smiConfig = &config.SMITrafficRouting{}
+		}
+		return findSMITrafficSplitManifests(manifests, smiConfig.TrafficSplit)
+
 	default:
 		return nil, fmt.Errorf("unsupport traffic routing method %v", method)
 	}
 }
 
+// canaryTrafficWeight returns the largest weight of the traffic routed to CANARY variant by the given traffic routing manifest.
+// A route forwarding all requests matching some conditions to CANARY variant, e.g. A/B testing route, has the weight of 100.
+func (e *deployExecutor) canaryTrafficWeight(m provider.Manifest) (int32, error) {
+	var (
+		variantLabel  = e.appCfg.VariantLabel.Key
+		canaryVariant = e.appCfg.VariantLabel.CanaryValue
+	)
+
+	method := config.DetermineKubernetesTrafficRoutingMethod(e.appCfg.TrafficRouting)
+	switch method {
+	case config.KubernetesTrafficRoutingMethodPodSelector:
+		selector, err := m.GetNestedStringMap("spec", "selector")
+		if err != nil {
+			return 0, err
+		}
+		if selector[variantLabel] == canaryVariant {
+			return 100, nil
+		}
+		return 0, nil
+
+	case config.KubernetesTrafficRoutingMethodIstio:
+		host := ""
+		if cfg := e.appCfg.TrafficRouting.Istio; cfg != nil {
+			host = cfg.Host
+		}
+		spec, err := m.GetSpec()
+		if err != nil {
+			return 0, err
+		}
+		// The fields used here are the same between v1alpha3 and v1beta1.
+		vs := istiov1beta1.VirtualService{}
+		data, err := json.Marshal(spec)
+		if err != nil {
+			return 0, err
+		}
+		if err := json.Unmarshal(data, &vs); err != nil {
+			return 0, err
+		}
+
+		var max int32
+		for _, http := range vs.Http {
+			for _, r := range http.Route {
+				if r.Destination == nil || r.Destination.Host != host || r.Destination.Subset != canaryVariant {
+					continue
+				}
+				weight := r.Weight
+				// The weight can be omitted when there is only one destination.
+				if weight == 0 && len(http.Route) == 1 {
+					weight = 100
+				}
+				if weight > max {
+					max = weight
+				}
+			}
+		}
+		return max, nil
+
+	case config.KubernetesTrafficRoutingMethodNginx:
+		return nginxCanaryWeight(m)
+
+	case config.KubernetesTrafficRoutingMethodGatewayAPI, config.KubernetesTrafficRoutingMethodSMI:
+		return weightedBackendsCanaryWeight(m, canaryVariant)
+
+	default:
+		return 0, fmt.Errorf("unsupport traffic routing method %v", method)
+	}
+}
+
 func (e *deployExecutor) generateTrafficRoutingManifest(manifest provider.Manifest, primaryPercent, canaryPercent, baselinePercent int) (provider.Manifest, error) {
 	// Because the loaded manifests are read-only
 	// so we duplicate them to avoid updating the shared manifests data in cache.
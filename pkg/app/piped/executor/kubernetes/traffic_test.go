@@ -144,3 +144,183 @@ spec:
 		})
 	}
 }
+
+func TestGenerateABTestingVirtualServiceManifest(t *testing.T) {
+	t.Parallel()
+
+	exec := &deployExecutor{
+		appCfg: &config.KubernetesApplicationSpec{
+			VariantLabel: config.KubernetesVariantLabel{
+				Key:           "pipecd.dev/variant",
+				PrimaryValue:  "primary",
+				BaselineValue: "baseline",
+				CanaryValue:   "canary",
+			},
+		},
+	}
+	manifests, err := provider.LoadManifestsFromYAMLFile("testdata/virtual-service.yaml")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(manifests))
+
+	testcases := []struct {
+		name            string
+		rules           []config.ABTestingRule
+		canaryPercent   int32
+		baselinePercent int32
+		expectedFile    string
+	}{
+		{
+			name: "route all other requests to primary",
+			rules: []config.ABTestingRule{
+				{Header: "X-Canary", Value: "true"},
+				{Cookie: "beta", Value: "1", MatchType: config.ABTestingMatchTypeExact},
+			},
+			expectedFile: "testdata/generated-ab-testing-virtual-service.yaml",
+		},
+		{
+			name: "split other requests by percentages",
+			rules: []config.ABTestingRule{
+				{Header: "X-Canary", Value: "true"},
+				{SourceLabels: map[string]string{"team": "qa"}},
+			},
+			canaryPercent: 20,
+			expectedFile:  "testdata/generated-ab-testing-virtual-service-with-percentages.yaml",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ab := &config.ABTestingRouting{Rules: tc.rules}
+			generatedManifest, err := exec.generateABTestingVirtualServiceManifest(manifests[0], "helloworld", []string{"only-primary-destination", "include-destination-to-other-host"}, ab, tc.canaryPercent, tc.baselinePercent)
+			require.NoError(t, err)
+
+			expectedManifests, err := provider.LoadManifestsFromYAMLFile(tc.expectedFile)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(expectedManifests))
+
+			expected, err := expectedManifests[0].YamlBytes()
+			require.NoError(t, err)
+			got, err := generatedManifest.YamlBytes()
+			require.NoError(t, err)
+
+			assert.EqualValues(t, string(expected), string(got))
+		})
+	}
+}
+
+func TestCanaryTrafficWeight(t *testing.T) {
+	t.Parallel()
+
+	variantLabel := config.KubernetesVariantLabel{
+		Key:           "pipecd.dev/variant",
+		PrimaryValue:  "primary",
+		BaselineValue: "baseline",
+		CanaryValue:   "canary",
+	}
+	istio := &config.KubernetesTrafficRouting{
+		Method: config.KubernetesTrafficRoutingMethodIstio,
+		Istio: &config.IstioTrafficRouting{
+			Host: "helloworld",
+		},
+	}
+	testcases := []struct {
+		name           string
+		trafficRouting *config.KubernetesTrafficRouting
+		manifest       string
+		manifestFile   string
+		expected       int32
+	}{
+		{
+			name: "service selecting primary",
+			manifest: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: simple
+spec:
+  selector:
+    app: simple
+    pipecd.dev/variant: primary
+`,
+			expected: 0,
+		},
+		{
+			name: "service selecting canary",
+			manifest: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: simple
+spec:
+  selector:
+    app: simple
+    pipecd.dev/variant: canary
+`,
+			expected: 100,
+		},
+		{
+			name:           "virtual service routing all traffic to primary",
+			trafficRouting: istio,
+			manifestFile:   "testdata/virtual-service.yaml",
+			expected:       0,
+		},
+		{
+			name:           "virtual service routing a part of traffic to canary",
+			trafficRouting: istio,
+			manifestFile:   "testdata/generated-virtual-service.yaml",
+			expected:       30,
+		},
+		{
+			name:           "virtual service routing matched requests to canary",
+			trafficRouting: istio,
+			manifestFile:   "testdata/generated-ab-testing-virtual-service.yaml",
+			expected:       100,
+		},
+		{
+			name:           "virtual service routing all traffic to canary without weight",
+			trafficRouting: istio,
+			manifest: `
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: helloworld
+spec:
+  hosts:
+  - helloworld
+  http:
+  - route:
+    - destination:
+        host: helloworld
+        subset: canary
+`,
+			expected: 100,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				manifests []provider.Manifest
+				err       error
+			)
+			if tc.manifestFile != "" {
+				manifests, err = provider.LoadManifestsFromYAMLFile(tc.manifestFile)
+			} else {
+				manifests, err = provider.ParseManifests(tc.manifest)
+			}
+			require.NoError(t, err)
+			require.Equal(t, 1, len(manifests))
+
+			exec := &deployExecutor{
+				appCfg: &config.KubernetesApplicationSpec{
+					VariantLabel:   variantLabel,
+					TrafficRouting: tc.trafficRouting,
+				},
+			}
+			got, err := exec.canaryTrafficWeight(manifests[0])
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const rolloutCheckInterval = 5 * time.Second
+
+// rolloutTarget is a workload waited by a K8S_WAIT_ROLLOUT stage.
+type rolloutTarget struct {
+	key      provider.ResourceKey
+	deadline time.Time
+
+	done         bool
+	lastProgress string
+	// The number of times each pod event was seen, keyed by the event UID.
+	// Events repeated by the API server keep their UIDs while incrementing the count.
+	seenEvents map[string]int32
+}
+
+func (e *deployExecutor) ensureWaitRollout(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.K8sWaitRolloutStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if e.appCfg.Input.DryRun {
+		e.LogPersister.Info("Nothing to wait for since no resource was actually changed in this dry-run deployment")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+	if e.isNoOpSync() {
+		e.LogPersister.Info("Nothing to wait for since applying the manifests was skipped in this deployment")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	e.LogPersister.Infof("Loading manifests at commit %s for handling", e.commit)
+	manifests, err := loadManifests(
+		ctx,
+		e.Deployment.ApplicationId,
+		e.commit,
+		e.AppManifestsCache,
+		e.loader,
+		e.Logger,
+	)
+	if err != nil {
+		e.LogPersister.Errorf("Failed while loading manifests (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	e.LogPersister.Successf("Successfully loaded %d manifests", len(manifests))
+
+	var (
+		start   = time.Now()
+		targets = make([]*rolloutTarget, 0, len(manifests))
+	)
+	for _, m := range manifests {
+		if !provider.IsRolloutWorkload(m.Key) {
+			continue
+		}
+		timeout := options.Timeout(m.Key.Kind)
+		targets = append(targets, &rolloutTarget{
+			key:        m.Key,
+			deadline:   start.Add(timeout),
+			seenEvents: make(map[string]int32),
+		})
+		e.LogPersister.Infof("Waiting up to %v for %s to be rolled out", timeout, m.Key.ReadableString())
+	}
+	if len(targets) == 0 {
+		e.LogPersister.Info("There is no Deployment, StatefulSet or DaemonSet to wait for")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	ticker := time.NewTicker(rolloutCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		pending := 0
+		for _, t := range targets {
+			if t.done {
+				continue
+			}
+			ok, err := e.checkRollout(ctx, t, start)
+			if err != nil {
+				e.LogPersister.Error(err.Error())
+				return model.StageStatus_STAGE_FAILURE
+			}
+			if !ok {
+				pending++
+			}
+		}
+		if pending == 0 {
+			e.LogPersister.Successf("All %d workloads were rolled out successfully", len(targets))
+			return model.StageStatus_STAGE_SUCCESS
+		}
+
+		select {
+		case <-ctx.Done():
+			return model.StageStatus_STAGE_FAILURE
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkRollout reports the current progress of the given workload into the stage log
+// and returns whether its rollout was done.
+// An error is returned when the rollout can no longer be done in time.
+func (e *deployExecutor) checkRollout(ctx context.Context, t *rolloutTarget, start time.Time) (bool, error) {
+	name := t.key.ReadableString()
+	applier, err := e.applierGetter.Get(t.key)
+	if err != nil {
+		return false, fmt.Errorf("unable to find the applier for %s (%w)", name, err)
+	}
+
+	var reason string
+	live, err := applier.GetManifest(ctx, t.key)
+	if err != nil {
+		reason = fmt.Sprintf("unable to get the live state (%v)", err)
+	} else {
+		status, err := provider.DetermineRolloutStatus(live)
+		if err != nil {
+			return false, err
+		}
+		if status.Progress != t.lastProgress {
+			e.LogPersister.Infof("%s: %s", name, status.Progress)
+			t.lastProgress = status.Progress
+		}
+		if status.Done {
+			e.LogPersister.Successf("%s was rolled out successfully", name)
+			t.done = true
+			return true, nil
+		}
+		if status.Stalled {
+			return false, fmt.Errorf("%s can no longer be rolled out: %s", name, status.Reason)
+		}
+		reason = status.Reason
+	}
+
+	e.reportPodEvents(ctx, applier, t, start)
+
+	if time.Now().After(t.deadline) {
+		return false, fmt.Errorf("timed out waiting for %s to be rolled out: %s", name, reason)
+	}
+	return false, nil
+}
+
+// reportPodEvents writes the pod events of the given workload occurred since the beginning of the stage
+// into the stage log unless they were already written.
+func (e *deployExecutor) reportPodEvents(ctx context.Context, applier provider.Applier, t *rolloutTarget, since time.Time) {
+	events, err := applier.ListPodEvents(ctx, t.key)
+	if err != nil {
+		e.Logger.Warn("failed to list pod events", zap.String("workload", t.key.ReadableString()), zap.Error(err))
+		return
+	}
+	for _, ev := range newPodEvents(events, t.seenEvents, since) {
+		msg := fmt.Sprintf("[%s] %s %s: %s", ev.InvolvedObject.Name, ev.Type, ev.Reason, ev.Message)
+		if ev.Type == corev1.EventTypeWarning {
+			e.LogPersister.Error(msg)
+			continue
+		}
+		e.LogPersister.Info(msg)
+	}
+}
+
+// newPodEvents returns the events occurred since the given time which are not recorded in seen yet
+// and records them into seen.
+func newPodEvents(events []corev1.Event, seen map[string]int32, since time.Time) []corev1.Event {
+	out := make([]corev1.Event, 0, len(events))
+	for _, ev := range events {
+		last := ev.LastTimestamp.Time
+		if last.IsZero() {
+			last = ev.EventTime.Time
+		}
+		if last.Before(since) {
+			continue
+		}
+		uid := string(ev.UID)
+		if count, ok := seen[uid]; ok && count >= ev.Count {
+			continue
+		}
+		seen[uid] = ev.Count
+		out = append(out, ev)
+	}
+	return out
+}
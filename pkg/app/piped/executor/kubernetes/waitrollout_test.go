@@ -0,0 +1,210 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes/kubernetestest"
+	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestEnsureWaitRollout(t *testing.T) {
+	t.Parallel()
+
+	const (
+		desired = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: foo
+spec:
+  replicas: 2
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: foo
+`
+		done = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: foo
+  generation: 2
+spec:
+  replicas: 2
+status:
+  observedGeneration: 2
+  replicas: 2
+  updatedReplicas: 2
+  readyReplicas: 2
+  availableReplicas: 2
+`
+		stalled = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: foo
+  generation: 2
+spec:
+  replicas: 2
+status:
+  observedGeneration: 2
+  replicas: 3
+  updatedReplicas: 1
+  conditions:
+  - type: Progressing
+    status: "False"
+    reason: ProgressDeadlineExceeded
+`
+	)
+
+	testcases := []struct {
+		name     string
+		options  *config.K8sWaitRolloutStageOptions
+		live     string
+		dryRun   bool
+		metadata mapMetadataStore
+		want     model.StageStatus
+	}{
+		{
+			name: "malformed configuration",
+			want: model.StageStatus_STAGE_FAILURE,
+		},
+		{
+			name:    "dry-run deployment",
+			options: &config.K8sWaitRolloutStageOptions{},
+			dryRun:  true,
+			want:    model.StageStatus_STAGE_SUCCESS,
+		},
+		{
+			name:     "applying was skipped",
+			options:  &config.K8sWaitRolloutStageOptions{},
+			metadata: mapMetadataStore{model.MetadataKeyDeploymentNoOpSync: "true"},
+			want:     model.StageStatus_STAGE_SUCCESS,
+		},
+		{
+			name:    "rolled out",
+			options: &config.K8sWaitRolloutStageOptions{DeploymentTimeout: config.Duration(time.Minute)},
+			live:    done,
+			want:    model.StageStatus_STAGE_SUCCESS,
+		},
+		{
+			name:    "progress deadline exceeded",
+			options: &config.K8sWaitRolloutStageOptions{DeploymentTimeout: config.Duration(time.Minute)},
+			live:    stalled,
+			want:    model.StageStatus_STAGE_FAILURE,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			manifests, err := provider.ParseManifests(desired)
+			require.NoError(t, err)
+
+			loader := kubernetestest.NewMockLoader(ctrl)
+			loader.EXPECT().LoadManifests(gomock.Any()).Return(manifests, nil).AnyTimes()
+
+			applier := kubernetestest.NewMockApplier(ctrl)
+			if tc.live != "" {
+				live, err := provider.ParseManifests(tc.live)
+				require.NoError(t, err)
+				applier.EXPECT().GetManifest(gomock.Any(), manifests[0].Key).Return(live[0], nil)
+				applier.EXPECT().ListPodEvents(gomock.Any(), manifests[0].Key).Return(nil, nil).AnyTimes()
+			}
+
+			e := &deployExecutor{
+				Input: executor.Input{
+					Deployment: &model.Deployment{
+						ApplicationId: "app-id",
+						Trigger: &model.DeploymentTrigger{
+							Commit: &model.Commit{Hash: "target"},
+						},
+					},
+					Stage: &model.PipelineStage{},
+					StageConfig: config.PipelineStage{
+						K8sWaitRolloutStageOptions: tc.options,
+					},
+					AppManifestsCache: memorycache.NewCache(),
+					MetadataStore:     tc.metadata,
+					LogPersister:      &fakeLogPersister{},
+					Logger:            zap.NewNop(),
+				},
+				commit:        "target",
+				loader:        loader,
+				applierGetter: &applierGroup{defaultApplier: applier},
+				appCfg: &config.KubernetesApplicationSpec{
+					Input: config.KubernetesDeploymentInput{DryRun: tc.dryRun},
+				},
+			}
+			got := e.ensureWaitRollout(context.Background())
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNewPodEvents(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	event := func(uid string, count int32, last time.Time) corev1.Event {
+		return corev1.Event{
+			ObjectMeta:    metav1.ObjectMeta{UID: types.UID("uid-" + uid)},
+			Count:         count,
+			LastTimestamp: metav1.NewTime(last),
+		}
+	}
+
+	seen := map[string]int32{}
+	got := newPodEvents([]corev1.Event{
+		event("old", 1, since.Add(-time.Minute)),
+		event("a", 1, since.Add(time.Second)),
+	}, seen, since)
+	require.Len(t, got, 1)
+	assert.Equal(t, "uid-a", string(got[0].UID))
+
+	got = newPodEvents([]corev1.Event{
+		event("a", 1, since.Add(time.Second)),
+		event("b", 1, since.Add(2*time.Second)),
+	}, seen, since)
+	require.Len(t, got, 1)
+	assert.Equal(t, "uid-b", string(got[0].UID))
+
+	// The repeated event is reported again since its count was incremented.
+	got = newPodEvents([]corev1.Event{
+		event("a", 2, since.Add(3*time.Second)),
+		event("b", 1, since.Add(2*time.Second)),
+	}, seen, since)
+	require.Len(t, got, 1)
+	assert.Equal(t, "uid-a", string(got[0].UID))
+}
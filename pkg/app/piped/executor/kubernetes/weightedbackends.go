@@ -0,0 +1,247 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	gatewayAPIGroupPrefix = "gateway.networking.k8s.io/"
+	httpRouteKind         = "HTTPRoute"
+	smiSplitGroupPrefix   = "split.smi-spec.io/"
+	trafficSplitKind      = "TrafficSplit"
+
+	// The backends whose weight is omitted are handled as 1
+	// as defined in the specification of Gateway API.
+	defaultBackendWeight = 1
+)
+
+// isWeightedBackendsMethod reports whether the given method routes traffic
+// by updating the weights of the backend Services of a traffic splitting resource.
+func isWeightedBackendsMethod(method config.KubernetesTrafficRoutingMethod) bool {
+	return method == config.KubernetesTrafficRoutingMethodGatewayAPI || method == config.KubernetesTrafficRoutingMethodSMI
+}
+
+func findGatewayAPIHTTPRouteManifests(manifests []provider.Manifest, ref config.K8sResourceReference) ([]provider.Manifest, error) {
+	if ref.Kind != "" && ref.Kind != httpRouteKind {
+		return nil, fmt.Errorf("support only %q kind for gatewayAPI HTTPRoute reference", httpRouteKind)
+	}
+	return findManifestsByGroupKind(manifests, gatewayAPIGroupPrefix, httpRouteKind, ref.Name), nil
+}
+
+func findSMITrafficSplitManifests(manifests []provider.Manifest, ref config.K8sResourceReference) ([]provider.Manifest, error) {
+	if ref.Kind != "" && ref.Kind != trafficSplitKind {
+		return nil, fmt.Errorf("support only %q kind for smi TrafficSplit reference", trafficSplitKind)
+	}
+	return findManifestsByGroupKind(manifests, smiSplitGroupPrefix, trafficSplitKind, ref.Name), nil
+}
+
+func findManifestsByGroupKind(manifests []provider.Manifest, apiVersionPrefix, kind, name string) []provider.Manifest {
+	out := make([]provider.Manifest, 0, len(manifests))
+	for _, m := range manifests {
+		if !strings.HasPrefix(m.Key.APIVersion, apiVersionPrefix) || m.Key.Kind != kind {
+			continue
+		}
+		if name != "" && m.Key.Name != name {
+			continue
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
+// backendLists returns the lists of the weighted backends in the given spec of a traffic splitting resource
+// and the field holding the name of the backend Service.
+// The returned lists share the underlying data with the spec.
+func backendLists(m provider.Manifest, spec map[string]interface{}) ([][]interface{}, string, error) {
+	switch m.Key.Kind {
+	case httpRouteKind:
+		rules, _ := spec["rules"].([]interface{})
+		lists := make([][]interface{}, 0, len(rules))
+		for _, r := range rules {
+			rule, ok := r.(map[string]interface{})
+			if !ok {
+				return nil, "", fmt.Errorf("malformed rule in HTTPRoute %s", m.Key.Name)
+			}
+			backends, _ := rule["backendRefs"].([]interface{})
+			lists = append(lists, backends)
+		}
+		return lists, "name", nil
+
+	case trafficSplitKind:
+		// The weight of v1alpha1 is a quantity string which is not supported here.
+		if m.Key.APIVersion == smiSplitGroupPrefix+"v1alpha1" {
+			return nil, "", fmt.Errorf("support only v1alpha2 or later TrafficSplit but got %s", m.Key.APIVersion)
+		}
+		backends, _ := spec["backends"].([]interface{})
+		return [][]interface{}{backends}, "service", nil
+
+	default:
+		return nil, "", fmt.Errorf("unsupported traffic splitting resource %s", m.Key.ReadableString())
+	}
+}
+
+// setBackendLists writes the given lists of backends back to the spec returned by backendLists.
+func setBackendLists(m provider.Manifest, spec map[string]interface{}, lists [][]interface{}) {
+	switch m.Key.Kind {
+	case httpRouteKind:
+		rules, _ := spec["rules"].([]interface{})
+		for i, r := range rules {
+			r.(map[string]interface{})["backendRefs"] = lists[i]
+		}
+	case trafficSplitKind:
+		spec["backends"] = lists[0]
+	}
+}
+
+func backendWeight(backend map[string]interface{}) int64 {
+	switch w := backend["weight"].(type) {
+	case int64:
+		return w
+	case float64:
+		return int64(w)
+	case int:
+		return int64(w)
+	default:
+		return defaultBackendWeight
+	}
+}
+
+// isServiceBackend reports whether the given backend references a Service.
+// The backendRefs of HTTPRoute can reference the other kinds of resources.
+func isServiceBackend(backend map[string]interface{}) bool {
+	kind, _ := backend["kind"].(string)
+	return kind == "" || kind == provider.KindService
+}
+
+// generateWeightedBackendsManifest generates the traffic splitting manifest routing the given percentages
+// of the traffic for the given Services to their CANARY and BASELINE variants.
+// The backends of the other Services are kept as is, and the variants share the rest of the weight of 100.
+func (e *deployExecutor) generateWeightedBackendsManifest(m provider.Manifest, serviceNames map[string]struct{}, canaryPercent, baselinePercent int64) (provider.Manifest, error) {
+	// Because the loaded manifests are read-only
+	// so we duplicate them to avoid updating the shared manifests data in cache.
+	m = duplicateManifest(m, "")
+
+	spec, err := m.GetNestedMap("spec")
+	if err != nil {
+		return m, err
+	}
+	lists, nameField, err := backendLists(m, spec)
+	if err != nil {
+		return m, err
+	}
+
+	for i, backends := range lists {
+		var (
+			variantBackend map[string]interface{}
+			otherWeight    int64
+			otherBackends  = make([]interface{}, 0, len(backends))
+		)
+		for _, b := range backends {
+			backend, ok := b.(map[string]interface{})
+			if !ok {
+				return m, fmt.Errorf("malformed backend in %s", m.Key.ReadableString())
+			}
+			name, _ := backend[nameField].(string)
+			if _, ok := serviceNames[name]; ok && isServiceBackend(backend) {
+				if variantBackend == nil {
+					variantBackend = backend
+				}
+				continue
+			}
+			otherWeight += backendWeight(backend)
+			otherBackends = append(otherBackends, backend)
+		}
+		if variantBackend == nil {
+			continue
+		}
+
+		var (
+			serviceName    = variantBackend[nameField].(string)
+			variantsWeight = 100 - otherWeight
+			canaryWeight   = canaryPercent * variantsWeight / 100
+			baselineWeight = baselinePercent * variantsWeight / 100
+			primaryWeight  = variantsWeight - canaryWeight - baselineWeight
+			routes         = make([]interface{}, 0, len(otherBackends)+3)
+		)
+		makeBackend := func(variant string, weight int64) map[string]interface{} {
+			backend := make(map[string]interface{}, len(variantBackend))
+			for k, v := range variantBackend {
+				backend[k] = v
+			}
+			backend[nameField] = makeSuffixedName(serviceName, variant)
+			backend["weight"] = weight
+			return backend
+		}
+
+		// The Service of application is used as the backend of PRIMARY variant.
+		routes = append(routes, makeBackend("", primaryWeight))
+		if canaryWeight > 0 {
+			routes = append(routes, makeBackend(e.appCfg.VariantLabel.CanaryValue, canaryWeight))
+		}
+		if baselineWeight > 0 {
+			routes = append(routes, makeBackend(e.appCfg.VariantLabel.BaselineValue, baselineWeight))
+		}
+		lists[i] = append(routes, otherBackends...)
+	}
+
+	setBackendLists(m, spec, lists)
+	if err := m.SetNestedField(spec, "spec"); err != nil {
+		return m, err
+	}
+	return m, nil
+}
+
+// weightedBackendsCanaryWeight returns the largest percentage of the traffic routed to
+// the CANARY Services, which are suffixed with the CANARY variant name, by the given manifest.
+func weightedBackendsCanaryWeight(m provider.Manifest, canaryVariant string) (int32, error) {
+	spec, err := m.GetNestedMap("spec")
+	if err != nil {
+		return 0, err
+	}
+	lists, nameField, err := backendLists(m, spec)
+	if err != nil {
+		return 0, err
+	}
+
+	var max int32
+	for _, backends := range lists {
+		var total, canary int64
+		for _, b := range backends {
+			backend, ok := b.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			weight := backendWeight(backend)
+			total += weight
+			name, _ := backend[nameField].(string)
+			if isServiceBackend(backend) && strings.HasSuffix(name, "-"+canaryVariant) {
+				canary += weight
+			}
+		}
+		if total == 0 {
+			continue
+		}
+		if w := int32(canary * 100 / total); w > max {
+			max = w
+		}
+	}
+	return max, nil
+}
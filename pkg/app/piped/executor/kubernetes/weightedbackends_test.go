@@ -0,0 +1,242 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const weightedBackendsTestManifests = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: helloworld
+spec:
+  parentRefs:
+  - name: gateway
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /
+    backendRefs:
+    - name: helloworld
+      port: 9085
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /static
+    backendRefs:
+    - name: helloworld
+      port: 9085
+      weight: 80
+    - name: static
+      port: 80
+      weight: 20
+---
+apiVersion: split.smi-spec.io/v1alpha4
+kind: TrafficSplit
+metadata:
+  name: helloworld
+spec:
+  service: helloworld
+  backends:
+  - service: helloworld
+    weight: 100
+`
+
+func TestGenerateWeightedBackendsManifest(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := provider.ParseManifests(weightedBackendsTestManifests)
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+
+	e := &deployExecutor{
+		appCfg: &config.KubernetesApplicationSpec{
+			VariantLabel: config.KubernetesVariantLabel{
+				Key:           "pipecd.dev/variant",
+				PrimaryValue:  "primary",
+				BaselineValue: "baseline",
+				CanaryValue:   "canary",
+			},
+		},
+	}
+	services := map[string]struct{}{"helloworld": {}}
+
+	testcases := []struct {
+		name            string
+		manifest        provider.Manifest
+		canaryPercent   int64
+		baselinePercent int64
+		expected        string
+		expectedWeight  int32
+	}{
+		{
+			name:            "HTTPRoute",
+			manifest:        manifests[0],
+			canaryPercent:   30,
+			baselinePercent: 10,
+			expected: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: helloworld
+spec:
+  parentRefs:
+  - name: gateway
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /
+    backendRefs:
+    - name: helloworld
+      port: 9085
+      weight: 60
+    - name: helloworld-canary
+      port: 9085
+      weight: 30
+    - name: helloworld-baseline
+      port: 9085
+      weight: 10
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /static
+    backendRefs:
+    - name: helloworld
+      port: 9085
+      weight: 48
+    - name: helloworld-canary
+      port: 9085
+      weight: 24
+    - name: helloworld-baseline
+      port: 9085
+      weight: 8
+    - name: static
+      port: 80
+      weight: 20
+`,
+			expectedWeight: 30,
+		},
+		{
+			name:          "TrafficSplit",
+			manifest:      manifests[1],
+			canaryPercent: 50,
+			expected: `
+apiVersion: split.smi-spec.io/v1alpha4
+kind: TrafficSplit
+metadata:
+  name: helloworld
+spec:
+  service: helloworld
+  backends:
+  - service: helloworld
+    weight: 50
+  - service: helloworld-canary
+    weight: 50
+`,
+			expectedWeight: 50,
+		},
+		{
+			name:     "all to primary",
+			manifest: manifests[1],
+			expected: `
+apiVersion: split.smi-spec.io/v1alpha4
+kind: TrafficSplit
+metadata:
+  name: helloworld
+spec:
+  service: helloworld
+  backends:
+  - service: helloworld
+    weight: 100
+`,
+			expectedWeight: 0,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := e.generateWeightedBackendsManifest(tc.manifest, services, tc.canaryPercent, tc.baselinePercent)
+			require.NoError(t, err)
+
+			expected, err := provider.ParseManifests(tc.expected)
+			require.NoError(t, err)
+			require.Len(t, expected, 1)
+
+			gotBytes, err := got.YamlBytes()
+			require.NoError(t, err)
+			expectedBytes, err := expected[0].YamlBytes()
+			require.NoError(t, err)
+			assert.Equal(t, string(expectedBytes), string(gotBytes))
+
+			weight, err := weightedBackendsCanaryWeight(got, "canary")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedWeight, weight)
+		})
+	}
+}
+
+func TestFindWeightedBackendsManifests(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := provider.ParseManifests(weightedBackendsTestManifests)
+	require.NoError(t, err)
+
+	got, err := findGatewayAPIHTTPRouteManifests(manifests, config.K8sResourceReference{Name: "helloworld"})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "HTTPRoute", got[0].Key.Kind)
+
+	got, err = findSMITrafficSplitManifests(manifests, config.K8sResourceReference{})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "TrafficSplit", got[0].Key.Kind)
+
+	_, err = findSMITrafficSplitManifests(manifests, config.K8sResourceReference{Kind: "Service"})
+	assert.Error(t, err)
+}
+
+func TestWeightedBackendsUnsupportedTrafficSplitVersion(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := provider.ParseManifests(`
+apiVersion: split.smi-spec.io/v1alpha1
+kind: TrafficSplit
+metadata:
+  name: helloworld
+spec:
+  service: helloworld
+  backends:
+  - service: helloworld
+    weight: 1000m
+`)
+	require.NoError(t, err)
+	require.Len(t, manifests, 1)
+
+	e := &deployExecutor{appCfg: &config.KubernetesApplicationSpec{}}
+	_, err = e.generateWeightedBackendsManifest(manifests[0], map[string]struct{}{"helloworld": {}}, 50, 0)
+	assert.Error(t, err)
+}
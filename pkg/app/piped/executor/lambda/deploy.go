@@ -87,6 +87,9 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 	if !sync(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, fm) {
 		return model.StageStatus_STAGE_FAILURE
 	}
+	if r := e.appCfg.Input.VersionRetention; r != nil {
+		pruneVersions(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, fm, r.Keep)
+	}
 
 	return model.StageStatus_STAGE_SUCCESS
 }
@@ -112,6 +115,10 @@ func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
 	if !promote(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, fm) {
 		return model.StageStatus_STAGE_FAILURE
 	}
+	// Prune the old versions only when the new version receives all traffic.
+	if r := e.appCfg.Input.VersionRetention; r != nil && options.Percent.Int() == 100 {
+		pruneVersions(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, fm, r.Keep)
+	}
 
 	return model.StageStatus_STAGE_SUCCESS
 }
@@ -23,6 +23,9 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
@@ -82,6 +85,16 @@ func loadFunctionManifest(in *executor.Input, functionManifestFile string, ds *d
 		return provider.FunctionManifest{}, false
 	}
 
+	if fm.Spec.Tags == nil {
+		fm.Spec.Tags = make(map[string]string)
+	}
+	if cp, ok := in.PipedConfig.FindPlatformProvider(in.Application.PlatformProvider, model.ApplicationKind_LAMBDA); ok && cp.LambdaConfig != nil {
+		for k, v := range in.ResolveResourceTags(cp.LambdaConfig.DefaultTags, in.Deployment.CommitHash()) {
+			if _, ok := fm.Spec.Tags[k]; !ok {
+				fm.Spec.Tags[k] = v
+			}
+		}
+	}
 	fm.Spec.Tags[provider.LabelManagedBy] = provider.ManagedByPiped
 	fm.Spec.Tags[provider.LabelPiped] = in.PipedConfig.PipedID
 	fm.Spec.Tags[provider.LabelApplication] = in.Deployment.ApplicationId
@@ -285,6 +298,76 @@ func configureTrafficRouting(trafficCfg provider.RoutingTrafficConfig, version s
 	return true
 }
 
+// pruneVersions deletes the published versions of the function except for the latest keep ones
+// and the ones referenced by the aliases. Failing to prune does not fail the deployment.
+func pruneVersions(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderLambdaConfig, fm provider.FunctionManifest, keep int) {
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to create Lambda client for the provider %s to prune old versions: %v", platformProviderName, err)
+		return
+	}
+
+	versions, err := client.ListFunctionVersions(ctx, fm.Spec.Name)
+	if err != nil {
+		in.LogPersister.Errorf("Skipped pruning old versions of Lambda function %s: %v", fm.Spec.Name, err)
+		return
+	}
+	aliasVersions, err := client.ListAliasVersions(ctx, fm.Spec.Name)
+	if err != nil {
+		in.LogPersister.Errorf("Skipped pruning old versions of Lambda function %s: %v", fm.Spec.Name, err)
+		return
+	}
+	referenced := make(map[string]struct{}, len(aliasVersions))
+	for _, v := range aliasVersions {
+		referenced[v] = struct{}{}
+	}
+
+	pruning := versionsToPrune(versions, keep, referenced)
+	if len(pruning) == 0 {
+		in.LogPersister.Infof("No old version of Lambda function %s to prune (keep: %d)", fm.Spec.Name, keep)
+		return
+	}
+
+	in.LogPersister.Infof("Start pruning %d old versions of Lambda function %s (keep: %d)", len(pruning), fm.Spec.Name, keep)
+	pruned := make([]string, 0, len(pruning))
+	for _, v := range pruning {
+		if err := client.DeleteFunctionVersion(ctx, fm.Spec.Name, v); err != nil {
+			in.LogPersister.Errorf("Failed to prune version %s of Lambda function %s: %v", v, fm.Spec.Name, err)
+			continue
+		}
+		pruned = append(pruned, v)
+	}
+	in.LogPersister.Infof("Pruned %d versions of Lambda function %s: %s", len(pruned), fm.Spec.Name, strings.Join(pruned, ", "))
+}
+
+// versionsToPrune returns the versions older than the latest keep ones, excluding the referenced ones.
+// The versions are returned from the oldest one.
+func versionsToPrune(versions []string, keep int, referenced map[string]struct{}) []string {
+	numbers := make([]int, 0, len(versions))
+	for _, v := range versions {
+		// Published versions are always numbers.
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			continue
+		}
+		numbers = append(numbers, n)
+	}
+	if len(numbers) <= keep {
+		return nil
+	}
+	sort.Ints(numbers)
+
+	var pruning []string
+	for _, n := range numbers[:len(numbers)-keep] {
+		v := strconv.Itoa(n)
+		if _, ok := referenced[v]; ok {
+			continue
+		}
+		pruning = append(pruning, v)
+	}
+	return pruning
+}
+
 func build(ctx context.Context, in *executor.Input, client provider.Client, fm provider.FunctionManifest) (version string, ok bool) {
 	found, err := client.IsFunctionExist(ctx, fm.Spec.Name)
 	if err != nil {
@@ -188,3 +188,52 @@ func TestPrepareZipFromSource(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotEqual(t, 0, len(data))
 }
+
+func TestVersionsToPrune(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		versions   []string
+		keep       int
+		referenced []string
+		expected   []string
+	}{
+		{
+			name:     "fewer versions than keep",
+			versions: []string{"1", "2"},
+			keep:     3,
+		},
+		{
+			name:     "prune older versions",
+			versions: []string{"10", "9", "2", "1", "11"},
+			keep:     2,
+			expected: []string{"1", "2", "9"},
+		},
+		{
+			name:       "keep referenced versions",
+			versions:   []string{"1", "2", "3", "4", "5"},
+			keep:       2,
+			referenced: []string{"2", "5"},
+			expected:   []string{"1", "3"},
+		},
+		{
+			name:     "ignore non published versions",
+			versions: []string{"$LATEST", "1", "2"},
+			keep:     1,
+			expected: []string{"1"},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			referenced := make(map[string]struct{}, len(tc.referenced))
+			for _, v := range tc.referenced {
+				referenced[v] = struct{}{}
+			}
+			got := versionsToPrune(tc.versions, tc.keep, referenced)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
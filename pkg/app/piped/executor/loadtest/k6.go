@@ -0,0 +1,120 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// k6Runner runs the k6 script stored in the application directory.
+// See https://grafana.com/docs/k6/latest/
+type k6Runner struct {
+	opts         *config.LoadTestStageOptions
+	envs         []string
+	logPersister executor.LogPersister
+}
+
+func (r *k6Runner) Run(ctx context.Context, appDir string) (*summary, error) {
+	dir, err := os.MkdirTemp("", "k6-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	summaryFile := filepath.Join(dir, "summary.json")
+
+	args := []string{
+		"run",
+		"--duration", r.opts.Duration.Duration().String(),
+		"--vus", strconv.Itoa(r.opts.VUs),
+		"--summary-trend-stats", "avg,max,p(95),p(99)",
+		"--summary-export", summaryFile,
+		r.opts.Script,
+	}
+	cmd := exec.CommandContext(ctx, "k6", args...)
+	cmd.Dir = appDir
+	cmd.Env = r.envs
+	cmd.Stdout = r.logPersister
+	cmd.Stderr = r.logPersister
+	var thresholdsCrossed bool
+	if err := cmd.Run(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		// k6 exits with 99 when the thresholds defined in the script were crossed.
+		// The summary is still exported in that case, so it is saved and evaluated by the stage thresholds as well
+		// but the stage fails regardless of them.
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) || exitErr.ExitCode() != k6ThresholdsFailedExitCode {
+			return nil, fmt.Errorf("failed to run k6: %w", err)
+		}
+		thresholdsCrossed = true
+	}
+
+	data, err := os.ReadFile(summaryFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the k6 summary: %w", err)
+	}
+	s, err := parseK6Summary(data)
+	if err != nil {
+		return nil, err
+	}
+	s.ScriptThresholdsCrossed = thresholdsCrossed
+	return s, nil
+}
+
+// k6ThresholdsFailedExitCode is the exit code of k6 when some thresholds were crossed.
+const k6ThresholdsFailedExitCode = 99
+
+// parseK6Summary parses the summary exported by the --summary-export flag of k6.
+func parseK6Summary(data []byte) (*summary, error) {
+	var s struct {
+		Metrics struct {
+			HTTPReqDuration map[string]float64 `json:"http_req_duration"`
+			HTTPReqFailed   map[string]float64 `json:"http_req_failed"`
+			HTTPReqs        map[string]float64 `json:"http_reqs"`
+		} `json:"metrics"`
+	}
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("failed to parse the k6 summary: %w", err)
+	}
+	if s.Metrics.HTTPReqs == nil {
+		return nil, fmt.Errorf("no HTTP request was sent by the k6 script")
+	}
+
+	// The trend metrics of k6 are in milliseconds.
+	ms := func(v float64) time.Duration {
+		return time.Duration(v * float64(time.Millisecond))
+	}
+	return &summary{
+		Requests:          int64(s.Metrics.HTTPReqs["count"]),
+		RequestsPerSecond: s.Metrics.HTTPReqs["rate"],
+		ErrorRate:         s.Metrics.HTTPReqFailed["value"],
+		LatencyAvg:        ms(s.Metrics.HTTPReqDuration["avg"]),
+		LatencyP95:        ms(s.Metrics.HTTPReqDuration["p(95)"]),
+		LatencyP99:        ms(s.Metrics.HTTPReqDuration["p(99)"]),
+		LatencyMax:        ms(s.Metrics.HTTPReqDuration["max"]),
+	}, nil
+}
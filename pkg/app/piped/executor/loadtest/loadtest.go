@@ -0,0 +1,212 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// The keys of the stage metadata used to save the summary of the load test.
+const (
+	requestsKey          = "LoadTestRequests"
+	requestsPerSecondKey = "LoadTestRequestsPerSecond"
+	errorRateKey         = "LoadTestErrorRate"
+	latencyAvgKey        = "LoadTestLatencyAvg"
+	latencyP95Key        = "LoadTestLatencyP95"
+	latencyP99Key        = "LoadTestLatencyP99"
+	latencyMaxKey        = "LoadTestLatencyMax"
+)
+
+type Executor struct {
+	executor.Input
+}
+
+type registerer interface {
+	Register(stage model.Stage, f executor.Factory) error
+}
+
+// Register registers this executor factory into a given registerer.
+func Register(r registerer) {
+	f := func(in executor.Input) executor.Executor {
+		return &Executor{
+			Input: in,
+		}
+	}
+	r.Register(model.StageLoadTest, f)
+}
+
+// summary is the result of a load test.
+type summary struct {
+	Requests          int64
+	RequestsPerSecond float64
+	// The ratio of the failed requests, between 0 and 1.
+	ErrorRate  float64
+	LatencyAvg time.Duration
+	LatencyP95 time.Duration
+	LatencyP99 time.Duration
+	LatencyMax time.Duration
+	// Whether the thresholds defined in the load test script were crossed.
+	// Only k6 supports defining thresholds in the script.
+	ScriptThresholdsCrossed bool
+}
+
+// runner runs a load test by using a specific tool.
+type runner interface {
+	// Run runs the load test in the given application directory and returns its summary.
+	Run(ctx context.Context, appDir string) (*summary, error)
+}
+
+// Execute runs the configured load test and decides the stage status
+// based on whether its summary satisfies the thresholds.
+func (e *Executor) Execute(sig executor.StopSignal) model.StageStatus {
+	opts := e.StageConfig.LoadTestStageOptions
+	if opts == nil {
+		e.LogPersister.Error("option for load test stage not found")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	originalStatus := e.Stage.Status
+	ds, err := e.TargetDSP.Get(sig.Context(), e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	r, err := newRunner(opts, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare the load test (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// The running load test will be killed when this stage was finished.
+	ctx, cancel := context.WithTimeout(sig.Context(), opts.Timeout.Duration())
+	defer cancel()
+
+	type result struct {
+		summary *summary
+		err     error
+	}
+	c := make(chan result, 1)
+	go func() {
+		e.LogPersister.Infof("Start sending load to %s for %v by using %s", opts.Target, opts.Duration.Duration(), opts.Tool)
+		s, err := r.Run(ctx, ds.AppDir)
+		c <- result{summary: s, err: err}
+	}()
+
+	select {
+	case res := <-c:
+		if errors.Is(res.err, context.DeadlineExceeded) {
+			e.LogPersister.Errorf("Canceled because of timeout")
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if res.err != nil {
+			e.LogPersister.Errorf("Failed to run the load test (%v)", res.err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		return e.handleSummary(sig.Context(), res.summary, opts.Thresholds)
+
+	case s := <-sig.Ch():
+		switch s {
+		case executor.StopSignalCancel:
+			e.LogPersister.Info("Canceled by user")
+			return model.StageStatus_STAGE_CANCELLED
+		case executor.StopSignalTerminate:
+			e.LogPersister.Info("Terminated by system")
+			return originalStatus
+		default:
+			e.LogPersister.Error("Unexpected")
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+}
+
+func (e *Executor) handleSummary(ctx context.Context, s *summary, thresholds config.LoadTestThresholds) model.StageStatus {
+	e.LogPersister.Infof("Load test summary: requests=%d, rps=%.2f, error rate=%.2f%%, latency avg=%v p95=%v p99=%v max=%v",
+		s.Requests, s.RequestsPerSecond, s.ErrorRate*100, s.LatencyAvg, s.LatencyP95, s.LatencyP99, s.LatencyMax)
+
+	if err := e.MetadataStore.Stage(e.Stage.Id).PutMulti(ctx, s.metadata()); err != nil {
+		e.LogPersister.Errorf("Unable to save the load test summary to deployment, %v", err)
+	}
+
+	violations := checkThresholds(s, thresholds)
+	if len(violations) > 0 {
+		for _, v := range violations {
+			e.LogPersister.Error(v)
+		}
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Success("All thresholds of the load test were satisfied")
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (s *summary) metadata() map[string]string {
+	return map[string]string{
+		requestsKey:          strconv.FormatInt(s.Requests, 10),
+		requestsPerSecondKey: strconv.FormatFloat(s.RequestsPerSecond, 'f', 2, 64),
+		errorRateKey:         strconv.FormatFloat(s.ErrorRate*100, 'f', 2, 64) + "%",
+		latencyAvgKey:        s.LatencyAvg.String(),
+		latencyP95Key:        s.LatencyP95.String(),
+		latencyP99Key:        s.LatencyP99.String(),
+		latencyMaxKey:        s.LatencyMax.String(),
+	}
+}
+
+// checkThresholds returns the messages describing the thresholds which the given summary violates.
+func checkThresholds(s *summary, t config.LoadTestThresholds) []string {
+	var violations []string
+	if s.ScriptThresholdsCrossed {
+		violations = append(violations, "Some thresholds defined in the load test script were crossed")
+	}
+	if max := t.P95Latency.Duration(); max > 0 && s.LatencyP95 > max {
+		violations = append(violations, fmt.Sprintf("The 95th percentile latency %v exceeded the threshold %v", s.LatencyP95, max))
+	}
+	if max := t.P99Latency.Duration(); max > 0 && s.LatencyP99 > max {
+		violations = append(violations, fmt.Sprintf("The 99th percentile latency %v exceeded the threshold %v", s.LatencyP99, max))
+	}
+	if t.ErrorRate != nil && s.ErrorRate*100 > *t.ErrorRate {
+		violations = append(violations, fmt.Sprintf("The error rate %.2f%% exceeded the threshold %.2f%%", s.ErrorRate*100, *t.ErrorRate))
+	}
+	return violations
+}
+
+func newRunner(opts *config.LoadTestStageOptions, lp executor.LogPersister) (runner, error) {
+	envs := make([]string, 0, len(opts.Env)+1)
+	if opts.Target != "" {
+		envs = append(envs, "TARGET="+opts.Target)
+	}
+	for k, v := range opts.Env {
+		envs = append(envs, k+"="+v)
+	}
+	envs = append(os.Environ(), envs...)
+
+	switch opts.Tool {
+	case config.LoadTestToolK6:
+		return &k6Runner{opts: opts, envs: envs, logPersister: lp}, nil
+	case config.LoadTestToolVegeta:
+		return &vegetaRunner{opts: opts, envs: envs, logPersister: lp}, nil
+	default:
+		return nil, fmt.Errorf("unsupported tool %q", opts.Tool)
+	}
+}
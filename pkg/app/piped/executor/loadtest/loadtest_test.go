@@ -0,0 +1,146 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadtest
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestParseSummary(t *testing.T) {
+	t.Parallel()
+
+	expected := &summary{
+		Requests:          600,
+		ErrorRate:         0.005,
+		LatencyAvg:        52500 * time.Microsecond,
+		LatencyP95:        120500 * time.Microsecond,
+		LatencyP99:        300 * time.Millisecond,
+		LatencyMax:        480250 * time.Microsecond,
+		RequestsPerSecond: 9.98,
+	}
+
+	t.Run("k6", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := os.ReadFile("testdata/k6-summary.json")
+		require.NoError(t, err)
+
+		got, err := parseK6Summary(data)
+		require.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+
+	t.Run("vegeta", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := os.ReadFile("testdata/vegeta-report.json")
+		require.NoError(t, err)
+
+		got, err := parseVegetaReport(data)
+		require.NoError(t, err)
+		assert.Equal(t, expected.Requests, got.Requests)
+		assert.Equal(t, 10.0, got.RequestsPerSecond)
+		assert.InDelta(t, expected.ErrorRate, got.ErrorRate, 1e-9)
+		assert.Equal(t, expected.LatencyAvg, got.LatencyAvg)
+		assert.Equal(t, expected.LatencyP95, got.LatencyP95)
+		assert.Equal(t, expected.LatencyP99, got.LatencyP99)
+		assert.Equal(t, expected.LatencyMax, got.LatencyMax)
+	})
+
+	t.Run("no request", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseK6Summary([]byte(`{"metrics": {}}`))
+		assert.Error(t, err)
+		_, err = parseVegetaReport([]byte(`{"requests": 0}`))
+		assert.Error(t, err)
+	})
+}
+
+func TestCheckThresholds(t *testing.T) {
+	t.Parallel()
+
+	rate := func(v float64) *float64 { return &v }
+	s := &summary{
+		Requests:   600,
+		ErrorRate:  0.005,
+		LatencyP95: 120 * time.Millisecond,
+		LatencyP99: 300 * time.Millisecond,
+	}
+
+	testcases := []struct {
+		name       string
+		summary    *summary
+		thresholds config.LoadTestThresholds
+		expected   int
+	}{
+		{
+			name: "no threshold",
+		},
+		{
+			name: "all satisfied",
+			thresholds: config.LoadTestThresholds{
+				P95Latency: config.Duration(200 * time.Millisecond),
+				P99Latency: config.Duration(500 * time.Millisecond),
+				ErrorRate:  rate(1),
+			},
+		},
+		{
+			name: "latency exceeded",
+			thresholds: config.LoadTestThresholds{
+				P95Latency: config.Duration(100 * time.Millisecond),
+				P99Latency: config.Duration(200 * time.Millisecond),
+			},
+			expected: 2,
+		},
+		{
+			name: "no error is allowed",
+			thresholds: config.LoadTestThresholds{
+				ErrorRate: rate(0),
+			},
+			expected: 1,
+		},
+		{
+			name: "thresholds in the script were crossed",
+			summary: &summary{
+				Requests:                600,
+				ScriptThresholdsCrossed: true,
+			},
+			thresholds: config.LoadTestThresholds{
+				ErrorRate: rate(1),
+			},
+			expected: 1,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := s
+			if tc.summary != nil {
+				s = tc.summary
+			}
+			got := checkThresholds(s, tc.thresholds)
+			assert.Len(t, got, tc.expected)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// vegetaRunner runs vegeta with the targets file stored in the application directory,
+// or with the configured target when no targets file is specified.
+// See https://github.com/tsenart/vegeta
+type vegetaRunner struct {
+	opts         *config.LoadTestStageOptions
+	envs         []string
+	logPersister executor.LogPersister
+}
+
+func (r *vegetaRunner) Run(ctx context.Context, appDir string) (*summary, error) {
+	dir, err := os.MkdirTemp("", "vegeta-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	resultsFile := filepath.Join(dir, "results.bin")
+
+	args := []string{
+		"attack",
+		"-duration", r.opts.Duration.Duration().String(),
+		"-rate", strconv.Itoa(r.opts.Rate),
+		"-output", resultsFile,
+	}
+	attack := exec.CommandContext(ctx, "vegeta", args...)
+	if r.opts.Script != "" {
+		attack.Args = append(attack.Args, "-targets", r.opts.Script)
+	} else {
+		attack.Stdin = strings.NewReader(fmt.Sprintf("GET %s\n", r.opts.Target))
+	}
+	attack.Dir = appDir
+	attack.Env = r.envs
+	attack.Stdout = r.logPersister
+	attack.Stderr = r.logPersister
+	if err := attack.Run(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, fmt.Errorf("failed to run vegeta attack: %w", err)
+	}
+
+	var out bytes.Buffer
+	report := exec.CommandContext(ctx, "vegeta", "report", "-type", "json", resultsFile)
+	report.Env = r.envs
+	report.Stdout = &out
+	report.Stderr = r.logPersister
+	if err := report.Run(); err != nil {
+		return nil, fmt.Errorf("failed to run vegeta report: %w", err)
+	}
+	return parseVegetaReport(out.Bytes())
+}
+
+// parseVegetaReport parses the JSON report generated by vegeta.
+func parseVegetaReport(data []byte) (*summary, error) {
+	var r struct {
+		Latencies struct {
+			Mean time.Duration `json:"mean"`
+			P95  time.Duration `json:"95th"`
+			P99  time.Duration `json:"99th"`
+			Max  time.Duration `json:"max"`
+		} `json:"latencies"`
+		Requests int64   `json:"requests"`
+		Rate     float64 `json:"rate"`
+		Success  float64 `json:"success"`
+	}
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("failed to parse the vegeta report: %w", err)
+	}
+	if r.Requests == 0 {
+		return nil, fmt.Errorf("no request was sent by vegeta")
+	}
+
+	return &summary{
+		Requests:          r.Requests,
+		RequestsPerSecond: r.Rate,
+		ErrorRate:         1 - r.Success,
+		LatencyAvg:        r.Latencies.Mean,
+		LatencyP95:        r.Latencies.P95,
+		LatencyP99:        r.Latencies.P99,
+		LatencyMax:        r.Latencies.Max,
+	}, nil
+}
@@ -23,8 +23,10 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/cloudrun"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/customsync"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/ecs"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/externalwait"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/kubernetes"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/lambda"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/loadtest"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/scriptrun"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/terraform"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/wait"
@@ -35,11 +37,21 @@ import (
 type Registry interface {
 	Executor(stage model.Stage, in executor.Input) (executor.Executor, bool)
 	RollbackExecutor(kind model.ApplicationKind, in executor.Input) (executor.Executor, bool)
+	// IsIdempotent reports whether the given stage was declared as idempotent by its executor.
+	// The successful result of an idempotent stage stays valid for the same commit,
+	// so it can be skipped while retrying a failed deployment.
+	IsIdempotent(stage model.Stage) bool
+	// IsDryRunnable reports whether the given stage was declared as dry-runnable by its executor.
+	// A dry-runnable stage replaces its mutations against the platform with dry-run
+	// or read-only calls while executing a dry-run deployment.
+	IsDryRunnable(stage model.Stage) bool
 }
 
 type registry struct {
 	factories         map[model.Stage]executor.Factory
 	rollbackFactories map[model.RollbackKind]executor.Factory
+	idempotentStages  map[model.Stage]struct{}
+	dryRunnableStages map[model.Stage]struct{}
 	mu                sync.RWMutex
 }
 
@@ -65,6 +77,20 @@ func (r *registry) RegisterRollback(kind model.RollbackKind, f executor.Factory)
 	return nil
 }
 
+func (r *registry) MarkIdempotent(stage model.Stage) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.idempotentStages[stage] = struct{}{}
+}
+
+func (r *registry) MarkDryRunnable(stage model.Stage) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.dryRunnableStages[stage] = struct{}{}
+}
+
 func (r *registry) Executor(stage model.Stage, in executor.Input) (executor.Executor, bool) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -93,9 +119,27 @@ func (r *registry) RollbackExecutor(kind model.ApplicationKind, in executor.Inpu
 	return f(in), true
 }
 
+func (r *registry) IsIdempotent(stage model.Stage) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.idempotentStages[stage]
+	return ok
+}
+
+func (r *registry) IsDryRunnable(stage model.Stage) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.dryRunnableStages[stage]
+	return ok
+}
+
 var defaultRegistry = &registry{
 	factories:         make(map[model.Stage]executor.Factory),
 	rollbackFactories: make(map[model.RollbackKind]executor.Factory),
+	idempotentStages:  make(map[model.Stage]struct{}),
+	dryRunnableStages: make(map[model.Stage]struct{}),
 }
 
 func DefaultRegistry() Registry {
@@ -112,6 +156,8 @@ func init() {
 	ecs.Register(defaultRegistry)
 	wait.Register(defaultRegistry)
 	waitapproval.Register(defaultRegistry)
+	externalwait.Register(defaultRegistry)
 	customsync.Register(defaultRegistry)
 	scriptrun.Register(defaultRegistry)
+	loadtest.Register(defaultRegistry)
 }
@@ -15,14 +15,15 @@
 package scriptrun
 
 import (
+	"context"
 	"encoding/json"
 	"os"
-	"os/exec"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/sandbox"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -60,9 +61,13 @@ func (e *Executor) Execute(sig executor.StopSignal) model.StageStatus {
 
 	timeout := e.StageConfig.ScriptRunStageOptions.Timeout.Duration()
 
+	// The running commands will be killed when this stage was finished.
+	ctx, cancel := context.WithCancel(sig.Context())
+	defer cancel()
+
 	c := make(chan model.StageStatus, 1)
 	go func() {
-		c <- e.executeCommand()
+		c <- e.executeCommand(ctx)
 	}()
 
 	timer := time.NewTimer(timeout)
@@ -92,7 +97,7 @@ func (e *Executor) Execute(sig executor.StopSignal) model.StageStatus {
 	}
 }
 
-func (e *Executor) executeCommand() model.StageStatus {
+func (e *Executor) executeCommand(ctx context.Context) model.StageStatus {
 	opts := e.StageConfig.ScriptRunStageOptions
 
 	e.LogPersister.Infof("Runnnig commands...")
@@ -118,12 +123,15 @@ func (e *Executor) executeCommand() model.StageStatus {
 		envs = append(envs, key+"="+value)
 	}
 
-	cmd := exec.Command("/bin/sh", "-l", "-c", opts.Run)
-	cmd.Dir = e.appDir
-	cmd.Env = append(os.Environ(), envs...)
-	cmd.Stdout = e.LogPersister
-	cmd.Stderr = e.LogPersister
-	if err := cmd.Run(); err != nil {
+	cmd := sandbox.Command{
+		Script:    opts.Run,
+		Dir:       e.appDir,
+		Env:       append(os.Environ(), envs...),
+		Stdout:    e.LogPersister,
+		Stderr:    e.LogPersister,
+		Resources: opts.Resources,
+	}
+	if err := sandbox.Run(ctx, cmd); err != nil {
 		e.LogPersister.Errorf("failed to exec command: %w", err)
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -16,6 +16,9 @@ package terraform
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/terraform"
@@ -29,8 +32,12 @@ type deployExecutor struct {
 	repoDir       string
 	appDir        string
 	vars          []string
+	sensitiveVars []string
 	terraformPath string
+	openTofu      provider.Option
+	terragrunt    provider.Option
 	appCfg        *config.TerraformApplicationSpec
+	pluginCache   *config.TerraformPluginCache
 }
 
 func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
@@ -55,17 +62,25 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 	e.repoDir = ds.RepoDir
 	e.appDir = ds.AppDir
 
-	e.vars = make([]string, 0, len(providerCfg.Vars)+len(e.appCfg.Input.Vars))
-	e.vars = append(e.vars, providerCfg.Vars...)
-	e.vars = append(e.vars, e.appCfg.Input.Vars...)
+	e.vars = makeVars(&e.Input, providerCfg, e.appCfg.Input.Vars, e.Deployment.CommitHash())
+	e.pluginCache = providerCfg.PluginCache
+
+	var ok bool
+	e.sensitiveVars, ok = resolveExternalVars(ctx, e.appCfg.Input.ExternalVars, e.LogPersister)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
 
 	var (
 		originalStatus = e.Stage.Status
 		status         model.StageStatus
 	)
 
-	var ok bool
-	e.terraformPath, ok = findTerraform(ctx, e.appCfg.Input.TerraformVersion, e.LogPersister)
+	e.terraformPath, e.openTofu, ok = findTerraform(ctx, e.appCfg.Input, e.LogPersister)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	e.terragrunt, ok = findTerragrunt(ctx, e.appCfg.Input.Terragrunt, e.LogPersister)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -96,9 +111,13 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 			e.terraformPath,
 			e.appDir,
 			provider.WithVars(e.vars),
+			provider.WithSensitiveVars(e.sensitiveVars),
 			provider.WithVarFiles(e.appCfg.Input.VarFiles),
 			provider.WithAdditionalFlags(flags.Shared, flags.Init, flags.Plan, flags.Apply),
 			provider.WithAdditionalEnvs(envs.Shared, envs.Init, envs.Plan, envs.Apply),
+			provider.WithPluginCacheConfig(e.pluginCache),
+			e.openTofu,
+			e.terragrunt,
 		)
 	)
 
@@ -115,25 +134,39 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	planResult, err := cmd.Plan(ctx, e.LogPersister)
-	if err != nil {
-		e.LogPersister.Errorf("Failed to plan (%v)", err)
+	planResult, planFile, cleanup, ok := e.plan(ctx, cmd)
+	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
+	defer cleanup()
+	reportPlanResult(ctx, &e.Input, planResult)
+	uploadPlanOutput(ctx, &e.Input, planResult)
 
 	if planResult.NoChanges() {
 		e.LogPersister.Info("No changes to apply")
 		return model.StageStatus_STAGE_SUCCESS
 	}
 
+	if e.Deployment.Trigger.DryRun {
+		e.LogPersister.Successf("Detected %d import, %d add, %d change, %d destroy. Those changes were not applied since this is a dry-run deployment.", planResult.Imports, planResult.Adds, planResult.Changes, planResult.Destroys)
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
 	e.LogPersister.Infof("Detected %d import, %d add, %d change, %d destroy. Those changes will be applied automatically.", planResult.Imports, planResult.Adds, planResult.Changes, planResult.Destroys)
 
-	if err := cmd.Apply(ctx, e.LogPersister); err != nil {
+	if patterns := e.appCfg.QuickSync.PreventDestroy; len(patterns) > 0 {
+		if ok := e.applyProtectedPlan(ctx, cmd, planFile, patterns); !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	} else if err := cmd.Apply(ctx, e.LogPersister); err != nil {
 		e.LogPersister.Errorf("Failed to apply changes (%v)", err)
 		return model.StageStatus_STAGE_FAILURE
 	}
 
 	e.LogPersister.Success("Successfully applied changes")
+	if ok := captureOutputs(ctx, &e.Input, cmd, e.appCfg.QuickSync.CaptureOutputs); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
 	return model.StageStatus_STAGE_SUCCESS
 }
 
@@ -145,9 +178,13 @@ func (e *deployExecutor) ensurePlan(ctx context.Context) model.StageStatus {
 			e.terraformPath,
 			e.appDir,
 			provider.WithVars(e.vars),
+			provider.WithSensitiveVars(e.sensitiveVars),
 			provider.WithVarFiles(e.appCfg.Input.VarFiles),
 			provider.WithAdditionalFlags(flags.Shared, flags.Init, flags.Plan, flags.Apply),
 			provider.WithAdditionalEnvs(envs.Shared, envs.Init, envs.Plan, envs.Apply),
+			provider.WithPluginCacheConfig(e.pluginCache),
+			e.openTofu,
+			e.terragrunt,
 		)
 	)
 
@@ -164,11 +201,13 @@ func (e *deployExecutor) ensurePlan(ctx context.Context) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	planResult, err := cmd.Plan(ctx, e.LogPersister)
-	if err != nil {
-		e.LogPersister.Errorf("Failed to plan (%v)", err)
+	planResult, planFile, cleanup, ok := e.plan(ctx, cmd)
+	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
+	defer cleanup()
+	reportPlanResult(ctx, &e.Input, planResult)
+	uploadPlanOutput(ctx, &e.Input, planResult)
 
 	if planResult.NoChanges() {
 		e.LogPersister.Success("No changes to apply")
@@ -179,6 +218,9 @@ func (e *deployExecutor) ensurePlan(ctx context.Context) model.StageStatus {
 	}
 
 	e.LogPersister.Successf("Detected %d import, %d add, %d change, %d destroy.", planResult.Imports, planResult.Adds, planResult.Changes, planResult.Destroys)
+	if planFile != "" {
+		reportPlanSummary(ctx, &e.Input, cmd, planFile)
+	}
 	return model.StageStatus_STAGE_SUCCESS
 }
 
@@ -190,9 +232,13 @@ func (e *deployExecutor) ensureApply(ctx context.Context) model.StageStatus {
 			e.terraformPath,
 			e.appDir,
 			provider.WithVars(e.vars),
+			provider.WithSensitiveVars(e.sensitiveVars),
 			provider.WithVarFiles(e.appCfg.Input.VarFiles),
 			provider.WithAdditionalFlags(flags.Shared, flags.Init, flags.Plan, flags.Apply),
 			provider.WithAdditionalEnvs(envs.Shared, envs.Init, envs.Plan, envs.Apply),
+			provider.WithPluginCacheConfig(e.pluginCache),
+			e.openTofu,
+			e.terragrunt,
 		)
 	)
 
@@ -209,11 +255,105 @@ func (e *deployExecutor) ensureApply(ctx context.Context) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	if err := cmd.Apply(ctx, e.LogPersister); err != nil {
+	// Only plan the changes instead of applying them in a dry-run deployment.
+	if e.Deployment.Trigger.DryRun {
+		planResult, err := cmd.Plan(ctx, e.LogPersister)
+		if err != nil {
+			e.LogPersister.Errorf("Failed to plan (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		e.LogPersister.Successf("Detected %d import, %d add, %d change, %d destroy. Those changes were not applied since this is a dry-run deployment.", planResult.Imports, planResult.Adds, planResult.Changes, planResult.Destroys)
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	opts := e.StageConfig.TerraformApplyStageOptions
+
+	// The changes must be planned to check them before applying when some resources are protected.
+	if patterns := opts.PreventDestroy; len(patterns) > 0 {
+		planFile, cleanup, err := newPlanFile()
+		if err != nil {
+			e.LogPersister.Errorf("Failed to prepare the file to save the plan (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		defer cleanup()
+
+		planResult, err := cmd.SavePlan(ctx, e.LogPersister, planFile)
+		if err != nil {
+			e.LogPersister.Errorf("Failed to plan (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if planResult.NoChanges() {
+			e.LogPersister.Success("No changes to apply")
+			return model.StageStatus_STAGE_SUCCESS
+		}
+		if ok := e.applyProtectedPlan(ctx, cmd, planFile, patterns); !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	} else if err := cmd.Apply(ctx, e.LogPersister); err != nil {
 		e.LogPersister.Errorf("Failed to apply changes (%v)", err)
 		return model.StageStatus_STAGE_FAILURE
 	}
 
 	e.LogPersister.Success("Successfully applied changes")
+	if ok := captureOutputs(ctx, &e.Input, cmd, opts.CaptureOutputs); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
 	return model.StageStatus_STAGE_SUCCESS
 }
+
+// applyProtectedPlan applies the saved plan unless it destroys or replaces any resource matching the given patterns.
+// The saved plan is applied instead of planning again so that only the checked changes are applied.
+func (e *deployExecutor) applyProtectedPlan(ctx context.Context, cmd *provider.Terraform, planFile string, patterns []string) bool {
+	summary, err := cmd.ShowPlan(ctx, planFile)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to inspect the plan for the resources protected by preventDestroy (%v)", err)
+		return false
+	}
+	if protected := summary.FindProtected(patterns); len(protected) > 0 {
+		e.LogPersister.Errorf("Stopped applying since the plan destroys or replaces the following resources protected by preventDestroy: %s", strings.Join(protected, ", "))
+		return false
+	}
+
+	if err := cmd.ApplyPlan(ctx, e.LogPersister, planFile); err != nil {
+		e.LogPersister.Errorf("Failed to apply changes (%v)", err)
+		return false
+	}
+	return true
+}
+
+// plan plans the changes and saves the plan into a temporary file to inspect it later.
+// The plan is not saved when all modules are planned by terragrunt run-all,
+// so the returned file is empty then.
+func (e *deployExecutor) plan(ctx context.Context, cmd *provider.Terraform) (provider.PlanResult, string, func(), bool) {
+	if tg := e.appCfg.Input.Terragrunt; tg != nil && tg.RunAll {
+		result, err := cmd.Plan(ctx, e.LogPersister)
+		if err != nil {
+			e.LogPersister.Errorf("Failed to plan (%v)", err)
+			return provider.PlanResult{}, "", nil, false
+		}
+		return result, "", func() {}, true
+	}
+
+	planFile, cleanup, err := newPlanFile()
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare the file to save the plan (%v)", err)
+		return provider.PlanResult{}, "", nil, false
+	}
+	result, err := cmd.SavePlan(ctx, e.LogPersister, planFile)
+	if err != nil {
+		cleanup()
+		e.LogPersister.Errorf("Failed to plan (%v)", err)
+		return provider.PlanResult{}, "", nil, false
+	}
+	return result, planFile, cleanup, true
+}
+
+// newPlanFile returns the path to save a plan in a new temporary directory,
+// and the function to remove the directory.
+func newPlanFile() (string, func(), error) {
+	dir, err := os.MkdirTemp("", "terraform-plan-*")
+	if err != nil {
+		return "", nil, err
+	}
+	return filepath.Join(dir, "plan.tfplan"), func() { os.RemoveAll(dir) }, nil
+}
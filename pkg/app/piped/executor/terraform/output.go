@@ -0,0 +1,91 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/terraform"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// outputMetadataKeyPrefix is the prefix of the deployment metadata keys holding the captured outputs.
+const outputMetadataKeyPrefix = "terraform-output."
+
+// captureOutputs saves the values of the given terraform outputs into the deployment metadata
+// and registers the events configured for them.
+// It returns false when any of them could not be captured.
+func captureOutputs(ctx context.Context, in *executor.Input, cmd *provider.Terraform, captures []config.TerraformOutputCapture) bool {
+	if len(captures) == 0 {
+		return true
+	}
+
+	outputs, err := cmd.Outputs(ctx)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to get the terraform outputs (%v)", err)
+		return false
+	}
+
+	values, ok := capturedOutputValues(in.LogPersister, outputs, captures)
+	if !ok {
+		return false
+	}
+
+	metadata := make(map[string]string, len(values))
+	for name, value := range values {
+		metadata[outputMetadataKeyPrefix+name] = value
+	}
+	if err := in.MetadataStore.Shared().PutMulti(ctx, metadata); err != nil {
+		in.LogPersister.Errorf("Failed to save the terraform outputs to the deployment metadata (%v)", err)
+		return false
+	}
+	in.LogPersister.Infof("Captured %d terraform output(s) into the deployment metadata", len(values))
+
+	for _, c := range captures {
+		if c.EventName == "" {
+			continue
+		}
+		if in.EventRegisterer == nil {
+			in.LogPersister.Errorf("Unable to register event %s for output %s since it is not supported", c.EventName, c.Name)
+			return false
+		}
+		if err := in.EventRegisterer.RegisterEvent(ctx, c.EventName, values[c.Name], c.EventLabels); err != nil {
+			in.LogPersister.Errorf("Failed to register event %s for output %s (%v)", c.EventName, c.Name, err)
+			return false
+		}
+		in.LogPersister.Infof("Registered event %s with the value of output %s", c.EventName, c.Name)
+	}
+	return true
+}
+
+// capturedOutputValues returns the string values of the captured outputs keyed by their names.
+// The sensitive outputs are refused to prevent them from being exposed in the deployment metadata and events.
+func capturedOutputValues(lp executor.LogPersister, outputs map[string]provider.Output, captures []config.TerraformOutputCapture) (map[string]string, bool) {
+	values := make(map[string]string, len(captures))
+	for _, c := range captures {
+		out, ok := outputs[c.Name]
+		if !ok {
+			lp.Errorf("Output %s was not found in the terraform outputs", c.Name)
+			return nil, false
+		}
+		if out.Sensitive {
+			lp.Errorf("Output %s can not be captured since it is marked as sensitive", c.Name)
+			return nil, false
+		}
+		values[c.Name] = out.String()
+	}
+	return values, true
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/terraform"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+type fakeLogPersister struct{}
+
+func (l *fakeLogPersister) Write(_ []byte) (int, error)         { return 0, nil }
+func (l *fakeLogPersister) Info(_ string)                       {}
+func (l *fakeLogPersister) Infof(_ string, _ ...interface{})    {}
+func (l *fakeLogPersister) Success(_ string)                    {}
+func (l *fakeLogPersister) Successf(_ string, _ ...interface{}) {}
+func (l *fakeLogPersister) Error(_ string)                      {}
+func (l *fakeLogPersister) Errorf(_ string, _ ...interface{})   {}
+
+func TestCapturedOutputValues(t *testing.T) {
+	t.Parallel()
+
+	outputs := map[string]provider.Output{
+		"endpoint": {Value: json.RawMessage(`"db.example.com"`)},
+		"port":     {Value: json.RawMessage(`5432`)},
+		"password": {Value: json.RawMessage(`"secret"`), Sensitive: true},
+	}
+
+	testcases := []struct {
+		name     string
+		captures []config.TerraformOutputCapture
+		expected map[string]string
+		ok       bool
+	}{
+		{
+			name:     "captured",
+			captures: []config.TerraformOutputCapture{{Name: "endpoint"}, {Name: "port", EventName: "db-port"}},
+			expected: map[string]string{"endpoint": "db.example.com", "port": "5432"},
+			ok:       true,
+		},
+		{
+			name:     "missing output",
+			captures: []config.TerraformOutputCapture{{Name: "endpoint"}, {Name: "unknown"}},
+		},
+		{
+			name:     "sensitive output",
+			captures: []config.TerraformOutputCapture{{Name: "password"}},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := capturedOutputValues(&fakeLogPersister{}, outputs, tc.captures)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -69,14 +69,20 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	terraformPath, ok := findTerraform(ctx, appCfg.Input.TerraformVersion, e.LogPersister)
+	terraformPath, openTofu, ok := findTerraform(ctx, appCfg.Input, e.LogPersister)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	terragrunt, ok := findTerragrunt(ctx, appCfg.Input.Terragrunt, e.LogPersister)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	vars := make([]string, 0, len(providerCfg.Vars)+len(appCfg.Input.Vars))
-	vars = append(vars, providerCfg.Vars...)
-	vars = append(vars, appCfg.Input.Vars...)
+	vars := makeVars(&e.Input, providerCfg, appCfg.Input.Vars, e.Deployment.RunningCommitHash)
+	sensitiveVars, ok := resolveExternalVars(ctx, appCfg.Input.ExternalVars, e.LogPersister)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
 
 	e.LogPersister.Infof("Start rolling back to the state defined at commit %s", e.Deployment.RunningCommitHash)
 	var (
@@ -86,9 +92,13 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 			terraformPath,
 			ds.AppDir,
 			provider.WithVars(vars),
+			provider.WithSensitiveVars(sensitiveVars),
 			provider.WithVarFiles(appCfg.Input.VarFiles),
 			provider.WithAdditionalFlags(flags.Shared, flags.Init, flags.Plan, flags.Apply),
 			provider.WithAdditionalEnvs(envs.Shared, envs.Init, envs.Plan, envs.Apply),
+			provider.WithPluginCacheConfig(providerCfg.PluginCache),
+			openTofu,
+			terragrunt,
 		)
 	)
 
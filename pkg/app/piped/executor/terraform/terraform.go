@@ -16,6 +16,11 @@ package terraform
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/terraform"
@@ -27,6 +32,7 @@ import (
 type registerer interface {
 	Register(stage model.Stage, f executor.Factory) error
 	RegisterRollback(kind model.RollbackKind, f executor.Factory) error
+	MarkDryRunnable(stage model.Stage)
 }
 
 func Register(r registerer) {
@@ -39,6 +45,11 @@ func Register(r registerer) {
 	r.Register(model.StageTerraformPlan, f)
 	r.Register(model.StageTerraformApply, f)
 
+	// Every stage stops after planning the changes in a dry-run deployment.
+	r.MarkDryRunnable(model.StageTerraformSync)
+	r.MarkDryRunnable(model.StageTerraformPlan)
+	r.MarkDryRunnable(model.StageTerraformApply)
+
 	r.RegisterRollback(model.RollbackKind_Rollback_TERRAFORM, func(in executor.Input) executor.Executor {
 		return &rollbackExecutor{
 			Input: in,
@@ -60,24 +71,77 @@ func selectWorkspace(ctx context.Context, cmd *provider.Terraform, workspace str
 	if workspace == "" {
 		return true
 	}
-	if err := cmd.SelectWorkspace(ctx, workspace); err != nil {
-		lp.Errorf("Failed to select workspace %q (%v). You might need to create the workspace before using by command %q", workspace, err, "terraform workspace new "+workspace)
+	created, err := cmd.SelectOrCreateWorkspace(ctx, workspace)
+	if err != nil {
+		lp.Errorf("Failed to select or create workspace %q (%v)", workspace, err)
 		return false
 	}
+	if created {
+		lp.Infof("Created and selected workspace %q since it did not exist", workspace)
+		return true
+	}
 	lp.Infof("Selected workspace %q", workspace)
 	return true
 }
 
-func findTerraform(ctx context.Context, version string, lp executor.LogPersister) (string, bool) {
+// findTerraform returns the path to the binary running the terraform commands,
+// which is OpenTofu if configured, and the option telling which one is used.
+func findTerraform(ctx context.Context, in config.TerraformDeploymentInput, lp executor.LogPersister) (string, provider.Option, bool) {
+	if tofu := in.OpenTofu; tofu != nil {
+		path, installed, err := toolregistry.DefaultRegistry().OpenTofu(ctx, tofu.Version)
+		if err != nil {
+			lp.Errorf("Unable to find required OpenTofu %q (%v)", tofu.Version, err)
+			return "", nil, false
+		}
+		if installed {
+			lp.Infof("OpenTofu %q has just been installed to %q because of no pre-installed binary for that version", tofu.Version, path)
+		}
+		return path, provider.WithOpenTofu(true), true
+	}
+
+	version := in.TerraformVersion
 	path, installed, err := toolregistry.DefaultRegistry().Terraform(ctx, version)
 	if err != nil {
 		lp.Errorf("Unable to find required terraform %q (%v)", version, err)
-		return "", false
+		return "", nil, false
 	}
 	if installed {
 		lp.Infof("Terraform %q has just been installed to %q because of no pre-installed binary for that version", version, path)
 	}
-	return path, true
+	return path, provider.WithOpenTofu(false), true
+}
+
+// findTerragrunt returns the option to run the terraform commands through terragrunt if configured.
+func findTerragrunt(ctx context.Context, cfg *config.TerraformTerragrunt, lp executor.LogPersister) (provider.Option, bool) {
+	if cfg == nil {
+		return provider.WithTerragrunt("", false), true
+	}
+	path, installed, err := toolregistry.DefaultRegistry().Terragrunt(ctx, cfg.Version)
+	if err != nil {
+		lp.Errorf("Unable to find required terragrunt %q (%v)", cfg.Version, err)
+		return nil, false
+	}
+	if installed {
+		lp.Infof("Terragrunt %q has just been installed to %q because of no pre-installed binary for that version", cfg.Version, path)
+	}
+	return provider.WithTerragrunt(path, cfg.RunAll), true
+}
+
+// resolveExternalVars fetches the values of the given external variables.
+// Only their names and sources are logged to keep the values secret.
+func resolveExternalVars(ctx context.Context, vars []config.TerraformExternalVar, lp executor.LogPersister) ([]string, bool) {
+	if len(vars) == 0 {
+		return nil, true
+	}
+	for _, v := range vars {
+		lp.Infof("Fetching the value of variable %s from %s", v.Name, provider.ExternalVarSource(v))
+	}
+	resolved, err := provider.ResolveExternalVars(ctx, vars)
+	if err != nil {
+		lp.Errorf("Failed to resolve external variables (%v)", err)
+		return nil, false
+	}
+	return resolved, true
 }
 
 func findPlatformProvider(in *executor.Input) (cfg *config.PlatformProviderTerraformConfig, found bool) {
@@ -97,3 +161,122 @@ func findPlatformProvider(in *executor.Input) (cfg *config.PlatformProviderTerra
 	found = true
 	return
 }
+
+// makeVars returns the variables passed to terraform commands.
+// The default tags come first so that they can be overridden by the explicitly specified variables.
+func makeVars(in *executor.Input, cfg *config.PlatformProviderTerraformConfig, appVars []string, commit string) []string {
+	vars := make([]string, 0, len(cfg.Vars)+len(appVars)+1)
+	if cfg.DefaultTagsVar != "" {
+		// Marshaling a string map never fails, and its JSON form is also a valid HCL object.
+		tags, _ := json.Marshal(in.ResolveResourceTags(cfg.DefaultTags, commit))
+		vars = append(vars, fmt.Sprintf("%s=%s", cfg.DefaultTagsVar, tags))
+	}
+	vars = append(vars, cfg.Vars...)
+	vars = append(vars, appVars...)
+	return vars
+}
+
+// reportPlanResult saves the given plan result as the diff of the deployment
+// to show what will be changed by the deployment.
+func reportPlanResult(ctx context.Context, in *executor.Input, result provider.PlanResult) {
+	if in.DeploymentDiffReporter == nil {
+		return
+	}
+
+	diff := &model.DeploymentDiff{
+		NoChange: result.NoChanges(),
+		Summary:  "No changes were detected",
+	}
+	if !diff.NoChange {
+		diff.Summary = fmt.Sprintf("%d to import, %d to add, %d to change, %d to destroy", result.Imports, result.Adds, result.Changes, result.Destroys)
+		diff.Details = result.PlanOutput
+		diff.ChangedResources = int32(result.Imports + result.Adds + result.Changes + result.Destroys)
+		diff.DeletedResources = int32(result.Destroys)
+		if rendered, err := result.Render(); err == nil && rendered != "" {
+			diff.Details = rendered
+		}
+	}
+
+	if err := in.DeploymentDiffReporter.ReportDeploymentDiff(ctx, diff); err != nil {
+		in.Logger.Warn("failed to report the plan result as the deployment diff", zap.Error(err))
+	}
+}
+
+// planOutputArtifactName is the name of the stage artifact holding the plan output.
+const planOutputArtifactName = "plan.txt"
+
+// planSummaryArtifactName is the name of the stage artifact holding the summary of the planned resource changes.
+const planSummaryArtifactName = "plan-summary.json"
+
+// Stage metadata keys holding the summary of the planned resource changes.
+const (
+	planCreatesKey         = "TerraformPlanCreates"
+	planUpdatesKey         = "TerraformPlanUpdates"
+	planDestroysKey        = "TerraformPlanDestroys"
+	planReplacementsKey    = "TerraformPlanReplacements"
+	planImportsKey         = "TerraformPlanImports"
+	planResourceChangesKey = "TerraformPlanResourceChanges"
+)
+
+// reportPlanSummary writes the resource changes of the given saved plan into the stage log,
+// and saves them as the stage metadata and artifact to be displayed by the web UI and pipectl.
+// Since the summary is just informational, any error is only logged.
+func reportPlanSummary(ctx context.Context, in *executor.Input, cmd *provider.Terraform, planFile string) {
+	s, err := cmd.ShowPlan(ctx, planFile)
+	if err != nil {
+		in.LogPersister.Infof("Unable to summarize the resource changes of the plan (%v)", err)
+		return
+	}
+
+	in.LogPersister.Info("Resource changes:")
+	for _, c := range []struct {
+		sign      string
+		addresses []string
+	}{
+		{"+", s.Creates},
+		{"~", s.Updates},
+		{"-", s.Destroys},
+		{"-/+", s.Replacements},
+		{"import", s.Imports},
+	} {
+		for _, a := range c.addresses {
+			in.LogPersister.Infof("  %s %s", c.sign, a)
+		}
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		in.Logger.Warn("failed to marshal the summary of the plan", zap.Error(err))
+		return
+	}
+	if err := in.MetadataStore.Stage(in.Stage.Id).PutMulti(ctx, planSummaryMetadata(s, data)); err != nil {
+		in.LogPersister.Errorf("Unable to save the summary of the plan to deployment, %v", err)
+	}
+	if in.StageArtifactUploader != nil {
+		if err := in.StageArtifactUploader.UploadStageArtifact(ctx, planSummaryArtifactName, data); err != nil {
+			in.Logger.Warn("failed to upload the summary of the plan as a stage artifact", zap.Error(err))
+		}
+	}
+}
+
+func planSummaryMetadata(s *provider.PlanSummary, data []byte) map[string]string {
+	return map[string]string{
+		planCreatesKey:         strconv.Itoa(len(s.Creates)),
+		planUpdatesKey:         strconv.Itoa(len(s.Updates)),
+		planDestroysKey:        strconv.Itoa(len(s.Destroys)),
+		planReplacementsKey:    strconv.Itoa(len(s.Replacements)),
+		planImportsKey:         strconv.Itoa(len(s.Imports)),
+		planResourceChangesKey: string(data),
+	}
+}
+
+// uploadPlanOutput saves the full output of terraform plan as an artifact of the stage
+// since it is usually too long to be read in the stage log.
+func uploadPlanOutput(ctx context.Context, in *executor.Input, result provider.PlanResult) {
+	if in.StageArtifactUploader == nil || result.PlanOutput == "" {
+		return
+	}
+	if err := in.StageArtifactUploader.UploadStageArtifact(ctx, planOutputArtifactName, []byte(result.PlanOutput)); err != nil {
+		in.Logger.Warn("failed to upload the plan output as a stage artifact", zap.Error(err))
+	}
+}
@@ -37,6 +37,8 @@ type Executor struct {
 
 type registerer interface {
 	Register(stage model.Stage, f executor.Factory) error
+	MarkIdempotent(stage model.Stage)
+	MarkDryRunnable(stage model.Stage)
 }
 
 // Register registers this executor factory into a given registerer.
@@ -47,6 +49,8 @@ func Register(r registerer) {
 		}
 	}
 	r.Register(model.StageWait, f)
+	r.MarkIdempotent(model.StageWait)
+	r.MarkDryRunnable(model.StageWait)
 }
 
 // Execute starts waiting for the specified duration.
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -29,7 +30,9 @@ import (
 )
 
 const (
-	approvedByKey = "ApprovedBy"
+	approvedByKey  = "ApprovedBy"
+	riskScoreKey   = "RiskScore"
+	riskFactorsKey = "RiskFactors"
 )
 
 type Executor struct {
@@ -38,6 +41,7 @@ type Executor struct {
 
 type registerer interface {
 	Register(stage model.Stage, f executor.Factory) error
+	MarkDryRunnable(stage model.Stage)
 }
 
 // Register registers this executor factory into a given registerer.
@@ -48,6 +52,7 @@ func Register(r registerer) {
 		}
 	}
 	r.Register(model.StageWaitApproval, f)
+	r.MarkDryRunnable(model.StageWaitApproval)
 }
 
 // Execute starts waiting until an approval from one of the specified users.
@@ -61,7 +66,8 @@ func (e *Executor) Execute(sig executor.StopSignal) model.StageStatus {
 	timeout := e.StageConfig.WaitApprovalStageOptions.Timeout.Duration()
 	timer := time.NewTimer(timeout)
 
-	e.reportRequiringApproval()
+	risk := e.loadDeploymentRisk(ctx)
+	e.reportRequiringApproval(risk)
 
 	num := e.StageConfig.WaitApprovalStageOptions.MinApproverNum
 	e.LogPersister.Infof("Waiting for approval from at least %d user(s)...", num)
@@ -101,6 +107,9 @@ func (e *Executor) checkApproval(ctx context.Context, num int) bool {
 	if approveCmd == nil {
 		return false
 	}
+	if comment := approveCmd.Metadata[model.MetadataKeyComment]; comment != "" {
+		e.LogPersister.Infof("Comment from %q: %s", approveCmd.Commander, comment)
+	}
 
 	reached := e.validateApproverNum(ctx, approveCmd.Commander, num)
 	if err := approveCmd.Report(ctx, model.CommandStatus_COMMAND_SUCCEEDED, nil, nil); err != nil {
@@ -126,7 +135,44 @@ func (e *Executor) reportApproved(approver string) {
 	})
 }
 
-func (e *Executor) reportRequiringApproval() {
+// loadDeploymentRisk fetches the risk score of this deployment from the control plane
+// and saves it into the stage metadata to be shown to the approvers.
+// Since the score is only informative, nil is returned when it could not be fetched.
+func (e *Executor) loadDeploymentRisk(ctx context.Context) *model.DeploymentRisk {
+	if e.DeploymentRiskGetter == nil {
+		return nil
+	}
+	risk, err := e.DeploymentRiskGetter.GetDeploymentRisk(ctx)
+	if err != nil {
+		e.Logger.Warn("failed to get deployment risk", zap.Error(err))
+		e.LogPersister.Infof("Unable to compute the risk score of this deployment: %v", err)
+		return nil
+	}
+	if risk == nil {
+		return nil
+	}
+
+	e.LogPersister.Infof("Risk score of this deployment: %d/100", risk.Score)
+	for _, f := range risk.Factors {
+		e.LogPersister.Infof("  - %s: %d (%s)", f.Name, f.Score, f.Description)
+	}
+
+	factors, err := json.Marshal(risk.Factors)
+	if err != nil {
+		e.Logger.Error("failed to marshal deployment risk factors", zap.Error(err))
+		return risk
+	}
+	md := map[string]string{
+		riskScoreKey:   strconv.Itoa(int(risk.Score)),
+		riskFactorsKey: string(factors),
+	}
+	if err := e.MetadataStore.Stage(e.Stage.Id).PutMulti(ctx, md); err != nil {
+		e.LogPersister.Errorf("Unable to save deployment risk to deployment, %v", err)
+	}
+	return risk
+}
+
+func (e *Executor) reportRequiringApproval(risk *model.DeploymentRisk) {
 	users, groups, err := e.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_WAIT_APPROVAL)
 	if err != nil {
 		e.Logger.Error("failed to get the list of users or groups", zap.Error(err))
@@ -138,6 +184,7 @@ func (e *Executor) reportRequiringApproval() {
 			Deployment:        e.Deployment,
 			MentionedAccounts: users,
 			MentionedGroups:   groups,
+			Risk:              risk,
 		},
 	})
 }
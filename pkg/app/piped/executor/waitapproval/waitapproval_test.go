@@ -16,9 +16,12 @@ package waitapproval
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
@@ -69,6 +72,15 @@ func (c *fakeAPIClient) SaveStageMetadata(_ context.Context, req *pipedservice.S
 	return &pipedservice.SaveStageMetadataResponse{}, nil
 }
 
+type fakeDeploymentRiskGetter struct {
+	risk *model.DeploymentRisk
+	err  error
+}
+
+func (g *fakeDeploymentRiskGetter) GetDeploymentRisk(_ context.Context) (*model.DeploymentRisk, error) {
+	return g.risk, g.err
+}
+
 type fakeNotifier struct{}
 
 func (n *fakeNotifier) Notify(_ model.NotificationEvent) {}
@@ -217,3 +229,75 @@ func TestValidateApproverNum(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadDeploymentRisk(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	risk := &model.DeploymentRisk{
+		Score: 40,
+		Factors: []*model.DeploymentRiskFactor{
+			{Name: "DIFF_SIZE", Score: 25, Description: "30 resources will be changed"},
+			{Name: "OFF_HOURS", Score: 15, Description: "triggered outside working hours"},
+		},
+	}
+	testcases := []struct {
+		name       string
+		getter     executor.DeploymentRiskGetter
+		want       *model.DeploymentRisk
+		wantScore  string
+		wantStored bool
+	}{
+		{
+			name: "no getter",
+		},
+		{
+			name:   "failed to get risk",
+			getter: &fakeDeploymentRiskGetter{err: errors.New("unavailable")},
+		},
+		{
+			name:       "risk was saved into metadata",
+			getter:     &fakeDeploymentRiskGetter{risk: risk},
+			want:       risk,
+			wantScore:  "40",
+			wantStored: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ac := &fakeAPIClient{
+				shared: make(map[string]string, 0),
+				stages: make(map[string]metadata, 0),
+			}
+			e := &Executor{
+				Input: executor.Input{
+					Stage: &model.PipelineStage{
+						Id: "stage-1",
+					},
+					LogPersister: &fakeLogPersister{},
+					MetadataStore: metadatastore.NewMetadataStore(ac, &model.Deployment{
+						Stages: []*model.PipelineStage{
+							{
+								Id:       "stage-1",
+								Metadata: map[string]string{},
+							},
+						},
+					}),
+					DeploymentRiskGetter: tc.getter,
+					Logger:               zap.NewNop(),
+				},
+			}
+			got := e.loadDeploymentRisk(ctx)
+			assert.Equal(t, tc.want, got)
+
+			score, ok := e.MetadataStore.Stage("stage-1").Get(riskScoreKey)
+			assert.Equal(t, tc.wantStored, ok)
+			assert.Equal(t, tc.wantScore, score)
+			if tc.wantStored {
+				factors, ok := e.MetadataStore.Stage("stage-1").Get(riskFactorsKey)
+				require.True(t, ok)
+				assert.JSONEq(t, `[{"name":"DIFF_SIZE","score":25,"description":"30 resources will be changed"},{"name":"OFF_HOURS","score":15,"description":"triggered outside working hours"}]`, factors)
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 
 type appNodes struct {
 	appID         string
+	healthRules   *provider.HealthRules
 	managingNodes map[string]node
 	dependedNodes map[string]node
 	version       model.ApplicationLiveStateVersion
@@ -59,7 +60,7 @@ func (a *appNodes) addManagingResource(uid string, key provider.ResourceKey, obj
 		appID:        a.appID,
 		key:          key,
 		unstructured: obj,
-		state:        provider.MakeKubernetesResourceState(uid, key, obj, now),
+		state:        provider.MakeKubernetesResourceState(uid, key, obj, a.healthRules, now),
 	}
 
 	a.mu.Lock()
@@ -113,7 +114,7 @@ func (a *appNodes) addDependedResource(uid string, key provider.ResourceKey, obj
 		appID:        a.appID,
 		key:          key,
 		unstructured: obj,
-		state:        provider.MakeKubernetesResourceState(uid, key, obj, now),
+		state:        provider.MakeKubernetesResourceState(uid, key, obj, a.healthRules, now),
 	}
 
 	a.mu.Lock()
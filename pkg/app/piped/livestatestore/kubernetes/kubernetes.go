@@ -68,11 +68,18 @@ func NewStore(cfg *config.PlatformProviderKubernetesConfig, pipedConfig *config.
 	logger = logger.Named("kubernetes").
 		With(zap.String("platform-provider", platformProvider))
 
+	// The rules were already validated while loading the piped configuration.
+	healthRules, err := provider.NewHealthRules(cfg.AppStateInformer.HealthRules)
+	if err != nil {
+		logger.Error("failed to compile the health rules, they will be ignored", zap.Error(err))
+	}
+
 	return &Store{
 		config:      cfg,
 		pipedConfig: pipedConfig,
 		store: &store{
 			pipedConfig: pipedConfig,
+			healthRules: healthRules,
 			apps:        make(map[string]*appNodes),
 			resources:   make(map[string]appResource),
 			iterators:   make(map[int]int, 1),
@@ -35,6 +35,7 @@ const (
 
 type store struct {
 	pipedConfig *config.PipedSpec
+	healthRules *provider.HealthRules
 	apps        map[string]*appNodes
 	// The map with the key is "resource's uid" and the value is "appResource".
 	// Because the depended resource does not include the appID in its annotations
@@ -108,6 +109,19 @@ func (s *store) addResource(obj *unstructured.Unstructured, appID string) {
 		now    = time.Now()
 	)
 
+	// Crossplane composite resources are not owned by their claims
+	// so we link them with the claim to be able to render the resource graph correctly.
+	if len(owners) == 0 {
+		s.mu.RLock()
+		ref, ok := s.findCrossplaneClaim(obj)
+		s.mu.RUnlock()
+		if ok {
+			owners = []metav1.OwnerReference{ref}
+			obj = obj.DeepCopy()
+			obj.SetOwnerReferences(owners)
+		}
+	}
+
 	// If this is a resource managed by PipeCD
 	// it must contain appID in its annotations and has no owners.
 	if appID != "" && len(owners) == 0 {
@@ -118,6 +132,7 @@ func (s *store) addResource(obj *unstructured.Unstructured, appID string) {
 		if !ok {
 			app = &appNodes{
 				appID:         appID,
+				healthRules:   s.healthRules,
 				managingNodes: make(map[string]node),
 				dependedNodes: make(map[string]node),
 				version: model.ApplicationLiveStateVersion{
@@ -275,6 +290,30 @@ func (s *store) findAppIDByOwners(owners []metav1.OwnerReference) string {
 	return ""
 }
 
+// findCrossplaneClaim returns a reference to the Crossplane claim the given composite resource is bound to.
+func (s *store) findCrossplaneClaim(obj *unstructured.Unstructured) (metav1.OwnerReference, bool) {
+	claimKey, ok := provider.CrossplaneClaimKey(obj)
+	if !ok {
+		return metav1.OwnerReference{}, false
+	}
+	for _, r := range s.resources {
+		key := provider.MakeResourceKey(r.resource)
+		if key.Kind != claimKey.Kind || key.Namespace != claimKey.Namespace || key.Name != claimKey.Name {
+			continue
+		}
+		if claimKey.APIVersion != "" && key.APIVersion != claimKey.APIVersion {
+			continue
+		}
+		return metav1.OwnerReference{
+			APIVersion: key.APIVersion,
+			Kind:       key.Kind,
+			Name:       key.Name,
+			UID:        r.resource.GetUID(),
+		}, true
+	}
+	return metav1.OwnerReference{}, false
+}
+
 func (s *store) getAppLiveState(appID string) (AppState, bool) {
 	s.mu.RLock()
 	app, ok := s.apps[appID]
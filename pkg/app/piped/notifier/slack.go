@@ -219,6 +219,7 @@ func (s *slack) buildSlackMessage(event model.NotificationEvent, webURL string)
 			{"Mention To Groups", groupsStr, true},
 			{"Started At", makeSlackDate(d.CreatedAt), true},
 		}
+		fields = append(fields, makeTriggerTraceFields(d.Trigger.GetTrace())...)
 	}
 
 	generateDeploymentEventDataForTriggerFailed := func(app *model.Application, hash string, msg string, accounts []string, groups []string) {
@@ -241,6 +242,19 @@ func (s *slack) buildSlackMessage(event model.NotificationEvent, webURL string)
 		}
 	}
 
+	generateApplicationEventData := func(app *model.Application, accounts []string, groups []string) {
+		accountsStr := getAccountsAsString(accounts)
+		groupsStr := getGroupsAsString(groups)
+		link = fmt.Sprintf("%s/applications/%s?project=%s", webURL, app.Id, app.ProjectId)
+		fields = []slackField{
+			{"Project", truncateText(app.ProjectId, 8), true},
+			{"Application", makeSlackLink(app.Name, link), true},
+			{"Kind", strings.ToLower(app.Kind.String()), true},
+			{"Mention To Users", accountsStr, true},
+			{"Mention To Groups", groupsStr, true},
+		}
+	}
+
 	generatePipedEventData := func(id string, name string, version string, project string, accounts []string, groups []string) {
 		accountStr := getAccountsAsString(accounts)
 		groupsStr := getGroupsAsString(groups)
@@ -299,6 +313,18 @@ func (s *slack) buildSlackMessage(event model.NotificationEvent, webURL string)
 		md.MentionedAccounts = append(md.MentionedAccounts, s.config.MentionedAccounts...)
 		md.MentionedGroups = append(md.MentionedGroups, s.config.MentionedGroups...)
 		title = fmt.Sprintf("Deployment for %q is waiting for an approval", md.Deployment.ApplicationName)
+		text = joinTexts(makeRiskText(md.Risk), makeCommentsText(md.Deployment.Comments))
+		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
+		if md.Risk != nil {
+			fields = append(fields, slackField{"Risk Score", fmt.Sprintf("%d/100", md.Risk.Score), true})
+		}
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_COMMENTED:
+		md := event.Metadata.(*model.NotificationEventDeploymentCommented)
+		md.MentionedAccounts = append(md.MentionedAccounts, s.config.MentionedAccounts...)
+		md.MentionedGroups = append(md.MentionedGroups, s.config.MentionedGroups...)
+		title = fmt.Sprintf("%s commented on the deployment for %q", md.Comment.Commenter, md.Deployment.ApplicationName)
+		text = md.Comment.Comment
 		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
 
 	case model.NotificationEventType_EVENT_DEPLOYMENT_APPROVED:
@@ -326,6 +352,15 @@ func (s *slack) buildSlackMessage(event model.NotificationEvent, webURL string)
 		color = slackErrorColor
 		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
 
+	case model.NotificationEventType_EVENT_DEPLOYMENT_TIMED_OUT:
+		md := event.Metadata.(*model.NotificationEventDeploymentTimedOut)
+		md.MentionedAccounts = append(md.MentionedAccounts, s.config.MentionedAccounts...)
+		md.MentionedGroups = append(md.MentionedGroups, s.config.MentionedGroups...)
+		title = fmt.Sprintf("Deployment for %q was timed out", md.Deployment.ApplicationName)
+		text = fmt.Sprintf("The deployment did not complete within %s", md.Timeout)
+		color = slackErrorColor
+		generateDeploymentEventData(md.Deployment, md.MentionedAccounts, md.MentionedGroups)
+
 	case model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED:
 		md := event.Metadata.(*model.NotificationEventDeploymentCancelled)
 		md.MentionedAccounts = append(md.MentionedAccounts, s.config.MentionedAccounts...)
@@ -382,7 +417,20 @@ func (s *slack) buildSlackMessage(event model.NotificationEvent, webURL string)
 		color = slackWarnColor
 		generateStageEventData(md.Deployment, md.Stage, s.config.MentionedAccounts, s.config.MentionedGroups)
 
-	// TODO: Support application type of notification event.
+	case model.NotificationEventType_EVENT_APPLICATION_SYNCED:
+		md := event.Metadata.(*model.NotificationEventApplicationSynced)
+		title = fmt.Sprintf("Application %q is back in sync", md.Application.Name)
+		color = slackSuccessColor
+		generateApplicationEventData(md.Application, s.config.MentionedAccounts, s.config.MentionedGroups)
+
+	case model.NotificationEventType_EVENT_APPLICATION_OUT_OF_SYNC:
+		md := event.Metadata.(*model.NotificationEventApplicationOutOfSync)
+		title = fmt.Sprintf("Configuration drift was detected in %q", md.Application.Name)
+		color = slackWarnColor
+		generateApplicationEventData(md.Application, s.config.MentionedAccounts, s.config.MentionedGroups)
+		text = makeDriftText(md, link)
+
+	// TODO: Support application health type of notification event.
 	default:
 		return slackMessage{}, false
 	}
@@ -415,6 +463,31 @@ func makeSlackLink(title, url string) string {
 	return fmt.Sprintf("<%s|%s>", url, title)
 }
 
+// makeTriggerTraceFields returns the fields linking back to what caused the deployment.
+func makeTriggerTraceFields(trace *model.DeploymentTriggerTrace) []slackField {
+	if trace == nil {
+		return nil
+	}
+	var fields []slackField
+	if trace.EventName != "" {
+		fields = append(fields, slackField{"Event", trace.EventName, true})
+	}
+	if trace.CommitHash != "" {
+		commit := truncateText(trace.CommitHash, 8)
+		if trace.CommitUrl != "" {
+			commit = makeSlackLink(commit, trace.CommitUrl)
+		}
+		if trace.CommitAuthor != "" {
+			commit = fmt.Sprintf("%s by %s", commit, trace.CommitAuthor)
+		}
+		fields = append(fields, slackField{"Trigger Commit", commit, true})
+	}
+	if trace.RunUrl != "" {
+		fields = append(fields, slackField{"CI Run", makeSlackLink("Open", trace.RunUrl), true})
+	}
+	return fields
+}
+
 func makeSlackDate(unix int64) string {
 	return fmt.Sprintf("<!date^%d^{date_num} {time_secs}|date>", unix)
 }
@@ -442,6 +515,43 @@ func makeSlackMessage(title, titleLink, text, color string, timestamp int64, fie
 	}
 }
 
+// makeCommentsText returns the text listing the comments left on the deployment
+// so that approvers can know its context without leaving Slack.
+func makeCommentsText(comments []*model.DeploymentComment) string {
+	if len(comments) == 0 {
+		return ""
+	}
+	lines := make([]string, 0, len(comments)+1)
+	lines = append(lines, "Comments:")
+	for _, c := range comments {
+		lines = append(lines, fmt.Sprintf("• %s (%s): %s", c.Commenter, makeSlackDate(c.CreatedAt), c.Comment))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func makeRiskText(risk *model.DeploymentRisk) string {
+	if risk == nil || len(risk.Factors) == 0 {
+		return ""
+	}
+	lines := make([]string, 0, len(risk.Factors)+1)
+	lines = append(lines, fmt.Sprintf("Risk score: %d/100", risk.Score))
+	for _, f := range risk.Factors {
+		lines = append(lines, fmt.Sprintf("• %s: %d (%s)", f.Name, f.Score, f.Description))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// joinTexts joins the given non-empty texts with a blank line.
+func joinTexts(texts ...string) string {
+	parts := make([]string, 0, len(texts))
+	for _, t := range texts {
+		if t != "" {
+			parts = append(parts, t)
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
+
 func getAccountsAsString(accounts []string) string {
 	if len(accounts) == 0 {
 		return ""
@@ -467,3 +577,18 @@ func getGroupsAsString(groups []string) string {
 	}
 	return strings.Join(formattedGroups, " ")
 }
+
+func makeDriftText(md *model.NotificationEventApplicationOutOfSync, appURL string) string {
+	var b strings.Builder
+	b.WriteString(md.State.GetShortReason())
+	if md.Diff == "" {
+		return b.String()
+	}
+	b.WriteString("\n```\n")
+	b.WriteString(md.Diff)
+	b.WriteString("\n```")
+	if md.DiffTruncated {
+		b.WriteString(fmt.Sprintf("\nThe diff was truncated. See %s for the full diff.", makeSlackLink("the application page", appURL)))
+	}
+	return b.String()
+}
@@ -14,7 +14,11 @@
 
 package notifier
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
 
 func Test_getAccountsAsString(t *testing.T) {
 	t.Parallel()
@@ -90,3 +94,116 @@ func Test_getGroupsAsString(t *testing.T) {
 		})
 	}
 }
+
+func Test_makeCommentsText(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		comments []*model.DeploymentComment
+		want     string
+	}{
+		{
+			name:     "empty",
+			comments: nil,
+			want:     "",
+		},
+		{
+			name: "multiple",
+			comments: []*model.DeploymentComment{
+				{Id: "1", Commenter: "foo", Comment: "paused for DB check", CreatedAt: 100},
+				{Id: "2", Commenter: "bar", Comment: "DB is fine", CreatedAt: 200},
+			},
+			want: "Comments:\n• foo (<!date^100^{date_num} {time_secs}|date>): paused for DB check\n• bar (<!date^200^{date_num} {time_secs}|date>): DB is fine",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := makeCommentsText(tt.comments)
+			if got != tt.want {
+				t.Errorf("makeCommentsText(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_makeRiskText(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		risk *model.DeploymentRisk
+		want string
+	}{
+		{
+			name: "nil",
+			risk: nil,
+			want: "",
+		},
+		{
+			name: "multiple factors",
+			risk: &model.DeploymentRisk{
+				Score: 40,
+				Factors: []*model.DeploymentRiskFactor{
+					{Name: "DIFF_SIZE", Score: 25, Description: "30 resources will be changed"},
+					{Name: "OFF_HOURS", Score: 15, Description: "triggered on Saturday"},
+				},
+			},
+			want: "Risk score: 40/100\n• DIFF_SIZE: 25 (30 resources will be changed)\n• OFF_HOURS: 15 (triggered on Saturday)",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := makeRiskText(tt.risk)
+			if got != tt.want {
+				t.Errorf("makeRiskText(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_makeDriftText(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		md   *model.NotificationEventApplicationOutOfSync
+		want string
+	}{
+		{
+			name: "no diff",
+			md: &model.NotificationEventApplicationOutOfSync{
+				State: &model.ApplicationSyncState{ShortReason: "There are 1 manifests not synced"},
+			},
+			want: "There are 1 manifests not synced",
+		},
+		{
+			name: "with diff",
+			md: &model.NotificationEventApplicationOutOfSync{
+				State: &model.ApplicationSyncState{ShortReason: "There are 1 manifests not synced"},
+				Diff:  "-replicas: 3\n+replicas: 300",
+			},
+			want: "There are 1 manifests not synced\n```\n-replicas: 3\n+replicas: 300\n```",
+		},
+		{
+			name: "with truncated diff",
+			md: &model.NotificationEventApplicationOutOfSync{
+				State:         &model.ApplicationSyncState{ShortReason: "There are 1 manifests not synced"},
+				Diff:          "-replicas: 3",
+				DiffTruncated: true,
+			},
+			want: "There are 1 manifests not synced\n```\n-replicas: 3\n```\nThe diff was truncated. See <https://pipecd.dev/applications/app|the application page> for the full diff.",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := makeDriftText(tt.md, "https://pipecd.dev/applications/app")
+			if got != tt.want {
+				t.Errorf("makeDriftText(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
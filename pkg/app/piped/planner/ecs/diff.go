@@ -0,0 +1,119 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/diff"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// Diff renders the diff of the service and task definitions between the running commit and the target commit.
+func (p *Planner) Diff(ctx context.Context, in planner.Input) (*model.DeploymentDiff, error) {
+	if in.MostRecentSuccessfulCommitHash == "" || in.RunningDSP == nil {
+		return nil, nil
+	}
+
+	newManifests, err := loadManifests(ctx, &in, in.TargetDSP, in.Trigger.Commit.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ecs manifests at the target commit: %w", err)
+	}
+	oldManifests, err := loadManifests(ctx, &in, in.RunningDSP, in.MostRecentSuccessfulCommitHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ecs manifests at the running commit: %w", err)
+	}
+
+	result, err := provider.Diff(
+		oldManifests,
+		newManifests,
+		diff.WithEquateEmpty(),
+		diff.WithCompareNumberAndNumericString(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare manifests: %w", err)
+	}
+
+	if result.NoChange() {
+		return &model.DeploymentDiff{
+			NoChange: true,
+			Summary:  "No changes were detected",
+		}, nil
+	}
+
+	// The service and task definitions are counted as the changed resources.
+	var changed int32
+	for _, prefix := range []string{"TaskDefinition", "ServiceDefinition"} {
+		if len(result.Diff.Nodes().FindByPrefix(prefix)) > 0 {
+			changed++
+		}
+	}
+
+	return &model.DeploymentDiff{
+		Summary:          fmt.Sprintf("%d changes were detected", len(result.Diff.Nodes())),
+		ChangedResources: changed,
+		Details: result.Render(provider.DiffRenderOptions{
+			UseDiffCommand: true,
+		}),
+	}, nil
+}
+
+func loadManifests(ctx context.Context, in *planner.Input, dsp deploysource.Provider, commit string) (provider.ECSManifests, error) {
+	cache := provider.ECSManifestsCache{
+		AppID:  in.ApplicationID,
+		Cache:  in.AppManifestsCache,
+		Logger: in.Logger,
+	}
+	if manifests, ok := cache.Get(commit); ok {
+		return manifests, nil
+	}
+
+	ds, err := dsp.Get(ctx, io.Discard)
+	if err != nil {
+		return provider.ECSManifests{}, err
+	}
+	cfg := ds.ApplicationConfig.ECSApplicationSpec
+	if cfg == nil {
+		return provider.ECSManifests{}, fmt.Errorf("missing ECSApplicationSpec in application configuration")
+	}
+
+	td := provider.NewTemplateData(cfg, in.ApplicationID, in.PipedConfig.PipedID, ds.Revision)
+	taskDef, err := provider.LoadTaskDefinition(ds.AppDir, cfg.Input.TaskDefinitionFile, td)
+	if err != nil {
+		return provider.ECSManifests{}, err
+	}
+
+	serviceDef := types.Service{}
+	if !cfg.Input.IsStandaloneTask() {
+		serviceDef, err = provider.LoadServiceDefinition(ds.AppDir, cfg.Input.ServiceDefinitionFile, td)
+		if err != nil {
+			return provider.ECSManifests{}, err
+		}
+	}
+
+	manifests := provider.ECSManifests{
+		TaskDefinition:    &taskDef,
+		ServiceDefinition: &serviceDef,
+	}
+	cache.Put(commit, manifests)
+	return manifests, nil
+}
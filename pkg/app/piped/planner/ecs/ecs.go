@@ -55,14 +55,15 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 	}
 
 	// Determine application version from the task definition
-	if version, e := determineVersion(ds.AppDir, cfg.Input.TaskDefinitionFile); e != nil {
+	td := provider.NewTemplateData(cfg, in.ApplicationID, in.PipedConfig.PipedID, ds.Revision)
+	if version, e := determineVersion(ds.AppDir, cfg.Input.TaskDefinitionFile, td); e != nil {
 		out.Version = "unknown"
 		in.Logger.Warn("unable to determine target version", zap.Error(e))
 	} else {
 		out.Version = version
 	}
 
-	if versions, e := determineVersions(ds.AppDir, cfg.Input.TaskDefinitionFile); e != nil || len(versions) == 0 {
+	if versions, e := determineVersions(ds.AppDir, cfg.Input.TaskDefinitionFile, td); e != nil || len(versions) == 0 {
 		in.Logger.Warn("unable to determine target versions", zap.Error(e))
 		out.Versions = []*model.ArtifactVersion{
 			{
@@ -123,7 +124,8 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 	// Load service manifest at the last deployed commit to decide running version.
 	ds, err = in.RunningDSP.Get(ctx, io.Discard)
 	if err == nil {
-		if lastVersion, e := determineVersion(ds.AppDir, cfg.Input.TaskDefinitionFile); e == nil {
+		runningTD := provider.NewTemplateData(ds.ApplicationConfig.ECSApplicationSpec, in.ApplicationID, in.PipedConfig.PipedID, ds.Revision)
+		if lastVersion, e := determineVersion(ds.AppDir, cfg.Input.TaskDefinitionFile, runningTD); e == nil {
 			out.SyncStrategy = model.SyncStrategy_PIPELINE
 			out.Stages = buildProgressivePipeline(cfg.Pipeline, autoRollback, time.Now())
 			out.Summary = fmt.Sprintf("Sync with pipeline to update image from %s to %s", lastVersion, out.Version)
@@ -137,8 +139,8 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 	return
 }
 
-func determineVersion(appDir, taskDefinitonFile string) (string, error) {
-	taskDefinition, err := provider.LoadTaskDefinition(appDir, taskDefinitonFile)
+func determineVersion(appDir, taskDefinitonFile string, td *provider.TemplateData) (string, error) {
+	taskDefinition, err := provider.LoadTaskDefinition(appDir, taskDefinitonFile, td)
 	if err != nil {
 		return "", err
 	}
@@ -146,8 +148,8 @@ func determineVersion(appDir, taskDefinitonFile string) (string, error) {
 	return provider.FindImageTag(taskDefinition)
 }
 
-func determineVersions(appDir, taskDefinitonFile string) ([]*model.ArtifactVersion, error) {
-	taskDefinition, err := provider.LoadTaskDefinition(appDir, taskDefinitonFile)
+func determineVersions(appDir, taskDefinitonFile string, td *provider.TemplateData) ([]*model.ArtifactVersion, error) {
+	taskDefinition, err := provider.LoadTaskDefinition(appDir, taskDefinitonFile, td)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,272 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc"
+	"sigs.k8s.io/yaml"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes/resource"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func buildArtifact(appDir string, chart *config.InputHelmChart, manifests []provider.Manifest) (*model.DeploymentArtifact, error) {
+	chartVersion, err := determineChartVersion(appDir, chart)
+	if err != nil {
+		return nil, err
+	}
+	return determineArtifact(manifests, chartVersion)
+}
+
+// determineArtifact builds the artifact record of the given manifests.
+// It contains all container images used by the workloads, the chart version
+// and the hash of the whole rendered manifests.
+func determineArtifact(manifests []provider.Manifest, chartVersion string) (*model.DeploymentArtifact, error) {
+	images, err := findArtifactImages(manifests)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := hashManifests(manifests)
+	if err != nil {
+		return nil, err
+	}
+	return &model.DeploymentArtifact{
+		Images:        images,
+		ChartVersion:  chartVersion,
+		ManifestsHash: hash,
+	}, nil
+}
+
+func findArtifactImages(manifests []provider.Manifest) ([]*model.ArtifactImage, error) {
+	refs := make(map[string]struct{})
+	for _, m := range manifests {
+		spec, err := findPodSpec(m)
+		if err != nil {
+			return nil, err
+		}
+		if spec == nil {
+			continue
+		}
+		for _, c := range spec.InitContainers {
+			refs[c.Image] = struct{}{}
+		}
+		for _, c := range spec.Containers {
+			refs[c.Image] = struct{}{}
+		}
+	}
+
+	images := make([]*model.ArtifactImage, 0, len(refs))
+	for ref := range refs {
+		if ref == "" {
+			continue
+		}
+		images = append(images, parseArtifactImage(ref))
+	}
+	sort.Slice(images, func(i, j int) bool {
+		return images[i].Reference < images[j].Reference
+	})
+	return images, nil
+}
+
+// findPodSpec returns the pod spec of the given manifest
+// or nil if it is not a built-in workload resource.
+func findPodSpec(m provider.Manifest) (*resource.PodSpec, error) {
+	if !provider.IsKubernetesBuiltInResource(m.Key.APIVersion) {
+		return nil, nil
+	}
+
+	data, err := m.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	switch m.Key.Kind {
+	case provider.KindPod:
+		var obj struct {
+			Spec resource.PodSpec
+		}
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return nil, err
+		}
+		return &obj.Spec, nil
+
+	case provider.KindDeployment, provider.KindStatefulSet, provider.KindDaemonSet, provider.KindReplicaSet, provider.KindJob:
+		var obj struct {
+			Spec struct {
+				Template resource.PodTemplateSpec
+			}
+		}
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return nil, err
+		}
+		return &obj.Spec.Template.Spec, nil
+
+	case provider.KindCronJob:
+		var obj struct {
+			Spec struct {
+				JobTemplate struct {
+					Spec struct {
+						Template resource.PodTemplateSpec
+					}
+				}
+			}
+		}
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return nil, err
+		}
+		return &obj.Spec.JobTemplate.Spec.Template.Spec, nil
+	}
+
+	return nil, nil
+}
+
+// parseArtifactImage parses an image reference such as
+// "gcr.io/pipecd/helloworld:v1.0.0@sha256:abc...".
+func parseArtifactImage(ref string) *model.ArtifactImage {
+	img := &model.ArtifactImage{
+		Reference: ref,
+		Name:      ref,
+	}
+	if i := strings.Index(img.Name, "@"); i >= 0 {
+		img.Digest = img.Name[i+1:]
+		img.Name = img.Name[:i]
+	}
+	// The tag is placed after the last colon but a colon can also
+	// be used for the port of registry host, e.g. "localhost:5000/helloworld".
+	if i := strings.LastIndex(img.Name, ":"); i > strings.LastIndex(img.Name, "/") {
+		img.Tag = img.Name[i+1:]
+		img.Name = img.Name[:i]
+	}
+	return img
+}
+
+// hashManifests returns the sha256 hash of the given manifests.
+// The order of the manifests does not affect the result.
+func hashManifests(manifests []provider.Manifest) (string, error) {
+	sorted := make([]provider.Manifest, len(manifests))
+	copy(sorted, manifests)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Key.String() < sorted[j].Key.String()
+	})
+
+	hasher := sha256.New()
+	for _, m := range sorted {
+		data, err := m.MarshalJSON()
+		if err != nil {
+			return "", err
+		}
+		hasher.Write(data)
+		hasher.Write([]byte{'\n'})
+	}
+	return fmt.Sprintf("%x", hasher.Sum(nil)), nil
+}
+
+// determineChartVersion returns the version of the Helm chart used to render the manifests.
+// Empty is returned when the manifests are not rendered from a chart
+// or the version is unknown such as the chart placed in a remote git repository.
+func determineChartVersion(appDir string, chart *config.InputHelmChart) (string, error) {
+	switch {
+	case chart == nil:
+		return "", nil
+	case chart.GitRemote != "":
+		return "", nil
+	case chart.Repository != "":
+		return chart.Version, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(appDir, chart.Path, "Chart.yaml"))
+	if err != nil {
+		return "", fmt.Errorf("unable to read Chart.yaml of the local chart: %w", err)
+	}
+	var meta struct {
+		Version string `json:"version"`
+	}
+	if err := yaml.Unmarshal(data, &meta); err != nil {
+		return "", fmt.Errorf("unable to parse Chart.yaml of the local chart: %w", err)
+	}
+	return meta.Version, nil
+}
+
+type promotionArtifactGetter interface {
+	GetPromotionArtifact(ctx context.Context, req *pipedservice.GetPromotionArtifactRequest, opts ...grpc.CallOption) (*pipedservice.GetPromotionArtifactResponse, error)
+}
+
+// promoteArtifact fetches the artifact of the given deployment of the configured source application
+// and verifies that the given artifact contains exactly the same bits.
+// The most recent successful deployment is used when no deployment is given.
+func promoteArtifact(ctx context.Context, client promotionArtifactGetter, promotion *config.KubernetesPromotion, deploymentID string, artifact *model.DeploymentArtifact) error {
+	if client == nil {
+		return fmt.Errorf("unable to fetch the artifact of application %s: no api client was given", promotion.From)
+	}
+	resp, err := client.GetPromotionArtifact(ctx, &pipedservice.GetPromotionArtifactRequest{
+		ApplicationName: promotion.From,
+		DeploymentId:    deploymentID,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to fetch the artifact of application %s (%w)", promotion.From, err)
+	}
+	if err := verifyPromotionArtifact(promotion, resp.Artifact, artifact); err != nil {
+		return fmt.Errorf("unable to promote the artifact of deployment %s of application %s: %w", resp.DeploymentId, promotion.From, err)
+	}
+	artifact.PromotedFromDeploymentId = resp.DeploymentId
+	return nil
+}
+
+func verifyPromotionArtifact(promotion *config.KubernetesPromotion, source, target *model.DeploymentArtifact) error {
+	if promotion.RequireDigest {
+		for _, img := range source.Images {
+			if img.Digest == "" {
+				return fmt.Errorf("image %s of the source artifact is not pinned by digest", img.Reference)
+			}
+		}
+	}
+
+	sourceRefs := make(map[string]struct{}, len(source.Images))
+	for _, img := range source.Images {
+		sourceRefs[img.Reference] = struct{}{}
+	}
+	targetRefs := make(map[string]struct{}, len(target.Images))
+	for _, img := range target.Images {
+		targetRefs[img.Reference] = struct{}{}
+		if _, ok := sourceRefs[img.Reference]; !ok {
+			return fmt.Errorf("image %s is not included in the source artifact", img.Reference)
+		}
+	}
+	for _, img := range source.Images {
+		if _, ok := targetRefs[img.Reference]; !ok {
+			return fmt.Errorf("image %s of the source artifact is not used", img.Reference)
+		}
+	}
+
+	if source.ChartVersion != target.ChartVersion {
+		return fmt.Errorf("chart version %q does not match the source one %q", target.ChartVersion, source.ChartVersion)
+	}
+	if promotion.VerifyManifests && source.ManifestsHash != target.ManifestsHash {
+		return fmt.Errorf("rendered manifests are not identical to the source ones")
+	}
+	return nil
+}
@@ -0,0 +1,282 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestParseArtifactImage(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		ref      string
+		expected *model.ArtifactImage
+	}{
+		{
+			ref: "nginx",
+			expected: &model.ArtifactImage{
+				Reference: "nginx",
+				Name:      "nginx",
+			},
+		},
+		{
+			ref: "gcr.io/pipecd/helloworld:v1.0.0",
+			expected: &model.ArtifactImage{
+				Reference: "gcr.io/pipecd/helloworld:v1.0.0",
+				Name:      "gcr.io/pipecd/helloworld",
+				Tag:       "v1.0.0",
+			},
+		},
+		{
+			ref: "localhost:5000/helloworld",
+			expected: &model.ArtifactImage{
+				Reference: "localhost:5000/helloworld",
+				Name:      "localhost:5000/helloworld",
+			},
+		},
+		{
+			ref: "localhost:5000/helloworld:v1.0.0@sha256:abc",
+			expected: &model.ArtifactImage{
+				Reference: "localhost:5000/helloworld:v1.0.0@sha256:abc",
+				Name:      "localhost:5000/helloworld",
+				Tag:       "v1.0.0",
+				Digest:    "sha256:abc",
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.ref, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, parseArtifactImage(tc.ref))
+		})
+	}
+}
+
+func TestDetermineArtifact(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := provider.LoadManifestsFromYAMLFile("testdata/artifact_workloads.yaml")
+	require.NoError(t, err)
+
+	artifact, err := determineArtifact(manifests, "1.2.0")
+	require.NoError(t, err)
+
+	assert.Equal(t, []*model.ArtifactImage{
+		{
+			Reference: "gcr.io/pipecd/cleanup@sha256:9f8e7d6c5b4a3f2e1d0c9b8a7f6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e",
+			Name:      "gcr.io/pipecd/cleanup",
+			Digest:    "sha256:9f8e7d6c5b4a3f2e1d0c9b8a7f6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e",
+		},
+		{
+			Reference: "gcr.io/pipecd/helloworld:v1.0.0@sha256:2b3c1f0a9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b3a2f1e0d9c8b7a6f5e4d3c2b",
+			Name:      "gcr.io/pipecd/helloworld",
+			Tag:       "v1.0.0",
+			Digest:    "sha256:2b3c1f0a9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b3a2f1e0d9c8b7a6f5e4d3c2b",
+		},
+		{
+			Reference: "localhost:5000/pipecd/migrate:v1.0.0",
+			Name:      "localhost:5000/pipecd/migrate",
+			Tag:       "v1.0.0",
+		},
+	}, artifact.Images)
+	assert.Equal(t, "1.2.0", artifact.ChartVersion)
+	assert.Len(t, artifact.ManifestsHash, 64)
+
+	// The hash must not depend on the order of manifests.
+	reversed := []provider.Manifest{manifests[2], manifests[1], manifests[0]}
+	hash, err := hashManifests(reversed)
+	require.NoError(t, err)
+	assert.Equal(t, artifact.ManifestsHash, hash)
+}
+
+func TestDetermineChartVersion(t *testing.T) {
+	t.Parallel()
+
+	appDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(appDir, "chart"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "chart", "Chart.yaml"), []byte("apiVersion: v2\nname: helloworld\nversion: 0.3.1\n"), 0644))
+
+	testcases := []struct {
+		name     string
+		chart    *config.InputHelmChart
+		expected string
+		wantErr  bool
+	}{
+		{
+			name: "no chart",
+		},
+		{
+			name:     "chart repository",
+			chart:    &config.InputHelmChart{Repository: "pipecd", Name: "helloworld", Version: "v0.1.0"},
+			expected: "v0.1.0",
+		},
+		{
+			name:  "remote git chart",
+			chart: &config.InputHelmChart{GitRemote: "git@github.com:pipe-cd/manifests.git", Ref: "v0.1.0", Path: "manifests/helloworld"},
+		},
+		{
+			name:     "local chart",
+			chart:    &config.InputHelmChart{Path: "chart"},
+			expected: "0.3.1",
+		},
+		{
+			name:    "missing local chart",
+			chart:   &config.InputHelmChart{Path: "not-found"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			version, err := determineChartVersion(appDir, tc.chart)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.expected, version)
+		})
+	}
+}
+
+func TestVerifyPromotionArtifact(t *testing.T) {
+	t.Parallel()
+
+	var (
+		pinned   = parseArtifactImage("gcr.io/pipecd/helloworld:v1.0.0@sha256:abc")
+		unpinned = parseArtifactImage("gcr.io/pipecd/helloworld:v1.0.0")
+		sidecar  = parseArtifactImage("envoyproxy/envoy:v1.28.0")
+	)
+
+	testcases := []struct {
+		name      string
+		promotion config.KubernetesPromotion
+		source    *model.DeploymentArtifact
+		target    *model.DeploymentArtifact
+		wantErr   bool
+	}{
+		{
+			name:   "identical artifacts",
+			source: &model.DeploymentArtifact{Images: []*model.ArtifactImage{pinned, sidecar}, ChartVersion: "1.0.0", ManifestsHash: "a"},
+			target: &model.DeploymentArtifact{Images: []*model.ArtifactImage{pinned, sidecar}, ChartVersion: "1.0.0", ManifestsHash: "b"},
+		},
+		{
+			name:    "different image",
+			source:  &model.DeploymentArtifact{Images: []*model.ArtifactImage{pinned}},
+			target:  &model.DeploymentArtifact{Images: []*model.ArtifactImage{unpinned}},
+			wantErr: true,
+		},
+		{
+			name:    "missing image",
+			source:  &model.DeploymentArtifact{Images: []*model.ArtifactImage{pinned, sidecar}},
+			target:  &model.DeploymentArtifact{Images: []*model.ArtifactImage{pinned}},
+			wantErr: true,
+		},
+		{
+			name:    "different chart version",
+			source:  &model.DeploymentArtifact{Images: []*model.ArtifactImage{pinned}, ChartVersion: "1.0.0"},
+			target:  &model.DeploymentArtifact{Images: []*model.ArtifactImage{pinned}, ChartVersion: "1.1.0"},
+			wantErr: true,
+		},
+		{
+			name:      "image not pinned by digest",
+			promotion: config.KubernetesPromotion{RequireDigest: true},
+			source:    &model.DeploymentArtifact{Images: []*model.ArtifactImage{pinned, sidecar}},
+			target:    &model.DeploymentArtifact{Images: []*model.ArtifactImage{pinned, sidecar}},
+			wantErr:   true,
+		},
+		{
+			name:      "different manifests",
+			promotion: config.KubernetesPromotion{VerifyManifests: true},
+			source:    &model.DeploymentArtifact{Images: []*model.ArtifactImage{pinned}, ManifestsHash: "a"},
+			target:    &model.DeploymentArtifact{Images: []*model.ArtifactImage{pinned}, ManifestsHash: "b"},
+			wantErr:   true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := verifyPromotionArtifact(&tc.promotion, tc.source, tc.target)
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+type fakePromotionArtifactGetter struct {
+	resp *pipedservice.GetPromotionArtifactResponse
+	err  error
+	req  *pipedservice.GetPromotionArtifactRequest
+}
+
+func (f *fakePromotionArtifactGetter) GetPromotionArtifact(_ context.Context, req *pipedservice.GetPromotionArtifactRequest, _ ...grpc.CallOption) (*pipedservice.GetPromotionArtifactResponse, error) {
+	f.req = req
+	return f.resp, f.err
+}
+
+func TestPromoteArtifact(t *testing.T) {
+	t.Parallel()
+
+	image := parseArtifactImage("gcr.io/pipecd/helloworld:v1.0.0@sha256:abc")
+	promotion := &config.KubernetesPromotion{From: "helloworld-staging", RequireDigest: true}
+
+	t.Run("promoted", func(t *testing.T) {
+		t.Parallel()
+		client := &fakePromotionArtifactGetter{
+			resp: &pipedservice.GetPromotionArtifactResponse{
+				DeploymentId: "deployment-1",
+				Artifact:     &model.DeploymentArtifact{Images: []*model.ArtifactImage{image}},
+			},
+		}
+		artifact := &model.DeploymentArtifact{Images: []*model.ArtifactImage{image}}
+		require.NoError(t, promoteArtifact(context.Background(), client, promotion, "", artifact))
+		assert.Equal(t, "deployment-1", artifact.PromotedFromDeploymentId)
+		assert.Equal(t, &pipedservice.GetPromotionArtifactRequest{ApplicationName: "helloworld-staging"}, client.req)
+	})
+
+	t.Run("promoted from the given deployment", func(t *testing.T) {
+		t.Parallel()
+		client := &fakePromotionArtifactGetter{
+			resp: &pipedservice.GetPromotionArtifactResponse{
+				DeploymentId: "deployment-2",
+				Artifact:     &model.DeploymentArtifact{Images: []*model.ArtifactImage{image}},
+			},
+		}
+		artifact := &model.DeploymentArtifact{Images: []*model.ArtifactImage{image}}
+		require.NoError(t, promoteArtifact(context.Background(), client, promotion, "deployment-2", artifact))
+		assert.Equal(t, "deployment-2", artifact.PromotedFromDeploymentId)
+		assert.Equal(t, &pipedservice.GetPromotionArtifactRequest{ApplicationName: "helloworld-staging", DeploymentId: "deployment-2"}, client.req)
+	})
+
+	t.Run("no source artifact", func(t *testing.T) {
+		t.Parallel()
+		client := &fakePromotionArtifactGetter{err: errors.New("not found")}
+		artifact := &model.DeploymentArtifact{Images: []*model.ArtifactImage{image}}
+		assert.Error(t, promoteArtifact(context.Background(), client, promotion, "", artifact))
+		assert.Empty(t, artifact.PromotedFromDeploymentId)
+	})
+}
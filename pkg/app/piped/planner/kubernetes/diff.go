@@ -0,0 +1,105 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/diff"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// Diff renders the diff between the manifests at the running commit and the ones at the target commit.
+// All manifests are treated as added when there is no running commit.
+func (p *Planner) Diff(ctx context.Context, in planner.Input) (*model.DeploymentDiff, error) {
+	newManifests, err := loadManifests(ctx, &in, in.TargetDSP, in.Trigger.Commit.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load manifests at the target commit: %w", err)
+	}
+
+	var oldManifests []provider.Manifest
+	if in.MostRecentSuccessfulCommitHash != "" && in.RunningDSP != nil {
+		oldManifests, err = loadManifests(ctx, &in, in.RunningDSP, in.MostRecentSuccessfulCommitHash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load manifests at the running commit: %w", err)
+		}
+	}
+
+	result, err := provider.DiffList(
+		oldManifests,
+		newManifests,
+		in.Logger,
+		diff.WithEquateEmpty(),
+		diff.WithCompareNumberAndNumericString(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare manifests: %w", err)
+	}
+
+	if result.NoChange() {
+		return &model.DeploymentDiff{
+			NoChange: true,
+			Summary:  "No changes were detected",
+		}, nil
+	}
+
+	return &model.DeploymentDiff{
+		Summary:          fmt.Sprintf("%d added manifests, %d changed manifests, %d deleted manifests", len(result.Adds), len(result.Changes), len(result.Deletes)),
+		ChangedResources: int32(len(result.Adds) + len(result.Changes) + len(result.Deletes)),
+		DeletedResources: int32(len(result.Deletes)),
+		Details: result.Render(provider.DiffRenderOptions{
+			MaskSecret:     true,
+			UseDiffCommand: true,
+		}),
+	}, nil
+}
+
+// loadManifests loads the manifests from the given deploy source of the given commit.
+// The loaded manifests are cached by the commit to avoid loading them again.
+func loadManifests(ctx context.Context, in *planner.Input, dsp deploysource.Provider, commit string) ([]provider.Manifest, error) {
+	manifestCache := provider.AppManifestsCache{
+		AppID:  in.ApplicationID,
+		Cache:  in.AppManifestsCache,
+		Logger: in.Logger,
+	}
+	if manifests, ok := manifestCache.Get(commit); ok {
+		return manifests, nil
+	}
+
+	ds, err := dsp.Get(ctx, io.Discard)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare deploy source data (%w)", err)
+	}
+	cfg := ds.ApplicationConfig.KubernetesApplicationSpec
+	if cfg == nil {
+		return nil, fmt.Errorf("missing KubernetesApplicationSpec in application configuration")
+	}
+	if cfg.Input.HelmChart != nil && cfg.Input.HelmChart.Repository != "" {
+		cfg.Input.HelmChart.Insecure = in.PipedConfig.IsInsecureChartRepository(cfg.Input.HelmChart.Repository)
+	}
+
+	loader := provider.NewLoader(in.ApplicationName, ds.AppDir, ds.RepoDir, in.GitPath.ConfigFilename, cfg.Input, in.GitClient, in.Logger)
+	manifests, err := loader.LoadManifests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	manifestCache.Put(commit, manifests)
+	return manifests, nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// cachedOnlyDSP is used to make sure that manifests are loaded from the cache.
+type cachedOnlyDSP struct {
+	revision string
+}
+
+func (d cachedOnlyDSP) Revision() string {
+	return d.revision
+}
+
+func (d cachedOnlyDSP) Get(_ context.Context, _ io.Writer) (*deploysource.DeploySource, error) {
+	return nil, errors.New("unexpected loading")
+}
+
+func (d cachedOnlyDSP) GetReadOnly(_ context.Context, _ io.Writer) (*deploysource.DeploySource, error) {
+	return nil, errors.New("unexpected loading")
+}
+
+func TestPlannerDiff(t *testing.T) {
+	t.Parallel()
+
+	olds, err := provider.ParseManifests(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      containers:
+      - name: helloworld
+        image: gcr.io/pipecd/helloworld:v1.0.0
+`)
+	require.NoError(t, err)
+	news, err := provider.ParseManifests(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      containers:
+      - name: helloworld
+        image: gcr.io/pipecd/helloworld:v1.1.0
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: simple
+spec:
+  ports:
+  - port: 80
+`)
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name          string
+		runningCommit string
+		olds          []provider.Manifest
+		news          []provider.Manifest
+		expected      *model.DeploymentDiff
+	}{
+		{
+			name: "first deployment",
+			news: news,
+			expected: &model.DeploymentDiff{
+				Summary:          "2 added manifests, 0 changed manifests, 0 deleted manifests",
+				ChangedResources: 2,
+			},
+		},
+		{
+			name:          "changed",
+			runningCommit: "running",
+			olds:          olds,
+			news:          news,
+			expected: &model.DeploymentDiff{
+				Summary:          "1 added manifests, 1 changed manifests, 0 deleted manifests",
+				ChangedResources: 2,
+			},
+		},
+		{
+			name:          "no change",
+			runningCommit: "running",
+			olds:          news,
+			news:          news,
+			expected: &model.DeploymentDiff{
+				NoChange: true,
+				Summary:  "No changes were detected",
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			in := planner.Input{
+				ApplicationID:                  "app-id",
+				Trigger:                        model.DeploymentTrigger{Commit: &model.Commit{Hash: "target"}},
+				MostRecentSuccessfulCommitHash: tc.runningCommit,
+				TargetDSP:                      cachedOnlyDSP{revision: "target"},
+				AppManifestsCache:              memorycache.NewCache(),
+				Logger:                         zap.NewNop(),
+			}
+			manifestsCache := provider.AppManifestsCache{AppID: in.ApplicationID, Cache: in.AppManifestsCache, Logger: in.Logger}
+			manifestsCache.Put("target", tc.news)
+			if tc.runningCommit != "" {
+				in.RunningDSP = cachedOnlyDSP{revision: tc.runningCommit}
+				manifestsCache.Put(tc.runningCommit, tc.olds)
+			}
+
+			p := &Planner{}
+			got, err := p.Diff(context.Background(), in)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected.NoChange, got.NoChange)
+			assert.Equal(t, tc.expected.Summary, got.Summary)
+			assert.Equal(t, tc.expected.ChangedResources, got.ChangedResources)
+			assert.Equal(t, tc.expected.DeletedResources, got.DeletedResources)
+			assert.Equal(t, tc.expected.NoChange, got.Details == "")
+		})
+	}
+}
@@ -25,7 +25,6 @@ import (
 
 	"go.uber.org/zap"
 
-	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/planner"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes/resource"
@@ -64,29 +63,9 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 		return
 	}
 
-	if cfg.Input.HelmChart != nil {
-		chartRepoName := cfg.Input.HelmChart.Repository
-		if chartRepoName != "" {
-			cfg.Input.HelmChart.Insecure = in.PipedConfig.IsInsecureChartRepository(chartRepoName)
-		}
-	}
-
-	manifestCache := provider.AppManifestsCache{
-		AppID:  in.ApplicationID,
-		Cache:  in.AppManifestsCache,
-		Logger: in.Logger,
-	}
-
-	// Load previous deployed manifests and new manifests to compare.
-	newManifests, ok := manifestCache.Get(in.Trigger.Commit.Hash)
-	if !ok {
-		// When the manifests were not in the cache we have to load them.
-		loader := provider.NewLoader(in.ApplicationName, ds.AppDir, ds.RepoDir, in.GitPath.ConfigFilename, cfg.Input, in.GitClient, in.Logger)
-		newManifests, err = loader.LoadManifests(ctx)
-		if err != nil {
-			return
-		}
-		manifestCache.Put(in.Trigger.Commit.Hash, newManifests)
+	newManifests, err := loadManifests(ctx, &in, in.TargetDSP, in.Trigger.Commit.Hash)
+	if err != nil {
+		return
 	}
 
 	// Determine application version from the manifests.
@@ -109,6 +88,27 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 		out.Versions = versions
 	}
 
+	// Record the artifact of this deployment to make it promotable to other applications.
+	artifact, err := buildArtifact(ds.AppDir, cfg.Input.HelmChart, newManifests)
+	if err != nil {
+		if cfg.Promotion != nil {
+			err = fmt.Errorf("unable to determine the artifact to promote (%w)", err)
+			return
+		}
+		in.Logger.Warn("unable to determine artifact", zap.Error(err))
+		err = nil
+	}
+	switch {
+	case cfg.Promotion != nil:
+		if err = promoteArtifact(ctx, in.APIClient, cfg.Promotion, in.Trigger.PromoteFromDeploymentId, artifact); err != nil {
+			return
+		}
+	case in.Trigger.PromoteFromDeploymentId != "":
+		err = fmt.Errorf("unable to promote the artifact of deployment %s since promotion is not configured", in.Trigger.PromoteFromDeploymentId)
+		return
+	}
+	out.Artifact = artifact
+
 	autoRollback := *cfg.Input.AutoRollback
 
 	// In case the strategy has been decided by trigger.
@@ -190,28 +190,10 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 	}
 
 	// Load manifests of the previously applied commit.
-	oldManifests, ok := manifestCache.Get(in.MostRecentSuccessfulCommitHash)
-	if !ok {
-		// When the manifests were not in the cache we have to load them.
-		var runningDs *deploysource.DeploySource
-		runningDs, err = in.RunningDSP.Get(ctx, io.Discard)
-		if err != nil {
-			err = fmt.Errorf("failed to prepare the running deploy source data (%v)", err)
-			return
-		}
-
-		runningCfg := runningDs.ApplicationConfig.KubernetesApplicationSpec
-		if runningCfg == nil {
-			err = fmt.Errorf("unable to find the running configuration (%v)", err)
-			return
-		}
-		loader := provider.NewLoader(in.ApplicationName, runningDs.AppDir, runningDs.RepoDir, in.GitPath.ConfigFilename, runningCfg.Input, in.GitClient, in.Logger)
-		oldManifests, err = loader.LoadManifests(ctx)
-		if err != nil {
-			err = fmt.Errorf("failed to load previously deployed manifests: %w", err)
-			return
-		}
-		manifestCache.Put(in.MostRecentSuccessfulCommitHash, oldManifests)
+	oldManifests, err := loadManifests(ctx, &in, in.RunningDSP, in.MostRecentSuccessfulCommitHash)
+	if err != nil {
+		err = fmt.Errorf("failed to load previously deployed manifests: %w", err)
+		return
 	}
 
 	progressive, desc := decideStrategy(oldManifests, newManifests, cfg.Workloads, in.Logger)
@@ -24,8 +24,10 @@ import (
 	"strings"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	"github.com/pipe-cd/pipecd/pkg/cache"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/git"
@@ -37,10 +39,22 @@ type Planner interface {
 	Plan(ctx context.Context, in Input) (Output, error)
 }
 
+// Differ is implemented by the planners which are able to render
+// the diff between the running commit and the target commit of a deployment.
+// Only the summary, details and no-change flag of the returned diff are used.
+// Nil is returned when there is nothing to compare with.
+type Differ interface {
+	Diff(ctx context.Context, in Input) (*model.DeploymentDiff, error)
+}
+
 type gitClient interface {
 	Clone(ctx context.Context, repoID, remote, branch, destination string) (git.Repo, error)
 }
 
+type apiClient interface {
+	GetPromotionArtifact(ctx context.Context, req *pipedservice.GetPromotionArtifactRequest, opts ...grpc.CallOption) (*pipedservice.GetPromotionArtifactResponse, error)
+}
+
 type Input struct {
 	ApplicationID                  string
 	ApplicationName                string
@@ -53,6 +67,7 @@ type Input struct {
 	AppManifestsCache              cache.Cache
 	RegexPool                      *regexpool.Pool
 	GitClient                      gitClient
+	APIClient                      apiClient
 	Logger                         *zap.Logger
 }
 
@@ -62,6 +77,9 @@ type Output struct {
 	SyncStrategy model.SyncStrategy
 	Summary      string
 	Stages       []*model.PipelineStage
+	// Artifact is the immutable set of bits this deployment is going to deploy.
+	// Nil means the planner does not support recording the artifact.
+	Artifact *model.DeploymentArtifact
 }
 
 // MakeInitialStageMetadata makes the initial metadata for the given state configuration.
@@ -15,9 +15,9 @@
 package planpreview
 
 import (
-	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"os"
 
 	"go.uber.org/zap"
@@ -64,6 +64,7 @@ type builder struct {
 	appManifestsCache cache.Cache
 	regexPool         *regexpool.Pool
 	pipedCfg          *config.PipedSpec
+	diffRenderers     map[model.ApplicationKind]diffRenderer
 	logger            *zap.Logger
 
 	workingDir string
@@ -82,7 +83,7 @@ func newBuilder(
 	logger *zap.Logger,
 ) *builder {
 
-	return &builder{
+	b := &builder{
 		gitClient:         gc,
 		apiClient:         ac,
 		applicationLister: al,
@@ -93,6 +94,8 @@ func newBuilder(
 		pipedCfg:          cfg,
 		logger:            logger.Named("plan-preview-builder"),
 	}
+	b.diffRenderers = b.defaultDiffRenderers()
+	return b
 }
 
 func (b *builder) Build(ctx context.Context, id string, cmd model.Command_BuildPlanPreview) (results []*model.ApplicationPlanPreviewResult, err error) {
@@ -228,44 +231,34 @@ func (b *builder) buildApp(ctx context.Context, worker int, command string, app
 		deploysource.NewLocalSourceCloner(repo, "target", mergedCommit),
 		*app.GitPath,
 		b.secretDecrypter,
+		deploysource.WithEnv(b.pipedCfg.AppEnv),
 	)
 
-	strategy, err := b.plan(ctx, app, targetDSP, preCommit)
+	strategy, stages, err := b.plan(ctx, app, targetDSP, preCommit)
 	if err != nil {
 		r.Error = fmt.Sprintf("failed while planning, %v", err)
 		return r
 	}
 	r.SyncStrategy = strategy
+	r.Stages = stages
 
 	logger.Info("successfully decided sync strategy for a application", zap.String("strategy", strategy.String()))
 
-	var buf bytes.Buffer
-	var dr *diffResult
-
-	switch app.Kind {
-	case model.ApplicationKind_KUBERNETES:
-		dr, err = b.kubernetesDiff(ctx, app, targetDSP, preCommit, &buf)
-	case model.ApplicationKind_TERRAFORM:
-		dr, err = b.terraformDiff(ctx, app, targetDSP, &buf)
-	case model.ApplicationKind_CLOUDRUN:
-		dr, err = b.cloudrundiff(ctx, app, targetDSP, preCommit, &buf)
-	case model.ApplicationKind_ECS:
-		dr, err = b.ecsdiff(ctx, app, targetDSP, preCommit, &buf)
-	case model.ApplicationKind_LAMBDA:
-		dr, err = b.lambdadiff(ctx, app, targetDSP, preCommit, &buf)
-	default:
-		dr = &diffResult{
-			summary: fmt.Sprintf("%s application is not implemented yet (coming soon)", app.Kind.String()),
-		}
+	in := diffInput{
+		app:       app,
+		targetDSP: targetDSP,
 	}
-
-	if dr != nil {
-		r.PlanSummary = []byte(dr.summary)
-		r.NoChange = dr.noChange
+	if preCommit != "" {
+		in.runningDSP = deploysource.NewProvider(
+			b.workingDir,
+			deploysource.NewGitSourceCloner(b.gitClient, b.repoCfg, "running", preCommit),
+			*app.GitPath,
+			b.secretDecrypter,
+			deploysource.WithEnv(b.pipedCfg.AppEnv),
+		)
 	}
-	r.PlanDetails = buf.Bytes()
 
-	if err != nil {
+	if err := b.renderDiff(ctx, in, r); err != nil {
 		r.Error = fmt.Sprintf("failed while calculating diff, %v", err)
 		return r
 	}
@@ -273,11 +266,6 @@ func (b *builder) buildApp(ctx context.Context, worker int, command string, app
 	return r
 }
 
-type diffResult struct {
-	summary  string
-	noChange bool
-}
-
 func (b *builder) cloneHeadCommit(ctx context.Context, headBranch, headCommit string) (git.Repo, error) {
 	dir, err := os.MkdirTemp(b.workingDir, "")
 	if err != nil {
@@ -328,7 +316,7 @@ func (b *builder) findTriggerApps(ctx context.Context, repo git.Repo, apps []*mo
 	return
 }
 
-func (b *builder) plan(ctx context.Context, app *model.Application, targetDSP deploysource.Provider, lastSuccessfulCommit string) (strategy model.SyncStrategy, err error) {
+func (b *builder) plan(ctx context.Context, app *model.Application, targetDSP deploysource.Provider, lastSuccessfulCommit string) (strategy model.SyncStrategy, stages []*model.PlanPreviewStage, err error) {
 	p, ok := defaultPlannerRegistry.Planner(app.Kind)
 	if !ok {
 		err = fmt.Errorf("application kind %s is not supported yet", app.Kind.String())
@@ -360,6 +348,7 @@ func (b *builder) plan(ctx context.Context, app *model.Application, targetDSP de
 			deploysource.NewGitSourceCloner(b.gitClient, b.repoCfg, "running", lastSuccessfulCommit),
 			*app.GitPath,
 			b.secretDecrypter,
+			deploysource.WithEnv(b.pipedCfg.AppEnv),
 		)
 	}
 
@@ -369,6 +358,15 @@ func (b *builder) plan(ctx context.Context, app *model.Application, targetDSP de
 	}
 
 	strategy = out.SyncStrategy
+
+	// The application config has been loaded by the planner so this does not clone the repository again.
+	ds, err := targetDSP.GetReadOnly(ctx, io.Discard)
+	if err != nil {
+		return
+	}
+	if spec, ok := ds.ApplicationConfig.GetGenericApplication(); ok {
+		stages = makePlanPreviewStages(out.Stages, spec)
+	}
 	return
 }
 
@@ -26,36 +26,24 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
-func (b *builder) cloudrundiff(
-	ctx context.Context,
-	app *model.Application,
-	targetDSP deploysource.Provider,
-	lastCommit string,
-	buf *bytes.Buffer,
-) (*diffResult, error) {
+func (b *builder) cloudrundiff(ctx context.Context, in diffInput, buf *bytes.Buffer) (*diffResult, error) {
 	var (
 		oldManifest, newManifest provider.ServiceManifest
 		err                      error
 	)
 
-	newManifest, err = b.loadCloudRunManifest(ctx, *app, targetDSP)
+	newManifest, err = b.loadCloudRunManifest(ctx, *in.app, in.targetDSP)
 	if err != nil {
 		fmt.Fprintf(buf, "failed to load cloud run manifest at the head commit (%v)\n", err)
 		return nil, err
 	}
+	resource := "Service/" + newManifest.Name
 
-	if lastCommit == "" {
-		fmt.Fprintf(buf, "failed to find the commit of the last successful deployment")
-		return nil, fmt.Errorf("cannot get the old manifest without the last successful deployment")
+	if in.runningDSP == nil {
+		return noRunningStateResult(buf, newResourceChange(resource, model.PlanPreviewResourceChange_ADD)), nil
 	}
 
-	runningDSP := deploysource.NewProvider(
-		b.workingDir,
-		deploysource.NewGitSourceCloner(b.gitClient, b.repoCfg, "running", lastCommit),
-		*app.GitPath,
-		b.secretDecrypter,
-	)
-	oldManifest, err = b.loadCloudRunManifest(ctx, *app, runningDSP)
+	oldManifest, err = b.loadCloudRunManifest(ctx, *in.app, in.runningDSP)
 	if err != nil {
 		fmt.Fprintf(buf, "failed to load cloud run manifest at the running commit (%v)\n", err)
 		return nil, err
@@ -89,8 +77,10 @@ func (b *builder) cloudrundiff(
 
 	return &diffResult{
 		summary: summary,
+		changes: []*model.PlanPreviewResourceChange{
+			newResourceChange(resource, model.PlanPreviewResourceChange_CHANGE),
+		},
 	}, nil
-
 }
 
 func (b *builder) loadCloudRunManifest(ctx context.Context, app model.Application, dsp deploysource.Provider) (provider.ServiceManifest, error) {
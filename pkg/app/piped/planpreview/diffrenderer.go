@@ -0,0 +1,143 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planpreview
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// The syntaxes of the rendered plan details.
+	// They are used by the clients as a hint for highlighting.
+	diffSyntax = "diff"
+	hclSyntax  = "hcl"
+
+	// maxPlanDetailsSize is the maximum number of bytes of the plan details of an application.
+	// The details exceeding this size are truncated at a line boundary.
+	maxPlanDetailsSize      = 512 * 1024
+	truncatedDetailsMessage = "\n... (truncated %d bytes because the details exceeded the size limit)\n"
+)
+
+// diffRenderer calculates the diff between the last successfully deployed state
+// and the head commit of an application, and renders it in both structured and
+// human-readable forms.
+// Each application kind supported by plan-preview contributes its own implementation.
+type diffRenderer interface {
+	// Render writes the human-readable diff into buf and returns the structured result.
+	Render(ctx context.Context, in diffInput, buf *bytes.Buffer) (*diffResult, error)
+	// Syntax returns the syntax of the rendered diff.
+	Syntax() string
+}
+
+type diffInput struct {
+	app       *model.Application
+	targetDSP deploysource.Provider
+	// The deploy source at the commit of the last successful deployment.
+	// This is nil when the application has never been deployed successfully.
+	runningDSP deploysource.Provider
+}
+
+type diffResult struct {
+	summary  string
+	noChange bool
+	changes  []*model.PlanPreviewResourceChange
+}
+
+// diffRenderFunc is an adapter to allow the use of ordinary functions as diffRenderer.
+type diffRenderFunc struct {
+	render func(ctx context.Context, in diffInput, buf *bytes.Buffer) (*diffResult, error)
+	syntax string
+}
+
+func (f diffRenderFunc) Render(ctx context.Context, in diffInput, buf *bytes.Buffer) (*diffResult, error) {
+	return f.render(ctx, in, buf)
+}
+
+func (f diffRenderFunc) Syntax() string {
+	return f.syntax
+}
+
+func (b *builder) defaultDiffRenderers() map[model.ApplicationKind]diffRenderer {
+	return map[model.ApplicationKind]diffRenderer{
+		model.ApplicationKind_KUBERNETES: diffRenderFunc{render: b.kubernetesDiff, syntax: diffSyntax},
+		model.ApplicationKind_TERRAFORM:  diffRenderFunc{render: b.terraformDiff, syntax: hclSyntax},
+		model.ApplicationKind_CLOUDRUN:   diffRenderFunc{render: b.cloudrundiff, syntax: diffSyntax},
+		model.ApplicationKind_ECS:        diffRenderFunc{render: b.ecsdiff, syntax: diffSyntax},
+		model.ApplicationKind_LAMBDA:     diffRenderFunc{render: b.lambdadiff, syntax: diffSyntax},
+	}
+}
+
+// renderDiff renders the diff of the given application by using the renderer
+// registered for its kind and fills the plan fields of the result.
+func (b *builder) renderDiff(ctx context.Context, in diffInput, r *model.ApplicationPlanPreviewResult) error {
+	renderer, ok := b.diffRenderers[in.app.Kind]
+	if !ok {
+		r.PlanSummary = []byte(fmt.Sprintf("%s application is not implemented yet (coming soon)", in.app.Kind.String()))
+		return nil
+	}
+
+	var buf bytes.Buffer
+	dr, err := renderer.Render(ctx, in, &buf)
+	if dr != nil {
+		r.PlanSummary = []byte(dr.summary)
+		r.NoChange = dr.noChange
+		r.ResourceChanges = dr.changes
+	}
+	r.PlanDetails, r.PlanDetailsTruncated = truncatePlanDetails(buf.Bytes(), maxPlanDetailsSize)
+	r.PlanDetailsSyntax = renderer.Syntax()
+
+	return err
+}
+
+// truncatePlanDetails cuts the given details at the last line boundary before the limit
+// and appends a message telling how many bytes were omitted.
+func truncatePlanDetails(details []byte, limit int) ([]byte, bool) {
+	if len(details) <= limit {
+		return details, false
+	}
+
+	cut := limit
+	if i := bytes.LastIndexByte(details[:limit], '\n'); i >= 0 {
+		cut = i + 1
+	}
+
+	truncated := make([]byte, 0, cut+len(truncatedDetailsMessage)+16)
+	truncated = append(truncated, details[:cut]...)
+	truncated = append(truncated, fmt.Sprintf(truncatedDetailsMessage, len(details)-cut)...)
+	return truncated, true
+}
+
+// noRunningStateResult returns the result for an application that has never been
+// deployed successfully, which means all of its resources will be added.
+func noRunningStateResult(buf *bytes.Buffer, changes ...*model.PlanPreviewResourceChange) *diffResult {
+	summary := fmt.Sprintf("%d resources will be added since no successful deployment was found", len(changes))
+	fmt.Fprintln(buf, summary)
+	return &diffResult{
+		summary: summary,
+		changes: changes,
+	}
+}
+
+func newResourceChange(resource string, action model.PlanPreviewResourceChange_Action) *model.PlanPreviewResourceChange {
+	return &model.PlanPreviewResourceChange{
+		Resource: resource,
+		Action:   action,
+	}
+}
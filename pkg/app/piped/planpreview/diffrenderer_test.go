@@ -0,0 +1,132 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planpreview
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestTruncatePlanDetails(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name          string
+		details       string
+		limit         int
+		expected      string
+		expectedTrunc bool
+	}{
+		{
+			name:     "empty",
+			details:  "",
+			limit:    10,
+			expected: "",
+		},
+		{
+			name:     "within the limit",
+			details:  "line-1\nline-2\n",
+			limit:    14,
+			expected: "line-1\nline-2\n",
+		},
+		{
+			name:          "cut at the line boundary",
+			details:       "line-1\nline-2\nline-3\n",
+			limit:         16,
+			expected:      "line-1\nline-2\n\n... (truncated 7 bytes because the details exceeded the size limit)\n",
+			expectedTrunc: true,
+		},
+		{
+			name:          "no line boundary",
+			details:       "0123456789",
+			limit:         4,
+			expected:      "0123\n... (truncated 6 bytes because the details exceeded the size limit)\n",
+			expectedTrunc: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, truncated := truncatePlanDetails([]byte(tc.details), tc.limit)
+			assert.Equal(t, tc.expected, string(got))
+			assert.Equal(t, tc.expectedTrunc, truncated)
+		})
+	}
+}
+
+func TestRenderDiff(t *testing.T) {
+	t.Parallel()
+
+	changes := []*model.PlanPreviewResourceChange{
+		newResourceChange("Service/simple", model.PlanPreviewResourceChange_CHANGE),
+	}
+	b := &builder{
+		diffRenderers: map[model.ApplicationKind]diffRenderer{
+			model.ApplicationKind_CLOUDRUN: diffRenderFunc{
+				render: func(_ context.Context, _ diffInput, buf *bytes.Buffer) (*diffResult, error) {
+					buf.WriteString("diff-details")
+					return &diffResult{summary: "1 changes were detected", changes: changes}, nil
+				},
+				syntax: diffSyntax,
+			},
+			model.ApplicationKind_TERRAFORM: diffRenderFunc{
+				render: func(_ context.Context, _ diffInput, buf *bytes.Buffer) (*diffResult, error) {
+					buf.WriteString("failed while executing terraform init")
+					return nil, errors.New("init error")
+				},
+				syntax: hclSyntax,
+			},
+		},
+	}
+
+	t.Run("registered kind", func(t *testing.T) {
+		t.Parallel()
+		r := &model.ApplicationPlanPreviewResult{}
+		err := b.renderDiff(context.Background(), diffInput{app: &model.Application{Kind: model.ApplicationKind_CLOUDRUN}}, r)
+		require.NoError(t, err)
+		assert.Equal(t, "1 changes were detected", string(r.PlanSummary))
+		assert.Equal(t, "diff-details", string(r.PlanDetails))
+		assert.Equal(t, diffSyntax, r.PlanDetailsSyntax)
+		assert.False(t, r.PlanDetailsTruncated)
+		assert.Equal(t, changes, r.ResourceChanges)
+	})
+
+	t.Run("renderer returned error", func(t *testing.T) {
+		t.Parallel()
+		r := &model.ApplicationPlanPreviewResult{}
+		err := b.renderDiff(context.Background(), diffInput{app: &model.Application{Kind: model.ApplicationKind_TERRAFORM}}, r)
+		require.Error(t, err)
+		assert.Equal(t, "failed while executing terraform init", string(r.PlanDetails))
+		assert.Equal(t, hclSyntax, r.PlanDetailsSyntax)
+	})
+
+	t.Run("unregistered kind", func(t *testing.T) {
+		t.Parallel()
+		r := &model.ApplicationPlanPreviewResult{}
+		err := b.renderDiff(context.Background(), diffInput{app: &model.Application{Kind: model.ApplicationKind_ECS}}, r)
+		require.NoError(t, err)
+		assert.Equal(t, "ECS application is not implemented yet (coming soon)", string(r.PlanSummary))
+		assert.Empty(t, r.PlanDetails)
+	})
+}
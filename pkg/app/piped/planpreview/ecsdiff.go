@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
@@ -28,37 +29,30 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
-func (b *builder) ecsdiff(
-	ctx context.Context,
-	app *model.Application,
-	targetDSP deploysource.Provider,
-	lastCommit string,
-	buf *bytes.Buffer,
-) (*diffResult, error) {
+func (b *builder) ecsdiff(ctx context.Context, in diffInput, buf *bytes.Buffer) (*diffResult, error) {
 	var (
 		oldManifests, newManifests provider.ECSManifests
 		err                        error
 	)
 
-	newManifests, err = b.loadECSManifests(ctx, *app, targetDSP)
+	newManifests, err = b.loadECSManifests(ctx, *in.app, in.targetDSP)
 	if err != nil {
 		fmt.Fprintf(buf, "failed to load ecs manifests at the head commit (%v)\n", err)
 		return nil, err
 	}
+	taskDefinition, service := ecsResourceNames(newManifests)
 
-	if lastCommit == "" {
-		fmt.Fprintf(buf, "failed to find the commit of the last successful deployment")
-		return nil, fmt.Errorf("cannot get the old manifests without the last successful deployment")
+	if in.runningDSP == nil {
+		changes := []*model.PlanPreviewResourceChange{
+			newResourceChange(taskDefinition, model.PlanPreviewResourceChange_ADD),
+		}
+		if service != "" {
+			changes = append(changes, newResourceChange(service, model.PlanPreviewResourceChange_ADD))
+		}
+		return noRunningStateResult(buf, changes...), nil
 	}
 
-	runningDSP := deploysource.NewProvider(
-		b.workingDir,
-		deploysource.NewGitSourceCloner(b.gitClient, b.repoCfg, "running", lastCommit),
-		*app.GitPath,
-		b.secretDecrypter,
-	)
-
-	oldManifests, err = b.loadECSManifests(ctx, *app, runningDSP)
+	oldManifests, err = b.loadECSManifests(ctx, *in.app, in.runningDSP)
 	if err != nil {
 		fmt.Fprintf(buf, "failed to load ecs manifests at the running commit (%v)\n", err)
 		return nil, err
@@ -88,11 +82,30 @@ func (b *builder) ecsdiff(
 	})
 	fmt.Fprintf(buf, "--- Last Deploy\n+++ Head Commit\n\n%s\n", details)
 
+	var changes []*model.PlanPreviewResourceChange
+	if len(result.Diff.Nodes().FindByPrefix("TaskDefinition")) > 0 {
+		changes = append(changes, newResourceChange(taskDefinition, model.PlanPreviewResourceChange_CHANGE))
+	}
+	if service != "" && len(result.Diff.Nodes().FindByPrefix("ServiceDefinition")) > 0 {
+		changes = append(changes, newResourceChange(service, model.PlanPreviewResourceChange_CHANGE))
+	}
+
 	return &diffResult{
 		summary: fmt.Sprintf("%d changes were detected", len(result.Diff.Nodes())),
+		changes: changes,
 	}, nil
 }
 
+// ecsResourceNames returns the readable names of the task definition and the service
+// defined in the given manifests. The service name is empty for standalone tasks.
+func ecsResourceNames(m provider.ECSManifests) (taskDefinition, service string) {
+	taskDefinition = "TaskDefinition/" + aws.ToString(m.TaskDefinition.Family)
+	if m.ServiceDefinition != nil && m.ServiceDefinition.ServiceName != nil {
+		service = "Service/" + aws.ToString(m.ServiceDefinition.ServiceName)
+	}
+	return
+}
+
 func (b *builder) loadECSManifests(ctx context.Context, app model.Application, dsp deploysource.Provider) (provider.ECSManifests, error) {
 	commit := dsp.Revision()
 	cache := provider.ECSManifestsCache{
@@ -116,14 +129,15 @@ func (b *builder) loadECSManifests(ctx context.Context, app model.Application, d
 		return provider.ECSManifests{}, fmt.Errorf("malformed application configuration file")
 	}
 
-	taskDef, err := provider.LoadTaskDefinition(ds.AppDir, appCfg.Input.TaskDefinitionFile)
+	td := provider.NewTemplateData(appCfg, app.Id, app.PipedId, ds.Revision)
+	taskDef, err := provider.LoadTaskDefinition(ds.AppDir, appCfg.Input.TaskDefinitionFile, td)
 	if err != nil {
 		return provider.ECSManifests{}, err
 	}
 
 	serviceDef := types.Service{}
 	if !appCfg.Input.IsStandaloneTask() {
-		serviceDef, err = provider.LoadServiceDefinition(ds.AppDir, appCfg.Input.ServiceDefinitionFile)
+		serviceDef, err = provider.LoadServiceDefinition(ds.AppDir, appCfg.Input.ServiceDefinitionFile, td)
 		if err != nil {
 			return provider.ECSManifests{}, err
 		}
@@ -29,31 +29,18 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
-func (b *builder) kubernetesDiff(
-	ctx context.Context,
-	app *model.Application,
-	targetDSP deploysource.Provider,
-	lastSuccessfulCommit string,
-	buf *bytes.Buffer,
-) (*diffResult, error) {
-
+func (b *builder) kubernetesDiff(ctx context.Context, in diffInput, buf *bytes.Buffer) (*diffResult, error) {
 	var oldManifests, newManifests []provider.Manifest
 	var err error
 
-	newManifests, err = loadKubernetesManifests(ctx, *app, targetDSP, b.appManifestsCache, b.gitClient, b.logger)
+	newManifests, err = loadKubernetesManifests(ctx, *in.app, in.targetDSP, b.appManifestsCache, b.gitClient, b.logger)
 	if err != nil {
 		fmt.Fprintf(buf, "failed to load kubernetes manifests at the head commit (%v)\n", err)
 		return nil, err
 	}
 
-	if lastSuccessfulCommit != "" {
-		runningDSP := deploysource.NewProvider(
-			b.workingDir,
-			deploysource.NewGitSourceCloner(b.gitClient, b.repoCfg, "running", lastSuccessfulCommit),
-			*app.GitPath,
-			b.secretDecrypter,
-		)
-		oldManifests, err = loadKubernetesManifests(ctx, *app, runningDSP, b.appManifestsCache, b.gitClient, b.logger)
+	if in.runningDSP != nil {
+		oldManifests, err = loadKubernetesManifests(ctx, *in.app, in.runningDSP, b.appManifestsCache, b.gitClient, b.logger)
 		if err != nil {
 			fmt.Fprintf(buf, "failed to load kubernetes manifests at the running commit (%v)\n", err)
 			return nil, err
@@ -89,9 +76,24 @@ func (b *builder) kubernetesDiff(
 
 	return &diffResult{
 		summary: summary,
+		changes: kubernetesResourceChanges(result),
 	}, nil
 }
 
+func kubernetesResourceChanges(result *provider.DiffListResult) []*model.PlanPreviewResourceChange {
+	changes := make([]*model.PlanPreviewResourceChange, 0, len(result.Adds)+len(result.Changes)+len(result.Deletes))
+	for _, m := range result.Adds {
+		changes = append(changes, newResourceChange(m.Key.String(), model.PlanPreviewResourceChange_ADD))
+	}
+	for _, c := range result.Changes {
+		changes = append(changes, newResourceChange(c.New.Key.String(), model.PlanPreviewResourceChange_CHANGE))
+	}
+	for _, m := range result.Deletes {
+		changes = append(changes, newResourceChange(m.Key.String(), model.PlanPreviewResourceChange_DELETE))
+	}
+	return changes
+}
+
 func loadKubernetesManifests(ctx context.Context, app model.Application, dsp deploysource.Provider, manifestsCache cache.Cache, gc gitClient, logger *zap.Logger) (manifests []provider.Manifest, err error) {
 	commit := dsp.Revision()
 	cache := provider.AppManifestsCache{
@@ -26,37 +26,24 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
-func (b *builder) lambdadiff(
-	ctx context.Context,
-	app *model.Application,
-	targetDSP deploysource.Provider,
-	lastCommit string,
-	buf *bytes.Buffer,
-) (*diffResult, error) {
+func (b *builder) lambdadiff(ctx context.Context, in diffInput, buf *bytes.Buffer) (*diffResult, error) {
 	var (
 		oldManifest, newManifest provider.FunctionManifest
 		err                      error
 	)
 
-	newManifest, err = b.loadFunctionManifest(ctx, *app, targetDSP)
+	newManifest, err = b.loadFunctionManifest(ctx, *in.app, in.targetDSP)
 	if err != nil {
 		fmt.Fprintf(buf, "failed to load lambda manifest at the head commit (%v)\n", err)
 		return nil, err
 	}
+	resource := "Function/" + newManifest.Spec.Name
 
-	if lastCommit == "" {
-		fmt.Fprintf(buf, "failed to find the commit of the last successful deployment")
-		return nil, fmt.Errorf("cannot get the old manifest without the last successful deployment")
+	if in.runningDSP == nil {
+		return noRunningStateResult(buf, newResourceChange(resource, model.PlanPreviewResourceChange_ADD)), nil
 	}
 
-	runningDSP := deploysource.NewProvider(
-		b.workingDir,
-		deploysource.NewGitSourceCloner(b.gitClient, b.repoCfg, "running", lastCommit),
-		*app.GitPath,
-		b.secretDecrypter,
-	)
-
-	oldManifest, err = b.loadFunctionManifest(ctx, *app, runningDSP)
+	oldManifest, err = b.loadFunctionManifest(ctx, *in.app, in.runningDSP)
 	if err != nil {
 		fmt.Fprintf(buf, "failed to load lambda manifest at the running commit (%v)\n", err)
 		return nil, err
@@ -88,6 +75,9 @@ func (b *builder) lambdadiff(
 
 	return &diffResult{
 		summary: fmt.Sprintf("%d changes were detected", len(result.Diff.Nodes())),
+		changes: []*model.PlanPreviewResourceChange{
+			newResourceChange(resource, model.PlanPreviewResourceChange_CHANGE),
+		},
 	}, nil
 }
 
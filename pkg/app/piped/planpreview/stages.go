@@ -0,0 +1,81 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planpreview
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// makePlanPreviewStages converts the planned stages to the ones shown to the reviewers.
+// The stages which are not rendered, such as the rollback ones, are excluded.
+func makePlanPreviewStages(stages []*model.PipelineStage, spec config.GenericApplicationSpec) []*model.PlanPreviewStage {
+	out := make([]*model.PlanPreviewStage, 0, len(stages))
+	for _, s := range stages {
+		if s.Rollback || !s.Visible {
+			continue
+		}
+		ps := &model.PlanPreviewStage{
+			Name: s.Name,
+			Desc: s.Desc,
+		}
+		if !s.Predefined {
+			if cfg, ok := spec.GetStage(s.Index); ok && cfg.Name.String() == s.Name {
+				ps.Parameters = stageParameters(cfg)
+			}
+		}
+		out = append(out, ps)
+	}
+	return out
+}
+
+// stageParameters returns the key parameters of the given stage
+// which help the reviewers to understand how the deployment will be progressed.
+func stageParameters(s config.PipelineStage) []string {
+	var params []string
+	switch s.Name {
+	case model.StageWait:
+		if o := s.WaitStageOptions; o != nil {
+			params = append(params, fmt.Sprintf("duration: %s", o.Duration.Duration()))
+		}
+	case model.StageWaitApproval:
+		if o := s.WaitApprovalStageOptions; o != nil {
+			if len(o.Approvers) > 0 {
+				params = append(params, fmt.Sprintf("approvers: %s", strings.Join(o.Approvers, ", ")))
+			}
+			params = append(params,
+				fmt.Sprintf("minApproverNum: %d", o.MinApproverNum),
+				fmt.Sprintf("timeout: %s", o.Timeout.Duration()),
+			)
+		}
+	case model.StageAnalysis:
+		if o := s.AnalysisStageOptions; o != nil {
+			params = append(params, fmt.Sprintf("duration: %s", o.Duration.Duration()))
+			if n := len(o.Metrics); n > 0 {
+				params = append(params, fmt.Sprintf("metrics: %d", n))
+			}
+			if n := len(o.Logs); n > 0 {
+				params = append(params, fmt.Sprintf("logs: %d", n))
+			}
+			if n := len(o.HTTPS); n > 0 {
+				params = append(params, fmt.Sprintf("https: %d", n))
+			}
+		}
+	}
+	return params
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planpreview
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestMakePlanPreviewStages(t *testing.T) {
+	t.Parallel()
+
+	spec := config.GenericApplicationSpec{
+		Pipeline: &config.DeploymentPipeline{
+			Stages: []config.PipelineStage{
+				{
+					Name:                         model.StageK8sCanaryRollout,
+					K8sCanaryRolloutStageOptions: &config.K8sCanaryRolloutStageOptions{},
+				},
+				{
+					Name: model.StageAnalysis,
+					AnalysisStageOptions: &config.AnalysisStageOptions{
+						Duration: config.Duration(10 * time.Minute),
+						Metrics:  []config.TemplatableAnalysisMetrics{{}, {}},
+					},
+				},
+				{
+					Name: model.StageWaitApproval,
+					WaitApprovalStageOptions: &config.WaitApprovalStageOptions{
+						Approvers:      []string{"alice", "bob"},
+						MinApproverNum: 2,
+						Timeout:        config.Duration(6 * time.Hour),
+					},
+				},
+			},
+		},
+	}
+	stages := []*model.PipelineStage{
+		{Name: model.StageK8sCanaryRollout.String(), Desc: "Rollout CANARY variant", Index: 0, Visible: true},
+		{Name: model.StageAnalysis.String(), Index: 1, Visible: true},
+		{Name: model.StageWaitApproval.String(), Index: 2, Visible: true},
+		{Name: model.StageRollback.String(), Predefined: true, Visible: false},
+	}
+
+	got := makePlanPreviewStages(stages, spec)
+	assert.Equal(t, []*model.PlanPreviewStage{
+		{Name: "K8S_CANARY_ROLLOUT", Desc: "Rollout CANARY variant"},
+		{Name: "ANALYSIS", Parameters: []string{"duration: 10m0s", "metrics: 2"}},
+		{Name: "WAIT_APPROVAL", Parameters: []string{"approvers: alice, bob", "minApproverNum: 2", "timeout: 6h0m0s"}},
+	}, got)
+}
+
+func TestMakePlanPreviewStagesQuickSync(t *testing.T) {
+	t.Parallel()
+
+	stages := []*model.PipelineStage{
+		{Name: model.StageK8sSync.String(), Desc: "Sync by applying all manifests", Predefined: true, Visible: true},
+		{Name: model.StageRollback.String(), Predefined: true, Visible: false},
+	}
+
+	got := makePlanPreviewStages(stages, config.GenericApplicationSpec{})
+	assert.Equal(t, []*model.PlanPreviewStage{
+		{Name: "K8S_SYNC", Desc: "Sync by applying all manifests"},
+	}, got)
+}
@@ -19,20 +19,16 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"regexp"
+	"strings"
 
-	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	terraformprovider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/terraform"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/toolregistry"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
-func (b *builder) terraformDiff(
-	ctx context.Context,
-	app *model.Application,
-	targetDSP deploysource.Provider,
-	buf *bytes.Buffer,
-) (*diffResult, error) {
-
+func (b *builder) terraformDiff(ctx context.Context, in diffInput, buf *bytes.Buffer) (*diffResult, error) {
+	app := in.app
 	cp, ok := b.pipedCfg.FindPlatformProvider(app.PlatformProvider, model.ApplicationKind_TERRAFORM)
 	if !ok {
 		err := fmt.Errorf("platform provider %s was not found in Piped config", app.PlatformProvider)
@@ -41,7 +37,7 @@ func (b *builder) terraformDiff(
 	}
 	cpCfg := cp.TerraformConfig
 
-	ds, err := targetDSP.Get(ctx, io.Discard)
+	ds, err := in.targetDSP.Get(ctx, io.Discard)
 	if err != nil {
 		fmt.Fprintf(buf, "failed to prepare deploy source data at the head commit (%v)\n", err)
 		return nil, err
@@ -54,19 +50,51 @@ func (b *builder) terraformDiff(
 		return nil, err
 	}
 
-	version := appCfg.Input.TerraformVersion
-	terraformPath, installed, err := toolregistry.DefaultRegistry().Terraform(ctx, version)
+	var (
+		terraformPath string
+		installed     bool
+		tool          = "terraform"
+		version       = appCfg.Input.TerraformVersion
+		openTofu      = appCfg.Input.OpenTofu != nil
+	)
+	if openTofu {
+		tool, version = "OpenTofu", appCfg.Input.OpenTofu.Version
+		terraformPath, installed, err = toolregistry.DefaultRegistry().OpenTofu(ctx, version)
+	} else {
+		terraformPath, installed, err = toolregistry.DefaultRegistry().Terraform(ctx, version)
+	}
 	if err != nil {
-		fmt.Fprintf(buf, "unable to find the specified terraform version %q (%v)\n", version, err)
+		fmt.Fprintf(buf, "unable to find the specified %s version %q (%v)\n", tool, version, err)
 		return nil, err
 	}
 	if installed {
-		b.logger.Info(fmt.Sprintf("terraform %q has just been installed to %q because of no pre-installed binary for that version", version, terraformPath))
+		b.logger.Info(fmt.Sprintf("%s %q has just been installed to %q because of no pre-installed binary for that version", tool, version, terraformPath))
+	}
+
+	var (
+		terragruntPath   string
+		terragruntRunAll bool
+	)
+	if tg := appCfg.Input.Terragrunt; tg != nil {
+		terragruntRunAll = tg.RunAll
+		terragruntPath, installed, err = toolregistry.DefaultRegistry().Terragrunt(ctx, tg.Version)
+		if err != nil {
+			fmt.Fprintf(buf, "unable to find the specified terragrunt version %q (%v)\n", tg.Version, err)
+			return nil, err
+		}
+		if installed {
+			b.logger.Info(fmt.Sprintf("terragrunt %q has just been installed to %q because of no pre-installed binary for that version", tg.Version, terragruntPath))
+		}
 	}
 
 	vars := make([]string, 0, len(cpCfg.Vars)+len(appCfg.Input.Vars))
 	vars = append(vars, cpCfg.Vars...)
 	vars = append(vars, appCfg.Input.Vars...)
+	sensitiveVars, err := terraformprovider.ResolveExternalVars(ctx, appCfg.Input.ExternalVars)
+	if err != nil {
+		fmt.Fprintf(buf, "failed to resolve external variables (%v)\n", err)
+		return nil, err
+	}
 	flags := appCfg.Input.CommandFlags
 	envs := appCfg.Input.CommandEnvs
 
@@ -75,9 +103,13 @@ func (b *builder) terraformDiff(
 		ds.AppDir,
 		terraformprovider.WithoutColor(),
 		terraformprovider.WithVars(vars),
+		terraformprovider.WithSensitiveVars(sensitiveVars),
 		terraformprovider.WithVarFiles(appCfg.Input.VarFiles),
 		terraformprovider.WithAdditionalFlags(flags.Shared, flags.Init, flags.Plan, flags.Apply),
 		terraformprovider.WithAdditionalEnvs(envs.Shared, envs.Init, envs.Plan, envs.Apply),
+		terraformprovider.WithPluginCacheConfig(cpCfg.PluginCache),
+		terraformprovider.WithOpenTofu(openTofu),
+		terraformprovider.WithTerragrunt(terragruntPath, terragruntRunAll),
 	)
 
 	if err := executor.Init(ctx, buf); err != nil {
@@ -115,5 +147,29 @@ func (b *builder) terraformDiff(
 	fmt.Fprintln(buf, summary)
 	return &diffResult{
 		summary: summary,
+		changes: parseTerraformResourceChanges(result.PlanOutput),
 	}, nil
 }
+
+var terraformResourceChangeRegex = regexp.MustCompile(`^\s*# (\S+) (?:is tainted, so )?(will be created|will be updated in-place|will be replaced|must be replaced|will be destroyed|will be imported)`)
+
+// parseTerraformResourceChanges extracts the changed resources from the output of terraform plan
+// by looking for the comment lines such as "# aws_instance.web will be created".
+func parseTerraformResourceChanges(planOutput string) []*model.PlanPreviewResourceChange {
+	var changes []*model.PlanPreviewResourceChange
+	for _, line := range strings.Split(planOutput, "\n") {
+		m := terraformResourceChangeRegex.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		action := model.PlanPreviewResourceChange_CHANGE
+		switch m[2] {
+		case "will be created", "will be imported":
+			action = model.PlanPreviewResourceChange_ADD
+		case "will be destroyed":
+			action = model.PlanPreviewResourceChange_DELETE
+		}
+		changes = append(changes, newResourceChange(m[1], action))
+	}
+	return changes
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planpreview
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestParseTerraformResourceChanges(t *testing.T) {
+	t.Parallel()
+
+	planOutput := `
+Terraform used the selected providers to generate the following execution
+plan. Resource actions are indicated with the following symbols:
+  + create
+  ~ update in-place
+  - destroy
+-/+ destroy and then create replacement
+
+Terraform will perform the following actions:
+
+  # aws_instance.web will be created
+  + resource "aws_instance" "web" {
+      + ami = "ami-123"
+    }
+
+  # module.network.aws_vpc.main will be updated in-place
+  ~ resource "aws_vpc" "main" {
+        id   = "vpc-123"
+      ~ tags = {
+          ~ "Name" = "old" -> "new"
+        }
+        # (3 unchanged attributes hidden)
+    }
+
+  # aws_s3_bucket.logs["a"] will be destroyed
+  - resource "aws_s3_bucket" "logs" {}
+
+  # aws_instance.db is tainted, so must be replaced
+-/+ resource "aws_instance" "db" {}
+
+Plan: 2 to add, 1 to change, 2 to destroy.
+`
+
+	expected := []*model.PlanPreviewResourceChange{
+		{Resource: "aws_instance.web", Action: model.PlanPreviewResourceChange_ADD},
+		{Resource: "module.network.aws_vpc.main", Action: model.PlanPreviewResourceChange_CHANGE},
+		{Resource: `aws_s3_bucket.logs["a"]`, Action: model.PlanPreviewResourceChange_DELETE},
+		{Resource: "aws_instance.db", Action: model.PlanPreviewResourceChange_CHANGE},
+	}
+	assert.Equal(t, expected, parseTerraformResourceChanges(planOutput))
+	assert.Nil(t, parseTerraformResourceChanges("No changes. Your infrastructure matches the configuration."))
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsapimetrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	platformProviderKey = "platform_provider"
+	reasonKey           = "reason"
+)
+
+type RetryReason string
+
+const (
+	LabelReasonThrottling RetryReason = "throttling"
+	LabelReasonError      RetryReason = "error"
+)
+
+var (
+	retriesCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cloudprovider_aws_api_retries_total",
+			Help: "Number of retried AWS API calls.",
+		},
+		[]string{
+			platformProviderKey,
+			reasonKey,
+		},
+	)
+	retryQuotaExceededCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cloudprovider_aws_api_retry_quota_exceeded_total",
+			Help: "Number of AWS API calls failed without retrying because the retry quota was exhausted.",
+		},
+		[]string{
+			platformProviderKey,
+		},
+	)
+	retryQuotaRemainingGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cloudprovider_aws_api_retry_quota_remaining",
+			Help: "Number of remaining tokens in the retry quota of AWS API calls.",
+		},
+		[]string{
+			platformProviderKey,
+		},
+	)
+	rateLimitWaitCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cloudprovider_aws_api_rate_limit_wait_seconds_total",
+			Help: "Total time spent by AWS API calls waiting for the client-side rate limiter.",
+		},
+		[]string{
+			platformProviderKey,
+		},
+	)
+)
+
+func IncRetriesCounter(platformProvider string, throttled bool) {
+	reason := LabelReasonError
+	if throttled {
+		reason = LabelReasonThrottling
+	}
+	retriesCounter.With(prometheus.Labels{
+		platformProviderKey: platformProvider,
+		reasonKey:           string(reason),
+	}).Inc()
+}
+
+func IncRetryQuotaExceededCounter(platformProvider string) {
+	retryQuotaExceededCounter.With(prometheus.Labels{
+		platformProviderKey: platformProvider,
+	}).Inc()
+}
+
+func SetRetryQuotaRemaining(platformProvider string, remaining uint) {
+	retryQuotaRemainingGauge.With(prometheus.Labels{
+		platformProviderKey: platformProvider,
+	}).Set(float64(remaining))
+}
+
+func AddRateLimitWaitSeconds(platformProvider string, seconds float64) {
+	rateLimitWaitCounter.With(prometheus.Labels{
+		platformProviderKey: platformProvider,
+	}).Add(seconds)
+}
+
+func Register(r prometheus.Registerer) {
+	r.MustRegister(
+		retriesCounter,
+		retryQuotaExceededCounter,
+		retryQuotaRemainingGauge,
+		rateLimitWaitCounter,
+	)
+}
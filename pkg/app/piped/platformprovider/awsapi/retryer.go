@@ -0,0 +1,119 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package awsapi provides the shared settings for the clients of AWS APIs
+// used by the AWS based platform providers.
+package awsapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"golang.org/x/time/rate"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/awsapi/awsapimetrics"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// retryer wraps the standard retryer of AWS SDK to limit the rate of API calls
+// and to report the retries and the state of the retry quota as metrics.
+type retryer struct {
+	aws.RetryerV2
+	platformProvider string
+	quota            *ratelimit.TokenRateLimit
+	limiter          *rate.Limiter
+	throttles        retry.IsErrorThrottles
+}
+
+// NewRetryer returns a function to be passed to config.WithRetryer of AWS SDK.
+// The returned function always returns the same retryer so that all clients
+// of the platform provider share the same retry quota and rate limit.
+// When cfg is nil, the default retry policy of AWS SDK is used without rate limiting.
+func NewRetryer(platformProvider string, cfg *config.AWSAPIClientConfig) func() aws.Retryer {
+	r := newRetryer(platformProvider, cfg)
+	return func() aws.Retryer {
+		return r
+	}
+}
+
+func newRetryer(platformProvider string, cfg *config.AWSAPIClientConfig) *retryer {
+	quota := ratelimit.NewTokenRateLimit(retry.DefaultRetryRateTokens)
+	if cfg != nil {
+		quota = ratelimit.NewTokenRateLimit(uint(cfg.RetryQuota))
+	}
+
+	r := &retryer{
+		RetryerV2: retry.NewStandard(func(o *retry.StandardOptions) {
+			o.RateLimiter = quota
+			if cfg != nil {
+				o.MaxAttempts = cfg.MaxAttempts
+				o.MaxBackoff = cfg.MaxBackoff.Duration()
+				o.Backoff = retry.NewExponentialJitterBackoff(cfg.MaxBackoff.Duration())
+			}
+		}),
+		platformProvider: platformProvider,
+		quota:            quota,
+		throttles:        retry.IsErrorThrottles(retry.DefaultThrottles),
+	}
+	if cfg != nil && cfg.RateLimit > 0 {
+		r.limiter = rate.NewLimiter(rate.Limit(cfg.RateLimit), cfg.RateLimitBurst)
+	}
+	awsapimetrics.SetRetryQuotaRemaining(platformProvider, quota.Remaining())
+	return r
+}
+
+// GetAttemptToken waits for the rate limiter before every attempt including the retries.
+func (r *retryer) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	if r.limiter != nil {
+		start := time.Now()
+		if err := r.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		awsapimetrics.AddRateLimitWaitSeconds(r.platformProvider, time.Now().Sub(start).Seconds())
+	}
+	release, err := r.RetryerV2.GetAttemptToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.observeRelease(release), nil
+}
+
+// GetRetryToken deducts the retry cost from the retry quota.
+// Once the quota is exhausted the API call fails without retrying,
+// which prevents the retries from making the throttling worse.
+func (r *retryer) GetRetryToken(ctx context.Context, opErr error) (func(error) error, error) {
+	release, err := r.RetryerV2.GetRetryToken(ctx, opErr)
+	if err != nil {
+		var qe ratelimit.QuotaExceededError
+		if errors.As(err, &qe) {
+			awsapimetrics.IncRetryQuotaExceededCounter(r.platformProvider)
+		}
+		return nil, err
+	}
+	awsapimetrics.IncRetriesCounter(r.platformProvider, r.throttles.IsErrorThrottle(opErr).Bool())
+	awsapimetrics.SetRetryQuotaRemaining(r.platformProvider, r.quota.Remaining())
+	return r.observeRelease(release), nil
+}
+
+func (r *retryer) observeRelease(release func(error) error) func(error) error {
+	return func(err error) error {
+		err = release(err)
+		awsapimetrics.SetRetryQuotaRemaining(r.platformProvider, r.quota.Remaining())
+		return err
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestNewRetryer(t *testing.T) {
+	t.Parallel()
+
+	r := newRetryer("ecs-dev", nil)
+	assert.Equal(t, retry.DefaultMaxAttempts, r.MaxAttempts())
+	assert.Equal(t, uint(retry.DefaultRetryRateTokens), r.quota.Remaining())
+	assert.Nil(t, r.limiter)
+
+	r = newRetryer("ecs-dev", &config.AWSAPIClientConfig{
+		MaxAttempts:    5,
+		MaxBackoff:     config.Duration(time.Second),
+		RetryQuota:     100,
+		RateLimit:      10,
+		RateLimitBurst: 2,
+	})
+	assert.Equal(t, 5, r.MaxAttempts())
+	assert.Equal(t, uint(100), r.quota.Remaining())
+	require.NotNil(t, r.limiter)
+	assert.Equal(t, 2, r.limiter.Burst())
+
+	delay, err := r.RetryDelay(10, errors.New("error"))
+	require.NoError(t, err)
+	assert.LessOrEqual(t, delay, time.Second)
+
+	// The same retryer must be shared by all clients of the platform provider.
+	fn := NewRetryer("ecs-dev", nil)
+	assert.Same(t, fn(), fn())
+}
+
+func TestRetryer_GetRetryToken(t *testing.T) {
+	t.Parallel()
+
+	r := newRetryer("ecs-dev", &config.AWSAPIClientConfig{
+		MaxAttempts: 3,
+		RetryQuota:  int(retry.DefaultRetryCost),
+	})
+	ctx := context.Background()
+
+	release, err := r.GetRetryToken(ctx, errors.New("error"))
+	require.NoError(t, err)
+	assert.Equal(t, uint(0), r.quota.Remaining())
+
+	// The retry quota is exhausted.
+	_, err = r.GetRetryToken(ctx, errors.New("error"))
+	var qe ratelimit.QuotaExceededError
+	assert.True(t, errors.As(err, &qe))
+
+	// A successful retry gives back its cost.
+	require.NoError(t, release(nil))
+	assert.Equal(t, uint(retry.DefaultRetryCost), r.quota.Remaining())
+}
+
+func TestRetryer_GetAttemptToken(t *testing.T) {
+	t.Parallel()
+
+	r := newRetryer("ecs-dev", &config.AWSAPIClientConfig{
+		MaxAttempts:    3,
+		RetryQuota:     10,
+		RateLimit:      0.001,
+		RateLimitBurst: 1,
+	})
+
+	release, err := r.GetAttemptToken(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, release(nil))
+
+	// The next attempt has to wait for the rate limiter longer than the deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = r.GetAttemptToken(ctx)
+	assert.Error(t, err)
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// LabelABTesting is the tag key added to the ELB listener rules created for A/B testing routing.
+const LabelABTesting string = "pipecd-dev-ab-testing"
+
+// maxListenerRulePriority is the maximum priority of an ELB listener rule.
+const maxListenerRulePriority = 50000
+
+// makeABTestingCondition returns the ELB listener rule condition matching the given A/B testing rule.
+// ELB supports the wildcards "*" and "?" in the values of http-header conditions,
+// so the prefix and cookie matching are expressed by them.
+func makeABTestingCondition(r config.ABTestingRule) (types.RuleCondition, error) {
+	var name string
+	var values []string
+	switch {
+	case r.MatchType == config.ABTestingMatchTypeRegex:
+		return types.RuleCondition{}, errors.New("regex matchType is not supported by ELB")
+	case r.Cookie != "" && r.MatchType == config.ABTestingMatchTypePrefix:
+		name, values = "Cookie", []string{"*" + r.Cookie + "=" + r.Value + "*"}
+	case r.Cookie != "":
+		// The cookie must be the last one or be followed by a separator.
+		pair := r.Cookie + "=" + r.Value
+		name, values = "Cookie", []string{"*" + pair, "*" + pair + ";*"}
+	case r.MatchType == config.ABTestingMatchTypePrefix:
+		name, values = r.Header, []string{r.Value + "*"}
+	default:
+		name, values = r.Header, []string{r.Value}
+	}
+
+	return types.RuleCondition{
+		Field: aws.String("http-header"),
+		HttpHeaderConfig: &types.HttpHeaderConditionConfig{
+			HttpHeaderName: aws.String(name),
+			Values:         values,
+		},
+	}, nil
+}
+
+// copyRuleCondition returns the condition to be passed to CreateRule API.
+// DescribeRules API returns both the legacy values and the condition config
+// but only one of them can be specified when creating a rule.
+func copyRuleCondition(c types.RuleCondition) types.RuleCondition {
+	if c.HostHeaderConfig != nil || c.PathPatternConfig != nil {
+		c.Values = nil
+	}
+	return c
+}
+
+// usedListenerRulePriorities returns the priorities used by the given rules.
+func usedListenerRulePriorities(rules []types.Rule) (map[int32]struct{}, error) {
+	used := make(map[int32]struct{}, len(rules))
+	for _, r := range rules {
+		if aws.ToBool(r.IsDefault) {
+			continue
+		}
+		p, err := parseListenerRulePriority(r)
+		if err != nil {
+			return nil, err
+		}
+		used[p] = struct{}{}
+	}
+	return used, nil
+}
+
+// listenerRulePriority returns the priority of the given rule.
+// The default rule is regarded as having the priority next to the maximum one since it is evaluated last.
+func listenerRulePriority(r types.Rule) (int32, error) {
+	if aws.ToBool(r.IsDefault) {
+		return maxListenerRulePriority + 1, nil
+	}
+	return parseListenerRulePriority(r)
+}
+
+// prioritiesBefore returns n free priorities right before the given one and marks them as used,
+// so that the rules having them are evaluated just before the rule of the given priority
+// and after all other rules evaluated before that rule.
+// An error is returned when there is not enough free priorities between the rule and the previous one.
+func prioritiesBefore(used map[int32]struct{}, priority int32, n int) ([]int32, error) {
+	start := priority - int32(n)
+	if start < 1 {
+		return nil, fmt.Errorf("no %d free priorities right before priority %d", n, priority)
+	}
+	for p := start; p < priority; p++ {
+		if _, ok := used[p]; ok {
+			return nil, fmt.Errorf("no %d free priorities right before priority %d since priority %d is used", n, priority, p)
+		}
+	}
+	out := make([]int32, 0, n)
+	for p := start; p < priority; p++ {
+		used[p] = struct{}{}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+func hasABTestingTag(tags []types.Tag) bool {
+	return hasTag(tags, LabelABTesting)
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestMakeABTestingCondition(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name         string
+		rule         config.ABTestingRule
+		expectedName string
+		expected     []string
+		expectedErr  bool
+	}{
+		{
+			name:         "exact header",
+			rule:         config.ABTestingRule{Header: "X-Canary", Value: "true"},
+			expectedName: "X-Canary",
+			expected:     []string{"true"},
+		},
+		{
+			name:         "prefix header",
+			rule:         config.ABTestingRule{Header: "User-Agent", Value: "beta-", MatchType: config.ABTestingMatchTypePrefix},
+			expectedName: "User-Agent",
+			expected:     []string{"beta-*"},
+		},
+		{
+			name:         "exact cookie",
+			rule:         config.ABTestingRule{Cookie: "beta", Value: "1"},
+			expectedName: "Cookie",
+			expected:     []string{"*beta=1", "*beta=1;*"},
+		},
+		{
+			name:         "prefix cookie",
+			rule:         config.ABTestingRule{Cookie: "group", Value: "b", MatchType: config.ABTestingMatchTypePrefix},
+			expectedName: "Cookie",
+			expected:     []string{"*group=b*"},
+		},
+		{
+			name:        "regex is not supported",
+			rule:        config.ABTestingRule{Header: "X-Canary", Value: ".*", MatchType: config.ABTestingMatchTypeRegex},
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := makeABTestingCondition(tc.rule)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "http-header", aws.ToString(got.Field))
+			assert.Equal(t, tc.expectedName, aws.ToString(got.HttpHeaderConfig.HttpHeaderName))
+			assert.Equal(t, tc.expected, got.HttpHeaderConfig.Values)
+		})
+	}
+}
+
+func TestPrioritiesBefore(t *testing.T) {
+	t.Parallel()
+
+	rules := []types.Rule{
+		{RuleArn: aws.String("rule-1"), Priority: aws.String("1")},
+		{RuleArn: aws.String("rule-5"), Priority: aws.String("5")},
+		{RuleArn: aws.String("rule-10"), Priority: aws.String("10")},
+		{RuleArn: aws.String("default"), Priority: aws.String("default"), IsDefault: aws.Bool(true)},
+	}
+	used, err := usedListenerRulePriorities(rules)
+	require.NoError(t, err)
+
+	got, err := prioritiesBefore(used, 10, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{8, 9}, got)
+
+	// The allocated priorities are no longer free.
+	_, err = prioritiesBefore(used, 10, 1)
+	assert.Error(t, err)
+
+	// There is no free slot right before the rule.
+	_, err = prioritiesBefore(used, 5, 4)
+	assert.Error(t, err)
+	_, err = prioritiesBefore(used, 1, 1)
+	assert.Error(t, err)
+
+	p, err := listenerRulePriority(rules[3])
+	require.NoError(t, err)
+	got, err = prioritiesBefore(used, p, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{maxListenerRulePriority}, got)
+
+	_, err = usedListenerRulePriorities([]types.Rule{{RuleArn: aws.String("invalid"), Priority: aws.String("x")}})
+	assert.Error(t, err)
+}
+
+func TestHasABTestingTag(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, hasABTestingTag([]types.Tag{
+		{Key: aws.String(LabelManagedBy), Value: aws.String(ManagedByPiped)},
+		{Key: aws.String(LabelABTesting), Value: aws.String("true")},
+	}))
+	assert.False(t, hasABTestingTag([]types.Tag{
+		{Key: aws.String(LabelManagedBy), Value: aws.String(ManagedByPiped)},
+	}))
+}
@@ -0,0 +1,150 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	amtypes "github.com/aws/aws-sdk-go-v2/service/appmesh/types"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func (c *client) GetMeshRouteWeights(ctx context.Context, mesh config.ECSAppMesh) (map[string]int, error) {
+	spec, err := c.describeMeshRoute(ctx, mesh)
+	if err != nil {
+		return nil, err
+	}
+	return meshRouteWeights(spec)
+}
+
+func (c *client) ModifyMeshRouteWeights(ctx context.Context, mesh config.ECSAppMesh, primary, canary int) error {
+	spec, err := c.describeMeshRoute(ctx, mesh)
+	if err != nil {
+		return err
+	}
+	weights := map[string]int{
+		mesh.Primary.VirtualNodeName: primary,
+		mesh.Canary.VirtualNodeName:  canary,
+	}
+	if err := setMeshRouteWeights(spec, mesh.Primary.VirtualNodeName, weights); err != nil {
+		return fmt.Errorf("failed to update route %s of virtual router %s: %w", mesh.RouteName, mesh.VirtualRouterName, err)
+	}
+
+	_, err = c.meshClient.UpdateRoute(ctx, &appmesh.UpdateRouteInput{
+		MeshName:          aws.String(mesh.MeshName),
+		MeshOwner:         optionalString(mesh.MeshOwner),
+		VirtualRouterName: aws.String(mesh.VirtualRouterName),
+		RouteName:         aws.String(mesh.RouteName),
+		Spec:              spec,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update route %s of virtual router %s: %w", mesh.RouteName, mesh.VirtualRouterName, err)
+	}
+	return nil
+}
+
+func (c *client) describeMeshRoute(ctx context.Context, mesh config.ECSAppMesh) (*amtypes.RouteSpec, error) {
+	out, err := c.meshClient.DescribeRoute(ctx, &appmesh.DescribeRouteInput{
+		MeshName:          aws.String(mesh.MeshName),
+		MeshOwner:         optionalString(mesh.MeshOwner),
+		VirtualRouterName: aws.String(mesh.VirtualRouterName),
+		RouteName:         aws.String(mesh.RouteName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe route %s of virtual router %s: %w", mesh.RouteName, mesh.VirtualRouterName, err)
+	}
+	if out.Route == nil || out.Route.Spec == nil {
+		return nil, fmt.Errorf("route %s of virtual router %s has no spec", mesh.RouteName, mesh.VirtualRouterName)
+	}
+	return out.Route.Spec, nil
+}
+
+// meshWeightedTargets returns the weighted targets the action of the given route spec forwards to.
+// The returned pointer can be used to replace them.
+func meshWeightedTargets(spec *amtypes.RouteSpec) (*[]amtypes.WeightedTarget, error) {
+	switch {
+	case spec.HttpRoute != nil && spec.HttpRoute.Action != nil:
+		return &spec.HttpRoute.Action.WeightedTargets, nil
+	case spec.Http2Route != nil && spec.Http2Route.Action != nil:
+		return &spec.Http2Route.Action.WeightedTargets, nil
+	case spec.GrpcRoute != nil && spec.GrpcRoute.Action != nil:
+		return &spec.GrpcRoute.Action.WeightedTargets, nil
+	case spec.TcpRoute != nil && spec.TcpRoute.Action != nil:
+		return &spec.TcpRoute.Action.WeightedTargets, nil
+	}
+	return nil, fmt.Errorf("no route forwarding to weighted targets was found")
+}
+
+// meshRouteWeights returns the weights of the virtual nodes the given route spec forwards to.
+func meshRouteWeights(spec *amtypes.RouteSpec) (map[string]int, error) {
+	targets, err := meshWeightedTargets(spec)
+	if err != nil {
+		return nil, err
+	}
+	weights := make(map[string]int, len(*targets))
+	for _, t := range *targets {
+		weights[aws.ToString(t.VirtualNode)] = int(t.Weight)
+	}
+	return weights, nil
+}
+
+// setMeshRouteWeights makes the weighted targets of the given route spec have the given weights.
+// The targets of the virtual nodes not in the given weights are removed,
+// and the missing ones are added with the port of the target of the given base virtual node.
+func setMeshRouteWeights(spec *amtypes.RouteSpec, baseVirtualNode string, weights map[string]int) error {
+	current, err := meshWeightedTargets(spec)
+	if err != nil {
+		return err
+	}
+
+	var port *int32
+	existing := make(map[string]struct{}, len(*current))
+	for _, t := range *current {
+		node := aws.ToString(t.VirtualNode)
+		existing[node] = struct{}{}
+		if node == baseVirtualNode {
+			port = t.Port
+		}
+	}
+	targets := make([]amtypes.WeightedTarget, 0, len(weights))
+	// Keep the order of the existing targets to avoid unnecessary diffs.
+	for _, t := range *current {
+		if w, ok := weights[aws.ToString(t.VirtualNode)]; ok {
+			t.Weight = int32(w)
+			targets = append(targets, t)
+		}
+	}
+	missing := make([]string, 0, len(weights))
+	for node := range weights {
+		if _, ok := existing[node]; !ok {
+			missing = append(missing, node)
+		}
+	}
+	sort.Strings(missing)
+	for _, node := range missing {
+		targets = append(targets, amtypes.WeightedTarget{
+			VirtualNode: aws.String(node),
+			Weight:      int32(weights[node]),
+			Port:        port,
+		})
+	}
+	*current = targets
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	amtypes "github.com/aws/aws-sdk-go-v2/service/appmesh/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMeshRouteWeights(t *testing.T) {
+	t.Parallel()
+
+	target := func(node string, weight int32, port *int32) amtypes.WeightedTarget {
+		return amtypes.WeightedTarget{VirtualNode: aws.String(node), Weight: weight, Port: port}
+	}
+	match := &amtypes.HttpRouteMatch{Prefix: aws.String("/")}
+
+	testcases := []struct {
+		name     string
+		spec     *amtypes.RouteSpec
+		weights  map[string]int
+		expected *amtypes.RouteSpec
+		wantErr  bool
+	}{
+		{
+			name: "modify existing targets",
+			spec: &amtypes.RouteSpec{
+				Priority: aws.Int32(10),
+				HttpRoute: &amtypes.HttpRoute{
+					Match: match,
+					Action: &amtypes.HttpRouteAction{WeightedTargets: []amtypes.WeightedTarget{
+						target("blue", 100, aws.Int32(8080)),
+						target("green", 0, aws.Int32(8080)),
+					}},
+				},
+			},
+			weights: map[string]int{"blue": 70, "green": 30},
+			expected: &amtypes.RouteSpec{
+				Priority: aws.Int32(10),
+				HttpRoute: &amtypes.HttpRoute{
+					Match: match,
+					Action: &amtypes.HttpRouteAction{WeightedTargets: []amtypes.WeightedTarget{
+						target("blue", 70, aws.Int32(8080)),
+						target("green", 30, aws.Int32(8080)),
+					}},
+				},
+			},
+		},
+		{
+			name: "add missing target with the port of primary",
+			spec: &amtypes.RouteSpec{
+				GrpcRoute: &amtypes.GrpcRoute{
+					Action: &amtypes.GrpcRouteAction{WeightedTargets: []amtypes.WeightedTarget{
+						target("blue", 100, aws.Int32(50051)),
+					}},
+				},
+			},
+			weights: map[string]int{"blue": 90, "green": 10},
+			expected: &amtypes.RouteSpec{
+				GrpcRoute: &amtypes.GrpcRoute{
+					Action: &amtypes.GrpcRouteAction{WeightedTargets: []amtypes.WeightedTarget{
+						target("blue", 90, aws.Int32(50051)),
+						target("green", 10, aws.Int32(50051)),
+					}},
+				},
+			},
+		},
+		{
+			name: "remove other targets",
+			spec: &amtypes.RouteSpec{
+				TcpRoute: &amtypes.TcpRoute{
+					Action: &amtypes.TcpRouteAction{WeightedTargets: []amtypes.WeightedTarget{
+						target("old", 50, nil),
+						target("blue", 50, nil),
+					}},
+				},
+			},
+			weights: map[string]int{"blue": 100, "green": 0},
+			expected: &amtypes.RouteSpec{
+				TcpRoute: &amtypes.TcpRoute{
+					Action: &amtypes.TcpRouteAction{WeightedTargets: []amtypes.WeightedTarget{
+						target("blue", 100, nil),
+						target("green", 0, nil),
+					}},
+				},
+			},
+		},
+		{
+			name:    "no route",
+			spec:    &amtypes.RouteSpec{Priority: aws.Int32(10)},
+			weights: map[string]int{"blue": 100, "green": 0},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := setMeshRouteWeights(tc.spec, "blue", tc.weights)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, tc.spec)
+
+			weights, err := meshRouteWeights(tc.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tc.weights, weights)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	aastypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// ServiceAutoScaling holds the Application Auto Scaling settings of an ECS service.
+type ServiceAutoScaling struct {
+	ScalableTargets []aastypes.ScalableTarget `json:"scalableTargets,omitempty"`
+	ScalingPolicies []aastypes.ScalingPolicy  `json:"scalingPolicies,omitempty"`
+}
+
+// MakeServiceAutoScaling returns the Application Auto Scaling settings of the given service
+// specified by the application configuration.
+func MakeServiceAutoScaling(service types.Service, cfg config.ECSAutoScaling) ServiceAutoScaling {
+	var (
+		resourceID = aws.String(serviceResourceID(service))
+		dimension  = aastypes.ScalableDimensionECSServiceDesiredCount
+		out        = ServiceAutoScaling{
+			ScalableTargets: []aastypes.ScalableTarget{
+				{
+					ServiceNamespace:  aastypes.ServiceNamespaceEcs,
+					ResourceId:        resourceID,
+					ScalableDimension: dimension,
+					MinCapacity:       aws.Int32(cfg.MinCapacity),
+					MaxCapacity:       aws.Int32(cfg.MaxCapacity),
+				},
+			},
+		}
+	)
+
+	for _, p := range cfg.TargetTrackingPolicies {
+		metric := &aastypes.PredefinedMetricSpecification{
+			PredefinedMetricType: aastypes.MetricType(p.PredefinedMetric),
+		}
+		if p.ResourceLabel != "" {
+			metric.ResourceLabel = aws.String(p.ResourceLabel)
+		}
+		out.ScalingPolicies = append(out.ScalingPolicies, aastypes.ScalingPolicy{
+			ServiceNamespace:  aastypes.ServiceNamespaceEcs,
+			ResourceId:        resourceID,
+			ScalableDimension: dimension,
+			PolicyName:        aws.String(p.Name),
+			PolicyType:        aastypes.PolicyTypeTargetTrackingScaling,
+			TargetTrackingScalingPolicyConfiguration: &aastypes.TargetTrackingScalingPolicyConfiguration{
+				TargetValue:                   aws.Float64(p.TargetValue),
+				PredefinedMetricSpecification: metric,
+				ScaleInCooldown:               aws.Int32(int32(p.ScaleInCooldown.Duration().Seconds())),
+				ScaleOutCooldown:              aws.Int32(int32(p.ScaleOutCooldown.Duration().Seconds())),
+				DisableScaleIn:                aws.Bool(p.DisableScaleIn),
+			},
+		})
+	}
+
+	for _, p := range cfg.StepScalingPolicies {
+		steps := make([]aastypes.StepAdjustment, 0, len(p.Steps))
+		for _, s := range p.Steps {
+			steps = append(steps, aastypes.StepAdjustment{
+				MetricIntervalLowerBound: s.LowerBound,
+				MetricIntervalUpperBound: s.UpperBound,
+				ScalingAdjustment:        aws.Int32(s.ScalingAdjustment),
+			})
+		}
+		policy := &aastypes.StepScalingPolicyConfiguration{
+			AdjustmentType:        aastypes.AdjustmentType(p.AdjustmentType),
+			MetricAggregationType: aastypes.MetricAggregationType(p.MetricAggregationType),
+			Cooldown:              aws.Int32(int32(p.Cooldown.Duration().Seconds())),
+			StepAdjustments:       steps,
+		}
+		if p.MinAdjustmentMagnitude > 0 {
+			policy.MinAdjustmentMagnitude = aws.Int32(p.MinAdjustmentMagnitude)
+		}
+		out.ScalingPolicies = append(out.ScalingPolicies, aastypes.ScalingPolicy{
+			ServiceNamespace:               aastypes.ServiceNamespaceEcs,
+			ResourceId:                     resourceID,
+			ScalableDimension:              dimension,
+			PolicyName:                     aws.String(p.Name),
+			PolicyType:                     aastypes.PolicyTypeStepScaling,
+			StepScalingPolicyConfiguration: policy,
+		})
+	}
+
+	return out
+}
+
+// autoScalingRestorePlan describes the operations to make the current settings the same as the recorded ones.
+type autoScalingRestorePlan struct {
+	deregisterTargets []aastypes.ScalableTarget
+	registerTargets   []aastypes.ScalableTarget
+	deletePolicies    []aastypes.ScalingPolicy
+	putPolicies       []aastypes.ScalingPolicy
+}
+
+// serviceResourceID returns the Application Auto Scaling resource ID of the given service
+// in the form of 'service/<cluster-name>/<service-name>'.
+func serviceResourceID(service types.Service) string {
+	var cluster string
+	if service.ClusterArn != nil {
+		cluster = *service.ClusterArn
+		// The cluster can be specified by both name and ARN.
+		if i := strings.LastIndex(cluster, "/"); i >= 0 {
+			cluster = cluster[i+1:]
+		}
+	}
+	var name string
+	if service.ServiceName != nil {
+		name = *service.ServiceName
+	}
+	return "service/" + cluster + "/" + name
+}
+
+// planAutoScalingRestore decides how to restore the recorded settings from the current ones.
+// All recorded targets and policies are registered again since their values could be changed,
+// and the ones which did not exist when being recorded are removed.
+func planAutoScalingRestore(current, recorded ServiceAutoScaling) autoScalingRestorePlan {
+	var plan autoScalingRestorePlan
+
+	recordedTargets := make(map[aastypes.ScalableDimension]struct{}, len(recorded.ScalableTargets))
+	for _, t := range recorded.ScalableTargets {
+		recordedTargets[t.ScalableDimension] = struct{}{}
+	}
+	removedTargets := make(map[aastypes.ScalableDimension]struct{})
+	for _, t := range current.ScalableTargets {
+		if _, ok := recordedTargets[t.ScalableDimension]; !ok {
+			plan.deregisterTargets = append(plan.deregisterTargets, t)
+			removedTargets[t.ScalableDimension] = struct{}{}
+		}
+	}
+	plan.registerTargets = recorded.ScalableTargets
+
+	recordedPolicies := make(map[string]struct{}, len(recorded.ScalingPolicies))
+	for _, p := range recorded.ScalingPolicies {
+		recordedPolicies[scalingPolicyKey(p)] = struct{}{}
+	}
+	for _, p := range current.ScalingPolicies {
+		if _, ok := recordedPolicies[scalingPolicyKey(p)]; ok {
+			continue
+		}
+		// Policies are deleted together with their deregistered target.
+		if _, ok := removedTargets[p.ScalableDimension]; ok {
+			continue
+		}
+		plan.deletePolicies = append(plan.deletePolicies, p)
+	}
+	plan.putPolicies = recorded.ScalingPolicies
+
+	return plan
+}
+
+func scalingPolicyKey(p aastypes.ScalingPolicy) string {
+	var name string
+	if p.PolicyName != nil {
+		name = *p.PolicyName
+	}
+	return string(p.ScalableDimension) + "/" + name
+}
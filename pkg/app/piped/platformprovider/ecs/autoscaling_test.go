@@ -0,0 +1,192 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	aastypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestServiceResourceID(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		service  types.Service
+		expected string
+	}{
+		{
+			name: "cluster name",
+			service: types.Service{
+				ClusterArn:  aws.String("cluster"),
+				ServiceName: aws.String("service"),
+			},
+			expected: "service/cluster/service",
+		},
+		{
+			name: "cluster ARN",
+			service: types.Service{
+				ClusterArn:  aws.String("arn:aws:ecs:ap-northeast-1:123456789012:cluster/cluster"),
+				ServiceName: aws.String("service"),
+			},
+			expected: "service/cluster/service",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, serviceResourceID(tc.service))
+		})
+	}
+}
+
+func TestPlanAutoScalingRestore(t *testing.T) {
+	t.Parallel()
+
+	target := func(min, max int32) aastypes.ScalableTarget {
+		return aastypes.ScalableTarget{
+			ScalableDimension: aastypes.ScalableDimensionECSServiceDesiredCount,
+			MinCapacity:       aws.Int32(min),
+			MaxCapacity:       aws.Int32(max),
+		}
+	}
+	policy := func(name string) aastypes.ScalingPolicy {
+		return aastypes.ScalingPolicy{
+			ScalableDimension: aastypes.ScalableDimensionECSServiceDesiredCount,
+			PolicyName:        aws.String(name),
+			PolicyType:        aastypes.PolicyTypeTargetTrackingScaling,
+		}
+	}
+
+	testcases := []struct {
+		name     string
+		current  ServiceAutoScaling
+		recorded ServiceAutoScaling
+		expected autoScalingRestorePlan
+	}{
+		{
+			name: "nothing to restore",
+		},
+		{
+			name: "capacity and policy were changed",
+			current: ServiceAutoScaling{
+				ScalableTargets: []aastypes.ScalableTarget{target(2, 20)},
+				ScalingPolicies: []aastypes.ScalingPolicy{policy("cpu"), policy("memory")},
+			},
+			recorded: ServiceAutoScaling{
+				ScalableTargets: []aastypes.ScalableTarget{target(1, 10)},
+				ScalingPolicies: []aastypes.ScalingPolicy{policy("cpu")},
+			},
+			expected: autoScalingRestorePlan{
+				registerTargets: []aastypes.ScalableTarget{target(1, 10)},
+				deletePolicies:  []aastypes.ScalingPolicy{policy("memory")},
+				putPolicies:     []aastypes.ScalingPolicy{policy("cpu")},
+			},
+		},
+		{
+			name: "auto scaling was newly enabled",
+			current: ServiceAutoScaling{
+				ScalableTargets: []aastypes.ScalableTarget{target(1, 10)},
+				ScalingPolicies: []aastypes.ScalingPolicy{policy("cpu")},
+			},
+			expected: autoScalingRestorePlan{
+				deregisterTargets: []aastypes.ScalableTarget{target(1, 10)},
+			},
+		},
+		{
+			name: "auto scaling was disabled",
+			recorded: ServiceAutoScaling{
+				ScalableTargets: []aastypes.ScalableTarget{target(1, 10)},
+				ScalingPolicies: []aastypes.ScalingPolicy{policy("cpu")},
+			},
+			expected: autoScalingRestorePlan{
+				registerTargets: []aastypes.ScalableTarget{target(1, 10)},
+				putPolicies:     []aastypes.ScalingPolicy{policy("cpu")},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, planAutoScalingRestore(tc.current, tc.recorded))
+		})
+	}
+}
+
+func TestMakeServiceAutoScaling(t *testing.T) {
+	t.Parallel()
+
+	service := types.Service{
+		ClusterArn:  aws.String("cluster"),
+		ServiceName: aws.String("service"),
+	}
+	cfg := config.ECSAutoScaling{
+		MinCapacity: 2,
+		MaxCapacity: 10,
+		TargetTrackingPolicies: []config.ECSTargetTrackingScalingPolicy{
+			{
+				Name:             "cpu",
+				PredefinedMetric: "ECSServiceAverageCPUUtilization",
+				TargetValue:      60,
+				ScaleInCooldown:  config.Duration(5 * time.Minute),
+			},
+		},
+		StepScalingPolicies: []config.ECSStepScalingPolicy{
+			{
+				Name:                  "queue",
+				AdjustmentType:        "ChangeInCapacity",
+				MetricAggregationType: "Average",
+				Steps: []config.ECSStepAdjustment{
+					{LowerBound: aws.Float64(0), ScalingAdjustment: 2},
+				},
+			},
+		},
+	}
+
+	got := MakeServiceAutoScaling(service, cfg)
+
+	require.Len(t, got.ScalableTargets, 1)
+	assert.Equal(t, "service/cluster/service", aws.ToString(got.ScalableTargets[0].ResourceId))
+	assert.Equal(t, aastypes.ScalableDimensionECSServiceDesiredCount, got.ScalableTargets[0].ScalableDimension)
+	assert.Equal(t, int32(2), aws.ToInt32(got.ScalableTargets[0].MinCapacity))
+	assert.Equal(t, int32(10), aws.ToInt32(got.ScalableTargets[0].MaxCapacity))
+
+	require.Len(t, got.ScalingPolicies, 2)
+	tracking := got.ScalingPolicies[0]
+	assert.Equal(t, "cpu", aws.ToString(tracking.PolicyName))
+	assert.Equal(t, aastypes.PolicyTypeTargetTrackingScaling, tracking.PolicyType)
+	assert.Equal(t, 60.0, aws.ToFloat64(tracking.TargetTrackingScalingPolicyConfiguration.TargetValue))
+	assert.Equal(t, aastypes.MetricTypeECSServiceAverageCPUUtilization, tracking.TargetTrackingScalingPolicyConfiguration.PredefinedMetricSpecification.PredefinedMetricType)
+	assert.Equal(t, int32(300), aws.ToInt32(tracking.TargetTrackingScalingPolicyConfiguration.ScaleInCooldown))
+
+	step := got.ScalingPolicies[1]
+	assert.Equal(t, "queue", aws.ToString(step.PolicyName))
+	assert.Equal(t, aastypes.PolicyTypeStepScaling, step.PolicyType)
+	assert.Equal(t, aastypes.AdjustmentTypeChangeInCapacity, step.StepScalingPolicyConfiguration.AdjustmentType)
+	assert.Nil(t, step.StepScalingPolicyConfiguration.MinAdjustmentMagnitude)
+	assert.Equal(t, []aastypes.StepAdjustment{
+		{MetricIntervalLowerBound: aws.Float64(0), ScalingAdjustment: aws.Int32(2)},
+	}, step.StepScalingPolicyConfiguration.StepAdjustments)
+}
@@ -18,18 +18,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	aastypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	cdtypes "github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/awsapi"
 	"github.com/pipe-cd/pipecd/pkg/backoff"
 	appconfig "github.com/pipe-cd/pipecd/pkg/config"
 )
@@ -45,12 +54,17 @@ const (
 )
 
 type client struct {
-	ecsClient *ecs.Client
-	elbClient *elasticloadbalancingv2.Client
-	logger    *zap.Logger
+	ecsClient  *ecs.Client
+	elbClient  *elasticloadbalancingv2.Client
+	aasClient  *applicationautoscaling.Client
+	cdClient   *codedeploy.Client
+	ebClient   *eventbridge.Client
+	logsClient *cloudwatchlogs.Client
+	meshClient *appmesh.Client
+	logger     *zap.Logger
 }
 
-func newClient(region, profile, credentialsFile, roleARN, tokenPath string, logger *zap.Logger) (Client, error) {
+func newClient(platformProvider, region, profile, credentialsFile, roleARN, tokenPath string, apiClientCfg *appconfig.AWSAPIClientConfig, logger *zap.Logger) (Client, error) {
 	if region == "" {
 		return nil, fmt.Errorf("region is required field")
 	}
@@ -59,7 +73,10 @@ func newClient(region, profile, credentialsFile, roleARN, tokenPath string, logg
 		logger: logger.Named("ecs"),
 	}
 
-	optFns := []func(*config.LoadOptions) error{config.WithRegion(region)}
+	optFns := []func(*config.LoadOptions) error{
+		config.WithRegion(region),
+		config.WithRetryer(awsapi.NewRetryer(platformProvider, apiClientCfg)),
+	}
 	if credentialsFile != "" {
 		optFns = append(optFns, config.WithSharedCredentialsFiles([]string{credentialsFile}))
 	}
@@ -79,6 +96,11 @@ func newClient(region, profile, credentialsFile, roleARN, tokenPath string, logg
 	}
 	c.ecsClient = ecs.NewFromConfig(cfg)
 	c.elbClient = elasticloadbalancingv2.NewFromConfig(cfg)
+	c.aasClient = applicationautoscaling.NewFromConfig(cfg)
+	c.cdClient = codedeploy.NewFromConfig(cfg)
+	c.ebClient = eventbridge.NewFromConfig(cfg)
+	c.logsClient = cloudwatchlogs.NewFromConfig(cfg)
+	c.meshClient = appmesh.NewFromConfig(cfg)
 
 	return c, nil
 }
@@ -254,6 +276,11 @@ func (c *client) CreateTaskSet(ctx context.Context, service types.Service, taskD
 		LaunchType:           service.LaunchType,
 		ServiceRegistries:    service.ServiceRegistries,
 	}
+	// The launch type must be omitted when the capacity provider strategy is specified.
+	if len(service.CapacityProviderStrategy) > 0 {
+		input.CapacityProviderStrategy = service.CapacityProviderStrategy
+		input.LaunchType = ""
+	}
 	if targetGroup != nil {
 		input.LoadBalancers = []types.LoadBalancer{*targetGroup}
 	}
@@ -263,33 +290,54 @@ func (c *client) CreateTaskSet(ctx context.Context, service types.Service, taskD
 	}
 
 	// Wait created TaskSet to be stable.
-	waitInput := &ecs.DescribeTaskSetsInput{
-		Cluster:  service.ClusterArn,
-		Service:  service.ServiceArn,
-		TaskSets: []string{*output.TaskSet.TaskSetArn},
+	if err := c.waitTaskSetStable(ctx, *output.TaskSet); err != nil {
+		return nil, fmt.Errorf("failed to wait ECS task set %s stable: %w", *taskDefinition.TaskDefinitionArn, err)
+	}
+
+	return output.TaskSet, nil
+}
+
+func (c *client) UpdateTaskSetScale(ctx context.Context, taskSet types.TaskSet, scale int) (*types.TaskSet, error) {
+	input := &ecs.UpdateTaskSetInput{
+		Cluster: taskSet.ClusterArn,
+		Service: taskSet.ServiceArn,
+		TaskSet: taskSet.TaskSetArn,
+		Scale:   &types.Scale{Unit: types.ScaleUnitPercent, Value: float64(scale)},
+	}
+	output, err := c.ecsClient.UpdateTaskSet(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update scale of ECS task set %s: %w", *taskSet.TaskSetArn, err)
+	}
+
+	if err := c.waitTaskSetStable(ctx, *output.TaskSet); err != nil {
+		return nil, fmt.Errorf("failed to wait ECS task set %s stable: %w", *taskSet.TaskSetArn, err)
+	}
+
+	return output.TaskSet, nil
+}
+
+func (c *client) waitTaskSetStable(ctx context.Context, taskSet types.TaskSet) error {
+	input := &ecs.DescribeTaskSetsInput{
+		Cluster:  taskSet.ClusterArn,
+		Service:  taskSet.ServiceArn,
+		TaskSets: []string{*taskSet.TaskSetArn},
 	}
 
 	retry := backoff.NewRetry(retryTaskSetStable, backoff.NewConstant(retryTaskSetStableInterval))
-	_, err = retry.Do(ctx, func() (interface{}, error) {
-		output, err := c.ecsClient.DescribeTaskSets(ctx, waitInput)
+	_, err := retry.Do(ctx, func() (interface{}, error) {
+		output, err := c.ecsClient.DescribeTaskSets(ctx, input)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get ECS task set %s: %w", *taskDefinition.TaskDefinitionArn, err)
+			return nil, fmt.Errorf("failed to get ECS task set %s: %w", *taskSet.TaskSetArn, err)
 		}
 		if len(output.TaskSets) == 0 {
-			return nil, fmt.Errorf("failed to get ECS task set %s: task sets empty", *taskDefinition.TaskDefinitionArn)
+			return nil, fmt.Errorf("failed to get ECS task set %s: task sets empty", *taskSet.TaskSetArn)
 		}
-		taskSet := output.TaskSets[0]
-		if taskSet.StabilityStatus == types.StabilityStatusSteadyState {
+		if output.TaskSets[0].StabilityStatus == types.StabilityStatusSteadyState {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("task set %s is not stable", *taskDefinition.TaskDefinitionArn)
+		return nil, fmt.Errorf("task set %s is not stable", *taskSet.TaskSetArn)
 	})
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to wait ECS task set %s stable: %w", *taskDefinition.TaskDefinitionArn, err)
-	}
-
-	return output.TaskSet, nil
+	return err
 }
 
 func (c *client) GetServiceTaskSets(ctx context.Context, service types.Service) ([]*types.TaskSet, error) {
@@ -343,6 +391,110 @@ func (c *client) GetServiceTaskSets(ctx context.Context, service types.Service)
 	return taskSets, nil
 }
 
+func (c *client) GetPrimaryTaskDefinitionArn(ctx context.Context, service types.Service) (string, error) {
+	input := &ecs.DescribeServicesInput{
+		Cluster: service.ClusterArn,
+		Services: []string{
+			*service.ServiceName,
+		},
+	}
+	output, err := c.ecsClient.DescribeServices(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to get service %s: %w", *service.ServiceName, err)
+	}
+	if len(output.Services) == 0 {
+		return "", platformprovider.ErrNotFound
+	}
+
+	for _, ts := range output.Services[0].TaskSets {
+		if aws.ToString(ts.Status) == "PRIMARY" && ts.TaskDefinition != nil {
+			return *ts.TaskDefinition, nil
+		}
+	}
+	return "", platformprovider.ErrNotFound
+}
+
+func (c *client) UpdateServiceTaskDefinition(ctx context.Context, service types.Service, taskDefinitionArn string, serviceConnect *types.ServiceConnectConfiguration) (*types.Service, string, error) {
+	input := &ecs.UpdateServiceInput{
+		Cluster:                     service.ClusterArn,
+		Service:                     service.ServiceName,
+		TaskDefinition:              aws.String(taskDefinitionArn),
+		DeploymentConfiguration:     service.DeploymentConfiguration,
+		NetworkConfiguration:        service.NetworkConfiguration,
+		PlatformVersion:             service.PlatformVersion,
+		EnableExecuteCommand:        aws.Bool(service.EnableExecuteCommand),
+		PlacementStrategy:           service.PlacementStrategy,
+		PropagateTags:               service.PropagateTags,
+		EnableECSManagedTags:        aws.Bool(service.EnableECSManagedTags),
+		ServiceConnectConfiguration: serviceConnect,
+	}
+	// Keep current desiredCount when it is not set because a user might use AutoScaling.
+	if service.DesiredCount != 0 {
+		input.DesiredCount = aws.Int32(service.DesiredCount)
+	}
+
+	output, err := c.ecsClient.UpdateService(ctx, input)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to update task definition of ECS service %s: %w", *service.ServiceName, err)
+	}
+	id, err := primaryDeploymentID(*output.Service)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to find the deployment started for ECS service %s: %w", *service.ServiceName, err)
+	}
+	return output.Service, id, nil
+}
+
+func (c *client) ValidateServiceConnectNamespace(ctx context.Context, service types.Service, serviceConnect types.ServiceConnectConfiguration) error {
+	namespace := aws.ToString(serviceConnect.Namespace)
+	if namespace == "" {
+		output, err := c.ecsClient.DescribeClusters(ctx, &ecs.DescribeClustersInput{
+			Clusters: []string{aws.ToString(service.ClusterArn)},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get cluster %s: %w", aws.ToString(service.ClusterArn), err)
+		}
+		if len(output.Clusters) == 0 {
+			return fmt.Errorf("cluster %s was not found", aws.ToString(service.ClusterArn))
+		}
+		if d := output.Clusters[0].ServiceConnectDefaults; d != nil {
+			namespace = aws.ToString(d.Namespace)
+		}
+		if namespace == "" {
+			return fmt.Errorf("serviceConnectConfiguration.namespace is required because cluster %s has no default Service Connect namespace", aws.ToString(service.ClusterArn))
+		}
+	}
+
+	_, err := c.ecsClient.ListServicesByNamespace(ctx, &ecs.ListServicesByNamespaceInput{
+		Namespace:  aws.String(namespace),
+		MaxResults: aws.Int32(1),
+	})
+	var nfe *types.NamespaceNotFoundException
+	if errors.As(err, &nfe) {
+		return fmt.Errorf("namespace %s used by Service Connect does not exist in Cloud Map", namespace)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to validate Cloud Map namespace %s: %w", namespace, err)
+	}
+	return nil
+}
+
+func (c *client) GetRollingDeployment(ctx context.Context, service types.Service, deploymentID string) (*RollingDeployment, error) {
+	input := &ecs.DescribeServicesInput{
+		Cluster: service.ClusterArn,
+		Services: []string{
+			*service.ServiceName,
+		},
+	}
+	output, err := c.ecsClient.DescribeServices(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service %s: %w", *service.ServiceName, err)
+	}
+	if len(output.Services) == 0 {
+		return nil, platformprovider.ErrNotFound
+	}
+	return findRollingDeployment(output.Services[0], deploymentID)
+}
+
 // WaitServiceStable blocks until the ECS service is stable.
 // It returns nil if the service is stable, otherwise it returns an error.
 // Note: This function follow the implementation of the AWS CLI.
@@ -432,6 +584,29 @@ func (c *client) ServiceExists(ctx context.Context, clusterName string, serviceN
 	return false, nil
 }
 
+func (c *client) GetService(ctx context.Context, clusterName string, serviceName string) (*types.Service, error) {
+	input := &ecs.DescribeServicesInput{
+		Cluster:  aws.String(clusterName),
+		Services: []string{serviceName},
+		Include:  []types.ServiceField{types.ServiceFieldTags},
+	}
+	output, err := c.ecsClient.DescribeServices(ctx, input)
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, platformprovider.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get service %s: %w", serviceName, err)
+	}
+	for i := range output.Services {
+		service := output.Services[i]
+		if aws.ToString(service.ServiceName) == serviceName && aws.ToString(service.Status) == "ACTIVE" {
+			return &service, nil
+		}
+	}
+	return nil, platformprovider.ErrNotFound
+}
+
 func (c *client) GetListenerArns(ctx context.Context, targetGroup types.LoadBalancer) ([]string, error) {
 	loadBalancerArn, err := c.getLoadBalancerArn(ctx, *targetGroup.TargetGroupArn)
 	if err != nil {
@@ -468,75 +643,376 @@ func (c *client) getLoadBalancerArn(ctx context.Context, targetGroupArn string)
 	if len(output.TargetGroups) == 0 {
 		return "", platformprovider.ErrNotFound
 	}
+	if len(output.TargetGroups[0].LoadBalancerArns) == 0 {
+		return "", platformprovider.ErrNotFound
+	}
 	// Note: Currently, only support TargetGroup which serves traffic from one Load Balancer.
 	return output.TargetGroups[0].LoadBalancerArns[0], nil
 }
 
-func (c *client) ModifyListeners(ctx context.Context, listenerArns []string, routingTrafficCfg RoutingTrafficConfig) ([]string, error) {
+func (c *client) GetTargetGroupWeight(ctx context.Context, listenerArns []string, targetGroupArn string) (int32, error) {
+	var max int32
+	for _, listenerArn := range listenerArns {
+		describeRulesOutput, err := c.elbClient.DescribeRules(ctx, &elasticloadbalancingv2.DescribeRulesInput{
+			ListenerArn: aws.String(listenerArn),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to describe rules of listener %s: %w", listenerArn, err)
+		}
+		for _, rule := range describeRulesOutput.Rules {
+			if weight := forwardedWeight(rule.Actions, targetGroupArn); weight > max {
+				max = weight
+			}
+		}
+	}
+	return max, nil
+}
+
+func (c *client) ModifyListeners(ctx context.Context, listenerArns []string, routingTrafficCfg RoutingTrafficConfig, scope ListenerRuleScope, stickinessDuration time.Duration) ([]string, error) {
 	if len(routingTrafficCfg) != 2 {
 		return nil, fmt.Errorf("invalid listener configuration: requires 2 target groups")
 	}
 
-	modifiedRuleArns := make([]string, 0)
+	var stickinessCfg *elbtypes.TargetGroupStickinessConfig
+	if stickinessDuration > 0 {
+		stickinessCfg = &elbtypes.TargetGroupStickinessConfig{
+			Enabled:         aws.Bool(true),
+			DurationSeconds: aws.Int32(int32(stickinessDuration.Seconds())),
+		}
+	}
 
+	type targetRule struct {
+		listenerArn string
+		rule        elbtypes.Rule
+	}
+
+	// Find all rules to modify before modifying any of them
+	// so that nothing is modified when some of them are not owned by the application.
+	var (
+		targetRules []targetRule
+		notOwned    []string
+	)
 	for _, listenerArn := range listenerArns {
 		describeRulesOutput, err := c.elbClient.DescribeRules(ctx, &elasticloadbalancingv2.DescribeRulesInput{
 			ListenerArn: aws.String(listenerArn),
 		})
 		if err != nil {
-			return modifiedRuleArns, fmt.Errorf("failed to describe rules of listener %s: %w", listenerArn, err)
+			return nil, fmt.Errorf("failed to describe rules of listener %s: %w", listenerArn, err)
+		}
+
+		var tags map[string]map[string]string
+		if scope.needsTags() {
+			tags, err = c.describeListenerRuleTags(ctx, listenerArn, describeRulesOutput.Rules)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		for _, rule := range describeRulesOutput.Rules {
-			modifiedActions := make([]elbtypes.Action, 0, len(rule.Actions))
-			for _, action := range rule.Actions {
-				if action.Type == elbtypes.ActionTypeEnumForward && routingTrafficCfg.hasSameTargets(action.ForwardConfig.TargetGroups) {
-					// Modify only the forward action which has the same target groups.
-					modifiedAction := elbtypes.Action{
-						Type:  elbtypes.ActionTypeEnumForward,
-						Order: action.Order,
-						ForwardConfig: &elbtypes.ForwardActionConfig{
-							TargetGroups: []elbtypes.TargetGroupTuple{
-								{
-									TargetGroupArn: aws.String(routingTrafficCfg[0].TargetGroupArn),
-									Weight:         aws.Int32(int32(routingTrafficCfg[0].Weight)),
-								},
-								{
-									TargetGroupArn: aws.String(routingTrafficCfg[1].TargetGroupArn),
-									Weight:         aws.Int32(int32(routingTrafficCfg[1].Weight)),
-								},
+			ruleTags := tags[aws.ToString(rule.RuleArn)]
+			// Leave the rules which are not related to the target groups or not selected untouched.
+			if !forwardsToTargets(rule, routingTrafficCfg) || !isSelectedRule(rule, ruleTags, scope.Selectors) {
+				continue
+			}
+			if scope.Owner != "" && !isOwnedBy(ruleTags, scope.Owner) {
+				notOwned = append(notOwned, aws.ToString(rule.RuleArn))
+				continue
+			}
+			targetRules = append(targetRules, targetRule{listenerArn: listenerArn, rule: rule})
+		}
+	}
+	if len(notOwned) > 0 {
+		return nil, fmt.Errorf("refused to modify the listener rules not owned by application %s: %s", scope.Owner, strings.Join(notOwned, ", "))
+	}
+	if len(scope.Selectors) > 0 && len(targetRules) == 0 {
+		return nil, fmt.Errorf("no listener rule forwarding to the target groups matched the given selectors")
+	}
+
+	modifiedRuleArns := make([]string, 0, len(targetRules))
+	for _, t := range targetRules {
+		listenerArn, rule := t.listenerArn, t.rule
+
+		modifiedActions := make([]elbtypes.Action, 0, len(rule.Actions))
+		for _, action := range rule.Actions {
+			if action.Type == elbtypes.ActionTypeEnumForward && routingTrafficCfg.hasSameTargets(action.ForwardConfig.TargetGroups) {
+				// Modify only the forward action which has the same target groups.
+				modifiedAction := elbtypes.Action{
+					Type:  elbtypes.ActionTypeEnumForward,
+					Order: action.Order,
+					ForwardConfig: &elbtypes.ForwardActionConfig{
+						TargetGroups: []elbtypes.TargetGroupTuple{
+							{
+								TargetGroupArn: aws.String(routingTrafficCfg[0].TargetGroupArn),
+								Weight:         aws.Int32(int32(routingTrafficCfg[0].Weight)),
+							},
+							{
+								TargetGroupArn: aws.String(routingTrafficCfg[1].TargetGroupArn),
+								Weight:         aws.Int32(int32(routingTrafficCfg[1].Weight)),
 							},
 						},
-					}
-					modifiedActions = append(modifiedActions, modifiedAction)
-				} else {
-					modifiedActions = append(modifiedActions, action)
+						TargetGroupStickinessConfig: stickinessCfg,
+					},
 				}
+				modifiedActions = append(modifiedActions, modifiedAction)
+			} else {
+				modifiedActions = append(modifiedActions, action)
 			}
+		}
 
-			// The default rule needs to be modified by ModifyListener API.
-			if aws.ToBool(rule.IsDefault) {
-				_, err := c.elbClient.ModifyListener(ctx, &elasticloadbalancingv2.ModifyListenerInput{
-					ListenerArn:    &listenerArn,
-					DefaultActions: modifiedActions,
-				})
-				if err != nil {
-					return modifiedRuleArns, fmt.Errorf("failed to modify default rule %s: %w", *rule.RuleArn, err)
+		// The default rule needs to be modified by ModifyListener API.
+		if aws.ToBool(rule.IsDefault) {
+			_, err := c.elbClient.ModifyListener(ctx, &elasticloadbalancingv2.ModifyListenerInput{
+				ListenerArn:    &listenerArn,
+				DefaultActions: modifiedActions,
+			})
+			if err != nil {
+				return modifiedRuleArns, fmt.Errorf("failed to modify default rule %s: %w", *rule.RuleArn, err)
+			}
+			modifiedRuleArns = append(modifiedRuleArns, fmt.Sprintf("default rule of listener %s", listenerArn))
+		} else {
+			_, err := c.elbClient.ModifyRule(ctx, &elasticloadbalancingv2.ModifyRuleInput{
+				RuleArn: rule.RuleArn,
+				Actions: modifiedActions,
+			})
+			if err != nil {
+				return modifiedRuleArns, fmt.Errorf("failed to modify rule %s: %w", *rule.RuleArn, err)
+			}
+			modifiedRuleArns = append(modifiedRuleArns, *rule.RuleArn)
+		}
+	}
+	return modifiedRuleArns, nil
+}
+
+// describeListenerRuleTags returns the tags of the given rules of the listener keyed by their ARNs.
+// The default rule is given the tags of the listener.
+func (c *client) describeListenerRuleTags(ctx context.Context, listenerArn string, rules []elbtypes.Rule) (map[string]map[string]string, error) {
+	// DescribeTags API accepts up to 20 resources at once.
+	const describeTagsChunkSize = 20
+
+	var defaultRuleArn string
+	resourceArns := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		if aws.ToBool(rule.IsDefault) {
+			defaultRuleArn = aws.ToString(rule.RuleArn)
+			resourceArns = append(resourceArns, listenerArn)
+			continue
+		}
+		resourceArns = append(resourceArns, aws.ToString(rule.RuleArn))
+	}
+
+	tags := make(map[string]map[string]string, len(resourceArns))
+	for i := 0; i < len(resourceArns); i += describeTagsChunkSize {
+		end := min(i+describeTagsChunkSize, len(resourceArns))
+		describeTagsOutput, err := c.elbClient.DescribeTags(ctx, &elasticloadbalancingv2.DescribeTagsInput{
+			ResourceArns: resourceArns[i:end],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe tags of rules of listener %s: %w", listenerArn, err)
+		}
+		for _, td := range describeTagsOutput.TagDescriptions {
+			arn := aws.ToString(td.ResourceArn)
+			if arn == listenerArn {
+				arn = defaultRuleArn
+			}
+			m := make(map[string]string, len(td.Tags))
+			for _, t := range td.Tags {
+				m[aws.ToString(t.Key)] = aws.ToString(t.Value)
+			}
+			tags[arn] = m
+		}
+	}
+	return tags, nil
+}
+
+func (c *client) CreateABTestingRules(ctx context.Context, listenerArns []string, routingTrafficCfg RoutingTrafficConfig, ruleSelectors []appconfig.ECSListenerRuleSelector, canaryTargetGroupArn string, rules []appconfig.ABTestingRule) ([]string, error) {
+	conditions := make([]elbtypes.RuleCondition, 0, len(rules))
+	for _, r := range rules {
+		cond, err := makeABTestingCondition(r)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, cond)
+	}
+
+	createdRuleArns := make([]string, 0)
+	for _, listenerArn := range listenerArns {
+		describeRulesOutput, err := c.elbClient.DescribeRules(ctx, &elasticloadbalancingv2.DescribeRulesInput{
+			ListenerArn: aws.String(listenerArn),
+		})
+		if err != nil {
+			return createdRuleArns, fmt.Errorf("failed to describe rules of listener %s: %w", listenerArn, err)
+		}
+
+		var tags map[string]map[string]string
+		if selectorsNeedTags(ruleSelectors) {
+			tags, err = c.describeListenerRuleTags(ctx, listenerArn, describeRulesOutput.Rules)
+			if err != nil {
+				return createdRuleArns, err
+			}
+		}
+
+		// Find the selected rules forwarding to the PRIMARY and CANARY target groups.
+		targetRules := make([]elbtypes.Rule, 0, len(describeRulesOutput.Rules))
+		for _, rule := range describeRulesOutput.Rules {
+			if forwardsToTargets(rule, routingTrafficCfg) && isSelectedRule(rule, tags[aws.ToString(rule.RuleArn)], ruleSelectors) {
+				targetRules = append(targetRules, rule)
+			}
+		}
+
+		used, err := usedListenerRulePriorities(describeRulesOutput.Rules)
+		if err != nil {
+			return createdRuleArns, fmt.Errorf("failed to create A/B testing rules for listener %s: %w", listenerArn, err)
+		}
+
+		for _, rule := range targetRules {
+			// The A/B testing rules must be evaluated right before the original one,
+			// otherwise they could shadow or be shadowed by the rules of other applications.
+			rp, err := listenerRulePriority(rule)
+			if err != nil {
+				return createdRuleArns, fmt.Errorf("failed to create A/B testing rules for listener %s: %w", listenerArn, err)
+			}
+			priorities, err := prioritiesBefore(used, rp, len(conditions))
+			if err != nil {
+				return createdRuleArns, fmt.Errorf("failed to create A/B testing rules for rule %s of listener %s: %w", aws.ToString(rule.RuleArn), listenerArn, err)
+			}
+			for i, cond := range conditions {
+				ruleConditions := make([]elbtypes.RuleCondition, 0, len(rule.Conditions)+1)
+				for _, rc := range rule.Conditions {
+					ruleConditions = append(ruleConditions, copyRuleCondition(rc))
 				}
-				modifiedRuleArns = append(modifiedRuleArns, fmt.Sprintf("default rule of listener %s", listenerArn))
-			} else {
-				_, err := c.elbClient.ModifyRule(ctx, &elasticloadbalancingv2.ModifyRuleInput{
-					RuleArn: rule.RuleArn,
-					Actions: modifiedActions,
+				ruleConditions = append(ruleConditions, cond)
+
+				output, err := c.elbClient.CreateRule(ctx, &elasticloadbalancingv2.CreateRuleInput{
+					ListenerArn: aws.String(listenerArn),
+					Priority:    aws.Int32(priorities[i]),
+					Conditions:  ruleConditions,
+					Actions: []elbtypes.Action{
+						{
+							Type: elbtypes.ActionTypeEnumForward,
+							ForwardConfig: &elbtypes.ForwardActionConfig{
+								TargetGroups: []elbtypes.TargetGroupTuple{
+									{
+										TargetGroupArn: aws.String(canaryTargetGroupArn),
+										Weight:         aws.Int32(100),
+									},
+								},
+							},
+						},
+					},
+					Tags: []elbtypes.Tag{
+						{Key: aws.String(LabelManagedBy), Value: aws.String(ManagedByPiped)},
+						{Key: aws.String(LabelABTesting), Value: aws.String("true")},
+					},
 				})
 				if err != nil {
-					return modifiedRuleArns, fmt.Errorf("failed to modify rule %s: %w", *rule.RuleArn, err)
+					return createdRuleArns, fmt.Errorf("failed to create A/B testing rule for listener %s: %w", listenerArn, err)
+				}
+				for _, r := range output.Rules {
+					createdRuleArns = append(createdRuleArns, *r.RuleArn)
 				}
-				modifiedRuleArns = append(modifiedRuleArns, *rule.RuleArn)
 			}
 		}
 	}
-	return modifiedRuleArns, nil
+	return createdRuleArns, nil
+}
+
+func (c *client) DeleteABTestingRules(ctx context.Context, listenerArns []string) ([]string, error) {
+	return c.deleteTaggedRules(ctx, listenerArns, LabelABTesting)
+}
+
+func (c *client) CreateMaintenanceRules(ctx context.Context, listenerArns []string, targetGroupArns []string, scope ListenerRuleScope, res MaintenanceResponse) ([]string, error) {
+	createdRuleArns := make([]string, 0)
+	for _, listenerArn := range listenerArns {
+		describeRulesOutput, err := c.elbClient.DescribeRules(ctx, &elasticloadbalancingv2.DescribeRulesInput{
+			ListenerArn: aws.String(listenerArn),
+		})
+		if err != nil {
+			return createdRuleArns, fmt.Errorf("failed to describe rules of listener %s: %w", listenerArn, err)
+		}
+
+		var tags map[string]map[string]string
+		if scope.needsTags() {
+			tags, err = c.describeListenerRuleTags(ctx, listenerArn, describeRulesOutput.Rules)
+			if err != nil {
+				return createdRuleArns, err
+			}
+		}
+
+		rules, err := makeMaintenanceRules(describeRulesOutput.Rules, tags, targetGroupArns, scope)
+		if err != nil {
+			return createdRuleArns, fmt.Errorf("failed to create maintenance rules for listener %s: %w", listenerArn, err)
+		}
+
+		for _, rule := range rules {
+			output, err := c.elbClient.CreateRule(ctx, &elasticloadbalancingv2.CreateRuleInput{
+				ListenerArn: aws.String(listenerArn),
+				Priority:    aws.Int32(rule.priority),
+				Conditions:  rule.conditions,
+				Actions:     []elbtypes.Action{makeMaintenanceAction(res)},
+				Tags: []elbtypes.Tag{
+					{Key: aws.String(LabelManagedBy), Value: aws.String(ManagedByPiped)},
+					{Key: aws.String(LabelMaintenance), Value: aws.String("true")},
+				},
+			})
+			if err != nil {
+				return createdRuleArns, fmt.Errorf("failed to create maintenance rule for listener %s: %w", listenerArn, err)
+			}
+			for _, r := range output.Rules {
+				createdRuleArns = append(createdRuleArns, *r.RuleArn)
+			}
+		}
+	}
+	return createdRuleArns, nil
+}
+
+func (c *client) DeleteMaintenanceRules(ctx context.Context, listenerArns []string) ([]string, error) {
+	return c.deleteTaggedRules(ctx, listenerArns, LabelMaintenance)
+}
+
+// deleteTaggedRules deletes all listener rules having the given tag key.
+func (c *client) deleteTaggedRules(ctx context.Context, listenerArns []string, tagKey string) ([]string, error) {
+	// DescribeTags API accepts up to 20 resources at once.
+	const describeTagsChunkSize = 20
+
+	deletedRuleArns := make([]string, 0)
+	for _, listenerArn := range listenerArns {
+		describeRulesOutput, err := c.elbClient.DescribeRules(ctx, &elasticloadbalancingv2.DescribeRulesInput{
+			ListenerArn: aws.String(listenerArn),
+		})
+		if err != nil {
+			return deletedRuleArns, fmt.Errorf("failed to describe rules of listener %s: %w", listenerArn, err)
+		}
+
+		ruleArns := make([]string, 0, len(describeRulesOutput.Rules))
+		for _, rule := range describeRulesOutput.Rules {
+			if !aws.ToBool(rule.IsDefault) {
+				ruleArns = append(ruleArns, *rule.RuleArn)
+			}
+		}
+
+		for i := 0; i < len(ruleArns); i += describeTagsChunkSize {
+			end := min(i+describeTagsChunkSize, len(ruleArns))
+			describeTagsOutput, err := c.elbClient.DescribeTags(ctx, &elasticloadbalancingv2.DescribeTagsInput{
+				ResourceArns: ruleArns[i:end],
+			})
+			if err != nil {
+				return deletedRuleArns, fmt.Errorf("failed to describe tags of rules of listener %s: %w", listenerArn, err)
+			}
+
+			for _, td := range describeTagsOutput.TagDescriptions {
+				if !hasTag(td.Tags, tagKey) {
+					continue
+				}
+				if _, err := c.elbClient.DeleteRule(ctx, &elasticloadbalancingv2.DeleteRuleInput{
+					RuleArn: td.ResourceArn,
+				}); err != nil {
+					return deletedRuleArns, fmt.Errorf("failed to delete rule %s: %w", *td.ResourceArn, err)
+				}
+				deletedRuleArns = append(deletedRuleArns, *td.ResourceArn)
+			}
+		}
+	}
+	return deletedRuleArns, nil
 }
 
 func (c *client) TagResource(ctx context.Context, resourceArn string, tags []types.Tag) error {
@@ -682,3 +1158,210 @@ func (c *client) UntagResource(ctx context.Context, resourceArn string, tagKeys
 	_, err := c.ecsClient.UntagResource(ctx, input)
 	return err
 }
+
+func (c *client) GetServiceAutoScaling(ctx context.Context, service types.Service) (*ServiceAutoScaling, error) {
+	resourceID := serviceResourceID(service)
+	out := &ServiceAutoScaling{}
+
+	targetsIn := &applicationautoscaling.DescribeScalableTargetsInput{
+		ServiceNamespace: aastypes.ServiceNamespaceEcs,
+		ResourceIds:      []string{resourceID},
+	}
+	for {
+		targetsOut, err := c.aasClient.DescribeScalableTargets(ctx, targetsIn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe scalable targets of %s: %w", resourceID, err)
+		}
+		out.ScalableTargets = append(out.ScalableTargets, targetsOut.ScalableTargets...)
+		if targetsOut.NextToken == nil {
+			break
+		}
+		targetsIn.NextToken = targetsOut.NextToken
+	}
+
+	policiesIn := &applicationautoscaling.DescribeScalingPoliciesInput{
+		ServiceNamespace: aastypes.ServiceNamespaceEcs,
+		ResourceId:       aws.String(resourceID),
+	}
+	for {
+		policiesOut, err := c.aasClient.DescribeScalingPolicies(ctx, policiesIn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe scaling policies of %s: %w", resourceID, err)
+		}
+		out.ScalingPolicies = append(out.ScalingPolicies, policiesOut.ScalingPolicies...)
+		if policiesOut.NextToken == nil {
+			break
+		}
+		policiesIn.NextToken = policiesOut.NextToken
+	}
+
+	return out, nil
+}
+
+func (c *client) PutServiceAutoScaling(ctx context.Context, service types.Service, settings ServiceAutoScaling) error {
+	resourceID := serviceResourceID(service)
+	current, err := c.GetServiceAutoScaling(ctx, service)
+	if err != nil {
+		return err
+	}
+	plan := planAutoScalingRestore(*current, settings)
+
+	for _, t := range plan.deregisterTargets {
+		if _, err := c.aasClient.DeregisterScalableTarget(ctx, &applicationautoscaling.DeregisterScalableTargetInput{
+			ServiceNamespace:  aastypes.ServiceNamespaceEcs,
+			ResourceId:        aws.String(resourceID),
+			ScalableDimension: t.ScalableDimension,
+		}); err != nil {
+			return fmt.Errorf("failed to deregister scalable target %s of %s: %w", t.ScalableDimension, resourceID, err)
+		}
+	}
+	for _, t := range plan.registerTargets {
+		if _, err := c.aasClient.RegisterScalableTarget(ctx, &applicationautoscaling.RegisterScalableTargetInput{
+			ServiceNamespace:  aastypes.ServiceNamespaceEcs,
+			ResourceId:        aws.String(resourceID),
+			ScalableDimension: t.ScalableDimension,
+			MinCapacity:       t.MinCapacity,
+			MaxCapacity:       t.MaxCapacity,
+			SuspendedState:    t.SuspendedState,
+		}); err != nil {
+			return fmt.Errorf("failed to register scalable target %s of %s: %w", t.ScalableDimension, resourceID, err)
+		}
+	}
+	for _, p := range plan.deletePolicies {
+		if _, err := c.aasClient.DeleteScalingPolicy(ctx, &applicationautoscaling.DeleteScalingPolicyInput{
+			ServiceNamespace:  aastypes.ServiceNamespaceEcs,
+			ResourceId:        aws.String(resourceID),
+			ScalableDimension: p.ScalableDimension,
+			PolicyName:        p.PolicyName,
+		}); err != nil {
+			return fmt.Errorf("failed to delete scaling policy %s of %s: %w", aws.ToString(p.PolicyName), resourceID, err)
+		}
+	}
+	for _, p := range plan.putPolicies {
+		if _, err := c.aasClient.PutScalingPolicy(ctx, &applicationautoscaling.PutScalingPolicyInput{
+			ServiceNamespace:                         aastypes.ServiceNamespaceEcs,
+			ResourceId:                               aws.String(resourceID),
+			ScalableDimension:                        p.ScalableDimension,
+			PolicyName:                               p.PolicyName,
+			PolicyType:                               p.PolicyType,
+			StepScalingPolicyConfiguration:           p.StepScalingPolicyConfiguration,
+			TargetTrackingScalingPolicyConfiguration: p.TargetTrackingScalingPolicyConfiguration,
+			PredictiveScalingPolicyConfiguration:     p.PredictiveScalingPolicyConfiguration,
+		}); err != nil {
+			return fmt.Errorf("failed to put scaling policy %s of %s: %w", aws.ToString(p.PolicyName), resourceID, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *client) FindCodeDeployDeploymentGroup(ctx context.Context, applicationName string, service types.Service) (string, error) {
+	paginator := codedeploy.NewListDeploymentGroupsPaginator(c.cdClient, &codedeploy.ListDeploymentGroupsInput{
+		ApplicationName: aws.String(applicationName),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to list deployment groups of CodeDeploy application %s: %w", applicationName, err)
+		}
+		if len(page.DeploymentGroups) == 0 {
+			continue
+		}
+		out, err := c.cdClient.BatchGetDeploymentGroups(ctx, &codedeploy.BatchGetDeploymentGroupsInput{
+			ApplicationName:      aws.String(applicationName),
+			DeploymentGroupNames: page.DeploymentGroups,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to get deployment groups of CodeDeploy application %s: %w", applicationName, err)
+		}
+		for _, g := range out.DeploymentGroupsInfo {
+			if deploysService(g.EcsServices, service) {
+				return aws.ToString(g.DeploymentGroupName), nil
+			}
+		}
+	}
+	return "", platformprovider.ErrNotFound
+}
+
+func (c *client) CreateCodeDeployDeployment(ctx context.Context, applicationName, deploymentGroupName, deploymentConfigName, appSpec, description string) (string, error) {
+	input := &codedeploy.CreateDeploymentInput{
+		ApplicationName:     aws.String(applicationName),
+		DeploymentGroupName: aws.String(deploymentGroupName),
+		Description:         aws.String(description),
+		Revision: &cdtypes.RevisionLocation{
+			RevisionType: cdtypes.RevisionLocationTypeAppSpecContent,
+			AppSpecContent: &cdtypes.AppSpecContent{
+				Content: aws.String(appSpec),
+			},
+		},
+	}
+	if deploymentConfigName != "" {
+		input.DeploymentConfigName = aws.String(deploymentConfigName)
+	}
+	out, err := c.cdClient.CreateDeployment(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to create CodeDeploy deployment in deployment group %s: %w", deploymentGroupName, err)
+	}
+	return aws.ToString(out.DeploymentId), nil
+}
+
+func (c *client) GetCodeDeployDeployment(ctx context.Context, deploymentID string) (*CodeDeployDeployment, error) {
+	out, err := c.cdClient.GetDeployment(ctx, &codedeploy.GetDeploymentInput{
+		DeploymentId: aws.String(deploymentID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CodeDeploy deployment %s: %w", deploymentID, err)
+	}
+	d := &CodeDeployDeployment{
+		ID:     deploymentID,
+		Status: out.DeploymentInfo.Status,
+	}
+	if ei := out.DeploymentInfo.ErrorInformation; ei != nil {
+		d.ErrorMessage = aws.ToString(ei.Message)
+	}
+
+	// The lifecycle events are recorded in the ECS target of the deployment.
+	targets, err := c.cdClient.ListDeploymentTargets(ctx, &codedeploy.ListDeploymentTargetsInput{
+		DeploymentId: aws.String(deploymentID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list targets of CodeDeploy deployment %s: %w", deploymentID, err)
+	}
+	if len(targets.TargetIds) == 0 {
+		return d, nil
+	}
+	targetsOut, err := c.cdClient.BatchGetDeploymentTargets(ctx, &codedeploy.BatchGetDeploymentTargetsInput{
+		DeploymentId: aws.String(deploymentID),
+		TargetIds:    targets.TargetIds,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get targets of CodeDeploy deployment %s: %w", deploymentID, err)
+	}
+	for _, t := range targetsOut.DeploymentTargets {
+		if t.EcsTarget == nil {
+			continue
+		}
+		for _, e := range t.EcsTarget.LifecycleEvents {
+			event := CodeDeployLifecycleEvent{
+				Name:   aws.ToString(e.LifecycleEventName),
+				Status: e.Status,
+			}
+			if e.Diagnostics != nil {
+				event.Message = aws.ToString(e.Diagnostics.Message)
+			}
+			d.LifecycleEvents = append(d.LifecycleEvents, event)
+		}
+	}
+	return d, nil
+}
+
+func (c *client) StopCodeDeployDeployment(ctx context.Context, deploymentID string, rollback bool) error {
+	_, err := c.cdClient.StopDeployment(ctx, &codedeploy.StopDeploymentInput{
+		DeploymentId:        aws.String(deploymentID),
+		AutoRollbackEnabled: aws.Bool(rollback),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stop CodeDeploy deployment %s: %w", deploymentID, err)
+	}
+	return nil
+}
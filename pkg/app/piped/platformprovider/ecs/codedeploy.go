@@ -0,0 +1,196 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	cdtypes "github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// CodeDeployDeployment represents the status of a CodeDeploy deployment of an ECS service.
+type CodeDeployDeployment struct {
+	ID     string
+	Status cdtypes.DeploymentStatus
+	// The error message of the deployment when it was failed or stopped.
+	ErrorMessage string
+	// The lifecycle events of the deployment target in the order of execution.
+	LifecycleEvents []CodeDeployLifecycleEvent
+}
+
+// IsCompleted returns true when the deployment reached a final status.
+func (d CodeDeployDeployment) IsCompleted() bool {
+	switch d.Status {
+	case cdtypes.DeploymentStatusSucceeded, cdtypes.DeploymentStatusFailed, cdtypes.DeploymentStatusStopped:
+		return true
+	}
+	return false
+}
+
+// CodeDeployLifecycleEvent represents a lifecycle event of a CodeDeploy deployment, e.g. BeforeAllowTraffic.
+type CodeDeployLifecycleEvent struct {
+	Name   string
+	Status cdtypes.LifecycleEventStatus
+	// The diagnostic message of the lifecycle event when it was failed.
+	Message string
+}
+
+// codeDeployAppSpec is the AppSpec file of a CodeDeploy deployment for ECS.
+// ref: https://docs.aws.amazon.com/codedeploy/latest/userguide/reference-appspec-file-structure-resources.html#reference-appspec-file-structure-resources-ecs
+type codeDeployAppSpec struct {
+	Version   float64                     `json:"version"`
+	Resources []codeDeployAppSpecResource `json:"Resources"`
+	Hooks     []map[string]string         `json:"Hooks,omitempty"`
+}
+
+type codeDeployAppSpecResource struct {
+	TargetService codeDeployAppSpecTargetService `json:"TargetService"`
+}
+
+type codeDeployAppSpecTargetService struct {
+	Type       string                        `json:"Type"`
+	Properties codeDeployAppSpecServiceProps `json:"Properties"`
+}
+
+type codeDeployAppSpecServiceProps struct {
+	TaskDefinition           string                                 `json:"TaskDefinition"`
+	LoadBalancerInfo         codeDeployAppSpecLoadBalancerInfo      `json:"LoadBalancerInfo"`
+	PlatformVersion          string                                 `json:"PlatformVersion,omitempty"`
+	NetworkConfiguration     *codeDeployAppSpecNetworkConfiguration `json:"NetworkConfiguration,omitempty"`
+	CapacityProviderStrategy []codeDeployAppSpecCapacityProvider    `json:"CapacityProviderStrategy,omitempty"`
+}
+
+type codeDeployAppSpecLoadBalancerInfo struct {
+	ContainerName string `json:"ContainerName"`
+	ContainerPort int32  `json:"ContainerPort"`
+}
+
+type codeDeployAppSpecNetworkConfiguration struct {
+	AwsvpcConfiguration codeDeployAppSpecAwsvpcConfiguration `json:"AwsvpcConfiguration"`
+}
+
+type codeDeployAppSpecAwsvpcConfiguration struct {
+	Subnets        []string `json:"Subnets"`
+	SecurityGroups []string `json:"SecurityGroups,omitempty"`
+	AssignPublicIp string   `json:"AssignPublicIp,omitempty"`
+}
+
+type codeDeployAppSpecCapacityProvider struct {
+	CapacityProvider string `json:"CapacityProvider"`
+	Base             int32  `json:"Base,omitempty"`
+	Weight           int32  `json:"Weight,omitempty"`
+}
+
+// MakeCodeDeployAppSpec returns the content of the AppSpec file deploying the given task definition to the given service.
+// The container receiving the traffic is taken from the given CodeDeploy input, or the given target group if not specified.
+func MakeCodeDeployAppSpec(taskDefinitionArn string, service types.Service, in config.ECSCodeDeployInput, targetGroup *types.LoadBalancer) (string, error) {
+	lb := codeDeployAppSpecLoadBalancerInfo{
+		ContainerName: in.ContainerName,
+		ContainerPort: int32(in.ContainerPort),
+	}
+	if lb.ContainerName == "" && targetGroup != nil {
+		if targetGroup.ContainerName != nil {
+			lb.ContainerName = *targetGroup.ContainerName
+		}
+		if targetGroup.ContainerPort != nil {
+			lb.ContainerPort = *targetGroup.ContainerPort
+		}
+	}
+	if lb.ContainerName == "" || lb.ContainerPort == 0 {
+		return "", fmt.Errorf("the container receiving the traffic must be specified by codeDeploy or the primary target group")
+	}
+
+	props := codeDeployAppSpecServiceProps{
+		TaskDefinition:   taskDefinitionArn,
+		LoadBalancerInfo: lb,
+	}
+	if service.PlatformVersion != nil {
+		props.PlatformVersion = *service.PlatformVersion
+	}
+	if nc := service.NetworkConfiguration; nc != nil && nc.AwsvpcConfiguration != nil {
+		props.NetworkConfiguration = &codeDeployAppSpecNetworkConfiguration{
+			AwsvpcConfiguration: codeDeployAppSpecAwsvpcConfiguration{
+				Subnets:        nc.AwsvpcConfiguration.Subnets,
+				SecurityGroups: nc.AwsvpcConfiguration.SecurityGroups,
+				AssignPublicIp: string(nc.AwsvpcConfiguration.AssignPublicIp),
+			},
+		}
+	}
+	for _, s := range service.CapacityProviderStrategy {
+		cp := codeDeployAppSpecCapacityProvider{
+			Base:   s.Base,
+			Weight: s.Weight,
+		}
+		if s.CapacityProvider != nil {
+			cp.CapacityProvider = *s.CapacityProvider
+		}
+		props.CapacityProviderStrategy = append(props.CapacityProviderStrategy, cp)
+	}
+
+	spec := codeDeployAppSpec{
+		Resources: []codeDeployAppSpecResource{
+			{
+				TargetService: codeDeployAppSpecTargetService{
+					Type:       "AWS::ECS::Service",
+					Properties: props,
+				},
+			},
+		},
+	}
+	// The hooks are run in the order of the lifecycle events regardless of the order in the AppSpec file.
+	for _, h := range []struct{ event, function string }{
+		{"BeforeInstall", in.Hooks.BeforeInstall},
+		{"AfterInstall", in.Hooks.AfterInstall},
+		{"AfterAllowTestTraffic", in.Hooks.AfterAllowTestTraffic},
+		{"BeforeAllowTraffic", in.Hooks.BeforeAllowTraffic},
+		{"AfterAllowTraffic", in.Hooks.AfterAllowTraffic},
+	} {
+		if h.function != "" {
+			spec.Hooks = append(spec.Hooks, map[string]string{h.event: h.function})
+		}
+	}
+
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// deploysService returns true when the given ECS services of a deployment group contain the given service.
+// The cluster of the service can be specified by both name and ARN.
+func deploysService(services []cdtypes.ECSService, service types.Service) bool {
+	var cluster, name string
+	if service.ClusterArn != nil {
+		cluster = *service.ClusterArn
+		if i := strings.LastIndex(cluster, "/"); i >= 0 {
+			cluster = cluster[i+1:]
+		}
+	}
+	if service.ServiceName != nil {
+		name = *service.ServiceName
+	}
+	for _, s := range services {
+		if s.ClusterName != nil && *s.ClusterName == cluster && s.ServiceName != nil && *s.ServiceName == name {
+			return true
+		}
+	}
+	return false
+}
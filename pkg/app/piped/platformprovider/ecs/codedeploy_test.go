@@ -0,0 +1,129 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cdtypes "github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestMakeCodeDeployAppSpec(t *testing.T) {
+	t.Parallel()
+
+	service := types.Service{
+		ServiceName:     aws.String("simple"),
+		PlatformVersion: aws.String("LATEST"),
+		NetworkConfiguration: &types.NetworkConfiguration{
+			AwsvpcConfiguration: &types.AwsVpcConfiguration{
+				Subnets:        []string{"subnet-1"},
+				SecurityGroups: []string{"sg-1"},
+				AssignPublicIp: types.AssignPublicIpDisabled,
+			},
+		},
+	}
+	targetGroup := &types.LoadBalancer{
+		TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:xyz"),
+		ContainerName:  aws.String("web"),
+		ContainerPort:  aws.Int32(80),
+	}
+
+	testcases := []struct {
+		name        string
+		in          config.ECSCodeDeployInput
+		targetGroup *types.LoadBalancer
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "container of the target group",
+			targetGroup: targetGroup,
+			want:        `{"version":0,"Resources":[{"TargetService":{"Type":"AWS::ECS::Service","Properties":{"TaskDefinition":"arn:aws:ecs:task-definition/simple:2","LoadBalancerInfo":{"ContainerName":"web","ContainerPort":80},"PlatformVersion":"LATEST","NetworkConfiguration":{"AwsvpcConfiguration":{"Subnets":["subnet-1"],"SecurityGroups":["sg-1"],"AssignPublicIp":"DISABLED"}}}}}]}`,
+		},
+		{
+			name: "specified container and hooks",
+			in: config.ECSCodeDeployInput{
+				ContainerName: "proxy",
+				ContainerPort: 8080,
+				Hooks: config.ECSCodeDeployHooks{
+					AfterAllowTraffic:     "check-traffic",
+					AfterAllowTestTraffic: "smoke-test",
+				},
+			},
+			targetGroup: targetGroup,
+			want:        `{"version":0,"Resources":[{"TargetService":{"Type":"AWS::ECS::Service","Properties":{"TaskDefinition":"arn:aws:ecs:task-definition/simple:2","LoadBalancerInfo":{"ContainerName":"proxy","ContainerPort":8080},"PlatformVersion":"LATEST","NetworkConfiguration":{"AwsvpcConfiguration":{"Subnets":["subnet-1"],"SecurityGroups":["sg-1"],"AssignPublicIp":"DISABLED"}}}}}],"Hooks":[{"AfterAllowTestTraffic":"smoke-test"},{"AfterAllowTraffic":"check-traffic"}]}`,
+		},
+		{
+			name:    "no container",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := MakeCodeDeployAppSpec("arn:aws:ecs:task-definition/simple:2", service, tc.in, tc.targetGroup)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.want, got)
+		})
+	}
+}
+
+func TestDeploysService(t *testing.T) {
+	t.Parallel()
+
+	services := []cdtypes.ECSService{
+		{ClusterName: aws.String("cluster"), ServiceName: aws.String("simple")},
+	}
+
+	testcases := []struct {
+		name    string
+		service types.Service
+		want    bool
+	}{
+		{
+			name:    "cluster name",
+			service: types.Service{ClusterArn: aws.String("cluster"), ServiceName: aws.String("simple")},
+			want:    true,
+		},
+		{
+			name:    "cluster ARN",
+			service: types.Service{ClusterArn: aws.String("arn:aws:ecs:ap-northeast-1:123456789012:cluster/cluster"), ServiceName: aws.String("simple")},
+			want:    true,
+		},
+		{
+			name:    "other service",
+			service: types.Service{ClusterArn: aws.String("cluster"), ServiceName: aws.String("other")},
+			want:    false,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, deploysService(services, tc.service))
+		})
+	}
+}
@@ -22,11 +22,11 @@ import (
 
 //nolint:unparam // appDir always be "testdata/", but it may be changed in the future.
 func loadManifests(appDir, taskDefFile, serviceDefFile string) (ECSManifests, error) {
-	taskDef, err := LoadTaskDefinition(appDir, taskDefFile)
+	taskDef, err := LoadTaskDefinition(appDir, taskDefFile, nil)
 	if err != nil {
 		return ECSManifests{}, err
 	}
-	serviceDef, err := LoadServiceDefinition(appDir, serviceDefFile)
+	serviceDef, err := LoadServiceDefinition(appDir, serviceDefFile, nil)
 	if err != nil {
 		return ECSManifests{}, err
 	}
@@ -92,7 +92,7 @@ func TestDiffResult_Render(t *testing.T) {
 	opt = DiffRenderOptions{UseDiffCommand: true}
 	actual = result.Render(opt)
 	expected = `# 1. ServiceDefinition
-@@ -10,7 +10,7 @@
+@@ -18,7 +18,7 @@
  DeploymentController:
    Type: EXTERNAL
  Deployments: null
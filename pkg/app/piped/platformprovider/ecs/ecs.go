@@ -18,6 +18,7 @@ import (
 	"context"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
@@ -39,11 +40,18 @@ const (
 type Client interface {
 	ECS
 	ELB
+	AutoScaling
+	CodeDeploy
+	EventBridge
+	AppMesh
 }
 
 type ECS interface {
 	ListClusters(ctx context.Context) ([]string, error)
 	ServiceExists(ctx context.Context, clusterName string, servicesName string) (bool, error)
+	// GetService returns the active service with the given name including its tags.
+	// It returns platformprovider.ErrNotFound when the service does not exist or is not active.
+	GetService(ctx context.Context, clusterName string, serviceName string) (*types.Service, error)
 	CreateService(ctx context.Context, service types.Service) (*types.Service, error)
 	UpdateService(ctx context.Context, service types.Service) (*types.Service, error)
 	PruneServiceTasks(ctx context.Context, service types.Service) error
@@ -53,8 +61,26 @@ type ECS interface {
 	RegisterTaskDefinition(ctx context.Context, taskDefinition types.TaskDefinition) (*types.TaskDefinition, error)
 	RunTask(ctx context.Context, taskDefinition types.TaskDefinition, clusterArn string, launchType string, awsVpcConfiguration *config.ECSVpcConfiguration, tags []types.Tag) error
 	GetTaskSetTasks(ctx context.Context, taskSet types.TaskSet) ([]*types.Task, error)
+	// ListStoppedTaskReasons returns why the recently stopped tasks of the given service running the given task definition stopped,
+	// including the exit codes and the last log lines of their containers.
+	ListStoppedTaskReasons(ctx context.Context, service types.Service, taskDefinition types.TaskDefinition) ([]StoppedTask, error)
 	GetServiceTaskSets(ctx context.Context, service types.Service) ([]*types.TaskSet, error)
+	// GetPrimaryTaskDefinitionArn returns the ARN of the task definition used by the PRIMARY task set of the given service.
+	// It returns platformprovider.ErrNotFound when the service or its PRIMARY task set does not exist.
+	GetPrimaryTaskDefinitionArn(ctx context.Context, service types.Service) (string, error)
+	// UpdateServiceTaskDefinition starts a rolling deployment of the given service using the ECS deployment controller
+	// to replace its tasks with the ones of the given task definition, and returns the updated service and the ID of the started deployment.
+	// The Service Connect configuration of the service is replaced with the given one unless it is nil.
+	UpdateServiceTaskDefinition(ctx context.Context, service types.Service, taskDefinitionArn string, serviceConnect *types.ServiceConnectConfiguration) (*types.Service, string, error)
+	// ValidateServiceConnectNamespace checks that the Cloud Map namespace used by the given Service Connect configuration exists.
+	// The default namespace of the cluster of the given service is checked when the configuration does not specify any.
+	ValidateServiceConnectNamespace(ctx context.Context, service types.Service, serviceConnect types.ServiceConnectConfiguration) error
+	// GetRollingDeployment returns the rolling deployment of the given service with the given ID.
+	// It returns platformprovider.ErrNotFound when the deployment does not exist anymore.
+	GetRollingDeployment(ctx context.Context, service types.Service, deploymentID string) (*RollingDeployment, error)
 	CreateTaskSet(ctx context.Context, service types.Service, taskDefinition types.TaskDefinition, targetGroup *types.LoadBalancer, scale int) (*types.TaskSet, error)
+	// UpdateTaskSetScale updates the scale of the given task set and waits until it becomes stable.
+	UpdateTaskSetScale(ctx context.Context, taskSet types.TaskSet, scale int) (*types.TaskSet, error)
 	DeleteTaskSet(ctx context.Context, taskSet types.TaskSet) error
 	UpdateServicePrimaryTaskSet(ctx context.Context, service types.Service, taskSet types.TaskSet) (*types.TaskSet, error)
 	TagResource(ctx context.Context, resourceArn string, tags []types.Tag) error
@@ -64,10 +90,73 @@ type ECS interface {
 
 type ELB interface {
 	GetListenerArns(ctx context.Context, targetGroup types.LoadBalancer) ([]string, error)
+	// GetTargetGroupWeight returns the largest weight given to the target group
+	// by the forward actions of all rules of the given listeners.
+	// Zero means that no traffic is forwarded to the target group.
+	GetTargetGroupWeight(ctx context.Context, listenerArns []string, targetGroupArn string) (int32, error)
 	// ModifyListeners modifies the actions of type ActionTypeEnumForward to perform routing traffic
 	// to the given target groups. Other actions won't be modified.
+	// Only the rules in the given scope are modified, and nothing is modified
+	// when any of them is not owned by the owner of the scope.
+	// The target group stickiness is enabled with the given duration when it is greater than 0.
 	// Note: This method will return any successfully modified rule ARNs even when returning an error.
-	ModifyListeners(ctx context.Context, listenerArns []string, routingTrafficCfg RoutingTrafficConfig) (modifiedRuleArns []string, err error)
+	ModifyListeners(ctx context.Context, listenerArns []string, routingTrafficCfg RoutingTrafficConfig, scope ListenerRuleScope, stickinessDuration time.Duration) (modifiedRuleArns []string, err error)
+	// CreateABTestingRules creates the listener rules forwarding the requests matching the given A/B testing rules
+	// to the canary target group. They are created for each rule forwarding to the given target groups
+	// and matching any of the given selectors, and prioritized by using the smallest free priorities.
+	// Note: This method will return any successfully created rule ARNs even when returning an error.
+	CreateABTestingRules(ctx context.Context, listenerArns []string, routingTrafficCfg RoutingTrafficConfig, ruleSelectors []config.ECSListenerRuleSelector, canaryTargetGroupArn string, rules []config.ABTestingRule) (createdRuleArns []string, err error)
+	// DeleteABTestingRules deletes all listener rules created by CreateABTestingRules.
+	// Note: This method will return any successfully deleted rule ARNs even when returning an error.
+	DeleteABTestingRules(ctx context.Context, listenerArns []string) (deletedRuleArns []string, err error)
+	// CreateMaintenanceRules creates the listener rules answering the requests with the given fixed response.
+	// They are created right before each rule in the given scope forwarding to any of the given target groups,
+	// including the default one, with the same conditions as it.
+	// Note: This method will return any successfully created rule ARNs even when returning an error.
+	CreateMaintenanceRules(ctx context.Context, listenerArns []string, targetGroupArns []string, scope ListenerRuleScope, res MaintenanceResponse) (createdRuleArns []string, err error)
+	// DeleteMaintenanceRules deletes all listener rules created by CreateMaintenanceRules.
+	// Note: This method will return any successfully deleted rule ARNs even when returning an error.
+	DeleteMaintenanceRules(ctx context.Context, listenerArns []string) (deletedRuleArns []string, err error)
+	// GetTargetGroupHealth returns the health of the targets registered to the given target group.
+	GetTargetGroupHealth(ctx context.Context, targetGroupArn string) (*TargetGroupHealth, error)
+}
+
+type AutoScaling interface {
+	// GetServiceAutoScaling returns the scalable targets and scaling policies of the given service.
+	GetServiceAutoScaling(ctx context.Context, service types.Service) (*ServiceAutoScaling, error)
+	// PutServiceAutoScaling makes the scalable targets and scaling policies of the given service
+	// the same as the given ones. The ones which do not exist in the given settings are removed.
+	PutServiceAutoScaling(ctx context.Context, service types.Service, settings ServiceAutoScaling) error
+}
+
+type CodeDeploy interface {
+	// FindCodeDeployDeploymentGroup returns the name of the deployment group
+	// of the given CodeDeploy application which deploys the given service.
+	// It returns platformprovider.ErrNotFound when no deployment group deploys the service.
+	FindCodeDeployDeploymentGroup(ctx context.Context, applicationName string, service types.Service) (string, error)
+	// CreateCodeDeployDeployment creates a CodeDeploy deployment with the given AppSpec content and returns its ID.
+	// The deployment configuration of the deployment group is used when deploymentConfigName is empty.
+	CreateCodeDeployDeployment(ctx context.Context, applicationName, deploymentGroupName, deploymentConfigName, appSpec, description string) (string, error)
+	// GetCodeDeployDeployment returns the status and the lifecycle events of the given deployment.
+	GetCodeDeployDeployment(ctx context.Context, deploymentID string) (*CodeDeployDeployment, error)
+	// StopCodeDeployDeployment stops the given deployment.
+	// The service is rolled back to the previous task set by CodeDeploy when rollback is true.
+	StopCodeDeployDeployment(ctx context.Context, deploymentID string, rollback bool) error
+}
+
+type EventBridge interface {
+	// UpdateScheduledTaskDefinition makes the ECS targets of the given EventBridge rule run the given task definition.
+	// Only the target of the given ID is updated unless it is empty.
+	// It returns the ARNs of the task definitions the updated targets ran before, keyed by the target ID.
+	UpdateScheduledTaskDefinition(ctx context.Context, eventBusName, ruleName, targetID, taskDefinitionArn string) (map[string]string, error)
+}
+
+type AppMesh interface {
+	// GetMeshRouteWeights returns the weights of the virtual nodes the route of the given App Mesh forwards to.
+	GetMeshRouteWeights(ctx context.Context, mesh config.ECSAppMesh) (map[string]int, error)
+	// ModifyMeshRouteWeights modifies the weighted targets of the route of the given App Mesh
+	// to forward the traffic to the virtual nodes of PRIMARY/CANARY variants with the given weights.
+	ModifyMeshRouteWeights(ctx context.Context, mesh config.ECSAppMesh, primary, canary int) error
 }
 
 // Registry holds a pool of aws client wrappers.
@@ -76,15 +165,24 @@ type Registry interface {
 }
 
 // LoadServiceDefinition returns ServiceDefinition object from a given service definition file.
-func LoadServiceDefinition(appDir, serviceDefinitionFilename string) (types.Service, error) {
+// The file is rendered as a template with the given data unless it is nil.
+func LoadServiceDefinition(appDir, serviceDefinitionFilename string, td *TemplateData) (types.Service, error) {
+	path := filepath.Join(appDir, serviceDefinitionFilename)
+	return loadServiceDefinition(path, td)
+}
+
+// LoadServiceConnectConfiguration returns the Service Connect configuration in a given service definition file,
+// or nil when it is not specified.
+func LoadServiceConnectConfiguration(appDir, serviceDefinitionFilename string, td *TemplateData) (*types.ServiceConnectConfiguration, error) {
 	path := filepath.Join(appDir, serviceDefinitionFilename)
-	return loadServiceDefinition(path)
+	return loadServiceConnectConfiguration(path, td)
 }
 
 // LoadTaskDefinition returns TaskDefinition object from a given task definition file.
-func LoadTaskDefinition(appDir, taskDefinition string) (types.TaskDefinition, error) {
+// The file is rendered as a template with the given data unless it is nil.
+func LoadTaskDefinition(appDir, taskDefinition string, td *TemplateData) (types.TaskDefinition, error) {
 	path := filepath.Join(appDir, taskDefinition)
-	return loadTaskDefinition(path)
+	return loadTaskDefinition(path, td)
 }
 
 // LoadTargetGroups returns primary & canary target groups according to the defined in pipe definition file.
@@ -107,7 +205,7 @@ func (r *registry) Client(name string, cfg *config.PlatformProviderECSConfig, lo
 	}
 
 	c, err, _ := r.newGroup.Do(name, func() (interface{}, error) {
-		return newClient(cfg.Region, cfg.Profile, cfg.CredentialsFile, cfg.RoleARN, cfg.TokenFile, logger)
+		return newClient(name, cfg.Region, cfg.Profile, cfg.CredentialsFile, cfg.RoleARN, cfg.TokenFile, cfg.AwsAPIClient, logger)
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,106 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	ebtypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider"
+)
+
+func (c *client) UpdateScheduledTaskDefinition(ctx context.Context, eventBusName, ruleName, targetID, taskDefinitionArn string) (map[string]string, error) {
+	var (
+		targets   []ebtypes.Target
+		nextToken *string
+	)
+	for {
+		out, err := c.ebClient.ListTargetsByRule(ctx, &eventbridge.ListTargetsByRuleInput{
+			EventBusName: optionalString(eventBusName),
+			Rule:         aws.String(ruleName),
+			NextToken:    nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list targets of EventBridge rule %s: %w", ruleName, err)
+		}
+		targets = append(targets, out.Targets...)
+		if aws.ToString(out.NextToken) == "" {
+			break
+		}
+		nextToken = out.NextToken
+	}
+
+	updated, prev, err := replaceScheduledTaskDefinition(targets, targetID, taskDefinitionArn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update targets of EventBridge rule %s: %w", ruleName, err)
+	}
+
+	out, err := c.ebClient.PutTargets(ctx, &eventbridge.PutTargetsInput{
+		EventBusName: optionalString(eventBusName),
+		Rule:         aws.String(ruleName),
+		Targets:      updated,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to put targets of EventBridge rule %s: %w", ruleName, err)
+	}
+	if out.FailedEntryCount > 0 && len(out.FailedEntries) > 0 {
+		e := out.FailedEntries[0]
+		return nil, fmt.Errorf("failed to put target %s of EventBridge rule %s: %s", aws.ToString(e.TargetId), ruleName, aws.ToString(e.ErrorMessage))
+	}
+	return prev, nil
+}
+
+// replaceScheduledTaskDefinition makes the ECS targets, or the one of the given ID, run the given task definition.
+// It returns the updated targets and the task definitions they ran before, keyed by the target ID.
+func replaceScheduledTaskDefinition(targets []ebtypes.Target, targetID, taskDefinitionArn string) ([]ebtypes.Target, map[string]string, error) {
+	var (
+		updated = make([]ebtypes.Target, 0, len(targets))
+		prev    = make(map[string]string, len(targets))
+	)
+	for _, t := range targets {
+		id := aws.ToString(t.Id)
+		if targetID != "" && id != targetID {
+			continue
+		}
+		if t.EcsParameters == nil {
+			continue
+		}
+		prev[id] = aws.ToString(t.EcsParameters.TaskDefinitionArn)
+
+		params := *t.EcsParameters
+		params.TaskDefinitionArn = aws.String(taskDefinitionArn)
+		t.EcsParameters = &params
+		updated = append(updated, t)
+	}
+	if len(updated) == 0 {
+		if targetID != "" {
+			return nil, nil, fmt.Errorf("ECS target %s was not found: %w", targetID, platformprovider.ErrNotFound)
+		}
+		return nil, nil, fmt.Errorf("no ECS target was found: %w", platformprovider.ErrNotFound)
+	}
+	return updated, prev, nil
+}
+
+// optionalString returns nil for the empty string so that the default value is used by AWS.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return aws.String(s)
+}
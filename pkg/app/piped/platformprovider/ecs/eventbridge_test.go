@@ -0,0 +1,116 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ebtypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider"
+)
+
+func TestReplaceScheduledTaskDefinition(t *testing.T) {
+	t.Parallel()
+
+	targets := []ebtypes.Target{
+		{
+			Id:      aws.String("batch"),
+			Arn:     aws.String("arn:aws:ecs:us-east-1:123:cluster/default"),
+			RoleArn: aws.String("arn:aws:iam::123:role/events"),
+			EcsParameters: &ebtypes.EcsParameters{
+				TaskDefinitionArn: aws.String("arn:aws:ecs:us-east-1:123:task-definition/batch:1"),
+				TaskCount:         aws.Int32(1),
+				LaunchType:        ebtypes.LaunchTypeFargate,
+			},
+		},
+		{
+			Id:  aws.String("notify"),
+			Arn: aws.String("arn:aws:sns:us-east-1:123:topic"),
+		},
+		{
+			Id:  aws.String("report"),
+			Arn: aws.String("arn:aws:ecs:us-east-1:123:cluster/default"),
+			EcsParameters: &ebtypes.EcsParameters{
+				TaskDefinitionArn: aws.String("arn:aws:ecs:us-east-1:123:task-definition/report:3"),
+			},
+		},
+	}
+	const newArn = "arn:aws:ecs:us-east-1:123:task-definition/batch:2"
+
+	testcases := []struct {
+		name     string
+		targetID string
+		expected map[string]string
+		wantErr  bool
+	}{
+		{
+			name: "all ECS targets",
+			expected: map[string]string{
+				"batch":  "arn:aws:ecs:us-east-1:123:task-definition/batch:1",
+				"report": "arn:aws:ecs:us-east-1:123:task-definition/report:3",
+			},
+		},
+		{
+			name:     "specified target",
+			targetID: "report",
+			expected: map[string]string{
+				"report": "arn:aws:ecs:us-east-1:123:task-definition/report:3",
+			},
+		},
+		{
+			name:     "not ECS target",
+			targetID: "notify",
+			wantErr:  true,
+		},
+		{
+			name:     "missing target",
+			targetID: "unknown",
+			wantErr:  true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			updated, prev, err := replaceScheduledTaskDefinition(targets, tc.targetID, newArn)
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, platformprovider.ErrNotFound))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, prev)
+
+			require.Len(t, updated, len(tc.expected))
+			for _, u := range updated {
+				assert.Contains(t, tc.expected, aws.ToString(u.Id))
+				assert.Equal(t, newArn, aws.ToString(u.EcsParameters.TaskDefinitionArn))
+				// The other fields are kept.
+				if aws.ToString(u.Id) == "batch" {
+					assert.Equal(t, ebtypes.LaunchTypeFargate, u.EcsParameters.LaunchType)
+					assert.Equal(t, "arn:aws:iam::123:role/events", aws.ToString(u.RoleArn))
+				}
+			}
+		})
+	}
+
+	// The given targets are not modified.
+	assert.Equal(t, "arn:aws:ecs:us-east-1:123:task-definition/batch:1", aws.ToString(targets[0].EcsParameters.TaskDefinitionArn))
+}
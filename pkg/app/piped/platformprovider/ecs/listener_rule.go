@@ -0,0 +1,133 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// forwardsToTargets reports whether the given rule has a forward action to the given target groups.
+func forwardsToTargets(rule types.Rule, cfg RoutingTrafficConfig) bool {
+	for _, action := range rule.Actions {
+		if action.Type == types.ActionTypeEnumForward && action.ForwardConfig != nil && cfg.hasSameTargets(action.ForwardConfig.TargetGroups) {
+			return true
+		}
+	}
+	return false
+}
+
+// ListenerRuleScope limits the listener rules modified to route the traffic.
+type ListenerRuleScope struct {
+	// Only the rules matching any of them are modified.
+	// All rules are in the scope when empty.
+	Selectors []config.ECSListenerRuleSelector
+	// The ID of the application which must own all rules in the scope.
+	// Empty means the ownership is not checked.
+	Owner string
+}
+
+// needsTags reports whether the tags of the rules are required to determine the rules in the scope.
+func (s ListenerRuleScope) needsTags() bool {
+	return s.Owner != "" || selectorsNeedTags(s.Selectors)
+}
+
+func selectorsNeedTags(selectors []config.ECSListenerRuleSelector) bool {
+	for _, s := range selectors {
+		if len(s.Tags) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// isOwnedBy reports whether the resource having the given tags belongs to the given application.
+func isOwnedBy(tags map[string]string, appID string) bool {
+	return tags[LabelApplication] == appID
+}
+
+// ScopeListeners returns the given listeners narrowed down to the scoped ones.
+// All listeners are returned when no scoped listener is given,
+// and an error is returned when any of the scoped listeners is not in the given listeners.
+func ScopeListeners(listenerArns, scoped []string) ([]string, error) {
+	if len(scoped) == 0 {
+		return listenerArns, nil
+	}
+	for _, arn := range scoped {
+		if !slices.Contains(listenerArns, arn) {
+			return nil, fmt.Errorf("listener %s is not a listener of the load balancer", arn)
+		}
+	}
+	return scoped, nil
+}
+
+// isSelectedRule reports whether the given rule having the given tags matches any of the given selectors.
+// All rules are selected when no selector is given.
+func isSelectedRule(rule types.Rule, tags map[string]string, selectors []config.ECSListenerRuleSelector) bool {
+	if len(selectors) == 0 {
+		return true
+	}
+	for _, s := range selectors {
+		if matchesRuleSelector(rule, tags, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesRuleSelector(rule types.Rule, tags map[string]string, s config.ECSListenerRuleSelector) bool {
+	if s.RuleArn != "" && s.RuleArn != aws.ToString(rule.RuleArn) {
+		return false
+	}
+	if s.Priority != "" && s.Priority != aws.ToString(rule.Priority) {
+		return false
+	}
+	if s.HostHeader != "" && !slices.Contains(ruleConditionValues(rule.Conditions, "host-header"), s.HostHeader) {
+		return false
+	}
+	if s.PathPattern != "" && !slices.Contains(ruleConditionValues(rule.Conditions, "path-pattern"), s.PathPattern) {
+		return false
+	}
+	for k, v := range s.Tags {
+		if value, ok := tags[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// ruleConditionValues returns the values of the conditions of the given field.
+// Both the condition config and the legacy values are taken into account.
+func ruleConditionValues(conditions []types.RuleCondition, field string) []string {
+	var values []string
+	for _, c := range conditions {
+		if aws.ToString(c.Field) != field {
+			continue
+		}
+		values = append(values, c.Values...)
+		switch {
+		case c.HostHeaderConfig != nil:
+			values = append(values, c.HostHeaderConfig.Values...)
+		case c.PathPatternConfig != nil:
+			values = append(values, c.PathPatternConfig.Values...)
+		}
+	}
+	return values
+}
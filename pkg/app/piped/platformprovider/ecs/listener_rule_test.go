@@ -0,0 +1,187 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestForwardsToTargets(t *testing.T) {
+	t.Parallel()
+
+	cfg := RoutingTrafficConfig{
+		{TargetGroupArn: "primary", Weight: 100},
+		{TargetGroupArn: "canary", Weight: 0},
+	}
+	forward := func(arns ...string) types.Action {
+		tgs := make([]types.TargetGroupTuple, 0, len(arns))
+		for _, arn := range arns {
+			tgs = append(tgs, types.TargetGroupTuple{TargetGroupArn: aws.String(arn)})
+		}
+		return types.Action{
+			Type:          types.ActionTypeEnumForward,
+			ForwardConfig: &types.ForwardActionConfig{TargetGroups: tgs},
+		}
+	}
+
+	assert.True(t, forwardsToTargets(types.Rule{Actions: []types.Action{forward("primary", "canary")}}, cfg))
+	assert.False(t, forwardsToTargets(types.Rule{Actions: []types.Action{forward("other", "canary")}}, cfg))
+	assert.False(t, forwardsToTargets(types.Rule{Actions: []types.Action{forward("primary")}}, cfg))
+	assert.False(t, forwardsToTargets(types.Rule{Actions: []types.Action{{Type: types.ActionTypeEnumFixedResponse}}}, cfg))
+}
+
+func TestIsSelectedRule(t *testing.T) {
+	t.Parallel()
+
+	defaultRule := types.Rule{
+		RuleArn:   aws.String("rule-default"),
+		Priority:  aws.String("default"),
+		IsDefault: aws.Bool(true),
+	}
+	hostRule := types.Rule{
+		RuleArn:  aws.String("rule-1"),
+		Priority: aws.String("10"),
+		Conditions: []types.RuleCondition{
+			{
+				Field:            aws.String("host-header"),
+				HostHeaderConfig: &types.HostHeaderConditionConfig{Values: []string{"app.example.com", "www.example.com"}},
+			},
+			{
+				Field:  aws.String("path-pattern"),
+				Values: []string{"/api/*"},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name      string
+		rule      types.Rule
+		tags      map[string]string
+		selectors []config.ECSListenerRuleSelector
+		expected  bool
+	}{
+		{
+			name:     "no selector",
+			rule:     hostRule,
+			expected: true,
+		},
+		{
+			name:      "by rule arn",
+			rule:      hostRule,
+			selectors: []config.ECSListenerRuleSelector{{RuleArn: "rule-1"}},
+			expected:  true,
+		},
+		{
+			name:      "by default priority",
+			rule:      defaultRule,
+			selectors: []config.ECSListenerRuleSelector{{Priority: "default"}},
+			expected:  true,
+		},
+		{
+			name:      "by host header",
+			rule:      hostRule,
+			selectors: []config.ECSListenerRuleSelector{{HostHeader: "www.example.com"}},
+			expected:  true,
+		},
+		{
+			name:      "by legacy path pattern values",
+			rule:      hostRule,
+			selectors: []config.ECSListenerRuleSelector{{PathPattern: "/api/*"}},
+			expected:  true,
+		},
+		{
+			name:      "all fields must match",
+			rule:      hostRule,
+			selectors: []config.ECSListenerRuleSelector{{Priority: "10", HostHeader: "other.example.com"}},
+			expected:  false,
+		},
+		{
+			name: "any selector matches",
+			rule: hostRule,
+			selectors: []config.ECSListenerRuleSelector{
+				{Priority: "default"},
+				{HostHeader: "app.example.com"},
+			},
+			expected: true,
+		},
+		{
+			name:      "default rule does not have conditions",
+			rule:      defaultRule,
+			selectors: []config.ECSListenerRuleSelector{{HostHeader: "app.example.com"}},
+			expected:  false,
+		},
+		{
+			name:      "by tags",
+			rule:      hostRule,
+			tags:      map[string]string{"team": "payment", "env": "prod"},
+			selectors: []config.ECSListenerRuleSelector{{Tags: map[string]string{"team": "payment"}}},
+			expected:  true,
+		},
+		{
+			name:      "tag value differs",
+			rule:      hostRule,
+			tags:      map[string]string{"team": "search"},
+			selectors: []config.ECSListenerRuleSelector{{Tags: map[string]string{"team": "payment"}}},
+			expected:  false,
+		},
+		{
+			name:      "rule without tags",
+			rule:      hostRule,
+			selectors: []config.ECSListenerRuleSelector{{Tags: map[string]string{"team": "payment"}}},
+			expected:  false,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, isSelectedRule(tc.rule, tc.tags, tc.selectors))
+		})
+	}
+}
+
+func TestScopeListeners(t *testing.T) {
+	t.Parallel()
+
+	listeners := []string{"listener-1", "listener-2"}
+
+	got, err := ScopeListeners(listeners, nil)
+	require.NoError(t, err)
+	assert.Equal(t, listeners, got)
+
+	got, err = ScopeListeners(listeners, []string{"listener-2"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"listener-2"}, got)
+
+	_, err = ScopeListeners(listeners, []string{"listener-3"})
+	assert.Error(t, err)
+}
+
+func TestListenerRuleScopeNeedsTags(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, ListenerRuleScope{Selectors: []config.ECSListenerRuleSelector{{Priority: "default"}}}.needsTags())
+	assert.True(t, ListenerRuleScope{Selectors: []config.ECSListenerRuleSelector{{Tags: map[string]string{"team": "payment"}}}}.needsTags())
+	assert.True(t, ListenerRuleScope{Owner: "app-id"}.needsTags())
+	assert.True(t, isOwnedBy(map[string]string{LabelApplication: "app-id"}, "app-id"))
+	assert.False(t, isOwnedBy(nil, "app-id"))
+}
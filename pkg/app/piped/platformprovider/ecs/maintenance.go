@@ -0,0 +1,161 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+// LabelMaintenance is the tag key added to the ELB listener rules created for the maintenance mode.
+const LabelMaintenance string = "pipecd-dev-maintenance"
+
+// MaintenanceResponse represents the fixed response returned by the ELB listeners during the maintenance mode.
+type MaintenanceResponse struct {
+	StatusCode  int
+	ContentType string
+	MessageBody string
+}
+
+type maintenanceRule struct {
+	priority   int32
+	conditions []types.RuleCondition
+}
+
+// makeMaintenanceRules returns the rules which should be created to take precedence over
+// the rules in the given scope forwarding to the given target groups.
+// Each created rule has the same conditions as the original one and the priority right before it,
+// so that it never answers the requests routed to the rules of other applications.
+// The one replacing the default action matches all requests and has the priority right before the default rule.
+// The given tags are the ones of each rule, which are required when the scope needs them.
+func makeMaintenanceRules(rules []types.Rule, tags map[string]map[string]string, targetGroupArns []string, scope ListenerRuleScope) ([]maintenanceRule, error) {
+	used, err := usedListenerRulePriorities(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		out      = make([]maintenanceRule, 0)
+		notOwned []string
+	)
+	for _, r := range rules {
+		ruleTags := tags[aws.ToString(r.RuleArn)]
+		if !forwardsToAny(r.Actions, targetGroupArns) || !isSelectedRule(r, ruleTags, scope.Selectors) {
+			continue
+		}
+		if scope.Owner != "" && !isOwnedBy(ruleTags, scope.Owner) {
+			notOwned = append(notOwned, aws.ToString(r.RuleArn))
+			continue
+		}
+
+		rp, err := listenerRulePriority(r)
+		if err != nil {
+			return nil, err
+		}
+		priorities, err := prioritiesBefore(used, rp, 1)
+		if err != nil {
+			return nil, fmt.Errorf("no free priority right before rule %s: %w", aws.ToString(r.RuleArn), err)
+		}
+
+		if aws.ToBool(r.IsDefault) {
+			out = append(out, maintenanceRule{
+				priority: priorities[0],
+				conditions: []types.RuleCondition{
+					{
+						Field:             aws.String("path-pattern"),
+						PathPatternConfig: &types.PathPatternConditionConfig{Values: []string{"/*"}},
+					},
+				},
+			})
+			continue
+		}
+
+		conditions := make([]types.RuleCondition, 0, len(r.Conditions))
+		for _, c := range r.Conditions {
+			conditions = append(conditions, copyRuleCondition(c))
+		}
+		out = append(out, maintenanceRule{
+			priority:   priorities[0],
+			conditions: conditions,
+		})
+	}
+	if len(notOwned) > 0 {
+		return nil, fmt.Errorf("refused to add maintenance rules in front of the listener rules not owned by application %s: %s", scope.Owner, strings.Join(notOwned, ", "))
+	}
+	if len(scope.Selectors) > 0 && len(out) == 0 {
+		return nil, fmt.Errorf("no listener rule forwarding to the target groups matched the given selectors")
+	}
+	return out, nil
+}
+
+func makeMaintenanceAction(res MaintenanceResponse) types.Action {
+	action := types.Action{
+		Type: types.ActionTypeEnumFixedResponse,
+		FixedResponseConfig: &types.FixedResponseActionConfig{
+			StatusCode:  aws.String(strconv.Itoa(res.StatusCode)),
+			ContentType: aws.String(res.ContentType),
+		},
+	}
+	if res.MessageBody != "" {
+		action.FixedResponseConfig.MessageBody = aws.String(res.MessageBody)
+	}
+	return action
+}
+
+func forwardsToAny(actions []types.Action, targetGroupArns []string) bool {
+	arns := make(map[string]struct{}, len(targetGroupArns))
+	for _, arn := range targetGroupArns {
+		arns[arn] = struct{}{}
+	}
+
+	for _, action := range actions {
+		if action.Type != types.ActionTypeEnumForward {
+			continue
+		}
+		if _, ok := arns[aws.ToString(action.TargetGroupArn)]; ok {
+			return true
+		}
+		if action.ForwardConfig == nil {
+			continue
+		}
+		for _, tg := range action.ForwardConfig.TargetGroups {
+			if _, ok := arns[aws.ToString(tg.TargetGroupArn)]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func parseListenerRulePriority(r types.Rule) (int32, error) {
+	p, err := strconv.ParseInt(aws.ToString(r.Priority), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid priority %q of rule %s: %w", aws.ToString(r.Priority), aws.ToString(r.RuleArn), err)
+	}
+	return int32(p), nil
+}
+
+func hasTag(tags []types.Tag, key string) bool {
+	for _, t := range tags {
+		if aws.ToString(t.Key) == key {
+			return true
+		}
+	}
+	return false
+}
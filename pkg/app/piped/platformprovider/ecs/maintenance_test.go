@@ -0,0 +1,107 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestMakeMaintenanceRules(t *testing.T) {
+	t.Parallel()
+
+	forward := func(arns ...string) []types.Action {
+		tgs := make([]types.TargetGroupTuple, 0, len(arns))
+		for _, arn := range arns {
+			tgs = append(tgs, types.TargetGroupTuple{TargetGroupArn: aws.String(arn)})
+		}
+		return []types.Action{{Type: types.ActionTypeEnumForward, ForwardConfig: &types.ForwardActionConfig{TargetGroups: tgs}}}
+	}
+	hostCondition := types.RuleCondition{
+		Field:            aws.String("host-header"),
+		Values:           []string{"example.com"},
+		HostHeaderConfig: &types.HostHeaderConditionConfig{Values: []string{"example.com"}},
+	}
+
+	rules := []types.Rule{
+		{RuleArn: aws.String("other"), Priority: aws.String("3"), Actions: forward("other-tg")},
+		{RuleArn: aws.String("app"), Priority: aws.String("5"), Actions: forward("primary-tg", "canary-tg"), Conditions: []types.RuleCondition{hostCondition}},
+		{RuleArn: aws.String("app-legacy"), Priority: aws.String("8"), Actions: []types.Action{{Type: types.ActionTypeEnumForward, TargetGroupArn: aws.String("primary-tg")}}},
+		{RuleArn: aws.String("default"), IsDefault: aws.Bool(true), Actions: forward("primary-tg")},
+	}
+	targetGroupArns := []string{"primary-tg", "canary-tg"}
+
+	got, err := makeMaintenanceRules(rules, nil, targetGroupArns, ListenerRuleScope{})
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+
+	// The priority right before the original rule is used.
+	assert.Equal(t, int32(4), got[0].priority)
+	assert.Equal(t, []types.RuleCondition{{Field: aws.String("host-header"), HostHeaderConfig: &types.HostHeaderConditionConfig{Values: []string{"example.com"}}}}, got[0].conditions)
+
+	assert.Equal(t, int32(7), got[1].priority)
+	assert.Empty(t, got[1].conditions)
+
+	// The default action is replaced by the rule matching all requests with the lowest precedence.
+	assert.Equal(t, int32(maxListenerRulePriority), got[2].priority)
+	require.Len(t, got[2].conditions, 1)
+	assert.Equal(t, []string{"/*"}, got[2].conditions[0].PathPatternConfig.Values)
+
+	// Only the selected rules are taken into account.
+	got, err = makeMaintenanceRules(rules, nil, targetGroupArns, ListenerRuleScope{
+		Selectors: []config.ECSListenerRuleSelector{{HostHeader: "example.com"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, int32(4), got[0].priority)
+
+	// The rules not owned by the application are refused.
+	tags := map[string]map[string]string{
+		"app":        {LabelApplication: "app-id"},
+		"app-legacy": {LabelApplication: "another-app-id"},
+		"default":    {LabelApplication: "app-id"},
+	}
+	_, err = makeMaintenanceRules(rules, tags, targetGroupArns, ListenerRuleScope{Owner: "app-id"})
+	assert.Error(t, err)
+
+	// The priority right before the rule is used by another one.
+	_, err = makeMaintenanceRules([]types.Rule{
+		{RuleArn: aws.String("other"), Priority: aws.String("4"), Actions: forward("other-tg")},
+		{RuleArn: aws.String("app"), Priority: aws.String("5"), Actions: forward("primary-tg")},
+	}, nil, []string{"primary-tg"}, ListenerRuleScope{})
+	assert.Error(t, err)
+
+	// There is no free priority in front of the rule having the highest precedence.
+	_, err = makeMaintenanceRules([]types.Rule{
+		{RuleArn: aws.String("app"), Priority: aws.String("1"), Actions: forward("primary-tg")},
+	}, nil, []string{"primary-tg"}, ListenerRuleScope{})
+	assert.Error(t, err)
+}
+
+func TestMakeMaintenanceAction(t *testing.T) {
+	t.Parallel()
+
+	action := makeMaintenanceAction(MaintenanceResponse{StatusCode: 503, ContentType: "text/plain"})
+	assert.Equal(t, types.ActionTypeEnumFixedResponse, action.Type)
+	assert.Equal(t, "503", aws.ToString(action.FixedResponseConfig.StatusCode))
+	assert.Equal(t, "text/plain", aws.ToString(action.FixedResponseConfig.ContentType))
+	assert.Nil(t, action.FixedResponseConfig.MessageBody)
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider"
+)
+
+// RollingDeployment represents a rolling deployment of an ECS service using the ECS deployment controller.
+type RollingDeployment struct {
+	ID                string
+	TaskDefinitionArn string
+	RolloutState      types.DeploymentRolloutState
+	// The reason why the deployment is in the current rollout state, e.g. why the circuit breaker was triggered.
+	RolloutStateReason string
+	DesiredCount       int32
+	RunningCount       int32
+	FailedTasks        int32
+	// Whether ECS rolls the service back to the last completed deployment by itself when this deployment fails.
+	CircuitBreakerRollback bool
+}
+
+// IsCompleted returns true when the deployment reached a final rollout state.
+func (d RollingDeployment) IsCompleted() bool {
+	return d.RolloutState == types.DeploymentRolloutStateCompleted || d.RolloutState == types.DeploymentRolloutStateFailed
+}
+
+// findRollingDeployment returns the deployment of the given service with the given ID.
+func findRollingDeployment(service types.Service, id string) (*RollingDeployment, error) {
+	for _, d := range service.Deployments {
+		if aws.ToString(d.Id) != id {
+			continue
+		}
+		rd := &RollingDeployment{
+			ID:                 id,
+			TaskDefinitionArn:  aws.ToString(d.TaskDefinition),
+			RolloutState:       d.RolloutState,
+			RolloutStateReason: aws.ToString(d.RolloutStateReason),
+			DesiredCount:       d.DesiredCount,
+			RunningCount:       d.RunningCount,
+			FailedTasks:        d.FailedTasks,
+		}
+		if c := service.DeploymentConfiguration; c != nil && c.DeploymentCircuitBreaker != nil {
+			rd.CircuitBreakerRollback = c.DeploymentCircuitBreaker.Enable && c.DeploymentCircuitBreaker.Rollback
+		}
+		return rd, nil
+	}
+	return nil, platformprovider.ErrNotFound
+}
+
+// primaryDeploymentID returns the ID of the PRIMARY deployment of the given service,
+// which is the one started by the last update of the service.
+func primaryDeploymentID(service types.Service) (string, error) {
+	for _, d := range service.Deployments {
+		if aws.ToString(d.Status) == "PRIMARY" && d.Id != nil {
+			return *d.Id, nil
+		}
+	}
+	return "", platformprovider.ErrNotFound
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider"
+)
+
+func TestFindRollingDeployment(t *testing.T) {
+	t.Parallel()
+
+	service := types.Service{
+		Deployments: []types.Deployment{
+			{
+				Id:                 aws.String("ecs-svc/2"),
+				Status:             aws.String("PRIMARY"),
+				TaskDefinition:     aws.String("arn:aws:ecs:task-definition/simple:2"),
+				RolloutState:       types.DeploymentRolloutStateFailed,
+				RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start."),
+				DesiredCount:       2,
+				FailedTasks:        3,
+			},
+			{
+				Id:             aws.String("ecs-svc/1"),
+				Status:         aws.String("ACTIVE"),
+				TaskDefinition: aws.String("arn:aws:ecs:task-definition/simple:1"),
+				RolloutState:   types.DeploymentRolloutStateCompleted,
+				DesiredCount:   2,
+				RunningCount:   2,
+			},
+		},
+		DeploymentConfiguration: &types.DeploymentConfiguration{
+			DeploymentCircuitBreaker: &types.DeploymentCircuitBreaker{
+				Enable:   true,
+				Rollback: true,
+			},
+		},
+	}
+
+	id, err := primaryDeploymentID(service)
+	require.NoError(t, err)
+	assert.Equal(t, "ecs-svc/2", id)
+
+	got, err := findRollingDeployment(service, id)
+	require.NoError(t, err)
+	assert.Equal(t, &RollingDeployment{
+		ID:                     "ecs-svc/2",
+		TaskDefinitionArn:      "arn:aws:ecs:task-definition/simple:2",
+		RolloutState:           types.DeploymentRolloutStateFailed,
+		RolloutStateReason:     "ECS deployment circuit breaker: tasks failed to start.",
+		DesiredCount:           2,
+		FailedTasks:            3,
+		CircuitBreakerRollback: true,
+	}, got)
+	assert.True(t, got.IsCompleted())
+
+	got, err = findRollingDeployment(service, "ecs-svc/1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), got.RunningCount)
+
+	_, err = findRollingDeployment(service, "ecs-svc/0")
+	assert.ErrorIs(t, err, platformprovider.ErrNotFound)
+
+	_, err = primaryDeploymentID(types.Service{})
+	assert.ErrorIs(t, err, platformprovider.ErrNotFound)
+}
@@ -15,6 +15,7 @@
 package ecs
 
 import (
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 )
 
@@ -43,3 +44,34 @@ func (c RoutingTrafficConfig) hasSameTargets(forwardActionTargets []types.Target
 
 	return true
 }
+
+// forwardedWeight returns the largest weight of the given target group among the forward actions.
+// A forward action having only the target group without weight forwards all requests to it,
+// so 1 is returned for that case since the weights are relative values.
+func forwardedWeight(actions []types.Action, targetGroupArn string) int32 {
+	var max int32
+	for _, action := range actions {
+		if action.Type != types.ActionTypeEnumForward {
+			continue
+		}
+		if action.ForwardConfig == nil {
+			if aws.ToString(action.TargetGroupArn) == targetGroupArn && max == 0 {
+				max = 1
+			}
+			continue
+		}
+		for _, tg := range action.ForwardConfig.TargetGroups {
+			if aws.ToString(tg.TargetGroupArn) != targetGroupArn {
+				continue
+			}
+			weight := int32(1)
+			if tg.Weight != nil {
+				weight = *tg.Weight
+			}
+			if weight > max {
+				max = weight
+			}
+		}
+	}
+	return max
+}
@@ -133,3 +133,72 @@ func TestHasSameTargets(t *testing.T) {
 		})
 	}
 }
+
+func TestForwardedWeight(t *testing.T) {
+	t.Parallel()
+
+	const (
+		primary = "arn:aws:elasticloadbalancing:<region>:<account-id>:targetgroup/xxx/yyy1"
+		canary  = "arn:aws:elasticloadbalancing:<region>:<account-id>:targetgroup/xxx/yyy2"
+	)
+	testcases := []struct {
+		name     string
+		actions  []types.Action
+		expected int32
+	}{
+		{
+			name: "no traffic to canary",
+			actions: []types.Action{
+				{
+					Type: types.ActionTypeEnumForward,
+					ForwardConfig: &types.ForwardActionConfig{
+						TargetGroups: []types.TargetGroupTuple{
+							{TargetGroupArn: aws.String(primary), Weight: aws.Int32(100)},
+							{TargetGroupArn: aws.String(canary), Weight: aws.Int32(0)},
+						},
+					},
+				},
+			},
+			expected: 0,
+		},
+		{
+			name: "a part of traffic to canary",
+			actions: []types.Action{
+				{
+					Type: types.ActionTypeEnumForward,
+					ForwardConfig: &types.ForwardActionConfig{
+						TargetGroups: []types.TargetGroupTuple{
+							{TargetGroupArn: aws.String(primary), Weight: aws.Int32(80)},
+							{TargetGroupArn: aws.String(canary), Weight: aws.Int32(20)},
+						},
+					},
+				},
+			},
+			expected: 20,
+		},
+		{
+			name: "forward only to canary without forward config",
+			actions: []types.Action{
+				{
+					Type:           types.ActionTypeEnumForward,
+					TargetGroupArn: aws.String(canary),
+				},
+			},
+			expected: 1,
+		},
+		{
+			name: "not a forward action",
+			actions: []types.Action{
+				{
+					Type: types.ActionTypeEnumFixedResponse,
+				},
+			},
+			expected: 0,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, forwardedWeight(tc.actions, canary))
+		})
+	}
+}
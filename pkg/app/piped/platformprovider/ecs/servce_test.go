@@ -20,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParseServiceDefinition(t *testing.T) {
@@ -165,3 +166,62 @@ deploymentController:
 		})
 	}
 }
+
+func TestParseServiceDefinitionForServiceConnect(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		input    string
+		expected *types.ServiceConnectConfiguration
+	}{
+		{
+			name: "not specified",
+			input: `
+serviceName: nginx
+`,
+		},
+		{
+			name: "enabled",
+			input: `
+serviceName: nginx
+serviceConnectConfiguration:
+  enabled: true
+  namespace: internal
+  services:
+    - portName: http
+      clientAliases:
+        - port: 80
+`,
+			expected: &types.ServiceConnectConfiguration{
+				Enabled:   true,
+				Namespace: aws.String("internal"),
+				Services: []types.ServiceConnectService{
+					{
+						PortName:      aws.String("http"),
+						ClientAliases: []types.ServiceConnectClientAlias{{Port: aws.Int32(80)}},
+					},
+				},
+			},
+		},
+		{
+			name: "disabled",
+			input: `
+serviceName: nginx
+serviceConnectConfiguration:
+  enabled: false
+`,
+			expected: &types.ServiceConnectConfiguration{},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseServiceDefinitionForServiceConnect([]byte(tc.input))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -15,6 +15,7 @@
 package ecs
 
 import (
+	"errors"
 	"os"
 
 	"sigs.k8s.io/yaml"
@@ -22,14 +23,36 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 )
 
-func loadServiceDefinition(path string) (types.Service, error) {
+func loadServiceDefinition(path string, td *TemplateData) (types.Service, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return types.Service{}, err
 	}
+	data, err = renderDefinition(path, data, td)
+	if err != nil {
+		return types.Service{}, err
+	}
 	return parseServiceDefinition(data)
 }
 
+// ErrServiceConnectNotSupported is returned when Service Connect is enabled in the service definition
+// of the application not deployed by rolling updates.
+// The ECS API does not support Service Connect for the services using EXTERNAL or CODE_DEPLOY deployment controller
+// and does not accept its configuration on creating task sets, so it would be dropped silently otherwise.
+var ErrServiceConnectNotSupported = errors.New("serviceConnectConfiguration is supported only with rollingUpdate because ECS Service Connect can not be used with EXTERNAL or CODE_DEPLOY deployment controller, use serviceRegistries with Cloud Map instead")
+
+func loadServiceConnectConfiguration(path string, td *TemplateData) (*types.ServiceConnectConfiguration, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err = renderDefinition(path, data, td)
+	if err != nil {
+		return nil, err
+	}
+	return parseServiceDefinitionForServiceConnect(data)
+}
+
 func parseServiceDefinition(data []byte) (types.Service, error) {
 	var obj types.Service
 	if err := yaml.Unmarshal(data, &obj); err != nil {
@@ -57,6 +80,19 @@ func parseServiceDefinition(data []byte) (types.Service, error) {
 	return obj, nil
 }
 
+// parseServiceDefinitionForServiceConnect returns the Service Connect configuration in the given service definition,
+// or nil when it is not specified.
+// It is parsed separately because types.Service does not have the serviceConnectConfiguration field.
+func parseServiceDefinitionForServiceConnect(data []byte) (*types.ServiceConnectConfiguration, error) {
+	var obj struct {
+		ServiceConnectConfiguration *types.ServiceConnectConfiguration `json:"serviceConnectConfiguration"`
+	}
+	if err := yaml.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return obj.ServiceConnectConfiguration, nil
+}
+
 func parseServiceDefinitionForCluster(data []byte) (string, error) {
 	var obj struct {
 		Cluster string `json:"cluster"`
@@ -0,0 +1,175 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+const (
+	// The maximum number of the stopped tasks to describe.
+	maxStoppedTasks = 5
+	// The number of the last log lines to get from each stopped container.
+	stoppedContainerLogLines = 20
+)
+
+// StoppedTask represents why a task stopped.
+type StoppedTask struct {
+	TaskArn       string
+	StopCode      string
+	StoppedReason string
+	Containers    []StoppedContainer
+}
+
+// StoppedContainer represents how a container of a stopped task exited.
+type StoppedContainer struct {
+	Name     string
+	ExitCode *int32
+	Reason   string
+	// The last lines written to CloudWatch Logs by the container
+	// when it uses the awslogs log driver.
+	LastLogLines []string
+	// Why the log lines could not be got.
+	LogError string
+}
+
+func (c *client) ListStoppedTaskReasons(ctx context.Context, service types.Service, taskDefinition types.TaskDefinition) ([]StoppedTask, error) {
+	listOut, err := c.ecsClient.ListTasks(ctx, &ecs.ListTasksInput{
+		Cluster:       service.ClusterArn,
+		ServiceName:   service.ServiceName,
+		DesiredStatus: types.DesiredStatusStopped,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stopped tasks of service %s: %w", aws.ToString(service.ServiceName), err)
+	}
+	if len(listOut.TaskArns) == 0 {
+		return nil, nil
+	}
+
+	// The number of the tasks returned by a single ListTasks is less than the limit of DescribeTasks.
+	describeOut, err := c.ecsClient.DescribeTasks(ctx, &ecs.DescribeTasksInput{
+		Cluster: service.ClusterArn,
+		Tasks:   listOut.TaskArns,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe stopped tasks of service %s: %w", aws.ToString(service.ServiceName), err)
+	}
+
+	tasks := filterStoppedTasks(describeOut.Tasks, aws.ToString(taskDefinition.TaskDefinitionArn))
+	out := make([]StoppedTask, 0, len(tasks))
+	for _, t := range tasks {
+		st := StoppedTask{
+			TaskArn:       aws.ToString(t.TaskArn),
+			StopCode:      string(t.StopCode),
+			StoppedReason: aws.ToString(t.StoppedReason),
+		}
+		for _, ct := range t.Containers {
+			sc := StoppedContainer{
+				Name:     aws.ToString(ct.Name),
+				ExitCode: ct.ExitCode,
+				Reason:   aws.ToString(ct.Reason),
+			}
+			// Not fail because the logs are supplementary information.
+			lines, err := c.getContainerLastLogLines(ctx, taskDefinition, aws.ToString(t.TaskArn), sc.Name)
+			if err != nil {
+				sc.LogError = err.Error()
+			}
+			sc.LastLogLines = lines
+			st.Containers = append(st.Containers, sc)
+		}
+		out = append(out, st)
+	}
+	return out, nil
+}
+
+// filterStoppedTasks returns the tasks running the given task definition,
+// the most recently stopped first, up to maxStoppedTasks.
+func filterStoppedTasks(tasks []types.Task, taskDefinitionArn string) []types.Task {
+	out := make([]types.Task, 0, len(tasks))
+	for _, t := range tasks {
+		if aws.ToString(t.TaskDefinitionArn) != taskDefinitionArn {
+			continue
+		}
+		out = append(out, t)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return aws.ToTime(out[i].StoppedAt).After(aws.ToTime(out[j].StoppedAt))
+	})
+	if len(out) > maxStoppedTasks {
+		out = out[:maxStoppedTasks]
+	}
+	return out
+}
+
+// getContainerLastLogLines returns the last log lines of the given container written by the awslogs log driver.
+// It returns nil when the container does not use the awslogs log driver with a stream prefix.
+func (c *client) getContainerLastLogLines(ctx context.Context, taskDefinition types.TaskDefinition, taskArn, containerName string) ([]string, error) {
+	group, region, stream, ok := awsLogsStream(taskDefinition, taskArn, containerName)
+	if !ok {
+		return nil, nil
+	}
+
+	out, err := c.logsClient.GetLogEvents(ctx, &cloudwatchlogs.GetLogEventsInput{
+		LogGroupName:  aws.String(group),
+		LogStreamName: aws.String(stream),
+		Limit:         aws.Int32(stoppedContainerLogLines),
+		StartFromHead: aws.Bool(false),
+	}, func(o *cloudwatchlogs.Options) {
+		// The logs can be sent to another region than the one of the cluster.
+		if region != "" {
+			o.Region = region
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]string, 0, len(out.Events))
+	for _, e := range out.Events {
+		lines = append(lines, aws.ToString(e.Message))
+	}
+	return lines, nil
+}
+
+// awsLogsStream returns the log group, region and stream of the given container
+// when it uses the awslogs log driver with a stream prefix.
+// See https://docs.aws.amazon.com/AmazonECS/latest/developerguide/using_awslogs.html
+func awsLogsStream(taskDefinition types.TaskDefinition, taskArn, containerName string) (group, region, stream string, ok bool) {
+	for _, cd := range taskDefinition.ContainerDefinitions {
+		if aws.ToString(cd.Name) != containerName {
+			continue
+		}
+		lc := cd.LogConfiguration
+		if lc == nil || lc.LogDriver != types.LogDriverAwslogs {
+			return "", "", "", false
+		}
+		group = lc.Options["awslogs-group"]
+		prefix := lc.Options["awslogs-stream-prefix"]
+		if group == "" || prefix == "" {
+			return "", "", "", false
+		}
+		// The stream name is prefix-name/container-name/ecs-task-id.
+		return group, lc.Options["awslogs-region"], prefix + "/" + containerName + "/" + path.Base(taskArn), true
+	}
+	return "", "", "", false
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterStoppedTasks(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	tasks := []types.Task{
+		{TaskArn: aws.String("old"), TaskDefinitionArn: aws.String("td:2"), StoppedAt: aws.Time(now.Add(-time.Minute))},
+		{TaskArn: aws.String("other"), TaskDefinitionArn: aws.String("td:1"), StoppedAt: aws.Time(now)},
+		{TaskArn: aws.String("new"), TaskDefinitionArn: aws.String("td:2"), StoppedAt: aws.Time(now)},
+	}
+	for i := 0; i < maxStoppedTasks; i++ {
+		tasks = append(tasks, types.Task{TaskArn: aws.String("older"), TaskDefinitionArn: aws.String("td:2"), StoppedAt: aws.Time(now.Add(-time.Hour))})
+	}
+
+	got := filterStoppedTasks(tasks, "td:2")
+	assert.Len(t, got, maxStoppedTasks)
+	assert.Equal(t, "new", aws.ToString(got[0].TaskArn))
+	assert.Equal(t, "old", aws.ToString(got[1].TaskArn))
+	for _, task := range got {
+		assert.Equal(t, "td:2", aws.ToString(task.TaskDefinitionArn))
+	}
+}
+
+func TestAWSLogsStream(t *testing.T) {
+	t.Parallel()
+
+	td := types.TaskDefinition{
+		ContainerDefinitions: []types.ContainerDefinition{
+			{
+				Name: aws.String("web"),
+				LogConfiguration: &types.LogConfiguration{
+					LogDriver: types.LogDriverAwslogs,
+					Options: map[string]string{
+						"awslogs-group":         "/ecs/web",
+						"awslogs-region":        "ap-northeast-1",
+						"awslogs-stream-prefix": "ecs",
+					},
+				},
+			},
+			{
+				Name: aws.String("no-prefix"),
+				LogConfiguration: &types.LogConfiguration{
+					LogDriver: types.LogDriverAwslogs,
+					Options: map[string]string{
+						"awslogs-group": "/ecs/web",
+					},
+				},
+			},
+			{
+				Name: aws.String("firelens"),
+				LogConfiguration: &types.LogConfiguration{
+					LogDriver: types.LogDriverAwsfirelens,
+				},
+			},
+		},
+	}
+	const taskArn = "arn:aws:ecs:ap-northeast-1:123456789012:task/cluster/0123456789abcdef"
+
+	group, region, stream, ok := awsLogsStream(td, taskArn, "web")
+	assert.True(t, ok)
+	assert.Equal(t, "/ecs/web", group)
+	assert.Equal(t, "ap-northeast-1", region)
+	assert.Equal(t, "ecs/web/0123456789abcdef", stream)
+
+	for _, name := range []string{"no-prefix", "firelens", "unknown"} {
+		_, _, _, ok := awsLogsStream(td, taskArn, name)
+		assert.False(t, ok, name)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+// TargetGroupHealth represents the health of the targets registered to a target group.
+type TargetGroupHealth struct {
+	TargetGroupArn string
+	// The number of the registered targets except the draining ones.
+	Total int
+	// The number of the healthy targets.
+	Healthy int
+	// The states and reasons of the targets which are not healthy, keyed by the target.
+	Unhealthy map[string]string
+}
+
+// HealthyPercentage returns the percentage of the healthy targets.
+// Zero is returned when no target is registered.
+func (h TargetGroupHealth) HealthyPercentage() int {
+	if h.Total == 0 {
+		return 0
+	}
+	return h.Healthy * 100 / h.Total
+}
+
+func (c *client) GetTargetGroupHealth(ctx context.Context, targetGroupArn string) (*TargetGroupHealth, error) {
+	output, err := c.elbClient.DescribeTargetHealth(ctx, &elasticloadbalancingv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(targetGroupArn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe the health of targets in target group %s: %w", targetGroupArn, err)
+	}
+	h := summarizeTargetHealth(targetGroupArn, output.TargetHealthDescriptions)
+	return &h, nil
+}
+
+// summarizeTargetHealth counts the healthy targets among the given ones.
+// The draining targets are ignored since they are being deregistered.
+func summarizeTargetHealth(targetGroupArn string, descs []elbtypes.TargetHealthDescription) TargetGroupHealth {
+	h := TargetGroupHealth{
+		TargetGroupArn: targetGroupArn,
+		Unhealthy:      make(map[string]string),
+	}
+	for _, d := range descs {
+		if d.TargetHealth == nil {
+			continue
+		}
+		switch d.TargetHealth.State {
+		case elbtypes.TargetHealthStateEnumDraining, elbtypes.TargetHealthStateEnumUnhealthyDraining:
+			continue
+		case elbtypes.TargetHealthStateEnumHealthy:
+			h.Total++
+			h.Healthy++
+			continue
+		}
+		h.Total++
+
+		var target string
+		if d.Target != nil {
+			target = aws.ToString(d.Target.Id)
+			if d.Target.Port != nil {
+				target = fmt.Sprintf("%s:%d", target, *d.Target.Port)
+			}
+		}
+		reason := string(d.TargetHealth.State)
+		if d.TargetHealth.Reason != "" {
+			reason = fmt.Sprintf("%s (%s)", reason, d.TargetHealth.Reason)
+		}
+		if desc := aws.ToString(d.TargetHealth.Description); desc != "" {
+			reason = fmt.Sprintf("%s: %s", reason, desc)
+		}
+		h.Unhealthy[target] = reason
+	}
+	return h
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeTargetHealth(t *testing.T) {
+	t.Parallel()
+
+	target := func(id string, port int32, state types.TargetHealthStateEnum, reason types.TargetHealthReasonEnum, desc string) types.TargetHealthDescription {
+		d := types.TargetHealthDescription{
+			Target:       &types.TargetDescription{Id: aws.String(id), Port: aws.Int32(port)},
+			TargetHealth: &types.TargetHealth{State: state, Reason: reason},
+		}
+		if desc != "" {
+			d.TargetHealth.Description = aws.String(desc)
+		}
+		return d
+	}
+
+	got := summarizeTargetHealth("tg", []types.TargetHealthDescription{
+		target("10.0.0.1", 80, types.TargetHealthStateEnumHealthy, "", ""),
+		target("10.0.0.2", 80, types.TargetHealthStateEnumHealthy, "", ""),
+		target("10.0.0.3", 80, types.TargetHealthStateEnumUnhealthy, types.TargetHealthReasonEnumFailedHealthChecks, "Health checks failed with these codes: [500]"),
+		target("10.0.0.4", 80, types.TargetHealthStateEnumInitial, types.TargetHealthReasonEnumInitialHealthChecking, ""),
+		target("10.0.0.5", 80, types.TargetHealthStateEnumDraining, types.TargetHealthReasonEnumDeregistrationInProgress, ""),
+	})
+	assert.Equal(t, TargetGroupHealth{
+		TargetGroupArn: "tg",
+		Total:          4,
+		Healthy:        2,
+		Unhealthy: map[string]string{
+			"10.0.0.3:80": "unhealthy (Target.FailedHealthChecks): Health checks failed with these codes: [500]",
+			"10.0.0.4:80": "initial (Elb.InitialHealthChecking)",
+		},
+	}, got)
+	assert.Equal(t, 50, got.HealthyPercentage())
+
+	empty := summarizeTargetHealth("tg", nil)
+	assert.Equal(t, 0, empty.HealthyPercentage())
+}
@@ -25,11 +25,15 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
-func loadTaskDefinition(path string) (types.TaskDefinition, error) {
+func loadTaskDefinition(path string, td *TemplateData) (types.TaskDefinition, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return types.TaskDefinition{}, err
 	}
+	data, err = renderDefinition(path, data, td)
+	if err != nil {
+		return types.TaskDefinition{}, err
+	}
 	return parseTaskDefinition(data)
 }
 
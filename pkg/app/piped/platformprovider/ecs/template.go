@@ -0,0 +1,72 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// TemplateData holds the values which can be referred from
+// the task and service definition files written as templates.
+type TemplateData struct {
+	AppName    string
+	AppID      string
+	PipedID    string
+	CommitHash string
+	Labels     map[string]string
+	Params     map[string]string
+	Env        map[string]string
+}
+
+// NewTemplateData returns the data to render the definition files of the given application.
+// Nil is returned when the definition templating is not enabled in the application configuration.
+func NewTemplateData(spec *config.ECSApplicationSpec, appID, pipedID, commitHash string) *TemplateData {
+	if spec == nil || !spec.Input.DefinitionTemplate.Enabled {
+		return nil
+	}
+	return &TemplateData{
+		AppName:    spec.Name,
+		AppID:      appID,
+		PipedID:    pipedID,
+		CommitHash: commitHash,
+		Labels:     spec.Labels,
+		Params:     spec.Input.DefinitionTemplate.Params,
+		Env:        spec.Env,
+	}
+}
+
+// renderDefinition renders the given definition file content with the given data.
+// The content is returned as is when no data was given.
+func renderDefinition(path string, data []byte, td *TemplateData) ([]byte, error) {
+	if td == nil {
+		return data, nil
+	}
+	tmpl, err := template.New(filepath.Base(path)).Funcs(sprig.TxtFuncMap()).Option("missingkey=error").Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse definition template %s (%w)", filepath.Base(path), err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, td); err != nil {
+		return nil, fmt.Errorf("failed to render definition template %s (%w)", filepath.Base(path), err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestNewTemplateData(t *testing.T) {
+	t.Parallel()
+
+	spec := &config.ECSApplicationSpec{
+		GenericApplicationSpec: config.GenericApplicationSpec{
+			Name:   "web",
+			Labels: map[string]string{"env": "dev"},
+			Env:    map[string]string{"region": "us-west-2"},
+		},
+	}
+	assert.Nil(t, NewTemplateData(nil, "app-id", "piped-id", "hash"))
+	assert.Nil(t, NewTemplateData(spec, "app-id", "piped-id", "hash"))
+
+	spec.Input.DefinitionTemplate = config.ECSDefinitionTemplate{
+		Enabled: true,
+		Params:  map[string]string{"cpu": "256"},
+	}
+	assert.Equal(t, &TemplateData{
+		AppName:    "web",
+		AppID:      "app-id",
+		PipedID:    "piped-id",
+		CommitHash: "hash",
+		Labels:     map[string]string{"env": "dev"},
+		Params:     map[string]string{"cpu": "256"},
+		Env:        map[string]string{"region": "us-west-2"},
+	}, NewTemplateData(spec, "app-id", "piped-id", "hash"))
+}
+
+func TestLoadTaskDefinitionWithTemplate(t *testing.T) {
+	t.Parallel()
+
+	const taskdef = `
+family: {{ .AppName }}-{{ .Labels.env }}
+cpu: "{{ .Params.cpu }}"
+memory: "{{ index .Params "memory" | default "512" }}"
+containerDefinitions:
+  - name: web
+    image: example.com/web:{{ .CommitHash | trunc 7 }}
+`
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "taskdef.yaml"), []byte(taskdef), 0644))
+
+	td := &TemplateData{
+		AppName:    "web",
+		CommitHash: "0123456789abcdef",
+		Labels:     map[string]string{"env": "dev"},
+		Params:     map[string]string{"cpu": "256"},
+	}
+	got, err := LoadTaskDefinition(dir, "taskdef.yaml", td)
+	require.NoError(t, err)
+	assert.Equal(t, aws.String("web-dev"), got.Family)
+	assert.Equal(t, aws.String("256"), got.Cpu)
+	assert.Equal(t, aws.String("512"), got.Memory)
+	assert.Equal(t, aws.String("example.com/web:0123456"), got.ContainerDefinitions[0].Image)
+
+	// Missing parameters must be reported instead of being rendered as empty values.
+	td.Params = map[string]string{}
+	_, err = LoadTaskDefinition(dir, "taskdef.yaml", td)
+	assert.Error(t, err)
+}
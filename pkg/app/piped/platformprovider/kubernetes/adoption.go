@@ -0,0 +1,127 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	annotationHelmReleaseName     = "meta.helm.sh/release-name"
+	annotationHelmReleaseNS       = "meta.helm.sh/release-namespace"
+	annotationHelmResourcePolicy  = "helm.sh/resource-policy"
+	labelFluxHelmReleaseName      = "helm.toolkit.fluxcd.io/name"
+	labelFluxHelmReleaseNS        = "helm.toolkit.fluxcd.io/namespace"
+	labelFluxKustomizationName    = "kustomize.toolkit.fluxcd.io/name"
+	labelFluxKustomizationNS      = "kustomize.toolkit.fluxcd.io/namespace"
+	annotationFluxPrune           = "kustomize.toolkit.fluxcd.io/prune"
+	annotationArgoCDTrackingID    = "argocd.argoproj.io/tracking-id"
+	labelArgoCDInstance           = "argocd.argoproj.io/instance"
+	annotationArgoCDSyncOptions   = "argocd.argoproj.io/sync-options"
+	helmResourcePolicyKeep        = "keep"
+	fluxPruneDisabled             = "disabled"
+	argoCDSyncOptionsKeepResource = "Prune=false,Delete=false"
+)
+
+// PreviousOwner represents the tool which managed a resource before it is adopted by PipeCD.
+type PreviousOwner struct {
+	// The name of the tool, e.g. Helm.
+	Tool string
+	// The name of the release or the application managing the resource in the tool.
+	// Empty when it is unknown.
+	Name string
+	// The annotations preventing the tool from deleting the resource.
+	keepAnnotations map[string]string
+}
+
+func (o PreviousOwner) String() string {
+	if o.Name == "" {
+		return o.Tool
+	}
+	return fmt.Sprintf("%s %s", o.Tool, o.Name)
+}
+
+// FindPreviousOwner returns the tool managing the given live resource, which is one of Flux, Argo CD and Helm.
+// Flux is checked before Helm because the resources deployed by Flux HelmReleases also have the Helm metadata.
+func FindPreviousOwner(m Manifest) (PreviousOwner, bool) {
+	var (
+		labels      = m.u.GetLabels()
+		annotations = m.u.GetAnnotations()
+	)
+
+	if name := labels[labelFluxHelmReleaseName]; name != "" {
+		return PreviousOwner{
+			Tool: "Flux HelmRelease",
+			Name: qualifiedName(labels[labelFluxHelmReleaseNS], name),
+			// The helm-controller of Flux respects the resource policy of Helm.
+			keepAnnotations: map[string]string{annotationHelmResourcePolicy: helmResourcePolicyKeep},
+		}, true
+	}
+	if name := labels[labelFluxKustomizationName]; name != "" {
+		return PreviousOwner{
+			Tool:            "Flux Kustomization",
+			Name:            qualifiedName(labels[labelFluxKustomizationNS], name),
+			keepAnnotations: map[string]string{annotationFluxPrune: fluxPruneDisabled},
+		}, true
+	}
+
+	argoCDApp := labels[labelArgoCDInstance]
+	if id := annotations[annotationArgoCDTrackingID]; id != "" {
+		// The tracking ID is formatted as <application>:<group>/<kind>:<namespace>/<name>.
+		argoCDApp, _, _ = strings.Cut(id, ":")
+	}
+	if argoCDApp != "" {
+		return PreviousOwner{
+			Tool:            "Argo CD Application",
+			Name:            argoCDApp,
+			keepAnnotations: map[string]string{annotationArgoCDSyncOptions: argoCDSyncOptionsKeepResource},
+		}, true
+	}
+
+	// The release annotations are set by "helm install" and "helm upgrade" but not by "helm template"
+	// used by PipeCD, unlike the app.kubernetes.io/managed-by label.
+	if name := annotations[annotationHelmReleaseName]; name != "" {
+		return PreviousOwner{
+			Tool:            "Helm release",
+			Name:            qualifiedName(annotations[annotationHelmReleaseNS], name),
+			keepAnnotations: map[string]string{annotationHelmResourcePolicy: helmResourcePolicyKeep},
+		}, true
+	}
+
+	return PreviousOwner{}, false
+}
+
+// KeepAnnotations returns the annotations preventing the previous owner from deleting the resource
+// when it uninstalls or prunes the resource after the migration to PipeCD.
+// The annotations already specified in the given manifest are not included.
+func (o PreviousOwner) KeepAnnotations(m Manifest) map[string]string {
+	annotations := m.u.GetAnnotations()
+	keep := make(map[string]string, len(o.keepAnnotations))
+	for k, v := range o.keepAnnotations {
+		if _, ok := annotations[k]; ok {
+			continue
+		}
+		keep[k] = v
+	}
+	return keep
+}
+
+func qualifiedName(namespace, name string) string {
+	if namespace == "" || name == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
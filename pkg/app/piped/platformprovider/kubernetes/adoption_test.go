@@ -0,0 +1,151 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindPreviousOwner(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		manifest  string
+		wantOwner string
+		wantKeep  map[string]string
+		wantFound bool
+	}{
+		{
+			name: "helm release",
+			manifest: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: simple
+  labels:
+    app.kubernetes.io/managed-by: Helm
+  annotations:
+    meta.helm.sh/release-name: simple
+    meta.helm.sh/release-namespace: default
+`,
+			wantOwner: "Helm release default/simple",
+			wantKeep:  map[string]string{"helm.sh/resource-policy": "keep"},
+			wantFound: true,
+		},
+		{
+			name: "rendered by helm template",
+			manifest: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: simple
+  labels:
+    app.kubernetes.io/managed-by: Helm
+`,
+		},
+		{
+			name: "flux helm release",
+			manifest: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: simple
+  labels:
+    helm.toolkit.fluxcd.io/name: simple
+    helm.toolkit.fluxcd.io/namespace: flux-system
+  annotations:
+    meta.helm.sh/release-name: simple
+`,
+			wantOwner: "Flux HelmRelease flux-system/simple",
+			wantKeep:  map[string]string{"helm.sh/resource-policy": "keep"},
+			wantFound: true,
+		},
+		{
+			name: "flux kustomization",
+			manifest: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: simple
+  labels:
+    kustomize.toolkit.fluxcd.io/name: apps
+    kustomize.toolkit.fluxcd.io/namespace: flux-system
+`,
+			wantOwner: "Flux Kustomization flux-system/apps",
+			wantKeep:  map[string]string{"kustomize.toolkit.fluxcd.io/prune": "disabled"},
+			wantFound: true,
+		},
+		{
+			name: "argo cd application with tracking id",
+			manifest: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: simple
+  annotations:
+    argocd.argoproj.io/tracking-id: simple:/Service:default/simple
+`,
+			wantOwner: "Argo CD Application simple",
+			wantKeep:  map[string]string{"argocd.argoproj.io/sync-options": "Prune=false,Delete=false"},
+			wantFound: true,
+		},
+		{
+			name: "sync options already specified",
+			manifest: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: simple
+  labels:
+    argocd.argoproj.io/instance: simple
+  annotations:
+    argocd.argoproj.io/sync-options: Prune=false
+`,
+			wantOwner: "Argo CD Application simple",
+			wantKeep:  map[string]string{},
+			wantFound: true,
+		},
+		{
+			name: "not managed by other tools",
+			manifest: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: simple
+`,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			manifests, err := ParseManifests(tc.manifest)
+			require.NoError(t, err)
+			require.Len(t, manifests, 1)
+
+			owner, found := FindPreviousOwner(manifests[0])
+			assert.Equal(t, tc.wantFound, found)
+			if !found {
+				return
+			}
+			assert.Equal(t, tc.wantOwner, owner.String())
+			assert.Equal(t, tc.wantKeep, owner.KeepAnnotations(manifests[0]))
+		})
+	}
+}
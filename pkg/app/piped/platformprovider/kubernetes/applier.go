@@ -18,9 +18,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/toolregistry"
 	"github.com/pipe-cd/pipecd/pkg/config"
@@ -37,6 +41,19 @@ type Applier interface {
 	ForceReplaceManifest(ctx context.Context, manifest Manifest) error
 	// Delete deletes the given resource from Kubernetes cluster.
 	Delete(ctx context.Context, key ResourceKey) error
+	// GetManifest returns the live manifest of the given resource from Kubernetes cluster.
+	GetManifest(ctx context.Context, key ResourceKey) (Manifest, error)
+	// WaitForCRDReady blocks until the given CustomResourceDefinition is established
+	// and its conversion webhook, if any, is ready to serve, or the context is done.
+	WaitForCRDReady(ctx context.Context, key ResourceKey) error
+	// ListPodEvents returns the events of the pods owned by the given workload.
+	// The pods are matched by the prefix of their names in the namespace of the workload.
+	ListPodEvents(ctx context.Context, workload ResourceKey) ([]corev1.Event, error)
+	// ListPods returns the pods matching the given labels in the namespace of the given workload.
+	ListPods(ctx context.Context, workload ResourceKey, selector map[string]string) ([]corev1.Pod, error)
+	// DeletePod deletes the given pod.
+	// Unlike Delete, the pod is not required to be managed by PipeCD since it is created by its workload.
+	DeletePod(ctx context.Context, pod ResourceKey) error
 }
 
 type applier struct {
@@ -198,6 +215,144 @@ func (a *applier) Delete(ctx context.Context, k ResourceKey) (err error) {
 	)
 }
 
+// GetManifest returns the live manifest of the given resource.
+// ErrNotFound is returned when the resource does not exist.
+func (a *applier) GetManifest(ctx context.Context, k ResourceKey) (Manifest, error) {
+	a.initOnce.Do(func() {
+		a.kubectl, a.initErr = a.findKubectl(ctx, a.getToolVersionToRun())
+	})
+	if a.initErr != nil {
+		return Manifest{}, a.initErr
+	}
+
+	return a.kubectl.Get(
+		ctx,
+		a.platformProvider.KubeConfigPath,
+		a.getNamespaceToRun(k),
+		k,
+	)
+}
+
+// WaitForCRDReady polls the given CustomResourceDefinition until it is established
+// and its conversion webhook, if any, has at least one ready endpoint.
+// An error describing the last observed state is returned when the context is done first.
+func (a *applier) WaitForCRDReady(ctx context.Context, key ResourceKey) error {
+	a.initOnce.Do(func() {
+		a.kubectl, a.initErr = a.findKubectl(ctx, a.getToolVersionToRun())
+	})
+	if a.initErr != nil {
+		return a.initErr
+	}
+	// No CustomResourceDefinition was actually created by the server-side dry-run.
+	if a.input.DryRun {
+		return nil
+	}
+
+	ticker := time.NewTicker(crdReadyCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		ready, reason := a.checkCRDReady(ctx, key)
+		if ready {
+			return nil
+		}
+		a.logger.Info(fmt.Sprintf("waiting for CustomResourceDefinition %s to be ready: %s", key.Name, reason))
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("CustomResourceDefinition %s did not become ready: %s (%w)", key.Name, reason, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// ListPodEvents returns the events of the pods whose names start with the name of the given workload
+// since the pods created by Deployments, StatefulSets and DaemonSets are named in that way.
+func (a *applier) ListPodEvents(ctx context.Context, workload ResourceKey) ([]corev1.Event, error) {
+	a.initOnce.Do(func() {
+		a.kubectl, a.initErr = a.findKubectl(ctx, a.getToolVersionToRun())
+	})
+	if a.initErr != nil {
+		return nil, a.initErr
+	}
+
+	events, err := a.kubectl.GetEvents(
+		ctx,
+		a.platformProvider.KubeConfigPath,
+		a.getNamespaceToRun(workload),
+		KindPod,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := workload.Name + "-"
+	filtered := make([]corev1.Event, 0, len(events))
+	for _, e := range events {
+		if strings.HasPrefix(e.InvolvedObject.Name, prefix) {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered, nil
+}
+
+// ListPods returns the pods matching the given labels in the namespace of the given workload.
+func (a *applier) ListPods(ctx context.Context, workload ResourceKey, selector map[string]string) ([]corev1.Pod, error) {
+	a.initOnce.Do(func() {
+		a.kubectl, a.initErr = a.findKubectl(ctx, a.getToolVersionToRun())
+	})
+	if a.initErr != nil {
+		return nil, a.initErr
+	}
+
+	return a.kubectl.GetPods(
+		ctx,
+		a.platformProvider.KubeConfigPath,
+		a.getNamespaceToRun(workload),
+		labels.SelectorFromSet(selector).String(),
+	)
+}
+
+// DeletePod deletes the given pod.
+func (a *applier) DeletePod(ctx context.Context, pod ResourceKey) error {
+	a.initOnce.Do(func() {
+		a.kubectl, a.initErr = a.findKubectl(ctx, a.getToolVersionToRun())
+	})
+	if a.initErr != nil {
+		return a.initErr
+	}
+
+	return a.kubectl.Delete(
+		ctx,
+		a.platformProvider.KubeConfigPath,
+		a.getNamespaceToRun(pod),
+		pod,
+	)
+}
+
+func (a *applier) checkCRDReady(ctx context.Context, key ResourceKey) (bool, string) {
+	m, err := a.kubectl.Get(ctx, a.platformProvider.KubeConfigPath, "", key)
+	if err != nil {
+		return false, fmt.Sprintf("unable to get the resource (%v)", err)
+	}
+	if ok, reason := checkCRDEstablished(m); !ok {
+		return false, reason
+	}
+
+	svc, ok := conversionWebhookService(m)
+	if !ok {
+		return true, ""
+	}
+	ep, err := a.kubectl.Get(ctx, a.platformProvider.KubeConfigPath, svc.Namespace, svc)
+	if err != nil {
+		return false, fmt.Sprintf("unable to get endpoints of conversion webhook service %s/%s (%v)", svc.Namespace, svc.Name, err)
+	}
+	if !hasReadyEndpoint(ep) {
+		return false, fmt.Sprintf("conversion webhook service %s/%s has no ready endpoint", svc.Namespace, svc.Name)
+	}
+	return true, ""
+}
+
 // getNamespaceToRun returns namespace used on kubectl apply/delete commands.
 // priority: config.KubernetesDeploymentInput > kubernetes.ResourceKey
 func (a *applier) getNamespaceToRun(k ResourceKey) string {
@@ -224,7 +379,26 @@ func (a *applier) findKubectl(ctx context.Context, version string) (*Kubectl, er
 	if installed {
 		a.logger.Info(fmt.Sprintf("kubectl %s has just been installed because of no pre-installed binary for that version", version))
 	}
-	return NewKubectl(version, path), nil
+	kubectl := NewKubectl(version, path)
+	if im := a.input.Impersonation; im != nil {
+		kubectl = kubectl.WithImpersonation(im.UserName(), im.Groups)
+	}
+	if ssa := a.serverSideApply(); ssa != nil && ssa.Enabled {
+		kubectl = kubectl.WithServerSideApply(ssa.FieldManager, ssa.ForceConflicts)
+	}
+	if a.input.DryRun {
+		kubectl = kubectl.WithServerDryRun()
+	}
+	return kubectl, nil
+}
+
+// serverSideApply returns the server-side apply options of the application
+// or the ones of the platform provider when the application does not specify.
+func (a *applier) serverSideApply() *config.KubernetesServerSideApply {
+	if a.input.ServerSideApply != nil {
+		return a.input.ServerSideApply
+	}
+	return a.platformProvider.ServerSideApply
 }
 
 type multiApplier struct {
@@ -282,3 +456,44 @@ func (a *multiApplier) Delete(ctx context.Context, key ResourceKey) error {
 	}
 	return nil
 }
+
+// GetManifest returns the live manifest got from the first applier.
+func (a *multiApplier) GetManifest(ctx context.Context, key ResourceKey) (Manifest, error) {
+	if len(a.appliers) == 0 {
+		return Manifest{}, ErrNotFound
+	}
+	return a.appliers[0].GetManifest(ctx, key)
+}
+
+// ListPodEvents returns the pod events got from the first applier.
+func (a *multiApplier) ListPodEvents(ctx context.Context, workload ResourceKey) ([]corev1.Event, error) {
+	if len(a.appliers) == 0 {
+		return nil, nil
+	}
+	return a.appliers[0].ListPodEvents(ctx, workload)
+}
+
+// ListPods returns the pods listed by the first applier.
+func (a *multiApplier) ListPods(ctx context.Context, workload ResourceKey, selector map[string]string) ([]corev1.Pod, error) {
+	if len(a.appliers) == 0 {
+		return nil, nil
+	}
+	return a.appliers[0].ListPods(ctx, workload, selector)
+}
+
+// DeletePod deletes the given pod by the first applier.
+func (a *multiApplier) DeletePod(ctx context.Context, pod ResourceKey) error {
+	if len(a.appliers) == 0 {
+		return nil
+	}
+	return a.appliers[0].DeletePod(ctx, pod)
+}
+
+func (a *multiApplier) WaitForCRDReady(ctx context.Context, key ResourceKey) error {
+	for _, a := range a.appliers {
+		if err := a.WaitForCRDReady(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
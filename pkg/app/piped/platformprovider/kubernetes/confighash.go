@@ -0,0 +1,95 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// podTemplateFields is the path to the pod template of each workload kind.
+var podTemplateFields = map[string][]string{
+	KindDeployment:  {"spec", "template"},
+	KindStatefulSet: {"spec", "template"},
+	KindDaemonSet:   {"spec", "template"},
+	KindReplicaSet:  {"spec", "template"},
+	KindJob:         {"spec", "template"},
+	KindCronJob:     {"spec", "jobTemplate", "spec", "template"},
+}
+
+// AnnotateConfigHash adds the hash of the ConfigMaps and Secrets referenced by each workload
+// into the annotations of its pod template, so that changing only those configs rolls out the pods.
+// The configs not included in the given manifests are ignored since their contents are unknown.
+func AnnotateConfigHash(manifests []Manifest) error {
+	configMaps := make(map[string]Manifest)
+	secrets := make(map[string]Manifest)
+	for _, m := range manifests {
+		switch {
+		case m.Key.IsConfigMap():
+			configMaps[m.Key.Name] = m
+		case m.Key.IsSecret():
+			secrets[m.Key.Name] = m
+		}
+	}
+	if len(configMaps)+len(secrets) == 0 {
+		return nil
+	}
+
+	for _, m := range manifests {
+		fields, ok := podTemplateFields[m.Key.Kind]
+		if !ok || !IsKubernetesBuiltInResource(m.Key.APIVersion) {
+			continue
+		}
+		if err := annotatePodTemplateConfigHash(m, fields, configMaps, secrets); err != nil {
+			return fmt.Errorf("unable to annotate config hash into %s: %w", m.Key.ReadableString(), err)
+		}
+	}
+	return nil
+}
+
+func annotatePodTemplateConfigHash(m Manifest, fields []string, configMaps, secrets map[string]Manifest) error {
+	obj, err := m.GetNestedMap(fields...)
+	if err != nil || obj == nil {
+		return err
+	}
+	template := &corev1.PodTemplateSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj, template); err != nil {
+		return err
+	}
+
+	var cfgs []Manifest
+	for _, name := range findReferencingConfigMaps(&template.Spec) {
+		if cm, ok := configMaps[name]; ok {
+			cfgs = append(cfgs, cm)
+		}
+	}
+	for _, name := range findReferencingSecrets(&template.Spec) {
+		if s, ok := secrets[name]; ok {
+			cfgs = append(cfgs, s)
+		}
+	}
+	if len(cfgs) == 0 {
+		return nil
+	}
+
+	hash, err := HashManifests(cfgs)
+	if err != nil {
+		return err
+	}
+	annotations := append(append([]string{}, fields...), "metadata", "annotations")
+	return m.AddStringMapValues(map[string]string{AnnotationConfigHash: hash}, annotations...)
+}
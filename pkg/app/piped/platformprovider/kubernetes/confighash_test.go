@@ -0,0 +1,120 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnnotateConfigHash(t *testing.T) {
+	t.Parallel()
+
+	const data = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: app-config
+data:
+  key: value
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: app-secret
+data:
+  password: cGFzc3dvcmQ=
+---
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: app
+spec:
+  template:
+    spec:
+      containers:
+      - name: app
+        image: app:v1
+        envFrom:
+        - configMapRef:
+            name: app-config
+        - secretRef:
+            name: unmanaged-secret
+      volumes:
+      - name: secret
+        secret:
+          secretName: app-secret
+---
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: job
+spec:
+  jobTemplate:
+    spec:
+      template:
+        metadata:
+          annotations:
+            foo: bar
+        spec:
+          containers:
+          - name: job
+            image: job:v1
+            env:
+            - name: KEY
+              valueFrom:
+                configMapKeyRef:
+                  name: app-config
+                  key: key
+---
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: agent
+spec:
+  template:
+    spec:
+      containers:
+      - name: agent
+        image: agent:v1
+        envFrom:
+        - configMapRef:
+            name: unmanaged-config
+`
+	manifests, err := ParseManifests(data)
+	require.NoError(t, err)
+	require.Len(t, manifests, 5)
+	configMap, secret, statefulSet, cronJob, daemonSet := manifests[0], manifests[1], manifests[2], manifests[3], manifests[4]
+
+	require.NoError(t, AnnotateConfigHash(manifests))
+
+	statefulSetHash, err := HashManifests([]Manifest{configMap, secret})
+	require.NoError(t, err)
+	got, err := statefulSet.GetNestedStringMap("spec", "template", "metadata", "annotations")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{AnnotationConfigHash: statefulSetHash}, got)
+
+	cronJobHash, err := HashManifests([]Manifest{configMap})
+	require.NoError(t, err)
+	got, err = cronJob.GetNestedStringMap("spec", "jobTemplate", "spec", "template", "metadata", "annotations")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"foo": "bar", AnnotationConfigHash: cronJobHash}, got)
+
+	got, err = daemonSet.GetNestedStringMap("spec", "template", "metadata", "annotations")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	crdConditionEstablished   = "Established"
+	crdConditionNamesAccepted = "NamesAccepted"
+	crdConversionWebhook      = "Webhook"
+)
+
+var crdReadyCheckInterval = 2 * time.Second
+
+// checkCRDEstablished reports whether the given CustomResourceDefinition
+// is established to serve its custom resources.
+// When it is not, the second returned value describes the reason.
+func checkCRDEstablished(m Manifest) (bool, string) {
+	conditions, _, _ := unstructured.NestedSlice(m.u.Object, "status", "conditions")
+	established := false
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		typ, _, _ := unstructured.NestedString(cond, "type")
+		status, _, _ := unstructured.NestedString(cond, "status")
+		message, _, _ := unstructured.NestedString(cond, "message")
+
+		switch typ {
+		case crdConditionNamesAccepted:
+			if status == "False" {
+				return false, fmt.Sprintf("names were not accepted: %s", message)
+			}
+		case crdConditionEstablished:
+			established = status == "True"
+		}
+	}
+	if !established {
+		return false, "the Established condition is not true yet"
+	}
+	return true, ""
+}
+
+// conversionWebhookService returns the key of the Endpoints of the service
+// serving the conversion webhook of the given CustomResourceDefinition.
+// The second returned value is false if the CRD does not use any webhook served by a service.
+func conversionWebhookService(m Manifest) (ResourceKey, bool) {
+	strategy, _, _ := unstructured.NestedString(m.u.Object, "spec", "conversion", "strategy")
+	if strategy != crdConversionWebhook {
+		return ResourceKey{}, false
+	}
+	// apiextensions.k8s.io/v1 uses spec.conversion.webhook.clientConfig
+	// while v1beta1 uses spec.conversion.webhookClientConfig.
+	service, ok, _ := unstructured.NestedMap(m.u.Object, "spec", "conversion", "webhook", "clientConfig", "service")
+	if !ok {
+		service, ok, _ = unstructured.NestedMap(m.u.Object, "spec", "conversion", "webhookClientConfig", "service")
+	}
+	if !ok {
+		return ResourceKey{}, false
+	}
+	namespace, _, _ := unstructured.NestedString(service, "namespace")
+	name, _, _ := unstructured.NestedString(service, "name")
+	if name == "" {
+		return ResourceKey{}, false
+	}
+	return ResourceKey{
+		APIVersion: "v1",
+		Kind:       "Endpoints",
+		Namespace:  namespace,
+		Name:       name,
+	}, true
+}
+
+// hasReadyEndpoint reports whether the given Endpoints has at least one ready address.
+func hasReadyEndpoint(m Manifest) bool {
+	subsets, _, _ := unstructured.NestedSlice(m.u.Object, "subsets")
+	for _, s := range subsets {
+		subset, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		addresses, _, _ := unstructured.NestedSlice(subset, "addresses")
+		if len(addresses) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,248 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCRDEstablished(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		manifest string
+		want     bool
+	}{
+		{
+			name: "no status yet",
+			manifest: `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+`,
+			want: false,
+		},
+		{
+			name: "names not accepted",
+			manifest: `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+status:
+  conditions:
+  - type: NamesAccepted
+    status: "False"
+    message: "\"foos\" is already in use"
+  - type: Established
+    status: "False"
+`,
+			want: false,
+		},
+		{
+			name: "established",
+			manifest: `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+status:
+  conditions:
+  - type: NamesAccepted
+    status: "True"
+  - type: Established
+    status: "True"
+`,
+			want: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := ParseManifests(tc.manifest)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(manifests))
+
+			got, reason := checkCRDEstablished(manifests[0])
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.want, reason == "")
+		})
+	}
+}
+
+func TestConversionWebhookService(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		manifest string
+		want     ResourceKey
+		wantOK   bool
+	}{
+		{
+			name: "no conversion",
+			manifest: `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+spec:
+  group: example.com
+`,
+		},
+		{
+			name: "webhook served by a service",
+			manifest: `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+spec:
+  conversion:
+    strategy: Webhook
+    webhook:
+      clientConfig:
+        service:
+          namespace: foo-system
+          name: foo-webhook
+`,
+			want: ResourceKey{
+				APIVersion: "v1",
+				Kind:       "Endpoints",
+				Namespace:  "foo-system",
+				Name:       "foo-webhook",
+			},
+			wantOK: true,
+		},
+		{
+			name: "v1beta1 webhook served by a service",
+			manifest: `
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+spec:
+  conversion:
+    strategy: Webhook
+    webhookClientConfig:
+      service:
+        namespace: foo-system
+        name: foo-webhook
+`,
+			want: ResourceKey{
+				APIVersion: "v1",
+				Kind:       "Endpoints",
+				Namespace:  "foo-system",
+				Name:       "foo-webhook",
+			},
+			wantOK: true,
+		},
+		{
+			name: "webhook served by an url",
+			manifest: `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+spec:
+  conversion:
+    strategy: Webhook
+    webhook:
+      clientConfig:
+        url: https://example.com/convert
+`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := ParseManifests(tc.manifest)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(manifests))
+
+			got, ok := conversionWebhookService(manifests[0])
+			assert.Equal(t, tc.wantOK, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestHasReadyEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		manifest string
+		want     bool
+	}{
+		{
+			name: "no subsets",
+			manifest: `
+apiVersion: v1
+kind: Endpoints
+metadata:
+  name: foo-webhook
+`,
+			want: false,
+		},
+		{
+			name: "only not ready addresses",
+			manifest: `
+apiVersion: v1
+kind: Endpoints
+metadata:
+  name: foo-webhook
+subsets:
+- notReadyAddresses:
+  - ip: 10.0.0.1
+`,
+			want: false,
+		},
+		{
+			name: "ready address",
+			manifest: `
+apiVersion: v1
+kind: Endpoints
+metadata:
+  name: foo-webhook
+subsets:
+- addresses:
+  - ip: 10.0.0.1
+`,
+			want: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := ParseManifests(tc.manifest)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(manifests))
+
+			assert.Equal(t, tc.want, hasReadyEndpoint(manifests[0]))
+		})
+	}
+}
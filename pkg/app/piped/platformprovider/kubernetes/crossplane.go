@@ -0,0 +1,177 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	crossplaneConditionReady  = "Ready"
+	crossplaneConditionSynced = "Synced"
+)
+
+// crossplaneSpecFields is the list of spec fields which are only used by Crossplane claims,
+// composite resources and managed resources.
+var crossplaneSpecFields = []string{
+	"compositionRef",
+	"compositionSelector",
+	"compositionRevisionRef",
+	"compositionUpdatePolicy",
+	"resourceRef",
+	"resourceRefs",
+	"claimRef",
+	"forProvider",
+	"providerConfigRef",
+}
+
+// crossplaneManagedSpecFields is the list of spec fields which are populated by Crossplane
+// when they were not specified by the user.
+var crossplaneManagedSpecFields = []string{
+	"compositionRef",
+	"compositionRevisionRef",
+	"compositionUpdatePolicy",
+	"resourceRef",
+	"resourceRefs",
+	"claimRef",
+	"writeConnectionSecretToRef",
+	"providerConfigRef",
+	"deletionPolicy",
+	"managementPolicies",
+}
+
+// IsCrossplaneResource reports whether the given object is a Crossplane claim, composite resource or managed resource.
+func IsCrossplaneResource(obj *unstructured.Unstructured) bool {
+	if obj == nil {
+		return false
+	}
+	if spec, ok := nestedMapNoCopy(obj.Object, "spec"); ok {
+		for _, f := range crossplaneSpecFields {
+			if _, ok := spec[f]; ok {
+				return true
+			}
+		}
+	}
+	_, ok := findCrossplaneCondition(obj, crossplaneConditionSynced)
+	return ok
+}
+
+// IsCrossplaneManifest reports whether the given manifest is a Crossplane resource.
+func IsCrossplaneManifest(m Manifest) bool {
+	return IsCrossplaneResource(m.u)
+}
+
+// IsCrossplaneManifestReady reports whether the given Crossplane manifest is both Synced and Ready.
+func IsCrossplaneManifestReady(m Manifest) (bool, string) {
+	return IsCrossplaneResourceReady(m.u)
+}
+
+// IsCrossplaneResourceReady reports whether the given Crossplane resource is both Synced and Ready.
+// The returned string explains why the resource is not ready yet.
+func IsCrossplaneResourceReady(obj *unstructured.Unstructured) (bool, string) {
+	if synced, ok := findCrossplaneCondition(obj, crossplaneConditionSynced); ok && synced["status"] == "False" {
+		return false, fmt.Sprintf("Resource is not synced: %s", describeCrossplaneCondition(synced))
+	}
+
+	ready, ok := findCrossplaneCondition(obj, crossplaneConditionReady)
+	if !ok {
+		return false, "Waiting for Crossplane to report the Ready condition"
+	}
+	if ready["status"] != "True" {
+		return false, fmt.Sprintf("Resource is not ready yet: %s", describeCrossplaneCondition(ready))
+	}
+	return true, ""
+}
+
+func determineCrossplaneHealth(obj *unstructured.Unstructured) (status model.KubernetesResourceState_HealthStatus, desc string) {
+	if ready, reason := IsCrossplaneResourceReady(obj); !ready {
+		status = model.KubernetesResourceState_OTHER
+		desc = reason
+		return
+	}
+	status = model.KubernetesResourceState_HEALTHY
+	desc = fmt.Sprintf("%q is synced and ready", obj.GetName())
+	return
+}
+
+// normalizeCrossplaneResource removes the spec fields populated by Crossplane from the live object
+// when they are not specified in the desired one, to avoid reporting them as a diff.
+func normalizeCrossplaneResource(live, desired *unstructured.Unstructured) *unstructured.Unstructured {
+	desiredSpec, _ := nestedMapNoCopy(desired.Object, "spec")
+
+	out := live.DeepCopy()
+	for _, f := range crossplaneManagedSpecFields {
+		if _, ok := desiredSpec[f]; ok {
+			continue
+		}
+		unstructured.RemoveNestedField(out.Object, "spec", f)
+	}
+	return out
+}
+
+// CrossplaneClaimKey returns the key of the claim that the given composite resource is bound to.
+func CrossplaneClaimKey(obj *unstructured.Unstructured) (ResourceKey, bool) {
+	ref, ok, _ := unstructured.NestedStringMap(obj.Object, "spec", "claimRef")
+	if !ok || ref["kind"] == "" || ref["name"] == "" {
+		return ResourceKey{}, false
+	}
+	namespace := ref["namespace"]
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+	return ResourceKey{
+		APIVersion: ref["apiVersion"],
+		Kind:       ref["kind"],
+		Namespace:  namespace,
+		Name:       ref["name"],
+	}, true
+}
+
+func nestedMapNoCopy(obj map[string]interface{}, fields ...string) (map[string]interface{}, bool) {
+	v, ok, _ := unstructured.NestedFieldNoCopy(obj, fields...)
+	if !ok {
+		return nil, false
+	}
+	m, ok := v.(map[string]interface{})
+	return m, ok
+}
+
+func findCrossplaneCondition(obj *unstructured.Unstructured, conditionType string) (map[string]interface{}, bool) {
+	v, _, _ := unstructured.NestedFieldNoCopy(obj.Object, "status", "conditions")
+	conditions, _ := v.([]interface{})
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if cond["type"] == conditionType {
+			return cond, true
+		}
+	}
+	return nil, false
+}
+
+func describeCrossplaneCondition(cond map[string]interface{}) string {
+	reason, _ := cond["reason"].(string)
+	message, _ := cond["message"].(string)
+	if message == "" {
+		return reason
+	}
+	return fmt.Sprintf("%s (%s)", reason, message)
+}
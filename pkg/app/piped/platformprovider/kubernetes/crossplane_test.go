@@ -0,0 +1,196 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestDetermineCrossplaneHealth(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		manifest   string
+		isResource bool
+		want       model.KubernetesResourceState_HealthStatus
+	}{
+		{
+			name: "not a crossplane resource",
+			manifest: `
+apiVersion: example.com/v1
+kind: Foo
+metadata:
+  name: foo
+spec:
+  replicas: 1
+`,
+			isResource: false,
+		},
+		{
+			name: "claim waiting for conditions",
+			manifest: `
+apiVersion: database.example.org/v1alpha1
+kind: PostgreSQLInstance
+metadata:
+  name: db
+spec:
+  compositionSelector:
+    matchLabels:
+      provider: aws
+`,
+			isResource: true,
+			want:       model.KubernetesResourceState_OTHER,
+		},
+		{
+			name: "composite synced and ready",
+			manifest: `
+apiVersion: database.example.org/v1alpha1
+kind: XPostgreSQLInstance
+metadata:
+  name: db-abcde
+spec:
+  claimRef:
+    apiVersion: database.example.org/v1alpha1
+    kind: PostgreSQLInstance
+    name: db
+    namespace: default
+status:
+  conditions:
+  - type: Synced
+    status: "True"
+  - type: Ready
+    status: "True"
+`,
+			isResource: true,
+			want:       model.KubernetesResourceState_HEALTHY,
+		},
+		{
+			name: "managed resource not synced",
+			manifest: `
+apiVersion: rds.aws.upbound.io/v1beta1
+kind: Instance
+metadata:
+  name: db-abcde-xyz
+spec:
+  forProvider:
+    region: us-east-1
+status:
+  conditions:
+  - type: Synced
+    status: "False"
+    reason: ReconcileError
+    message: access denied
+  - type: Ready
+    status: "True"
+`,
+			isResource: true,
+			want:       model.KubernetesResourceState_OTHER,
+		},
+		{
+			name: "composite not ready",
+			manifest: `
+apiVersion: database.example.org/v1alpha1
+kind: XPostgreSQLInstance
+metadata:
+  name: db-abcde
+status:
+  conditions:
+  - type: Synced
+    status: "True"
+  - type: Ready
+    status: "False"
+    reason: Creating
+`,
+			isResource: true,
+			want:       model.KubernetesResourceState_OTHER,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := ParseManifests(tc.manifest)
+			require.NoError(t, err)
+			require.Len(t, manifests, 1)
+
+			m := manifests[0]
+			require.Equal(t, tc.isResource, IsCrossplaneManifest(m))
+			if !tc.isResource {
+				return
+			}
+
+			status, _ := determineResourceHealth(m.Key, m.u, nil)
+			assert.Equal(t, tc.want, status)
+		})
+	}
+}
+
+func TestNormalizeCrossplaneResource(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := ParseManifests(`
+apiVersion: database.example.org/v1alpha1
+kind: PostgreSQLInstance
+metadata:
+  name: db
+spec:
+  parameters:
+    storageGB: 20
+  compositionSelector:
+    matchLabels:
+      provider: aws
+---
+apiVersion: database.example.org/v1alpha1
+kind: PostgreSQLInstance
+metadata:
+  name: db
+spec:
+  parameters:
+    storageGB: 20
+  compositionSelector:
+    matchLabels:
+      provider: aws
+  compositionRef:
+    name: aws-postgres
+  compositionUpdatePolicy: Automatic
+  resourceRef:
+    apiVersion: database.example.org/v1alpha1
+    kind: XPostgreSQLInstance
+    name: db-abcde
+`)
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+
+	desired, live := manifests[0], manifests[1]
+
+	result, err := Diff(live, desired, zap.NewNop())
+	require.NoError(t, err)
+	assert.False(t, result.HasDiff())
+
+	// Fields specified in Git must be still compared.
+	desired.u.Object["spec"].(map[string]interface{})["compositionRef"] = map[string]interface{}{"name": "gcp-postgres"}
+	result, err = Diff(live, desired, zap.NewNop())
+	require.NoError(t, err)
+	assert.True(t, result.HasDiff())
+}
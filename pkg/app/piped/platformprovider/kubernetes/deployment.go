@@ -22,10 +22,14 @@ import (
 )
 
 func FindReferencingConfigMapsInDeployment(d *appsv1.Deployment) []string {
+	return findReferencingConfigMaps(&d.Spec.Template.Spec)
+}
+
+func findReferencingConfigMaps(spec *corev1.PodSpec) []string {
 	m := make(map[string]struct{}, 0)
 
 	// Find all configmaps specified in Volumes.
-	for _, v := range d.Spec.Template.Spec.Volumes {
+	for _, v := range spec.Volumes {
 		if cm := v.ConfigMap; cm != nil {
 			m[cm.Name] = struct{}{}
 		}
@@ -49,8 +53,8 @@ func FindReferencingConfigMapsInDeployment(d *appsv1.Deployment) []string {
 	}
 
 	// Find all configmaps specified in Env.
-	findInContainers(d.Spec.Template.Spec.Containers)
-	findInContainers(d.Spec.Template.Spec.InitContainers)
+	findInContainers(spec.Containers)
+	findInContainers(spec.InitContainers)
 
 	if len(m) == 0 {
 		return nil
@@ -66,10 +70,14 @@ func FindReferencingConfigMapsInDeployment(d *appsv1.Deployment) []string {
 }
 
 func FindReferencingSecretsInDeployment(d *appsv1.Deployment) []string {
+	return findReferencingSecrets(&d.Spec.Template.Spec)
+}
+
+func findReferencingSecrets(spec *corev1.PodSpec) []string {
 	m := make(map[string]struct{}, 0)
 
 	// Find all secrets specified in Volumes.
-	for _, v := range d.Spec.Template.Spec.Volumes {
+	for _, v := range spec.Volumes {
 		if s := v.Secret; s != nil {
 			m[s.SecretName] = struct{}{}
 		}
@@ -93,8 +101,8 @@ func FindReferencingSecretsInDeployment(d *appsv1.Deployment) []string {
 	}
 
 	// Find all secrets specified in Env.
-	findInContainers(d.Spec.Template.Spec.Containers)
-	findInContainers(d.Spec.Template.Spec.InitContainers)
+	findInContainers(spec.Containers)
+	findInContainers(spec.InitContainers)
 
 	if len(m) == 0 {
 		return nil
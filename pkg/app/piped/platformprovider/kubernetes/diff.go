@@ -59,21 +59,29 @@ func Diff(old, new Manifest, logger *zap.Logger, opts ...diff.Option) (*diff.Res
 		}
 	}
 
-	key := old.Key.String()
+	if IsCrossplaneResource(old.u) {
+		old.u = normalizeCrossplaneResource(old.u, new.u)
+	}
 
 	normalizedOld, err := remarshal(old.u)
 	if err != nil {
 		logger.Info("compare manifests directly since it was unable to remarshal old Kubernetes manifest to normalize special fields", zap.Error(err))
-		return diff.DiffUnstructureds(*old.u, *new.u, key, opts...)
+		return diffNormalized(old.Key, old.u, new.u, opts...)
 	}
 
 	normalizedNew, err := remarshal(new.u)
 	if err != nil {
 		logger.Info("compare manifests directly since it was unable to remarshal new Kubernetes manifest to normalize special fields", zap.Error(err))
-		return diff.DiffUnstructureds(*old.u, *new.u, key, opts...)
+		return diffNormalized(old.Key, old.u, new.u, opts...)
 	}
 
-	return diff.DiffUnstructureds(*normalizedOld, *normalizedNew, key, opts...)
+	return diffNormalized(old.Key, normalizedOld, normalizedNew, opts...)
+}
+
+func diffNormalized(key ResourceKey, old, new *unstructured.Unstructured, opts ...diff.Option) (*diff.Result, error) {
+	old = normalizeUnstructured(key, old, builtinNormalizationRules)
+	new = normalizeUnstructured(key, new, builtinNormalizationRules)
+	return diff.DiffUnstructureds(*old, *new, key.String(), opts...)
 }
 
 func DiffList(olds, news []Manifest, logger *zap.Logger, opts ...diff.Option) (*DiffListResult, error) {
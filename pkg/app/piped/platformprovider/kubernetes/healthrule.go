@@ -0,0 +1,121 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// HealthRules determines the health status of the custom resources
+// by the rules configured in the platform provider.
+type HealthRules struct {
+	rules map[healthRuleKey][]healthCondition
+}
+
+type healthRuleKey struct {
+	apiVersion string
+	kind       string
+}
+
+type healthCondition struct {
+	path    *jsonpath.JSONPath
+	raw     string
+	values  map[string]struct{}
+	status  model.KubernetesResourceState_HealthStatus
+	message string
+}
+
+// NewHealthRules compiles the given rules.
+func NewHealthRules(rules []config.KubernetesHealthRule) (*HealthRules, error) {
+	hr := &HealthRules{
+		rules: make(map[healthRuleKey][]healthCondition, len(rules)),
+	}
+	for _, r := range rules {
+		key := healthRuleKey{apiVersion: r.APIVersion, kind: r.Kind}
+		for _, c := range r.Conditions {
+			path := jsonpath.New(r.Kind).AllowMissingKeys(true)
+			if err := path.Parse(c.JSONPath); err != nil {
+				return nil, fmt.Errorf("invalid jsonPath %q for %s/%s: %w", c.JSONPath, r.APIVersion, r.Kind, err)
+			}
+			values := make(map[string]struct{}, len(c.Values))
+			for _, v := range c.Values {
+				values[v] = struct{}{}
+			}
+			status := model.KubernetesResourceState_OTHER
+			if c.Health == config.KubernetesHealthy {
+				status = model.KubernetesResourceState_HEALTHY
+			}
+			hr.rules[key] = append(hr.rules[key], healthCondition{
+				path:    path,
+				raw:     c.JSONPath,
+				values:  values,
+				status:  status,
+				message: c.Message,
+			})
+		}
+	}
+	return hr, nil
+}
+
+// determine returns the health status of the given resource by the first matched condition.
+// The last return value is false when there is no rule for the resource.
+func (r *HealthRules) determine(key ResourceKey, obj *unstructured.Unstructured) (model.KubernetesResourceState_HealthStatus, string, bool) {
+	if r == nil {
+		return model.KubernetesResourceState_UNKNOWN, "", false
+	}
+	conditions, ok := r.rules[healthRuleKey{apiVersion: key.APIVersion, kind: key.Kind}]
+	if !ok {
+		return model.KubernetesResourceState_UNKNOWN, "", false
+	}
+
+	for _, c := range conditions {
+		value, ok := c.find(obj)
+		if !ok {
+			continue
+		}
+		if _, ok := c.values[value]; !ok {
+			continue
+		}
+		if c.message != "" {
+			return c.status, c.message, true
+		}
+		return c.status, fmt.Sprintf("%s is %q", c.raw, value), true
+	}
+	return model.KubernetesResourceState_UNKNOWN, fmt.Sprintf("\"%s/%s\" matched none of the configured health conditions", key.APIVersion, key.Kind), true
+}
+
+func (c healthCondition) find(obj *unstructured.Unstructured) (string, bool) {
+	results, err := c.path.FindResults(obj.Object)
+	if err != nil {
+		return "", false
+	}
+	values := make([]string, 0, 1)
+	for _, rs := range results {
+		for _, v := range rs {
+			values = append(values, fmt.Sprint(v.Interface()))
+		}
+	}
+	if len(values) == 0 {
+		return "", false
+	}
+	return strings.Join(values, " "), true
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestHealthRulesDetermine(t *testing.T) {
+	t.Parallel()
+
+	rules, err := NewHealthRules([]config.KubernetesHealthRule{
+		{
+			APIVersion: "argoproj.io/v1alpha1",
+			Kind:       "Rollout",
+			Conditions: []config.KubernetesHealthCondition{
+				{JSONPath: "{.status.phase}", Values: []string{"Healthy"}, Health: config.KubernetesHealthy},
+				{JSONPath: "{.status.phase}", Values: []string{"Degraded", "Paused"}, Health: config.KubernetesUnhealthy, Message: "The rollout is not progressing"},
+			},
+		},
+		{
+			APIVersion: "kafka.strimzi.io/v1beta2",
+			Kind:       "Kafka",
+			Conditions: []config.KubernetesHealthCondition{
+				{JSONPath: `{.status.conditions[?(@.type=="Ready")].status}`, Values: []string{"True"}, Health: config.KubernetesHealthy},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name       string
+		manifest   string
+		wantStatus model.KubernetesResourceState_HealthStatus
+		wantDesc   string
+	}{
+		{
+			name: "healthy rollout",
+			manifest: `
+apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: demo
+status:
+  phase: Healthy
+`,
+			wantStatus: model.KubernetesResourceState_HEALTHY,
+			wantDesc:   `{.status.phase} is "Healthy"`,
+		},
+		{
+			name: "degraded rollout",
+			manifest: `
+apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: demo
+status:
+  phase: Degraded
+`,
+			wantStatus: model.KubernetesResourceState_OTHER,
+			wantDesc:   "The rollout is not progressing",
+		},
+		{
+			name: "rollout without status",
+			manifest: `
+apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: demo
+`,
+			wantStatus: model.KubernetesResourceState_UNKNOWN,
+			wantDesc:   `"argoproj.io/v1alpha1/Rollout" matched none of the configured health conditions`,
+		},
+		{
+			name: "ready kafka",
+			manifest: `
+apiVersion: kafka.strimzi.io/v1beta2
+kind: Kafka
+metadata:
+  name: demo
+status:
+  conditions:
+  - type: Warning
+    status: "True"
+  - type: Ready
+    status: "True"
+`,
+			wantStatus: model.KubernetesResourceState_HEALTHY,
+			wantDesc:   `{.status.conditions[?(@.type=="Ready")].status} is "True"`,
+		},
+		{
+			name: "no rule for the resource",
+			manifest: `
+apiVersion: networking.istio.io/v1beta1
+kind: VirtualService
+metadata:
+  name: demo
+`,
+			wantStatus: model.KubernetesResourceState_UNKNOWN,
+			wantDesc:   `"networking.istio.io/v1beta1/VirtualService" was applied successfully but its health status couldn't be determined exactly. (Because tracking status for this kind of resource is not supported yet.)`,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			manifests, err := ParseManifests(tc.manifest)
+			require.NoError(t, err)
+			require.Len(t, manifests, 1)
+
+			status, desc := determineResourceHealth(manifests[0].Key, manifests[0].u, rules)
+			assert.Equal(t, tc.wantStatus, status)
+			assert.Equal(t, tc.wantDesc, desc)
+		})
+	}
+}
@@ -39,22 +39,41 @@ type Helm struct {
 	version  string
 	execPath string
 	logger   *zap.Logger
+
+	// The paths of the fetched external values files.
+	// They were fetched by piped itself so no verification is needed.
+	externalValueFiles []string
+
+	// The directory to cache the built chart dependencies.
+	dependencyCacheDir string
 }
 
 func NewHelm(version, path string, logger *zap.Logger) *Helm {
 	return &Helm{
-		version:  version,
-		execPath: path,
-		logger:   logger,
+		version:            version,
+		execPath:           path,
+		logger:             logger,
+		dependencyCacheDir: defaultChartDependencyCacheDir,
 	}
 }
 
+// withExternalValueFiles returns a copy of the Helm that additionally loads the given values files.
+func (h *Helm) withExternalValueFiles(files []string) *Helm {
+	c := *h
+	c.externalValueFiles = files
+	return &c
+}
+
 func (h *Helm) TemplateLocalChart(ctx context.Context, appName, appDir, namespace, chartPath string, opts *config.InputHelmOptions) (string, error) {
 	releaseName := appName
 	if opts != nil && opts.ReleaseName != "" {
 		releaseName = opts.ReleaseName
 	}
 
+	if err := h.buildDependencies(ctx, appDir, chartPath); err != nil {
+		return "", fmt.Errorf("failed to build dependencies of chart %s: %w", chartPath, err)
+	}
+
 	args := []string{
 		"template",
 		"--no-hooks",
@@ -78,6 +97,9 @@ func (h *Helm) TemplateLocalChart(ctx context.Context, appName, appDir, namespac
 			}
 			args = append(args, "-f", v)
 		}
+		for _, v := range h.externalValueFiles {
+			args = append(args, "-f", v)
+		}
 		for k, v := range opts.SetFiles {
 			args = append(args, "--set-file", fmt.Sprintf("%s=%s", k, v))
 		}
@@ -89,6 +111,14 @@ func (h *Helm) TemplateLocalChart(ctx context.Context, appName, appDir, namespac
 		}
 	}
 
+	cacheKey, cacheable := h.renderedChartKey(appDir, resolveAppPath(appDir, chartPath), releaseName, namespace, opts)
+	if cacheable {
+		if out, err := renderedChartCache.Get(cacheKey); err == nil {
+			h.logger.Info(fmt.Sprintf("reused the rendered local chart (or cloned remote git chart) for application %s", appName))
+			return out.(string), nil
+		}
+	}
+
 	var stdout, stderr bytes.Buffer
 	cmd := exec.CommandContext(ctx, h.execPath, args...)
 	cmd.Dir = appDir
@@ -102,6 +132,9 @@ func (h *Helm) TemplateLocalChart(ctx context.Context, appName, appDir, namespac
 	if err := cmd.Run(); err != nil {
 		return stdout.String(), fmt.Errorf("%w: %s", err, stderr.String())
 	}
+	if cacheable {
+		renderedChartCache.Put(cacheKey, stdout.String())
+	}
 	return stdout.String(), nil
 }
 
@@ -176,6 +209,9 @@ func (h *Helm) TemplateRemoteChart(ctx context.Context, appName, appDir, namespa
 			}
 			args = append(args, "-f", v)
 		}
+		for _, v := range h.externalValueFiles {
+			args = append(args, "-f", v)
+		}
 		for k, v := range opts.SetFiles {
 			args = append(args, "--set-file", fmt.Sprintf("%s=%s", k, v))
 		}
@@ -204,18 +240,32 @@ func (h *Helm) TemplateRemoteChart(ctx context.Context, appName, appDir, namespa
 		return stdout.String(), nil
 	}
 
+	return h.runRemoteChartCommand(ctx, chart, executor)
+}
+
+// runRemoteChartCommand runs the given helm command for a remote chart and retries it once
+// after updating the repositories or logging in to the registries again
+// when it failed because of the stale repositories or the expired registry credentials.
+func (h *Helm) runRemoteChartCommand(ctx context.Context, chart helmRemoteChart, executor func() (string, error)) (string, error) {
 	out, err := executor()
 	if err == nil {
 		return out, nil
 	}
 
-	if !strings.Contains(err.Error(), "helm repo update") {
-		return "", err
-	}
-
-	// If the error is a "Not Found", we update the repositories and try again.
-	if e := chartrepo.Update(ctx, toolregistry.DefaultRegistry(), h.logger); e != nil {
-		h.logger.Error("failed to update Helm chart repositories", zap.Error(e))
+	switch {
+	case strings.HasPrefix(chart.Repository, config.OCIChartRepositoryPrefix) && chartrepo.IsAuthError(err):
+		// The registry credentials might be expired, we log in again and try again.
+		if e := chartrepo.Relogin(ctx, toolregistry.DefaultRegistry(), h.logger); e != nil {
+			h.logger.Error("failed to login to Helm chart registries", zap.Error(e))
+			return "", err
+		}
+	case strings.Contains(err.Error(), "helm repo update"):
+		// If the error is a "Not Found", we update the repositories and try again.
+		if e := chartrepo.Update(ctx, toolregistry.DefaultRegistry(), h.logger); e != nil {
+			h.logger.Error("failed to update Helm chart repositories", zap.Error(e))
+			return "", err
+		}
+	default:
 		return "", err
 	}
 	return executor()
@@ -286,6 +336,10 @@ func (h *Helm) UpgradeLocalChart(ctx context.Context, appName, appDir, namespace
 		releaseName = opts.ReleaseName
 	}
 
+	if err := h.buildDependencies(ctx, appDir, chartPath); err != nil {
+		return "", fmt.Errorf("failed to build dependencies of chart %s: %w", chartPath, err)
+	}
+
 	args := []string{
 		"upgrade",
 		"--install",
@@ -308,6 +362,9 @@ func (h *Helm) UpgradeLocalChart(ctx context.Context, appName, appDir, namespace
 			}
 			args = append(args, "-f", v)
 		}
+		for _, v := range h.externalValueFiles {
+			args = append(args, "-f", v)
+		}
 		for k, v := range opts.SetFiles {
 			args = append(args, "--set-file", fmt.Sprintf("%s=%s", k, v))
 		}
@@ -384,6 +441,9 @@ func (h *Helm) UpgradeRemoteChart(ctx context.Context, appName, appDir, namespac
 			}
 			args = append(args, "-f", v)
 		}
+		for _, v := range h.externalValueFiles {
+			args = append(args, "-f", v)
+		}
 		for k, v := range opts.SetFiles {
 			args = append(args, "--set-file", fmt.Sprintf("%s=%s", k, v))
 		}
@@ -406,20 +466,5 @@ func (h *Helm) UpgradeRemoteChart(ctx context.Context, appName, appDir, namespac
 		return stdout.String(), nil
 	}
 
-	out, err := executor()
-	if err == nil {
-		return out, nil
-	}
-
-	if !strings.Contains(err.Error(), "helm repo update") {
-		return "", err
-	}
-
-	// If the error is a "Not Found", we update the repositories and try again.
-	if e := chartrepo.Update(ctx, toolregistry.DefaultRegistry(), h.logger); e != nil {
-		h.logger.Error("failed to update Helm chart repositories", zap.Error(e))
-		return "", err
-	}
-	return executor()
-
+	return h.runRemoteChartCommand(ctx, chart, executor)
 }
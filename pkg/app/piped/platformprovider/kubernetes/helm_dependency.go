@@ -0,0 +1,319 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	// defaultChartDependencyCacheDir is the directory where the built chart dependencies are stored keyed by their digest.
+	defaultChartDependencyCacheDir = filepath.Join(os.TempDir(), "helm-chart-dependencies")
+	// chartDependencyCacheMaxSize is the maximum total size of the cached chart dependencies.
+	// The least recently used ones are removed when it is exceeded.
+	chartDependencyCacheMaxSize int64 = 1 << 30
+)
+
+type chartDependency struct {
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	Repository string `json:"repository"`
+}
+
+type chartDependencies struct {
+	Dependencies []chartDependency `json:"dependencies"`
+}
+
+// buildDependencies downloads the dependencies of the given local chart into its charts directory
+// by running "helm dependency build" unless all of them were already placed there.
+// The chart repositories and registries configured in the piped config were registered to helm
+// while starting piped, so their credentials are used to download the dependencies.
+// The downloaded charts are cached by the digest of Chart.lock to be reused by other deployments.
+func (h *Helm) buildDependencies(ctx context.Context, appDir, chartPath string) error {
+	chartDir := chartPath
+	if !filepath.IsAbs(chartDir) {
+		chartDir = filepath.Join(appDir, chartDir)
+	}
+
+	data, err := os.ReadFile(filepath.Join(chartDir, "Chart.yaml"))
+	if err != nil {
+		// The chart could be a packaged one or an invalid one, let helm handle it.
+		return nil
+	}
+	var deps chartDependencies
+	if err := yaml.Unmarshal(data, &deps); err != nil {
+		return fmt.Errorf("failed to parse Chart.yaml: %w", err)
+	}
+	if len(deps.Dependencies) == 0 {
+		return nil
+	}
+
+	chartsDir := filepath.Join(chartDir, "charts")
+	missing, err := missingChartDependencies(chartDir, deps.Dependencies)
+	if err != nil {
+		return err
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	digest, err := chartDependencyDigest(chartDir, deps.Dependencies)
+	if err != nil {
+		return err
+	}
+
+	cacheDir := h.dependencyCacheDir
+	cachedDir := filepath.Join(cacheDir, digest)
+	if digest != "" {
+		if err := copyChartFiles(cachedDir, chartsDir); err == nil {
+			// Mark it as recently used to keep it in the cache longer.
+			now := time.Now()
+			os.Chtimes(cachedDir, now, now)
+			h.logger.Info("reused the cached chart dependencies", zap.String("chart", chartPath), zap.String("digest", digest))
+			return nil
+		}
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, h.execPath, "dependency", "build", chartDir)
+	cmd.Dir = appDir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	h.logger.Info("start building chart dependencies", zap.String("chart", chartPath), zap.Strings("missing", missing))
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%w: %s", err, stderr.String())
+	}
+
+	if digest == "" {
+		return nil
+	}
+	if err := storeChartDependencies(chartsDir, cacheDir, cachedDir); err != nil {
+		h.logger.Warn("failed to cache the built chart dependencies", zap.String("chart", chartPath), zap.Error(err))
+		return nil
+	}
+	if err := pruneChartDependencyCache(cacheDir, chartDependencyCacheMaxSize); err != nil {
+		h.logger.Warn("failed to prune the cached chart dependencies", zap.Error(err))
+	}
+	return nil
+}
+
+// missingChartDependencies returns the names of the given dependencies
+// which are placed in the charts directory neither as an archive nor as an unpacked chart.
+// The versions pinned by Chart.lock are preferred to the ones in Chart.yaml
+// since the latter can be version ranges.
+func missingChartDependencies(chartDir string, deps []chartDependency) ([]string, error) {
+	versions := make(map[string]string, len(deps))
+	data, err := os.ReadFile(filepath.Join(chartDir, "Chart.lock"))
+	switch {
+	case err == nil:
+		var lock chartDependencies
+		if err := yaml.Unmarshal(data, &lock); err != nil {
+			return nil, fmt.Errorf("failed to parse Chart.lock: %w", err)
+		}
+		for _, d := range lock.Dependencies {
+			versions[d.Name] = d.Version
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, fmt.Errorf("failed to read Chart.lock: %w", err)
+	}
+
+	chartsDir := filepath.Join(chartDir, "charts")
+	var missing []string
+	for _, d := range deps {
+		version, ok := versions[d.Name]
+		if !ok {
+			version = d.Version
+		}
+		if fileExists(filepath.Join(chartsDir, fmt.Sprintf("%s-%s.tgz", d.Name, version))) ||
+			fileExists(filepath.Join(chartsDir, d.Name, "Chart.yaml")) {
+			continue
+		}
+		missing = append(missing, d.Name)
+	}
+	return missing, nil
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
+
+// chartDependencyDigest returns the digest of Chart.lock identifying the given dependencies.
+// An empty digest is returned when there is no Chart.lock because the versions could be ranges
+// resolved to different charts over time, or when some dependencies are local charts
+// because their content can be changed without changing the dependencies.
+func chartDependencyDigest(chartDir string, deps []chartDependency) (string, error) {
+	for _, d := range deps {
+		if d.Repository == "" || strings.HasPrefix(d.Repository, "file://") {
+			return "", nil
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(chartDir, "Chart.lock"))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read Chart.lock: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// pruneChartDependencyCache removes the least recently used dependencies from the cache
+// until the total size of the cache gets smaller than the given max size.
+func pruneChartDependencyCache(cacheDir string, maxSize int64) error {
+	entries, err := os.ReadDir(cacheDir)
+	if err != nil {
+		return err
+	}
+
+	type cached struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var (
+		caches = make([]cached, 0, len(entries))
+		total  int64
+	)
+	for _, e := range entries {
+		// Skip the ones being stored.
+		if !e.IsDir() || strings.HasPrefix(e.Name(), "tmp") {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		path := filepath.Join(cacheDir, e.Name())
+		size, err := dirSize(path)
+		if err != nil {
+			continue
+		}
+		caches = append(caches, cached{path: path, size: size, modTime: info.ModTime()})
+		total += size
+	}
+
+	sort.Slice(caches, func(i, j int) bool {
+		return caches[i].modTime.Before(caches[j].modTime)
+	})
+	for _, c := range caches {
+		if total <= maxSize {
+			break
+		}
+		if err := os.RemoveAll(c.path); err != nil {
+			return err
+		}
+		total -= c.size
+	}
+	return nil
+}
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
+
+// storeChartDependencies copies the built charts into the cache.
+// They are copied into a temporary directory first and then renamed
+// so that the partially copied charts are never used.
+func storeChartDependencies(chartsDir, cacheDir, cachedDir string) error {
+	if err := os.MkdirAll(cacheDir, 0700); err != nil {
+		return err
+	}
+	tmp, err := os.MkdirTemp(cacheDir, "tmp")
+	if err != nil {
+		return err
+	}
+	if err := copyChartFiles(chartsDir, tmp); err != nil {
+		os.RemoveAll(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, cachedDir); err != nil {
+		// Another deployment has already stored the same dependencies.
+		os.RemoveAll(tmp)
+	}
+	return nil
+}
+
+// copyChartFiles copies the regular files inside src to dest.
+func copyChartFiles(src, dest string) error {
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if !e.Type().IsRegular() {
+			continue
+		}
+		if err := copyFile(filepath.Join(src, e.Name()), filepath.Join(dest, e.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyFile(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
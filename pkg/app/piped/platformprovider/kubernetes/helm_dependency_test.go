@@ -0,0 +1,222 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const chartWithDependencies = `apiVersion: v2
+name: app
+version: 0.1.0
+dependencies:
+- name: redis
+  version: 17.0.0
+  repository: https://charts.example.com
+`
+
+func writeChart(t *testing.T, chart string, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Chart.yaml"), []byte(chart), 0644))
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return dir
+}
+
+func TestBuildDependencies(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("no dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		chartDir := writeChart(t, "apiVersion: v2\nname: app\nversion: 0.1.0\n", nil)
+		h := &Helm{execPath: "not-found", logger: zap.NewNop(), dependencyCacheDir: t.TempDir()}
+		require.NoError(t, h.buildDependencies(ctx, chartDir, "."))
+
+		_, err := os.Stat(filepath.Join(chartDir, "charts"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("already vendored", func(t *testing.T) {
+		t.Parallel()
+
+		chartDir := writeChart(t, chartWithDependencies, map[string]string{
+			"charts/redis-17.0.0.tgz": "vendored",
+		})
+		h := &Helm{execPath: "not-found", logger: zap.NewNop(), dependencyCacheDir: t.TempDir()}
+		require.NoError(t, h.buildDependencies(ctx, chartDir, "."))
+	})
+
+	t.Run("other charts are vendored", func(t *testing.T) {
+		t.Parallel()
+
+		chartDir := writeChart(t, chartWithDependencies, map[string]string{
+			"charts/postgresql-12.0.0.tgz": "vendored",
+		})
+		h := &Helm{execPath: "not-found", logger: zap.NewNop(), dependencyCacheDir: t.TempDir()}
+		assert.Error(t, h.buildDependencies(ctx, chartDir, "."))
+	})
+
+	t.Run("cached", func(t *testing.T) {
+		t.Parallel()
+
+		chartDir := writeChart(t, chartWithDependencies, map[string]string{
+			"Chart.lock": "dependencies:\n- name: redis\n  version: 17.0.0\n",
+		})
+		deps := []chartDependency{{Name: "redis", Version: "17.0.0", Repository: "https://charts.example.com"}}
+		digest, err := chartDependencyDigest(chartDir, deps)
+		require.NoError(t, err)
+		require.NotEmpty(t, digest)
+
+		cacheDir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(cacheDir, digest), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(cacheDir, digest, "redis-17.0.0.tgz"), []byte("cached"), 0644))
+
+		// The helm command must not be executed because the dependencies are cached.
+		h := &Helm{execPath: "not-found", logger: zap.NewNop(), dependencyCacheDir: cacheDir}
+		require.NoError(t, h.buildDependencies(ctx, filepath.Dir(chartDir), filepath.Base(chartDir)))
+
+		data, err := os.ReadFile(filepath.Join(chartDir, "charts", "redis-17.0.0.tgz"))
+		require.NoError(t, err)
+		assert.Equal(t, "cached", string(data))
+	})
+
+	t.Run("failed to build", func(t *testing.T) {
+		t.Parallel()
+
+		chartDir := writeChart(t, chartWithDependencies, nil)
+		h := &Helm{execPath: "not-found", logger: zap.NewNop(), dependencyCacheDir: t.TempDir()}
+		assert.Error(t, h.buildDependencies(ctx, chartDir, "."))
+	})
+}
+
+func TestChartDependencyDigest(t *testing.T) {
+	t.Parallel()
+
+	remote := []chartDependency{{Name: "redis", Version: "17.0.0", Repository: "https://charts.example.com"}}
+	local := []chartDependency{{Name: "common", Version: "0.1.0", Repository: "file://../common"}}
+
+	// The dependencies are not cached without Chart.lock.
+	chartDir := t.TempDir()
+	digest, err := chartDependencyDigest(chartDir, remote)
+	require.NoError(t, err)
+	assert.Empty(t, digest)
+
+	require.NoError(t, os.WriteFile(filepath.Join(chartDir, "Chart.lock"), []byte("digest: sha256:abc\n"), 0644))
+	locked, err := chartDependencyDigest(chartDir, remote)
+	require.NoError(t, err)
+	assert.NotEmpty(t, locked)
+
+	// The digest is changed when the locked dependencies are changed.
+	require.NoError(t, os.WriteFile(filepath.Join(chartDir, "Chart.lock"), []byte("digest: sha256:def\n"), 0644))
+	updated, err := chartDependencyDigest(chartDir, remote)
+	require.NoError(t, err)
+	assert.NotEqual(t, locked, updated)
+
+	// Local dependencies are never cached.
+	digest, err = chartDependencyDigest(chartDir, append(remote, local...))
+	require.NoError(t, err)
+	assert.Empty(t, digest)
+}
+
+func TestMissingChartDependencies(t *testing.T) {
+	t.Parallel()
+
+	deps := []chartDependency{
+		{Name: "redis", Version: "~17.0.0", Repository: "https://charts.example.com"},
+		{Name: "postgresql", Version: "12.0.0", Repository: "https://charts.example.com"},
+		{Name: "common", Version: "0.1.0", Repository: "file://../common"},
+	}
+
+	testcases := []struct {
+		name  string
+		files map[string]string
+		want  []string
+	}{
+		{
+			name: "nothing is placed",
+			want: []string{"redis", "postgresql", "common"},
+		},
+		{
+			name: "version range without Chart.lock",
+			files: map[string]string{
+				"charts/redis-17.0.1.tgz":      "",
+				"charts/postgresql-12.0.0.tgz": "",
+				"charts/common/Chart.yaml":     "",
+			},
+			want: []string{"redis"},
+		},
+		{
+			name: "version pinned by Chart.lock",
+			files: map[string]string{
+				"Chart.lock":                   "dependencies:\n- name: redis\n  version: 17.0.1\n",
+				"charts/redis-17.0.1.tgz":      "",
+				"charts/postgresql-11.0.0.tgz": "",
+				"charts/common-0.1.0.tgz":      "",
+			},
+			want: []string{"postgresql"},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			chartDir := writeChart(t, chartWithDependencies, tc.files)
+			got, err := missingChartDependencies(chartDir, deps)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPruneChartDependencyCache(t *testing.T) {
+	t.Parallel()
+
+	cacheDir := t.TempDir()
+	now := time.Now()
+	for i, name := range []string{"old", "recent", "tmp123"} {
+		dir := filepath.Join(cacheDir, name)
+		require.NoError(t, os.MkdirAll(dir, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "chart.tgz"), make([]byte, 100), 0644))
+		modTime := now.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, os.Chtimes(dir, modTime, modTime))
+	}
+
+	require.NoError(t, pruneChartDependencyCache(cacheDir, 150))
+
+	_, err := os.Stat(filepath.Join(cacheDir, "old"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(cacheDir, "recent"))
+	assert.NoError(t, err)
+	// The ones being stored must not be removed.
+	_, err = os.Stat(filepath.Join(cacheDir, "tmp123"))
+	assert.NoError(t, err)
+}
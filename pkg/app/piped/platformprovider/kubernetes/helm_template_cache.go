@@ -0,0 +1,145 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pipe-cd/pipecd/pkg/cache"
+	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// renderedChartCacheSize is the number of the rendered local charts kept in memory.
+const renderedChartCacheSize = 50
+
+// renderedChartCache stores the outputs of "helm template" for the local charts keyed by the digest of all their inputs,
+// so that the stages, the rollback and the drift detection rendering the same chart with the same values
+// reuse the output instead of running helm again.
+var renderedChartCache cache.Cache = mustNewLRUCache(renderedChartCacheSize)
+
+func mustNewLRUCache(size int) cache.Cache {
+	c, err := memorycache.NewLRUCache(size)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// renderedChartKey returns the digest identifying the output of "helm template" for the given local chart.
+// The content of the chart and the values files is used instead of their paths
+// since the same chart is rendered from a different directory by each stage.
+// The second returned value is false when the output can not be cached,
+// e.g. some values files are fetched from remote at rendering.
+func (h *Helm) renderedChartKey(appDir, chartDir, releaseName, namespace string, opts *config.InputHelmOptions) (string, bool) {
+	hs := sha256.New()
+	fmt.Fprintf(hs, "%s\x00%s\x00%s\x00", h.version, releaseName, namespace)
+	if err := digestChartDir(hs, chartDir); err != nil {
+		return "", false
+	}
+
+	for _, f := range h.externalValueFiles {
+		if err := digestFile(hs, f); err != nil {
+			return "", false
+		}
+	}
+	if opts == nil {
+		return hex.EncodeToString(hs.Sum(nil)), true
+	}
+
+	for _, k := range sortedKeys(opts.SetValues) {
+		fmt.Fprintf(hs, "set\x00%s\x00%s\x00", k, opts.SetValues[k])
+	}
+	for _, v := range opts.ValueFiles {
+		if u, err := url.Parse(v); err == nil && u.Scheme != "" {
+			return "", false
+		}
+		fmt.Fprintf(hs, "values\x00")
+		if err := digestFile(hs, resolveAppPath(appDir, v)); err != nil {
+			return "", false
+		}
+	}
+	for _, k := range sortedKeys(opts.SetFiles) {
+		fmt.Fprintf(hs, "set-file\x00%s\x00", k)
+		if err := digestFile(hs, resolveAppPath(appDir, opts.SetFiles[k])); err != nil {
+			return "", false
+		}
+	}
+	for _, v := range opts.APIVersions {
+		fmt.Fprintf(hs, "api-versions\x00%s\x00", v)
+	}
+	fmt.Fprintf(hs, "kube-version\x00%s\x00", opts.KubeVersion)
+	return hex.EncodeToString(hs.Sum(nil)), true
+}
+
+// digestChartDir writes the relative paths and the content of all files inside the given chart into the given hash.
+func digestChartDir(h hash.Hash, chartDir string) error {
+	return filepath.WalkDir(chartDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(chartDir, path)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "%s\x00", rel)
+		if !d.Type().IsRegular() {
+			// The symlinks could point to the files outside the chart.
+			return fmt.Errorf("%s is not a regular file", rel)
+		}
+		return digestFile(h, path)
+	})
+}
+
+func digestFile(h hash.Hash, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	_, err = h.Write([]byte{0})
+	return err
+}
+
+func resolveAppPath(appDir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(appDir, path)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
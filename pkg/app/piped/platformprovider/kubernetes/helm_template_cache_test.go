@@ -0,0 +1,69 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestRenderedChartKey(t *testing.T) {
+	t.Parallel()
+
+	writeApp := func(values string) string {
+		appDir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(appDir, "chart", "templates"), 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(appDir, "chart", "Chart.yaml"), []byte("apiVersion: v2\nname: app\nversion: 0.1.0\n"), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(appDir, "chart", "templates", "cm.yaml"), []byte("kind: ConfigMap\n"), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(appDir, "values.yaml"), []byte(values), 0600))
+		return appDir
+	}
+	h := NewHelm("3.8.2", "helm", zap.NewNop())
+	opts := &config.InputHelmOptions{
+		ValueFiles: []string{"values.yaml"},
+		SetValues:  map[string]string{"a": "1", "b": "2"},
+	}
+
+	// The same content at different directories has the same key.
+	app1, app2 := writeApp("replicas: 1"), writeApp("replicas: 1")
+	key1, ok := h.renderedChartKey(app1, filepath.Join(app1, "chart"), "app", "default", opts)
+	require.True(t, ok)
+	key2, ok := h.renderedChartKey(app2, filepath.Join(app2, "chart"), "app", "default", opts)
+	require.True(t, ok)
+	assert.Equal(t, key1, key2)
+
+	// The values are taken into account.
+	app3 := writeApp("replicas: 2")
+	key3, ok := h.renderedChartKey(app3, filepath.Join(app3, "chart"), "app", "default", opts)
+	require.True(t, ok)
+	assert.NotEqual(t, key1, key3)
+
+	key4, ok := h.renderedChartKey(app1, filepath.Join(app1, "chart"), "app", "other", opts)
+	require.True(t, ok)
+	assert.NotEqual(t, key1, key4)
+
+	// The remote values files can be changed without changing the key.
+	_, ok = h.renderedChartKey(app1, filepath.Join(app1, "chart"), "app", "default", &config.InputHelmOptions{
+		ValueFiles: []string{"https://example.com/values.yaml"},
+	})
+	assert.False(t, ok)
+}
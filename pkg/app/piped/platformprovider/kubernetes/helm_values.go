@@ -0,0 +1,220 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/cache"
+	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	externalValuesCacheTTL              = 5 * time.Minute
+	externalValuesCacheEvictionInterval = time.Minute
+)
+
+var (
+	externalValuesCache     cache.Cache
+	externalValuesCacheOnce sync.Once
+)
+
+// externalValueFetcher fetches the content of a Helm values file from an external source.
+type externalValueFetcher func(ctx context.Context, f config.InputHelmExternalValueFile) ([]byte, error)
+
+// fetchExternalValueFiles fetches all given external values files and stores them into the given directory.
+// Only the contents pinned by their checksum are cached for a while to avoid fetching them repeatedly,
+// so the cached content is always the one expected by the application.
+// The values fetched from secret sources are never cached.
+func fetchExternalValueFiles(ctx context.Context, dir string, files []config.InputHelmExternalValueFile, fetch externalValueFetcher, logger *zap.Logger) ([]string, error) {
+	externalValuesCacheOnce.Do(func() {
+		externalValuesCache = memorycache.NewTTLCache(context.Background(), externalValuesCacheTTL, externalValuesCacheEvictionInterval)
+	})
+
+	paths := make([]string, 0, len(files))
+	for i, f := range files {
+		source := externalValueFileSource(f)
+		cacheKey, cacheable := externalValueFileCacheKey(f)
+
+		var (
+			data   []byte
+			cached bool
+		)
+		if cacheable {
+			if v, err := externalValuesCache.Get(cacheKey); err == nil {
+				data, cached = v.([]byte), true
+			}
+		}
+		if !cached {
+			var err error
+			if data, err = fetch(ctx, f); err != nil {
+				return nil, fmt.Errorf("unable to fetch external values file %s: %w", source, err)
+			}
+		}
+
+		sum := sha256.Sum256(data)
+		checksum := hex.EncodeToString(sum[:])
+		if f.Checksum != "" && !strings.EqualFold(f.Checksum, checksum) {
+			return nil, fmt.Errorf("checksum of external values file %s mismatched: expected %s but got %s", source, f.Checksum, checksum)
+		}
+		if cacheable && !cached {
+			if err := externalValuesCache.Put(cacheKey, data); err != nil {
+				logger.Warn("unable to cache external values file", zap.String("source", source), zap.Error(err))
+			}
+		}
+
+		path := filepath.Join(dir, fmt.Sprintf("values-%d.yaml", i))
+		if err := os.WriteFile(path, data, 0600); err != nil {
+			return nil, fmt.Errorf("unable to write external values file %s: %w", source, err)
+		}
+		logger.Info("loaded external values file",
+			zap.String("source", source),
+			zap.String("sha256", checksum),
+		)
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// externalValueFileSource returns a string to identify the source of the given values file.
+func externalValueFileSource(f config.InputHelmExternalValueFile) string {
+	switch {
+	case f.Git != nil:
+		return fmt.Sprintf("git:%s@%s:%s", f.Git.GitRemote, f.Git.Ref, f.Git.Path)
+	case f.S3 != "":
+		return f.S3
+	case f.SSMParameter != "":
+		return fmt.Sprintf("ssm:%s:%s", f.Region, f.SSMParameter)
+	default:
+		return fmt.Sprintf("secretsmanager:%s:%s", f.Region, f.SecretsManagerSecret)
+	}
+}
+
+// externalValueFileCacheKey returns the key to cache the content of the given values file
+// and whether it can be cached.
+func externalValueFileCacheKey(f config.InputHelmExternalValueFile) (string, bool) {
+	if f.Checksum == "" || f.SSMParameter != "" || f.SecretsManagerSecret != "" {
+		return "", false
+	}
+	return externalValueFileSource(f) + "@sha256:" + strings.ToLower(f.Checksum), true
+}
+
+// newExternalValueFetcher returns a fetcher that fetches values files from Git repositories and AWS services.
+func newExternalValueFetcher(gc gitClient) externalValueFetcher {
+	return func(ctx context.Context, f config.InputHelmExternalValueFile) ([]byte, error) {
+		if f.Git != nil {
+			return fetchGitValueFile(ctx, gc, f.Git)
+		}
+
+		var opts []func(*awsconfig.LoadOptions) error
+		if f.Region != "" {
+			opts = append(opts, awsconfig.WithRegion(f.Region))
+		}
+		cfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load AWS config: %w", err)
+		}
+
+		switch {
+		case f.S3 != "":
+			return fetchS3ValueFile(ctx, s3.NewFromConfig(cfg), f.S3)
+		case f.SSMParameter != "":
+			out, err := ssm.NewFromConfig(cfg).GetParameter(ctx, &ssm.GetParameterInput{
+				Name:           aws.String(f.SSMParameter),
+				WithDecryption: aws.Bool(true),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return []byte(aws.ToString(out.Parameter.Value)), nil
+		case f.SecretsManagerSecret != "":
+			out, err := secretsmanager.NewFromConfig(cfg).GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+				SecretId: aws.String(f.SecretsManagerSecret),
+			})
+			if err != nil {
+				return nil, err
+			}
+			if out.SecretString != nil {
+				return []byte(*out.SecretString), nil
+			}
+			return out.SecretBinary, nil
+		default:
+			return nil, errors.New("no source was specified")
+		}
+	}
+}
+
+func fetchGitValueFile(ctx context.Context, gc gitClient, f *config.InputHelmGitValueFile) ([]byte, error) {
+	repoDir, err := os.MkdirTemp("", "helm-external-values")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create temporary directory for storing remote git repository: %w", err)
+	}
+	defer os.RemoveAll(repoDir)
+
+	repo, err := gc.Clone(ctx, f.GitRemote, f.GitRemote, "", repoDir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to clone git repository containing values file: %w", err)
+	}
+	if f.Ref != "" {
+		if err := repo.Checkout(ctx, f.Ref); err != nil {
+			return nil, fmt.Errorf("unable to checkout to specified ref %s: %w", f.Ref, err)
+		}
+	}
+
+	path := filepath.Join(repoDir, f.Path)
+	if !strings.HasPrefix(path, repoDir) {
+		return nil, fmt.Errorf("values file %s references outside the git repository", f.Path)
+	}
+	return os.ReadFile(path)
+}
+
+func fetchS3ValueFile(ctx context.Context, client *s3.Client, uri string) ([]byte, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid s3 uri %s: %w", uri, err)
+	}
+	in := &s3.GetObjectInput{
+		Bucket: aws.String(u.Host),
+		Key:    aws.String(strings.TrimPrefix(u.Path, "/")),
+	}
+	if v := u.Query().Get("versionId"); v != "" {
+		in.VersionId = aws.String(v)
+	}
+	out, err := client.GetObject(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	defer out.Body.Close()
+	return io.ReadAll(out.Body)
+}
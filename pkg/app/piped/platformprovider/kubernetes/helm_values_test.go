@@ -0,0 +1,165 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestFetchExternalValueFiles(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	fetch := func(_ context.Context, f config.InputHelmExternalValueFile) ([]byte, error) {
+		calls++
+		return []byte("replicas: 3\n"), nil
+	}
+	files := []config.InputHelmExternalValueFile{
+		{
+			S3:       "s3://bucket/fetch-external-value-files/values.yaml",
+			Checksum: "7d7c4e6aa4cc0b0e3b0e52d1a3da3ec2b8e6e0ee4b9b4d4e0a2b0b3cb9a5c1f0",
+		},
+	}
+
+	// Checksum mismatched.
+	_, err := fetchExternalValueFiles(context.Background(), t.TempDir(), files, fetch, zap.NewNop())
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+
+	// The content not matching the checksum must not be cached.
+	files[0].Checksum = "9cf3a5f89adc05f90e87b284d40f8e39e1b763d9f9df307327e6f127ed492175"
+	paths, err := fetchExternalValueFiles(context.Background(), t.TempDir(), files, fetch, zap.NewNop())
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	assert.Equal(t, 2, calls)
+
+	data, err := os.ReadFile(paths[0])
+	require.NoError(t, err)
+	assert.Equal(t, "replicas: 3\n", string(data))
+
+	// The content pinned by the checksum must be cached.
+	_, err = fetchExternalValueFiles(context.Background(), t.TempDir(), files, fetch, zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	// The content without checksum must be fetched every time.
+	files[0].Checksum = ""
+	_, err = fetchExternalValueFiles(context.Background(), t.TempDir(), files, fetch, zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestExternalValueFileCacheKey(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		file      config.InputHelmExternalValueFile
+		want      string
+		cacheable bool
+	}{
+		{
+			name: "s3 with checksum",
+			file: config.InputHelmExternalValueFile{
+				S3:       "s3://bucket/values.yaml",
+				Checksum: "ABC",
+			},
+			want:      "s3://bucket/values.yaml@sha256:abc",
+			cacheable: true,
+		},
+		{
+			name: "s3 without checksum",
+			file: config.InputHelmExternalValueFile{
+				S3: "s3://bucket/values.yaml",
+			},
+		},
+		{
+			name: "ssm parameter",
+			file: config.InputHelmExternalValueFile{
+				SSMParameter: "/app/values",
+				Checksum:     "abc",
+			},
+		},
+		{
+			name: "secrets manager secret",
+			file: config.InputHelmExternalValueFile{
+				SecretsManagerSecret: "app-values",
+				Checksum:             "abc",
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, cacheable := externalValueFileCacheKey(tc.file)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.cacheable, cacheable)
+		})
+	}
+}
+
+func TestExternalValueFileSource(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name string
+		file config.InputHelmExternalValueFile
+		want string
+	}{
+		{
+			name: "git",
+			file: config.InputHelmExternalValueFile{
+				Git: &config.InputHelmGitValueFile{
+					GitRemote: "git@github.com:org/config.git",
+					Ref:       "v1.0.0",
+					Path:      "apps/foo/values.yaml",
+				},
+			},
+			want: "git:git@github.com:org/config.git@v1.0.0:apps/foo/values.yaml",
+		},
+		{
+			name: "s3",
+			file: config.InputHelmExternalValueFile{S3: "s3://bucket/values.yaml"},
+			want: "s3://bucket/values.yaml",
+		},
+		{
+			name: "ssm",
+			file: config.InputHelmExternalValueFile{SSMParameter: "/foo/values", Region: "us-west-2"},
+			want: "ssm:us-west-2:/foo/values",
+		},
+		{
+			name: "secrets manager",
+			file: config.InputHelmExternalValueFile{SecretsManagerSecret: "foo-values"},
+			want: "secretsmanager::foo-values",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, externalValueFileSource(tc.file))
+		})
+	}
+}
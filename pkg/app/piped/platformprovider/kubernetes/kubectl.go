@@ -17,11 +17,13 @@ package kubernetes
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os/exec"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/rest"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes/kubernetesmetrics"
@@ -38,6 +40,15 @@ type Kubectl struct {
 	version  string
 	execPath string
 	config   *rest.Config
+	// The user and groups to impersonate while running commands.
+	impersonateUser   string
+	impersonateGroups []string
+	// Whether all manifests are applied by server-side apply.
+	serverSide     bool
+	fieldManager   string
+	forceConflicts bool
+	// Whether all mutating commands are only validated by the API server.
+	dryRun bool
 }
 
 func NewKubectl(version, path string) *Kubectl {
@@ -47,6 +58,65 @@ func NewKubectl(version, path string) *Kubectl {
 	}
 }
 
+// WithImpersonation returns a copy of this Kubectl that runs all commands
+// as the given user and groups.
+func (c *Kubectl) WithImpersonation(user string, groups []string) *Kubectl {
+	cp := *c
+	cp.impersonateUser = user
+	cp.impersonateGroups = groups
+	return &cp
+}
+
+// WithServerSideApply returns a copy of this Kubectl that applies all manifests
+// by server-side apply with the given field manager.
+func (c *Kubectl) WithServerSideApply(fieldManager string, forceConflicts bool) *Kubectl {
+	cp := *c
+	cp.serverSide = true
+	cp.fieldManager = fieldManager
+	cp.forceConflicts = forceConflicts
+	return &cp
+}
+
+// WithServerDryRun returns a copy of this Kubectl that sends all mutating commands
+// with server-side dry-run, so nothing is persisted on the cluster.
+func (c *Kubectl) WithServerDryRun() *Kubectl {
+	cp := *c
+	cp.dryRun = true
+	return &cp
+}
+
+func (c *Kubectl) dryRunArgs() []string {
+	if !c.dryRun {
+		return nil
+	}
+	return []string{"--dry-run=server"}
+}
+
+func (c *Kubectl) serverSideApplyArgs(manifest Manifest) []string {
+	if !c.serverSide && manifest.GetAnnotations()[LabelServerSideApply] != UseServerSideApply {
+		return nil
+	}
+	args := []string{"--server-side"}
+	if c.fieldManager != "" {
+		args = append(args, "--field-manager", c.fieldManager)
+	}
+	if c.forceConflicts {
+		args = append(args, "--force-conflicts")
+	}
+	return args
+}
+
+func (c *Kubectl) impersonationArgs() []string {
+	if c.impersonateUser == "" {
+		return nil
+	}
+	args := []string{"--as", c.impersonateUser}
+	for _, g := range c.impersonateGroups {
+		args = append(args, "--as-group", g)
+	}
+	return args
+}
+
 func (c *Kubectl) Apply(ctx context.Context, kubeconfig, namespace string, manifest Manifest) (err error) {
 	defer func() {
 		kubernetesmetrics.IncKubectlCallsCounter(
@@ -65,14 +135,14 @@ func (c *Kubectl) Apply(ctx context.Context, kubeconfig, namespace string, manif
 	if kubeconfig != "" {
 		args = append(args, "--kubeconfig", kubeconfig)
 	}
+	args = append(args, c.impersonationArgs()...)
 	if namespace != "" {
 		args = append(args, "--namespace", namespace)
 	}
 
 	args = append(args, "apply")
-	if annotation := manifest.GetAnnotations()[LabelServerSideApply]; annotation == UseServerSideApply {
-		args = append(args, "--server-side")
-	}
+	args = append(args, c.serverSideApplyArgs(manifest)...)
+	args = append(args, c.dryRunArgs()...)
 	args = append(args, "-f", "-")
 
 	cmd := exec.CommandContext(ctx, c.execPath, args...)
@@ -104,10 +174,12 @@ func (c *Kubectl) Create(ctx context.Context, kubeconfig, namespace string, mani
 	if kubeconfig != "" {
 		args = append(args, "--kubeconfig", kubeconfig)
 	}
+	args = append(args, c.impersonationArgs()...)
 	if namespace != "" {
 		args = append(args, "--namespace", namespace)
 	}
 	args = append(args, "create", "-f", "-")
+	args = append(args, c.dryRunArgs()...)
 
 	cmd := exec.CommandContext(ctx, c.execPath, args...)
 	r := bytes.NewReader(data)
@@ -138,10 +210,12 @@ func (c *Kubectl) Replace(ctx context.Context, kubeconfig, namespace string, man
 	if kubeconfig != "" {
 		args = append(args, "--kubeconfig", kubeconfig)
 	}
+	args = append(args, c.impersonationArgs()...)
 	if namespace != "" {
 		args = append(args, "--namespace", namespace)
 	}
 	args = append(args, "replace", "-f", "-")
+	args = append(args, c.dryRunArgs()...)
 
 	cmd := exec.CommandContext(ctx, c.execPath, args...)
 	r := bytes.NewReader(data)
@@ -177,10 +251,12 @@ func (c *Kubectl) ForceReplace(ctx context.Context, kubeconfig, namespace string
 	if kubeconfig != "" {
 		args = append(args, "--kubeconfig", kubeconfig)
 	}
+	args = append(args, c.impersonationArgs()...)
 	if namespace != "" {
 		args = append(args, "--namespace", namespace)
 	}
 	args = append(args, "replace", "--force", "-f", "-")
+	args = append(args, c.dryRunArgs()...)
 
 	cmd := exec.CommandContext(ctx, c.execPath, args...)
 	r := bytes.NewReader(data)
@@ -211,10 +287,12 @@ func (c *Kubectl) Delete(ctx context.Context, kubeconfig, namespace string, r Re
 	if kubeconfig != "" {
 		args = append(args, "--kubeconfig", kubeconfig)
 	}
+	args = append(args, c.impersonationArgs()...)
 	if namespace != "" {
 		args = append(args, "--namespace", namespace)
 	}
 	args = append(args, "delete", r.Kind, r.Name)
+	args = append(args, c.dryRunArgs()...)
 
 	cmd := exec.CommandContext(ctx, c.execPath, args...)
 	out, err := cmd.CombinedOutput()
@@ -228,6 +306,33 @@ func (c *Kubectl) Delete(ctx context.Context, kubeconfig, namespace string, r Re
 	return nil
 }
 
+// DeleteBySelector deletes all resources of the given kind matching the given label selector.
+// No error is returned when there is no matching resource.
+func (c *Kubectl) DeleteBySelector(ctx context.Context, kubeconfig, kind, selector string) (err error) {
+	defer func() {
+		kubernetesmetrics.IncKubectlCallsCounter(
+			c.version,
+			kubernetesmetrics.LabelDeleteCommand,
+			err == nil,
+		)
+	}()
+
+	args := make([]string, 0, 7)
+	if kubeconfig != "" {
+		args = append(args, "--kubeconfig", kubeconfig)
+	}
+	args = append(args, c.impersonationArgs()...)
+	args = append(args, "delete", kind, "--selector", selector)
+	args = append(args, c.dryRunArgs()...)
+
+	cmd := exec.CommandContext(ctx, c.execPath, args...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to delete: %s, %v", string(out), err)
+	}
+	return nil
+}
+
 func (c *Kubectl) Get(ctx context.Context, kubeconfig, namespace string, r ResourceKey) (m Manifest, err error) {
 	defer func() {
 		kubernetesmetrics.IncKubectlCallsCounter(
@@ -241,6 +346,7 @@ func (c *Kubectl) Get(ctx context.Context, kubeconfig, namespace string, r Resou
 	if kubeconfig != "" {
 		args = append(args, "--kubeconfig", kubeconfig)
 	}
+	args = append(args, c.impersonationArgs()...)
 	if namespace != "" {
 		args = append(args, "--namespace", namespace)
 	}
@@ -265,12 +371,84 @@ func (c *Kubectl) Get(ctx context.Context, kubeconfig, namespace string, r Resou
 	return ms[0], nil
 }
 
+// GetEvents returns the events of the resources of the given kind in the given namespace.
+func (c *Kubectl) GetEvents(ctx context.Context, kubeconfig, namespace, involvedKind string) (events []corev1.Event, err error) {
+	defer func() {
+		kubernetesmetrics.IncKubectlCallsCounter(
+			c.version,
+			kubernetesmetrics.LabelGetCommand,
+			err == nil,
+		)
+	}()
+
+	args := make([]string, 0, 9)
+	if kubeconfig != "" {
+		args = append(args, "--kubeconfig", kubeconfig)
+	}
+	args = append(args, c.impersonationArgs()...)
+	if namespace != "" {
+		args = append(args, "--namespace", namespace)
+	}
+	args = append(args, "get", "events", "--field-selector", "involvedObject.kind="+involvedKind, "-o", "json")
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.execPath, args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %s, %v", stderr.String(), err)
+	}
+
+	var list corev1.EventList
+	if err := json.Unmarshal(out, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse events: %v", err)
+	}
+	return list.Items, nil
+}
+
+// GetPods returns the pods matching the given label selector in the given namespace.
+func (c *Kubectl) GetPods(ctx context.Context, kubeconfig, namespace, selector string) (pods []corev1.Pod, err error) {
+	defer func() {
+		kubernetesmetrics.IncKubectlCallsCounter(
+			c.version,
+			kubernetesmetrics.LabelGetCommand,
+			err == nil,
+		)
+	}()
+
+	args := make([]string, 0, 9)
+	if kubeconfig != "" {
+		args = append(args, "--kubeconfig", kubeconfig)
+	}
+	args = append(args, c.impersonationArgs()...)
+	if namespace != "" {
+		args = append(args, "--namespace", namespace)
+	}
+	args = append(args, "get", "pods", "--selector", selector, "-o", "json")
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.execPath, args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pods: %s, %v", stderr.String(), err)
+	}
+
+	var list corev1.PodList
+	if err := json.Unmarshal(out, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse pods: %v", err)
+	}
+	return list.Items, nil
+}
+
 func (c *Kubectl) CreateNamespace(ctx context.Context, kubeconfig, namespace string) (err error) {
 	args := make([]string, 0, 7)
 	if kubeconfig != "" {
 		args = append(args, "--kubeconfig", kubeconfig)
 	}
+	args = append(args, c.impersonationArgs()...)
 	args = append(args, "create", "namespace", namespace)
+	args = append(args, c.dryRunArgs()...)
 
 	cmd := exec.CommandContext(ctx, c.execPath, args...)
 	out, err := cmd.CombinedOutput()
@@ -0,0 +1,87 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKubectlImpersonationArgs(t *testing.T) {
+	t.Parallel()
+
+	kubectl := NewKubectl("1.30.0", "kubectl")
+	assert.Empty(t, kubectl.impersonationArgs())
+
+	impersonated := kubectl.WithImpersonation("system:serviceaccount:team-a:deployer", []string{"team-a", "deployers"})
+	assert.Equal(t, []string{
+		"--as", "system:serviceaccount:team-a:deployer",
+		"--as-group", "team-a",
+		"--as-group", "deployers",
+	}, impersonated.impersonationArgs())
+
+	// The original one must not be changed.
+	assert.Empty(t, kubectl.impersonationArgs())
+}
+
+func TestKubectlServerSideApplyArgs(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := ParseManifests(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: plain
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: annotated
+  annotations:
+    pipecd.dev/server-side-apply: "true"
+`)
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+	plain, annotated := manifests[0], manifests[1]
+
+	kubectl := NewKubectl("1.30.0", "kubectl")
+	assert.Empty(t, kubectl.serverSideApplyArgs(plain))
+	assert.Equal(t, []string{"--server-side"}, kubectl.serverSideApplyArgs(annotated))
+
+	ssa := kubectl.WithServerSideApply("pipecd", true)
+	want := []string{"--server-side", "--field-manager", "pipecd", "--force-conflicts"}
+	assert.Equal(t, want, ssa.serverSideApplyArgs(plain))
+	assert.Equal(t, want, ssa.serverSideApplyArgs(annotated))
+
+	assert.Equal(t, []string{"--server-side"}, kubectl.WithServerSideApply("", false).serverSideApplyArgs(plain))
+
+	// The original one must not be changed.
+	assert.Empty(t, kubectl.serverSideApplyArgs(plain))
+}
+
+func TestKubectlDryRunArgs(t *testing.T) {
+	t.Parallel()
+
+	kubectl := NewKubectl("1.30.0", "kubectl")
+	assert.Empty(t, kubectl.dryRunArgs())
+
+	dryRun := kubectl.WithServerDryRun()
+	assert.Equal(t, []string{"--dry-run=server"}, dryRun.dryRunArgs())
+
+	// The original one must not be changed.
+	assert.Empty(t, kubectl.dryRunArgs())
+}
@@ -35,11 +35,15 @@ const (
 	LabelServerSideApply      = "pipecd.dev/server-side-apply"      // Use server side apply instead of client side apply.
 	AnnotationConfigHash      = "pipecd.dev/config-hash"            // The hash value of all mouting config resources.
 	AnnotationOrder           = "pipecd.dev/order"                  // The order number of resource used to sort them before using.
+	AnnotationRolloutOverride = "pipecd.dev/rollout-override"       // The comma-separated rolling update fields overridden by the stage options.
+	LabelNamespaceOwner       = "pipecd.dev/namespace-owner"        // The application managing the lifecycle of this namespace.
+	LabelDeleteOnAppDeletion  = "pipecd.dev/delete-on-app-deletion" // Whether this namespace should be deleted together with its owner application.
 
 	ManagedByPiped           = "piped"
 	IgnoreDriftDetectionTrue = "true"
 	UseReplaceEnabled        = "enabled"
 	UseServerSideApply       = "true"
+	DeleteOnAppDeletionTrue  = "true"
 
 	kustomizationFileName = "kustomization.yaml"
 )
@@ -15,6 +15,7 @@ import (
 
 	kubernetes "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
 	gomock "go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
 )
 
 // MockApplier is a mock of Applier interface.
@@ -97,6 +98,65 @@ func (mr *MockApplierMockRecorder) ForceReplaceManifest(ctx, manifest any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceReplaceManifest", reflect.TypeOf((*MockApplier)(nil).ForceReplaceManifest), ctx, manifest)
 }
 
+// DeletePod mocks base method.
+func (m *MockApplier) DeletePod(ctx context.Context, pod kubernetes.ResourceKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePod", ctx, pod)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePod indicates an expected call of DeletePod.
+func (mr *MockApplierMockRecorder) DeletePod(ctx, pod any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePod", reflect.TypeOf((*MockApplier)(nil).DeletePod), ctx, pod)
+}
+
+// GetManifest mocks base method.
+func (m *MockApplier) GetManifest(ctx context.Context, key kubernetes.ResourceKey) (kubernetes.Manifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManifest", ctx, key)
+	ret0, _ := ret[0].(kubernetes.Manifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManifest indicates an expected call of GetManifest.
+func (mr *MockApplierMockRecorder) GetManifest(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManifest", reflect.TypeOf((*MockApplier)(nil).GetManifest), ctx, key)
+}
+
+// ListPodEvents mocks base method.
+func (m *MockApplier) ListPodEvents(ctx context.Context, workload kubernetes.ResourceKey) ([]v1.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPodEvents", ctx, workload)
+	ret0, _ := ret[0].([]v1.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPodEvents indicates an expected call of ListPodEvents.
+func (mr *MockApplierMockRecorder) ListPodEvents(ctx, workload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPodEvents", reflect.TypeOf((*MockApplier)(nil).ListPodEvents), ctx, workload)
+}
+
+// ListPods mocks base method.
+func (m *MockApplier) ListPods(ctx context.Context, workload kubernetes.ResourceKey, selector map[string]string) ([]v1.Pod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPods", ctx, workload, selector)
+	ret0, _ := ret[0].([]v1.Pod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPods indicates an expected call of ListPods.
+func (mr *MockApplierMockRecorder) ListPods(ctx, workload, selector any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPods", reflect.TypeOf((*MockApplier)(nil).ListPods), ctx, workload, selector)
+}
+
 // ReplaceManifest mocks base method.
 func (m *MockApplier) ReplaceManifest(ctx context.Context, manifest kubernetes.Manifest) error {
 	m.ctrl.T.Helper()
@@ -111,6 +171,20 @@ func (mr *MockApplierMockRecorder) ReplaceManifest(ctx, manifest any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceManifest", reflect.TypeOf((*MockApplier)(nil).ReplaceManifest), ctx, manifest)
 }
 
+// WaitForCRDReady mocks base method.
+func (m *MockApplier) WaitForCRDReady(ctx context.Context, key kubernetes.ResourceKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForCRDReady", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForCRDReady indicates an expected call of WaitForCRDReady.
+func (mr *MockApplierMockRecorder) WaitForCRDReady(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForCRDReady", reflect.TypeOf((*MockApplier)(nil).WaitForCRDReady), ctx, key)
+}
+
 // MockLoader is a mock of Loader interface.
 type MockLoader struct {
 	ctrl     *gomock.Controller
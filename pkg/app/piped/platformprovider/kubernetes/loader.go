@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"sort"
 	"strconv"
@@ -89,6 +90,9 @@ func (l *loader) LoadManifests(ctx context.Context) (manifests []Manifest, err e
 		// if namespace is not explicitly specified in the manifests.
 		setNamespace(manifests, l.input.Namespace)
 		sortManifests(manifests)
+		if err == nil && l.input.ConfigHash {
+			err = AnnotateConfigHash(manifests)
+		}
 	}()
 	l.initOnce.Do(func() {
 		var initErrorHelm, initErrorKustomize error
@@ -105,6 +109,23 @@ func (l *loader) LoadManifests(ctx context.Context) (manifests []Manifest, err e
 
 	switch l.templatingMethod {
 	case TemplatingMethodHelm:
+		helm := l.helm
+		if opts := l.input.HelmOptions; opts != nil && len(opts.ExternalValueFiles) > 0 {
+			dir, e := os.MkdirTemp("", "helm-external-values")
+			if e != nil {
+				err = fmt.Errorf("unable to create temporary directory for storing external values files: %w", e)
+				return
+			}
+			defer os.RemoveAll(dir)
+
+			files, e := fetchExternalValueFiles(ctx, dir, opts.ExternalValueFiles, newExternalValueFetcher(l.gc), l.logger)
+			if e != nil {
+				err = e
+				return
+			}
+			helm = helm.withExternalValueFiles(files)
+		}
+
 		var data string
 		switch {
 		case l.input.HelmChart.GitRemote != "":
@@ -113,7 +134,7 @@ func (l *loader) LoadManifests(ctx context.Context) (manifests []Manifest, err e
 				Ref:       l.input.HelmChart.Ref,
 				Path:      l.input.HelmChart.Path,
 			}
-			data, err = l.helm.TemplateRemoteGitChart(ctx,
+			data, err = helm.TemplateRemoteGitChart(ctx,
 				l.appName,
 				l.appDir,
 				l.input.Namespace,
@@ -128,7 +149,7 @@ func (l *loader) LoadManifests(ctx context.Context) (manifests []Manifest, err e
 				Version:    l.input.HelmChart.Version,
 				Insecure:   l.input.HelmChart.Insecure,
 			}
-			data, err = l.helm.TemplateRemoteChart(ctx,
+			data, err = helm.TemplateRemoteChart(ctx,
 				l.appName,
 				l.appDir,
 				l.input.Namespace,
@@ -136,7 +157,7 @@ func (l *loader) LoadManifests(ctx context.Context) (manifests []Manifest, err e
 				l.input.HelmOptions)
 
 		default:
-			data, err = l.helm.TemplateLocalChart(ctx,
+			data, err = helm.TemplateLocalChart(ctx,
 				l.appName,
 				l.appDir,
 				l.input.Namespace,
@@ -148,6 +169,13 @@ func (l *loader) LoadManifests(ctx context.Context) (manifests []Manifest, err e
 			err = fmt.Errorf("unable to run helm template: %w", err)
 			return
 		}
+		if opts := l.input.HelmOptions; opts != nil && opts.PostRenderer != nil {
+			data, err = l.postRenderHelmOutput(ctx, data, opts.PostRenderer)
+			if err != nil {
+				err = fmt.Errorf("unable to post-render helm output: %w", err)
+				return
+			}
+		}
 		manifests, err = ParseManifests(data)
 
 	case TemplatingMethodKustomize:
@@ -169,6 +197,28 @@ func (l *loader) LoadManifests(ctx context.Context) (manifests []Manifest, err e
 	return
 }
 
+// postRenderHelmOutput runs the configured kustomization over the rendered chart.
+// The application directory is copied into a temporary directory
+// so that the rendered file is never written into the original one.
+func (l *loader) postRenderHelmOutput(ctx context.Context, data string, r *config.InputHelmPostRenderer) (string, error) {
+	dir, err := os.MkdirTemp("", "helm-post-renderer")
+	if err != nil {
+		return "", fmt.Errorf("unable to create temporary directory for post-rendering: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	appDir := filepath.Join(dir, "app")
+	if out, err := exec.Command("cp", "-rf", l.appDir, appDir).CombinedOutput(); err != nil {
+		return "", fmt.Errorf("failed to copy %s to %s (%w, %s)", l.appDir, appDir, err, string(out))
+	}
+
+	kustomizeDir := filepath.Join(appDir, r.KustomizeDir)
+	if err := os.WriteFile(filepath.Join(kustomizeDir, r.RenderedFile), []byte(data), 0644); err != nil {
+		return "", fmt.Errorf("unable to write the rendered chart into %s: %w", r.KustomizeDir, err)
+	}
+	return l.kustomize.Template(ctx, l.appName, kustomizeDir, l.input.KustomizeOptions, l.helm)
+}
+
 func setNamespace(manifests []Manifest, namespace string) {
 	if namespace == "" {
 		return
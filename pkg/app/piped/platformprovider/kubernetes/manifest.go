@@ -115,6 +115,17 @@ func (m Manifest) AddStringMapValues(values map[string]string, fields ...string)
 	return unstructured.SetNestedStringMap(m.u.Object, curMap, fields...)
 }
 
+// SetNestedStringMap replaces the string map that can be found at the specified fields with the given one.
+func (m Manifest) SetNestedStringMap(value map[string]string, fields ...string) error {
+	return unstructured.SetNestedStringMap(m.u.Object, value, fields...)
+}
+
+// SetNestedField sets the given value at the specified fields.
+// The value must be a JSON-compatible type such as int64, string or map[string]interface{}.
+func (m Manifest) SetNestedField(value interface{}, fields ...string) error {
+	return unstructured.SetNestedField(m.u.Object, value, fields...)
+}
+
 func (m Manifest) GetSpec() (interface{}, error) {
 	spec, ok, err := unstructured.NestedFieldNoCopy(m.u.Object, "spec")
 	if err != nil {
@@ -0,0 +1,40 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/toolregistry"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// DeleteManagedNamespaces deletes all namespaces owned by the given application
+// that were marked to be deleted together with their owner application.
+func DeleteManagedNamespaces(ctx context.Context, cp config.PlatformProviderKubernetesConfig, appID string) error {
+	path, _, err := toolregistry.DefaultRegistry().Kubectl(ctx, cp.KubectlVersion)
+	if err != nil {
+		return fmt.Errorf("no kubectl %s (%v)", cp.KubectlVersion, err)
+	}
+	kubectl := NewKubectl(cp.KubectlVersion, path)
+	return kubectl.DeleteBySelector(ctx, cp.KubeConfigPath, "namespace", managedNamespaceSelector(appID))
+}
+
+// managedNamespaceSelector returns the label selector matching the namespaces
+// which should be deleted together with the given application.
+func managedNamespaceSelector(appID string) string {
+	return fmt.Sprintf("%s=%s,%s=%s", LabelNamespaceOwner, appID, LabelDeleteOnAppDeletion, DeleteOnAppDeletionTrue)
+}
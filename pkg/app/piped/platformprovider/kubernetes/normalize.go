@@ -0,0 +1,310 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// builtinNormalizationRules are applied to all manifests before comparing them
+// to avoid reporting the differences caused by the values populated by the API server
+// or written in different but equivalent formats.
+var builtinNormalizationRules = makeBuiltinNormalizationRules()
+
+func makeBuiltinNormalizationRules() []config.DiffNormalizationRule {
+	var (
+		rules      []config.DiffNormalizationRule
+		addDefault = func(resource, path string, value interface{}) {
+			rules = append(rules, config.DiffNormalizationRule{Resource: resource, Path: path, Default: value})
+		}
+		addQuantity = func(resource, path string) {
+			rules = append(rules, config.DiffNormalizationRule{Resource: resource, Path: path, Quantity: true})
+		}
+	)
+
+	podSpecs := []struct {
+		kind string
+		path string
+	}{
+		{KindPod, "spec"},
+		{KindDeployment, "spec.template.spec"},
+		{KindStatefulSet, "spec.template.spec"},
+		{KindDaemonSet, "spec.template.spec"},
+		{KindReplicaSet, "spec.template.spec"},
+		{KindJob, "spec.template.spec"},
+		{KindCronJob, "spec.jobTemplate.spec.template.spec"},
+	}
+	for _, ps := range podSpecs {
+		r, podSpec := fmt.Sprintf("*:%s:*:*", ps.kind), ps.path
+		addDefault(r, podSpec+".restartPolicy", "Always")
+		addDefault(r, podSpec+".dnsPolicy", "ClusterFirst")
+		addDefault(r, podSpec+".schedulerName", "default-scheduler")
+		addDefault(r, podSpec+".terminationGracePeriodSeconds", 30)
+		addDefault(r, podSpec+".volumes.*.configMap.defaultMode", 420)
+		addDefault(r, podSpec+".volumes.*.secret.defaultMode", 420)
+		addQuantity(r, podSpec+".volumes.*.emptyDir.sizeLimit")
+
+		for _, containers := range []string{"containers", "initContainers"} {
+			c := podSpec + "." + containers + ".*"
+			addDefault(r, c+".terminationMessagePath", "/dev/termination-log")
+			addDefault(r, c+".terminationMessagePolicy", "File")
+			addDefault(r, c+".ports.*.protocol", "TCP")
+			for _, probe := range []string{"livenessProbe", "readinessProbe", "startupProbe"} {
+				addDefault(r, c+"."+probe+".timeoutSeconds", 1)
+				addDefault(r, c+"."+probe+".periodSeconds", 10)
+				addDefault(r, c+"."+probe+".successThreshold", 1)
+				addDefault(r, c+"."+probe+".failureThreshold", 3)
+				addDefault(r, c+"."+probe+".httpGet.scheme", "HTTP")
+			}
+			addQuantity(r, c+".resources.limits.*")
+			addQuantity(r, c+".resources.requests.*")
+			// The order of env is not normalized since an env var can refer to
+			// the ones defined before it by $(VAR_NAME).
+		}
+	}
+
+	deployment := fmt.Sprintf("*:%s:*:*", KindDeployment)
+	addDefault(deployment, "spec.revisionHistoryLimit", 10)
+	addDefault(deployment, "spec.progressDeadlineSeconds", 600)
+	addDefault(deployment, "spec.strategy.rollingUpdate.maxSurge", "25%")
+	addDefault(deployment, "spec.strategy.rollingUpdate.maxUnavailable", "25%")
+
+	service := fmt.Sprintf("*:%s:*:*", KindService)
+	addDefault(service, "spec.type", "ClusterIP")
+	addDefault(service, "spec.sessionAffinity", "None")
+	addDefault(service, "spec.ports.*.protocol", "TCP")
+
+	addQuantity(fmt.Sprintf("*:%s:*:*", KindPersistentVolumeClaim), "spec.resources.requests.*")
+	addQuantity(fmt.Sprintf("*:%s:*:*", KindPersistentVolume), "spec.capacity.*")
+	addQuantity("*:ResourceQuota:*:*", "spec.hard.*")
+	for _, field := range []string{"max", "min", "default", "defaultRequest", "maxLimitRequestRatio"} {
+		addQuantity("*:LimitRange:*:*", "spec.limits.*."+field+".*")
+	}
+
+	return rules
+}
+
+// NormalizeManifests returns the copies of the given manifests
+// which the given normalization rules were applied to.
+func NormalizeManifests(manifests []Manifest, rules []config.DiffNormalizationRule) []Manifest {
+	if len(rules) == 0 {
+		return manifests
+	}
+	out := make([]Manifest, 0, len(manifests))
+	for _, m := range manifests {
+		out = append(out, Manifest{
+			Key: m.Key,
+			u:   normalizeUnstructured(m.Key, m.u, rules),
+		})
+	}
+	return out
+}
+
+// normalizeUnstructured returns a copy of the given object which the given rules were applied to.
+func normalizeUnstructured(key ResourceKey, u *unstructured.Unstructured, rules []config.DiffNormalizationRule) *unstructured.Unstructured {
+	u = &unstructured.Unstructured{Object: deepCopyObject(u.Object)}
+	for _, r := range rules {
+		if !matchResource(r.Resource, key) {
+			continue
+		}
+		steps := strings.Split(r.Path, ".")
+		switch {
+		case r.Default != nil:
+			walkFields(u.Object, steps, func(parent map[string]interface{}, field string) {
+				if equalJSON(parent[field], r.Default) {
+					delete(parent, field)
+				}
+			})
+		case r.Quantity:
+			walkFields(u.Object, steps, func(parent map[string]interface{}, field string) {
+				if q, ok := parseQuantity(parent[field]); ok {
+					parent[field] = q.String()
+				}
+			})
+		case r.SortByKey != "":
+			walkFields(u.Object, steps, func(parent map[string]interface{}, field string) {
+				sortListByKey(parent[field], r.SortByKey)
+			})
+		}
+	}
+	if key.IsSecret() {
+		normalizeSecretData(u)
+	}
+	return u
+}
+
+// deepCopyObject copies the maps and lists of the given object recursively.
+// Unlike runtime.DeepCopyJSON, it accepts any scalar type because the objects
+// which failed to be remarshaled may contain non-JSON types such as int.
+func deepCopyObject(obj map[string]interface{}) map[string]interface{} {
+	if obj == nil {
+		return nil
+	}
+	return deepCopyValue(obj).(map[string]interface{})
+}
+
+func deepCopyValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, v := range t {
+			out[k] = deepCopyValue(v)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, v := range t {
+			out[i] = deepCopyValue(v)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// matchResource reports whether the given key matches the pattern
+// in the form of 'apiVersion:kind:namespace:name' where each part can be '*'.
+func matchResource(pattern string, key ResourceKey) bool {
+	if pattern == "" {
+		return true
+	}
+	parts := strings.Split(pattern, ":")
+	if len(parts) != 4 {
+		return false
+	}
+	for i, v := range []string{key.APIVersion, key.Kind, key.Namespace, key.Name} {
+		if parts[i] != "*" && parts[i] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// walkFields calls fn for all existing fields matching the given path steps.
+// A '*' step matches any map key or list index.
+func walkFields(obj interface{}, steps []string, fn func(parent map[string]interface{}, field string)) {
+	if len(steps) == 0 {
+		return
+	}
+	step, rest := steps[0], steps[1:]
+
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		for k, v := range o {
+			if step != "*" && step != k {
+				continue
+			}
+			if len(rest) == 0 {
+				fn(o, k)
+				continue
+			}
+			walkFields(v, rest, fn)
+		}
+	case []interface{}:
+		for i, v := range o {
+			if step != "*" && step != strconv.Itoa(i) {
+				continue
+			}
+			walkFields(v, rest, fn)
+		}
+	}
+}
+
+func equalJSON(x, y interface{}) bool {
+	bx, err := json.Marshal(x)
+	if err != nil {
+		return false
+	}
+	by, err := json.Marshal(y)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(bx, by)
+}
+
+func parseQuantity(v interface{}) (resource.Quantity, bool) {
+	var s string
+	switch t := v.(type) {
+	case string:
+		s = t
+	case int, int32, int64, float64:
+		s = fmt.Sprint(t)
+	default:
+		return resource.Quantity{}, false
+	}
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return resource.Quantity{}, false
+	}
+	return q, true
+}
+
+// sortListByKey stably sorts the given list of maps by the string value of the given key.
+func sortListByKey(v interface{}, key string) {
+	list, ok := v.([]interface{})
+	if !ok {
+		return
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return listItemKey(list[i], key) < listItemKey(list[j], key)
+	})
+}
+
+func listItemKey(item interface{}, key string) string {
+	m, ok := item.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	return fmt.Sprint(m[key])
+}
+
+// normalizeSecretData re-encodes the values of Secret data in the standard base64 encoding
+// so that the values decoded to the same bytes are treated as equal.
+func normalizeSecretData(u *unstructured.Unstructured) {
+	data, ok := u.Object["data"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for k, v := range data {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		if decoded, ok := decodeBase64(s); ok {
+			data[k] = base64.StdEncoding.EncodeToString(decoded)
+		}
+	}
+}
+
+func decodeBase64(s string) ([]byte, bool) {
+	s = strings.Join(strings.Fields(s), "")
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding} {
+		if b, err := enc.DecodeString(s); err == nil {
+			return b, true
+		}
+	}
+	return nil, false
+}
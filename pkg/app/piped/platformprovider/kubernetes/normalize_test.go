@@ -0,0 +1,398 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/diff"
+)
+
+func TestDiffWithBuiltinNormalization(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		manifests string
+	}{
+		{
+			name: "equivalent cpu quantities",
+			manifests: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      containers:
+      - name: helloworld
+        resources:
+          requests:
+            cpu: 100m
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      containers:
+      - name: helloworld
+        resources:
+          requests:
+            cpu: "0.1"
+`,
+		},
+		{
+			name: "server default values",
+			manifests: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  revisionHistoryLimit: 10
+  template:
+    spec:
+      restartPolicy: Always
+      containers:
+      - name: helloworld
+        terminationMessagePath: /dev/termination-log
+        ports:
+        - containerPort: 8080
+          protocol: TCP
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      containers:
+      - name: helloworld
+        ports:
+        - containerPort: 8080
+`,
+		},
+		{
+			name: "secret data without padding",
+			manifests: `apiVersion: v1
+kind: Secret
+metadata:
+  name: secret
+data:
+  foo: YQ==
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: secret
+data:
+  foo: YQ
+`,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := ParseManifests(tc.manifests)
+			require.NoError(t, err)
+			require.Equal(t, 2, len(manifests))
+
+			result, err := Diff(manifests[0], manifests[1], zap.NewNop(), diff.WithEquateEmpty())
+			require.NoError(t, err)
+			assert.Equal(t, 0, result.NumNodes())
+		})
+	}
+}
+
+func TestDiffWithBuiltinNormalizationKeepsRealChanges(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := ParseManifests(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      restartPolicy: OnFailure
+      containers:
+      - name: helloworld
+        resources:
+          requests:
+            cpu: 100m
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      containers:
+      - name: helloworld
+        resources:
+          requests:
+            cpu: 200m
+`)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(manifests))
+
+	result, err := Diff(manifests[0], manifests[1], zap.NewNop(), diff.WithEquateEmpty())
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.NumNodes())
+
+	// The original manifests must not be modified.
+	assert.Equal(t, "OnFailure", manifests[0].u.Object["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"].(map[string]interface{})["restartPolicy"])
+}
+
+func TestDiffWithBuiltinNormalizationKeepsEnvOrder(t *testing.T) {
+	t.Parallel()
+
+	// The order of env matters since an env var can refer to the ones defined before it.
+	manifests, err := ParseManifests(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      containers:
+      - name: helloworld
+        env:
+        - name: B
+          value: $(A)-b
+        - name: A
+          value: a
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      containers:
+      - name: helloworld
+        env:
+        - name: A
+          value: a
+        - name: B
+          value: $(A)-b
+`)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(manifests))
+
+	result, err := Diff(manifests[0], manifests[1], zap.NewNop(), diff.WithEquateEmpty())
+	require.NoError(t, err)
+	assert.True(t, result.HasDiff())
+}
+
+func TestNormalizeManifests(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		rules    []config.DiffNormalizationRule
+		live     string
+		head     string
+		expected int
+	}{
+		{
+			name: "no rules",
+			live: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      containers:
+      - name: helloworld
+        imagePullPolicy: IfNotPresent
+`,
+			head: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      containers:
+      - name: helloworld
+`,
+			expected: 1,
+		},
+		{
+			name: "default value",
+			rules: []config.DiffNormalizationRule{
+				{
+					Resource: "apps/v1:Deployment:*:*",
+					Path:     "spec.template.spec.containers.*.imagePullPolicy",
+					Default:  "IfNotPresent",
+				},
+			},
+			live: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      containers:
+      - name: helloworld
+        imagePullPolicy: IfNotPresent
+`,
+			head: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      containers:
+      - name: helloworld
+`,
+			expected: 0,
+		},
+		{
+			name: "rule for another resource",
+			rules: []config.DiffNormalizationRule{
+				{
+					Resource: "apps/v1:Deployment:*:another",
+					Path:     "spec.template.spec.containers.*.imagePullPolicy",
+					Default:  "IfNotPresent",
+				},
+			},
+			live: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      containers:
+      - name: helloworld
+        imagePullPolicy: IfNotPresent
+`,
+			head: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      containers:
+      - name: helloworld
+`,
+			expected: 1,
+		},
+		{
+			name: "quantity",
+			rules: []config.DiffNormalizationRule{
+				{
+					Path:     "spec.template.metadata.annotations.*",
+					Quantity: true,
+				},
+			},
+			live: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    metadata:
+      annotations:
+        example.com/memory: 1024Mi
+`,
+			head: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    metadata:
+      annotations:
+        example.com/memory: 1Gi
+`,
+			expected: 0,
+		},
+		{
+			name: "sort by key",
+			rules: []config.DiffNormalizationRule{
+				{
+					Path:      "spec.template.spec.volumes",
+					SortByKey: "name",
+				},
+			},
+			live: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      volumes:
+      - name: b
+        emptyDir: {}
+      - name: a
+        emptyDir: {}
+`,
+			head: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      volumes:
+      - name: a
+        emptyDir: {}
+      - name: b
+        emptyDir: {}
+`,
+			expected: 0,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			live, err := ParseManifests(tc.live)
+			require.NoError(t, err)
+			head, err := ParseManifests(tc.head)
+			require.NoError(t, err)
+
+			live = NormalizeManifests(live, tc.rules)
+			head = NormalizeManifests(head, tc.rules)
+
+			result, err := diff.DiffUnstructureds(*head[0].u, *live[0].u, head[0].Key.String())
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result.NumNodes())
+		})
+	}
+}
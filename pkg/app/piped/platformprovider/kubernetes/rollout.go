@@ -0,0 +1,113 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// RolloutStatus represents how far the rollout of a workload has progressed.
+type RolloutStatus struct {
+	// Whether all replicas were updated and became available.
+	Done bool
+	// Whether the rollout can no longer progress without any intervention,
+	// e.g. the progress deadline of the Deployment was exceeded.
+	Stalled bool
+	// The summary of the replica counts, e.g. "updated 2/3, ready 2/3, available 1/3".
+	Progress string
+	// The reason why the rollout has not been done yet.
+	Reason string
+}
+
+// IsRolloutWorkload checks whether the rollout of the given resource can be tracked by DetermineRolloutStatus.
+func IsRolloutWorkload(key ResourceKey) bool {
+	if !IsKubernetesBuiltInResource(key.APIVersion) {
+		return false
+	}
+	switch key.Kind {
+	case KindDeployment, KindStatefulSet, KindDaemonSet:
+		return true
+	}
+	return false
+}
+
+// DetermineRolloutStatus determines the rollout status of the given live workload
+// in the same way as "kubectl rollout status".
+func DetermineRolloutStatus(m Manifest) (RolloutStatus, error) {
+	if !IsRolloutWorkload(m.Key) {
+		return RolloutStatus{}, fmt.Errorf("unsupported workload %s", m.Key.ReadableString())
+	}
+
+	var health model.KubernetesResourceState_HealthStatus
+	s := RolloutStatus{}
+	switch m.Key.Kind {
+	case KindDeployment:
+		health, s.Reason = determineDeploymentHealth(m.u)
+		desired := nestedInt64OrDefault(m.u, 1, "spec", "replicas")
+		s.Progress = fmt.Sprintf("updated %d/%d, ready %d/%d, available %d/%d",
+			nestedInt64OrDefault(m.u, 0, "status", "updatedReplicas"), desired,
+			nestedInt64OrDefault(m.u, 0, "status", "readyReplicas"), desired,
+			nestedInt64OrDefault(m.u, 0, "status", "availableReplicas"), desired,
+		)
+		s.Stalled = isDeploymentProgressDeadlineExceeded(m.u)
+	case KindStatefulSet:
+		health, s.Reason = determineStatefulSetHealth(m.u)
+		desired := nestedInt64OrDefault(m.u, 1, "spec", "replicas")
+		s.Progress = fmt.Sprintf("updated %d/%d, ready %d/%d",
+			nestedInt64OrDefault(m.u, 0, "status", "updatedReplicas"), desired,
+			nestedInt64OrDefault(m.u, 0, "status", "readyReplicas"), desired,
+		)
+	case KindDaemonSet:
+		health, s.Reason = determineDaemonSetHealth(m.u)
+		desired := nestedInt64OrDefault(m.u, 0, "status", "desiredNumberScheduled")
+		s.Progress = fmt.Sprintf("updated %d/%d, ready %d/%d, available %d/%d",
+			nestedInt64OrDefault(m.u, 0, "status", "updatedNumberScheduled"), desired,
+			nestedInt64OrDefault(m.u, 0, "status", "numberReady"), desired,
+			nestedInt64OrDefault(m.u, 0, "status", "numberAvailable"), desired,
+		)
+	}
+	s.Done = health == model.KubernetesResourceState_HEALTHY
+	if s.Done {
+		s.Stalled = false
+		s.Reason = ""
+	}
+	return s, nil
+}
+
+func isDeploymentProgressDeadlineExceeded(u *unstructured.Unstructured) bool {
+	conds, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	for _, c := range conds {
+		cond, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if cond["type"] == "Progressing" && cond["reason"] == "ProgressDeadlineExceeded" {
+			return true
+		}
+	}
+	return false
+}
+
+func nestedInt64OrDefault(u *unstructured.Unstructured, def int64, fields ...string) int64 {
+	v, found, err := unstructured.NestedInt64(u.Object, fields...)
+	if err != nil || !found {
+		return def
+	}
+	return v
+}
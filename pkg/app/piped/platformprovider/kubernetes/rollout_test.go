@@ -0,0 +1,169 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetermineRolloutStatus(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		manifest string
+		want     RolloutStatus
+		wantErr  bool
+	}{
+		{
+			name: "unsupported kind",
+			manifest: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: foo
+`,
+			wantErr: true,
+		},
+		{
+			name: "deployment in progress",
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: foo
+  generation: 2
+spec:
+  replicas: 3
+status:
+  observedGeneration: 2
+  replicas: 4
+  updatedReplicas: 2
+  readyReplicas: 3
+  availableReplicas: 3
+`,
+			want: RolloutStatus{
+				Progress: "updated 2/3, ready 3/3, available 3/3",
+				Reason:   "Waiting for remaining 2/3 replicas to be updated",
+			},
+		},
+		{
+			name: "deployment exceeded its progress deadline",
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: foo
+  generation: 2
+spec:
+  replicas: 1
+status:
+  observedGeneration: 2
+  replicas: 2
+  updatedReplicas: 1
+  readyReplicas: 1
+  availableReplicas: 1
+  conditions:
+  - type: Progressing
+    status: "False"
+    reason: ProgressDeadlineExceeded
+`,
+			want: RolloutStatus{
+				Stalled:  true,
+				Progress: "updated 1/1, ready 1/1, available 1/1",
+				Reason:   "1 old replicas are pending termination",
+			},
+		},
+		{
+			name: "deployment done",
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: foo
+  generation: 2
+spec:
+  replicas: 2
+status:
+  observedGeneration: 2
+  replicas: 2
+  updatedReplicas: 2
+  readyReplicas: 2
+  availableReplicas: 2
+`,
+			want: RolloutStatus{
+				Done:     true,
+				Progress: "updated 2/2, ready 2/2, available 2/2",
+			},
+		},
+		{
+			name: "statefulset in progress",
+			manifest: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: foo
+  generation: 1
+spec:
+  replicas: 3
+status:
+  observedGeneration: 1
+  updatedReplicas: 1
+  readyReplicas: 2
+`,
+			want: RolloutStatus{
+				Progress: "updated 1/3, ready 2/3",
+				Reason:   "The number of ready replicas (2) is different from the desired number (3)",
+			},
+		},
+		{
+			name: "daemonset done",
+			manifest: `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: foo
+  generation: 1
+status:
+  observedGeneration: 1
+  desiredNumberScheduled: 2
+  updatedNumberScheduled: 2
+  numberReady: 2
+  numberAvailable: 2
+`,
+			want: RolloutStatus{
+				Done:     true,
+				Progress: "updated 2/2, ready 2/2, available 2/2",
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := ParseManifests(tc.manifest)
+			require.NoError(t, err)
+			require.Len(t, manifests, 1)
+
+			got, err := DetermineRolloutStatus(manifests[0])
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -30,12 +30,14 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
-func MakeKubernetesResourceState(uid string, key ResourceKey, obj *unstructured.Unstructured, now time.Time) model.KubernetesResourceState {
+// MakeKubernetesResourceState builds the state of the given resource.
+// The health status of the custom resources is determined by the given rules if any.
+func MakeKubernetesResourceState(uid string, key ResourceKey, obj *unstructured.Unstructured, rules *HealthRules, now time.Time) model.KubernetesResourceState {
 	var (
 		owners       = obj.GetOwnerReferences()
 		ownerIDs     = make([]string, 0, len(owners))
 		creationTime = obj.GetCreationTimestamp()
-		status, desc = determineResourceHealth(key, obj)
+		status, desc = determineResourceHealth(key, obj, rules)
 	)
 
 	for _, owner := range owners {
@@ -63,8 +65,14 @@ func MakeKubernetesResourceState(uid string, key ResourceKey, obj *unstructured.
 	return state
 }
 
-func determineResourceHealth(key ResourceKey, obj *unstructured.Unstructured) (status model.KubernetesResourceState_HealthStatus, desc string) {
+func determineResourceHealth(key ResourceKey, obj *unstructured.Unstructured, rules *HealthRules) (status model.KubernetesResourceState_HealthStatus, desc string) {
 	if !IsKubernetesBuiltInResource(key.APIVersion) {
+		if status, desc, ok := rules.determine(key, obj); ok {
+			return status, desc
+		}
+		if IsCrossplaneResource(obj) {
+			return determineCrossplaneHealth(obj)
+		}
 		desc = fmt.Sprintf("\"%s/%s\" was applied successfully but its health status couldn't be determined exactly. (Because tracking status for this kind of resource is not supported yet.)", key.APIVersion, key.Kind)
 		return
 	}
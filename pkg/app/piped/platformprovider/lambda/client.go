@@ -29,7 +29,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"go.uber.org/zap"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/awsapi"
 	"github.com/pipe-cd/pipecd/pkg/backoff"
+	appconfig "github.com/pipe-cd/pipecd/pkg/config"
 )
 
 const (
@@ -48,7 +50,7 @@ type client struct {
 	logger *zap.Logger
 }
 
-func newClient(region, profile, credentialsFile, roleARN, tokenPath string, logger *zap.Logger) (*client, error) {
+func newClient(platformProvider, region, profile, credentialsFile, roleARN, tokenPath string, apiClientCfg *appconfig.AWSAPIClientConfig, logger *zap.Logger) (*client, error) {
 	if region == "" {
 		return nil, fmt.Errorf("region is required field")
 	}
@@ -57,7 +59,10 @@ func newClient(region, profile, credentialsFile, roleARN, tokenPath string, logg
 		logger: logger.Named("lambda"),
 	}
 
-	optFns := []func(*config.LoadOptions) error{config.WithRegion(region)}
+	optFns := []func(*config.LoadOptions) error{
+		config.WithRegion(region),
+		config.WithRetryer(awsapi.NewRetryer(platformProvider, apiClientCfg)),
+	}
 	if credentialsFile != "" {
 		optFns = append(optFns, config.WithSharedCredentialsFiles([]string{credentialsFile}))
 	}
@@ -491,6 +496,73 @@ func (c *client) UpdateTrafficConfig(ctx context.Context, fm FunctionManifest, r
 	return nil
 }
 
+func (c *client) ListFunctionVersions(ctx context.Context, functionName string) ([]string, error) {
+	input := &lambda.ListVersionsByFunctionInput{
+		FunctionName: aws.String(functionName),
+		MaxItems:     aws.Int32(50),
+	}
+	var versions []string
+	for {
+		output, err := c.client.ListVersionsByFunction(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list versions of Lambda function %s: %w", functionName, err)
+		}
+		for _, v := range output.Versions {
+			if version := aws.ToString(v.Version); version != "$LATEST" {
+				versions = append(versions, version)
+			}
+		}
+
+		if output.NextMarker == nil {
+			return versions, nil
+		}
+		input.Marker = output.NextMarker
+	}
+}
+
+func (c *client) ListAliasVersions(ctx context.Context, functionName string) ([]string, error) {
+	input := &lambda.ListAliasesInput{
+		FunctionName: aws.String(functionName),
+		MaxItems:     aws.Int32(50),
+	}
+	var versions []string
+	for {
+		output, err := c.client.ListAliases(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list aliases of Lambda function %s: %w", functionName, err)
+		}
+		for _, a := range output.Aliases {
+			versions = append(versions, aws.ToString(a.FunctionVersion))
+			if a.RoutingConfig == nil {
+				continue
+			}
+			for version := range a.RoutingConfig.AdditionalVersionWeights {
+				versions = append(versions, version)
+			}
+		}
+
+		if output.NextMarker == nil {
+			return versions, nil
+		}
+		input.Marker = output.NextMarker
+	}
+}
+
+func (c *client) DeleteFunctionVersion(ctx context.Context, functionName, version string) error {
+	// Deleting without the qualifier deletes the whole function.
+	if version == "" || version == "$LATEST" {
+		return fmt.Errorf("invalid version %q to delete for Lambda function %s", version, functionName)
+	}
+	input := &lambda.DeleteFunctionInput{
+		FunctionName: aws.String(functionName),
+		Qualifier:    aws.String(version),
+	}
+	if _, err := c.client.DeleteFunction(ctx, input); err != nil {
+		return fmt.Errorf("failed to delete version %s of Lambda function %s: %w", version, functionName, err)
+	}
+	return nil
+}
+
 func (c *client) updateTagsConfig(ctx context.Context, fm FunctionManifest) error {
 	getFuncInput := &lambda.GetFunctionInput{
 		FunctionName: aws.String(fm.Spec.Name),
@@ -49,6 +49,13 @@ type Client interface {
 	GetTrafficConfig(ctx context.Context, fm FunctionManifest) (routingTrafficCfg RoutingTrafficConfig, err error)
 	CreateTrafficConfig(ctx context.Context, fm FunctionManifest, version string) error
 	UpdateTrafficConfig(ctx context.Context, fm FunctionManifest, routingTraffic RoutingTrafficConfig) error
+	// ListFunctionVersions returns the published versions of the given function, excluding $LATEST.
+	ListFunctionVersions(ctx context.Context, functionName string) ([]string, error)
+	// ListAliasVersions returns the versions referenced by the aliases of the given function,
+	// including the additional versions of their routing configurations.
+	ListAliasVersions(ctx context.Context, functionName string) ([]string, error)
+	// DeleteFunctionVersion deletes the given published version of the given function.
+	DeleteFunctionVersion(ctx context.Context, functionName, version string) error
 }
 
 // Registry holds a pool of aws client wrappers.
@@ -77,7 +84,7 @@ func (r *registry) Client(name string, cfg *config.PlatformProviderLambdaConfig,
 	}
 
 	c, err, _ := r.newGroup.Do(name, func() (interface{}, error) {
-		return newClient(cfg.Region, cfg.Profile, cfg.CredentialsFile, cfg.RoleARN, cfg.TokenFile, logger)
+		return newClient(name, cfg.Region, cfg.Profile, cfg.CredentialsFile, cfg.RoleARN, cfg.TokenFile, cfg.AwsAPIClient, logger)
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,119 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// externalVarFetcher fetches the raw value of a terraform variable from an external source.
+type externalVarFetcher func(ctx context.Context, v config.TerraformExternalVar) (string, error)
+
+// ResolveExternalVars fetches the values of the given external variables from AWS services
+// and returns them formatted as "key=value" to be passed via WithSensitiveVars.
+func ResolveExternalVars(ctx context.Context, vars []config.TerraformExternalVar) ([]string, error) {
+	return resolveExternalVars(ctx, vars, fetchExternalVar)
+}
+
+func resolveExternalVars(ctx context.Context, vars []config.TerraformExternalVar, fetch externalVarFetcher) ([]string, error) {
+	resolved := make([]string, 0, len(vars))
+	for _, v := range vars {
+		source := ExternalVarSource(v)
+		value, err := fetch(ctx, v)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch variable %s from %s: %w", v.Name, source, err)
+		}
+		if v.SecretKey != "" {
+			if value, err = extractSecretKey(value, v.SecretKey); err != nil {
+				return nil, fmt.Errorf("unable to extract variable %s from %s: %w", v.Name, source, err)
+			}
+		}
+		resolved = append(resolved, fmt.Sprintf("%s=%s", v.Name, value))
+	}
+	return resolved, nil
+}
+
+// ExternalVarSource returns a string to identify the source of the given variable.
+func ExternalVarSource(v config.TerraformExternalVar) string {
+	if v.SSMParameter != "" {
+		return fmt.Sprintf("ssm:%s:%s", v.Region, v.SSMParameter)
+	}
+	return fmt.Sprintf("secretsmanager:%s:%s", v.Region, v.SecretsManagerSecret)
+}
+
+// extractSecretKey returns the value of the given key in the JSON object secret.
+// String values are returned without their quotes while the others are returned as JSON,
+// which is also valid as HCL.
+func extractSecretKey(secret, key string) (string, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(secret), &obj); err != nil {
+		return "", errors.New("secret is not a JSON object")
+	}
+	raw, ok := obj[key]
+	if !ok {
+		return "", fmt.Errorf("key %q was not found in secret", key)
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, nil
+	}
+	return string(raw), nil
+}
+
+func fetchExternalVar(ctx context.Context, v config.TerraformExternalVar) (string, error) {
+	var opts []func(*awsconfig.LoadOptions) error
+	if v.Region != "" {
+		opts = append(opts, awsconfig.WithRegion(v.Region))
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return "", fmt.Errorf("unable to load AWS config: %w", err)
+	}
+
+	switch {
+	case v.SSMParameter != "":
+		out, err := ssm.NewFromConfig(cfg).GetParameter(ctx, &ssm.GetParameterInput{
+			Name:           aws.String(v.SSMParameter),
+			WithDecryption: aws.Bool(true),
+		})
+		if err != nil {
+			return "", err
+		}
+		return aws.ToString(out.Parameter.Value), nil
+	case v.SecretsManagerSecret != "":
+		out, err := secretsmanager.NewFromConfig(cfg).GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+			SecretId: aws.String(v.SecretsManagerSecret),
+		})
+		if err != nil {
+			return "", err
+		}
+		if out.SecretString != nil {
+			return *out.SecretString, nil
+		}
+		return string(out.SecretBinary), nil
+	default:
+		return "", errors.New("no source was specified")
+	}
+}
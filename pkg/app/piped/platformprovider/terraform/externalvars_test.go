@@ -0,0 +1,102 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestResolveExternalVars(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]string{
+		"ssm::/app/region":       "ap-northeast-1",
+		"secretsmanager::app/db": `{"password": "p@ss=word", "port": 5432}`,
+	}
+	fetch := func(_ context.Context, v config.TerraformExternalVar) (string, error) {
+		value, ok := values[ExternalVarSource(v)]
+		if !ok {
+			return "", errors.New("not found")
+		}
+		return value, nil
+	}
+
+	testcases := []struct {
+		name    string
+		vars    []config.TerraformExternalVar
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "no variable",
+			want: []string{},
+		},
+		{
+			name: "whole values",
+			vars: []config.TerraformExternalVar{
+				{Name: "region", SSMParameter: "/app/region"},
+				{Name: "db", SecretsManagerSecret: "app/db"},
+			},
+			want: []string{
+				"region=ap-northeast-1",
+				`db={"password": "p@ss=word", "port": 5432}`,
+			},
+		},
+		{
+			name: "keys of json secret",
+			vars: []config.TerraformExternalVar{
+				{Name: "db_password", SecretsManagerSecret: "app/db", SecretKey: "password"},
+				{Name: "db_port", SecretsManagerSecret: "app/db", SecretKey: "port"},
+			},
+			want: []string{
+				"db_password=p@ss=word",
+				"db_port=5432",
+			},
+		},
+		{
+			name: "missing key",
+			vars: []config.TerraformExternalVar{
+				{Name: "db_user", SecretsManagerSecret: "app/db", SecretKey: "user"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unable to fetch",
+			vars: []config.TerraformExternalVar{
+				{Name: "token", SSMParameter: "/app/token"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := resolveExternalVars(context.Background(), tc.vars, fetch)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Output represents a root module output value of the current state.
+type Output struct {
+	// The value in JSON, e.g. "\"db.example.com\"", "5432" or "[\"a\",\"b\"]".
+	Value     json.RawMessage `json:"value"`
+	Sensitive bool            `json:"sensitive"`
+}
+
+// String returns the value as is when it is a string, or its JSON representation otherwise.
+func (o Output) String() string {
+	var s string
+	if err := json.Unmarshal(o.Value, &s); err == nil {
+		return s
+	}
+	return string(o.Value)
+}
+
+// Outputs returns all root module outputs of the current state keyed by their names.
+func (t *Terraform) Outputs(ctx context.Context) (map[string]Output, error) {
+	if t.options.terragruntRunAll {
+		return nil, errRunAllUnsupported
+	}
+	args := []string{
+		"output",
+		"-json",
+	}
+	cmd := t.command(ctx, args...)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outputs: %s (%w)", stderr.String(), err)
+	}
+	return parseOutputs(out)
+}
+
+func parseOutputs(data []byte) (map[string]Output, error) {
+	outputs := make(map[string]Output)
+	if err := json.Unmarshal(data, &outputs); err != nil {
+		return nil, fmt.Errorf("failed to parse outputs: %w", err)
+	}
+	return outputs, nil
+}
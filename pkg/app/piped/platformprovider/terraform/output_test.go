@@ -0,0 +1,49 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOutputs(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`{
+  "endpoint": {"sensitive": false, "type": "string", "value": "db.example.com"},
+  "port": {"sensitive": false, "type": "number", "value": 5432},
+  "subnets": {"sensitive": false, "type": ["list", "string"], "value": ["a", "b"]},
+  "password": {"sensitive": true, "type": "string", "value": "secret"}
+}`)
+
+	got, err := parseOutputs(data)
+	require.NoError(t, err)
+	require.Len(t, got, 4)
+	assert.Equal(t, "db.example.com", got["endpoint"].String())
+	assert.Equal(t, "5432", got["port"].String())
+	assert.Equal(t, `["a", "b"]`, got["subnets"].String())
+	assert.False(t, got["endpoint"].Sensitive)
+	assert.True(t, got["password"].Sensitive)
+
+	got, err = parseOutputs([]byte(`{}`))
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	_, err = parseOutputs([]byte(`invalid`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// PlanSummary is the structured summary of the resource changes in a saved plan.
+// Each field holds the addresses of the resources, e.g. aws_s3_bucket.logs.
+type PlanSummary struct {
+	Creates      []string `json:"creates"`
+	Updates      []string `json:"updates"`
+	Destroys     []string `json:"destroys"`
+	Replacements []string `json:"replacements"`
+	Imports      []string `json:"imports"`
+}
+
+// NoChanges returns true when no resource is changed by the plan.
+func (s PlanSummary) NoChanges() bool {
+	return len(s.Creates)+len(s.Updates)+len(s.Destroys)+len(s.Replacements)+len(s.Imports) == 0
+}
+
+// FindProtected returns the addresses of the resources to be destroyed or replaced
+// which match any of the given patterns. "*" in the pattern matches any sequence of characters.
+func (s PlanSummary) FindProtected(patterns []string) []string {
+	var protected []string
+	for _, addr := range append(slices.Clone(s.Destroys), s.Replacements...) {
+		for _, p := range patterns {
+			if matchAddress(p, addr) {
+				protected = append(protected, addr)
+				break
+			}
+		}
+	}
+	return protected
+}
+
+// matchAddress reports whether the resource address matches the pattern.
+// Unlike path.Match, only "*" is special since addresses contain brackets, e.g. aws_instance.web[0].
+func matchAddress(pattern, address string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == address
+	}
+	if !strings.HasPrefix(address, parts[0]) {
+		return false
+	}
+	address = address[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(address, part)
+		if i < 0 {
+			return false
+		}
+		address = address[i+len(part):]
+	}
+	return strings.HasSuffix(address, parts[len(parts)-1])
+}
+
+// planJSON is the part of the JSON output of "terraform show -json <plan>" used for the summary.
+// ref: https://developer.hashicorp.com/terraform/internals/json-format#plan-representation
+type planJSON struct {
+	ResourceChanges []struct {
+		Address string `json:"address"`
+		Change  struct {
+			Actions   []string        `json:"actions"`
+			Importing json.RawMessage `json:"importing,omitempty"`
+		} `json:"change"`
+	} `json:"resource_changes"`
+}
+
+// ShowPlan returns the summary of the resource changes in the given plan file saved by SavePlan.
+func (t *Terraform) ShowPlan(ctx context.Context, planFile string) (*PlanSummary, error) {
+	if t.options.terragruntRunAll {
+		return nil, errRunAllUnsupported
+	}
+	args := []string{
+		"show",
+		"-json",
+		planFile,
+	}
+	cmd := t.command(ctx, args...)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to show plan: %s (%w)", stderr.String(), err)
+	}
+	return parsePlanSummary(out)
+}
+
+func parsePlanSummary(data []byte) (*PlanSummary, error) {
+	var plan planJSON
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, fmt.Errorf("failed to parse plan: %w", err)
+	}
+
+	s := &PlanSummary{
+		Creates:      []string{},
+		Updates:      []string{},
+		Destroys:     []string{},
+		Replacements: []string{},
+		Imports:      []string{},
+	}
+	for _, rc := range plan.ResourceChanges {
+		actions := rc.Change.Actions
+		switch {
+		case slices.Contains(actions, "create") && slices.Contains(actions, "delete"):
+			s.Replacements = append(s.Replacements, rc.Address)
+		case slices.Contains(actions, "create"):
+			s.Creates = append(s.Creates, rc.Address)
+		case slices.Contains(actions, "update"):
+			s.Updates = append(s.Updates, rc.Address)
+		case slices.Contains(actions, "delete"):
+			s.Destroys = append(s.Destroys, rc.Address)
+		}
+		// The resources to be imported can be updated at the same time.
+		if len(rc.Change.Importing) > 0 && string(rc.Change.Importing) != "null" {
+			s.Imports = append(s.Imports, rc.Address)
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePlanSummary(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`{
+  "format_version": "1.2",
+  "resource_changes": [
+    {"address": "aws_s3_bucket.new", "change": {"actions": ["create"]}},
+    {"address": "aws_s3_bucket.tagged", "change": {"actions": ["update"]}},
+    {"address": "aws_s3_bucket.old", "change": {"actions": ["delete"]}},
+    {"address": "aws_instance.web", "change": {"actions": ["delete", "create"]}},
+    {"address": "aws_instance.api", "change": {"actions": ["create", "delete"]}},
+    {"address": "aws_iam_role.imported", "change": {"actions": ["no-op"], "importing": {"id": "role"}}},
+    {"address": "aws_iam_role.updated", "change": {"actions": ["update"], "importing": {"id": "role"}}},
+    {"address": "data.aws_caller_identity.current", "change": {"actions": ["read"]}},
+    {"address": "aws_s3_bucket.unchanged", "change": {"actions": ["no-op"], "importing": null}}
+  ]
+}`)
+
+	got, err := parsePlanSummary(data)
+	require.NoError(t, err)
+	assert.Equal(t, &PlanSummary{
+		Creates:      []string{"aws_s3_bucket.new"},
+		Updates:      []string{"aws_s3_bucket.tagged", "aws_iam_role.updated"},
+		Destroys:     []string{"aws_s3_bucket.old"},
+		Replacements: []string{"aws_instance.web", "aws_instance.api"},
+		Imports:      []string{"aws_iam_role.imported", "aws_iam_role.updated"},
+	}, got)
+	assert.False(t, got.NoChanges())
+
+	got, err = parsePlanSummary([]byte(`{"format_version": "1.2"}`))
+	require.NoError(t, err)
+	assert.True(t, got.NoChanges())
+
+	_, err = parsePlanSummary([]byte(`invalid`))
+	assert.Error(t, err)
+}
+
+func TestPlanSummaryFindProtected(t *testing.T) {
+	t.Parallel()
+
+	s := PlanSummary{
+		Creates:      []string{"aws_db_instance.new"},
+		Updates:      []string{"aws_db_instance.tagged"},
+		Destroys:     []string{"aws_db_instance.main", "aws_s3_bucket.logs"},
+		Replacements: []string{"module.db.aws_db_instance.this[0]", "aws_instance.web[0]"},
+	}
+
+	testcases := []struct {
+		name     string
+		patterns []string
+		expected []string
+	}{
+		{
+			name: "no pattern",
+		},
+		{
+			name:     "exact address",
+			patterns: []string{"aws_s3_bucket.logs", "aws_instance.web[0]"},
+			expected: []string{"aws_s3_bucket.logs", "aws_instance.web[0]"},
+		},
+		{
+			name:     "wildcard",
+			patterns: []string{"aws_db_instance.*"},
+			expected: []string{"aws_db_instance.main"},
+		},
+		{
+			name:     "wildcards in the middle",
+			patterns: []string{"*.aws_db_instance.*"},
+			expected: []string{"module.db.aws_db_instance.this[0]"},
+		},
+		{
+			name:     "created and updated resources are not protected",
+			patterns: []string{"aws_db_instance.new", "aws_db_instance.tagged"},
+		},
+		{
+			name:     "matched by multiple patterns",
+			patterns: []string{"*", "aws_s3_bucket.*"},
+			expected: []string{"aws_db_instance.main", "aws_s3_bucket.logs", "module.db.aws_db_instance.this[0]", "aws_instance.web[0]"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, s.FindProtected(tc.patterns))
+		})
+	}
+}
@@ -0,0 +1,401 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	pluginCacheDirEnv = "TF_PLUGIN_CACHE_DIR"
+	// The file recording the checksums of the cached plugins.
+	pluginCacheManifestFile = ".pipecd-plugin-cache.json"
+	// The cached plugins are stored as <hostname>/<namespace>/<type>/<version>/<os_arch>.
+	pluginCacheEntryDepth = 5
+	// The plugins used within this period are not garbage collected
+	// because they might be still linked from the running deployments.
+	pluginCacheGCGracePeriod = 6 * time.Hour
+)
+
+var (
+	pluginCaches   = make(map[string]*PluginCache)
+	pluginCachesMu sync.Mutex
+)
+
+// PluginCache is a directory shared by the terraform commands of a piped
+// to avoid downloading the same provider plugins for every deployment.
+type PluginCache struct {
+	dir     string
+	maxSize int64
+	// Terraform does not guarantee the concurrent use of the plugin cache directory is safe,
+	// so the directory is locked while running terraform init.
+	mu sync.Mutex
+}
+
+// SharedPluginCache returns the plugin cache stored in the given directory.
+// The same instance is returned for the same directory so that they share the lock.
+// The least recently used plugins are removed when the total size exceeds maxSize bytes.
+func SharedPluginCache(dir string, maxSize int64) *PluginCache {
+	pluginCachesMu.Lock()
+	defer pluginCachesMu.Unlock()
+
+	if c, ok := pluginCaches[dir]; ok {
+		return c
+	}
+	c := &PluginCache{
+		dir:     dir,
+		maxSize: maxSize,
+	}
+	pluginCaches[dir] = c
+	return c
+}
+
+type pluginCacheManifest map[string]map[string]pluginCacheFile
+
+type pluginCacheFile struct {
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"modTime"`
+	SHA256  string    `json:"sha256"`
+}
+
+// use runs the given function with the plugin cache locked.
+// The cached plugins are verified before running it, and the newly cached plugins are recorded after that.
+// The function is run without the cache when the cache directory is not available.
+func (c *PluginCache) use(workingDir string, w io.Writer, f func(cacheDir string) error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	manifest, err := c.verify(w)
+	if err != nil {
+		fmt.Fprintf(w, "Unable to use the plugin cache %s, continue without it (%v)\n", c.dir, err)
+		return f("")
+	}
+
+	if err := f(c.dir); err != nil {
+		return err
+	}
+
+	if err := c.update(manifest, workingDir, time.Now()); err != nil {
+		fmt.Fprintf(w, "Unable to update the plugin cache %s (%v)\n", c.dir, err)
+	}
+	return nil
+}
+
+// verify removes the cached plugins which were modified or not recorded,
+// e.g. left by an interrupted terraform init.
+func (c *PluginCache) verify(w io.Writer) (pluginCacheManifest, error) {
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return nil, err
+	}
+	manifest, err := c.loadManifest()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := c.listEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	verified := make(pluginCacheManifest, len(entries))
+	for _, e := range entries {
+		files, ok := manifest[e]
+		if !ok {
+			fmt.Fprintf(w, "Removing the cached plugin %s because its checksums were not recorded\n", e)
+		} else if err := verifyPluginCacheEntry(filepath.Join(c.dir, e), files); err != nil {
+			fmt.Fprintf(w, "Removing the cached plugin %s because it failed the integrity verification (%v)\n", e, err)
+		} else {
+			verified[e] = files
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(c.dir, e)); err != nil {
+			return nil, err
+		}
+	}
+	return verified, c.saveManifest(verified)
+}
+
+// update records the checksums of the newly cached plugins, marks the plugins used by the given working directory
+// and removes the least recently used plugins while the total size exceeds the limit.
+func (c *PluginCache) update(manifest pluginCacheManifest, workingDir string, now time.Time) error {
+	entries, err := c.listEntries()
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if _, ok := manifest[e]; ok {
+			continue
+		}
+		files, err := hashPluginCacheEntry(filepath.Join(c.dir, e))
+		if err != nil {
+			return err
+		}
+		manifest[e] = files
+	}
+
+	for _, e := range c.usedEntries(workingDir) {
+		if err := os.Chtimes(filepath.Join(c.dir, e), now, now); err != nil {
+			return err
+		}
+	}
+
+	if err := c.gc(manifest, now); err != nil {
+		return err
+	}
+	return c.saveManifest(manifest)
+}
+
+// usedEntries returns the cached plugins linked from the given working directory by terraform init.
+func (c *PluginCache) usedEntries(workingDir string) []string {
+	dir, err := filepath.EvalSymlinks(c.dir)
+	if err != nil {
+		return nil
+	}
+	links, _ := filepath.Glob(pluginCacheEntryPattern(filepath.Join(workingDir, ".terraform", "providers")))
+
+	used := make([]string, 0, len(links))
+	for _, l := range links {
+		target, err := filepath.EvalSymlinks(l)
+		if err != nil {
+			continue
+		}
+		rel, err := filepath.Rel(dir, target)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			continue
+		}
+		used = append(used, rel)
+	}
+	return used
+}
+
+// gc removes the least recently used plugins until the total size gets under the limit.
+func (c *PluginCache) gc(manifest pluginCacheManifest, now time.Time) error {
+	type entry struct {
+		name   string
+		size   int64
+		usedAt time.Time
+	}
+	var (
+		entries = make([]entry, 0, len(manifest))
+		total   int64
+	)
+	for name, files := range manifest {
+		info, err := os.Stat(filepath.Join(c.dir, name))
+		if err != nil {
+			return err
+		}
+		var size int64
+		for _, f := range files {
+			size += f.Size
+		}
+		entries = append(entries, entry{name: name, size: size, usedAt: info.ModTime()})
+		total += size
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].usedAt.Before(entries[j].usedAt)
+	})
+
+	for _, e := range entries {
+		if total <= c.maxSize {
+			break
+		}
+		if now.Sub(e.usedAt) < pluginCacheGCGracePeriod {
+			break
+		}
+		if err := os.RemoveAll(filepath.Join(c.dir, e.name)); err != nil {
+			return err
+		}
+		delete(manifest, e.name)
+		total -= e.size
+	}
+	return nil
+}
+
+func (c *PluginCache) listEntries() ([]string, error) {
+	matches, err := filepath.Glob(pluginCacheEntryPattern(c.dir))
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]string, 0, len(matches))
+	for _, m := range matches {
+		info, err := os.Stat(m)
+		if err != nil || !info.IsDir() {
+			continue
+		}
+		rel, err := filepath.Rel(c.dir, m)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, rel)
+	}
+	return entries, nil
+}
+
+// pluginCacheEntryPattern returns the glob pattern matching the plugins stored under the given directory.
+func pluginCacheEntryPattern(root string) string {
+	elems := []string{root}
+	for i := 0; i < pluginCacheEntryDepth; i++ {
+		elems = append(elems, "*")
+	}
+	return filepath.Join(elems...)
+}
+
+func (c *PluginCache) loadManifest() (pluginCacheManifest, error) {
+	data, err := os.ReadFile(filepath.Join(c.dir, pluginCacheManifestFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return pluginCacheManifest{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	manifest := pluginCacheManifest{}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		// Start over with an empty manifest since the broken one cannot be trusted.
+		return pluginCacheManifest{}, nil
+	}
+	return manifest, nil
+}
+
+func (c *PluginCache) saveManifest(manifest pluginCacheManifest) error {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(c.dir, pluginCacheManifestFile)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// verifyPluginCacheEntry checks that the files of the given plugin are the same as the recorded ones.
+// The checksum is compared only when the size or modification time was changed to keep it fast.
+func verifyPluginCacheEntry(dir string, files map[string]pluginCacheFile) error {
+	current, err := listPluginCacheFiles(dir)
+	if err != nil {
+		return err
+	}
+	if len(current) != len(files) {
+		return fmt.Errorf("the number of files was changed")
+	}
+	for name, f := range files {
+		info, ok := current[name]
+		if !ok {
+			return fmt.Errorf("file %s was removed", name)
+		}
+		if info.Size() == f.Size && info.ModTime().Equal(f.ModTime) {
+			continue
+		}
+		sum, err := sha256File(filepath.Join(dir, name))
+		if err != nil {
+			return err
+		}
+		if sum != f.SHA256 {
+			return fmt.Errorf("checksum of file %s does not match", name)
+		}
+	}
+	return nil
+}
+
+func hashPluginCacheEntry(dir string) (map[string]pluginCacheFile, error) {
+	current, err := listPluginCacheFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]pluginCacheFile, len(current))
+	for name, info := range current {
+		sum, err := sha256File(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		files[name] = pluginCacheFile{
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+			SHA256:  sum,
+		}
+	}
+	return files, nil
+}
+
+func listPluginCacheFiles(dir string) (map[string]fs.FileInfo, error) {
+	files := make(map[string]fs.FileInfo)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[rel] = info
+		return nil
+	})
+	return files, err
+}
+
+func sha256File(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// WithPluginCacheConfig makes terraform init reuse the provider plugins
+// stored in the plugin cache configured for the platform provider.
+// Nothing is changed when the plugin cache is not enabled.
+func WithPluginCacheConfig(cfg *config.TerraformPluginCache) Option {
+	return func(opts *options) {
+		if cfg == nil || !cfg.Enabled {
+			return
+		}
+		dir := cfg.Dir
+		if dir == "" {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return
+			}
+			dir = filepath.Join(home, ".piped", "terraform-plugin-cache")
+		}
+		opts.pluginCache = SharedPluginCache(dir, cfg.MaxSizeBytes())
+	}
+}
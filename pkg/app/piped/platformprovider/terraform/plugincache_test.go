@@ -0,0 +1,88 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluginCache(t *testing.T) {
+	t.Parallel()
+
+	var (
+		cacheDir   = t.TempDir()
+		workingDir = t.TempDir()
+		cache      = &PluginCache{dir: cacheDir, maxSize: 10}
+		awsEntry   = filepath.Join("registry.terraform.io", "hashicorp", "aws", "5.0.0", "linux_amd64")
+		nullEntry  = filepath.Join("registry.terraform.io", "hashicorp", "null", "3.0.0", "linux_amd64")
+	)
+
+	// install simulates terraform init installing the given plugin to the cache and linking it.
+	install := func(entry, content string) func(string) error {
+		return func(dir string) error {
+			assert.Equal(t, cacheDir, dir)
+			p := filepath.Join(dir, entry)
+			if _, err := os.Stat(p); os.IsNotExist(err) {
+				require.NoError(t, os.MkdirAll(p, 0755))
+				require.NoError(t, os.WriteFile(filepath.Join(p, "terraform-provider"), []byte(content), 0755))
+			}
+			link := filepath.Join(workingDir, ".terraform", "providers", entry)
+			require.NoError(t, os.MkdirAll(filepath.Dir(link), 0755))
+			os.Remove(link)
+			return os.Symlink(p, link)
+		}
+	}
+
+	// The newly cached plugin is recorded.
+	require.NoError(t, cache.use(workingDir, io.Discard, install(awsEntry, "aws")))
+	manifest, err := cache.loadManifest()
+	require.NoError(t, err)
+	require.Contains(t, manifest, awsEntry)
+	assert.Equal(t, int64(3), manifest[awsEntry]["terraform-provider"].Size)
+
+	// The tampered plugin is removed before the next init.
+	require.NoError(t, os.WriteFile(filepath.Join(cacheDir, awsEntry, "terraform-provider"), []byte("bad"), 0755))
+	manifest, err = cache.verify(io.Discard)
+	require.NoError(t, err)
+	assert.NotContains(t, manifest, awsEntry)
+	assert.NoDirExists(t, filepath.Join(cacheDir, awsEntry))
+
+	// The plugin not recorded, e.g. left by an interrupted init, is removed too.
+	require.NoError(t, os.MkdirAll(filepath.Join(cacheDir, nullEntry), 0755))
+	manifest, err = cache.verify(io.Discard)
+	require.NoError(t, err)
+	assert.Empty(t, manifest)
+	assert.NoDirExists(t, filepath.Join(cacheDir, nullEntry))
+
+	// The least recently used plugins are removed when the total size exceeds the limit.
+	require.NoError(t, cache.use(workingDir, io.Discard, install(awsEntry, "aws-plugin")))
+	old := time.Now().Add(-2 * pluginCacheGCGracePeriod)
+	require.NoError(t, os.Chtimes(filepath.Join(cacheDir, awsEntry), old, old))
+	require.NoError(t, os.RemoveAll(filepath.Join(workingDir, ".terraform")))
+	require.NoError(t, cache.use(workingDir, io.Discard, install(nullEntry, "null")))
+
+	manifest, err = cache.loadManifest()
+	require.NoError(t, err)
+	assert.NotContains(t, manifest, awsEntry)
+	assert.Contains(t, manifest, nullEntry)
+	assert.NoDirExists(t, filepath.Join(cacheDir, awsEntry))
+}
@@ -27,10 +27,15 @@ import (
 	"strings"
 )
 
+// sensitiveVarEnvPrefix is the prefix of the environment variables
+// from which terraform reads the values of the input variables.
+const sensitiveVarEnvPrefix = "TF_VAR_"
+
 type options struct {
-	noColor  bool
-	vars     []string
-	varFiles []string
+	noColor       bool
+	vars          []string
+	sensitiveVars []string
+	varFiles      []string
 
 	sharedFlags []string
 	initFlags   []string
@@ -41,6 +46,13 @@ type options struct {
 	initEnvs   []string
 	planEnvs   []string
 	applyEnvs  []string
+
+	pluginCache *PluginCache
+
+	terragruntPath   string
+	terragruntRunAll bool
+
+	openTofu bool
 }
 
 type Option func(*options)
@@ -57,6 +69,14 @@ func WithVars(vars []string) Option {
 	}
 }
 
+// WithSensitiveVars sets the given "key=value" variables like WithVars
+// but passes them as TF_VAR_<key> environment variables to keep their values out of the command lines.
+func WithSensitiveVars(vars []string) Option {
+	return func(opts *options) {
+		opts.sensitiveVars = vars
+	}
+}
+
 func WithVarFiles(files []string) Option {
 	return func(opts *options) {
 		opts.varFiles = files
@@ -81,6 +101,21 @@ func WithAdditionalEnvs(shared, init, plan, apply []string) Option {
 	}
 }
 
+// WithOpenTofu tells that the binary given to NewTerraform is OpenTofu
+// so that the logged command lines show it.
+func WithOpenTofu(enabled bool) Option {
+	return func(opts *options) {
+		opts.openTofu = enabled
+	}
+}
+
+// WithPluginCache makes terraform init reuse the provider plugins stored in the given cache.
+func WithPluginCache(c *PluginCache) Option {
+	return func(opts *options) {
+		opts.pluginCache = c
+	}
+}
+
 type Terraform struct {
 	execPath string
 	dir      string
@@ -103,9 +138,7 @@ func NewTerraform(execPath, dir string, opts ...Option) *Terraform {
 
 func (t *Terraform) Version(ctx context.Context) (string, error) {
 	args := []string{"version"}
-	cmd := exec.CommandContext(ctx, t.execPath, args...)
-	cmd.Dir = t.dir
-	cmd.Env = append(os.Environ(), t.options.sharedEnvs...)
+	cmd := t.command(ctx, args...)
 
 	out, err := cmd.CombinedOutput()
 	if err != nil {
@@ -116,23 +149,28 @@ func (t *Terraform) Version(ctx context.Context) (string, error) {
 }
 
 func (t *Terraform) Init(ctx context.Context, w io.Writer) error {
-	args := []string{
-		"init",
-	}
+	args := t.subcommand("init")
 	args = append(args, t.makeCommonCommandArgs()...)
 	args = append(args, t.options.initFlags...)
 
-	cmd := exec.CommandContext(ctx, t.execPath, args...)
-	cmd.Dir = t.dir
+	cmd := t.command(ctx, args...)
 	cmd.Stdout = w
 	cmd.Stderr = w
+	cmd.Env = append(cmd.Env, t.options.initEnvs...)
 
-	env := append(os.Environ(), t.options.sharedEnvs...)
-	env = append(env, t.options.initEnvs...)
-	cmd.Env = env
-
-	io.WriteString(w, fmt.Sprintf("terraform %s", strings.Join(args, " ")))
-	return cmd.Run()
+	run := func() error {
+		io.WriteString(w, t.commandLine(args))
+		return cmd.Run()
+	}
+	if t.options.pluginCache == nil {
+		return run()
+	}
+	return t.options.pluginCache.use(t.dir, w, func(cacheDir string) error {
+		if cacheDir != "" {
+			cmd.Env = append(cmd.Env, pluginCacheDirEnv+"="+cacheDir)
+		}
+		return run()
+	})
 }
 
 func (t *Terraform) SelectWorkspace(ctx context.Context, workspace string) error {
@@ -141,9 +179,7 @@ func (t *Terraform) SelectWorkspace(ctx context.Context, workspace string) error
 		"select",
 		workspace,
 	}
-	cmd := exec.CommandContext(ctx, t.execPath, args...)
-	cmd.Dir = t.dir
-	cmd.Env = append(os.Environ(), t.options.sharedEnvs...)
+	cmd := t.command(ctx, args...)
 
 	out, err := cmd.CombinedOutput()
 	if err != nil {
@@ -153,6 +189,29 @@ func (t *Terraform) SelectWorkspace(ctx context.Context, workspace string) error
 	return nil
 }
 
+// SelectOrCreateWorkspace selects the given workspace and creates it when it does not exist yet.
+// It does the same as "terraform workspace select -or-create" which is not available before terraform v1.4.
+// The returned bool reports whether the workspace was created.
+func (t *Terraform) SelectOrCreateWorkspace(ctx context.Context, workspace string) (bool, error) {
+	selectErr := t.SelectWorkspace(ctx, workspace)
+	if selectErr == nil {
+		return false, nil
+	}
+
+	args := []string{
+		"workspace",
+		"new",
+		workspace,
+	}
+	cmd := t.command(ctx, args...)
+
+	// Report the error of selecting since it explains the reason better when the workspace exists.
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return false, fmt.Errorf("%w, and failed to create workspace: %s", selectErr, string(out))
+	}
+	return true, nil
+}
+
 type PlanResult struct {
 	Adds            int
 	Changes         int
@@ -168,22 +227,19 @@ func (r PlanResult) NoChanges() bool {
 }
 
 func (r PlanResult) Render() (string, error) {
-	terraformDiffStart := "Terraform will perform the following actions:"
-	if !strings.Contains(r.PlanOutput, terraformDiffStart) {
+	loc := planDiffStartRegex.FindStringIndex(r.PlanOutput)
+	if loc == nil {
 		return "", nil
 	}
-	startIndex := strings.Index(r.PlanOutput, terraformDiffStart) + len(terraformDiffStart)
+	startIndex := loc[1]
 
-	terraformDiffEnd := fmt.Sprintf("Plan: %d to import, %d to add, %d to change, %d to destroy.", r.Imports, r.Adds, r.Changes, r.Destroys)
-	endIndex := strings.Index(r.PlanOutput, terraformDiffEnd) + len(terraformDiffEnd)
-	if endIndex < startIndex {
-		terraformDiffEnd = fmt.Sprintf("Plan: %d to add, %d to change, %d to destroy.", r.Adds, r.Changes, r.Destroys)
-		endIndex = strings.Index(r.PlanOutput, terraformDiffEnd) + len(terraformDiffEnd)
-	}
-
-	if endIndex < startIndex {
+	// The diff ends at the last plan line since the output contains the plans of all modules
+	// when they are planned by terragrunt run-all.
+	locs := planLineRegex.FindAllStringIndex(r.PlanOutput, -1)
+	if len(locs) == 0 || locs[len(locs)-1][1] < startIndex {
 		return "", fmt.Errorf("unable to parse Terraform plan result")
 	}
+	endIndex := locs[len(locs)-1][1]
 
 	out := r.PlanOutput[startIndex:endIndex]
 
@@ -274,29 +330,40 @@ func GetExitCode(err error) int {
 }
 
 func (t *Terraform) Plan(ctx context.Context, w io.Writer) (PlanResult, error) {
-	args := []string{
-		"plan",
-		"-lock=false",
-		"-detailed-exitcode",
+	return t.plan(ctx, w)
+}
+
+// SavePlan is the same as Plan but also saves the plan into the given file to be inspected by ShowPlan.
+func (t *Terraform) SavePlan(ctx context.Context, w io.Writer, planFile string) (PlanResult, error) {
+	if t.options.terragruntRunAll {
+		return PlanResult{}, errRunAllUnsupported
 	}
+	return t.plan(ctx, w, "-out="+planFile)
+}
+
+func (t *Terraform) plan(ctx context.Context, w io.Writer, extraArgs ...string) (PlanResult, error) {
+	args := append(t.subcommand("plan"), "-lock=false", "-detailed-exitcode")
+	args = append(args, extraArgs...)
 	args = append(args, t.makeCommonCommandArgs()...)
 	args = append(args, t.options.planFlags...)
 
 	var buf bytes.Buffer
 	stdout := io.MultiWriter(w, &buf)
 
-	cmd := exec.CommandContext(ctx, t.execPath, args...)
-	cmd.Dir = t.dir
+	cmd := t.command(ctx, args...)
 	cmd.Stdout = stdout
 	cmd.Stderr = stdout
+	cmd.Env = append(cmd.Env, t.options.planEnvs...)
 
-	env := append(os.Environ(), t.options.sharedEnvs...)
-	env = append(env, t.options.planEnvs...)
-	cmd.Env = env
-
-	io.WriteString(w, fmt.Sprintf("terraform %s", strings.Join(args, " ")))
+	io.WriteString(w, t.commandLine(args))
 	err := cmd.Run()
-	switch GetExitCode(err) {
+	code := GetExitCode(err)
+	if t.options.terragruntRunAll && (code == 0 || code == 2) {
+		// The detailed exit codes of the modules are not always passed through by terragrunt,
+		// so whether there are changes is determined only by the output.
+		return parseRunAllPlanResult(buf.String(), !t.options.noColor), nil
+	}
+	switch code {
 	case 0:
 		return PlanResult{}, nil
 	case 2:
@@ -320,11 +387,41 @@ func (t *Terraform) makeCommonCommandArgs() (args []string) {
 	return
 }
 
+// command returns the command running terraform, or terragrunt when configured, in the working directory.
+func (t *Terraform) command(ctx context.Context, args ...string) *exec.Cmd {
+	path, env := t.execPath, os.Environ()
+	if t.options.terragruntPath != "" {
+		path = t.options.terragruntPath
+		env = append(env, terragruntTFPathEnv+"="+t.execPath, terragruntNonInteractiveEnv+"=true")
+	}
+	cmd := exec.CommandContext(ctx, path, args...)
+	cmd.Dir = t.dir
+	cmd.Env = append(env, t.options.sharedEnvs...)
+	for _, v := range t.options.sensitiveVars {
+		cmd.Env = append(cmd.Env, sensitiveVarEnvPrefix+v)
+	}
+	return cmd
+}
+
+// commandLine returns the command line running the given arguments for logging.
+func (t *Terraform) commandLine(args []string) string {
+	name := "terraform"
+	if t.options.openTofu {
+		name = "tofu"
+	}
+	if t.options.terragruntPath != "" {
+		name = "terragrunt"
+	}
+	return name + " " + strings.Join(args, " ")
+}
+
 var (
 	// Import block was introduced from Terraform v1.5.0.
 	// Keep this regex for backward compatibility.
 	planHasChangeRegex  = regexp.MustCompile(`(?m)^Plan:(?: (\d+) to import,)?? (\d+) to add, (\d+) to change, (\d+) to destroy\.$`)
 	planHasOutputsRegex = regexp.MustCompile(`(?m)^Changes to Outputs:$`)
+	// OpenTofu prints its own name instead of Terraform.
+	planDiffStartRegex = regexp.MustCompile(`(?:Terraform|OpenTofu) will perform the following actions:`)
 )
 
 // Borrowed from https://github.com/acarl005/stripansi
@@ -390,23 +487,37 @@ func parsePlanResult(out string, ansiIncluded bool) (PlanResult, error) {
 }
 
 func (t *Terraform) Apply(ctx context.Context, w io.Writer) error {
+	args := append(t.subcommand("apply"), "-auto-approve", "-input=false")
+	args = append(args, t.makeCommonCommandArgs()...)
+	args = append(args, t.options.applyFlags...)
+	return t.apply(ctx, w, args)
+}
+
+// ApplyPlan applies exactly the changes in the given plan file saved by SavePlan.
+// The variables are not given since they were already fixed in the plan.
+func (t *Terraform) ApplyPlan(ctx context.Context, w io.Writer, planFile string) error {
+	if t.options.terragruntRunAll {
+		return errRunAllUnsupported
+	}
 	args := []string{
 		"apply",
-		"-auto-approve",
 		"-input=false",
 	}
-	args = append(args, t.makeCommonCommandArgs()...)
+	if t.options.noColor {
+		args = append(args, "-no-color")
+	}
+	args = append(args, t.options.sharedFlags...)
 	args = append(args, t.options.applyFlags...)
+	args = append(args, planFile)
+	return t.apply(ctx, w, args)
+}
 
-	cmd := exec.CommandContext(ctx, t.execPath, args...)
-	cmd.Dir = t.dir
+func (t *Terraform) apply(ctx context.Context, w io.Writer, args []string) error {
+	cmd := t.command(ctx, args...)
 	cmd.Stdout = w
 	cmd.Stderr = w
+	cmd.Env = append(cmd.Env, t.options.applyEnvs...)
 
-	env := append(os.Environ(), t.options.sharedEnvs...)
-	env = append(env, t.options.applyEnvs...)
-	cmd.Env = env
-
-	io.WriteString(w, fmt.Sprintf("terraform %s", strings.Join(args, " ")))
+	io.WriteString(w, t.commandLine(args))
 	return cmd.Run()
 }
@@ -15,9 +15,13 @@
 package terraform
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPlanHasChangeRegex(t *testing.T) {
@@ -285,6 +289,28 @@ guarantee to take exactly these actions if you run "terraform apply" now.`,
 			},
 			expected: "",
 		},
+		{
+			name: "opentofu",
+			planResult: &PlanResult{
+				Adds: 1,
+				PlanOutput: `
+OpenTofu used the selected providers to generate the following execution plan. Resource actions are indicated with the following symbols:
+  + create
+
+OpenTofu will perform the following actions:
+  + resource "test-add" "test" {
+      + id    = (known after apply)
+    }
+
+Plan: 1 to add, 0 to change, 0 to destroy.
+`,
+			},
+			expected: `    resource "test-add" "test" {
++       id    = (known after apply)
+    }
+Plan: 1 to add, 0 to change, 0 to destroy.
+`,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -297,3 +323,51 @@ guarantee to take exactly these actions if you run "terraform apply" now.`,
 		})
 	}
 }
+
+func TestSelectOrCreateWorkspace(t *testing.T) {
+	t.Parallel()
+
+	// The fake terraform command stores the created workspaces as files in the working directory.
+	dir := t.TempDir()
+	execPath := filepath.Join(dir, "terraform")
+	script := `#!/bin/sh
+case "$2" in
+select) [ -f "ws-$3" ] || { echo "Workspace \"$3\" doesn't exist."; exit 1; } ;;
+new) [ "$3" != "invalid" ] || { echo "Invalid workspace name"; exit 1; }; touch "ws-$3" ;;
+esac
+`
+	require.NoError(t, os.WriteFile(execPath, []byte(script), 0755))
+	tf := NewTerraform(execPath, dir)
+
+	created, err := tf.SelectOrCreateWorkspace(context.Background(), "staging")
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	created, err = tf.SelectOrCreateWorkspace(context.Background(), "staging")
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	_, err = tf.SelectOrCreateWorkspace(context.Background(), "invalid")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid workspace name")
+}
+
+func TestCommandLine(t *testing.T) {
+	t.Parallel()
+
+	tf := NewTerraform("terraform", "",
+		WithVars([]string{"env=prod"}),
+		WithSensitiveVars([]string{"db_password=p@ss=word"}),
+	)
+	args := append([]string{"plan"}, tf.makeCommonCommandArgs()...)
+
+	assert.Equal(t, []string{"plan", "-var=env=prod"}, args)
+	assert.Equal(t, "terraform plan -var=env=prod", tf.commandLine(args))
+	assert.Contains(t, tf.command(context.Background(), args...).Env, "TF_VAR_db_password=p@ss=word")
+
+	tg := NewTerraform("terraform", "", WithTerragrunt("terragrunt", true))
+	assert.Equal(t, "terragrunt run-all plan", tg.commandLine(tg.subcommand("plan")))
+
+	tofu := NewTerraform("tofu", "", WithOpenTofu(true))
+	assert.Equal(t, "tofu plan", tofu.commandLine(tofu.subcommand("plan")))
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+)
+
+const (
+	terragruntTFPathEnv         = "TERRAGRUNT_TFPATH"
+	terragruntNonInteractiveEnv = "TERRAGRUNT_NON_INTERACTIVE"
+)
+
+var (
+	errRunAllUnsupported = errors.New("not supported with terragrunt run-all")
+
+	// Terragrunt may prefix the output lines of each module, so the lines are not anchored.
+	planLineRegex        = regexp.MustCompile(`Plan:(?: (\d+) to import,)?? (\d+) to add, (\d+) to change, (\d+) to destroy\.`)
+	planOutputsLineRegex = regexp.MustCompile(`Changes to Outputs:`)
+)
+
+// WithTerragrunt makes the commands run through the given terragrunt binary,
+// which runs the terraform binary given to NewTerraform.
+// Empty path means terraform is run directly.
+// When runAll is true, init, plan and apply are run against all modules under the directory by "terragrunt run-all".
+// Saving plans and getting outputs are not supported then since each module has its own plan and state.
+func WithTerragrunt(path string, runAll bool) Option {
+	return func(opts *options) {
+		opts.terragruntPath = path
+		opts.terragruntRunAll = path != "" && runAll
+	}
+}
+
+// subcommand returns the arguments to run the given subcommand, by "terragrunt run-all" if configured.
+func (t *Terraform) subcommand(name string) []string {
+	if t.options.terragruntRunAll {
+		return []string{"run-all", name}
+	}
+	return []string{name}
+}
+
+// parseRunAllPlanResult sums up the plans of all modules run by "terragrunt run-all plan".
+// The modules without changes do not print the plan line, so no changes are found when none of them has it.
+func parseRunAllPlanResult(out string, ansiIncluded bool) PlanResult {
+	if ansiIncluded {
+		out = stripAnsiCodes(out)
+	}
+
+	var r PlanResult
+	for _, s := range planLineRegex.FindAllStringSubmatch(out, -1) {
+		// The numbers are matched as digits, and the import count missing before Terraform v1.5.0 is parsed as zero.
+		imports, _ := strconv.Atoi(s[1])
+		adds, _ := strconv.Atoi(s[2])
+		changes, _ := strconv.Atoi(s[3])
+		destroys, _ := strconv.Atoi(s[4])
+		r.Imports += imports
+		r.Adds += adds
+		r.Changes += changes
+		r.Destroys += destroys
+		r.HasStateChanges = true
+	}
+	if planOutputsLineRegex.MatchString(out) {
+		r.HasStateChanges = true
+	}
+	if r.HasStateChanges {
+		r.PlanOutput = out
+	}
+	return r
+}
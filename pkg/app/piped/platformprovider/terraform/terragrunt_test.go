@@ -0,0 +1,73 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRunAllPlanResult(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		out      string
+		expected PlanResult
+	}{
+		{
+			name: "no changes in all modules",
+			out: `
+[vpc] No changes. Your infrastructure matches the configuration.
+[db] No changes. Your infrastructure matches the configuration.
+`,
+			expected: PlanResult{},
+		},
+		{
+			name: "changes in some modules",
+			out: `
+[vpc] No changes. Your infrastructure matches the configuration.
+[db] Plan: 1 to import, 2 to add, 0 to change, 1 to destroy.
+[app] Plan: 0 to add, 3 to change, 0 to destroy.
+`,
+			expected: PlanResult{
+				Imports:         1,
+				Adds:            2,
+				Changes:         3,
+				Destroys:        1,
+				HasStateChanges: true,
+			},
+		},
+		{
+			name: "changes only in outputs",
+			out: `
+[vpc] Changes to Outputs:
+[vpc]   + vpc_id = "vpc-123"
+`,
+			expected: PlanResult{HasStateChanges: true},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := parseRunAllPlanResult(tc.out, false)
+			if tc.expected.HasStateChanges {
+				tc.expected.PlanOutput = tc.out
+			}
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
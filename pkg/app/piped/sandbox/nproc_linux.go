@@ -0,0 +1,80 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sandbox
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// countUserProcesses returns the number of the processes running as the real user of piped,
+// which are counted by the OS against the limit set by "ulimit -u".
+// Since every thread is counted as a process by Linux, the threads are counted as well.
+func countUserProcesses() (int, error) {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return 0, err
+	}
+	uid := strconv.Itoa(os.Getuid())
+
+	var count int
+	for _, e := range entries {
+		if _, err := strconv.Atoi(e.Name()); err != nil {
+			continue
+		}
+		// The process may have exited already.
+		data, err := os.ReadFile(filepath.Join("/proc", e.Name(), "status"))
+		if err != nil {
+			continue
+		}
+		realUID, threads := parseProcStatus(data)
+		if realUID == uid {
+			count += threads
+		}
+	}
+	return count, nil
+}
+
+// parseProcStatus returns the real user ID and the number of threads from the content of /proc/<pid>/status.
+func parseProcStatus(data []byte) (string, int) {
+	var (
+		uid     string
+		threads = 1
+	)
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for s.Scan() {
+		key, value, ok := strings.Cut(s.Text(), ":")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			continue
+		}
+		switch key {
+		case "Uid":
+			uid = fields[0]
+		case "Threads":
+			if n, err := strconv.Atoi(fields[0]); err == nil {
+				threads = n
+			}
+		}
+	}
+	return uid, threads
+}
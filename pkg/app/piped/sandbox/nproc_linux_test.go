@@ -0,0 +1,39 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sandbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProcStatus(t *testing.T) {
+	t.Parallel()
+
+	uid, threads := parseProcStatus([]byte("Name:\tpiped\nUid:\t1000\t1000\t1000\t1000\nThreads:\t12\n"))
+	assert.Equal(t, "1000", uid)
+	assert.Equal(t, 12, threads)
+}
+
+func TestCountUserProcesses(t *testing.T) {
+	t.Parallel()
+
+	n, err := countUserProcesses()
+	require.NoError(t, err)
+	// At least this test process is running.
+	assert.Positive(t, n)
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sandbox provides a way to run user-provided commands
+// in an isolated process group with resource limits.
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// waitDelay is the duration to wait for the I/O of the command to be closed
+// after the command was killed.
+const waitDelay = 5 * time.Second
+
+// Command represents a shell script to be run in the sandbox.
+type Command struct {
+	// The shell script to run.
+	Script string
+	// The working directory of the script.
+	Dir string
+	// The environment variables of the script.
+	Env []string
+	// Where the standard output and error of the script will be written.
+	Stdout io.Writer
+	Stderr io.Writer
+	// The resource limits applied to the script.
+	Resources config.ScriptResources
+}
+
+// Run runs the given command and waits for it to complete.
+// The command and all of its child processes are killed when the given context is done,
+// so a runaway script cannot be left running after the stage has finished.
+func Run(ctx context.Context, c Command) error {
+	var running int
+	if c.Resources.MaxProcesses > 0 {
+		n, err := countUserProcesses()
+		if err != nil {
+			return fmt.Errorf("failed to count the running processes to limit the number of processes: %w", err)
+		}
+		running = n
+	}
+	limits, err := ulimitArgs(c.Resources, running)
+	if err != nil {
+		return err
+	}
+
+	script := c.Script
+	if limits != "" {
+		script = limits + "\n" + script
+	}
+
+	cmd := exec.CommandContext(ctx, "/bin/sh", "-l", "-c", script)
+	cmd.Dir = c.Dir
+	cmd.Env = c.Env
+	cmd.Stdout = c.Stdout
+	cmd.Stderr = c.Stderr
+	// Run the command in its own process group to be able to kill all of its descendants.
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	cmd.WaitDelay = waitDelay
+
+	if err := cmd.Run(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return fmt.Errorf("%w: %w", ctxErr, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// ulimitArgs returns the shell commands to apply the given resource limits.
+// The limit of the number of processes is applied by the OS per user rather than per process tree,
+// so it is raised by the given number of the processes already running as the same user.
+func ulimitArgs(r config.ScriptResources, running int) (string, error) {
+	var cmds []string
+	if r.CPUTime > 0 {
+		seconds := int64(r.CPUTime.Duration().Round(time.Second) / time.Second)
+		if seconds == 0 {
+			seconds = 1
+		}
+		cmds = append(cmds, fmt.Sprintf("ulimit -t %d", seconds))
+	}
+	memory, err := r.MemoryBytes()
+	if err != nil {
+		return "", err
+	}
+	if memory > 0 {
+		// The unit of ulimit -v is kilobytes.
+		kb := memory / 1024
+		if kb == 0 {
+			kb = 1
+		}
+		cmds = append(cmds, fmt.Sprintf("ulimit -v %d", kb))
+	}
+	if r.MaxProcesses > 0 {
+		n := running + r.MaxProcesses
+		// The option for the number of processes is -u in bash but -p in dash.
+		cmds = append(cmds, fmt.Sprintf("{ ulimit -u %d 2>/dev/null || ulimit -p %d; }", n, n))
+	}
+	if r.MaxOpenFiles > 0 {
+		cmds = append(cmds, fmt.Sprintf("ulimit -n %d", r.MaxOpenFiles))
+	}
+	if len(cmds) == 0 {
+		return "", nil
+	}
+	// Fail fast when the limits could not be applied.
+	return "{ " + strings.Join(cmds, " && ") + "; } || exit 1", nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestUlimitArgs(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		resources config.ScriptResources
+		running   int
+		want      string
+		wantErr   bool
+	}{
+		{
+			name: "no limit",
+		},
+		{
+			name: "all limits",
+			resources: config.ScriptResources{
+				CPUTime:      config.Duration(90 * time.Second),
+				Memory:       "512Mi",
+				MaxProcesses: 100,
+				MaxOpenFiles: 1024,
+			},
+			want: "{ ulimit -t 90 && ulimit -v 524288 && { ulimit -u 100 2>/dev/null || ulimit -p 100; } && ulimit -n 1024; } || exit 1",
+		},
+		{
+			name:      "processes relative to the running ones",
+			resources: config.ScriptResources{MaxProcesses: 100},
+			running:   20,
+			want:      "{ { ulimit -u 120 2>/dev/null || ulimit -p 120; }; } || exit 1",
+		},
+		{
+			name:      "invalid memory",
+			resources: config.ScriptResources{Memory: "foo"},
+			wantErr:   true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ulimitArgs(tc.resources, tc.running)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	err := Run(context.Background(), Command{
+		Script:    "ulimit -n\necho $FOO",
+		Env:       []string{"FOO=bar"},
+		Stdout:    &out,
+		Stderr:    &out,
+		Resources: config.ScriptResources{MaxOpenFiles: 64},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "64\nbar\n", out.String())
+}
+
+func TestRunCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := Run(ctx, Command{
+		// The child process must be killed together.
+		Script: "sleep 30 & wait",
+	})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 10*time.Second)
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sourceprocesser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+type parametersProcessor struct {
+	params *config.DeploymentParameters
+	values map[string]string
+}
+
+// NewParametersProcessor returns a processor templating the parameter targets with the given values.
+// The defaults are used for the parameters not in the given values.
+func NewParametersProcessor(params *config.DeploymentParameters, values map[string]string) *parametersProcessor {
+	return &parametersProcessor{
+		params: params,
+		values: values,
+	}
+}
+
+func (p *parametersProcessor) BuildTemplateData(appDir string) (map[string]string, error) {
+	if len(p.params.Definitions) == 0 {
+		// Skip building no error.
+		return nil, nil
+	}
+	return p.params.Resolve(p.values)
+}
+
+func (p *parametersProcessor) TemplateKey() string {
+	return "parameters"
+}
+
+func (p *parametersProcessor) TemplateSource(appDir string, data map[string]map[string]string) error {
+	for _, t := range p.params.Targets {
+		targetPath := filepath.Join(appDir, t)
+		fileName := filepath.Base(targetPath)
+		tmpl := template.New(fileName).Funcs(sprig.TxtFuncMap()).Option("missingkey=error")
+		tmpl, err := tmpl.ParseFiles(targetPath)
+		if err != nil {
+			return fmt.Errorf("failed to parse target file %s (%w)", t, err)
+		}
+
+		f, err := os.OpenFile(targetPath, os.O_WRONLY|os.O_TRUNC, 0644)
+		if err != nil {
+			return fmt.Errorf("failed to open target file %s (%w)", t, err)
+		}
+
+		if err := tmpl.Execute(f, data); err != nil {
+			f.Close()
+			return fmt.Errorf("failed to render target file %s (%w)", t, err)
+		}
+
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("failed to close target file %s (%w)", t, err)
+		}
+	}
+	return nil
+}
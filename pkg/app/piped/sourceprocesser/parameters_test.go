@@ -0,0 +1,54 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sourceprocesser
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestRenderParameters(t *testing.T) {
+	t.Parallel()
+
+	appDir := t.TempDir()
+	target := filepath.Join(appDir, "deployment.yaml")
+	err := os.WriteFile(target, []byte("image: app:{{ .parameters.imageTag }}\nreplicas: {{ .parameters.replicas }}\n"), 0600)
+	require.NoError(t, err)
+
+	tag, replicas := config.ParameterValue("v1.0.0"), config.ParameterValue("3")
+	params := &config.DeploymentParameters{
+		Definitions: []config.DeploymentParameter{
+			{Name: "imageTag", Type: config.ParameterTypeString, Default: &tag},
+			{Name: "replicas", Type: config.ParameterTypeInt, Default: &replicas},
+		},
+		Targets: []string{"deployment.yaml"},
+	}
+
+	sp := NewSourceProcessor(appDir, NewParametersProcessor(params, map[string]string{"imageTag": "v1.1.0"}))
+	require.NoError(t, sp.Process())
+
+	got, err := os.ReadFile(target)
+	require.NoError(t, err)
+	assert.Equal(t, "image: app:v1.1.0\nreplicas: 3\n", string(got))
+
+	sp = NewSourceProcessor(appDir, NewParametersProcessor(params, map[string]string{"replicas": "many"}))
+	assert.Error(t, sp.Process())
+}
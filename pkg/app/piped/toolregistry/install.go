@@ -26,17 +26,21 @@ import (
 )
 
 const (
-	defaultKubectlVersion   = "1.18.2"
-	defaultKustomizeVersion = "3.8.1"
-	defaultHelmVersion      = "3.8.2"
-	defaultTerraformVersion = "0.13.0"
+	defaultKubectlVersion    = "1.18.2"
+	defaultKustomizeVersion  = "3.8.1"
+	defaultHelmVersion       = "3.8.2"
+	defaultTerraformVersion  = "0.13.0"
+	defaultTerragruntVersion = "0.67.16"
+	defaultOpenTofuVersion   = "1.8.5"
 )
 
 var (
-	kubectlInstallScriptTmpl   = template.Must(template.New("kubectl").Parse(kubectlInstallScript))
-	kustomizeInstallScriptTmpl = template.Must(template.New("kustomize").Parse(kustomizeInstallScript))
-	helmInstallScriptTmpl      = template.Must(template.New("helm").Parse(helmInstallScript))
-	terraformInstallScriptTmpl = template.Must(template.New("terraform").Parse(terraformInstallScript))
+	kubectlInstallScriptTmpl    = template.Must(template.New("kubectl").Parse(kubectlInstallScript))
+	kustomizeInstallScriptTmpl  = template.Must(template.New("kustomize").Parse(kustomizeInstallScript))
+	helmInstallScriptTmpl       = template.Must(template.New("helm").Parse(helmInstallScript))
+	terraformInstallScriptTmpl  = template.Must(template.New("terraform").Parse(terraformInstallScript))
+	terragruntInstallScriptTmpl = template.Must(template.New("terragrunt").Parse(terragruntInstallScript))
+	openTofuInstallScriptTmpl   = template.Must(template.New("opentofu").Parse(openTofuInstallScript))
 )
 
 func (r *registry) installKubectl(ctx context.Context, version string) error {
@@ -226,3 +230,97 @@ func (r *registry) installTerraform(ctx context.Context, version string) error {
 	r.logger.Info("just installed terraform", zap.String("version", version))
 	return nil
 }
+
+func (r *registry) installTerragrunt(ctx context.Context, version string) error {
+	workingDir, err := os.MkdirTemp("", "terragrunt-install")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workingDir)
+
+	asDefault := version == ""
+	if asDefault {
+		version = defaultTerragruntVersion
+	}
+
+	var (
+		buf  bytes.Buffer
+		data = map[string]interface{}{
+			"WorkingDir": workingDir,
+			"Version":    version,
+			"BinDir":     r.binDir,
+			"AsDefault":  asDefault,
+		}
+	)
+	if err := terragruntInstallScriptTmpl.Execute(&buf, data); err != nil {
+		r.logger.Error("failed to render terragrunt install script",
+			zap.String("version", version),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install terragrunt %s (%w)", version, err)
+	}
+
+	var (
+		script = buf.String()
+		cmd    = exec.CommandContext(ctx, "/bin/sh", "-c", script)
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		r.logger.Error("failed to install terragrunt",
+			zap.String("version", version),
+			zap.String("script", script),
+			zap.String("out", string(out)),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install terragrunt %s, %s (%w)", version, string(out), err)
+	}
+
+	r.logger.Info("just installed terragrunt", zap.String("version", version))
+	return nil
+}
+
+func (r *registry) installOpenTofu(ctx context.Context, version string) error {
+	workingDir, err := os.MkdirTemp("", "opentofu-install")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workingDir)
+
+	asDefault := version == ""
+	if asDefault {
+		version = defaultOpenTofuVersion
+	}
+
+	var (
+		buf  bytes.Buffer
+		data = map[string]interface{}{
+			"WorkingDir": workingDir,
+			"Version":    version,
+			"BinDir":     r.binDir,
+			"AsDefault":  asDefault,
+		}
+	)
+	if err := openTofuInstallScriptTmpl.Execute(&buf, data); err != nil {
+		r.logger.Error("failed to render opentofu install script",
+			zap.String("version", version),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install opentofu %s (%w)", version, err)
+	}
+
+	var (
+		script = buf.String()
+		cmd    = exec.CommandContext(ctx, "/bin/sh", "-c", script)
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		r.logger.Error("failed to install opentofu",
+			zap.String("version", version),
+			zap.String("script", script),
+			zap.String("out", string(out)),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install opentofu %s, %s (%w)", version, string(out), err)
+	}
+
+	r.logger.Info("just installed opentofu", zap.String("version", version))
+	return nil
+}
@@ -33,6 +33,8 @@ type Registry interface {
 	Kustomize(ctx context.Context, version string) (string, bool, error)
 	Helm(ctx context.Context, version string) (string, bool, error)
 	Terraform(ctx context.Context, version string) (string, bool, error)
+	Terragrunt(ctx context.Context, version string) (string, bool, error)
+	OpenTofu(ctx context.Context, version string) (string, bool, error)
 }
 
 var defaultRegistry *registry
@@ -57,10 +59,12 @@ func InitDefaultRegistry(binDir string, logger *zap.Logger) error {
 	logger.Info("successfully loaded the pre-installed tools", zap.Any("tools", tools))
 
 	defaultRegistry = &registry{
-		binDir:       binDir,
-		versions:     tools,
-		installGroup: &singleflight.Group{},
-		logger:       logger,
+		binDir:           binDir,
+		versions:         tools,
+		resolvedVersions: make(map[string]string),
+		listReleases:     listGitHubReleases,
+		installGroup:     &singleflight.Group{},
+		logger:           logger,
 	}
 
 	return nil
@@ -92,18 +96,60 @@ func loadPreinstalledTool(binDir string) (map[string]struct{}, error) {
 }
 
 const (
-	kubectlPrefix   = "kubectl"
-	kustomizePrefix = "kustomize"
-	helmPrefix      = "helm"
-	terraformPrefix = "terraform"
+	kubectlPrefix    = "kubectl"
+	kustomizePrefix  = "kustomize"
+	helmPrefix       = "helm"
+	terraformPrefix  = "terraform"
+	terragruntPrefix = "terragrunt"
+	openTofuPrefix   = "tofu"
 )
 
 type registry struct {
-	binDir       string
-	versions     map[string]struct{}
-	mu           sync.RWMutex
-	installGroup *singleflight.Group
-	logger       *zap.Logger
+	binDir   string
+	versions map[string]struct{}
+	// The exact versions resolved from the version constraints, keyed by the tool prefix and the constraint.
+	resolvedVersions map[string]string
+	listReleases     releaseLister
+	mu               sync.RWMutex
+	installGroup     *singleflight.Group
+	logger           *zap.Logger
+}
+
+// resolveVersion returns the exact version of the given tool to install for the given version or version constraint.
+// The versions resolved from the constraints are cached until piped restarts.
+func (r *registry) resolveVersion(ctx context.Context, prefix, version, repository string) (string, error) {
+	if version == "" {
+		return "", nil
+	}
+	key := fmt.Sprintf("%s:%s", prefix, version)
+
+	r.mu.RLock()
+	resolved, ok := r.resolvedVersions[key]
+	r.mu.RUnlock()
+	if ok {
+		return resolved, nil
+	}
+
+	v, err, _ := r.installGroup.Do("resolve:"+key, func() (interface{}, error) {
+		return resolveVersion(ctx, version, repository, r.listReleases)
+	})
+	if err != nil {
+		return "", err
+	}
+	resolved = v.(string)
+	if resolved != version {
+		r.logger.Info("resolved version constraint",
+			zap.String("tool", prefix),
+			zap.String("constraint", version),
+			zap.String("version", resolved),
+		)
+	}
+
+	r.mu.Lock()
+	r.resolvedVersions[key] = resolved
+	r.mu.Unlock()
+
+	return resolved, nil
 }
 
 func (r *registry) Kubectl(ctx context.Context, version string) (string, bool, error) {
@@ -217,3 +263,69 @@ func (r *registry) Terraform(ctx context.Context, version string) (string, bool,
 
 	return path, true, nil
 }
+
+// Terragrunt returns the path to terragrunt of the given version or version constraint.
+func (r *registry) Terragrunt(ctx context.Context, version string) (string, bool, error) {
+	version, err := r.resolveVersion(ctx, terragruntPrefix, version, terragruntRepository)
+	if err != nil {
+		return "", false, err
+	}
+	name := terragruntPrefix
+	if version != "" {
+		name = fmt.Sprintf("%s-%s", terragruntPrefix, version)
+	}
+	path := filepath.Join(r.binDir, name)
+
+	r.mu.RLock()
+	_, ok := r.versions[name]
+	r.mu.RUnlock()
+	if ok {
+		return path, false, nil
+	}
+
+	_, err, _ = r.installGroup.Do(name, func() (interface{}, error) {
+		return nil, r.installTerragrunt(ctx, version)
+	})
+	if err != nil {
+		return "", true, err
+	}
+
+	r.mu.Lock()
+	r.versions[name] = struct{}{}
+	r.mu.Unlock()
+
+	return path, true, nil
+}
+
+// OpenTofu returns the path to tofu of the given version or version constraint.
+func (r *registry) OpenTofu(ctx context.Context, version string) (string, bool, error) {
+	version, err := r.resolveVersion(ctx, openTofuPrefix, version, openTofuRepository)
+	if err != nil {
+		return "", false, err
+	}
+	name := openTofuPrefix
+	if version != "" {
+		name = fmt.Sprintf("%s-%s", openTofuPrefix, version)
+	}
+	path := filepath.Join(r.binDir, name)
+
+	r.mu.RLock()
+	_, ok := r.versions[name]
+	r.mu.RUnlock()
+	if ok {
+		return path, false, nil
+	}
+
+	_, err, _ = r.installGroup.Do(name, func() (interface{}, error) {
+		return nil, r.installOpenTofu(ctx, version)
+	})
+	if err != nil {
+		return "", true, err
+	}
+
+	r.mu.Lock()
+	r.versions[name] = struct{}{}
+	r.mu.Unlock()
+
+	return path, true, nil
+}
@@ -53,3 +53,30 @@ mv terraform {{ .BinDir }}/terraform-{{ .Version }}
 cp -f {{ .BinDir }}/terraform-{{ .Version }} {{ .BinDir }}/terraform
 {{ end }}
 `
+
+var terragruntInstallScript = `
+set -e
+cd {{ .WorkingDir }}
+curl -fL https://github.com/gruntwork-io/terragrunt/releases/download/v{{ .Version }}/terragrunt_darwin_amd64 -o terragrunt_darwin_amd64
+curl -fL https://github.com/gruntwork-io/terragrunt/releases/download/v{{ .Version }}/SHA256SUMS -o SHA256SUMS
+grep ' terragrunt_darwin_amd64$' SHA256SUMS | shasum -a 256 -c -
+mv terragrunt_darwin_amd64 {{ .BinDir }}/terragrunt-{{ .Version }}
+chmod +x {{ .BinDir }}/terragrunt-{{ .Version }}
+{{ if .AsDefault }}
+cp -f {{ .BinDir }}/terragrunt-{{ .Version }} {{ .BinDir }}/terragrunt
+{{ end }}
+`
+
+var openTofuInstallScript = `
+set -e
+cd {{ .WorkingDir }}
+curl -fL https://github.com/opentofu/opentofu/releases/download/v{{ .Version }}/tofu_{{ .Version }}_darwin_amd64.zip -o tofu_{{ .Version }}_darwin_amd64.zip
+curl -fL https://github.com/opentofu/opentofu/releases/download/v{{ .Version }}/tofu_{{ .Version }}_SHA256SUMS -o SHA256SUMS
+grep ' tofu_{{ .Version }}_darwin_amd64.zip$' SHA256SUMS | shasum -a 256 -c -
+unzip tofu_{{ .Version }}_darwin_amd64.zip tofu
+mv tofu {{ .BinDir }}/tofu-{{ .Version }}
+chmod +x {{ .BinDir }}/tofu-{{ .Version }}
+{{ if .AsDefault }}
+cp -f {{ .BinDir }}/tofu-{{ .Version }} {{ .BinDir }}/tofu
+{{ end }}
+`
@@ -0,0 +1,112 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package toolregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+const (
+	openTofuRepository   = "opentofu/opentofu"
+	terragruntRepository = "gruntwork-io/terragrunt"
+
+	// The max number of pages of the GitHub releases to look up while resolving a version constraint.
+	maxReleasePages = 5
+)
+
+// releaseLister lists the tags of the releases published in the given GitHub repository.
+type releaseLister func(ctx context.Context, repository string) ([]string, error)
+
+// resolveVersion returns the exact version to install for the given version or version constraint.
+// An exact version such as "1.8.5" is returned as is, and a constraint such as "~> 1.8" or ">= 1.7, < 1.9"
+// is resolved to the latest stable release satisfying it.
+// Since the returned version is always a valid semantic version, it is safe to be embedded into the install scripts.
+func resolveVersion(ctx context.Context, version, repository string, list releaseLister) (string, error) {
+	if _, err := semver.StrictNewVersion(version); err == nil {
+		return version, nil
+	}
+	constraint, err := semver.NewConstraint(version)
+	if err != nil {
+		return "", fmt.Errorf("invalid version or version constraint %q: %w", version, err)
+	}
+
+	releases, err := list(ctx, repository)
+	if err != nil {
+		return "", fmt.Errorf("failed to list the releases of %s: %w", repository, err)
+	}
+	var latest *semver.Version
+	for _, r := range releases {
+		v, err := semver.NewVersion(r)
+		if err != nil || v.Prerelease() != "" || !constraint.Check(v) {
+			continue
+		}
+		if latest == nil || v.GreaterThan(latest) {
+			latest = v
+		}
+	}
+	if latest == nil {
+		return "", fmt.Errorf("no release of %s satisfies the version constraint %q", repository, version)
+	}
+	return latest.String(), nil
+}
+
+// listGitHubReleases lists the tags of the recent releases published in the given GitHub repository.
+func listGitHubReleases(ctx context.Context, repository string) ([]string, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	tags := make([]string, 0)
+	for page := 1; page <= maxReleasePages; page++ {
+		url := fmt.Sprintf("https://api.github.com/repos/%s/releases?per_page=100&page=%d", repository, page)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/vnd.github+json")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		var releases []struct {
+			TagName string `json:"tag_name"`
+			Draft   bool   `json:"draft"`
+		}
+		err = func() error {
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				return fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, url)
+			}
+			return json.NewDecoder(resp.Body).Decode(&releases)
+		}()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range releases {
+			if !r.Draft {
+				tags = append(tags, r.TagName)
+			}
+		}
+		if len(releases) < 100 {
+			break
+		}
+	}
+	return tags, nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package toolregistry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveVersion(t *testing.T) {
+	t.Parallel()
+
+	list := func(_ context.Context, repository string) ([]string, error) {
+		assert.Equal(t, openTofuRepository, repository)
+		return []string{"v1.9.0-beta1", "v1.8.5", "v1.8.4", "v1.7.6", "invalid"}, nil
+	}
+
+	testcases := []struct {
+		name    string
+		version string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "exact version",
+			version: "1.6.0",
+			want:    "1.6.0",
+		},
+		{
+			name:    "pessimistic constraint",
+			version: "~> 1.8",
+			want:    "1.8.5",
+		},
+		{
+			name:    "range constraint",
+			version: ">= 1.7, < 1.8",
+			want:    "1.7.6",
+		},
+		{
+			name:    "no release satisfies",
+			version: ">= 2.0",
+			wantErr: true,
+		},
+		{
+			name:    "injected command",
+			version: "1.8.5; rm -rf /",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := resolveVersion(context.Background(), tc.version, openTofuRepository, list)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestResolveVersionListFailed(t *testing.T) {
+	t.Parallel()
+
+	list := func(context.Context, string) ([]string, error) {
+		return nil, errors.New("rate limited")
+	}
+	_, err := resolveVersion(context.Background(), "~> 0.67", terragruntRepository, list)
+	assert.Error(t, err)
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/filematcher"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// codeOwnersFiles are the locations of the CODEOWNERS file in the priority order.
+// As same as GitHub, only the first found one is used.
+var codeOwnersFiles = []string{
+	".github/CODEOWNERS",
+	"CODEOWNERS",
+	"docs/CODEOWNERS",
+}
+
+type codeOwnersRule struct {
+	matcher *filematcher.PatternMatcher
+	owners  []string
+}
+
+// deploymentNotification returns the notification configuration of the given application
+// added the mentions of the code owners of the application directory when it is enabled.
+func (t *Trigger) deploymentNotification(repoDir string, app *model.Application, appCfg *config.GenericApplicationSpec) *config.DeploymentNotification {
+	n := appCfg.DeploymentNotification
+	if n == nil || n.CodeOwners == nil {
+		return n
+	}
+	logger := t.logger.With(zap.String("app", app.Name), zap.String("app-id", app.Id))
+
+	owners, err := findCodeOwners(repoDir, app.GitPath.Path)
+	if err != nil {
+		// Not fail the deployment since the code owners are additional mentions.
+		logger.Error("failed to find the code owners of the application", zap.Error(err))
+		return n
+	}
+	users, groups, unknown := t.config.Notifications.CodeOwners.FindSlackAccounts(owners)
+	if len(unknown) > 0 {
+		logger.Warn("some code owners of the application are not mentioned because their Slack IDs are not configured", zap.Strings("owners", unknown))
+	}
+
+	// Copy not to change the loaded configuration.
+	out := *n
+	out.Mentions = append([]config.NotificationMention(nil), n.Mentions...)
+	out.MentionCodeOwners(users, groups)
+	return &out
+}
+
+// findCodeOwners returns the owners of the given application directory
+// defined by the CODEOWNERS file of the given repository.
+// As same as GitHub, the last matching rule decides the owners.
+func findCodeOwners(repoDir, appDir string) ([]string, error) {
+	for _, f := range codeOwnersFiles {
+		data, err := os.ReadFile(filepath.Join(repoDir, f))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		rules, err := parseCodeOwnersFile(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s file: %w", f, err)
+		}
+
+		var owners []string
+		for _, r := range rules {
+			if r.matches(path.Clean(appDir)) {
+				owners = r.owners
+			}
+		}
+		return owners, nil
+	}
+	return nil, nil
+}
+
+// parseCodeOwnersFile parses the content of a CODEOWNERS file.
+// Its patterns follow the same rules as the .pipecd-ignore file except that negation is not supported.
+func parseCodeOwnersFile(data []byte) ([]codeOwnersRule, error) {
+	var rules []codeOwnersRule
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		pattern := fields[0]
+		if strings.HasPrefix(pattern, "!") {
+			return nil, fmt.Errorf("negation pattern %q is not supported", pattern)
+		}
+		// Only directories are matched, so the pattern for directories is the same as the others.
+		pattern = strings.TrimRight(pattern, "/")
+		if pattern == "" {
+			continue
+		}
+		if strings.Contains(pattern, "/") {
+			pattern = strings.TrimPrefix(pattern, "/")
+		} else {
+			pattern = "**/" + pattern
+		}
+
+		m, err := filematcher.NewPatternMatcher([]string{pattern})
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		// The rule without owners removes the owners of the matching paths.
+		rules = append(rules, codeOwnersRule{
+			matcher: m,
+			owners:  fields[1:],
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// matches reports whether the given directory or any of its parent directories matches the rule.
+func (r codeOwnersRule) matches(dir string) bool {
+	if dir == "." {
+		return r.matcher.Matches("")
+	}
+	parts := strings.Split(dir, "/")
+	for i := 1; i <= len(parts); i++ {
+		if r.matcher.Matches(strings.Join(parts[:i], "/")) {
+			return true
+		}
+	}
+	return false
+}
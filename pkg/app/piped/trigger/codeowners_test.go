@@ -0,0 +1,104 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindCodeOwners(t *testing.T) {
+	t.Parallel()
+
+	const codeOwners = `
+# Default owners.
+*                 @org/platform
+/apps/            @org/apps
+apps/payment/     @org/payment alice@example.com # The payment team.
+**/legacy         @org/legacy
+/apps/unowned
+*.go              @org/gophers
+`
+	testcases := []struct {
+		name     string
+		appDir   string
+		expected []string
+	}{
+		{
+			name:     "root",
+			appDir:   ".",
+			expected: []string{"@org/platform"},
+		},
+		{
+			name:     "matched by the default rule",
+			appDir:   "infra/network",
+			expected: []string{"@org/platform"},
+		},
+		{
+			name:     "matched by the parent directory",
+			appDir:   "apps/search",
+			expected: []string{"@org/apps"},
+		},
+		{
+			name:     "last matching rule wins",
+			appDir:   "apps/payment/api",
+			expected: []string{"@org/payment", "alice@example.com"},
+		},
+		{
+			name:     "pattern at any level",
+			appDir:   "apps/search/legacy",
+			expected: []string{"@org/legacy"},
+		},
+		{
+			name:     "owners removed",
+			appDir:   "apps/unowned/",
+			expected: []string{},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repoDir := t.TempDir()
+			require.NoError(t, os.MkdirAll(filepath.Join(repoDir, ".github"), 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(repoDir, ".github", "CODEOWNERS"), []byte(codeOwners), 0o644))
+			// Not used because the one in .github has priority.
+			require.NoError(t, os.WriteFile(filepath.Join(repoDir, "CODEOWNERS"), []byte("* @org/other\n"), 0o644))
+
+			owners, err := findCodeOwners(repoDir, tc.appDir)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.expected, owners)
+		})
+	}
+}
+
+func TestFindCodeOwnersWithoutFile(t *testing.T) {
+	t.Parallel()
+
+	owners, err := findCodeOwners(t.TempDir(), "apps/search")
+	require.NoError(t, err)
+	assert.Empty(t, owners)
+}
+
+func TestParseCodeOwnersFileNegation(t *testing.T) {
+	t.Parallel()
+
+	_, err := parseCodeOwnersFile([]byte("!apps/ @org/apps\n"))
+	assert.Error(t, err)
+}
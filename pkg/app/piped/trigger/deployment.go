@@ -47,6 +47,9 @@ func buildDeployment(
 	commander string,
 	syncStrategy model.SyncStrategy,
 	strategySummary string,
+	parameters map[string]string,
+	dryRun bool,
+	promoteFromDeploymentID string,
 	now time.Time,
 	noti *config.DeploymentNotification,
 	deploymentChainID string,
@@ -87,10 +90,14 @@ func buildDeployment(
 				Url:       commitURL,
 				CreatedAt: int64(commit.CreatedAt),
 			},
-			Commander:       commander,
-			Timestamp:       now.Unix(),
-			SyncStrategy:    syncStrategy,
-			StrategySummary: strategySummary,
+			Commander:               commander,
+			Timestamp:               now.Unix(),
+			SyncStrategy:            syncStrategy,
+			StrategySummary:         strategySummary,
+			Trace:                   model.MakeDeploymentTriggerTrace(commit.GetTrailerValueByKey),
+			Parameters:              parameters,
+			DryRun:                  dryRun,
+			PromoteFromDeploymentId: promoteFromDeploymentID,
 		},
 		GitPath:                   app.GitPath,
 		CloudProvider:             app.CloudProvider,
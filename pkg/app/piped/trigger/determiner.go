@@ -191,6 +191,17 @@ func (d *OnCommitDeterminer) ShouldTrigger(ctx context.Context, app *model.Appli
 		return false, err
 	}
 
+	// Exclude the changed files ignored by the .pipecd-ignore files.
+	im, err := loadIgnoreMatcher(d.repo.GetPath(), app.GitPath.Path)
+	if err != nil {
+		logger.Error("failed to load ignore files", zap.Error(err))
+		return false, err
+	}
+	if filtered := im.filterIgnoredFiles(changedFiles); len(filtered) != len(changedFiles) {
+		logger.Info(fmt.Sprintf("%d changed files were ignored by %s files", len(changedFiles)-len(filtered), ignoreFileName))
+		changedFiles = filtered
+	}
+
 	touched, err := isTouchedByChangedFiles(app.GitPath.Path, appCfg.Trigger.OnCommit.Paths, appCfg.Trigger.OnCommit.Ignores, changedFiles)
 	if err != nil {
 		return false, err
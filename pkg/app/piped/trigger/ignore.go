@@ -0,0 +1,160 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pipe-cd/pipecd/pkg/filematcher"
+)
+
+// ignoreFileName is the name of the file listing the paths whose changes
+// should not trigger deployments, written in gitignore syntax.
+// It can be placed at the root of the repository and the application directory.
+const ignoreFileName = ".pipecd-ignore"
+
+type ignoreRule struct {
+	matcher  *filematcher.PatternMatcher
+	negative bool
+	dirOnly  bool
+}
+
+// ignoreMatcher decides whether a changed file is ignored by the .pipecd-ignore files.
+// As same as gitignore, the last matching rule decides the result.
+type ignoreMatcher struct {
+	rules []ignoreRule
+}
+
+// loadIgnoreMatcher loads the .pipecd-ignore files at the root of the given repository
+// and the given application directory. A nil matcher is returned when there is no such file.
+func loadIgnoreMatcher(repoDir, appDir string) (*ignoreMatcher, error) {
+	dirs := []string{""}
+	if d := path.Clean(appDir); d != "." {
+		dirs = append(dirs, d)
+	}
+
+	var rules []ignoreRule
+	for _, dir := range dirs {
+		data, err := os.ReadFile(filepath.Join(repoDir, dir, ignoreFileName))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		rs, err := parseIgnoreFile(data, dir)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s file in %q: %w", ignoreFileName, dir, err)
+		}
+		rules = append(rules, rs...)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	return &ignoreMatcher{rules: rules}, nil
+}
+
+// parseIgnoreFile parses the content of a .pipecd-ignore file placed in the given directory.
+func parseIgnoreFile(data []byte, baseDir string) ([]ignoreRule, error) {
+	var rules []ignoreRule
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var r ignoreRule
+		if strings.HasPrefix(line, "!") {
+			r.negative = true
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			r.dirOnly = true
+			line = strings.TrimRight(line, "/")
+		}
+		if line == "" {
+			continue
+		}
+
+		// The pattern without any slash matches at any level below the directory of the file,
+		// otherwise it is relative to that directory.
+		if strings.Contains(line, "/") {
+			line = strings.TrimPrefix(line, "/")
+		} else {
+			line = "**/" + line
+		}
+		if baseDir != "" {
+			line = path.Join(baseDir, line)
+		}
+
+		m, err := filematcher.NewPatternMatcher([]string{line})
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", line, err)
+		}
+		r.matcher = m
+		rules = append(rules, r)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// Matches reports whether the given file or any of its parent directories is ignored.
+func (m *ignoreMatcher) Matches(file string) bool {
+	var ignored bool
+	for _, r := range m.rules {
+		if r.matches(file) {
+			ignored = !r.negative
+		}
+	}
+	return ignored
+}
+
+func (r ignoreRule) matches(file string) bool {
+	parts := strings.Split(file, "/")
+	for i := 1; i <= len(parts); i++ {
+		// The file itself can not match the pattern for directories.
+		if i == len(parts) && r.dirOnly {
+			break
+		}
+		if r.matcher.Matches(strings.Join(parts[:i], "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterIgnoredFiles returns the given files except the ignored ones.
+func (m *ignoreMatcher) filterIgnoredFiles(files []string) []string {
+	if m == nil {
+		return files
+	}
+	out := make([]string, 0, len(files))
+	for _, f := range files {
+		if !m.Matches(f) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIgnoreMatcher(t *testing.T) {
+	t.Parallel()
+
+	repoDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, "apps", "demo"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, ignoreFileName), []byte(`
+# Documents never affect the deployments.
+*.md
+docs/
+`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "apps", "demo", ignoreFileName), []byte(`
+/tests
+!CHANGELOG.md
+`), 0600))
+
+	m, err := loadIgnoreMatcher(repoDir, "apps/demo")
+	require.NoError(t, err)
+	require.NotNil(t, m)
+
+	testcases := []struct {
+		file     string
+		expected bool
+	}{
+		{file: "README.md", expected: true},
+		{file: "apps/demo/README.md", expected: true},
+		{file: "docs/index.html", expected: true},
+		{file: "apps/demo/docs/guide/index.html", expected: true},
+		{file: "apps/demo/tests/e2e.sh", expected: true},
+		{file: "apps/demo/CHANGELOG.md", expected: false},
+		{file: "apps/demo/deployment.yaml", expected: false},
+		{file: "apps/demo/src/tests/unit.sh", expected: false},
+		// The pattern for directories does not match the file having the same name.
+		{file: "apps/demo/docs", expected: false},
+	}
+	for _, tc := range testcases {
+		assert.Equal(t, tc.expected, m.Matches(tc.file), tc.file)
+	}
+
+	assert.Equal(t,
+		[]string{"apps/demo/CHANGELOG.md", "apps/demo/deployment.yaml"},
+		m.filterIgnoredFiles([]string{"README.md", "apps/demo/CHANGELOG.md", "apps/demo/tests/e2e.sh", "apps/demo/deployment.yaml"}),
+	)
+}
+
+func TestLoadIgnoreMatcherWithoutFiles(t *testing.T) {
+	t.Parallel()
+
+	m, err := loadIgnoreMatcher(t.TempDir(), ".")
+	require.NoError(t, err)
+	assert.Nil(t, m)
+
+	files := []string{"README.md"}
+	assert.Equal(t, files, m.filterIgnoredFiles(files))
+}
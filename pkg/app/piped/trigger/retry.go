@@ -0,0 +1,177 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// retryDeployments handles all RETRY_DEPLOYMENT commands
+// by triggering a new deployment for each requested failed deployment.
+func (t *Trigger) retryDeployments(ctx context.Context) {
+	for _, cmd := range t.commandLister.ListApplicationCommands() {
+		if !cmd.IsRetryDeploymentCmd() {
+			continue
+		}
+
+		logger := t.logger.With(
+			zap.String("command", cmd.Id),
+			zap.String("deployment-id", cmd.GetRetryDeployment().DeploymentId),
+			zap.String("commander", cmd.Commander),
+		)
+
+		deployment, err := t.retryDeployment(ctx, cmd)
+		if err != nil {
+			logger.Error("failed to retry deployment", zap.Error(err))
+			if err := cmd.Report(ctx, model.CommandStatus_COMMAND_FAILED, nil, []byte(err.Error())); err != nil {
+				logger.Error("failed to report command status", zap.Error(err))
+			}
+			continue
+		}
+
+		logger.Info(fmt.Sprintf("triggered deployment %s to retry the failed deployment", deployment.Id))
+		metadata := map[string]string{
+			model.MetadataKeyTriggeredDeploymentID: deployment.Id,
+		}
+		if err := cmd.Report(ctx, model.CommandStatus_COMMAND_SUCCEEDED, metadata, nil); err != nil {
+			logger.Error("failed to report command status", zap.Error(err))
+		}
+	}
+}
+
+func (t *Trigger) retryDeployment(ctx context.Context, cmd model.ReportableCommand) (*model.Deployment, error) {
+	resp, err := t.apiClient.GetDeployment(ctx, &pipedservice.GetDeploymentRequest{
+		Id: cmd.GetRetryDeployment().DeploymentId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the deployment to retry: %w", err)
+	}
+	failed := resp.Deployment
+	if failed.Status != model.DeploymentStatus_DEPLOYMENT_FAILURE {
+		return nil, fmt.Errorf("could not retry deployment %s because its status is %s", failed.Id, failed.Status)
+	}
+
+	app, ok := t.applicationLister.Get(failed.ApplicationId)
+	if !ok {
+		return nil, fmt.Errorf("application %s of the deployment was not found (maybe it was disabled)", failed.ApplicationId)
+	}
+
+	deployment := buildRetryDeployment(app, failed, cmd.Commander, time.Now())
+	if err := t.triggerDeployment(ctx, deployment); err != nil {
+		return nil, err
+	}
+
+	// This error is ignored because the deployment was already registered successfully.
+	if e := reportMostRecentlyTriggeredDeployment(ctx, t.apiClient, deployment); e != nil {
+		t.logger.Error("failed to report most recently triggered deployment", zap.Error(e))
+	}
+
+	var noti *config.DeploymentNotification
+	if v, ok := deployment.Metadata[model.MetadataKeyDeploymentNotification]; ok {
+		noti = &config.DeploymentNotification{}
+		if err := json.Unmarshal([]byte(v), noti); err != nil {
+			t.logger.Error("failed to extract notification config of the deployment", zap.Error(err))
+			noti = nil
+		}
+	}
+	t.notifyDeploymentTriggered(ctx, noti, deployment)
+
+	return deployment, nil
+}
+
+// buildRetryDeployment builds a new deployment to retry the given failed deployment.
+// The new deployment targets the same commit with the same sync strategy as the failed one
+// and keeps the list of its succeeded stages so that they can be skipped while executing.
+// The stages reused by the failed deployment from an earlier attempt are kept as they were
+// so that each result is always bound to the attempt which actually executed the stage.
+func buildRetryDeployment(app *model.Application, failed *model.Deployment, commander string, now time.Time) *model.Deployment {
+	prev := failed.Trigger.GetRetry()
+	reused := make(map[string]*model.DeploymentTriggerRetry_SucceededStage, len(prev.GetSucceededStages()))
+	for _, s := range prev.GetSucceededStages() {
+		reused[s.DeploymentId+"/"+s.Id] = s
+	}
+
+	succeeded := make([]*model.DeploymentTriggerRetry_SucceededStage, 0, len(failed.Stages))
+	for _, s := range failed.Stages {
+		switch s.Status {
+		case model.StageStatus_STAGE_SUCCESS:
+			succeeded = append(succeeded, &model.DeploymentTriggerRetry_SucceededStage{
+				Id:           s.Id,
+				Name:         s.Name,
+				Index:        s.Index,
+				DeploymentId: failed.Id,
+				Attempt:      prev.GetAttempt(),
+			})
+		case model.StageStatus_STAGE_SKIPPED:
+			from, ok := s.Metadata[model.MetadataKeyStageReusedFrom]
+			if !ok {
+				continue
+			}
+			if r, ok := reused[from+"/"+s.Id]; ok {
+				succeeded = append(succeeded, r)
+			}
+		}
+	}
+
+	metadata := make(map[string]string)
+	if v, ok := failed.Metadata[model.MetadataKeyDeploymentNotification]; ok {
+		metadata[model.MetadataKeyDeploymentNotification] = v
+	}
+
+	return &model.Deployment{
+		Id:              uuid.New().String(),
+		ApplicationId:   app.Id,
+		ApplicationName: app.Name,
+		PipedId:         app.PipedId,
+		ProjectId:       app.ProjectId,
+		Kind:            app.Kind,
+		Trigger: &model.DeploymentTrigger{
+			Commit:          failed.Trigger.Commit,
+			Commander:       commander,
+			Timestamp:       now.Unix(),
+			SyncStrategy:    failed.Trigger.SyncStrategy,
+			StrategySummary: fmt.Sprintf("Retry the failed deployment %s because piped received a command from user via web console or pipectl", failed.Id),
+			Trace:           failed.Trigger.Trace,
+			Parameters:      failed.Trigger.Parameters,
+			DryRun:          failed.Trigger.DryRun,
+			Retry: &model.DeploymentTriggerRetry{
+				DeploymentId:    failed.Id,
+				SucceededStages: succeeded,
+				Attempt:         prev.GetAttempt() + 1,
+			},
+		},
+		GitPath:                   app.GitPath,
+		CloudProvider:             app.CloudProvider,
+		PlatformProvider:          app.PlatformProvider,
+		DeployTargetsByPlugin:     app.DeployTargetsByPlugin,
+		Labels:                    app.Labels,
+		Status:                    model.DeploymentStatus_DEPLOYMENT_PENDING,
+		StatusReason:              "The deployment is waiting to be planned",
+		Metadata:                  metadata,
+		CreatedAt:                 now.Unix(),
+		UpdatedAt:                 now.Unix(),
+		DeploymentTraceCommitHash: failed.DeploymentTraceCommitHash,
+	}
+}
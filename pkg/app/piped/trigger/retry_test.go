@@ -0,0 +1,99 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestBuildRetryDeployment(t *testing.T) {
+	t.Parallel()
+
+	app := &model.Application{
+		Id:               "app-id",
+		Name:             "app-name",
+		PipedId:          "piped-id",
+		ProjectId:        "project-id",
+		Kind:             model.ApplicationKind_KUBERNETES,
+		PlatformProvider: "kubernetes-default",
+		GitPath:          &model.ApplicationGitPath{Path: "app"},
+	}
+	commit := &model.Commit{Hash: "commit-hash", Branch: "main"}
+	failed := &model.Deployment{
+		Id:            "failed-deployment",
+		ApplicationId: app.Id,
+		Status:        model.DeploymentStatus_DEPLOYMENT_FAILURE,
+		Trigger: &model.DeploymentTrigger{
+			Commit:       commit,
+			Commander:    "foo",
+			SyncStrategy: model.SyncStrategy_PIPELINE,
+			Retry: &model.DeploymentTriggerRetry{
+				DeploymentId: "original-deployment",
+				Attempt:      1,
+				SucceededStages: []*model.DeploymentTriggerRetry_SucceededStage{
+					{Id: "stage-0", Name: "WAIT", Index: 0, DeploymentId: "original-deployment"},
+					{Id: "stage-1", Name: "WAIT", Index: 1, DeploymentId: "original-deployment"},
+				},
+			},
+		},
+		Stages: []*model.PipelineStage{
+			{
+				Id:       "stage-0",
+				Name:     "WAIT",
+				Index:    0,
+				Status:   model.StageStatus_STAGE_SKIPPED,
+				Metadata: map[string]string{model.MetadataKeyStageReusedFrom: "original-deployment"},
+			},
+			{Id: "stage-1", Name: "WAIT", Index: 1, Status: model.StageStatus_STAGE_SKIPPED},
+			{Id: "stage-2", Name: "WAIT", Index: 2, Status: model.StageStatus_STAGE_SUCCESS},
+			{Id: "stage-3", Name: "K8S_SYNC", Index: 3, Status: model.StageStatus_STAGE_FAILURE},
+			{Id: "stage-4", Name: "WAIT", Index: 4, Status: model.StageStatus_STAGE_NOT_STARTED_YET},
+		},
+		Metadata: map[string]string{
+			model.MetadataKeyDeploymentNotification: `{"mentions":[]}`,
+			"other-key":                             "other-value",
+		},
+	}
+	now := time.Unix(1700000000, 0)
+
+	d := buildRetryDeployment(app, failed, "bar", now)
+	require.NotEmpty(t, d.Id)
+	assert.NotEqual(t, failed.Id, d.Id)
+	assert.Equal(t, model.DeploymentStatus_DEPLOYMENT_PENDING, d.Status)
+	assert.Equal(t, commit, d.Trigger.Commit)
+	assert.Equal(t, "bar", d.Trigger.Commander)
+	assert.Equal(t, now.Unix(), d.Trigger.Timestamp)
+	assert.Equal(t, model.SyncStrategy_PIPELINE, d.Trigger.SyncStrategy)
+	assert.Equal(t, &model.DeploymentTriggerRetry{
+		DeploymentId: "failed-deployment",
+		Attempt:      2,
+		SucceededStages: []*model.DeploymentTriggerRetry_SucceededStage{
+			{Id: "stage-0", Name: "WAIT", Index: 0, DeploymentId: "original-deployment"},
+			{Id: "stage-2", Name: "WAIT", Index: 2, DeploymentId: "failed-deployment", Attempt: 1},
+		},
+	}, d.Trigger.Retry)
+	assert.Equal(t, map[string]string{
+		model.MetadataKeyDeploymentNotification: `{"mentions":[]}`,
+	}, d.Metadata)
+	assert.Equal(t, app.PlatformProvider, d.PlatformProvider)
+	assert.Equal(t, app.GitPath, d.GitPath)
+	assert.Empty(t, d.Stages)
+}
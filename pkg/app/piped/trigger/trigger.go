@@ -158,6 +158,8 @@ func (t *Trigger) Run(ctx context.Context) error {
 			t.checkCandidates(ctx, candidates)
 
 		case <-ondemandTicker.C:
+			t.retryDeployments(ctx)
+
 			candidates := t.listCommandCandidates()
 			if len(candidates) > 0 {
 				t.logger.Info(fmt.Sprintf("found %d command candidates", len(candidates)))
@@ -266,6 +268,7 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 			commander                 string
 			strategy                  model.SyncStrategy
 			strategySummary           string
+			parameters                map[string]string
 			deploymentChainID         string
 			deploymentChainBlockIndex uint32
 		)
@@ -274,7 +277,9 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		case model.TriggerKind_ON_COMMAND:
 			strategy = c.command.GetSyncApplication().SyncStrategy
 			commander = c.command.Commander
-			if strategy == model.SyncStrategy_QUICK_SYNC {
+			if id := c.command.GetSyncApplication().GetPromoteFromDeploymentId(); id != "" {
+				strategySummary = fmt.Sprintf("Promote the artifact of deployment %s because piped received a command from user via pipectl", id)
+			} else if strategy == model.SyncStrategy_QUICK_SYNC {
 				strategySummary = "Quick sync because piped received a command from user via web console or pipectl"
 			} else {
 				strategySummary = "Sync with the specified pipeline because piped received a command from user via web console or pipectl"
@@ -291,6 +296,20 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 			strategy = model.SyncStrategy_AUTO
 		}
 
+		// Apply the defaults to the deployment parameters not supplied by the command.
+		parameters, err = appCfg.Parameters.Resolve(c.command.GetSyncApplication().GetParameters())
+		if err != nil {
+			msg := fmt.Sprintf("failed to resolve deployment parameters of application %s: %v", app.Name, err)
+			t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
+			t.logger.Error(msg, zap.Error(err))
+			if c.HasCommand() {
+				if err := c.command.Report(ctx, model.CommandStatus_COMMAND_FAILED, nil, []byte(msg)); err != nil {
+					t.logger.Error("failed to report command status", zap.Error(err))
+				}
+			}
+			continue
+		}
+
 		// Build the deployment to trigger.
 		deployment, err := buildDeployment(
 			app,
@@ -299,8 +318,11 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 			commander,
 			strategy,
 			strategySummary,
+			parameters,
+			c.command.GetSyncApplication().GetDryRun(),
+			c.command.GetSyncApplication().GetPromoteFromDeploymentId(),
 			time.Now(),
-			appCfg.DeploymentNotification,
+			t.deploymentNotification(gitRepo.GetPath(), app, appCfg),
 			deploymentChainID,
 			deploymentChainBlockIndex,
 		)
@@ -314,7 +336,8 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		// In case the triggered deployment is of application that can trigger a deployment chain
 		// create a new deployment chain with its configuration besides with the first deployment
 		// in that chain.
-		if appCfg.PostSync != nil && appCfg.PostSync.DeploymentChain != nil {
+		// A dry-run deployment never triggers its deployment chain since nothing is actually deployed.
+		if !deployment.Trigger.DryRun && appCfg.PostSync != nil && appCfg.PostSync.DeploymentChain != nil {
 			if err := t.triggerDeploymentChain(ctx, appCfg.PostSync.DeploymentChain, deployment); err != nil {
 				msg := fmt.Sprintf("failed to trigger application %s and its deployment chain: %v", app.Id, err)
 				t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
@@ -331,16 +354,20 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 			}
 		}
 
-		// TODO: Find a better way to ensure that the application should be updated correctly
-		// when the deployment was successfully triggered.
-		// This error is ignored because the deployment was already registered successfully.
-		if e := reportMostRecentlyTriggeredDeployment(ctx, t.apiClient, deployment); e != nil {
-			t.logger.Error("failed to report most recently triggered deployment", zap.Error(e))
-		}
-
 		triggered[app.Id] = struct{}{}
-		t.commitStore.Put(app.Id, headCommit.Hash)
-		t.notifyDeploymentTriggered(ctx, appCfg, deployment)
+
+		// A dry-run deployment does not change what is running on the platform,
+		// so it must not be treated as the latest deployment of the application.
+		if !deployment.Trigger.DryRun {
+			// TODO: Find a better way to ensure that the application should be updated correctly
+			// when the deployment was successfully triggered.
+			// This error is ignored because the deployment was already registered successfully.
+			if e := reportMostRecentlyTriggeredDeployment(ctx, t.apiClient, deployment); e != nil {
+				t.logger.Error("failed to report most recently triggered deployment", zap.Error(e))
+			}
+			t.commitStore.Put(app.Id, headCommit.Hash)
+		}
+		t.notifyDeploymentTriggered(ctx, appCfg.DeploymentNotification, deployment)
 
 		// Mask command as handled since the deployment has been triggered successfully.
 		if c.HasCommand() {
@@ -470,10 +497,10 @@ func (t *Trigger) GetLastTriggeredCommitGetter() LastTriggeredCommitGetter {
 	return t.commitStore
 }
 
-func (t *Trigger) notifyDeploymentTriggered(_ context.Context, appCfg *config.GenericApplicationSpec, d *model.Deployment) {
+func (t *Trigger) notifyDeploymentTriggered(_ context.Context, n *config.DeploymentNotification, d *model.Deployment) {
 	var users []string
 	var groups []string
-	if n := appCfg.DeploymentNotification; n != nil {
+	if n != nil {
 		users = n.FindSlackUsers(model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED)
 		groups = n.FindSlackGroups(model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED)
 	}
@@ -521,6 +521,7 @@ func (p *piped) createAPIClient(ctx context.Context, address, projectID, pipedID
 		options = []rpcclient.DialOption{
 			rpcclient.WithBlock(),
 			rpcclient.WithPerRPCCredentials(creds),
+			rpcclient.WithUnaryInterceptor(pipedservice.DeploymentTokenUnaryClientInterceptor(logger)),
 			rpcclient.WithMaxRecvMsgSize(p.maxRecvMsgSize),
 		}
 	)
@@ -733,6 +734,7 @@ func (p *piped) sendPipedMeta(ctx context.Context, client pipedservice.Client, c
 		Config:       string(maskedCfg),
 		Repositories: repos,
 		Plugins:      make([]*model.Piped_Plugin, 0, len(cfg.Plugins)),
+		Labels:       cfg.Labels,
 	}
 
 	// Configure the list of plugins
@@ -371,6 +371,9 @@ func (s *scheduler) Run(ctx context.Context) error {
 		if result == model.StageStatus_STAGE_CANCELLED {
 			deploymentStatus = model.DeploymentStatus_DEPLOYMENT_CANCELLED
 			statusReason = fmt.Sprintf("Cancelled by %s while executing stage %s", cancelCommander, ps.Id)
+			if cancelCommand != nil && cancelCommand.Metadata[model.MetadataKeyComment] != "" {
+				statusReason = fmt.Sprintf("%s: %s", statusReason, cancelCommand.Metadata[model.MetadataKeyComment])
+			}
 			break
 		}
 
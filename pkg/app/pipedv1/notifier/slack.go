@@ -219,6 +219,7 @@ func (s *slack) buildSlackMessage(event model.NotificationEvent, webURL string)
 			{"Mention To Groups", groupsStr, true},
 			{"Started At", makeSlackDate(d.CreatedAt), true},
 		}
+		fields = append(fields, makeTriggerTraceFields(d.Trigger.GetTrace())...)
 	}
 
 	generateDeploymentEventDataForTriggerFailed := func(app *model.Application, hash string, msg string, accounts []string, groups []string) {
@@ -415,6 +416,31 @@ func makeSlackLink(title, url string) string {
 	return fmt.Sprintf("<%s|%s>", url, title)
 }
 
+// makeTriggerTraceFields returns the fields linking back to what caused the deployment.
+func makeTriggerTraceFields(trace *model.DeploymentTriggerTrace) []slackField {
+	if trace == nil {
+		return nil
+	}
+	var fields []slackField
+	if trace.EventName != "" {
+		fields = append(fields, slackField{"Event", trace.EventName, true})
+	}
+	if trace.CommitHash != "" {
+		commit := truncateText(trace.CommitHash, 8)
+		if trace.CommitUrl != "" {
+			commit = makeSlackLink(commit, trace.CommitUrl)
+		}
+		if trace.CommitAuthor != "" {
+			commit = fmt.Sprintf("%s by %s", commit, trace.CommitAuthor)
+		}
+		fields = append(fields, slackField{"Trigger Commit", commit, true})
+	}
+	if trace.RunUrl != "" {
+		fields = append(fields, slackField{"CI Run", makeSlackLink("Open", trace.RunUrl), true})
+	}
+	return fields
+}
+
 func makeSlackDate(unix int64) string {
 	return fmt.Sprintf("<!date^%d^{date_num} {time_secs}|date>", unix)
 }
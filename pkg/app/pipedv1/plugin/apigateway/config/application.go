@@ -0,0 +1,122 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
+)
+
+// APIGatewayApplicationSpec represents an application configuration for API Gateway application.
+type APIGatewayApplicationSpec struct {
+	// Input for API Gateway deployment such as the REST API id, the definition file...
+	Input APIGatewayDeploymentInput `json:"input"`
+	// Configuration for quick sync.
+	QuickSync APIGatewaySyncStageOptions `json:"quickSync"`
+}
+
+func (s *APIGatewayApplicationSpec) Validate() error {
+	if err := s.Input.validate(); err != nil {
+		return fmt.Errorf("invalid input: %w", err)
+	}
+	return nil
+}
+
+// APIGatewayDeploymentInput represents needed input for triggering an API Gateway deployment.
+type APIGatewayDeploymentInput struct {
+	// The id of the REST API to be updated.
+	RestAPIID string `json:"restApiId"`
+	// The path to the OpenAPI definition file of the REST API.
+	// Both JSON and YAML formats are supported.
+	// The path is relative to the application directory.
+	DefinitionFile string `json:"definitionFile" default:"openapi.yaml"`
+	// The name of the stage where the API is deployed.
+	StageName string `json:"stageName"`
+	// How the definition is imported into the REST API, "overwrite" or "merge".
+	PutMode string `json:"putMode" default:"overwrite"`
+	// Whether the import should be rolled back when a warning is encountered.
+	FailOnWarnings bool `json:"failOnWarnings"`
+}
+
+func (in APIGatewayDeploymentInput) validate() error {
+	if in.RestAPIID == "" {
+		return errors.New("restApiId is required")
+	}
+	if in.DefinitionFile == "" {
+		return errors.New("definitionFile is required")
+	}
+	if in.StageName == "" {
+		return errors.New("stageName is required")
+	}
+	switch in.PutMode {
+	case "overwrite", "merge":
+	default:
+		return fmt.Errorf("unsupported putMode %q, it must be overwrite or merge", in.PutMode)
+	}
+	return nil
+}
+
+// APIGatewayDeployTargetConfig represents PipedDeployTarget.Config for API Gateway.
+type APIGatewayDeployTargetConfig struct {
+	// The region to send requests to.
+	Region string `json:"region"`
+	// Path to the shared credentials file.
+	// Empty means the default credentials file will be used.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+	// The IAM role arn to use when assuming an role.
+	// This is used together with TokenFile.
+	RoleARN string `json:"roleARN,omitempty"`
+	// Path to the WebIdentity token the SDK should use to assume a role with.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// AWS Profile to extract credentials from the shared credentials file.
+	// Empty means "default" profile will be used.
+	Profile string `json:"profile,omitempty"`
+}
+
+// APIGatewaySyncStageOptions contains all configurable values for a APIGATEWAY_SYNC stage.
+type APIGatewaySyncStageOptions struct {
+}
+
+// APIGatewayCanaryRolloutStageOptions contains all configurable values for a APIGATEWAY_CANARY_ROLLOUT stage.
+type APIGatewayCanaryRolloutStageOptions struct {
+	// Percentage of traffic should be routed to the canary deployment.
+	Percent config.Percentage `json:"percent"`
+}
+
+// Validate checks the percentage is in the valid range.
+func (o APIGatewayCanaryRolloutStageOptions) Validate() error {
+	return validatePercent(o.Percent)
+}
+
+// APIGatewayPromoteStageOptions contains all configurable values for a APIGATEWAY_PROMOTE stage.
+type APIGatewayPromoteStageOptions struct {
+	// Percentage of traffic should be routed to the canary deployment.
+	// 100 means the canary deployment will be promoted to be the stage's deployment.
+	Percent config.Percentage `json:"percent"`
+}
+
+// Validate checks the percentage is in the valid range.
+func (o APIGatewayPromoteStageOptions) Validate() error {
+	return validatePercent(o.Percent)
+}
+
+func validatePercent(p config.Percentage) error {
+	if v := p.Int(); v < 0 || v > 100 {
+		return fmt.Errorf("percent must be in range [0, 100] but got %d", v)
+	}
+	return nil
+}
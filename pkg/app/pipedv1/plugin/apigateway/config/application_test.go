@@ -0,0 +1,78 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
+)
+
+func TestAPIGatewayApplicationSpec_Validate(t *testing.T) {
+	t.Parallel()
+
+	valid := APIGatewayDeploymentInput{
+		RestAPIID:      "abcdef",
+		DefinitionFile: "openapi.yaml",
+		StageName:      "prod",
+		PutMode:        "overwrite",
+	}
+
+	testcases := []struct {
+		name        string
+		modify      func(in *APIGatewayDeploymentInput)
+		expectedErr bool
+	}{
+		{
+			name:   "valid",
+			modify: func(in *APIGatewayDeploymentInput) {},
+		},
+		{
+			name:        "missing rest api id",
+			modify:      func(in *APIGatewayDeploymentInput) { in.RestAPIID = "" },
+			expectedErr: true,
+		},
+		{
+			name:        "missing stage name",
+			modify:      func(in *APIGatewayDeploymentInput) { in.StageName = "" },
+			expectedErr: true,
+		},
+		{
+			name:        "unsupported put mode",
+			modify:      func(in *APIGatewayDeploymentInput) { in.PutMode = "replace" },
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			in := valid
+			tc.modify(&in)
+			spec := APIGatewayApplicationSpec{Input: in}
+			err := spec.Validate()
+			assert.Equal(t, tc.expectedErr, err != nil)
+		})
+	}
+}
+
+func TestAPIGatewayPromoteStageOptions_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, APIGatewayPromoteStageOptions{Percent: config.Percentage{Number: 100}}.Validate())
+	assert.Error(t, APIGatewayPromoteStageOptions{Percent: config.Percentage{Number: 101}}.Validate())
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	agconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/apigateway/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/apigateway/provider"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+const (
+	// metadataKeyCanaryDeploymentID is the key of the deployment metadata
+	// storing the id of the canary deployment created by this deployment.
+	metadataKeyCanaryDeploymentID = "apigateway-canary-deployment-id"
+	// metadataKeyPreviousStage is the key of the deployment metadata
+	// storing the stage state before it was changed by this deployment.
+	metadataKeyPreviousStage = "apigateway-previous-stage"
+)
+
+type apiGatewayClient interface {
+	PutRestAPI(ctx context.Context, restAPIID string, definition []byte, mode string, failOnWarnings bool) error
+	CreateDeployment(ctx context.Context, restAPIID, stageName, description string, canaryPercent *float64) (string, error)
+	GetStage(ctx context.Context, restAPIID, stageName string) (provider.Stage, bool, error)
+	UpdateStage(ctx context.Context, restAPIID, stageName string, ops []provider.PatchOperation) error
+}
+
+type metadataStore interface {
+	GetDeploymentPluginMetadata(ctx context.Context, key string) (string, error)
+	PutDeploymentPluginMetadataMulti(ctx context.Context, metadata map[string]string) error
+}
+
+// previousStage represents the stage state before it was changed by this deployment.
+type previousStage struct {
+	Exists       bool   `json:"exists"`
+	DeploymentID string `json:"deploymentId"`
+}
+
+func newClient(ctx context.Context, dts []*sdk.DeployTarget[agconfig.APIGatewayDeployTargetConfig]) (*provider.Client, error) {
+	if len(dts) == 0 {
+		return nil, errors.New("no deploy target was found")
+	}
+	return provider.NewClient(ctx, dts[0].Config)
+}
+
+// putRestAPI imports the OpenAPI definition placed in the given application directory into the REST API.
+func putRestAPI(ctx context.Context, lp sdk.StageLogPersister, client apiGatewayClient, in agconfig.APIGatewayDeploymentInput, appDir string) bool {
+	definition, err := provider.LoadDefinition(appDir, in.DefinitionFile)
+	if err != nil {
+		lp.Errorf("Failed while loading API definition (%v)", err)
+		return false
+	}
+
+	lp.Infof("Start importing API definition into REST API %s", in.RestAPIID)
+	if err := client.PutRestAPI(ctx, in.RestAPIID, definition, in.PutMode, in.FailOnWarnings); err != nil {
+		lp.Errorf("Failed while importing API definition (%v)", err)
+		return false
+	}
+	lp.Successf("Successfully imported API definition into REST API %s", in.RestAPIID)
+	return true
+}
+
+// recordStage stores the current state of the stage so that it can be restored while rolling back.
+// Only the state before the first change made by this deployment is stored.
+// It returns the current state of the stage and whether the stage exists.
+func recordStage(ctx context.Context, lp sdk.StageLogPersister, client apiGatewayClient, store metadataStore, in agconfig.APIGatewayDeploymentInput) (provider.Stage, bool, bool) {
+	current, exists, err := client.GetStage(ctx, in.RestAPIID, in.StageName)
+	if err != nil {
+		lp.Errorf("Failed while getting stage %s (%v)", in.StageName, err)
+		return provider.Stage{}, false, false
+	}
+
+	recorded, err := store.GetDeploymentPluginMetadata(ctx, metadataKeyPreviousStage)
+	if err != nil {
+		lp.Errorf("Failed while getting deployment metadata (%v)", err)
+		return provider.Stage{}, false, false
+	}
+	if recorded != "" {
+		return current, exists, true
+	}
+
+	data, err := json.Marshal(previousStage{
+		Exists:       exists,
+		DeploymentID: current.DeploymentID,
+	})
+	if err != nil {
+		lp.Errorf("Failed while marshaling the state of stage %s (%v)", in.StageName, err)
+		return provider.Stage{}, false, false
+	}
+	if err := store.PutDeploymentPluginMetadataMulti(ctx, map[string]string{
+		metadataKeyPreviousStage: string(data),
+	}); err != nil {
+		lp.Errorf("Failed while storing deployment metadata (%v)", err)
+		return provider.Stage{}, false, false
+	}
+
+	return current, exists, true
+}
+
+func updateStage(ctx context.Context, lp sdk.StageLogPersister, client apiGatewayClient, in agconfig.APIGatewayDeploymentInput, ops []provider.PatchOperation) bool {
+	lp.Infof("Start updating stage %s", in.StageName)
+	if err := client.UpdateStage(ctx, in.RestAPIID, in.StageName, ops); err != nil {
+		lp.Errorf("Failed while updating stage %s (%v)", in.StageName, err)
+		return false
+	}
+	lp.Successf("Successfully updated stage %s", in.StageName)
+	return true
+}
+
+func deploymentDescription(commitHash string) string {
+	return fmt.Sprintf("Deployed by PipeCD at commit %s", commitHash)
+}
@@ -0,0 +1,187 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	agconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/apigateway/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/apigateway/provider"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/pipe-cd/piped-plugin-sdk-go/logpersister/logpersistertest"
+)
+
+// fakeClient emulates a single stage of a REST API.
+type fakeClient struct {
+	deployments int
+	stage       *provider.Stage
+}
+
+func (c *fakeClient) PutRestAPI(_ context.Context, _ string, _ []byte, _ string, _ bool) error {
+	return nil
+}
+
+func (c *fakeClient) CreateDeployment(_ context.Context, _, _, _ string, canaryPercent *float64) (string, error) {
+	c.deployments++
+	id := fmt.Sprintf("d%d", c.deployments)
+	switch {
+	case c.stage == nil:
+		c.stage = &provider.Stage{DeploymentID: id}
+	case canaryPercent != nil:
+		c.stage.CanaryDeploymentID = id
+		c.stage.CanaryPercent = *canaryPercent
+	default:
+		c.stage.DeploymentID = id
+	}
+	return id, nil
+}
+
+func (c *fakeClient) GetStage(_ context.Context, _, _ string) (provider.Stage, bool, error) {
+	if c.stage == nil {
+		return provider.Stage{}, false, nil
+	}
+	return *c.stage, true, nil
+}
+
+func (c *fakeClient) UpdateStage(_ context.Context, _, _ string, ops []provider.PatchOperation) error {
+	for _, op := range ops {
+		switch op.Path {
+		case "/deploymentId":
+			c.stage.DeploymentID = op.Value
+		case "/canarySettings":
+			c.stage.CanaryDeploymentID = ""
+			c.stage.CanaryPercent = 0
+		case "/canarySettings/percentTraffic":
+			var p float64
+			fmt.Sscanf(op.Value, "%g", &p)
+			c.stage.CanaryPercent = p
+		}
+	}
+	return nil
+}
+
+type fakeStore map[string]string
+
+func (s fakeStore) GetDeploymentPluginMetadata(_ context.Context, key string) (string, error) {
+	return s[key], nil
+}
+
+func (s fakeStore) PutDeploymentPluginMetadataMulti(_ context.Context, metadata map[string]string) error {
+	for k, v := range metadata {
+		s[k] = v
+	}
+	return nil
+}
+
+func newTestDeploymentSource(t *testing.T) (agconfig.APIGatewayDeploymentInput, *sdk.DeploymentSource[agconfig.APIGatewayApplicationSpec]) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "openapi.yaml"), []byte("openapi: 3.0.1\ninfo:\n  title: hello\n"), 0o644))
+
+	in := agconfig.APIGatewayDeploymentInput{
+		RestAPIID:      "abcdef",
+		DefinitionFile: "openapi.yaml",
+		StageName:      "prod",
+		PutMode:        "overwrite",
+	}
+	ds := &sdk.DeploymentSource[agconfig.APIGatewayApplicationSpec]{
+		ApplicationDirectory: dir,
+		CommitHash:           "0123456789",
+	}
+	return in, ds
+}
+
+func TestSyncAndRollback(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	in, ds := newTestDeploymentSource(t)
+	client := &fakeClient{deployments: 1, stage: &provider.Stage{DeploymentID: "d1"}}
+	store := fakeStore{}
+
+	status := syncStage(ctx, logpersistertest.NewTestLogPersister(t), client, store, in, ds)
+	require.Equal(t, sdk.StageStatusSuccess, status)
+	assert.Equal(t, provider.Stage{DeploymentID: "d2"}, *client.stage)
+
+	status = rollback(ctx, logpersistertest.NewTestLogPersister(t), client, store, in)
+	require.Equal(t, sdk.StageStatusSuccess, status)
+	assert.Equal(t, provider.Stage{DeploymentID: "d1"}, *client.stage)
+}
+
+func TestCanaryRolloutPromoteAndRollback(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	in, ds := newTestDeploymentSource(t)
+	client := &fakeClient{deployments: 1, stage: &provider.Stage{DeploymentID: "d1"}}
+	store := fakeStore{}
+
+	status := canaryRollout(ctx, logpersistertest.NewTestLogPersister(t), client, store, in, ds, 10)
+	require.Equal(t, sdk.StageStatusSuccess, status)
+	assert.Equal(t, provider.Stage{DeploymentID: "d1", CanaryDeploymentID: "d2", CanaryPercent: 10}, *client.stage)
+
+	status = promote(ctx, logpersistertest.NewTestLogPersister(t), client, store, in, 50)
+	require.Equal(t, sdk.StageStatusSuccess, status)
+	assert.Equal(t, provider.Stage{DeploymentID: "d1", CanaryDeploymentID: "d2", CanaryPercent: 50}, *client.stage)
+
+	status = rollback(ctx, logpersistertest.NewTestLogPersister(t), client, store, in)
+	require.Equal(t, sdk.StageStatusSuccess, status)
+	assert.Equal(t, provider.Stage{DeploymentID: "d1"}, *client.stage)
+}
+
+func TestCanaryRolloutAndFullPromote(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	in, ds := newTestDeploymentSource(t)
+	client := &fakeClient{deployments: 1, stage: &provider.Stage{DeploymentID: "d1"}}
+	store := fakeStore{}
+
+	status := canaryRollout(ctx, logpersistertest.NewTestLogPersister(t), client, store, in, ds, 10)
+	require.Equal(t, sdk.StageStatusSuccess, status)
+
+	status = promote(ctx, logpersistertest.NewTestLogPersister(t), client, store, in, 100)
+	require.Equal(t, sdk.StageStatusSuccess, status)
+	assert.Equal(t, provider.Stage{DeploymentID: "d2"}, *client.stage)
+}
+
+func TestPromoteWithoutCanaryRollout(t *testing.T) {
+	t.Parallel()
+
+	in, _ := newTestDeploymentSource(t)
+	status := promote(context.Background(), logpersistertest.NewTestLogPersister(t), &fakeClient{}, fakeStore{}, in, 50)
+	assert.Equal(t, sdk.StageStatusFailure, status)
+}
+
+func TestRollbackFirstDeployment(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	in, ds := newTestDeploymentSource(t)
+	client := &fakeClient{}
+	store := fakeStore{}
+
+	status := syncStage(ctx, logpersistertest.NewTestLogPersister(t), client, store, in, ds)
+	require.Equal(t, sdk.StageStatusSuccess, status)
+
+	status = rollback(ctx, logpersistertest.NewTestLogPersister(t), client, store, in)
+	assert.Equal(t, sdk.StageStatusFailure, status)
+}
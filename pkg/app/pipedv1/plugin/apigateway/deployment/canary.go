@@ -0,0 +1,91 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+
+	agconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/apigateway/config"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+func (p *Plugin) executeAPIGatewayCanaryRolloutStage(ctx context.Context, input *sdk.ExecuteStageInput[agconfig.APIGatewayApplicationSpec], dts []*sdk.DeployTarget[agconfig.APIGatewayDeployTargetConfig]) sdk.StageStatus {
+	lp := input.Client.LogPersister()
+
+	cfg, err := input.Request.TargetDeploymentSource.AppConfig()
+	if err != nil {
+		lp.Errorf("Failed while loading application config (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	var opts agconfig.APIGatewayCanaryRolloutStageOptions
+	if err := json.Unmarshal(input.Request.StageConfig, &opts); err != nil {
+		lp.Errorf("Failed while unmarshaling the stage config (%v)", err)
+		return sdk.StageStatusFailure
+	}
+	if err := opts.Validate(); err != nil {
+		lp.Errorf("Invalid stage config (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	client, err := newClient(ctx, dts)
+	if err != nil {
+		lp.Errorf("Failed while creating API Gateway client (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	return canaryRollout(ctx, lp, client, input.Client, cfg.Spec.Input, &input.Request.TargetDeploymentSource, opts.Percent.Int())
+}
+
+// canaryRollout creates a new deployment of the REST API and adds it to the stage as a canary
+// receiving the given percentage of traffic.
+func canaryRollout(ctx context.Context, lp sdk.StageLogPersister, client apiGatewayClient, store metadataStore, in agconfig.APIGatewayDeploymentInput, ds *sdk.DeploymentSource[agconfig.APIGatewayApplicationSpec], percent int) sdk.StageStatus {
+	if !putRestAPI(ctx, lp, client, in, ds.ApplicationDirectory) {
+		return sdk.StageStatusFailure
+	}
+
+	_, exists, ok := recordStage(ctx, lp, client, store, in)
+	if !ok {
+		return sdk.StageStatusFailure
+	}
+
+	// Since there is no deployment serving traffic yet,
+	// the stage is created to serve the new deployment.
+	var canaryPercent *float64
+	if exists {
+		p := float64(percent)
+		canaryPercent = &p
+		lp.Infof("Start deploying REST API %s to stage %s as a canary receiving %d%% of traffic", in.RestAPIID, in.StageName, percent)
+	} else {
+		lp.Infof("Stage %s does not exist, it will be created to serve the new deployment", in.StageName)
+	}
+
+	id, err := client.CreateDeployment(ctx, in.RestAPIID, in.StageName, deploymentDescription(ds.CommitHash), canaryPercent)
+	if err != nil {
+		lp.Errorf("Failed while creating deployment (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	if err := store.PutDeploymentPluginMetadataMulti(ctx, map[string]string{
+		metadataKeyCanaryDeploymentID: id,
+	}); err != nil {
+		lp.Errorf("Failed while storing deployment metadata (%v)", err)
+		return sdk.StageStatusFailure
+	}
+	lp.Successf("Successfully deployed %s to stage %s", id, in.StageName)
+
+	return sdk.StageStatusSuccess
+}
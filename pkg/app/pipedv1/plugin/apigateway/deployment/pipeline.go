@@ -0,0 +1,107 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"slices"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+const (
+	// StageAPIGatewaySync represents the state where
+	// the REST API should be synced with the Git state
+	// and the stage should serve the new deployment.
+	StageAPIGatewaySync = "APIGATEWAY_SYNC"
+	// StageAPIGatewayCanaryRollout represents the state where
+	// a new deployment of the REST API has been added to the stage as a canary.
+	StageAPIGatewayCanaryRollout = "APIGATEWAY_CANARY_ROLLOUT"
+	// StageAPIGatewayPromote represents the state where
+	// the specified percentage of traffic should be routed to the canary deployment.
+	StageAPIGatewayPromote = "APIGATEWAY_PROMOTE"
+	// StageAPIGatewayRollback represents the state where
+	// the stage should be pointed back to the deployment it served before the deployment.
+	StageAPIGatewayRollback = "APIGATEWAY_ROLLBACK"
+)
+
+var allStages = []string{
+	StageAPIGatewaySync,
+	StageAPIGatewayCanaryRollout,
+	StageAPIGatewayPromote,
+	StageAPIGatewayRollback,
+}
+
+const (
+	// StageDescriptionAPIGatewaySync represents the description of the APIGatewaySync stage.
+	StageDescriptionAPIGatewaySync = "Sync by importing the API definition and deploying it to the stage"
+	// StageDescriptionAPIGatewayRollback represents the description of the APIGatewayRollback stage.
+	StageDescriptionAPIGatewayRollback = "Rollback the deployment"
+)
+
+func buildQuickSyncPipeline(autoRollback bool) []sdk.QuickSyncStage {
+	out := make([]sdk.QuickSyncStage, 0, 2)
+
+	out = append(out, sdk.QuickSyncStage{
+		Name:               StageAPIGatewaySync,
+		Description:        StageDescriptionAPIGatewaySync,
+		Rollback:           false,
+		Metadata:           make(map[string]string, 0),
+		AvailableOperation: sdk.ManualOperationNone,
+	})
+
+	if autoRollback {
+		out = append(out, sdk.QuickSyncStage{
+			Name:               StageAPIGatewayRollback,
+			Description:        StageDescriptionAPIGatewayRollback,
+			Rollback:           true,
+			Metadata:           make(map[string]string, 0),
+			AvailableOperation: sdk.ManualOperationNone,
+		})
+	}
+
+	return out
+}
+
+// buildPipelineStages builds the pipeline stages with the given SDK stages.
+func buildPipelineStages(stages []sdk.StageConfig, autoRollback bool) []sdk.PipelineStage {
+	out := make([]sdk.PipelineStage, 0, len(stages)+1)
+
+	for _, s := range stages {
+		out = append(out, sdk.PipelineStage{
+			Name:               s.Name,
+			Index:              s.Index,
+			Rollback:           false,
+			Metadata:           make(map[string]string, 0),
+			AvailableOperation: sdk.ManualOperationNone,
+		})
+	}
+
+	if autoRollback && len(stages) > 0 {
+		// we set the index of the rollback stage to the minimum index of all stages.
+		minIndex := slices.MinFunc(stages, func(a, b sdk.StageConfig) int {
+			return a.Index - b.Index
+		}).Index
+
+		out = append(out, sdk.PipelineStage{
+			Name:               StageAPIGatewayRollback,
+			Index:              minIndex,
+			Rollback:           true,
+			Metadata:           make(map[string]string, 0),
+			AvailableOperation: sdk.ManualOperationNone,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	agconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/apigateway/config"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+// Plugin implements the sdk.DeploymentPlugin interface.
+type Plugin struct {
+}
+
+var _ sdk.DeploymentPlugin[sdk.ConfigNone, agconfig.APIGatewayDeployTargetConfig, agconfig.APIGatewayApplicationSpec] = (*Plugin)(nil)
+
+// FetchDefinedStages returns the defined stages for this plugin.
+func (p *Plugin) FetchDefinedStages() []string {
+	return allStages
+}
+
+// BuildPipelineSyncStages returns the stages for the pipeline sync strategy.
+func (p *Plugin) BuildPipelineSyncStages(ctx context.Context, _ *sdk.ConfigNone, input *sdk.BuildPipelineSyncStagesInput) (*sdk.BuildPipelineSyncStagesResponse, error) {
+	return &sdk.BuildPipelineSyncStagesResponse{
+		Stages: buildPipelineStages(input.Request.Stages, input.Request.Rollback),
+	}, nil
+}
+
+// BuildQuickSyncStages returns the stages for the quick sync strategy.
+func (p *Plugin) BuildQuickSyncStages(ctx context.Context, _ *sdk.ConfigNone, input *sdk.BuildQuickSyncStagesInput) (*sdk.BuildQuickSyncStagesResponse, error) {
+	return &sdk.BuildQuickSyncStagesResponse{
+		Stages: buildQuickSyncPipeline(input.Request.Rollback),
+	}, nil
+}
+
+// ExecuteStage executes the stage.
+func (p *Plugin) ExecuteStage(ctx context.Context, _ *sdk.ConfigNone, dts []*sdk.DeployTarget[agconfig.APIGatewayDeployTargetConfig], input *sdk.ExecuteStageInput[agconfig.APIGatewayApplicationSpec]) (*sdk.ExecuteStageResponse, error) {
+	switch input.Request.StageName {
+	case StageAPIGatewaySync:
+		return &sdk.ExecuteStageResponse{
+			Status: p.executeAPIGatewaySyncStage(ctx, input, dts),
+		}, nil
+	case StageAPIGatewayCanaryRollout:
+		return &sdk.ExecuteStageResponse{
+			Status: p.executeAPIGatewayCanaryRolloutStage(ctx, input, dts),
+		}, nil
+	case StageAPIGatewayPromote:
+		return &sdk.ExecuteStageResponse{
+			Status: p.executeAPIGatewayPromoteStage(ctx, input, dts),
+		}, nil
+	case StageAPIGatewayRollback:
+		return &sdk.ExecuteStageResponse{
+			Status: p.executeAPIGatewayRollbackStage(ctx, input, dts),
+		}, nil
+	default:
+		return nil, errors.New("unimplemented or unsupported stage")
+	}
+}
+
+// DetermineVersions determines the versions of the application.
+func (p *Plugin) DetermineVersions(ctx context.Context, _ *sdk.ConfigNone, input *sdk.DetermineVersionsInput[agconfig.APIGatewayApplicationSpec]) (*sdk.DetermineVersionsResponse, error) {
+	logger := input.Logger
+
+	cfg, err := input.Request.DeploymentSource.AppConfig()
+	if err != nil {
+		logger.Error("Failed while loading application config", zap.Error(err))
+		return nil, err
+	}
+
+	return &sdk.DetermineVersionsResponse{
+		Versions: []sdk.ArtifactVersion{
+			{
+				Kind:    sdk.ArtifactKindUnknown,
+				Name:    cfg.Spec.Input.RestAPIID + "/" + cfg.Spec.Input.StageName,
+				Version: input.Request.DeploymentSource.CommitHash,
+			},
+		},
+	}, nil
+}
+
+// DetermineStrategy determines the strategy for the deployment.
+func (p *Plugin) DetermineStrategy(ctx context.Context, _ *sdk.ConfigNone, input *sdk.DetermineStrategyInput[agconfig.APIGatewayApplicationSpec]) (*sdk.DetermineStrategyResponse, error) {
+	if input.Request.RunningDeploymentSource.CommitHash == "" {
+		return &sdk.DetermineStrategyResponse{
+			Strategy: sdk.SyncStrategyQuickSync,
+			Summary:  "Quick sync because it seems this is the first deployment",
+		}, nil
+	}
+	return &sdk.DetermineStrategyResponse{
+		Strategy: sdk.SyncStrategyPipelineSync,
+		Summary:  "Sync progressively with the specified pipeline",
+	}, nil
+}
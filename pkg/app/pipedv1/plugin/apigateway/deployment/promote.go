@@ -0,0 +1,92 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+
+	agconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/apigateway/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/apigateway/provider"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+func (p *Plugin) executeAPIGatewayPromoteStage(ctx context.Context, input *sdk.ExecuteStageInput[agconfig.APIGatewayApplicationSpec], dts []*sdk.DeployTarget[agconfig.APIGatewayDeployTargetConfig]) sdk.StageStatus {
+	lp := input.Client.LogPersister()
+
+	cfg, err := input.Request.TargetDeploymentSource.AppConfig()
+	if err != nil {
+		lp.Errorf("Failed while loading application config (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	var opts agconfig.APIGatewayPromoteStageOptions
+	if err := json.Unmarshal(input.Request.StageConfig, &opts); err != nil {
+		lp.Errorf("Failed while unmarshaling the stage config (%v)", err)
+		return sdk.StageStatusFailure
+	}
+	if err := opts.Validate(); err != nil {
+		lp.Errorf("Invalid stage config (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	client, err := newClient(ctx, dts)
+	if err != nil {
+		lp.Errorf("Failed while creating API Gateway client (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	return promote(ctx, lp, client, input.Client, cfg.Spec.Input, opts.Percent.Int())
+}
+
+// promote routes the given percentage of traffic to the canary deployment created by the APIGATEWAY_CANARY_ROLLOUT stage.
+func promote(ctx context.Context, lp sdk.StageLogPersister, client apiGatewayClient, store metadataStore, in agconfig.APIGatewayDeploymentInput, percent int) sdk.StageStatus {
+	canaryID, err := store.GetDeploymentPluginMetadata(ctx, metadataKeyCanaryDeploymentID)
+	if err != nil {
+		lp.Errorf("Failed while getting deployment metadata (%v)", err)
+		return sdk.StageStatusFailure
+	}
+	if canaryID == "" {
+		lp.Errorf("Unable to determine the deployment to promote. %s stage must be executed before %s stage", StageAPIGatewayCanaryRollout, StageAPIGatewayPromote)
+		return sdk.StageStatusFailure
+	}
+
+	current, exists, err := client.GetStage(ctx, in.RestAPIID, in.StageName)
+	if err != nil {
+		lp.Errorf("Failed while getting stage %s (%v)", in.StageName, err)
+		return sdk.StageStatusFailure
+	}
+	if !exists {
+		lp.Errorf("Stage %s was not found", in.StageName)
+		return sdk.StageStatusFailure
+	}
+
+	// The stage is already serving the canary deployment, e.g. it was created by the canary rollout.
+	if current.DeploymentID == canaryID && current.CanaryDeploymentID == "" {
+		lp.Successf("Stage %s is already serving deployment %s", in.StageName, canaryID)
+		return sdk.StageStatusSuccess
+	}
+	if current.CanaryDeploymentID != canaryID {
+		lp.Errorf("Stage %s has no canary for deployment %s", in.StageName, canaryID)
+		return sdk.StageStatusFailure
+	}
+
+	lp.Infof("Routing %d%% of traffic to deployment %s", percent, canaryID)
+	if !updateStage(ctx, lp, client, in, provider.MakeShiftOperations(canaryID, percent)) {
+		return sdk.StageStatusFailure
+	}
+
+	return sdk.StageStatusSuccess
+}
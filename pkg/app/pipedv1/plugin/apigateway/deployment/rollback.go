@@ -0,0 +1,80 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+
+	agconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/apigateway/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/apigateway/provider"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+func (p *Plugin) executeAPIGatewayRollbackStage(ctx context.Context, input *sdk.ExecuteStageInput[agconfig.APIGatewayApplicationSpec], dts []*sdk.DeployTarget[agconfig.APIGatewayDeployTargetConfig]) sdk.StageStatus {
+	lp := input.Client.LogPersister()
+
+	cfg, err := input.Request.TargetDeploymentSource.AppConfig()
+	if err != nil {
+		lp.Errorf("Failed while loading application config (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	client, err := newClient(ctx, dts)
+	if err != nil {
+		lp.Errorf("Failed while creating API Gateway client (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	return rollback(ctx, lp, client, input.Client, cfg.Spec.Input)
+}
+
+// rollback points the stage back to the deployment it served before this deployment.
+func rollback(ctx context.Context, lp sdk.StageLogPersister, client apiGatewayClient, store metadataStore, in agconfig.APIGatewayDeploymentInput) sdk.StageStatus {
+	lp.Info("Start rolling back the deployment")
+
+	recorded, err := store.GetDeploymentPluginMetadata(ctx, metadataKeyPreviousStage)
+	if err != nil {
+		lp.Errorf("Failed while getting deployment metadata (%v)", err)
+		return sdk.StageStatusFailure
+	}
+	if recorded == "" {
+		lp.Info("Nothing to rollback because the stage was not changed by this deployment")
+		return sdk.StageStatusSuccess
+	}
+
+	var previous previousStage
+	if err := json.Unmarshal([]byte(recorded), &previous); err != nil {
+		lp.Errorf("Failed while unmarshaling the previous state of stage %s (%v)", in.StageName, err)
+		return sdk.StageStatusFailure
+	}
+	if !previous.Exists {
+		lp.Errorf("Unable to rollback because stage %s did not exist before this deployment. It seems this is the first deployment.", in.StageName)
+		return sdk.StageStatusFailure
+	}
+
+	current, _, err := client.GetStage(ctx, in.RestAPIID, in.StageName)
+	if err != nil {
+		lp.Errorf("Failed while getting stage %s (%v)", in.StageName, err)
+		return sdk.StageStatusFailure
+	}
+
+	lp.Infof("Pointing stage %s back to deployment %s", in.StageName, previous.DeploymentID)
+	if !updateStage(ctx, lp, client, in, provider.MakeRestoreOperations(current, previous.DeploymentID)) {
+		return sdk.StageStatusFailure
+	}
+
+	return sdk.StageStatusSuccess
+}
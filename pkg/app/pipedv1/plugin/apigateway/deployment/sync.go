@@ -0,0 +1,69 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	agconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/apigateway/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/apigateway/provider"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+func (p *Plugin) executeAPIGatewaySyncStage(ctx context.Context, input *sdk.ExecuteStageInput[agconfig.APIGatewayApplicationSpec], dts []*sdk.DeployTarget[agconfig.APIGatewayDeployTargetConfig]) sdk.StageStatus {
+	lp := input.Client.LogPersister()
+
+	cfg, err := input.Request.TargetDeploymentSource.AppConfig()
+	if err != nil {
+		lp.Errorf("Failed while loading application config (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	client, err := newClient(ctx, dts)
+	if err != nil {
+		lp.Errorf("Failed while creating API Gateway client (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	return syncStage(ctx, lp, client, input.Client, cfg.Spec.Input, &input.Request.TargetDeploymentSource)
+}
+
+func syncStage(ctx context.Context, lp sdk.StageLogPersister, client apiGatewayClient, store metadataStore, in agconfig.APIGatewayDeploymentInput, ds *sdk.DeploymentSource[agconfig.APIGatewayApplicationSpec]) sdk.StageStatus {
+	if !putRestAPI(ctx, lp, client, in, ds.ApplicationDirectory) {
+		return sdk.StageStatusFailure
+	}
+
+	current, _, ok := recordStage(ctx, lp, client, store, in)
+	if !ok {
+		return sdk.StageStatusFailure
+	}
+
+	lp.Infof("Start deploying REST API %s to stage %s", in.RestAPIID, in.StageName)
+	id, err := client.CreateDeployment(ctx, in.RestAPIID, in.StageName, deploymentDescription(ds.CommitHash), nil)
+	if err != nil {
+		lp.Errorf("Failed while creating deployment (%v)", err)
+		return sdk.StageStatusFailure
+	}
+	lp.Successf("Successfully deployed %s to stage %s", id, in.StageName)
+
+	// Remove the remaining canary to make the stage serve only the new deployment.
+	if current.CanaryDeploymentID != "" {
+		if !updateStage(ctx, lp, client, in, provider.MakeRestoreOperations(current, id)) {
+			return sdk.StageStatusFailure
+		}
+	}
+
+	return sdk.StageStatusSuccess
+}
@@ -0,0 +1,35 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"log"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/apigateway/deployment"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+func main() {
+	plugin, err := sdk.NewPlugin(
+		"apigateway", "0.0.1",
+		sdk.WithDeploymentPlugin(&deployment.Plugin{}),
+	)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err := plugin.Run(); err != nil {
+		log.Fatalln(err)
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+
+	agconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/apigateway/config"
+)
+
+// Stage represents the deployments currently served by an API Gateway stage.
+type Stage struct {
+	DeploymentID string
+	// Empty means the stage has no canary deployment.
+	CanaryDeploymentID string
+	CanaryPercent      float64
+}
+
+// PatchOperation represents an operation to update an API Gateway stage.
+type PatchOperation struct {
+	Op    string
+	Path  string
+	Value string
+}
+
+// Client is a wrapper of the API Gateway client.
+type Client struct {
+	client *apigateway.Client
+}
+
+// NewClient creates a new API Gateway client by using the given deploy target config.
+func NewClient(ctx context.Context, cfg agconfig.APIGatewayDeployTargetConfig) (*Client, error) {
+	if cfg.Region == "" {
+		return nil, errors.New("region is required field")
+	}
+
+	optFns := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(cfg.Region)}
+	if cfg.CredentialsFile != "" {
+		optFns = append(optFns, awsconfig.WithSharedCredentialsFiles([]string{cfg.CredentialsFile}))
+	}
+	if cfg.Profile != "" {
+		optFns = append(optFns, awsconfig.WithSharedConfigProfile(cfg.Profile))
+	}
+	if cfg.TokenFile != "" && cfg.RoleARN != "" {
+		optFns = append(optFns, awsconfig.WithWebIdentityRoleCredentialOptions(func(v *stscreds.WebIdentityRoleOptions) {
+			v.RoleARN = cfg.RoleARN
+			v.TokenRetriever = stscreds.IdentityTokenFile(cfg.TokenFile)
+		}))
+	}
+
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config to create api gateway client: %w", err)
+	}
+	return &Client{client: apigateway.NewFromConfig(awsCfg)}, nil
+}
+
+// PutRestAPI imports the given OpenAPI definition into the REST API.
+func (c *Client) PutRestAPI(ctx context.Context, restAPIID string, definition []byte, mode string, failOnWarnings bool) error {
+	_, err := c.client.PutRestApi(ctx, &apigateway.PutRestApiInput{
+		RestApiId:      aws.String(restAPIID),
+		Body:           definition,
+		Mode:           types.PutMode(mode),
+		FailOnWarnings: failOnWarnings,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put rest api %s: %w", restAPIID, err)
+	}
+	return nil
+}
+
+// CreateDeployment creates a new deployment of the REST API for the given stage.
+// When canaryPercent is not nil, the deployment is added to the stage as a canary
+// receiving the given percentage of traffic instead of replacing the stage's deployment.
+func (c *Client) CreateDeployment(ctx context.Context, restAPIID, stageName, description string, canaryPercent *float64) (string, error) {
+	input := &apigateway.CreateDeploymentInput{
+		RestApiId:   aws.String(restAPIID),
+		StageName:   aws.String(stageName),
+		Description: aws.String(description),
+	}
+	if canaryPercent != nil {
+		input.CanarySettings = &types.DeploymentCanarySettings{
+			PercentTraffic: *canaryPercent,
+		}
+	}
+
+	out, err := c.client.CreateDeployment(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to create deployment for stage %s: %w", stageName, err)
+	}
+	return aws.ToString(out.Id), nil
+}
+
+// GetStage returns the deployments currently served by the given stage.
+// The returned bool is false when the stage does not exist.
+func (c *Client) GetStage(ctx context.Context, restAPIID, stageName string) (Stage, bool, error) {
+	out, err := c.client.GetStage(ctx, &apigateway.GetStageInput{
+		RestApiId: aws.String(restAPIID),
+		StageName: aws.String(stageName),
+	})
+	if err != nil {
+		var nfe *types.NotFoundException
+		if errors.As(err, &nfe) {
+			return Stage{}, false, nil
+		}
+		return Stage{}, false, fmt.Errorf("failed to get stage %s: %w", stageName, err)
+	}
+
+	stage := Stage{
+		DeploymentID: aws.ToString(out.DeploymentId),
+	}
+	if out.CanarySettings != nil {
+		stage.CanaryDeploymentID = aws.ToString(out.CanarySettings.DeploymentId)
+		stage.CanaryPercent = out.CanarySettings.PercentTraffic
+	}
+	return stage, true, nil
+}
+
+// UpdateStage applies the given patch operations to the stage.
+func (c *Client) UpdateStage(ctx context.Context, restAPIID, stageName string, ops []PatchOperation) error {
+	patches := make([]types.PatchOperation, 0, len(ops))
+	for _, op := range ops {
+		p := types.PatchOperation{
+			Op:   types.Op(op.Op),
+			Path: aws.String(op.Path),
+		}
+		if op.Value != "" {
+			p.Value = aws.String(op.Value)
+		}
+		patches = append(patches, p)
+	}
+
+	if _, err := c.client.UpdateStage(ctx, &apigateway.UpdateStageInput{
+		RestApiId:       aws.String(restAPIID),
+		StageName:       aws.String(stageName),
+		PatchOperations: patches,
+	}); err != nil {
+		return fmt.Errorf("failed to update stage %s: %w", stageName, err)
+	}
+	return nil
+}
+
+// MakeShiftOperations returns the operations to route the given percentage of traffic to the canary deployment.
+// When percent is 100, the canary deployment is promoted to be the stage's deployment and the canary settings are removed.
+func MakeShiftOperations(canaryDeploymentID string, percent int) []PatchOperation {
+	if percent >= 100 {
+		return []PatchOperation{
+			{Op: "replace", Path: "/deploymentId", Value: canaryDeploymentID},
+			{Op: "remove", Path: "/canarySettings"},
+		}
+	}
+	return []PatchOperation{
+		{Op: "replace", Path: "/canarySettings/percentTraffic", Value: strconv.Itoa(percent)},
+	}
+}
+
+// MakeRestoreOperations returns the operations to point the stage back to the given deployment.
+// The canary settings are removed when the stage currently has a canary deployment.
+func MakeRestoreOperations(current Stage, deploymentID string) []PatchOperation {
+	ops := []PatchOperation{
+		{Op: "replace", Path: "/deploymentId", Value: deploymentID},
+	}
+	if current.CanaryDeploymentID != "" {
+		ops = append(ops, PatchOperation{Op: "remove", Path: "/canarySettings"})
+	}
+	return ops
+}
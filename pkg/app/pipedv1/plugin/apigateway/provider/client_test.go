@@ -0,0 +1,47 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakeShiftOperations(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []PatchOperation{
+		{Op: "replace", Path: "/canarySettings/percentTraffic", Value: "30"},
+	}, MakeShiftOperations("canary", 30))
+
+	assert.Equal(t, []PatchOperation{
+		{Op: "replace", Path: "/deploymentId", Value: "canary"},
+		{Op: "remove", Path: "/canarySettings"},
+	}, MakeShiftOperations("canary", 100))
+}
+
+func TestMakeRestoreOperations(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []PatchOperation{
+		{Op: "replace", Path: "/deploymentId", Value: "previous"},
+	}, MakeRestoreOperations(Stage{DeploymentID: "current"}, "previous"))
+
+	assert.Equal(t, []PatchOperation{
+		{Op: "replace", Path: "/deploymentId", Value: "previous"},
+		{Op: "remove", Path: "/canarySettings"},
+	}, MakeRestoreOperations(Stage{DeploymentID: "current", CanaryDeploymentID: "canary", CanaryPercent: 10}, "previous"))
+}
@@ -0,0 +1,30 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// LoadDefinition reads the OpenAPI definition file placed in the application directory.
+func LoadDefinition(appDir, definitionFile string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(appDir, definitionFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read definition file %s: %w", definitionFile, err)
+	}
+	return data, nil
+}
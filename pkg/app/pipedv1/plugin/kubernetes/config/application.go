@@ -80,6 +80,11 @@ type KubernetesDeploymentInput struct {
 	// Automatically create a new namespace if it does not exist.
 	// Default is false.
 	AutoCreateNamespace bool `json:"autoCreateNamespace,omitempty"`
+	// Labels to be set to the namespace created by autoCreateNamespace, e.g. istio-injection.
+	// The namespace which already exists is left untouched.
+	NamespaceLabels map[string]string `json:"namespaceLabels,omitempty"`
+	// Annotations to be set to the namespace created by autoCreateNamespace.
+	NamespaceAnnotations map[string]string `json:"namespaceAnnotations,omitempty"`
 }
 
 type KubernetesVariantLabel struct {
@@ -30,7 +30,7 @@ type kubectl interface {
 	ForceReplace(ctx context.Context, kubeconfig, namespace string, manifest Manifest) error
 	Delete(ctx context.Context, kubeconfig, namespace string, key ResourceKey) error
 	Get(ctx context.Context, kubeconfig, namespace string, key ResourceKey) (Manifest, error)
-	CreateNamespace(ctx context.Context, kubeconfig, namespace string) error
+	CreateNamespace(ctx context.Context, kubeconfig, namespace string, labels, annotations map[string]string) error
 }
 
 type Applier struct {
@@ -57,6 +57,8 @@ func (a *Applier) ApplyManifest(ctx context.Context, manifest Manifest) error {
 			ctx,
 			a.deployTarget.KubeConfigPath,
 			manifest.Key().Namespace(),
+			a.input.NamespaceLabels,
+			a.input.NamespaceAnnotations,
 		)
 		if err != nil && !errors.Is(err, errResourceAlreadyExists) {
 			return err
@@ -78,6 +80,8 @@ func (a *Applier) CreateManifest(ctx context.Context, manifest Manifest) error {
 			ctx,
 			a.deployTarget.KubeConfigPath,
 			manifest.Key().Namespace(),
+			a.input.NamespaceLabels,
+			a.input.NamespaceAnnotations,
 		)
 		if err != nil && !errors.Is(err, errResourceAlreadyExists) {
 			return err
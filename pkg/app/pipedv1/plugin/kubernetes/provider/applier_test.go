@@ -33,7 +33,7 @@ type mockKubectl struct {
 	ForceReplaceFunc    func(ctx context.Context, kubeconfig, namespace string, manifest Manifest) error
 	DeleteFunc          func(ctx context.Context, kubeconfig, namespace string, key ResourceKey) error
 	GetFunc             func(ctx context.Context, kubeconfig, namespace string, key ResourceKey) (Manifest, error)
-	CreateNamespaceFunc func(ctx context.Context, kubeconfig, namespace string) error
+	CreateNamespaceFunc func(ctx context.Context, kubeconfig, namespace string, labels, annotations map[string]string) error
 }
 
 var (
@@ -82,9 +82,9 @@ func (m *mockKubectl) Get(ctx context.Context, kubeconfig, namespace string, key
 	return Manifest{}, errUnexpectedCall
 }
 
-func (m *mockKubectl) CreateNamespace(ctx context.Context, kubeconfig, namespace string) error {
+func (m *mockKubectl) CreateNamespace(ctx context.Context, kubeconfig, namespace string, labels, annotations map[string]string) error {
 	if m.CreateNamespaceFunc != nil {
-		return m.CreateNamespaceFunc(ctx, kubeconfig, namespace)
+		return m.CreateNamespaceFunc(ctx, kubeconfig, namespace, labels, annotations)
 	}
 	return errUnexpectedCall
 }
@@ -146,7 +146,7 @@ func TestApplier_ApplyManifest(t *testing.T) {
 			t.Parallel()
 
 			mockKubectl := &mockKubectl{
-				CreateNamespaceFunc: func(ctx context.Context, kubeconfig, namespace string) error {
+				CreateNamespaceFunc: func(ctx context.Context, kubeconfig, namespace string, labels, annotations map[string]string) error {
 					return tc.createNamespaceErr
 				},
 				ApplyFunc: func(ctx context.Context, kubeconfig, namespace string, manifest Manifest) error {
@@ -183,6 +183,67 @@ func TestApplier_ApplyManifest(t *testing.T) {
 	}
 }
 
+func TestApplier_ApplyManifest_NamespaceMetadata(t *testing.T) {
+	t.Parallel()
+
+	var gotLabels, gotAnnotations map[string]string
+	mockKubectl := &mockKubectl{
+		CreateNamespaceFunc: func(ctx context.Context, kubeconfig, namespace string, labels, annotations map[string]string) error {
+			gotLabels, gotAnnotations = labels, annotations
+			return nil
+		},
+		ApplyFunc: func(ctx context.Context, kubeconfig, namespace string, manifest Manifest) error {
+			return nil
+		},
+	}
+
+	applier := NewApplier(
+		mockKubectl,
+		config.KubernetesDeploymentInput{
+			AutoCreateNamespace:  true,
+			NamespaceLabels:      map[string]string{"istio-injection": "enabled"},
+			NamespaceAnnotations: map[string]string{"owner": "team-a"},
+		},
+		config.KubernetesDeployTargetConfig{},
+		zap.NewNop(),
+	)
+
+	manifest := Manifest{
+		body: &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"namespace": "test-namespace",
+				},
+			},
+		},
+	}
+	if err := applier.ApplyManifest(context.Background(), manifest); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotLabels["istio-injection"] != "enabled" || gotAnnotations["owner"] != "team-a" {
+		t.Errorf("unexpected namespace metadata: labels=%v, annotations=%v", gotLabels, gotAnnotations)
+	}
+}
+
+func TestMakeNamespaceManifest(t *testing.T) {
+	t.Parallel()
+
+	got, err := makeNamespaceManifest("foo", map[string]string{"istio-injection": "enabled"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `apiVersion: v1
+kind: Namespace
+metadata:
+  labels:
+    istio-injection: enabled
+  name: foo
+`
+	if string(got) != want {
+		t.Errorf("unexpected manifest:\n%s", got)
+	}
+}
+
 func TestApplier_CreateManifest(t *testing.T) {
 	t.Parallel()
 
@@ -240,7 +301,7 @@ func TestApplier_CreateManifest(t *testing.T) {
 			t.Parallel()
 
 			mockKubectl := &mockKubectl{
-				CreateNamespaceFunc: func(ctx context.Context, kubeconfig, namespace string) error {
+				CreateNamespaceFunc: func(ctx context.Context, kubeconfig, namespace string, labels, annotations map[string]string) error {
 					return tc.createNamespaceErr
 				},
 				CreateFunc: func(ctx context.Context, kubeconfig, namespace string, manifest Manifest) error {
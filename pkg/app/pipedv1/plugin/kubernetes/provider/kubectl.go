@@ -339,16 +339,33 @@ func (c *Kubectl) GetAll(ctx context.Context, kubeconfig, namespace string, sele
 }
 
 // CreateNamespace runs kubectl create namespace with the given namespace.
-func (c *Kubectl) CreateNamespace(ctx context.Context, kubeconfig, namespace string) (err error) {
+// CreateNamespace creates the given namespace with the given labels and annotations.
+// errResourceAlreadyExists is returned when the namespace already exists.
+func (c *Kubectl) CreateNamespace(ctx context.Context, kubeconfig, namespace string, labels, annotations map[string]string) (err error) {
 	// TODO: record the metrics for the kubectl create namespace command.
 
 	args := make([]string, 0, 7)
 	if kubeconfig != "" {
 		args = append(args, "--kubeconfig", kubeconfig)
 	}
-	args = append(args, "create", "namespace", namespace)
+
+	var stdin []byte
+	if len(labels) == 0 && len(annotations) == 0 {
+		args = append(args, "create", "namespace", namespace)
+	} else {
+		// The namespace is created from a manifest to set the metadata atomically,
+		// otherwise it could be left without them when labeling fails after creating.
+		stdin, err = makeNamespaceManifest(namespace, labels, annotations)
+		if err != nil {
+			return err
+		}
+		args = append(args, "create", "-f", "-")
+	}
 
 	cmd := exec.CommandContext(ctx, c.execPath, args...)
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
 	out, err := cmd.CombinedOutput()
 
 	if strings.Contains(string(out), errAlreadyExistsLiteral) {
@@ -359,3 +376,20 @@ func (c *Kubectl) CreateNamespace(ctx context.Context, kubeconfig, namespace str
 	}
 	return nil
 }
+
+func makeNamespaceManifest(name string, labels, annotations map[string]string) ([]byte, error) {
+	metadata := map[string]interface{}{
+		"name": name,
+	}
+	if len(labels) > 0 {
+		metadata["labels"] = labels
+	}
+	if len(annotations) > 0 {
+		metadata["annotations"] = annotations
+	}
+	return yaml.Marshal(map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata":   metadata,
+	})
+}
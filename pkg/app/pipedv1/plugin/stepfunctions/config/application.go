@@ -0,0 +1,115 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
+)
+
+// StepFunctionsApplicationSpec represents an application configuration for Step Functions application.
+type StepFunctionsApplicationSpec struct {
+	// Input for Step Functions deployment such as the definition file, state machine name...
+	Input StepFunctionsDeploymentInput `json:"input"`
+	// Configuration for quick sync.
+	QuickSync StepFunctionsSyncStageOptions `json:"quickSync"`
+}
+
+func (s *StepFunctionsApplicationSpec) Validate() error {
+	if err := s.Input.validate(); err != nil {
+		return fmt.Errorf("invalid input: %w", err)
+	}
+	return nil
+}
+
+// StepFunctionsDeploymentInput represents needed input for triggering a Step Functions deployment.
+type StepFunctionsDeploymentInput struct {
+	// The name of the state machine.
+	StateMachineName string `json:"stateMachineName"`
+	// The path to the file containing the state machine definition written in Amazon States Language.
+	// The path is relative to the application directory.
+	DefinitionFile string `json:"definitionFile" default:"definition.asl.json"`
+	// The ARN of the IAM role used by the state machine.
+	RoleARN string `json:"roleArn"`
+	// The type of the state machine, STANDARD or EXPRESS.
+	// This is used only when creating a new state machine.
+	Type string `json:"type" default:"STANDARD"`
+	// The name of the alias used to route executions to the published versions.
+	AliasName string `json:"aliasName" default:"Service"`
+	// Tags added to the state machine.
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+func (in StepFunctionsDeploymentInput) validate() error {
+	if in.StateMachineName == "" {
+		return errors.New("stateMachineName is required")
+	}
+	if in.DefinitionFile == "" {
+		return errors.New("definitionFile is required")
+	}
+	if in.RoleARN == "" {
+		return errors.New("roleArn is required")
+	}
+	if in.AliasName == "" {
+		return errors.New("aliasName is required")
+	}
+	switch in.Type {
+	case "STANDARD", "EXPRESS":
+	default:
+		return fmt.Errorf("unsupported type %q, it must be STANDARD or EXPRESS", in.Type)
+	}
+	return nil
+}
+
+// StepFunctionsDeployTargetConfig represents PipedDeployTarget.Config for Step Functions.
+type StepFunctionsDeployTargetConfig struct {
+	// The region to send requests to.
+	Region string `json:"region"`
+	// Path to the shared credentials file.
+	// Empty means the default credentials file will be used.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+	// The IAM role arn to use when assuming an role.
+	// This is used together with TokenFile.
+	RoleARN string `json:"roleARN,omitempty"`
+	// Path to the WebIdentity token the SDK should use to assume a role with.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// AWS Profile to extract credentials from the shared credentials file.
+	// Empty means "default" profile will be used.
+	Profile string `json:"profile,omitempty"`
+}
+
+// StepFunctionsSyncStageOptions contains all configurable values for a STEPFUNCTIONS_SYNC stage.
+type StepFunctionsSyncStageOptions struct {
+}
+
+// StepFunctionsCanaryRolloutStageOptions contains all configurable values for a STEPFUNCTIONS_CANARY_ROLLOUT stage.
+type StepFunctionsCanaryRolloutStageOptions struct {
+}
+
+// StepFunctionsPromoteStageOptions contains all configurable values for a STEPFUNCTIONS_PROMOTE stage.
+type StepFunctionsPromoteStageOptions struct {
+	// Percentage of traffic should be routed to the new version.
+	Percent config.Percentage `json:"percent"`
+}
+
+// Validate checks the percentage is in the valid range.
+func (o StepFunctionsPromoteStageOptions) Validate() error {
+	if p := o.Percent.Int(); p < 0 || p > 100 {
+		return fmt.Errorf("percent must be in range [0, 100] but got %d", p)
+	}
+	return nil
+}
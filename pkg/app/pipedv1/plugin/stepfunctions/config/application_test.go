@@ -0,0 +1,70 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStepFunctionsApplicationSpec_Validate(t *testing.T) {
+	t.Parallel()
+
+	valid := StepFunctionsDeploymentInput{
+		StateMachineName: "hello",
+		DefinitionFile:   "definition.asl.json",
+		RoleARN:          "arn:aws:iam::123456789012:role/hello",
+		Type:             "STANDARD",
+		AliasName:        "Service",
+	}
+
+	testcases := []struct {
+		name        string
+		modify      func(in *StepFunctionsDeploymentInput)
+		expectedErr bool
+	}{
+		{
+			name:   "valid",
+			modify: func(in *StepFunctionsDeploymentInput) {},
+		},
+		{
+			name:        "missing state machine name",
+			modify:      func(in *StepFunctionsDeploymentInput) { in.StateMachineName = "" },
+			expectedErr: true,
+		},
+		{
+			name:        "missing role",
+			modify:      func(in *StepFunctionsDeploymentInput) { in.RoleARN = "" },
+			expectedErr: true,
+		},
+		{
+			name:        "unsupported type",
+			modify:      func(in *StepFunctionsDeploymentInput) { in.Type = "UNKNOWN" },
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			in := valid
+			tc.modify(&in)
+			spec := StepFunctionsApplicationSpec{Input: in}
+			err := spec.Validate()
+			assert.Equal(t, tc.expectedErr, err != nil)
+		})
+	}
+}
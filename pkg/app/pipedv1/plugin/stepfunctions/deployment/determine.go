@@ -0,0 +1,59 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sfnconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/stepfunctions/config"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+// determineStrategy decides the sync strategy by comparing the running and target state machines.
+// Progressive deployment is used only when the behavior of the state machine was changed.
+func determineStrategy(runningDefinition, targetDefinition string, running, target sfnconfig.StepFunctionsDeploymentInput) (sdk.SyncStrategy, string) {
+	if running.StateMachineName != target.StateMachineName {
+		return sdk.SyncStrategyQuickSync, fmt.Sprintf("Quick sync because the state machine was changed from %s to %s", running.StateMachineName, target.StateMachineName)
+	}
+
+	equal, err := equalJSON(runningDefinition, targetDefinition)
+	if err != nil {
+		return sdk.SyncStrategyPipelineSync, fmt.Sprintf("Sync progressively due to an error while comparing the definitions (%v)", err)
+	}
+	if !equal {
+		return sdk.SyncStrategyPipelineSync, "Sync progressively because the state machine definition was changed"
+	}
+	if running.RoleARN != target.RoleARN {
+		return sdk.SyncStrategyPipelineSync, "Sync progressively because the role of the state machine was changed"
+	}
+
+	return sdk.SyncStrategyQuickSync, "Quick sync because the state machine definition was not changed"
+}
+
+// equalJSON reports whether the given JSON documents are semantically equal,
+// ignoring differences in formatting and key order.
+func equalJSON(a, b string) (bool, error) {
+	var va, vb any
+	if err := json.Unmarshal([]byte(a), &va); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal([]byte(b), &vb); err != nil {
+		return false, err
+	}
+	ja, _ := json.Marshal(va)
+	jb, _ := json.Marshal(vb)
+	return string(ja) == string(jb), nil
+}
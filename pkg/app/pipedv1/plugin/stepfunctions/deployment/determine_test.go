@@ -0,0 +1,80 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sfnconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/stepfunctions/config"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+func TestDetermineStrategy(t *testing.T) {
+	t.Parallel()
+
+	input := sfnconfig.StepFunctionsDeploymentInput{
+		StateMachineName: "hello",
+		RoleARN:          "arn:aws:iam::123456789012:role/hello",
+	}
+
+	testcases := []struct {
+		name     string
+		running  string
+		target   string
+		modify   func(in *sfnconfig.StepFunctionsDeploymentInput)
+		expected sdk.SyncStrategy
+	}{
+		{
+			name:     "definition was not changed",
+			running:  `{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "End": true}}}`,
+			target:   `{"States": {"Hello": {"End": true, "Type": "Pass"}}, "StartAt": "Hello"}`,
+			modify:   func(in *sfnconfig.StepFunctionsDeploymentInput) {},
+			expected: sdk.SyncStrategyQuickSync,
+		},
+		{
+			name:     "definition was changed",
+			running:  `{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "End": true}}}`,
+			target:   `{"StartAt": "Hello", "States": {"Hello": {"Type": "Succeed"}}}`,
+			modify:   func(in *sfnconfig.StepFunctionsDeploymentInput) {},
+			expected: sdk.SyncStrategyPipelineSync,
+		},
+		{
+			name:     "role was changed",
+			running:  `{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "End": true}}}`,
+			target:   `{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "End": true}}}`,
+			modify:   func(in *sfnconfig.StepFunctionsDeploymentInput) { in.RoleARN = "arn:aws:iam::123456789012:role/new" },
+			expected: sdk.SyncStrategyPipelineSync,
+		},
+		{
+			name:     "state machine was changed",
+			running:  `{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "End": true}}}`,
+			target:   `{"StartAt": "Hello", "States": {"Hello": {"Type": "Succeed"}}}`,
+			modify:   func(in *sfnconfig.StepFunctionsDeploymentInput) { in.StateMachineName = "new" },
+			expected: sdk.SyncStrategyQuickSync,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			target := input
+			tc.modify(&target)
+			got, _ := determineStrategy(tc.running, tc.target, input, target)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"slices"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+const (
+	// StageStepFunctionsSync represents the state where
+	// the state machine should be synced with the Git state
+	// and all executions should be routed to the new version.
+	StageStepFunctionsSync = "STEPFUNCTIONS_SYNC"
+	// StageStepFunctionsCanaryRollout represents the state where
+	// a new version of the state machine has been published without receiving any executions.
+	StageStepFunctionsCanaryRollout = "STEPFUNCTIONS_CANARY_ROLLOUT"
+	// StageStepFunctionsPromote represents the state where
+	// the specified percentage of executions should be routed to the new version through the alias.
+	StageStepFunctionsPromote = "STEPFUNCTIONS_PROMOTE"
+	// StageStepFunctionsRollback represents the state where
+	// the alias should be pointed back to the versions it targeted before the deployment.
+	StageStepFunctionsRollback = "STEPFUNCTIONS_ROLLBACK"
+)
+
+var allStages = []string{
+	StageStepFunctionsSync,
+	StageStepFunctionsCanaryRollout,
+	StageStepFunctionsPromote,
+	StageStepFunctionsRollback,
+}
+
+const (
+	// StageDescriptionStepFunctionsSync represents the description of the StepFunctionsSync stage.
+	StageDescriptionStepFunctionsSync = "Sync by publishing a new version of the state machine and routing all executions to it"
+	// StageDescriptionStepFunctionsRollback represents the description of the StepFunctionsRollback stage.
+	StageDescriptionStepFunctionsRollback = "Rollback the deployment"
+)
+
+func buildQuickSyncPipeline(autoRollback bool) []sdk.QuickSyncStage {
+	out := make([]sdk.QuickSyncStage, 0, 2)
+
+	out = append(out, sdk.QuickSyncStage{
+		Name:               StageStepFunctionsSync,
+		Description:        StageDescriptionStepFunctionsSync,
+		Rollback:           false,
+		Metadata:           make(map[string]string, 0),
+		AvailableOperation: sdk.ManualOperationNone,
+	})
+
+	if autoRollback {
+		out = append(out, sdk.QuickSyncStage{
+			Name:               StageStepFunctionsRollback,
+			Description:        StageDescriptionStepFunctionsRollback,
+			Rollback:           true,
+			Metadata:           make(map[string]string, 0),
+			AvailableOperation: sdk.ManualOperationNone,
+		})
+	}
+
+	return out
+}
+
+// buildPipelineStages builds the pipeline stages with the given SDK stages.
+func buildPipelineStages(stages []sdk.StageConfig, autoRollback bool) []sdk.PipelineStage {
+	out := make([]sdk.PipelineStage, 0, len(stages)+1)
+
+	for _, s := range stages {
+		out = append(out, sdk.PipelineStage{
+			Name:               s.Name,
+			Index:              s.Index,
+			Rollback:           false,
+			Metadata:           make(map[string]string, 0),
+			AvailableOperation: sdk.ManualOperationNone,
+		})
+	}
+
+	if autoRollback && len(stages) > 0 {
+		// we set the index of the rollback stage to the minimum index of all stages.
+		minIndex := slices.MinFunc(stages, func(a, b sdk.StageConfig) int {
+			return a.Index - b.Index
+		}).Index
+
+		out = append(out, sdk.PipelineStage{
+			Name:               StageStepFunctionsRollback,
+			Index:              minIndex,
+			Rollback:           true,
+			Metadata:           make(map[string]string, 0),
+			AvailableOperation: sdk.ManualOperationNone,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+func Test_buildPipelineStages(t *testing.T) {
+	t.Parallel()
+
+	stages := []sdk.StageConfig{
+		{Index: 0, Name: StageStepFunctionsCanaryRollout},
+		{Index: 1, Name: StageStepFunctionsPromote},
+	}
+
+	got := buildPipelineStages(stages, true)
+	assert.Equal(t, []sdk.PipelineStage{
+		{
+			Name:               StageStepFunctionsCanaryRollout,
+			Index:              0,
+			Rollback:           false,
+			Metadata:           map[string]string{},
+			AvailableOperation: sdk.ManualOperationNone,
+		},
+		{
+			Name:               StageStepFunctionsPromote,
+			Index:              1,
+			Rollback:           false,
+			Metadata:           map[string]string{},
+			AvailableOperation: sdk.ManualOperationNone,
+		},
+		{
+			Name:               StageStepFunctionsRollback,
+			Index:              0,
+			Rollback:           true,
+			Metadata:           map[string]string{},
+			AvailableOperation: sdk.ManualOperationNone,
+		},
+	}, got)
+
+	got = buildPipelineStages(stages, false)
+	assert.Len(t, got, 2)
+}
+
+func Test_buildQuickSyncPipeline(t *testing.T) {
+	t.Parallel()
+
+	got := buildQuickSyncPipeline(true)
+	assert.Equal(t, []string{StageStepFunctionsSync, StageStepFunctionsRollback}, []string{got[0].Name, got[1].Name})
+	assert.True(t, got[1].Rollback)
+
+	got = buildQuickSyncPipeline(false)
+	assert.Len(t, got, 1)
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	sfnconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/stepfunctions/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/stepfunctions/provider"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+// Plugin implements the sdk.DeploymentPlugin interface.
+type Plugin struct {
+}
+
+var _ sdk.DeploymentPlugin[sdk.ConfigNone, sfnconfig.StepFunctionsDeployTargetConfig, sfnconfig.StepFunctionsApplicationSpec] = (*Plugin)(nil)
+
+// FetchDefinedStages returns the defined stages for this plugin.
+func (p *Plugin) FetchDefinedStages() []string {
+	return allStages
+}
+
+// BuildPipelineSyncStages returns the stages for the pipeline sync strategy.
+func (p *Plugin) BuildPipelineSyncStages(ctx context.Context, _ *sdk.ConfigNone, input *sdk.BuildPipelineSyncStagesInput) (*sdk.BuildPipelineSyncStagesResponse, error) {
+	return &sdk.BuildPipelineSyncStagesResponse{
+		Stages: buildPipelineStages(input.Request.Stages, input.Request.Rollback),
+	}, nil
+}
+
+// BuildQuickSyncStages returns the stages for the quick sync strategy.
+func (p *Plugin) BuildQuickSyncStages(ctx context.Context, _ *sdk.ConfigNone, input *sdk.BuildQuickSyncStagesInput) (*sdk.BuildQuickSyncStagesResponse, error) {
+	return &sdk.BuildQuickSyncStagesResponse{
+		Stages: buildQuickSyncPipeline(input.Request.Rollback),
+	}, nil
+}
+
+// ExecuteStage executes the stage.
+func (p *Plugin) ExecuteStage(ctx context.Context, _ *sdk.ConfigNone, dts []*sdk.DeployTarget[sfnconfig.StepFunctionsDeployTargetConfig], input *sdk.ExecuteStageInput[sfnconfig.StepFunctionsApplicationSpec]) (*sdk.ExecuteStageResponse, error) {
+	switch input.Request.StageName {
+	case StageStepFunctionsSync:
+		return &sdk.ExecuteStageResponse{
+			Status: p.executeStepFunctionsSyncStage(ctx, input, dts),
+		}, nil
+	case StageStepFunctionsCanaryRollout:
+		return &sdk.ExecuteStageResponse{
+			Status: p.executeStepFunctionsCanaryRolloutStage(ctx, input, dts),
+		}, nil
+	case StageStepFunctionsPromote:
+		return &sdk.ExecuteStageResponse{
+			Status: p.executeStepFunctionsPromoteStage(ctx, input, dts),
+		}, nil
+	case StageStepFunctionsRollback:
+		return &sdk.ExecuteStageResponse{
+			Status: p.executeStepFunctionsRollbackStage(ctx, input, dts),
+		}, nil
+	default:
+		return nil, errors.New("unimplemented or unsupported stage")
+	}
+}
+
+// DetermineVersions determines the versions of the application.
+func (p *Plugin) DetermineVersions(ctx context.Context, _ *sdk.ConfigNone, input *sdk.DetermineVersionsInput[sfnconfig.StepFunctionsApplicationSpec]) (*sdk.DetermineVersionsResponse, error) {
+	logger := input.Logger
+
+	cfg, err := input.Request.DeploymentSource.AppConfig()
+	if err != nil {
+		logger.Error("Failed while loading application config", zap.Error(err))
+		return nil, err
+	}
+
+	return &sdk.DetermineVersionsResponse{
+		Versions: []sdk.ArtifactVersion{
+			{
+				Kind:    sdk.ArtifactKindUnknown,
+				Name:    cfg.Spec.Input.StateMachineName,
+				Version: input.Request.DeploymentSource.CommitHash,
+			},
+		},
+	}, nil
+}
+
+// DetermineStrategy determines the strategy for the deployment.
+func (p *Plugin) DetermineStrategy(ctx context.Context, _ *sdk.ConfigNone, input *sdk.DetermineStrategyInput[sfnconfig.StepFunctionsApplicationSpec]) (*sdk.DetermineStrategyResponse, error) {
+	logger := input.Logger
+
+	if input.Request.RunningDeploymentSource.CommitHash == "" {
+		return &sdk.DetermineStrategyResponse{
+			Strategy: sdk.SyncStrategyQuickSync,
+			Summary:  "Quick sync because it seems this is the first deployment",
+		}, nil
+	}
+
+	runningCfg, err := input.Request.RunningDeploymentSource.AppConfig()
+	if err != nil {
+		logger.Error("Failed while loading running application config", zap.Error(err))
+		return nil, err
+	}
+
+	targetCfg, err := input.Request.TargetDeploymentSource.AppConfig()
+	if err != nil {
+		logger.Error("Failed while loading target application config", zap.Error(err))
+		return nil, err
+	}
+
+	running, err := provider.LoadDefinition(input.Request.RunningDeploymentSource.ApplicationDirectory, runningCfg.Spec.Input.DefinitionFile)
+	if err != nil {
+		return &sdk.DetermineStrategyResponse{
+			Strategy: sdk.SyncStrategyPipelineSync,
+			Summary:  "Sync progressively because it was unable to load the running state machine definition",
+		}, nil
+	}
+
+	target, err := provider.LoadDefinition(input.Request.TargetDeploymentSource.ApplicationDirectory, targetCfg.Spec.Input.DefinitionFile)
+	if err != nil {
+		logger.Error("Failed while loading target state machine definition", zap.Error(err))
+		return nil, err
+	}
+
+	strategy, summary := determineStrategy(running, target, runningCfg.Spec.Input, targetCfg.Spec.Input)
+	return &sdk.DetermineStrategyResponse{
+		Strategy: strategy,
+		Summary:  summary,
+	}, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	sfnconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/stepfunctions/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/stepfunctions/provider"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+func (p *Plugin) executeStepFunctionsPromoteStage(ctx context.Context, input *sdk.ExecuteStageInput[sfnconfig.StepFunctionsApplicationSpec], dts []*sdk.DeployTarget[sfnconfig.StepFunctionsDeployTargetConfig]) sdk.StageStatus {
+	lp := input.Client.LogPersister()
+
+	cfg, err := input.Request.TargetDeploymentSource.AppConfig()
+	if err != nil {
+		lp.Errorf("Failed while loading application config (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	opts, err := decodePromoteStageOptions(input.Request.StageConfig)
+	if err != nil {
+		lp.Errorf("Failed while decoding the stage config (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	client, err := newClient(ctx, dts)
+	if err != nil {
+		lp.Errorf("Failed while creating Step Functions client (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	return promote(ctx, lp, client, input.Client, cfg.Spec.Input.AliasName, opts.Percent.Int())
+}
+
+func decodePromoteStageOptions(data []byte) (sfnconfig.StepFunctionsPromoteStageOptions, error) {
+	var opts sfnconfig.StepFunctionsPromoteStageOptions
+	if err := json.Unmarshal(data, &opts); err != nil {
+		return sfnconfig.StepFunctionsPromoteStageOptions{}, fmt.Errorf("failed to unmarshal the config: %w", err)
+	}
+	if err := opts.Validate(); err != nil {
+		return sfnconfig.StepFunctionsPromoteStageOptions{}, fmt.Errorf("failed to validate the config: %w", err)
+	}
+	return opts, nil
+}
+
+// promote routes the given percentage of executions to the version published by the STEPFUNCTIONS_CANARY_ROLLOUT stage.
+func promote(ctx context.Context, lp sdk.StageLogPersister, client stateMachineClient, store metadataStore, aliasName string, percent int) sdk.StageStatus {
+	stateMachineARN, err := store.GetDeploymentPluginMetadata(ctx, metadataKeyStateMachineARN)
+	if err != nil {
+		lp.Errorf("Failed while getting deployment metadata (%v)", err)
+		return sdk.StageStatusFailure
+	}
+	newVersionARN, err := store.GetDeploymentPluginMetadata(ctx, metadataKeyNewVersionARN)
+	if err != nil {
+		lp.Errorf("Failed while getting deployment metadata (%v)", err)
+		return sdk.StageStatusFailure
+	}
+	if stateMachineARN == "" || newVersionARN == "" {
+		lp.Errorf("Unable to determine the version to promote. %s stage must be executed before %s stage", StageStepFunctionsCanaryRollout, StageStepFunctionsPromote)
+		return sdk.StageStatusFailure
+	}
+
+	current, _, err := client.GetAliasRouting(ctx, stateMachineARN, aliasName)
+	if err != nil {
+		lp.Errorf("Failed while getting the routing of alias %s (%v)", aliasName, err)
+		return sdk.StageStatusFailure
+	}
+
+	routing, err := provider.MakeRouting(current, newVersionARN, percent)
+	if err != nil {
+		lp.Errorf("Failed while making the routing of alias %s (%v)", aliasName, err)
+		return sdk.StageStatusFailure
+	}
+
+	if !updateRouting(ctx, lp, client, stateMachineARN, aliasName, routing) {
+		return sdk.StageStatusFailure
+	}
+
+	return sdk.StageStatusSuccess
+}
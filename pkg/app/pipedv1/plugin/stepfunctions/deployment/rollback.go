@@ -0,0 +1,85 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+
+	sfnconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/stepfunctions/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/stepfunctions/provider"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+func (p *Plugin) executeStepFunctionsRollbackStage(ctx context.Context, input *sdk.ExecuteStageInput[sfnconfig.StepFunctionsApplicationSpec], dts []*sdk.DeployTarget[sfnconfig.StepFunctionsDeployTargetConfig]) sdk.StageStatus {
+	lp := input.Client.LogPersister()
+
+	cfg, err := input.Request.TargetDeploymentSource.AppConfig()
+	if err != nil {
+		lp.Errorf("Failed while loading application config (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	client, err := newClient(ctx, dts)
+	if err != nil {
+		lp.Errorf("Failed while creating Step Functions client (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	return rollback(ctx, lp, client, input.Client, input.Request.RunningDeploymentSource.CommitHash, cfg.Spec.Input.AliasName)
+}
+
+// rollback points the alias back to the versions it targeted before this deployment.
+// It is skipped for the first deployment since there are no versions to go back to.
+func rollback(ctx context.Context, lp sdk.StageLogPersister, client stateMachineClient, store metadataStore, runningCommitHash, aliasName string) sdk.StageStatus {
+	if runningCommitHash == "" {
+		lp.Info("Skip rolling back because there is no previously deployed commit. It seems this is the first deployment.")
+		return sdk.StageStatusSuccess
+	}
+
+	lp.Info("Start rolling back the deployment")
+
+	recorded, err := store.GetDeploymentPluginMetadata(ctx, metadataKeyPreviousRouting)
+	if err != nil {
+		lp.Errorf("Failed while getting deployment metadata (%v)", err)
+		return sdk.StageStatusFailure
+	}
+	if recorded == "" {
+		lp.Info("Nothing to rollback because the alias was not changed by this deployment")
+		return sdk.StageStatusSuccess
+	}
+
+	var previous []provider.RoutingEntry
+	if err := json.Unmarshal([]byte(recorded), &previous); err != nil {
+		lp.Errorf("Failed while unmarshaling the previous routing of alias %s (%v)", aliasName, err)
+		return sdk.StageStatusFailure
+	}
+	if len(previous) == 0 {
+		lp.Errorf("Unable to rollback because alias %s did not exist before this deployment. It seems this is the first deployment.", aliasName)
+		return sdk.StageStatusFailure
+	}
+
+	stateMachineARN, err := store.GetDeploymentPluginMetadata(ctx, metadataKeyStateMachineARN)
+	if err != nil {
+		lp.Errorf("Failed while getting deployment metadata (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	if !updateRouting(ctx, lp, client, stateMachineARN, aliasName, previous) {
+		return sdk.StageStatusFailure
+	}
+
+	return sdk.StageStatusSuccess
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	sfnconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/stepfunctions/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/stepfunctions/provider"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+const (
+	// metadataKeyStateMachineARN is the key of the deployment metadata
+	// storing the ARN of the deployed state machine.
+	metadataKeyStateMachineARN = "stepfunctions-state-machine-arn"
+	// metadataKeyNewVersionARN is the key of the deployment metadata
+	// storing the ARN of the version published by this deployment.
+	metadataKeyNewVersionARN = "stepfunctions-new-version-arn"
+	// metadataKeyPreviousRouting is the key of the deployment metadata
+	// storing the alias routing configuration before it was changed by this deployment.
+	metadataKeyPreviousRouting = "stepfunctions-previous-alias-routing"
+)
+
+type stateMachineClient interface {
+	PublishStateMachine(ctx context.Context, in provider.StateMachineInput) (provider.StateMachine, error)
+	GetAliasRouting(ctx context.Context, stateMachineARN, aliasName string) ([]provider.RoutingEntry, bool, error)
+	UpdateAliasRouting(ctx context.Context, stateMachineARN, aliasName string, routing []provider.RoutingEntry) error
+}
+
+type metadataStore interface {
+	GetDeploymentPluginMetadata(ctx context.Context, key string) (string, error)
+	PutDeploymentPluginMetadataMulti(ctx context.Context, metadata map[string]string) error
+}
+
+func newClient(ctx context.Context, dts []*sdk.DeployTarget[sfnconfig.StepFunctionsDeployTargetConfig]) (*provider.Client, error) {
+	if len(dts) == 0 {
+		return nil, errors.New("no deploy target was found")
+	}
+	return provider.NewClient(ctx, dts[0].Config)
+}
+
+// publish creates or updates the state machine with the definition placed in the given application directory
+// and publishes a new version of it.
+func publish(ctx context.Context, lp sdk.StageLogPersister, client stateMachineClient, input sfnconfig.StepFunctionsDeploymentInput, appDir, commitHash string) (provider.StateMachine, bool) {
+	definition, err := provider.LoadDefinition(appDir, input.DefinitionFile)
+	if err != nil {
+		lp.Errorf("Failed while loading state machine definition (%v)", err)
+		return provider.StateMachine{}, false
+	}
+
+	lp.Infof("Start publishing a new version of state machine %s", input.StateMachineName)
+	sm, err := client.PublishStateMachine(ctx, provider.StateMachineInput{
+		Name:               input.StateMachineName,
+		Definition:         definition,
+		RoleARN:            input.RoleARN,
+		Type:               input.Type,
+		Tags:               input.Tags,
+		VersionDescription: fmt.Sprintf("Deployed by PipeCD at commit %s", commitHash),
+	})
+	if err != nil {
+		lp.Errorf("Failed while publishing state machine %s (%v)", input.StateMachineName, err)
+		return provider.StateMachine{}, false
+	}
+	lp.Successf("Successfully published version %s", sm.VersionARN)
+
+	return sm, true
+}
+
+// recordRouting stores the current routing of the alias so that it can be restored while rolling back.
+// Only the routing before the first change made by this deployment is stored.
+// It returns the current routing and whether the alias exists.
+func recordRouting(ctx context.Context, lp sdk.StageLogPersister, client stateMachineClient, store metadataStore, sm provider.StateMachine, aliasName string) ([]provider.RoutingEntry, bool, bool) {
+	current, exists, err := client.GetAliasRouting(ctx, sm.ARN, aliasName)
+	if err != nil {
+		lp.Errorf("Failed while getting the routing of alias %s (%v)", aliasName, err)
+		return nil, false, false
+	}
+
+	recorded, err := store.GetDeploymentPluginMetadata(ctx, metadataKeyPreviousRouting)
+	if err != nil {
+		lp.Errorf("Failed while getting deployment metadata (%v)", err)
+		return nil, false, false
+	}
+	if recorded != "" {
+		return current, exists, true
+	}
+
+	previous := current
+	if previous == nil {
+		previous = []provider.RoutingEntry{}
+	}
+	data, err := json.Marshal(previous)
+	if err != nil {
+		lp.Errorf("Failed while marshaling the routing of alias %s (%v)", aliasName, err)
+		return nil, false, false
+	}
+	if err := store.PutDeploymentPluginMetadataMulti(ctx, map[string]string{
+		metadataKeyStateMachineARN: sm.ARN,
+		metadataKeyPreviousRouting: string(data),
+	}); err != nil {
+		lp.Errorf("Failed while storing deployment metadata (%v)", err)
+		return nil, false, false
+	}
+
+	return current, exists, true
+}
+
+func updateRouting(ctx context.Context, lp sdk.StageLogPersister, client stateMachineClient, stateMachineARN, aliasName string, routing []provider.RoutingEntry) bool {
+	lp.Infof("Start updating alias %s to route executions as %s", aliasName, formatRouting(routing))
+	if err := client.UpdateAliasRouting(ctx, stateMachineARN, aliasName, routing); err != nil {
+		lp.Errorf("Failed while updating alias %s (%v)", aliasName, err)
+		return false
+	}
+	lp.Successf("Successfully updated alias %s", aliasName)
+	return true
+}
+
+func formatRouting(routing []provider.RoutingEntry) string {
+	out := ""
+	for i, r := range routing {
+		if i > 0 {
+			out += ", "
+		}
+		out += fmt.Sprintf("%d%% to %s", r.Weight, r.VersionARN)
+	}
+	return out
+}
@@ -0,0 +1,181 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sfnconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/stepfunctions/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/stepfunctions/provider"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/pipe-cd/piped-plugin-sdk-go/logpersister/logpersistertest"
+)
+
+const (
+	testStateMachineARN   = "arn:aws:states:us-east-1:123456789012:stateMachine:hello"
+	testRunningCommitHash = "0123456789abcdef"
+)
+
+type fakeClient struct {
+	versions int
+	routing  []provider.RoutingEntry
+}
+
+func (c *fakeClient) PublishStateMachine(_ context.Context, _ provider.StateMachineInput) (provider.StateMachine, error) {
+	c.versions++
+	return provider.StateMachine{
+		ARN:        testStateMachineARN,
+		VersionARN: testStateMachineARN + ":" + string(rune('0'+c.versions)),
+	}, nil
+}
+
+func (c *fakeClient) GetAliasRouting(_ context.Context, _, _ string) ([]provider.RoutingEntry, bool, error) {
+	return c.routing, c.routing != nil, nil
+}
+
+func (c *fakeClient) UpdateAliasRouting(_ context.Context, _, _ string, routing []provider.RoutingEntry) error {
+	c.routing = routing
+	return nil
+}
+
+type fakeStore map[string]string
+
+func (s fakeStore) GetDeploymentPluginMetadata(_ context.Context, key string) (string, error) {
+	return s[key], nil
+}
+
+func (s fakeStore) PutDeploymentPluginMetadataMulti(_ context.Context, metadata map[string]string) error {
+	for k, v := range metadata {
+		s[k] = v
+	}
+	return nil
+}
+
+func newTestDeploymentSource(t *testing.T) (sfnconfig.StepFunctionsDeploymentInput, *sdk.DeploymentSource[sfnconfig.StepFunctionsApplicationSpec]) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "definition.asl.json"), []byte(`{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "End": true}}}`), 0o644))
+
+	in := sfnconfig.StepFunctionsDeploymentInput{
+		StateMachineName: "hello",
+		DefinitionFile:   "definition.asl.json",
+		RoleARN:          "arn:aws:iam::123456789012:role/hello",
+		Type:             "STANDARD",
+		AliasName:        "Service",
+	}
+	ds := &sdk.DeploymentSource[sfnconfig.StepFunctionsApplicationSpec]{
+		ApplicationDirectory: dir,
+		CommitHash:           "0123456789",
+	}
+	return in, ds
+}
+
+func TestSyncAndRollback(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	in, ds := newTestDeploymentSource(t)
+	client := &fakeClient{
+		versions: 1,
+		routing:  []provider.RoutingEntry{{VersionARN: testStateMachineARN + ":1", Weight: 100}},
+	}
+	store := fakeStore{}
+
+	status := syncStateMachine(ctx, logpersistertest.NewTestLogPersister(t), client, store, in, ds)
+	require.Equal(t, sdk.StageStatusSuccess, status)
+	assert.Equal(t, []provider.RoutingEntry{{VersionARN: testStateMachineARN + ":2", Weight: 100}}, client.routing)
+
+	status = rollback(ctx, logpersistertest.NewTestLogPersister(t), client, store, testRunningCommitHash, in.AliasName)
+	require.Equal(t, sdk.StageStatusSuccess, status)
+	assert.Equal(t, []provider.RoutingEntry{{VersionARN: testStateMachineARN + ":1", Weight: 100}}, client.routing)
+}
+
+func TestCanaryRolloutPromoteAndRollback(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	in, ds := newTestDeploymentSource(t)
+	client := &fakeClient{
+		versions: 1,
+		routing:  []provider.RoutingEntry{{VersionARN: testStateMachineARN + ":1", Weight: 100}},
+	}
+	store := fakeStore{}
+
+	status := canaryRollout(ctx, logpersistertest.NewTestLogPersister(t), client, store, in, ds)
+	require.Equal(t, sdk.StageStatusSuccess, status)
+	assert.Equal(t, []provider.RoutingEntry{{VersionARN: testStateMachineARN + ":1", Weight: 100}}, client.routing)
+
+	status = promote(ctx, logpersistertest.NewTestLogPersister(t), client, store, in.AliasName, 30)
+	require.Equal(t, sdk.StageStatusSuccess, status)
+	assert.Equal(t, []provider.RoutingEntry{
+		{VersionARN: testStateMachineARN + ":1", Weight: 70},
+		{VersionARN: testStateMachineARN + ":2", Weight: 30},
+	}, client.routing)
+
+	status = rollback(ctx, logpersistertest.NewTestLogPersister(t), client, store, testRunningCommitHash, in.AliasName)
+	require.Equal(t, sdk.StageStatusSuccess, status)
+	assert.Equal(t, []provider.RoutingEntry{{VersionARN: testStateMachineARN + ":1", Weight: 100}}, client.routing)
+}
+
+func TestPromoteWithoutCanaryRollout(t *testing.T) {
+	t.Parallel()
+
+	status := promote(context.Background(), logpersistertest.NewTestLogPersister(t), &fakeClient{}, fakeStore{}, "Service", 50)
+	assert.Equal(t, sdk.StageStatusFailure, status)
+}
+
+func TestRollbackFirstDeployment(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	in, ds := newTestDeploymentSource(t)
+	client := &fakeClient{}
+	store := fakeStore{}
+
+	status := syncStateMachine(ctx, logpersistertest.NewTestLogPersister(t), client, store, in, ds)
+	require.Equal(t, sdk.StageStatusSuccess, status)
+	routing := client.routing
+
+	status = rollback(ctx, logpersistertest.NewTestLogPersister(t), client, store, "", in.AliasName)
+	assert.Equal(t, sdk.StageStatusSuccess, status)
+	assert.Equal(t, routing, client.routing)
+}
+
+func TestRollbackWithoutPreviousAlias(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	in, ds := newTestDeploymentSource(t)
+	client := &fakeClient{}
+	store := fakeStore{}
+
+	status := syncStateMachine(ctx, logpersistertest.NewTestLogPersister(t), client, store, in, ds)
+	require.Equal(t, sdk.StageStatusSuccess, status)
+
+	status = rollback(ctx, logpersistertest.NewTestLogPersister(t), client, store, testRunningCommitHash, in.AliasName)
+	assert.Equal(t, sdk.StageStatusFailure, status)
+}
+
+func TestRollbackWithoutChange(t *testing.T) {
+	t.Parallel()
+
+	status := rollback(context.Background(), logpersistertest.NewTestLogPersister(t), &fakeClient{}, fakeStore{}, testRunningCommitHash, "Service")
+	assert.Equal(t, sdk.StageStatusSuccess, status)
+}
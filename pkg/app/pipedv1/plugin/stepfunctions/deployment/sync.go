@@ -0,0 +1,59 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	sfnconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/stepfunctions/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/stepfunctions/provider"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+func (p *Plugin) executeStepFunctionsSyncStage(ctx context.Context, input *sdk.ExecuteStageInput[sfnconfig.StepFunctionsApplicationSpec], dts []*sdk.DeployTarget[sfnconfig.StepFunctionsDeployTargetConfig]) sdk.StageStatus {
+	lp := input.Client.LogPersister()
+
+	cfg, err := input.Request.TargetDeploymentSource.AppConfig()
+	if err != nil {
+		lp.Errorf("Failed while loading application config (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	client, err := newClient(ctx, dts)
+	if err != nil {
+		lp.Errorf("Failed while creating Step Functions client (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	return syncStateMachine(ctx, lp, client, input.Client, cfg.Spec.Input, &input.Request.TargetDeploymentSource)
+}
+
+func syncStateMachine(ctx context.Context, lp sdk.StageLogPersister, client stateMachineClient, store metadataStore, in sfnconfig.StepFunctionsDeploymentInput, ds *sdk.DeploymentSource[sfnconfig.StepFunctionsApplicationSpec]) sdk.StageStatus {
+	sm, ok := publish(ctx, lp, client, in, ds.ApplicationDirectory, ds.CommitHash)
+	if !ok {
+		return sdk.StageStatusFailure
+	}
+
+	if _, _, ok := recordRouting(ctx, lp, client, store, sm, in.AliasName); !ok {
+		return sdk.StageStatusFailure
+	}
+
+	routing := []provider.RoutingEntry{{VersionARN: sm.VersionARN, Weight: 100}}
+	if !updateRouting(ctx, lp, client, sm.ARN, in.AliasName, routing) {
+		return sdk.StageStatusFailure
+	}
+
+	return sdk.StageStatusSuccess
+}
@@ -0,0 +1,227 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+
+	sfnconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/stepfunctions/config"
+)
+
+// StateMachineInput represents the desired state of a state machine.
+type StateMachineInput struct {
+	Name       string
+	Definition string
+	RoleARN    string
+	Type       string
+	Tags       map[string]string
+	// Description of the version published by this input.
+	VersionDescription string
+}
+
+// StateMachine represents a state machine and one of its published versions.
+type StateMachine struct {
+	ARN        string
+	VersionARN string
+}
+
+// Client is a wrapper of the Step Functions client.
+type Client struct {
+	client *sfn.Client
+}
+
+// NewClient creates a new Step Functions client by using the given deploy target config.
+func NewClient(ctx context.Context, cfg sfnconfig.StepFunctionsDeployTargetConfig) (*Client, error) {
+	if cfg.Region == "" {
+		return nil, errors.New("region is required field")
+	}
+
+	optFns := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(cfg.Region)}
+	if cfg.CredentialsFile != "" {
+		optFns = append(optFns, awsconfig.WithSharedCredentialsFiles([]string{cfg.CredentialsFile}))
+	}
+	if cfg.Profile != "" {
+		optFns = append(optFns, awsconfig.WithSharedConfigProfile(cfg.Profile))
+	}
+	if cfg.TokenFile != "" && cfg.RoleARN != "" {
+		optFns = append(optFns, awsconfig.WithWebIdentityRoleCredentialOptions(func(v *stscreds.WebIdentityRoleOptions) {
+			v.RoleARN = cfg.RoleARN
+			v.TokenRetriever = stscreds.IdentityTokenFile(cfg.TokenFile)
+		}))
+	}
+
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config to create step functions client: %w", err)
+	}
+	return &Client{client: sfn.NewFromConfig(awsCfg)}, nil
+}
+
+// PublishStateMachine creates or updates the state machine with the given input
+// and publishes a new version of it.
+func (c *Client) PublishStateMachine(ctx context.Context, in StateMachineInput) (StateMachine, error) {
+	arn, err := c.findStateMachineARN(ctx, in.Name)
+	if err != nil {
+		return StateMachine{}, err
+	}
+
+	if arn == "" {
+		out, err := c.client.CreateStateMachine(ctx, &sfn.CreateStateMachineInput{
+			Name:               aws.String(in.Name),
+			Definition:         aws.String(in.Definition),
+			RoleArn:            aws.String(in.RoleARN),
+			Type:               types.StateMachineType(in.Type),
+			Tags:               makeTags(in.Tags),
+			Publish:            true,
+			VersionDescription: aws.String(in.VersionDescription),
+		})
+		if err != nil {
+			return StateMachine{}, fmt.Errorf("failed to create state machine %s: %w", in.Name, err)
+		}
+		return StateMachine{
+			ARN:        aws.ToString(out.StateMachineArn),
+			VersionARN: aws.ToString(out.StateMachineVersionArn),
+		}, nil
+	}
+
+	out, err := c.client.UpdateStateMachine(ctx, &sfn.UpdateStateMachineInput{
+		StateMachineArn:    aws.String(arn),
+		Definition:         aws.String(in.Definition),
+		RoleArn:            aws.String(in.RoleARN),
+		Publish:            true,
+		VersionDescription: aws.String(in.VersionDescription),
+	})
+	if err != nil {
+		return StateMachine{}, fmt.Errorf("failed to update state machine %s: %w", in.Name, err)
+	}
+
+	if len(in.Tags) > 0 {
+		if _, err := c.client.TagResource(ctx, &sfn.TagResourceInput{
+			ResourceArn: aws.String(arn),
+			Tags:        makeTags(in.Tags),
+		}); err != nil {
+			return StateMachine{}, fmt.Errorf("failed to tag state machine %s: %w", in.Name, err)
+		}
+	}
+
+	return StateMachine{
+		ARN:        arn,
+		VersionARN: aws.ToString(out.StateMachineVersionArn),
+	}, nil
+}
+
+// GetAliasRouting returns the current routing configuration of the given alias.
+// The returned bool is false when the alias does not exist.
+func (c *Client) GetAliasRouting(ctx context.Context, stateMachineARN, aliasName string) ([]RoutingEntry, bool, error) {
+	out, err := c.client.DescribeStateMachineAlias(ctx, &sfn.DescribeStateMachineAliasInput{
+		StateMachineAliasArn: aws.String(aliasARN(stateMachineARN, aliasName)),
+	})
+	if err != nil {
+		var nfe *types.ResourceNotFound
+		if errors.As(err, &nfe) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to describe alias %s: %w", aliasName, err)
+	}
+
+	routing := make([]RoutingEntry, 0, len(out.RoutingConfiguration))
+	for _, r := range out.RoutingConfiguration {
+		routing = append(routing, RoutingEntry{
+			VersionARN: aws.ToString(r.StateMachineVersionArn),
+			Weight:     int(r.Weight),
+		})
+	}
+	return routing, true, nil
+}
+
+// UpdateAliasRouting updates the routing configuration of the given alias.
+// The alias will be created if it does not exist.
+func (c *Client) UpdateAliasRouting(ctx context.Context, stateMachineARN, aliasName string, routing []RoutingEntry) error {
+	if len(routing) == 0 {
+		return errors.New("routing configuration must contain at least one version")
+	}
+
+	items := make([]types.RoutingConfigurationListItem, 0, len(routing))
+	for _, r := range routing {
+		items = append(items, types.RoutingConfigurationListItem{
+			StateMachineVersionArn: aws.String(r.VersionARN),
+			Weight:                 int32(r.Weight),
+		})
+	}
+
+	_, exists, err := c.GetAliasRouting(ctx, stateMachineARN, aliasName)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		if _, err := c.client.CreateStateMachineAlias(ctx, &sfn.CreateStateMachineAliasInput{
+			Name:                 aws.String(aliasName),
+			RoutingConfiguration: items,
+		}); err != nil {
+			return fmt.Errorf("failed to create alias %s: %w", aliasName, err)
+		}
+		return nil
+	}
+
+	if _, err := c.client.UpdateStateMachineAlias(ctx, &sfn.UpdateStateMachineAliasInput{
+		StateMachineAliasArn: aws.String(aliasARN(stateMachineARN, aliasName)),
+		RoutingConfiguration: items,
+	}); err != nil {
+		return fmt.Errorf("failed to update alias %s: %w", aliasName, err)
+	}
+	return nil
+}
+
+// findStateMachineARN returns the ARN of the state machine with the given name.
+// Empty string is returned when no state machine was found.
+func (c *Client) findStateMachineARN(ctx context.Context, name string) (string, error) {
+	paginator := sfn.NewListStateMachinesPaginator(c.client, &sfn.ListStateMachinesInput{})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to list state machines: %w", err)
+		}
+		for _, sm := range out.StateMachines {
+			if aws.ToString(sm.Name) == name {
+				return aws.ToString(sm.StateMachineArn), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+func aliasARN(stateMachineARN, aliasName string) string {
+	return stateMachineARN + ":" + aliasName
+}
+
+func makeTags(tags map[string]string) []types.Tag {
+	out := make([]types.Tag, 0, len(tags))
+	for k, v := range tags {
+		out = append(out, types.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+	return out
+}
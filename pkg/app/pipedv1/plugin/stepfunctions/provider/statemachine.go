@@ -0,0 +1,79 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// RoutingEntry represents a version and the percentage of executions routed to it through an alias.
+type RoutingEntry struct {
+	VersionARN string `json:"versionArn"`
+	Weight     int    `json:"weight"`
+}
+
+// MakeRouting builds the routing configuration which routes the given percentage
+// of executions to the new version and the rest to the primary version of the current routing.
+// The primary version is the version receiving the most executions in the current routing.
+func MakeRouting(current []RoutingEntry, newVersionARN string, percent int) ([]RoutingEntry, error) {
+	if percent < 0 || percent > 100 {
+		return nil, fmt.Errorf("percent must be in range [0, 100] but got %d", percent)
+	}
+
+	var primary *RoutingEntry
+	for i := range current {
+		if current[i].VersionARN == newVersionARN {
+			continue
+		}
+		if primary == nil || current[i].Weight > primary.Weight {
+			primary = &current[i]
+		}
+	}
+
+	if primary == nil {
+		if percent != 100 {
+			return nil, fmt.Errorf("unable to route %d%% of executions to the new version because there is no other version to route the rest", percent)
+		}
+		return []RoutingEntry{{VersionARN: newVersionARN, Weight: 100}}, nil
+	}
+
+	switch percent {
+	case 0:
+		return []RoutingEntry{{VersionARN: primary.VersionARN, Weight: 100}}, nil
+	case 100:
+		return []RoutingEntry{{VersionARN: newVersionARN, Weight: 100}}, nil
+	default:
+		return []RoutingEntry{
+			{VersionARN: primary.VersionARN, Weight: 100 - percent},
+			{VersionARN: newVersionARN, Weight: percent},
+		}, nil
+	}
+}
+
+// LoadDefinition reads the state machine definition file placed in the application directory.
+// The definition must be written in JSON as required by Amazon States Language.
+func LoadDefinition(appDir, definitionFile string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(appDir, definitionFile))
+	if err != nil {
+		return "", fmt.Errorf("failed to read definition file %s: %w", definitionFile, err)
+	}
+	if !json.Valid(data) {
+		return "", fmt.Errorf("definition file %s is not a valid JSON", definitionFile)
+	}
+	return string(data), nil
+}
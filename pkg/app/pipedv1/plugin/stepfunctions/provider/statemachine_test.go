@@ -0,0 +1,134 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeRouting(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		current     []RoutingEntry
+		newVersion  string
+		percent     int
+		expected    []RoutingEntry
+		expectedErr bool
+	}{
+		{
+			name:       "no current routing",
+			newVersion: "v2",
+			percent:    100,
+			expected:   []RoutingEntry{{VersionARN: "v2", Weight: 100}},
+		},
+		{
+			name:        "no current routing with partial percent",
+			newVersion:  "v2",
+			percent:     10,
+			expectedErr: true,
+		},
+		{
+			name:       "route a part of executions to the new version",
+			current:    []RoutingEntry{{VersionARN: "v1", Weight: 100}},
+			newVersion: "v2",
+			percent:    20,
+			expected: []RoutingEntry{
+				{VersionARN: "v1", Weight: 80},
+				{VersionARN: "v2", Weight: 20},
+			},
+		},
+		{
+			name: "update the percentage of the new version",
+			current: []RoutingEntry{
+				{VersionARN: "v1", Weight: 80},
+				{VersionARN: "v2", Weight: 20},
+			},
+			newVersion: "v2",
+			percent:    50,
+			expected: []RoutingEntry{
+				{VersionARN: "v1", Weight: 50},
+				{VersionARN: "v2", Weight: 50},
+			},
+		},
+		{
+			name: "replace the canary version of the previous deployment",
+			current: []RoutingEntry{
+				{VersionARN: "v1", Weight: 70},
+				{VersionARN: "v2", Weight: 30},
+			},
+			newVersion: "v3",
+			percent:    10,
+			expected: []RoutingEntry{
+				{VersionARN: "v1", Weight: 90},
+				{VersionARN: "v3", Weight: 10},
+			},
+		},
+		{
+			name:       "route all executions to the new version",
+			current:    []RoutingEntry{{VersionARN: "v1", Weight: 100}},
+			newVersion: "v2",
+			percent:    100,
+			expected:   []RoutingEntry{{VersionARN: "v2", Weight: 100}},
+		},
+		{
+			name:       "route no execution to the new version",
+			current:    []RoutingEntry{{VersionARN: "v1", Weight: 100}},
+			newVersion: "v2",
+			percent:    0,
+			expected:   []RoutingEntry{{VersionARN: "v1", Weight: 100}},
+		},
+		{
+			name:        "invalid percent",
+			current:     []RoutingEntry{{VersionARN: "v1", Weight: 100}},
+			newVersion:  "v2",
+			percent:     101,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := MakeRouting(tc.current, tc.newVersion, tc.percent)
+			assert.Equal(t, tc.expectedErr, err != nil)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestLoadDefinition(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "valid.json"), []byte(`{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "End": true}}}`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "invalid.json"), []byte(`StartAt: Hello`), 0o644))
+
+	got, err := LoadDefinition(dir, "valid.json")
+	require.NoError(t, err)
+	assert.Contains(t, got, `"StartAt": "Hello"`)
+
+	_, err = LoadDefinition(dir, "invalid.json")
+	assert.Error(t, err)
+
+	_, err = LoadDefinition(dir, "not-found.json")
+	assert.Error(t, err)
+}
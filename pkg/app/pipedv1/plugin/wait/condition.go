@@ -0,0 +1,127 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const (
+	// maxCheckOutputSize is the maximum number of bytes of the response body or the command output
+	// which are read to check or shown in the stage log.
+	maxCheckOutputSize = 4096
+)
+
+// conditionChecker checks whether the condition of a WAIT stage has passed.
+type conditionChecker interface {
+	// check returns nil when the condition has passed,
+	// otherwise returns an error describing why it has not passed.
+	check(ctx context.Context) error
+}
+
+func newConditionChecker(c *WaitCondition, appDir string) conditionChecker {
+	if c.HTTP != nil {
+		return &httpChecker{
+			condition: c.HTTP,
+			client:    http.DefaultClient,
+		}
+	}
+	return &commandChecker{
+		condition: c.Command,
+		dir:       appDir,
+	}
+}
+
+type httpChecker struct {
+	condition *WaitHTTPCondition
+	client    *http.Client
+}
+
+func (c *httpChecker) check(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, c.condition.Method, c.condition.URL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	for k, v := range c.condition.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if !c.expectedStatusCode(resp.StatusCode) {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	if c.condition.ExpectedBodyContains == "" {
+		return nil
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxCheckOutputSize))
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	if !strings.Contains(string(body), c.condition.ExpectedBodyContains) {
+		return fmt.Errorf("response body does not contain %q", c.condition.ExpectedBodyContains)
+	}
+	return nil
+}
+
+func (c *httpChecker) expectedStatusCode(code int) bool {
+	if len(c.condition.ExpectedStatusCodes) == 0 {
+		return code >= 200 && code < 300
+	}
+	return slices.Contains(c.condition.ExpectedStatusCodes, code)
+}
+
+type commandChecker struct {
+	condition *WaitCommandCondition
+	dir       string
+}
+
+func (c *commandChecker) check(ctx context.Context) error {
+	cmd := exec.CommandContext(ctx, "sh", "-c", c.condition.Command)
+	cmd.Dir = c.dir
+	cmd.Env = os.Environ()
+	keys := make([]string, 0, len(c.condition.Env))
+	for k := range c.condition.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		cmd.Env = append(cmd.Env, k+"="+c.condition.Env[k])
+	}
+
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		return nil
+	}
+	if len(out) > maxCheckOutputSize {
+		out = out[len(out)-maxCheckOutputSize:]
+	}
+	if output := strings.TrimSpace(string(out)); output != "" {
+		return fmt.Errorf("%w: %s", err, output)
+	}
+	return err
+}
@@ -0,0 +1,147 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPChecker(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/ready":
+			w.Write([]byte(`{"status":"ready"}`))
+		case "/accepted":
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"status":"not ready"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	testcases := []struct {
+		name      string
+		condition WaitHTTPCondition
+		wantErr   bool
+	}{
+		{
+			name:      "passed with 2xx",
+			condition: WaitHTTPCondition{URL: server.URL + "/ready"},
+			wantErr:   false,
+		},
+		{
+			name:      "not passed with 5xx",
+			condition: WaitHTTPCondition{URL: server.URL + "/not-ready"},
+			wantErr:   true,
+		},
+		{
+			name: "passed with expected status code",
+			condition: WaitHTTPCondition{
+				URL:                 server.URL + "/not-ready",
+				ExpectedStatusCodes: []int{http.StatusServiceUnavailable},
+			},
+			wantErr: false,
+		},
+		{
+			name: "not passed with unexpected status code",
+			condition: WaitHTTPCondition{
+				URL:                 server.URL + "/accepted",
+				ExpectedStatusCodes: []int{http.StatusOK},
+			},
+			wantErr: true,
+		},
+		{
+			name: "passed with expected body",
+			condition: WaitHTTPCondition{
+				URL:                  server.URL + "/ready",
+				ExpectedBodyContains: `"status":"ready"`,
+			},
+			wantErr: false,
+		},
+		{
+			name: "not passed with unexpected body",
+			condition: WaitHTTPCondition{
+				URL:                  server.URL + "/accepted",
+				ExpectedBodyContains: `"status":"ready"`,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			tc.condition.Method = http.MethodGet
+			tc.condition.Headers = map[string]string{"Authorization": "Bearer token"}
+			c := newConditionChecker(&WaitCondition{HTTP: &tc.condition}, "")
+			err := c.check(context.Background())
+			assert.Equal(t, tc.wantErr, err != nil, err)
+		})
+	}
+}
+
+func TestCommandChecker(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ready"), nil, 0o644))
+
+	testcases := []struct {
+		name      string
+		condition WaitCommandCondition
+		wantErr   bool
+	}{
+		{
+			name:      "passed in the application directory",
+			condition: WaitCommandCondition{Command: "test -f ready"},
+			wantErr:   false,
+		},
+		{
+			name:      "not passed with non-zero exit code",
+			condition: WaitCommandCondition{Command: "echo not ready && exit 1"},
+			wantErr:   true,
+		},
+		{
+			name: "passed with env",
+			condition: WaitCommandCondition{
+				Command: `test "$STATUS" = ready`,
+				Env:     map[string]string{"STATUS": "ready"},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			c := newConditionChecker(&WaitCondition{Command: &tc.condition}, dir)
+			err := c.check(context.Background())
+			assert.Equal(t, tc.wantErr, err != nil, err)
+		})
+	}
+}
@@ -17,22 +17,130 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"time"
 
 	config "github.com/pipe-cd/pipecd/pkg/configv1"
 )
 
+const (
+	defaultConditionInterval = 10 * time.Second
+	defaultConditionTimeout  = 10 * time.Second
+)
+
 // WaitStageOptions contains configurable values for a WAIT stage.
 type WaitStageOptions struct {
+	// Time to wait.
+	// When the condition is specified, this is the maximum time to wait for the condition to pass,
+	// and the stage fails when the condition has not passed within it.
 	Duration config.Duration `json:"duration,omitempty"`
+	// The condition to finish this stage early.
+	Condition *WaitCondition `json:"condition,omitempty"`
+}
+
+// WaitCondition is the condition checked periodically while waiting.
+// Exactly one of http or command must be specified.
+type WaitCondition struct {
+	// How long to wait between the checks.
+	// Default is 10s.
+	Interval config.Duration `json:"interval,omitempty"`
+	// The maximum time for a single check.
+	// Default is 10s.
+	Timeout config.Duration `json:"timeout,omitempty"`
+	// Passes when the HTTP endpoint responds with one of the expected status codes.
+	HTTP *WaitHTTPCondition `json:"http,omitempty"`
+	// Passes when the command exits with code 0.
+	Command *WaitCommandCondition `json:"command,omitempty"`
+}
+
+// WaitHTTPCondition checks the response of an HTTP endpoint.
+type WaitHTTPCondition struct {
+	// The URL to send the request.
+	URL string `json:"url"`
+	// The HTTP method of the request.
+	// Default is GET.
+	Method string `json:"method,omitempty"`
+	// The headers of the request.
+	Headers map[string]string `json:"headers,omitempty"`
+	// The status codes regarded as passing.
+	// Default is any 2xx status code.
+	ExpectedStatusCodes []int `json:"expectedStatusCodes,omitempty"`
+	// The string that must be contained in the response body to pass.
+	ExpectedBodyContains string `json:"expectedBodyContains,omitempty"`
+}
+
+// WaitCommandCondition checks the exit code of a command.
+type WaitCommandCondition struct {
+	// The command to run by "sh -c" in the application directory.
+	Command string `json:"command"`
+	// The environment variables given to the command in addition to the ones of the plugin process.
+	Env map[string]string `json:"env,omitempty"`
 }
 
 func (o WaitStageOptions) validate() error {
 	if o.Duration <= 0 {
 		return fmt.Errorf("duration must be greater than 0")
 	}
+	if o.Condition != nil {
+		if err := o.Condition.validate(); err != nil {
+			return fmt.Errorf("invalid condition: %w", err)
+		}
+	}
 	return nil
 }
 
+func (c *WaitCondition) validate() error {
+	if c.Interval < 0 {
+		return fmt.Errorf("interval must not be negative")
+	}
+	if c.Timeout < 0 {
+		return fmt.Errorf("timeout must not be negative")
+	}
+	if (c.HTTP == nil) == (c.Command == nil) {
+		return fmt.Errorf("exactly one of http or command must be specified")
+	}
+	if c.HTTP != nil {
+		return c.HTTP.validate()
+	}
+	return c.Command.validate()
+}
+
+func (c *WaitHTTPCondition) validate() error {
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url must be an http or https url")
+	}
+	for _, code := range c.ExpectedStatusCodes {
+		if code < 100 || code > 599 {
+			return fmt.Errorf("invalid expected status code %d", code)
+		}
+	}
+	return nil
+}
+
+func (c *WaitCommandCondition) validate() error {
+	if c.Command == "" {
+		return fmt.Errorf("command must not be empty")
+	}
+	return nil
+}
+
+func (c *WaitCondition) setDefaults() {
+	if c.Interval == 0 {
+		c.Interval = config.Duration(defaultConditionInterval)
+	}
+	if c.Timeout == 0 {
+		c.Timeout = config.Duration(defaultConditionTimeout)
+	}
+	if c.HTTP != nil && c.HTTP.Method == "" {
+		c.HTTP.Method = http.MethodGet
+	}
+}
+
 // decode decodes the raw JSON data and validates it.
 func decode(data json.RawMessage) (WaitStageOptions, error) {
 	var opts WaitStageOptions
@@ -42,5 +150,8 @@ func decode(data json.RawMessage) (WaitStageOptions, error) {
 	if err := opts.validate(); err != nil {
 		return WaitStageOptions{}, fmt.Errorf("failed to validate the config: %w", err)
 	}
+	if opts.Condition != nil {
+		opts.Condition.setDefaults()
+	}
 	return opts, nil
 }
@@ -68,6 +68,88 @@ func TestDecode(t *testing.T) {
 			expected: WaitStageOptions{},
 			wantErr:  true,
 		},
+		{
+			name: "http condition with defaults",
+			data: json.RawMessage(`{
+				"duration":"10m",
+				"condition":{"http":{"url":"https://example.com/healthz"}}
+			}`),
+			expected: WaitStageOptions{
+				Duration: config.Duration(10 * time.Minute),
+				Condition: &WaitCondition{
+					Interval: config.Duration(10 * time.Second),
+					Timeout:  config.Duration(10 * time.Second),
+					HTTP: &WaitHTTPCondition{
+						URL:    "https://example.com/healthz",
+						Method: "GET",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "command condition",
+			data: json.RawMessage(`{
+				"duration":"10m",
+				"condition":{"interval":"30s","timeout":"1m","command":{"command":"test -f ready"}}
+			}`),
+			expected: WaitStageOptions{
+				Duration: config.Duration(10 * time.Minute),
+				Condition: &WaitCondition{
+					Interval: config.Duration(30 * time.Second),
+					Timeout:  config.Duration(time.Minute),
+					Command: &WaitCommandCondition{
+						Command: "test -f ready",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "condition without check",
+			data: json.RawMessage(`{
+				"duration":"10m",
+				"condition":{"interval":"30s"}
+			}`),
+			expected: WaitStageOptions{},
+			wantErr:  true,
+		},
+		{
+			name: "condition with both checks",
+			data: json.RawMessage(`{
+				"duration":"10m",
+				"condition":{"http":{"url":"https://example.com"},"command":{"command":"true"}}
+			}`),
+			expected: WaitStageOptions{},
+			wantErr:  true,
+		},
+		{
+			name: "condition with invalid url",
+			data: json.RawMessage(`{
+				"duration":"10m",
+				"condition":{"http":{"url":"example.com"}}
+			}`),
+			expected: WaitStageOptions{},
+			wantErr:  true,
+		},
+		{
+			name: "condition with invalid status code",
+			data: json.RawMessage(`{
+				"duration":"10m",
+				"condition":{"http":{"url":"https://example.com","expectedStatusCodes":[1000]}}
+			}`),
+			expected: WaitStageOptions{},
+			wantErr:  true,
+		},
+		{
+			name: "condition with empty command",
+			data: json.RawMessage(`{
+				"duration":"10m",
+				"condition":{"command":{"command":""}}
+			}`),
+			expected: WaitStageOptions{},
+			wantErr:  true,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -48,6 +48,10 @@ func (p *plugin) executeWait(ctx context.Context, in *sdk.ExecuteStageInput[stru
 	}
 	p.saveStartTime(ctx, in.Client, initialStart, in.Logger)
 
+	if c := opts.Condition; c != nil {
+		checker := newConditionChecker(c, in.Request.TargetDeploymentSource.ApplicationDirectory)
+		return waitCondition(ctx, duration, c.Interval.Duration(), c.Timeout.Duration(), initialStart, checker, in.Client.LogPersister())
+	}
 	return wait(ctx, duration, initialStart, in.Client.LogPersister())
 }
 
@@ -84,6 +88,42 @@ func wait(ctx context.Context, duration time.Duration, initialStart time.Time, s
 	}
 }
 
+// waitCondition checks the condition every interval until it passes.
+// It fails when the condition has not passed within the given duration since initialStart.
+func waitCondition(ctx context.Context, duration, interval, timeout time.Duration, initialStart time.Time, checker conditionChecker, slp sdk.StageLogPersister) sdk.StageStatus {
+	deadline := time.NewTimer(max(duration-time.Since(initialStart), 0))
+	defer deadline.Stop()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	slp.Infof("Waiting for the condition to pass for up to %v since %v...", duration, initialStart.Local())
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := checker.check(checkCtx)
+		cancel()
+		if err == nil {
+			slp.Successf("The condition passed after %v", time.Since(initialStart).Round(time.Second))
+			return sdk.StageStatusSuccess
+		}
+		slp.Infof("The condition has not passed yet: %v", err)
+
+		select {
+		case <-deadline.C: // on max duration elapsed
+			slp.Errorf("The condition did not pass within %v", duration)
+			return sdk.StageStatusFailure
+
+		case <-ticker.C: // on interval elapsed
+
+		case <-ctx.Done(): // on cancelled
+			slp.Info("Wait cancelled")
+			// We can return any status here because the piped handles this case as cancelled by a user,
+			// ignoring the result from a plugin.
+			return sdk.StageStatusFailure
+		}
+	}
+}
+
 func (p *plugin) retrieveStartTime(ctx context.Context, client *sdk.Client, logger *zap.Logger) time.Time {
 	sec, err := client.GetStageMetadata(ctx, startTimeKey)
 	if err != nil {
@@ -16,6 +16,8 @@ package main
 
 import (
 	"context"
+	"errors"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -116,3 +118,57 @@ func TestWait_RestartAndContinue(t *testing.T) {
 		t.Error("wait() did not end even after the specified duration has passed")
 	}
 }
+
+// fakeConditionChecker passes after the specified number of checks.
+type fakeConditionChecker struct {
+	passAfter int32
+	calls     atomic.Int32
+}
+
+func (c *fakeConditionChecker) check(_ context.Context) error {
+	if c.calls.Add(1) < c.passAfter {
+		return errors.New("not ready")
+	}
+	return nil
+}
+
+func TestWaitCondition_Passed(t *testing.T) {
+	t.Parallel()
+
+	checker := &fakeConditionChecker{passAfter: 3}
+	result := waitCondition(context.Background(), time.Minute, time.Millisecond, time.Second, time.Now(), checker, logpersistertest.NewTestLogPersister(t))
+	assert.Equal(t, sdk.StageStatusSuccess, result)
+	assert.Equal(t, int32(3), checker.calls.Load())
+}
+
+func TestWaitCondition_NotPassedWithinDuration(t *testing.T) {
+	t.Parallel()
+
+	checker := &fakeConditionChecker{passAfter: 1000000}
+	result := waitCondition(context.Background(), 50*time.Millisecond, 10*time.Millisecond, time.Second, time.Now(), checker, logpersistertest.NewTestLogPersister(t))
+	assert.Equal(t, sdk.StageStatusFailure, result)
+	assert.Greater(t, checker.calls.Load(), int32(1))
+}
+
+func TestWaitCondition_RestartAfterLongTime(t *testing.T) {
+	t.Parallel()
+	// Suppose this stage started 2 hours ago but it was interrupted.
+	previousStart := time.Now().Add(-2 * time.Hour)
+
+	// The condition is still checked once.
+	result := waitCondition(context.Background(), time.Second, time.Second, time.Second, previousStart, &fakeConditionChecker{passAfter: 1}, logpersistertest.NewTestLogPersister(t))
+	assert.Equal(t, sdk.StageStatusSuccess, result)
+
+	result = waitCondition(context.Background(), time.Second, time.Second, time.Second, previousStart, &fakeConditionChecker{passAfter: 2}, logpersistertest.NewTestLogPersister(t))
+	assert.Equal(t, sdk.StageStatusFailure, result)
+}
+
+func TestWaitCondition_Cancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result := waitCondition(ctx, time.Minute, time.Minute, time.Second, time.Now(), &fakeConditionChecker{passAfter: 2}, logpersistertest.NewTestLogPersister(t))
+	assert.Equal(t, sdk.StageStatusFailure, result)
+}
@@ -91,6 +91,7 @@ func buildDeployment(
 			Timestamp:       now.Unix(),
 			SyncStrategy:    syncStrategy,
 			StrategySummary: strategySummary,
+			Trace:           model.MakeDeploymentTriggerTrace(commit.GetTrailerValueByKey),
 		},
 		GitPath:                   app.GitPath,
 		CloudProvider:             app.CloudProvider,
@@ -0,0 +1,89 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploymentdiffstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/filestore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+var (
+	ErrNotFound = errors.New("not found")
+)
+
+type Store interface {
+	// Get returns the rendered diff of the specified deployment.
+	Get(ctx context.Context, deploymentID string) (*model.DeploymentDiff, error)
+	// Put saves the rendered diff of the specified deployment.
+	Put(ctx context.Context, deploymentID string, diff *model.DeploymentDiff) error
+}
+
+type store struct {
+	backend filestore.Store
+	logger  *zap.Logger
+}
+
+func NewStore(fs filestore.Store, logger *zap.Logger) Store {
+	return &store{
+		backend: fs,
+		logger:  logger.Named("deployment-diff-store"),
+	}
+}
+
+func (s *store) Get(ctx context.Context, deploymentID string) (*model.DeploymentDiff, error) {
+	content, err := s.backend.Get(ctx, dataPath(deploymentID))
+	if err != nil {
+		if err == filestore.ErrNotFound {
+			return nil, ErrNotFound
+		}
+		s.logger.Error("failed to get deployment diff from filestore",
+			zap.String("deployment", deploymentID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	var diff model.DeploymentDiff
+	if err := json.Unmarshal(content, &diff); err != nil {
+		return nil, err
+	}
+	return &diff, nil
+}
+
+func (s *store) Put(ctx context.Context, deploymentID string, diff *model.DeploymentDiff) error {
+	data, err := json.Marshal(diff)
+	if err != nil {
+		return err
+	}
+	if err := s.backend.Put(ctx, dataPath(deploymentID), data); err != nil {
+		s.logger.Error("failed to put deployment diff to filestore",
+			zap.String("deployment", deploymentID),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+func dataPath(deploymentID string) string {
+	return fmt.Sprintf("deployment-diff/%s.json", deploymentID)
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploymentdiffstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/filestore"
+	"github.com/pipe-cd/pipecd/pkg/filestore/filestoretest"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testcases := []struct {
+		name        string
+		content     string
+		readerErr   error
+		expected    *model.DeploymentDiff
+		expectedErr error
+	}{
+		{
+			name:        "file not found in filestore",
+			readerErr:   filestore.ErrNotFound,
+			expectedErr: ErrNotFound,
+		},
+		{
+			name:        "unexpected filestore error",
+			readerErr:   errors.New("unexpected"),
+			expectedErr: errors.New("unexpected"),
+		},
+		{
+			name: "found file in filestore",
+			content: `{
+				"target_commit_hash": "target",
+				"summary": "1 added manifests, 0 changed manifests, 0 deleted manifests",
+				"created_at": 1590000000
+			}`,
+			expected: &model.DeploymentDiff{
+				TargetCommitHash: "target",
+				Summary:          "1 added manifests, 0 changed manifests, 0 deleted manifests",
+				CreatedAt:        1590000000,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := filestoretest.NewMockStore(ctrl)
+			fs.EXPECT().Get(context.TODO(), "deployment-diff/deployment-id.json").Return([]byte(tc.content), tc.readerErr)
+
+			s := NewStore(fs, zap.NewNop())
+			diff, err := s.Get(context.TODO(), "deployment-id")
+			require.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expected, diff)
+		})
+	}
+}
+
+func TestPut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fs := filestoretest.NewMockStore(ctrl)
+	fs.EXPECT().Put(context.TODO(), "deployment-diff/deployment-id.json", gomock.Any()).Return(nil)
+
+	s := NewStore(fs, zap.NewNop())
+	err := s.Put(context.TODO(), "deployment-id", &model.DeploymentDiff{
+		TargetCommitHash: "target",
+		NoChange:         true,
+		CreatedAt:        1590000000,
+	})
+	require.NoError(t, err)
+}
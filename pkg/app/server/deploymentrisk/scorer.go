@@ -0,0 +1,222 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deploymentrisk provides a scorer to compute the heuristic risk score
+// of a deployment, which helps the approvers to decide how carefully to review it.
+package deploymentrisk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/deploymentdiffstore"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// The names of the factors of the risk score.
+const (
+	FactorDiffSize            = "DIFF_SIZE"
+	FactorDestroyedResources  = "DESTROYED_RESOURCES"
+	FactorTimeSinceLastDeploy = "TIME_SINCE_LAST_DEPLOY"
+	FactorFailureRate         = "FAILURE_RATE"
+	FactorOffHours            = "OFF_HOURS"
+)
+
+const (
+	maxScore = 100
+
+	// The values at which each factor scores its full weight.
+	fullDiffSize            = 20
+	fullDestroyedResources  = 5
+	fullTimeSinceLastDeploy = 30 * 24 * time.Hour
+
+	// The number of the recent deployments used to compute the failure rate.
+	recentDeploymentsNum = 20
+)
+
+type applicationGetter interface {
+	Get(ctx context.Context, id string) (*model.Application, error)
+}
+
+type deploymentLister interface {
+	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Deployment, string, error)
+}
+
+type diffGetter interface {
+	Get(ctx context.Context, deploymentID string) (*model.DeploymentDiff, error)
+}
+
+// Scorer computes the risk scores of deployments
+// with the scoring configured for their projects.
+type Scorer struct {
+	cfg              config.ControlPlaneDeploymentRisk
+	applicationStore applicationGetter
+	deploymentStore  deploymentLister
+	diffStore        diffGetter
+	nowFunc          func() time.Time
+	logger           *zap.Logger
+}
+
+// NewScorer creates a new Scorer.
+func NewScorer(cfg config.ControlPlaneDeploymentRisk, ds datastore.DataStore, dds deploymentdiffstore.Store, logger *zap.Logger) *Scorer {
+	// The scorer never writes to the datastore so the commander does not matter.
+	c := datastore.PipedCommander
+	return &Scorer{
+		cfg:              cfg,
+		applicationStore: datastore.NewApplicationStore(ds, c),
+		deploymentStore:  datastore.NewDeploymentStore(ds, c),
+		diffStore:        dds,
+		nowFunc:          time.Now,
+		logger:           logger.Named("deployment-risk-scorer"),
+	}
+}
+
+// Score returns the risk score of the given deployment.
+// Only the factors having a non-zero weight are included.
+func (s *Scorer) Score(ctx context.Context, d *model.Deployment) (*model.DeploymentRisk, error) {
+	var (
+		scoring = s.cfg.Find(d.ProjectId)
+		risk    = &model.DeploymentRisk{}
+	)
+	add := func(name string, weight int, ratio float64, desc string) {
+		if weight == 0 {
+			return
+		}
+		if ratio > 1 {
+			ratio = 1
+		}
+		score := int32(float64(weight)*ratio + 0.5)
+		risk.Factors = append(risk.Factors, &model.DeploymentRiskFactor{
+			Name:        name,
+			Score:       score,
+			Description: desc,
+		})
+		risk.Score += score
+	}
+
+	if scoring.DiffSize > 0 || scoring.DestroyedResources > 0 {
+		diff, err := s.diffStore.Get(ctx, d.Id)
+		switch {
+		case errors.Is(err, deploymentdiffstore.ErrNotFound):
+			add(FactorDiffSize, scoring.DiffSize, 0, "No diff was reported")
+			add(FactorDestroyedResources, scoring.DestroyedResources, 0, "No diff was reported")
+		case err != nil:
+			s.logger.Error("failed to get deployment diff", zap.String("deployment-id", d.Id), zap.Error(err))
+			return nil, err
+		default:
+			changed, deleted := diff.ChangedResources, diff.DeletedResources
+			add(FactorDiffSize, scoring.DiffSize, float64(changed)/fullDiffSize, fmt.Sprintf("%d resources will be changed", changed))
+			add(FactorDestroyedResources, scoring.DestroyedResources, float64(deleted)/fullDestroyedResources, fmt.Sprintf("%d resources will be deleted", deleted))
+		}
+	}
+
+	if scoring.TimeSinceLastDeploy > 0 || scoring.FailureRate > 0 {
+		app, err := s.applicationStore.Get(ctx, d.ApplicationId)
+		if err != nil {
+			s.logger.Error("failed to get application", zap.String("application-id", d.ApplicationId), zap.Error(err))
+			return nil, err
+		}
+		if last := app.MostRecentlySuccessfulDeployment; last != nil && last.CompletedAt > 0 {
+			elapsed := s.nowFunc().Sub(time.Unix(last.CompletedAt, 0))
+			days := int(elapsed.Hours() / 24)
+			add(FactorTimeSinceLastDeploy, scoring.TimeSinceLastDeploy, float64(elapsed)/float64(fullTimeSinceLastDeploy), fmt.Sprintf("Last deployed successfully %d days ago", days))
+		} else {
+			add(FactorTimeSinceLastDeploy, scoring.TimeSinceLastDeploy, 1, "Never deployed successfully")
+		}
+
+		if scoring.FailureRate > 0 {
+			failed, completed, err := s.countRecentFailures(ctx, d)
+			if err != nil {
+				return nil, err
+			}
+			var ratio float64
+			if completed > 0 {
+				ratio = float64(failed) / float64(completed)
+			}
+			add(FactorFailureRate, scoring.FailureRate, ratio, fmt.Sprintf("%d of the last %d deployments failed", failed, completed))
+		}
+	}
+
+	if scoring.OffHours > 0 {
+		loc, err := time.LoadLocation(scoring.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		var (
+			now        = s.nowFunc().In(loc)
+			start, end = scoring.WorkingHours()
+			weekend    = now.Weekday() == time.Saturday || now.Weekday() == time.Sunday
+			off        = weekend || now.Hour() < start || now.Hour() >= end
+		)
+		if off {
+			add(FactorOffHours, scoring.OffHours, 1, fmt.Sprintf("Deploying at %s (%s), outside the working hours %d:00-%d:00 on weekdays", now.Format("Mon 15:04"), loc, start, end))
+		} else {
+			add(FactorOffHours, scoring.OffHours, 0, fmt.Sprintf("Deploying at %s (%s), within the working hours", now.Format("Mon 15:04"), loc))
+		}
+	}
+
+	if risk.Score > maxScore {
+		risk.Score = maxScore
+	}
+	return risk, nil
+}
+
+// countRecentFailures returns the number of the failed ones and the completed ones
+// in the recent deployments of the application, excluding the given one.
+func (s *Scorer) countRecentFailures(ctx context.Context, d *model.Deployment) (failed, completed int, err error) {
+	opts := datastore.ListOptions{
+		Limit: recentDeploymentsNum + 1,
+		Filters: []datastore.ListFilter{
+			{
+				Field:    "ApplicationId",
+				Operator: datastore.OperatorEqual,
+				Value:    d.ApplicationId,
+			},
+		},
+		Orders: []datastore.Order{
+			{
+				Field:     "UpdatedAt",
+				Direction: datastore.Desc,
+			},
+			{
+				Field:     "Id",
+				Direction: datastore.Asc,
+			},
+		},
+	}
+	deployments, _, err := s.deploymentStore.List(ctx, opts)
+	if err != nil {
+		s.logger.Error("failed to list deployments", zap.String("application-id", d.ApplicationId), zap.Error(err))
+		return 0, 0, err
+	}
+	for _, r := range deployments {
+		if r.Id == d.Id || completed == recentDeploymentsNum {
+			continue
+		}
+		switch r.Status {
+		case model.DeploymentStatus_DEPLOYMENT_SUCCESS:
+			completed++
+		case model.DeploymentStatus_DEPLOYMENT_FAILURE:
+			completed++
+			failed++
+		}
+	}
+	return failed, completed, nil
+}
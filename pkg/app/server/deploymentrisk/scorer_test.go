@@ -0,0 +1,164 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploymentrisk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/deploymentdiffstore"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeApplicationStore struct {
+	app *model.Application
+}
+
+func (s fakeApplicationStore) Get(_ context.Context, _ string) (*model.Application, error) {
+	return s.app, nil
+}
+
+type fakeDeploymentStore struct {
+	deployments []*model.Deployment
+}
+
+func (s fakeDeploymentStore) List(_ context.Context, _ datastore.ListOptions) ([]*model.Deployment, string, error) {
+	return s.deployments, "", nil
+}
+
+type fakeDiffStore struct {
+	diff *model.DeploymentDiff
+}
+
+func (s fakeDiffStore) Get(_ context.Context, _ string) (*model.DeploymentDiff, error) {
+	if s.diff == nil {
+		return nil, deploymentdiffstore.ErrNotFound
+	}
+	return s.diff, nil
+}
+
+func TestScore(t *testing.T) {
+	t.Parallel()
+
+	// Wednesday 10:00 in UTC.
+	now := time.Date(2025, 1, 8, 10, 0, 0, 0, time.UTC)
+	deployment := &model.Deployment{
+		Id:            "deployment-1",
+		ApplicationId: "app-1",
+		ProjectId:     "project-1",
+	}
+	recent := []*model.Deployment{
+		deployment,
+		{Id: "deployment-2", Status: model.DeploymentStatus_DEPLOYMENT_SUCCESS},
+		{Id: "deployment-3", Status: model.DeploymentStatus_DEPLOYMENT_FAILURE},
+		{Id: "deployment-4", Status: model.DeploymentStatus_DEPLOYMENT_CANCELLED},
+		{Id: "deployment-5", Status: model.DeploymentStatus_DEPLOYMENT_SUCCESS},
+		{Id: "deployment-6", Status: model.DeploymentStatus_DEPLOYMENT_SUCCESS},
+	}
+	deployedApp := &model.Application{
+		MostRecentlySuccessfulDeployment: &model.ApplicationDeploymentReference{
+			CompletedAt: now.Add(-15 * 24 * time.Hour).Unix(),
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		cfg      config.ControlPlaneDeploymentRisk
+		app      *model.Application
+		diff     *model.DeploymentDiff
+		now      time.Time
+		expected *model.DeploymentRisk
+	}{
+		{
+			name: "default scoring",
+			app:  deployedApp,
+			diff: &model.DeploymentDiff{ChangedResources: 10, DeletedResources: 1},
+			now:  now,
+			expected: &model.DeploymentRisk{
+				Score: 31,
+				Factors: []*model.DeploymentRiskFactor{
+					{Name: FactorDiffSize, Score: 13, Description: "10 resources will be changed"},
+					{Name: FactorDestroyedResources, Score: 5, Description: "1 resources will be deleted"},
+					{Name: FactorTimeSinceLastDeploy, Score: 8, Description: "Last deployed successfully 15 days ago"},
+					{Name: FactorFailureRate, Score: 5, Description: "1 of the last 4 deployments failed"},
+					{Name: FactorOffHours, Score: 0, Description: "Deploying at Wed 10:00 (UTC), within the working hours"},
+				},
+			},
+		},
+		{
+			name: "capped at the max score",
+			cfg: config.ControlPlaneDeploymentRisk{
+				Projects: []config.DeploymentRiskScoringOverride{
+					{
+						ProjectID: "project-1",
+						DeploymentRiskScoring: config.DeploymentRiskScoring{
+							TimeSinceLastDeploy: 80,
+							OffHours:            80,
+							Timezone:            "Asia/Tokyo",
+						},
+					},
+				},
+			},
+			app: &model.Application{},
+			now: now,
+			expected: &model.DeploymentRisk{
+				Score: 100,
+				Factors: []*model.DeploymentRiskFactor{
+					{Name: FactorTimeSinceLastDeploy, Score: 80, Description: "Never deployed successfully"},
+					{Name: FactorOffHours, Score: 80, Description: "Deploying at Wed 19:00 (Asia/Tokyo), outside the working hours 9:00-18:00 on weekdays"},
+				},
+			},
+		},
+		{
+			name: "no diff on weekend",
+			cfg: config.ControlPlaneDeploymentRisk{
+				Default: &config.DeploymentRiskScoring{DiffSize: 50, OffHours: 50},
+			},
+			app: deployedApp,
+			now: time.Date(2025, 1, 11, 10, 0, 0, 0, time.UTC),
+			expected: &model.DeploymentRisk{
+				Score: 50,
+				Factors: []*model.DeploymentRiskFactor{
+					{Name: FactorDiffSize, Score: 0, Description: "No diff was reported"},
+					{Name: FactorOffHours, Score: 50, Description: "Deploying at Sat 10:00 (UTC), outside the working hours 9:00-18:00 on weekdays"},
+				},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			s := &Scorer{
+				cfg:              tc.cfg,
+				applicationStore: fakeApplicationStore{app: tc.app},
+				deploymentStore:  fakeDeploymentStore{deployments: recent},
+				diffStore:        fakeDiffStore{diff: tc.diff},
+				nowFunc:          func() time.Time { return tc.now },
+				logger:           zap.NewNop(),
+			}
+			got, err := s.Score(context.Background(), deployment)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deploymenttoken provides a way to issue and verify short-lived tokens
+// which allow piped to call the per-deployment RPCs of a single deployment
+// without using its long-lived piped key.
+package deploymenttoken
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	jwtgo "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/cache"
+	"github.com/pipe-cd/pipecd/pkg/jwt"
+	"github.com/pipe-cd/pipecd/pkg/rpc/rpcauth"
+)
+
+const (
+	// audience distinguishes deployment tokens from the other tokens issued by PipeCD.
+	audience = "piped-deployment"
+	// keyContext is used to derive the signing key of deployment tokens
+	// so that they can never be accepted as web tokens and vice versa.
+	keyContext = "pipecd-deployment-token"
+
+	revokedKeyPrefix = "deployment-token-revoked:"
+)
+
+var (
+	ErrRevoked = errors.New("deployment token was revoked")
+)
+
+// Claims represents the claims of a deployment token.
+// The subject of the token is the ID of the deployment it was issued for.
+type Claims struct {
+	jwtgo.RegisteredClaims
+	ProjectID string `json:"projectId"`
+	PipedID   string `json:"pipedId"`
+	// The ID of the piped key used to issue the token.
+	// The token is no longer accepted once the key was deleted.
+	PipedKeyID string `json:"pipedKeyId"`
+}
+
+// Manager issues, verifies and revokes deployment tokens.
+type Manager struct {
+	key          []byte
+	method       jwtgo.SigningMethod
+	ttl          time.Duration
+	revokedCache cache.Cache
+	nowFunc      func() time.Time
+	logger       *zap.Logger
+}
+
+// NewManager creates a new Manager whose signing key is derived from the given key file.
+// The revokedCache is used to share the revoked deployments between control plane replicas,
+// its TTL should not be shorter than the given token TTL.
+func NewManager(keyFile string, ttl time.Duration, revokedCache cache.Cache, logger *zap.Logger) (*Manager, error) {
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read key file: %w", err)
+	}
+	return newManager(data, ttl, revokedCache, logger), nil
+}
+
+func newManager(secret []byte, ttl time.Duration, revokedCache cache.Cache, logger *zap.Logger) *Manager {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(keyContext))
+	return &Manager{
+		key:          mac.Sum(nil),
+		method:       jwtgo.SigningMethodHS256,
+		ttl:          ttl,
+		revokedCache: revokedCache,
+		nowFunc:      time.Now,
+		logger:       logger.Named("deployment-token"),
+	}
+}
+
+// Issue issues a new token for the given deployment.
+func (m *Manager) Issue(projectID, pipedID, pipedKeyID, deploymentID string) (string, time.Time, error) {
+	var (
+		now       = m.nowFunc().UTC()
+		expiresAt = now.Add(m.ttl)
+		claims    = &Claims{
+			RegisteredClaims: jwtgo.RegisteredClaims{
+				ID:        uuid.New().String(),
+				Subject:   deploymentID,
+				Issuer:    jwt.Issuer,
+				Audience:  jwtgo.ClaimStrings{audience},
+				IssuedAt:  jwtgo.NewNumericDate(now),
+				NotBefore: jwtgo.NewNumericDate(now),
+				ExpiresAt: jwtgo.NewNumericDate(expiresAt),
+			},
+			ProjectID:  projectID,
+			PipedID:    pipedID,
+			PipedKeyID: pipedKeyID,
+		}
+	)
+	token, err := jwtgo.NewWithClaims(m.method, claims).SignedString(m.key)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	m.logger.Info("issued a deployment token",
+		zap.String("project-id", projectID),
+		zap.String("piped-id", pipedID),
+		zap.String("deployment-id", deploymentID),
+		zap.String("token-id", claims.ID),
+		zap.Time("expires-at", expiresAt),
+	)
+	return token, expiresAt, nil
+}
+
+// Verify checks the given token and returns the claims of what it was issued for.
+func (m *Manager) Verify(_ context.Context, token string) (*rpcauth.DeploymentTokenClaims, error) {
+	parser := jwtgo.NewParser(
+		jwtgo.WithIssuer(jwt.Issuer),
+		jwtgo.WithAudience(audience),
+		jwtgo.WithIssuedAt(),
+		jwtgo.WithExpirationRequired(),
+		jwtgo.WithTimeFunc(m.nowFunc),
+	)
+	parsed, err := parser.ParseWithClaims(token, &Claims{}, func(t *jwtgo.Token) (interface{}, error) {
+		if t.Method != m.method {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Method.Alg())
+		}
+		return m.key, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse token: %w", err)
+	}
+	claims, ok := parsed.Claims.(*Claims)
+	if !ok || !parsed.Valid {
+		return nil, fmt.Errorf("token is not valid")
+	}
+	if claims.Subject == "" || claims.ProjectID == "" || claims.PipedID == "" || claims.PipedKeyID == "" {
+		return nil, fmt.Errorf("token is missing required claims")
+	}
+	if _, err := m.revokedCache.Get(revokedKeyPrefix + claims.Subject); err == nil {
+		return nil, ErrRevoked
+	} else if !errors.Is(err, cache.ErrNotFound) {
+		return nil, fmt.Errorf("unable to check token revocation: %w", err)
+	}
+	return &rpcauth.DeploymentTokenClaims{
+		ProjectID:    claims.ProjectID,
+		PipedID:      claims.PipedID,
+		PipedKeyID:   claims.PipedKeyID,
+		DeploymentID: claims.Subject,
+	}, nil
+}
+
+// Revoke invalidates all tokens issued for the given deployment.
+func (m *Manager) Revoke(_ context.Context, deploymentID string) error {
+	if err := m.revokedCache.Put(revokedKeyPrefix+deploymentID, m.nowFunc().Unix()); err != nil {
+		return err
+	}
+	m.logger.Info("revoked deployment tokens", zap.String("deployment-id", deploymentID))
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploymenttoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	jwtgo "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
+	"github.com/pipe-cd/pipecd/pkg/jwt"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestManager(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		secret = []byte("secret")
+		m      = newManager(secret, time.Hour, memorycache.NewCache(), zap.NewNop())
+	)
+
+	token, expiresAt, err := m.Issue("project-id", "piped-id", "piped-key-id", "deployment-id")
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+
+	claims, err := m.Verify(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, "project-id", claims.ProjectID)
+	assert.Equal(t, "piped-id", claims.PipedID)
+	assert.Equal(t, "piped-key-id", claims.PipedKeyID)
+	assert.Equal(t, "deployment-id", claims.DeploymentID)
+
+	// Tokens must be rejected after their expiration.
+	m.nowFunc = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	_, err = m.Verify(ctx, token)
+	assert.Error(t, err)
+	m.nowFunc = time.Now
+
+	// Tokens signed by another manager must be rejected.
+	other := newManager([]byte("another-secret"), time.Hour, memorycache.NewCache(), zap.NewNop())
+	_, err = other.Verify(ctx, token)
+	assert.Error(t, err)
+
+	// Web tokens signed with the same secret must be rejected.
+	webToken, err := jwtgo.NewWithClaims(jwtgo.SigningMethodHS256, jwt.NewClaims("user", "", time.Hour, model.Role{})).SignedString(secret)
+	require.NoError(t, err)
+	_, err = m.Verify(ctx, webToken)
+	assert.Error(t, err)
+
+	// Tokens must be rejected once their deployment was revoked.
+	require.NoError(t, m.Revoke(ctx, "deployment-id"))
+	_, err = m.Verify(ctx, token)
+	assert.ErrorIs(t, err, ErrRevoked)
+
+	token, _, err = m.Issue("project-id", "piped-id", "piped-key-id", "another-deployment-id")
+	require.NoError(t, err)
+	_, err = m.Verify(ctx, token)
+	assert.NoError(t, err)
+}
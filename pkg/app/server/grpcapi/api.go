@@ -19,6 +19,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -28,12 +31,18 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/pipe-cd/pipecd/pkg/app/server/commandstore"
+	"github.com/pipe-cd/pipecd/pkg/app/server/deploymentdiffstore"
+	"github.com/pipe-cd/pipecd/pkg/app/server/projectquota"
+	"github.com/pipe-cd/pipecd/pkg/app/server/projectwebhook"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/app/server/stageartifactstore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/stagelogstore"
 	"github.com/pipe-cd/pipecd/pkg/cache"
 	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
+	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/datastore"
 	"github.com/pipe-cd/pipecd/pkg/filestore"
+	"github.com/pipe-cd/pipecd/pkg/jwt"
 	"github.com/pipe-cd/pipecd/pkg/model"
 	"github.com/pipe-cd/pipecd/pkg/rpc/rpcauth"
 )
@@ -48,11 +57,14 @@ type apiApplicationStore interface {
 	UpdateConfigFilename(ctx context.Context, id, filename string) error
 	UpdateConfiguration(ctx context.Context, id, pipedID, platformProvider, configFilename string, deployTargetsByPlugin map[string]*model.DeployTargets) error
 	UpdateDeployTargets(ctx context.Context, id string, dp map[string]*model.DeployTargets) error
+	UpdateLabels(ctx context.Context, id string, add map[string]string, remove []string) error
+	UpdatePiped(ctx context.Context, id, pipedID, platformProvider string) error
 }
 
 type apiDeploymentStore interface {
 	Get(ctx context.Context, id string) (*model.Deployment, error)
 	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Deployment, string, error)
+	UpdateLabels(ctx context.Context, id string, add map[string]string, remove []string) error
 }
 
 type apiPipedStore interface {
@@ -62,6 +74,7 @@ type apiPipedStore interface {
 	UpdateInfo(ctx context.Context, id, name, desc string) error
 	EnablePiped(ctx context.Context, id string) error
 	DisablePiped(ctx context.Context, id string) error
+	UpdateDesiredVersion(ctx context.Context, id, version string) error
 }
 
 type apiEventStore interface {
@@ -76,6 +89,11 @@ type commandOutputGetter interface {
 	Get(ctx context.Context, commandID string) ([]byte, error)
 }
 
+// approverTokenStore records the IDs of the approver tokens which have already been used.
+type approverTokenStore interface {
+	PutIfNotExists(key string, value interface{}) (bool, error)
+}
+
 // API implements the behaviors for the gRPC definitions of API.
 type API struct {
 	apiservice.UnimplementedAPIServiceServer
@@ -87,11 +105,20 @@ type API struct {
 	deploymentTraceStore apiDeploymentTraceStore
 	commandStore         commandstore.Store
 	stageLogStore        stagelogstore.Store
+	deploymentDiffStore  deploymentdiffstore.Store
+	stageArtifactStore   stageartifactstore.Store
 	commandOutputGetter  commandOutputGetter
+	quotaChecker         *projectquota.Checker
+	webhookNotifier      *projectwebhook.Notifier
+	approverVerifier     jwt.ExternalVerifier
+	approverTokenStore   approverTokenStore
 
 	encryptionKeyCache cache.Cache
 	pipedStatCache     cache.Cache
 
+	// The interval to check newly appended stage logs while streaming them.
+	stageLogsPollingInterval time.Duration
+
 	webBaseURL string
 	logger     *zap.Logger
 }
@@ -105,6 +132,10 @@ func NewAPI(
 	cog commandOutputGetter,
 	psc cache.Cache,
 	webBaseURL string,
+	qc *projectquota.Checker,
+	wn *projectwebhook.Notifier,
+	av jwt.ExternalVerifier,
+	ats approverTokenStore,
 	logger *zap.Logger,
 ) *API {
 	w := datastore.PipectlCommander
@@ -116,12 +147,19 @@ func NewAPI(
 		deploymentTraceStore: datastore.NewDeploymentTraceStore(ds, w),
 		commandStore:         commandstore.NewStore(w, ds, sc, logger),
 		stageLogStore:        stagelogstore.NewStore(fs, sc, logger),
+		deploymentDiffStore:  deploymentdiffstore.NewStore(fs, logger),
+		stageArtifactStore:   stageartifactstore.NewStore(fs, logger),
 		commandOutputGetter:  cog,
+		quotaChecker:         qc,
+		webhookNotifier:      wn,
+		approverVerifier:     av,
+		approverTokenStore:   ats,
 		// Public key is variable but likely to be accessed multiple times in a short period.
-		encryptionKeyCache: memorycache.NewTTLCache(ctx, 5*time.Minute, 5*time.Minute),
-		pipedStatCache:     psc,
-		webBaseURL:         webBaseURL,
-		logger:             logger.Named("api"),
+		encryptionKeyCache:       memorycache.NewTTLCache(ctx, 5*time.Minute, 5*time.Minute),
+		pipedStatCache:           psc,
+		stageLogsPollingInterval: time.Second,
+		webBaseURL:               webBaseURL,
+		logger:                   logger.Named("api"),
 	}
 	return a
 }
@@ -146,6 +184,10 @@ func (a *API) AddApplication(ctx context.Context, req *apiservice.AddApplication
 		return nil, status.Error(codes.InvalidArgument, "Requested piped does not belong to your project")
 	}
 
+	if err := a.quotaChecker.CheckApplications(ctx, key.ProjectId); err != nil {
+		return nil, checkProjectQuota(err, a.logger)
+	}
+
 	gitpath, err := makeGitPath(
 		req.GitPath.Repo.Id,
 		req.GitPath.Path,
@@ -171,6 +213,7 @@ func (a *API) AddApplication(ctx context.Context, req *apiservice.AddApplication
 	if err := a.applicationStore.Add(ctx, &app); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("add application %s", app.Id))
 	}
+	a.webhookNotifier.Notify(applicationEvent(config.ProjectEventApplicationRegistered, &app, key.Id))
 
 	return &apiservice.AddApplicationResponse{
 		ApplicationId: app.Id,
@@ -192,6 +235,12 @@ func (a *API) SyncApplication(ctx context.Context, req *apiservice.SyncApplicati
 		return nil, status.Error(codes.InvalidArgument, "Requested application does not belong to your project")
 	}
 
+	if req.PromoteFromDeploymentId != "" {
+		if err := a.validatePromotedDeployment(ctx, app, req.PromoteFromDeploymentId); err != nil {
+			return nil, err
+		}
+	}
+
 	cmd := model.Command{
 		Id:            uuid.New().String(),
 		PipedId:       app.PipedId,
@@ -200,8 +249,11 @@ func (a *API) SyncApplication(ctx context.Context, req *apiservice.SyncApplicati
 		Type:          model.Command_SYNC_APPLICATION,
 		Commander:     key.Id,
 		SyncApplication: &model.Command_SyncApplication{
-			ApplicationId: app.Id,
-			SyncStrategy:  model.SyncStrategy_AUTO,
+			ApplicationId:           app.Id,
+			SyncStrategy:            model.SyncStrategy_AUTO,
+			Parameters:              req.Parameters,
+			DryRun:                  req.DryRun,
+			PromoteFromDeploymentId: req.PromoteFromDeploymentId,
 		},
 	}
 	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
@@ -213,6 +265,31 @@ func (a *API) SyncApplication(ctx context.Context, req *apiservice.SyncApplicati
 	}, nil
 }
 
+// validatePromotedDeployment checks whether the artifact of the given deployment can be promoted to the given application.
+// Whether the application is configured to promote the artifact of that deployment's application is verified by piped while planning.
+func (a *API) validatePromotedDeployment(ctx context.Context, app *model.Application, deploymentID string) error {
+	if app.Kind != model.ApplicationKind_KUBERNETES {
+		return status.Error(codes.InvalidArgument, "Artifact promotion is supported only by Kubernetes applications")
+	}
+	deployment, err := getDeployment(ctx, a.deploymentStore, deploymentID, a.logger)
+	if err != nil {
+		return err
+	}
+	if deployment.ProjectId != app.ProjectId {
+		return status.Error(codes.InvalidArgument, "Requested deployment to promote does not belong to your project")
+	}
+	if deployment.ApplicationId == app.Id {
+		return status.Error(codes.InvalidArgument, "Requested deployment to promote belongs to the same application")
+	}
+	if deployment.Status != model.DeploymentStatus_DEPLOYMENT_SUCCESS {
+		return status.Errorf(codes.FailedPrecondition, "Requested deployment to promote is not successful: %s", deployment.Status)
+	}
+	if deployment.Artifact == nil {
+		return status.Error(codes.FailedPrecondition, "Requested deployment to promote has no artifact")
+	}
+	return nil
+}
+
 func (a *API) GetApplication(ctx context.Context, req *apiservice.GetApplicationRequest) (*apiservice.GetApplicationResponse, error) {
 	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
 	if err != nil {
@@ -393,6 +470,13 @@ func (a *API) DeleteApplication(ctx context.Context, req *apiservice.DeleteAppli
 	if err := a.applicationStore.Delete(ctx, req.ApplicationId); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("delete application %s", app.Id))
 	}
+	a.webhookNotifier.Notify(applicationEvent(config.ProjectEventApplicationDeleted, app, key.Id))
+
+	// The application has already been deleted at this point,
+	// so failing to clean up its resources should not fail the request.
+	if err := addDeleteApplicationCommand(ctx, a.commandStore, app, key.Id, a.logger); err != nil {
+		a.logger.Warn("failed to request piped to clean up resources of the deleted application", zap.String("application-id", app.Id), zap.Error(err))
+	}
 
 	return &apiservice.DeleteApplicationResponse{
 		ApplicationId: app.Id,
@@ -447,6 +531,54 @@ func (a *API) DisableApplication(ctx context.Context, req *apiservice.DisableApp
 	}, nil
 }
 
+// MigrateApplication moves an application to another piped in the same project.
+// The references to its most recent deployments are kept, so the next deployment
+// on the new piped is not treated as the first one and can still be rolled back.
+func (a *API) MigrateApplication(ctx context.Context, req *apiservice.MigrateApplicationRequest) (*apiservice.MigrateApplicationResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := getApplication(ctx, a.applicationStore, req.ApplicationId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if app.ProjectId != key.ProjectId {
+		return nil, status.Error(codes.InvalidArgument, "Requested application does not belong to your project")
+	}
+
+	if app.PipedId == req.PipedId {
+		return nil, status.Error(codes.InvalidArgument, "Requested application already belongs to the piped")
+	}
+
+	if app.Deploying {
+		return nil, status.Error(codes.FailedPrecondition, "Requested application is deploying, please try again after the deployment is completed")
+	}
+
+	piped, err := getPiped(ctx, a.pipedStore, req.PipedId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if piped.ProjectId != key.ProjectId {
+		return nil, status.Error(codes.InvalidArgument, "Requested piped does not belong to your project")
+	}
+
+	if piped.Disabled {
+		return nil, status.Error(codes.FailedPrecondition, "Requested piped is disabled")
+	}
+
+	if err := a.applicationStore.UpdatePiped(ctx, app.Id, piped.Id, req.PlatformProvider); err != nil {
+		return nil, gRPCStoreError(err, fmt.Sprintf("migrate application %s to piped %s", app.Id, piped.Id))
+	}
+
+	return &apiservice.MigrateApplicationResponse{
+		ApplicationId: app.Id,
+	}, nil
+}
+
 func (a *API) RenameApplicationConfigFile(ctx context.Context, req *apiservice.RenameApplicationConfigFileRequest) (*apiservice.RenameApplicationConfigFileResponse, error) {
 	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
 	if err != nil {
@@ -490,6 +622,193 @@ func (a *API) UpdateApplicationDeployTargets(ctx context.Context, req *apiservic
 	return &apiservice.UpdateApplicationDeployTargetsResponse{}, nil
 }
 
+// UpdateApplicationLabels adds and removes labels of the applications
+// specified by their IDs or selected by the given labels.
+func (a *API) UpdateApplicationLabels(ctx context.Context, req *apiservice.UpdateApplicationLabelsRequest) (*apiservice.UpdateApplicationLabelsResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.ApplicationIds) == 0 && len(req.Selector) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Either application IDs or selector must be specified")
+	}
+	if len(req.AddLabels) == 0 && len(req.RemoveLabels) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No label to be added or removed")
+	}
+	for k, v := range req.AddLabels {
+		if err := model.ValidateLabelKey(k); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := model.ValidateLabelValue(v); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	for _, k := range req.RemoveLabels {
+		if _, ok := req.AddLabels[k]; ok {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Label %s cannot be added and removed at the same time", k))
+		}
+	}
+
+	apps, err := a.listLabelTargetApplications(ctx, key.ProjectId, req.ApplicationIds, req.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := make([]string, 0, len(apps))
+	for _, app := range apps {
+		if _, changed := model.ApplyLabelChanges(app.Labels, req.AddLabels, req.RemoveLabels); !changed {
+			continue
+		}
+		updated = append(updated, app.Id)
+	}
+	if req.DryRun {
+		return &apiservice.UpdateApplicationLabelsResponse{
+			ApplicationIds: updated,
+		}, nil
+	}
+
+	for _, id := range updated {
+		if err := a.applicationStore.UpdateLabels(ctx, id, req.AddLabels, req.RemoveLabels); err != nil {
+			return nil, gRPCStoreError(err, fmt.Sprintf("update labels of application %s", id))
+		}
+	}
+
+	var updatedDeployments int32
+	if req.PropagateToDeployments {
+		// Propagate to all of the target applications since their deployments
+		// might have been created before the labels were changed in the configuration.
+		for _, app := range apps {
+			n, err := a.updateDeploymentLabels(ctx, app.Id, req.AddLabels, req.RemoveLabels)
+			updatedDeployments += n
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	a.logger.Info("updated application labels",
+		zap.String("project-id", key.ProjectId),
+		zap.String("api-key-id", key.Id),
+		zap.String("api-key-name", key.Name),
+		zap.Strings("application-ids", updated),
+		zap.Any("add-labels", req.AddLabels),
+		zap.Strings("remove-labels", req.RemoveLabels),
+		zap.Int32("updated-deployment-count", updatedDeployments),
+	)
+
+	return &apiservice.UpdateApplicationLabelsResponse{
+		ApplicationIds:         updated,
+		UpdatedDeploymentCount: updatedDeployments,
+	}, nil
+}
+
+// listLabelTargetApplications returns the applications which are specified by the given IDs
+// and have all of the selector labels.
+// All applications in the project are checked against the selector when no ID is given.
+func (a *API) listLabelTargetApplications(ctx context.Context, projectID string, ids []string, selector map[string]string) ([]*model.Application, error) {
+	var apps []*model.Application
+	if len(ids) > 0 {
+		apps = make([]*model.Application, 0, len(ids))
+		for _, id := range ids {
+			app, err := getApplication(ctx, a.applicationStore, id, a.logger)
+			if err != nil {
+				return nil, err
+			}
+			if app.ProjectId != projectID {
+				return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("requested application %s does not belong to your project", id))
+			}
+			apps = append(apps, app)
+		}
+	} else {
+		opts := datastore.ListOptions{
+			Limit: 500,
+			Filters: []datastore.ListFilter{
+				{
+					Field:    "ProjectId",
+					Operator: datastore.OperatorEqual,
+					Value:    projectID,
+				},
+			},
+			Orders: []datastore.Order{
+				{
+					Field:     "UpdatedAt",
+					Direction: datastore.Desc,
+				},
+				{
+					Field:     "Id",
+					Direction: datastore.Asc,
+				},
+			},
+		}
+		for {
+			page, cursor, err := a.applicationStore.List(ctx, opts)
+			if err != nil {
+				return nil, gRPCStoreError(err, "list applications")
+			}
+			apps = append(apps, page...)
+			if cursor == "" || len(page) == 0 {
+				break
+			}
+			opts.Cursor = cursor
+		}
+	}
+
+	filtered := make([]*model.Application, 0, len(apps))
+	for _, app := range apps {
+		if app.Deleted || !app.ContainLabels(selector) {
+			continue
+		}
+		filtered = append(filtered, app)
+	}
+	return filtered, nil
+}
+
+// updateDeploymentLabels applies the label changes to all deployments of the given application
+// and returns the number of the updated deployments.
+func (a *API) updateDeploymentLabels(ctx context.Context, appID string, add map[string]string, remove []string) (int32, error) {
+	opts := datastore.ListOptions{
+		Limit: 500,
+		Filters: []datastore.ListFilter{
+			{
+				Field:    "ApplicationId",
+				Operator: datastore.OperatorEqual,
+				Value:    appID,
+			},
+		},
+		Orders: []datastore.Order{
+			{
+				Field:     "UpdatedAt",
+				Direction: datastore.Desc,
+			},
+			{
+				Field:     "Id",
+				Direction: datastore.Asc,
+			},
+		},
+	}
+	var updated int32
+	for {
+		deployments, cursor, err := a.deploymentStore.List(ctx, opts)
+		if err != nil {
+			return updated, gRPCStoreError(err, fmt.Sprintf("list deployments of application %s", appID))
+		}
+		for _, d := range deployments {
+			if _, changed := model.ApplyLabelChanges(d.Labels, add, remove); !changed {
+				continue
+			}
+			if err := a.deploymentStore.UpdateLabels(ctx, d.Id, add, remove); err != nil {
+				return updated, gRPCStoreError(err, fmt.Sprintf("update labels of deployment %s", d.Id))
+			}
+			updated++
+		}
+		if cursor == "" || len(deployments) == 0 {
+			return updated, nil
+		}
+		opts.Cursor = cursor
+	}
+}
+
 func (a *API) GetDeployment(ctx context.Context, req *apiservice.GetDeploymentRequest) (*apiservice.GetDeploymentResponse, error) {
 	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
 	if err != nil {
@@ -510,6 +829,87 @@ func (a *API) GetDeployment(ctx context.Context, req *apiservice.GetDeploymentRe
 	}, nil
 }
 
+func (a *API) GetDeploymentDiff(ctx context.Context, req *apiservice.GetDeploymentDiffRequest) (*apiservice.GetDeploymentDiffResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.ProjectId != deployment.ProjectId {
+		return nil, status.Error(codes.InvalidArgument, "Requested deployment does not belong to your project")
+	}
+
+	diff, err := a.deploymentDiffStore.Get(ctx, req.DeploymentId)
+	if err != nil {
+		return nil, gRPCStoreError(err, "get deployment diff")
+	}
+
+	return &apiservice.GetDeploymentDiffResponse{
+		Diff: diff,
+	}, nil
+}
+
+func (a *API) ListStageArtifacts(ctx context.Context, req *apiservice.ListStageArtifactsRequest) (*apiservice.ListStageArtifactsResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.ProjectId != deployment.ProjectId {
+		return nil, status.Error(codes.InvalidArgument, "Requested deployment does not belong to your project")
+	}
+
+	artifacts, err := a.stageArtifactStore.List(ctx, deployment, req.StageId)
+	if err != nil {
+		return nil, gRPCStoreError(err, "list stage artifacts")
+	}
+
+	return &apiservice.ListStageArtifactsResponse{
+		Artifacts: artifacts,
+	}, nil
+}
+
+func (a *API) GetStageArtifact(ctx context.Context, req *apiservice.GetStageArtifactRequest) (*apiservice.GetStageArtifactResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.ProjectId != deployment.ProjectId {
+		return nil, status.Error(codes.InvalidArgument, "Requested deployment does not belong to your project")
+	}
+
+	content, err := a.stageArtifactStore.Get(ctx, deployment, req.StageId, req.Name)
+	if err != nil {
+		return nil, gRPCStoreError(err, "get stage artifact")
+	}
+
+	return &apiservice.GetStageArtifactResponse{
+		Artifact: &model.StageArtifact{
+			DeploymentId: req.DeploymentId,
+			StageId:      req.StageId,
+			Name:         req.Name,
+			Size:         int64(len(content)),
+		},
+		Content: content,
+	}, nil
+}
+
 func (a *API) ListDeployments(ctx context.Context, req *apiservice.ListDeploymentsRequest) (*apiservice.ListDeploymentsResponse, error) {
 	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
 	if err != nil {
@@ -648,8 +1048,8 @@ func (a *API) ListDeployments(ctx context.Context, req *apiservice.ListDeploymen
 	}, nil
 }
 
-func (a *API) ListStageLogs(ctx context.Context, req *apiservice.ListStageLogsRequest) (*apiservice.ListStageLogsResponse, error) {
-	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
+func (a *API) RetryDeployment(ctx context.Context, req *apiservice.RetryDeploymentRequest) (*apiservice.RetryDeploymentResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
 	if err != nil {
 		return nil, err
 	}
@@ -663,67 +1063,551 @@ func (a *API) ListStageLogs(ctx context.Context, req *apiservice.ListStageLogsRe
 		return nil, status.Error(codes.InvalidArgument, "Requested deployment does not belong to your project")
 	}
 
-	stageLogs := map[string]*apiservice.StageLog{}
-
-	for _, stage := range deployment.Stages {
-		blocks, completed, err := a.stageLogStore.FetchLogs(ctx, deployment.Id, stage.Id, stage.RetriedCount, 0)
-		if err != nil && !errors.Is(err, stagelogstore.ErrNotFound) {
-			return nil, err
-		}
-
-		// StageRollback is generated automatically and returns nothing if not found.
-		if err != nil && stage.Name == model.StageRollback.String() {
-			continue
-		}
-
-		if err != nil {
-			stageLogs[stage.Id] = &apiservice.StageLog{}
-			continue
-		}
-
-		stageLogs[stage.Id] = &apiservice.StageLog{
-			Blocks:    blocks,
-			Completed: completed,
-		}
-	}
-
-	return &apiservice.ListStageLogsResponse{
-		StageLogs: stageLogs,
-	}, nil
-}
-
-func (a *API) GetCommand(ctx context.Context, req *apiservice.GetCommandRequest) (*apiservice.GetCommandResponse, error) {
-	_, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
+	app, err := getApplication(ctx, a.applicationStore, deployment.ApplicationId, a.logger)
 	if err != nil {
 		return nil, err
 	}
+	if err := validateRetryableDeployment(deployment, app); err != nil {
+		return nil, err
+	}
 
-	cmd, err := getCommand(ctx, a.commandStore, req.CommandId, a.logger)
-	if err != nil {
+	cmd := model.Command{
+		Id:            uuid.New().String(),
+		PipedId:       deployment.PipedId,
+		ApplicationId: deployment.ApplicationId,
+		ProjectId:     deployment.ProjectId,
+		DeploymentId:  deployment.Id,
+		Type:          model.Command_RETRY_DEPLOYMENT,
+		Commander:     key.Id,
+		RetryDeployment: &model.Command_RetryDeployment{
+			DeploymentId: deployment.Id,
+		},
+	}
+	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
 		return nil, err
 	}
 
-	return &apiservice.GetCommandResponse{
-		Command: cmd,
+	return &apiservice.RetryDeploymentResponse{
+		CommandId: cmd.Id,
 	}, nil
 }
 
-func (a *API) GetPiped(ctx context.Context, req *apiservice.GetPipedRequest) (*apiservice.GetPipedResponse, error) {
-	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
+func (a *API) ReportStageResult(ctx context.Context, req *apiservice.ReportStageResultRequest) (*apiservice.ReportStageResultResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
 	if err != nil {
 		return nil, err
 	}
 
-	piped, err := getPiped(ctx, a.pipedStore, req.PipedId, a.logger)
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
 	if err != nil {
 		return nil, err
 	}
-	if piped.ProjectId != key.ProjectId {
-		return nil, status.Error(codes.InvalidArgument, "Requested piped does not belong to your project")
-	}
-
-	// Redact all sensitive data inside piped message before sending to the client.
-	piped.RedactSensitiveData()
+
+	if key.ProjectId != deployment.ProjectId {
+		return nil, status.Error(codes.InvalidArgument, "Requested deployment does not belong to your project")
+	}
+	if err := validateReportableStage(deployment, req.StageId); err != nil {
+		return nil, err
+	}
+
+	cmd := model.Command{
+		Id:            uuid.New().String(),
+		PipedId:       deployment.PipedId,
+		ApplicationId: deployment.ApplicationId,
+		ProjectId:     deployment.ProjectId,
+		DeploymentId:  deployment.Id,
+		StageId:       req.StageId,
+		Type:          model.Command_REPORT_STAGE_RESULT,
+		Commander:     key.Id,
+		ReportStageResult: &model.Command_ReportStageResult{
+			DeploymentId: deployment.Id,
+			StageId:      req.StageId,
+			Result:       req.Result,
+			Message:      req.Message,
+		},
+	}
+	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
+		return nil, err
+	}
+
+	return &apiservice.ReportStageResultResponse{
+		CommandId: cmd.Id,
+	}, nil
+}
+
+// ApproveStage approves a WAIT_APPROVAL stage on behalf of the approver
+// identified by the token signed by one of the configured approval issuers.
+func (a *API) ApproveStage(ctx context.Context, req *apiservice.ApproveStageRequest) (*apiservice.ApproveStageResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.approverVerifier == nil {
+		return nil, status.Error(codes.FailedPrecondition, "No approval issuer is configured in the control plane")
+	}
+	approver, err := a.approverVerifier.Verify(req.ApproverToken)
+	if err != nil {
+		a.logger.Warn("failed to verify the approver token", zap.Error(err))
+		return nil, status.Error(codes.Unauthenticated, "Unable to verify the approver token")
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.ProjectId != deployment.ProjectId {
+		return nil, status.Error(codes.InvalidArgument, "Requested deployment does not belong to your project")
+	}
+	if err := validateApprovableStage(deployment, req.StageId); err != nil {
+		return nil, err
+	}
+	if err := validateApprover(deployment.Stages, approver.Name, req.StageId); err != nil {
+		return nil, err
+	}
+	if approver.IsBound() && !approver.IsBoundTo(deployment.Id, req.StageId) {
+		return nil, status.Error(codes.PermissionDenied, "The approver token is not issued for the requested stage")
+	}
+	if err := a.consumeApproverToken(approver); err != nil {
+		return nil, err
+	}
+
+	cmd := model.Command{
+		Id:            uuid.New().String(),
+		PipedId:       deployment.PipedId,
+		ApplicationId: deployment.ApplicationId,
+		ProjectId:     deployment.ProjectId,
+		DeploymentId:  deployment.Id,
+		StageId:       req.StageId,
+		Type:          model.Command_APPROVE_STAGE,
+		Commander:     approver.Name,
+		ApproveStage: &model.Command_ApproveStage{
+			DeploymentId: deployment.Id,
+			StageId:      req.StageId,
+		},
+	}
+	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
+		return nil, err
+	}
+
+	a.logger.Info("approved stage on behalf of an external approver",
+		zap.String("deployment-id", deployment.Id),
+		zap.String("stage-id", req.StageId),
+		zap.String("approver", approver.Name),
+		zap.String("issuer", approver.Issuer),
+		zap.String("api-key-id", key.Id),
+	)
+
+	return &apiservice.ApproveStageResponse{
+		CommandId: cmd.Id,
+	}, nil
+}
+
+// ListPendingApprovals lists the WAIT_APPROVAL stages of the project which are waiting for an approval.
+func (a *API) ListPendingApprovals(ctx context.Context, req *apiservice.ListPendingApprovalsRequest) (*apiservice.ListPendingApprovalsResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	pendings, err := a.listPendingApprovals(ctx, key.ProjectId, req.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := make([]*apiservice.PendingApproval, 0, len(pendings))
+	for _, p := range pendings {
+		approvals = append(approvals, &apiservice.PendingApproval{
+			DeploymentId:    p.deployment.Id,
+			StageId:         p.stage.Id,
+			ApplicationId:   p.deployment.ApplicationId,
+			ApplicationName: p.deployment.ApplicationName,
+			Kind:            p.deployment.Kind,
+			Labels:          p.deployment.Labels,
+			Summary:         p.deployment.Summary,
+			Approvers:       stageApprovers(p.stage),
+		})
+	}
+	return &apiservice.ListPendingApprovalsResponse{
+		Approvals: approvals,
+	}, nil
+}
+
+// ApproveStages approves or rejects all of the WAIT_APPROVAL stages matching the given selector
+// on behalf of the approver identified by the token signed by one of the configured approval issuers.
+// The stages which could not be handled are reported in the results instead of failing the whole request.
+func (a *API) ApproveStages(ctx context.Context, req *apiservice.ApproveStagesRequest) (*apiservice.ApproveStagesResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if isEmptyApprovalSelector(req.Selector) {
+		return nil, status.Error(codes.InvalidArgument, "At least one condition of the selector must be specified")
+	}
+	if a.approverVerifier == nil {
+		return nil, status.Error(codes.FailedPrecondition, "No approval issuer is configured in the control plane")
+	}
+	approver, err := a.approverVerifier.Verify(req.ApproverToken)
+	if err != nil {
+		a.logger.Warn("failed to verify the approver token", zap.Error(err))
+		return nil, status.Error(codes.Unauthenticated, "Unable to verify the approver token")
+	}
+	if err := a.consumeApproverToken(approver); err != nil {
+		return nil, err
+	}
+
+	pendings, err := a.listPendingApprovals(ctx, key.ProjectId, req.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*apiservice.ApproveStagesResponse_Result, 0, len(pendings))
+	for _, p := range pendings {
+		d := p.deployment
+		result := &apiservice.ApproveStagesResponse_Result{
+			DeploymentId: d.Id,
+			StageId:      p.stage.Id,
+		}
+		results = append(results, result)
+
+		// A token bound to a stage must not be used to handle the other stages.
+		if approver.IsBound() && !approver.IsBoundTo(d.Id, p.stage.Id) {
+			result.Error = "The approver token is not issued for this stage"
+			continue
+		}
+		if err := validateApprover(d.Stages, approver.Name, p.stage.Id); err != nil {
+			result.Error = status.Convert(err).Message()
+			continue
+		}
+
+		cmd := model.Command{
+			Id:            uuid.New().String(),
+			PipedId:       d.PipedId,
+			ApplicationId: d.ApplicationId,
+			ProjectId:     d.ProjectId,
+			DeploymentId:  d.Id,
+			StageId:       p.stage.Id,
+			Commander:     approver.Name,
+		}
+		if req.Reject {
+			cmd.Type = model.Command_CANCEL_DEPLOYMENT
+			cmd.CancelDeployment = &model.Command_CancelDeployment{
+				DeploymentId: d.Id,
+			}
+		} else {
+			cmd.Type = model.Command_APPROVE_STAGE
+			cmd.ApproveStage = &model.Command_ApproveStage{
+				DeploymentId: d.Id,
+				StageId:      p.stage.Id,
+			}
+		}
+		if req.Comment != "" {
+			cmd.Metadata = map[string]string{
+				model.MetadataKeyComment: req.Comment,
+			}
+		}
+		if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
+			result.Error = status.Convert(err).Message()
+			continue
+		}
+		result.CommandId = cmd.Id
+
+		a.logger.Info("handled stage approval on behalf of an external approver",
+			zap.String("deployment-id", d.Id),
+			zap.String("stage-id", p.stage.Id),
+			zap.Bool("reject", req.Reject),
+			zap.String("approver", approver.Name),
+			zap.String("issuer", approver.Issuer),
+			zap.String("api-key-id", key.Id),
+		)
+	}
+
+	return &apiservice.ApproveStagesResponse{
+		Results: results,
+	}, nil
+}
+
+// consumeApproverToken ensures that the approver token which is not bound to any stage is used only once.
+func (a *API) consumeApproverToken(approver *jwt.ExternalIdentity) error {
+	if approver.IsBound() {
+		return nil
+	}
+	if a.approverTokenStore == nil {
+		return status.Error(codes.FailedPrecondition, "The approver token must be bound to a stage")
+	}
+	key := fmt.Sprintf("approver-token:%s:%s", approver.Issuer, approver.ID)
+	ok, err := a.approverTokenStore.PutIfNotExists(key, approver.ExpiresAt.Unix())
+	if err != nil {
+		a.logger.Error("failed to record the approver token", zap.Error(err))
+		return status.Error(codes.Internal, "Failed to record the approver token")
+	}
+	if !ok {
+		return status.Error(codes.Unauthenticated, "The approver token has already been used")
+	}
+	return nil
+}
+
+type pendingApproval struct {
+	deployment *model.Deployment
+	stage      *model.PipelineStage
+}
+
+func (a *API) listPendingApprovals(ctx context.Context, projectID string, selector *apiservice.ApprovalSelector) ([]pendingApproval, error) {
+	kinds := make([]model.ApplicationKind, 0, len(selector.GetKinds()))
+	for _, k := range selector.GetKinds() {
+		kind, ok := model.ApplicationKind_value[k]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "%s is invalid application kind", k)
+		}
+		kinds = append(kinds, model.ApplicationKind(kind))
+	}
+
+	deployments, _, err := a.deploymentStore.List(ctx, datastore.ListOptions{
+		Filters: []datastore.ListFilter{
+			{
+				Field:    "ProjectId",
+				Operator: datastore.OperatorEqual,
+				Value:    projectID,
+			},
+			{
+				Field:    "Status",
+				Operator: datastore.OperatorIn,
+				Value:    model.GetNotCompletedDeploymentStatuses(),
+			},
+		},
+	})
+	if err != nil {
+		a.logger.Error("failed to list deployments", zap.Error(err))
+		return nil, gRPCStoreError(err, "list deployments")
+	}
+	return selectPendingApprovals(deployments, selector, kinds), nil
+}
+
+// selectPendingApprovals returns the running WAIT_APPROVAL stages of the given deployments
+// which match the selector and the kinds parsed from it. A nil selector matches all of them.
+func selectPendingApprovals(deployments []*model.Deployment, selector *apiservice.ApprovalSelector, kinds []model.ApplicationKind) []pendingApproval {
+	var pendings []pendingApproval
+	for _, d := range deployments {
+		if !matchApprovalSelector(d, selector, kinds) {
+			continue
+		}
+		for _, s := range d.Stages {
+			if s.Name != model.StageWaitApproval.String() || s.Status != model.StageStatus_STAGE_RUNNING {
+				continue
+			}
+			pendings = append(pendings, pendingApproval{
+				deployment: d,
+				stage:      s,
+			})
+		}
+	}
+	return pendings
+}
+
+func matchApprovalSelector(d *model.Deployment, selector *apiservice.ApprovalSelector, kinds []model.ApplicationKind) bool {
+	if ids := selector.GetDeploymentIds(); len(ids) > 0 && !slices.Contains(ids, d.Id) {
+		return false
+	}
+	if ids := selector.GetApplicationIds(); len(ids) > 0 && !slices.Contains(ids, d.ApplicationId) {
+		return false
+	}
+	if name := selector.GetApplicationName(); name != "" && name != d.ApplicationName {
+		return false
+	}
+	if len(kinds) > 0 && !slices.Contains(kinds, d.Kind) {
+		return false
+	}
+	return d.ContainLabels(selector.GetLabels())
+}
+
+func isEmptyApprovalSelector(selector *apiservice.ApprovalSelector) bool {
+	return len(selector.GetDeploymentIds()) == 0 &&
+		len(selector.GetApplicationIds()) == 0 &&
+		selector.GetApplicationName() == "" &&
+		len(selector.GetKinds()) == 0 &&
+		len(selector.GetLabels()) == 0
+}
+
+func stageApprovers(s *model.PipelineStage) []string {
+	as := s.Metadata["Approvers"]
+	if as == "" {
+		return nil
+	}
+	return strings.Split(as, ",")
+}
+
+func (a *API) ListStageLogs(ctx context.Context, req *apiservice.ListStageLogsRequest) (*apiservice.ListStageLogsResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.ProjectId != deployment.ProjectId {
+		return nil, status.Error(codes.InvalidArgument, "Requested deployment does not belong to your project")
+	}
+
+	stageLogs := map[string]*apiservice.StageLog{}
+
+	for _, stage := range deployment.Stages {
+		blocks, completed, err := a.stageLogStore.FetchLogs(ctx, deployment.Id, stage.Id, stage.RetriedCount, 0)
+		if err != nil && !errors.Is(err, stagelogstore.ErrNotFound) {
+			return nil, err
+		}
+
+		// StageRollback is generated automatically and returns nothing if not found.
+		if err != nil && stage.Name == model.StageRollback.String() {
+			continue
+		}
+
+		if err != nil {
+			stageLogs[stage.Id] = &apiservice.StageLog{}
+			continue
+		}
+
+		stageLogs[stage.Id] = &apiservice.StageLog{
+			Blocks:    blocks,
+			Completed: completed,
+		}
+	}
+
+	return &apiservice.ListStageLogsResponse{
+		StageLogs: stageLogs,
+	}, nil
+}
+
+// StreamStageLogs streams the stage logs of the given deployment in near real time.
+// The stream is closed once the deployment is completed and all of its logs have been sent.
+func (a *API) StreamStageLogs(req *apiservice.StreamStageLogsRequest, stream apiservice.APIService_StreamStageLogsServer) error {
+	ctx := stream.Context()
+	if err := req.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
+	if err != nil {
+		return err
+	}
+
+	var (
+		// The index of the next log block to be sent for each stage.
+		offsets = make(map[string]int64)
+		// The retried count of each stage when its logs were sent last time.
+		retriedCounts = make(map[string]int32)
+		completed     = make(map[string]bool)
+		ticker        = time.NewTicker(a.stageLogsPollingInterval)
+	)
+	defer ticker.Stop()
+
+	for {
+		deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+		if err != nil {
+			return err
+		}
+		if key.ProjectId != deployment.ProjectId {
+			return status.Error(codes.InvalidArgument, "Requested deployment does not belong to your project")
+		}
+		// The deployment status must be checked before fetching the logs
+		// to ensure that all logs reported before its completion are sent.
+		deploymentCompleted := deployment.Status.IsCompleted()
+
+		found := false
+		for _, stage := range deployment.Stages {
+			if req.StageName != "" && stage.Name != req.StageName {
+				continue
+			}
+			found = true
+
+			// Start over when the stage has been retried since the last time.
+			if rc, ok := retriedCounts[stage.Id]; ok && rc != stage.RetriedCount {
+				offsets[stage.Id] = 0
+				completed[stage.Id] = false
+			}
+			retriedCounts[stage.Id] = stage.RetriedCount
+			if completed[stage.Id] {
+				continue
+			}
+
+			blocks, logCompleted, err := a.stageLogStore.FetchLogs(ctx, deployment.Id, stage.Id, stage.RetriedCount, offsets[stage.Id])
+			if errors.Is(err, stagelogstore.ErrNotFound) {
+				// The stage has not started yet.
+				continue
+			}
+			if err != nil {
+				a.logger.Error("failed to fetch stage logs", zap.Error(err))
+				return status.Error(codes.Internal, "Failed to fetch stage logs")
+			}
+			if len(blocks) == 0 && !logCompleted {
+				continue
+			}
+			if len(blocks) > 0 {
+				offsets[stage.Id] = blocks[len(blocks)-1].Index + 1
+			}
+			completed[stage.Id] = logCompleted
+
+			if err := stream.Send(&apiservice.StreamStageLogsResponse{
+				StageId:   stage.Id,
+				StageName: stage.Name,
+				Blocks:    blocks,
+				Completed: logCompleted,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if deploymentCompleted {
+			if req.StageName != "" && !found {
+				return status.Error(codes.NotFound, "Stage is not found")
+			}
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *API) GetCommand(ctx context.Context, req *apiservice.GetCommandRequest) (*apiservice.GetCommandResponse, error) {
+	_, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd, err := getCommand(ctx, a.commandStore, req.CommandId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiservice.GetCommandResponse{
+		Command: cmd,
+	}, nil
+}
+
+func (a *API) GetPiped(ctx context.Context, req *apiservice.GetPipedRequest) (*apiservice.GetPipedResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	piped, err := getPiped(ctx, a.pipedStore, req.PipedId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if piped.ProjectId != key.ProjectId {
+		return nil, status.Error(codes.InvalidArgument, "Requested piped does not belong to your project")
+	}
+
+	// Redact all sensitive data inside piped message before sending to the client.
+	piped.RedactSensitiveData()
 
 	return &apiservice.GetPipedResponse{
 		Piped: piped,
@@ -736,6 +1620,10 @@ func (a *API) RegisterPiped(ctx context.Context, req *apiservice.RegisterPipedRe
 		return nil, err
 	}
 
+	if err := a.quotaChecker.CheckPipeds(ctx, key.ProjectId); err != nil {
+		return nil, checkProjectQuota(err, a.logger)
+	}
+
 	pipedKey, pipedKeyHash, err := model.GeneratePipedKey()
 	if err != nil {
 		a.logger.Error("failed to generate piped key", zap.Error(err))
@@ -755,6 +1643,12 @@ func (a *API) RegisterPiped(ctx context.Context, req *apiservice.RegisterPipedRe
 	if err = a.pipedStore.Add(ctx, &piped); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("add piped %s", piped.Id))
 	}
+	a.webhookNotifier.Notify(projectwebhook.Event{
+		Type:      config.ProjectEventPipedRegistered,
+		ProjectID: piped.ProjectId,
+		Resource:  projectwebhook.Resource{ID: piped.Id, Name: piped.Name},
+		Actor:     key.Id,
+	})
 
 	return &apiservice.RegisterPipedResponse{
 		Id:  piped.Id,
@@ -784,9 +1678,192 @@ func (a *API) DisablePiped(ctx context.Context, req *apiservice.DisablePipedRequ
 	if err := a.updatePiped(ctx, req.PipedId, a.pipedStore.DisablePiped); err != nil {
 		return nil, err
 	}
+	// The API key has already been verified by updatePiped.
+	if key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger); err == nil {
+		a.webhookNotifier.Notify(projectwebhook.Event{
+			Type:      config.ProjectEventPipedDisabled,
+			ProjectID: key.ProjectId,
+			Resource:  projectwebhook.Resource{ID: req.PipedId},
+			Actor:     key.Id,
+		})
+	}
 	return &apiservice.DisablePipedResponse{}, nil
 }
 
+// UpgradePipeds updates the desired version of a percentage of the target pipeds.
+// Calling it repeatedly with an increasing percentage upgrades the pipeds gradually.
+func (a *API) UpgradePipeds(ctx context.Context, req *apiservice.UpgradePipedsRequest) (*apiservice.UpgradePipedsResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeds, err := a.listUpgradeTargetPipeds(ctx, key.ProjectId, req.PipedIds, req.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	picked := model.PickPipeds(pipeds, int(req.Percentage))
+	updated := make([]string, 0, len(picked))
+	for _, piped := range picked {
+		if piped.DesiredVersion == req.Version {
+			continue
+		}
+		updated = append(updated, piped.Id)
+	}
+	if req.DryRun {
+		return &apiservice.UpgradePipedsResponse{
+			PipedIds: updated,
+		}, nil
+	}
+
+	for _, id := range updated {
+		if err := a.pipedStore.UpdateDesiredVersion(ctx, id, req.Version); err != nil {
+			return nil, gRPCStoreError(err, fmt.Sprintf("update desired version of piped %s", id))
+		}
+	}
+
+	return &apiservice.UpgradePipedsResponse{
+		PipedIds: updated,
+	}, nil
+}
+
+// ListPipedUpgradeStatuses returns the upgrade status of the target pipeds
+// including the results of the deployments they handled with the given version.
+func (a *API) ListPipedUpgradeStatuses(ctx context.Context, req *apiservice.ListPipedUpgradeStatusesRequest) (*apiservice.ListPipedUpgradeStatusesResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeds, err := a.listUpgradeTargetPipeds(ctx, key.ProjectId, req.PipedIds, req.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(pipeds, func(i, j int) bool {
+		return pipeds[i].Id < pipeds[j].Id
+	})
+
+	statuses := make([]*apiservice.PipedUpgradeStatus, 0, len(pipeds))
+	for _, piped := range pipeds {
+		connStatus, err := getPipedStatus(a.pipedStatCache, piped.Id)
+		if err != nil {
+			a.logger.Error("failed to get or unmarshal piped stat", zap.Error(err))
+			connStatus = model.Piped_UNKNOWN
+		}
+
+		s := &apiservice.PipedUpgradeStatus{
+			PipedId:        piped.Id,
+			PipedName:      piped.Name,
+			Version:        piped.Version,
+			DesiredVersion: piped.DesiredVersion,
+			Status:         connStatus,
+			StartedAt:      piped.StartedAt,
+		}
+		if piped.Version == req.Version {
+			s.CompletedDeployments, s.FailedDeployments, err = a.countCompletedDeployments(ctx, piped.Id, piped.StartedAt)
+			if err != nil {
+				return nil, err
+			}
+		}
+		statuses = append(statuses, s)
+	}
+
+	return &apiservice.ListPipedUpgradeStatusesResponse{
+		Statuses: statuses,
+	}, nil
+}
+
+// listUpgradeTargetPipeds returns the enabled pipeds specified by the IDs,
+// or the ones having all labels of the selector when no ID was given.
+func (a *API) listUpgradeTargetPipeds(ctx context.Context, projectID string, ids []string, selector map[string]string) ([]*model.Piped, error) {
+	var pipeds []*model.Piped
+	if len(ids) > 0 {
+		pipeds = make([]*model.Piped, 0, len(ids))
+		for _, id := range ids {
+			piped, err := getPiped(ctx, a.pipedStore, id, a.logger)
+			if err != nil {
+				return nil, err
+			}
+			if piped.ProjectId != projectID {
+				return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("requested piped %s does not belong to your project", id))
+			}
+			pipeds = append(pipeds, piped)
+		}
+	} else {
+		opts := datastore.ListOptions{
+			Filters: []datastore.ListFilter{
+				{
+					Field:    "ProjectId",
+					Operator: datastore.OperatorEqual,
+					Value:    projectID,
+				},
+			},
+		}
+		var err error
+		pipeds, err = a.pipedStore.List(ctx, opts)
+		if err != nil {
+			return nil, gRPCStoreError(err, "list pipeds")
+		}
+	}
+
+	filtered := make([]*model.Piped, 0, len(pipeds))
+	for _, piped := range pipeds {
+		if piped.Disabled || !piped.ContainLabels(selector) {
+			continue
+		}
+		filtered = append(filtered, piped)
+	}
+	return filtered, nil
+}
+
+// countCompletedDeployments returns the numbers of the deployments completed
+// and failed by the given piped since the given time.
+// Only the latest deployments are counted to keep the cost of this call bounded.
+func (a *API) countCompletedDeployments(ctx context.Context, pipedID string, since int64) (completed, failed int32, err error) {
+	opts := datastore.ListOptions{
+		Limit: 100,
+		Filters: []datastore.ListFilter{
+			{
+				Field:    "PipedId",
+				Operator: datastore.OperatorEqual,
+				Value:    pipedID,
+			},
+			{
+				Field:    "UpdatedAt",
+				Operator: datastore.OperatorGreaterThanOrEqual,
+				Value:    since,
+			},
+		},
+		Orders: []datastore.Order{
+			{
+				Field:     "UpdatedAt",
+				Direction: datastore.Desc,
+			},
+			{
+				Field:     "Id",
+				Direction: datastore.Asc,
+			},
+		},
+	}
+	deployments, _, err := a.deploymentStore.List(ctx, opts)
+	if err != nil {
+		return 0, 0, gRPCStoreError(err, fmt.Sprintf("list deployments of piped %s", pipedID))
+	}
+
+	for _, d := range deployments {
+		if !d.Status.IsCompleted() || d.CompletedAt < since {
+			continue
+		}
+		completed++
+		if d.Status == model.DeploymentStatus_DEPLOYMENT_FAILURE {
+			failed++
+		}
+	}
+	return completed, failed, nil
+}
+
 func (a *API) updatePiped(ctx context.Context, pipedID string, updater func(context.Context, string) error) error {
 	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
 	if err != nil {
@@ -816,16 +1893,19 @@ func (a *API) RegisterEvent(ctx context.Context, req *apiservice.RegisterEventRe
 	id := uuid.New().String()
 
 	event := model.Event{
-		Id:                id,
-		Name:              req.Name,
-		Data:              req.Data,
-		Labels:            req.Labels,
-		Contexts:          req.Contexts,
-		TriggerCommitHash: req.CommitHash,
-		EventKey:          model.MakeEventKey(req.Name, req.Labels),
-		ProjectId:         key.ProjectId,
-		Status:            model.EventStatus_EVENT_NOT_HANDLED,
-		StatusDescription: fmt.Sprintf("It is going to be replaced by %s", req.Data),
+		Id:                  id,
+		Name:                req.Name,
+		Data:                req.Data,
+		Labels:              req.Labels,
+		Contexts:            req.Contexts,
+		TriggerCommitHash:   req.CommitHash,
+		TriggerCommitAuthor: req.CommitAuthor,
+		TriggerCommitUrl:    req.CommitUrl,
+		TriggerRunUrl:       req.RunUrl,
+		EventKey:            model.MakeEventKey(req.Name, req.Labels),
+		ProjectId:           key.ProjectId,
+		Status:              model.EventStatus_EVENT_NOT_HANDLED,
+		StatusDescription:   fmt.Sprintf("It is going to be replaced by %s", req.Data),
 	}
 	if err = a.eventStore.Add(ctx, event); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("add event %s", id))
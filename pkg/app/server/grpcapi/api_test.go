@@ -16,11 +16,23 @@ package grpcapi
 
 import (
 	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/app/server/stagelogstore"
+	"github.com/pipe-cd/pipecd/pkg/cache/memorycache"
+	"github.com/pipe-cd/pipecd/pkg/datastore/datastoretest"
+	"github.com/pipe-cd/pipecd/pkg/jwt"
 	"github.com/pipe-cd/pipecd/pkg/model"
 	"github.com/pipe-cd/pipecd/pkg/rpc/rpcauth"
 )
@@ -102,3 +114,581 @@ func TestRequireAPIKey(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateApplicationLabels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apps := []*model.Application{
+		{Id: "app-1", ProjectId: "project", Labels: map[string]string{"team": "a", "env": "dev"}},
+		{Id: "app-2", ProjectId: "project", Labels: map[string]string{"team": "a", "env": "prod"}},
+		{Id: "app-3", ProjectId: "project", Labels: map[string]string{"team": "b"}},
+		{Id: "app-4", ProjectId: "project", Labels: map[string]string{"team": "a"}, Deleted: true},
+	}
+	appStore := datastoretest.NewMockApplicationStore(ctrl)
+	deploymentStore := datastoretest.NewMockDeploymentStore(ctrl)
+	api := &API{
+		applicationStore: appStore,
+		deploymentStore:  deploymentStore,
+		logger:           zap.NewNop(),
+	}
+	ctx := rpcauth.ContextWithAPIKey(context.Background(), &model.APIKey{
+		ProjectId: "project",
+		Role:      model.APIKey_READ_WRITE,
+	})
+
+	// Invalid requests.
+	_, err := api.UpdateApplicationLabels(ctx, &apiservice.UpdateApplicationLabelsRequest{
+		AddLabels: map[string]string{"env": "prod"},
+	})
+	assert.Error(t, err)
+	_, err = api.UpdateApplicationLabels(ctx, &apiservice.UpdateApplicationLabelsRequest{
+		Selector:  map[string]string{"team": "a"},
+		AddLabels: map[string]string{"-env": "prod"},
+	})
+	assert.Error(t, err)
+
+	// Dry run does not update anything.
+	appStore.EXPECT().List(gomock.Any(), gomock.Any()).Return(apps, "", nil)
+	resp, err := api.UpdateApplicationLabels(ctx, &apiservice.UpdateApplicationLabelsRequest{
+		Selector:  map[string]string{"team": "a"},
+		AddLabels: map[string]string{"env": "prod"},
+		DryRun:    true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app-1"}, resp.ApplicationIds)
+
+	// Update and propagate to the deployments.
+	add := map[string]string{"owner": "x"}
+	remove := []string{"env"}
+	appStore.EXPECT().List(gomock.Any(), gomock.Any()).Return(apps, "", nil)
+	appStore.EXPECT().UpdateLabels(gomock.Any(), "app-1", add, remove).Return(nil)
+	appStore.EXPECT().UpdateLabels(gomock.Any(), "app-2", add, remove).Return(nil)
+	deploymentStore.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*model.Deployment{
+		{Id: "deployment-1", Labels: map[string]string{"team": "a", "env": "dev"}},
+		{Id: "deployment-2", Labels: map[string]string{"team": "a", "owner": "x"}},
+	}, "", nil)
+	deploymentStore.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*model.Deployment{}, "", nil)
+	deploymentStore.EXPECT().UpdateLabels(gomock.Any(), "deployment-1", add, remove).Return(nil)
+	resp, err = api.UpdateApplicationLabels(ctx, &apiservice.UpdateApplicationLabelsRequest{
+		Selector:               map[string]string{"team": "a"},
+		AddLabels:              add,
+		RemoveLabels:           remove,
+		PropagateToDeployments: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app-1", "app-2"}, resp.ApplicationIds)
+	assert.Equal(t, int32(1), resp.UpdatedDeploymentCount)
+}
+
+func TestUpgradePipeds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pipeds := []*model.Piped{
+		{Id: "piped-3", ProjectId: "project", Labels: map[string]string{"env": "dev"}},
+		{Id: "piped-1", ProjectId: "project", Labels: map[string]string{"env": "dev"}, DesiredVersion: "v0.2.0"},
+		{Id: "piped-2", ProjectId: "project", Labels: map[string]string{"env": "dev"}},
+		{Id: "piped-4", ProjectId: "project", Labels: map[string]string{"env": "prod"}},
+		{Id: "piped-5", ProjectId: "project", Labels: map[string]string{"env": "dev"}, Disabled: true},
+	}
+	pipedStore := datastoretest.NewMockPipedStore(ctrl)
+	api := &API{
+		pipedStore: pipedStore,
+		logger:     zap.NewNop(),
+	}
+	ctx := rpcauth.ContextWithAPIKey(context.Background(), &model.APIKey{
+		ProjectId: "project",
+		Role:      model.APIKey_READ_WRITE,
+	})
+
+	// Dry run does not update anything.
+	pipedStore.EXPECT().List(gomock.Any(), gomock.Any()).Return(pipeds, nil)
+	resp, err := api.UpgradePipeds(ctx, &apiservice.UpgradePipedsRequest{
+		Version:    "v0.2.0",
+		Selector:   map[string]string{"env": "dev"},
+		Percentage: 100,
+		DryRun:     true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"piped-2", "piped-3"}, resp.PipedIds)
+
+	// The already upgraded piped is kept while the next one is upgraded.
+	pipedStore.EXPECT().List(gomock.Any(), gomock.Any()).Return(pipeds, nil)
+	pipedStore.EXPECT().UpdateDesiredVersion(gomock.Any(), "piped-2", "v0.2.0").Return(nil)
+	resp, err = api.UpgradePipeds(ctx, &apiservice.UpgradePipedsRequest{
+		Version:    "v0.2.0",
+		Selector:   map[string]string{"env": "dev"},
+		Percentage: 50,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"piped-2"}, resp.PipedIds)
+
+	// The pipeds of other projects cannot be upgraded.
+	pipedStore.EXPECT().Get(gomock.Any(), "piped-x").Return(&model.Piped{Id: "piped-x", ProjectId: "other"}, nil)
+	_, err = api.UpgradePipeds(ctx, &apiservice.UpgradePipedsRequest{
+		Version:    "v0.2.0",
+		PipedIds:   []string{"piped-x"},
+		Percentage: 100,
+	})
+	assert.Error(t, err)
+
+	// The percentage must be set explicitly even to upgrade all of the pipeds.
+	assert.Error(t, (&apiservice.UpgradePipedsRequest{Version: "v0.2.0"}).Validate())
+	assert.NoError(t, (&apiservice.UpgradePipedsRequest{Version: "v0.2.0", Percentage: 100}).Validate())
+}
+
+func TestListPipedUpgradeStatuses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pipedStore := datastoretest.NewMockPipedStore(ctrl)
+	deploymentStore := datastoretest.NewMockDeploymentStore(ctrl)
+	api := &API{
+		pipedStore:      pipedStore,
+		deploymentStore: deploymentStore,
+		pipedStatCache:  memorycache.NewCache(),
+		logger:          zap.NewNop(),
+	}
+	ctx := rpcauth.ContextWithAPIKey(context.Background(), &model.APIKey{
+		ProjectId: "project",
+		Role:      model.APIKey_READ_ONLY,
+	})
+
+	pipedStore.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*model.Piped{
+		{Id: "piped-2", Name: "old", ProjectId: "project", Version: "v0.1.0", DesiredVersion: "v0.2.0", StartedAt: 100},
+		{Id: "piped-1", Name: "new", ProjectId: "project", Version: "v0.2.0", DesiredVersion: "v0.2.0", StartedAt: 100},
+	}, nil)
+	deploymentStore.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*model.Deployment{
+		{Id: "deployment-1", Status: model.DeploymentStatus_DEPLOYMENT_SUCCESS, CompletedAt: 120},
+		{Id: "deployment-2", Status: model.DeploymentStatus_DEPLOYMENT_FAILURE, CompletedAt: 110},
+		{Id: "deployment-3", Status: model.DeploymentStatus_DEPLOYMENT_RUNNING},
+		{Id: "deployment-4", Status: model.DeploymentStatus_DEPLOYMENT_FAILURE, CompletedAt: 90},
+	}, "", nil)
+
+	resp, err := api.ListPipedUpgradeStatuses(ctx, &apiservice.ListPipedUpgradeStatusesRequest{
+		Version: "v0.2.0",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Statuses, 2)
+
+	assert.Equal(t, "piped-1", resp.Statuses[0].PipedId)
+	assert.Equal(t, model.Piped_OFFLINE, resp.Statuses[0].Status)
+	assert.Equal(t, int32(2), resp.Statuses[0].CompletedDeployments)
+	assert.Equal(t, int32(1), resp.Statuses[0].FailedDeployments)
+
+	assert.Equal(t, "piped-2", resp.Statuses[1].PipedId)
+	assert.Equal(t, int32(0), resp.Statuses[1].CompletedDeployments)
+}
+
+type fakeStageLogStore struct {
+	stagelogstore.Store
+
+	mu sync.Mutex
+	// The logs of each stage, keyed by stage ID.
+	logs      map[string][]*model.LogBlock
+	completed map[string]bool
+}
+
+func (s *fakeStageLogStore) FetchLogs(_ context.Context, _, stageID string, _ int32, offsetIndex int64) ([]*model.LogBlock, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	logs, ok := s.logs[stageID]
+	if !ok {
+		return nil, false, stagelogstore.ErrNotFound
+	}
+	blocks := make([]*model.LogBlock, 0)
+	for _, b := range logs {
+		if b.Index >= offsetIndex {
+			blocks = append(blocks, b)
+		}
+	}
+	return blocks, s.completed[stageID], nil
+}
+
+func (s *fakeStageLogStore) append(stageID string, completed bool, blocks ...*model.LogBlock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.logs[stageID] = append(s.logs[stageID], blocks...)
+	s.completed[stageID] = completed
+}
+
+type fakeStreamStageLogsServer struct {
+	grpc.ServerStream
+
+	ctx       context.Context
+	responses []*apiservice.StreamStageLogsResponse
+	onSend    func()
+}
+
+func (s *fakeStreamStageLogsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeStreamStageLogsServer) Send(resp *apiservice.StreamStageLogsResponse) error {
+	s.responses = append(s.responses, resp)
+	if s.onSend != nil {
+		s.onSend()
+	}
+	return nil
+}
+
+func TestStreamStageLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stages := []*model.PipelineStage{
+		{Id: "stage-1", Name: "K8S_SYNC"},
+		{Id: "stage-2", Name: "WAIT"},
+		{Id: "stage-rollback", Name: "ROLLBACK"},
+	}
+	running := &model.Deployment{Id: "deployment", ProjectId: "project", Status: model.DeploymentStatus_DEPLOYMENT_RUNNING, Stages: stages}
+	succeeded := &model.Deployment{Id: "deployment", ProjectId: "project", Status: model.DeploymentStatus_DEPLOYMENT_SUCCESS, Stages: stages}
+
+	ctx := rpcauth.ContextWithAPIKey(context.Background(), &model.APIKey{
+		ProjectId: "project",
+		Role:      model.APIKey_READ_ONLY,
+	})
+
+	t.Run("stream logs until the deployment is completed", func(t *testing.T) {
+		logStore := &fakeStageLogStore{
+			logs: map[string][]*model.LogBlock{
+				"stage-1": {{Index: 0, Log: "\x1b[32mstart\x1b[0m"}},
+			},
+			completed: map[string]bool{},
+		}
+		deploymentStore := datastoretest.NewMockDeploymentStore(ctrl)
+		gomock.InOrder(
+			deploymentStore.EXPECT().Get(gomock.Any(), "deployment").Return(running, nil),
+			deploymentStore.EXPECT().Get(gomock.Any(), "deployment").Return(running, nil),
+			deploymentStore.EXPECT().Get(gomock.Any(), "deployment").Return(succeeded, nil),
+		)
+		api := &API{
+			deploymentStore:          deploymentStore,
+			stageLogStore:            logStore,
+			stageLogsPollingInterval: time.Millisecond,
+			logger:                   zap.NewNop(),
+		}
+
+		sent := 0
+		stream := &fakeStreamStageLogsServer{ctx: ctx}
+		stream.onSend = func() {
+			sent++
+			if sent == 1 {
+				logStore.append("stage-1", true, &model.LogBlock{Index: 1, Log: "done"})
+				logStore.append("stage-2", true, &model.LogBlock{Index: 0, Log: "waited"})
+			}
+		}
+		err := api.StreamStageLogs(&apiservice.StreamStageLogsRequest{DeploymentId: "deployment"}, stream)
+		require.NoError(t, err)
+
+		require.Len(t, stream.responses, 3)
+		assert.Equal(t, "stage-1", stream.responses[0].StageId)
+		assert.Equal(t, "\x1b[32mstart\x1b[0m", stream.responses[0].Blocks[0].Log)
+		assert.False(t, stream.responses[0].Completed)
+		assert.Equal(t, "stage-2", stream.responses[1].StageId)
+		assert.Equal(t, "WAIT", stream.responses[1].StageName)
+		assert.True(t, stream.responses[1].Completed)
+		assert.Equal(t, "stage-1", stream.responses[2].StageId)
+		assert.Equal(t, []*model.LogBlock{{Index: 1, Log: "done"}}, stream.responses[2].Blocks)
+		assert.True(t, stream.responses[2].Completed)
+	})
+
+	t.Run("stream logs of the specified stage", func(t *testing.T) {
+		logStore := &fakeStageLogStore{
+			logs: map[string][]*model.LogBlock{
+				"stage-1": {{Index: 0, Log: "synced"}},
+				"stage-2": {{Index: 0, Log: "waited"}},
+			},
+			completed: map[string]bool{"stage-1": true, "stage-2": true},
+		}
+		deploymentStore := datastoretest.NewMockDeploymentStore(ctrl)
+		deploymentStore.EXPECT().Get(gomock.Any(), "deployment").Return(succeeded, nil)
+		api := &API{
+			deploymentStore:          deploymentStore,
+			stageLogStore:            logStore,
+			stageLogsPollingInterval: time.Millisecond,
+			logger:                   zap.NewNop(),
+		}
+
+		stream := &fakeStreamStageLogsServer{ctx: ctx}
+		err := api.StreamStageLogs(&apiservice.StreamStageLogsRequest{DeploymentId: "deployment", StageName: "WAIT"}, stream)
+		require.NoError(t, err)
+		require.Len(t, stream.responses, 1)
+		assert.Equal(t, "stage-2", stream.responses[0].StageId)
+	})
+
+	t.Run("unknown stage", func(t *testing.T) {
+		deploymentStore := datastoretest.NewMockDeploymentStore(ctrl)
+		deploymentStore.EXPECT().Get(gomock.Any(), "deployment").Return(succeeded, nil)
+		api := &API{
+			deploymentStore:          deploymentStore,
+			stageLogStore:            &fakeStageLogStore{},
+			stageLogsPollingInterval: time.Millisecond,
+			logger:                   zap.NewNop(),
+		}
+
+		err := api.StreamStageLogs(&apiservice.StreamStageLogsRequest{DeploymentId: "deployment", StageName: "UNKNOWN"}, &fakeStreamStageLogsServer{ctx: ctx})
+		assert.Error(t, err)
+	})
+
+	t.Run("deployment in another project", func(t *testing.T) {
+		deploymentStore := datastoretest.NewMockDeploymentStore(ctrl)
+		deploymentStore.EXPECT().Get(gomock.Any(), "deployment").Return(&model.Deployment{Id: "deployment", ProjectId: "other"}, nil)
+		api := &API{
+			deploymentStore:          deploymentStore,
+			stageLogsPollingInterval: time.Millisecond,
+			logger:                   zap.NewNop(),
+		}
+
+		err := api.StreamStageLogs(&apiservice.StreamStageLogsRequest{DeploymentId: "deployment"}, &fakeStreamStageLogsServer{ctx: ctx})
+		assert.Error(t, err)
+	})
+}
+
+func TestListPendingApprovals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	waitApproval := func(id string, status model.StageStatus, approvers string) *model.PipelineStage {
+		return &model.PipelineStage{
+			Id:       id,
+			Name:     model.StageWaitApproval.String(),
+			Status:   status,
+			Metadata: map[string]string{"Approvers": approvers},
+		}
+	}
+	deploymentStore := datastoretest.NewMockDeploymentStore(ctrl)
+	deploymentStore.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*model.Deployment{
+		{
+			Id:              "deployment-1",
+			ApplicationId:   "app-1",
+			ApplicationName: "app-1",
+			Kind:            model.ApplicationKind_KUBERNETES,
+			Labels:          map[string]string{"team": "a"},
+			Stages: []*model.PipelineStage{
+				{Id: "sync", Name: model.StageK8sSync.String(), Status: model.StageStatus_STAGE_SUCCESS},
+				waitApproval("approval", model.StageStatus_STAGE_RUNNING, "alice,bob"),
+			},
+		},
+		{
+			Id:              "deployment-2",
+			ApplicationId:   "app-2",
+			ApplicationName: "app-2",
+			Kind:            model.ApplicationKind_ECS,
+			Labels:          map[string]string{"team": "a"},
+			Stages: []*model.PipelineStage{
+				waitApproval("approval", model.StageStatus_STAGE_RUNNING, ""),
+			},
+		},
+		{
+			Id:              "deployment-3",
+			ApplicationId:   "app-3",
+			ApplicationName: "app-3",
+			Kind:            model.ApplicationKind_KUBERNETES,
+			Labels:          map[string]string{"team": "a"},
+			Stages: []*model.PipelineStage{
+				waitApproval("approval", model.StageStatus_STAGE_NOT_STARTED_YET, ""),
+			},
+		},
+		{
+			Id:              "deployment-4",
+			ApplicationId:   "app-4",
+			ApplicationName: "app-4",
+			Kind:            model.ApplicationKind_KUBERNETES,
+			Labels:          map[string]string{"team": "b"},
+			Stages: []*model.PipelineStage{
+				waitApproval("approval", model.StageStatus_STAGE_RUNNING, ""),
+			},
+		},
+	}, "", nil)
+
+	api := &API{
+		deploymentStore: deploymentStore,
+		logger:          zap.NewNop(),
+	}
+	ctx := rpcauth.ContextWithAPIKey(context.Background(), &model.APIKey{
+		ProjectId: "project",
+		Role:      model.APIKey_READ_ONLY,
+	})
+
+	resp, err := api.ListPendingApprovals(ctx, &apiservice.ListPendingApprovalsRequest{
+		Selector: &apiservice.ApprovalSelector{
+			Kinds:  []string{model.ApplicationKind_KUBERNETES.String()},
+			Labels: map[string]string{"team": "a"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Approvals, 1)
+	assert.Equal(t, "deployment-1", resp.Approvals[0].DeploymentId)
+	assert.Equal(t, "approval", resp.Approvals[0].StageId)
+	assert.Equal(t, []string{"alice", "bob"}, resp.Approvals[0].Approvers)
+
+	_, err = api.ListPendingApprovals(ctx, &apiservice.ListPendingApprovalsRequest{
+		Selector: &apiservice.ApprovalSelector{
+			Kinds: []string{"UNKNOWN"},
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestSelectPendingApprovals(t *testing.T) {
+	deployments := []*model.Deployment{
+		{
+			Id:              "deployment-1",
+			ApplicationId:   "app-1",
+			ApplicationName: "app-1",
+			Stages: []*model.PipelineStage{
+				{Id: "approval-1", Name: model.StageWaitApproval.String(), Status: model.StageStatus_STAGE_RUNNING},
+				{Id: "approval-2", Name: model.StageWaitApproval.String(), Status: model.StageStatus_STAGE_SUCCESS},
+			},
+		},
+		{
+			Id:              "deployment-2",
+			ApplicationId:   "app-2",
+			ApplicationName: "app-2",
+			Stages: []*model.PipelineStage{
+				{Id: "approval-1", Name: model.StageWaitApproval.String(), Status: model.StageStatus_STAGE_RUNNING},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		selector *apiservice.ApprovalSelector
+		expected []string
+	}{
+		{
+			name:     "nil selector matches all",
+			expected: []string{"deployment-1", "deployment-2"},
+		},
+		{
+			name:     "by deployment id",
+			selector: &apiservice.ApprovalSelector{DeploymentIds: []string{"deployment-2"}},
+			expected: []string{"deployment-2"},
+		},
+		{
+			name:     "by application id",
+			selector: &apiservice.ApprovalSelector{ApplicationIds: []string{"app-1", "app-3"}},
+			expected: []string{"deployment-1"},
+		},
+		{
+			name:     "by application name",
+			selector: &apiservice.ApprovalSelector{ApplicationName: "app-3"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			pendings := selectPendingApprovals(deployments, tc.selector, nil)
+			var got []string
+			for _, p := range pendings {
+				assert.Equal(t, "approval-1", p.stage.Id)
+				got = append(got, p.deployment.Id)
+			}
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestApproveStagesRequiresSelector(t *testing.T) {
+	api := &API{
+		logger: zap.NewNop(),
+	}
+	ctx := rpcauth.ContextWithAPIKey(context.Background(), &model.APIKey{
+		ProjectId: "project",
+		Role:      model.APIKey_READ_WRITE,
+	})
+
+	_, err := api.ApproveStages(ctx, &apiservice.ApproveStagesRequest{
+		Selector:      &apiservice.ApprovalSelector{},
+		ApproverToken: "token",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+type fakeApproverTokenStore map[string]interface{}
+
+func (s fakeApproverTokenStore) PutIfNotExists(key string, value interface{}) (bool, error) {
+	if _, ok := s[key]; ok {
+		return false, nil
+	}
+	s[key] = value
+	return true, nil
+}
+
+func TestConsumeApproverToken(t *testing.T) {
+	api := &API{
+		approverTokenStore: fakeApproverTokenStore{},
+		logger:             zap.NewNop(),
+	}
+
+	bound := &jwt.ExternalIdentity{Issuer: "chatops", Name: "chatops:alice", DeploymentID: "deployment-1", StageID: "stage-1"}
+	require.NoError(t, api.consumeApproverToken(bound))
+	require.NoError(t, api.consumeApproverToken(bound))
+
+	once := &jwt.ExternalIdentity{Issuer: "chatops", Name: "chatops:alice", ID: "token-1"}
+	require.NoError(t, api.consumeApproverToken(once))
+	assert.Equal(t, codes.Unauthenticated, status.Code(api.consumeApproverToken(once)))
+
+	// The same ID issued by another issuer is another token.
+	other := &jwt.ExternalIdentity{Issuer: "itsm", Name: "itsm:alice", ID: "token-1"}
+	require.NoError(t, api.consumeApproverToken(other))
+}
+
+func TestSyncApplicationPromotion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appStore := datastoretest.NewMockApplicationStore(ctrl)
+	deploymentStore := datastoretest.NewMockDeploymentStore(ctrl)
+	api := &API{
+		applicationStore: appStore,
+		deploymentStore:  deploymentStore,
+		logger:           zap.NewNop(),
+	}
+	ctx := rpcauth.ContextWithAPIKey(context.Background(), &model.APIKey{
+		ProjectId: "project",
+		Role:      model.APIKey_READ_WRITE,
+	})
+	app := &model.Application{Id: "app-prod", ProjectId: "project"}
+
+	testcases := []struct {
+		name       string
+		deployment *model.Deployment
+		wantCode   codes.Code
+	}{
+		{
+			name:       "deployment of another project",
+			deployment: &model.Deployment{Id: "deployment-1", ApplicationId: "app-staging", ProjectId: "other", Status: model.DeploymentStatus_DEPLOYMENT_SUCCESS, Artifact: &model.DeploymentArtifact{}},
+			wantCode:   codes.InvalidArgument,
+		},
+		{
+			name:       "deployment of the same application",
+			deployment: &model.Deployment{Id: "deployment-1", ApplicationId: "app-prod", ProjectId: "project", Status: model.DeploymentStatus_DEPLOYMENT_SUCCESS, Artifact: &model.DeploymentArtifact{}},
+			wantCode:   codes.InvalidArgument,
+		},
+		{
+			name:       "failed deployment",
+			deployment: &model.Deployment{Id: "deployment-1", ApplicationId: "app-staging", ProjectId: "project", Status: model.DeploymentStatus_DEPLOYMENT_FAILURE, Artifact: &model.DeploymentArtifact{}},
+			wantCode:   codes.FailedPrecondition,
+		},
+		{
+			name:       "deployment without artifact",
+			deployment: &model.Deployment{Id: "deployment-1", ApplicationId: "app-staging", ProjectId: "project", Status: model.DeploymentStatus_DEPLOYMENT_SUCCESS},
+			wantCode:   codes.FailedPrecondition,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			appStore.EXPECT().Get(gomock.Any(), "app-prod").Return(app, nil)
+			deploymentStore.EXPECT().Get(gomock.Any(), "deployment-1").Return(tc.deployment, nil)
+			_, err := api.SyncApplication(ctx, &apiservice.SyncApplicationRequest{
+				ApplicationId:           "app-prod",
+				PromoteFromDeploymentId: "deployment-1",
+			})
+			assert.Equal(t, tc.wantCode, status.Code(err))
+		})
+	}
+}
@@ -20,11 +20,16 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/pipe-cd/pipecd/pkg/app/server/commandstore"
+	"github.com/pipe-cd/pipecd/pkg/app/server/deploymentdiffstore"
+	"github.com/pipe-cd/pipecd/pkg/app/server/projectquota"
+	"github.com/pipe-cd/pipecd/pkg/app/server/projectwebhook"
+	"github.com/pipe-cd/pipecd/pkg/app/server/stageartifactstore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/stagelogstore"
 	"github.com/pipe-cd/pipecd/pkg/cache"
 	"github.com/pipe-cd/pipecd/pkg/crypto"
@@ -106,6 +111,80 @@ func addCommand(ctx context.Context, store commandstore.Store, cmd *model.Comman
 	return nil
 }
 
+// addDeleteApplicationCommand notifies the piped of the deleted application
+// to clean up the resources whose lifecycle is bound to that application.
+// Currently, only the namespaces managed by Kubernetes applications are such resources.
+func addDeleteApplicationCommand(ctx context.Context, store commandstore.Store, app *model.Application, commander string, logger *zap.Logger) error {
+	if app.Kind != model.ApplicationKind_KUBERNETES {
+		return nil
+	}
+	cmd := model.Command{
+		Id:            uuid.New().String(),
+		PipedId:       app.PipedId,
+		ApplicationId: app.Id,
+		ProjectId:     app.ProjectId,
+		Type:          model.Command_DELETE_APPLICATION,
+		Commander:     commander,
+		DeleteApplication: &model.Command_DeleteApplication{
+			ApplicationId:    app.Id,
+			PlatformProvider: app.PlatformProvider,
+		},
+	}
+	return addCommand(ctx, store, &cmd, logger)
+}
+
+// validateRetryableDeployment checks whether the given deployment can be retried.
+// Only the failed deployment that is still the most recently triggered one of its application
+// can be retried, otherwise retrying would bring back an older commit.
+func validateRetryableDeployment(d *model.Deployment, app *model.Application) error {
+	if d.Status != model.DeploymentStatus_DEPLOYMENT_FAILURE {
+		return status.Error(codes.FailedPrecondition, "Only the failed deployment can be retried")
+	}
+	if d.DeploymentChainId != "" {
+		return status.Error(codes.FailedPrecondition, "Retrying a deployment in a deployment chain is not supported")
+	}
+	if ref := app.MostRecentlyTriggeredDeployment; ref != nil && ref.DeploymentId != d.Id {
+		return status.Error(codes.FailedPrecondition, "Could not retry the deployment because a newer deployment has been triggered for the application")
+	}
+	return nil
+}
+
+// validateReportableStage checks whether the result of the given stage can be reported by external systems.
+// Only the EXTERNAL_WAIT stage of a running deployment that has not been completed yet can receive the result.
+func validateReportableStage(d *model.Deployment, stageID string) error {
+	if d.Status.IsCompleted() {
+		return status.Error(codes.FailedPrecondition, "Could not report the stage result because the deployment was already completed")
+	}
+	stage, ok := d.Stage(stageID)
+	if !ok {
+		return status.Error(codes.NotFound, "The stage was not found in the deployment")
+	}
+	if stage.Name != model.StageExternalWait.String() {
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("Reporting the result is not supported for stage %q", stage.Name))
+	}
+	if stage.Status.IsCompleted() {
+		return status.Error(codes.FailedPrecondition, "Could not report the stage result because the stage was already completed")
+	}
+	return nil
+}
+
+func validateApprovableStage(d *model.Deployment, stageID string) error {
+	if d.Status.IsCompleted() {
+		return status.Error(codes.FailedPrecondition, "Could not approve the stage because the deployment was already completed")
+	}
+	stage, ok := d.Stage(stageID)
+	if !ok {
+		return status.Error(codes.NotFound, "The stage was not found in the deployment")
+	}
+	if stage.Name != model.StageWaitApproval.String() {
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("Approving is not supported for stage %q", stage.Name))
+	}
+	if stage.Status.IsCompleted() {
+		return status.Error(codes.FailedPrecondition, "Could not approve the stage because it was already completed")
+	}
+	return nil
+}
+
 // makeGitPath returns an ApplicationGitPath by adding Repository info and GitPath URL to given args.
 func makeGitPath(repoID, path, cfgFilename string, piped *model.Piped, logger *zap.Logger) (*model.ApplicationGitPath, error) {
 	var repo *model.ApplicationGitRepository
@@ -169,10 +248,10 @@ func gRPCStoreError(err error, msg string) error {
 	if err == nil {
 		return nil
 	}
-	if errors.Is(err, datastore.ErrNotFound) || errors.Is(err, filestore.ErrNotFound) || errors.Is(err, stagelogstore.ErrNotFound) {
+	if errors.Is(err, datastore.ErrNotFound) || errors.Is(err, filestore.ErrNotFound) || errors.Is(err, stagelogstore.ErrNotFound) || errors.Is(err, deploymentdiffstore.ErrNotFound) || errors.Is(err, stageartifactstore.ErrNotFound) {
 		return status.Error(codes.NotFound, fmt.Sprintf("Entity was not found to %s", msg))
 	}
-	if errors.Is(err, datastore.ErrInvalidArgument) {
+	if errors.Is(err, datastore.ErrInvalidArgument) || errors.Is(err, stageartifactstore.ErrInvalidName) || errors.Is(err, stageartifactstore.ErrInvalidStage) {
 		return status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid argument to %s", msg))
 	}
 	if errors.Is(err, datastore.ErrAlreadyExists) {
@@ -184,6 +263,36 @@ func gRPCStoreError(err error, msg string) error {
 	return status.Error(codes.Internal, fmt.Sprintf("Failed to %s", msg))
 }
 
+// checkProjectQuota converts the error returned by the project quota checker into a gRPC error.
+func checkProjectQuota(err error, logger *zap.Logger) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, projectquota.ErrExceeded) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	logger.Error("failed to check project quota", zap.Error(err))
+	return status.Error(codes.Internal, "Failed to check project quota")
+}
+
+// applicationEvent builds the project webhook event about the given application.
+func applicationEvent(event string, app *model.Application, actor string) projectwebhook.Event {
+	return projectwebhook.Event{
+		Type:      event,
+		ProjectID: app.ProjectId,
+		Resource: projectwebhook.Resource{
+			ID:   app.Id,
+			Name: app.Name,
+			Attributes: map[string]string{
+				"kind":             app.Kind.String(),
+				"pipedId":          app.PipedId,
+				"platformProvider": app.PlatformProvider,
+			},
+		},
+		Actor: actor,
+	}
+}
+
 func getPipedStatus(cs cache.Cache, id string) (model.Piped_ConnectionStatus, error) {
 	pipedStatus, err := cs.Get(id)
 	if errors.Is(err, cache.ErrNotFound) {
@@ -25,6 +25,7 @@ import (
 
 	"github.com/pipe-cd/pipecd/pkg/datastore"
 	"github.com/pipe-cd/pipecd/pkg/filestore"
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 func TestGRPCStoreError(t *testing.T) {
@@ -91,3 +92,207 @@ func TestGRPCStoreError(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRetryableDeployment(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		deployment *model.Deployment
+		app        *model.Application
+		expected   codes.Code
+	}{
+		{
+			name: "retryable",
+			deployment: &model.Deployment{
+				Id:     "deployment-1",
+				Status: model.DeploymentStatus_DEPLOYMENT_FAILURE,
+			},
+			app: &model.Application{
+				MostRecentlyTriggeredDeployment: &model.ApplicationDeploymentReference{DeploymentId: "deployment-1"},
+			},
+			expected: codes.OK,
+		},
+		{
+			name: "not failed",
+			deployment: &model.Deployment{
+				Id:     "deployment-1",
+				Status: model.DeploymentStatus_DEPLOYMENT_CANCELLED,
+			},
+			app:      &model.Application{},
+			expected: codes.FailedPrecondition,
+		},
+		{
+			name: "in deployment chain",
+			deployment: &model.Deployment{
+				Id:                "deployment-1",
+				Status:            model.DeploymentStatus_DEPLOYMENT_FAILURE,
+				DeploymentChainId: "chain-1",
+			},
+			app:      &model.Application{},
+			expected: codes.FailedPrecondition,
+		},
+		{
+			name: "newer deployment exists",
+			deployment: &model.Deployment{
+				Id:     "deployment-1",
+				Status: model.DeploymentStatus_DEPLOYMENT_FAILURE,
+			},
+			app: &model.Application{
+				MostRecentlyTriggeredDeployment: &model.ApplicationDeploymentReference{DeploymentId: "deployment-2"},
+			},
+			expected: codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateRetryableDeployment(tt.deployment, tt.app)
+			assert.Equal(t, tt.expected, status.Code(err))
+		})
+	}
+}
+
+func TestValidateReportableStage(t *testing.T) {
+	t.Parallel()
+	deployment := func(status model.DeploymentStatus) *model.Deployment {
+		return &model.Deployment{
+			Id:     "deployment-1",
+			Status: status,
+			Stages: []*model.PipelineStage{
+				{
+					Id:     "stage-1",
+					Name:   model.StageExternalWait.String(),
+					Status: model.StageStatus_STAGE_RUNNING,
+				},
+				{
+					Id:     "stage-2",
+					Name:   model.StageWaitApproval.String(),
+					Status: model.StageStatus_STAGE_RUNNING,
+				},
+				{
+					Id:     "stage-3",
+					Name:   model.StageExternalWait.String(),
+					Status: model.StageStatus_STAGE_SUCCESS,
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name       string
+		deployment *model.Deployment
+		stageID    string
+		expected   codes.Code
+	}{
+		{
+			name:       "reportable",
+			deployment: deployment(model.DeploymentStatus_DEPLOYMENT_RUNNING),
+			stageID:    "stage-1",
+			expected:   codes.OK,
+		},
+		{
+			name:       "deployment was completed",
+			deployment: deployment(model.DeploymentStatus_DEPLOYMENT_CANCELLED),
+			stageID:    "stage-1",
+			expected:   codes.FailedPrecondition,
+		},
+		{
+			name:       "stage not found",
+			deployment: deployment(model.DeploymentStatus_DEPLOYMENT_RUNNING),
+			stageID:    "stage-4",
+			expected:   codes.NotFound,
+		},
+		{
+			name:       "not an EXTERNAL_WAIT stage",
+			deployment: deployment(model.DeploymentStatus_DEPLOYMENT_RUNNING),
+			stageID:    "stage-2",
+			expected:   codes.FailedPrecondition,
+		},
+		{
+			name:       "stage was completed",
+			deployment: deployment(model.DeploymentStatus_DEPLOYMENT_RUNNING),
+			stageID:    "stage-3",
+			expected:   codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateReportableStage(tt.deployment, tt.stageID)
+			assert.Equal(t, tt.expected, status.Code(err))
+		})
+	}
+}
+
+func TestValidateApprovableStage(t *testing.T) {
+	t.Parallel()
+	deployment := func(status model.DeploymentStatus) *model.Deployment {
+		return &model.Deployment{
+			Id:     "deployment-1",
+			Status: status,
+			Stages: []*model.PipelineStage{
+				{
+					Id:     "stage-1",
+					Name:   model.StageWaitApproval.String(),
+					Status: model.StageStatus_STAGE_RUNNING,
+				},
+				{
+					Id:     "stage-2",
+					Name:   model.StageExternalWait.String(),
+					Status: model.StageStatus_STAGE_RUNNING,
+				},
+				{
+					Id:     "stage-3",
+					Name:   model.StageWaitApproval.String(),
+					Status: model.StageStatus_STAGE_SUCCESS,
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name       string
+		deployment *model.Deployment
+		stageID    string
+		expected   codes.Code
+	}{
+		{
+			name:       "approvable",
+			deployment: deployment(model.DeploymentStatus_DEPLOYMENT_RUNNING),
+			stageID:    "stage-1",
+			expected:   codes.OK,
+		},
+		{
+			name:       "deployment was completed",
+			deployment: deployment(model.DeploymentStatus_DEPLOYMENT_CANCELLED),
+			stageID:    "stage-1",
+			expected:   codes.FailedPrecondition,
+		},
+		{
+			name:       "stage not found",
+			deployment: deployment(model.DeploymentStatus_DEPLOYMENT_RUNNING),
+			stageID:    "stage-4",
+			expected:   codes.NotFound,
+		},
+		{
+			name:       "not a WAIT_APPROVAL stage",
+			deployment: deployment(model.DeploymentStatus_DEPLOYMENT_RUNNING),
+			stageID:    "stage-2",
+			expected:   codes.FailedPrecondition,
+		},
+		{
+			name:       "stage was completed",
+			deployment: deployment(model.DeploymentStatus_DEPLOYMENT_RUNNING),
+			stageID:    "stage-3",
+			expected:   codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateApprovableStage(tt.deployment, tt.stageID)
+			assert.Equal(t, tt.expected, status.Code(err))
+		})
+	}
+}
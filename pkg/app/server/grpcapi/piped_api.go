@@ -30,8 +30,12 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/server/analysisresultstore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/applicationlivestatestore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/commandstore"
+	"github.com/pipe-cd/pipecd/pkg/app/server/deploymentdiffstore"
+	"github.com/pipe-cd/pipecd/pkg/app/server/deploymentrisk"
 	"github.com/pipe-cd/pipecd/pkg/app/server/grpcapi/grpcapimetrics"
+	"github.com/pipe-cd/pipecd/pkg/app/server/projectquota"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/app/server/stageartifactstore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/stagelogstore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/unregisteredappstore"
 	"github.com/pipe-cd/pipecd/pkg/cache"
@@ -57,6 +61,7 @@ type pipedAPIDeploymentStore interface {
 	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Deployment, string, error)
 	UpdateToPlanned(ctx context.Context, id, summary, reason, runningCommitHash, runningConfigFilename, version string, versions []*model.ArtifactVersion, stages []*model.PipelineStage) error
 	UpdateToCompleted(ctx context.Context, id string, status model.DeploymentStatus, stageStatuses map[string]model.StageStatus, reason string, completedAt int64) error
+	UpdateArtifact(ctx context.Context, id string, artifact *model.DeploymentArtifact) error
 	UpdateStatus(ctx context.Context, id string, status model.DeploymentStatus, reason string) error
 	UpdateStageStatus(ctx context.Context, id, stageID string, status model.StageStatus, reason string, requires []string, visible bool, retriedCount int32, completedAt int64) error
 	UpdateMetadata(ctx context.Context, id string, metadata map[string]string) error
@@ -70,14 +75,20 @@ type pipedAPIDeploymentChainStore interface {
 
 type pipedAPIPipedStore interface {
 	Get(ctx context.Context, id string) (*model.Piped, error)
-	UpdateMetadata(ctx context.Context, id, version, config string, pps []*model.Piped_PlatformProvider, pls []*model.Piped_Plugin, repos []*model.ApplicationGitRepository, se *model.Piped_SecretEncryption, startedAt int64) error
+	UpdateMetadata(ctx context.Context, id, version, config string, pps []*model.Piped_PlatformProvider, pls []*model.Piped_Plugin, repos []*model.ApplicationGitRepository, se *model.Piped_SecretEncryption, labels map[string]string, startedAt int64) error
 }
 
 type pipedAPIEventStore interface {
+	Add(ctx context.Context, e model.Event) error
 	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Event, string, error)
 	UpdateStatus(ctx context.Context, eventID string, status model.EventStatus, statusDescription string) error
 }
 
+type deploymentTokenManager interface {
+	Issue(projectID, pipedID, pipedKeyID, deploymentID string) (string, time.Time, error)
+	Revoke(ctx context.Context, deploymentID string) error
+}
+
 type commandOutputPutter interface {
 	Put(ctx context.Context, commandID string, data []byte) error
 }
@@ -94,9 +105,14 @@ type PipedAPI struct {
 	stageLogStore             stagelogstore.Store
 	applicationLiveStateStore applicationlivestatestore.Store
 	analysisResultStore       analysisresultstore.Store
+	deploymentDiffStore       deploymentdiffstore.Store
+	stageArtifactStore        stageartifactstore.Store
 	commandStore              commandstore.Store
 	commandOutputPutter       commandOutputPutter
 	unregisteredAppStore      unregisteredappstore.Store
+	quotaChecker              *projectquota.Checker
+	riskScorer                *deploymentrisk.Scorer
+	deploymentTokenManager    deploymentTokenManager
 
 	appPipedCache        cache.Cache
 	deploymentPipedCache cache.Cache
@@ -107,7 +123,7 @@ type PipedAPI struct {
 }
 
 // NewPipedAPI creates a new PipedAPI instance.
-func NewPipedAPI(ctx context.Context, ds datastore.DataStore, sc cache.Cache, sls stagelogstore.Store, alss applicationlivestatestore.Store, las analysisresultstore.Store, hc cache.Cache, cop commandOutputPutter, uas unregisteredappstore.Store, webBaseURL string, logger *zap.Logger) *PipedAPI {
+func NewPipedAPI(ctx context.Context, ds datastore.DataStore, sc cache.Cache, sls stagelogstore.Store, alss applicationlivestatestore.Store, las analysisresultstore.Store, dds deploymentdiffstore.Store, sas stageartifactstore.Store, hc cache.Cache, cop commandOutputPutter, uas unregisteredappstore.Store, qc *projectquota.Checker, rs *deploymentrisk.Scorer, dtm deploymentTokenManager, webBaseURL string, logger *zap.Logger) *PipedAPI {
 	w := datastore.PipedCommander
 	a := &PipedAPI{
 		applicationStore:          datastore.NewApplicationStore(ds, w),
@@ -118,9 +134,14 @@ func NewPipedAPI(ctx context.Context, ds datastore.DataStore, sc cache.Cache, sl
 		stageLogStore:             sls,
 		applicationLiveStateStore: alss,
 		analysisResultStore:       las,
+		deploymentDiffStore:       dds,
+		stageArtifactStore:        sas,
 		commandStore:              commandstore.NewStore(w, ds, sc, logger),
 		commandOutputPutter:       cop,
 		unregisteredAppStore:      uas,
+		quotaChecker:              qc,
+		riskScorer:                rs,
+		deploymentTokenManager:    dtm,
 		appPipedCache:             memorycache.NewTTLCache(ctx, 24*time.Hour, 3*time.Hour),
 		deploymentPipedCache:      memorycache.NewTTLCache(ctx, 24*time.Hour, 3*time.Hour),
 		pipedStatCache:            hc,
@@ -190,6 +211,7 @@ func (a *PipedAPI) ReportPipedMeta(ctx context.Context, req *pipedservice.Report
 		req.Plugins,
 		req.Repositories,
 		req.SecretEncryption,
+		req.Labels,
 		now,
 	); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("update metadata of piped %s", pipedID))
@@ -333,8 +355,12 @@ func (a *PipedAPI) GetDeployment(ctx context.Context, req *pipedservice.GetDeplo
 		return nil, err
 	}
 
+	// The deployments done by the previous piped of a migrated application
+	// are still readable by the piped currently managing that application.
 	if deployment.PipedId != pipedID {
-		return nil, status.Error(codes.PermissionDenied, "requested deployment doesn't belong to the piped")
+		if err := a.validateAppBelongsToPiped(ctx, deployment.ApplicationId, pipedID); err != nil {
+			return nil, status.Error(codes.PermissionDenied, "requested deployment doesn't belong to the piped")
+		}
 	}
 
 	return &pipedservice.GetDeploymentResponse{
@@ -392,6 +418,10 @@ func (a *PipedAPI) CreateDeployment(ctx context.Context, req *pipedservice.Creat
 		return nil, err
 	}
 
+	if err := a.quotaChecker.CheckDeployments(ctx, projectID); err != nil {
+		return nil, checkProjectQuota(err, a.logger)
+	}
+
 	if err := a.deploymentStore.Add(ctx, req.Deployment); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("add deployment %s", req.Deployment.Id))
 	}
@@ -425,6 +455,11 @@ func (a *PipedAPI) ReportDeploymentPlanned(ctx context.Context, req *pipedservic
 	); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("update deployment %s as planned", req.DeploymentId))
 	}
+	if req.Artifact != nil {
+		if err = a.deploymentStore.UpdateArtifact(ctx, req.DeploymentId, req.Artifact); err != nil {
+			return nil, gRPCStoreError(err, fmt.Sprintf("update artifact of deployment %s", req.DeploymentId))
+		}
+	}
 	return &pipedservice.ReportDeploymentPlannedResponse{}, nil
 }
 
@@ -459,6 +494,14 @@ func (a *PipedAPI) ReportDeploymentCompleted(ctx context.Context, req *pipedserv
 	if err = a.deploymentStore.UpdateToCompleted(ctx, req.DeploymentId, req.Status, req.StageStatuses, req.StatusReason, req.CompletedAt); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("update deployment %s as completed", req.DeploymentId))
 	}
+
+	// No one should work on the deployment anymore, so its tokens are no longer needed.
+	if err := a.deploymentTokenManager.Revoke(ctx, req.DeploymentId); err != nil {
+		a.logger.Warn("failed to revoke tokens of the completed deployment",
+			zap.String("deployment-id", req.DeploymentId),
+			zap.Error(err),
+		)
+	}
 	return &pipedservice.ReportDeploymentCompletedResponse{}, nil
 }
 
@@ -518,7 +561,7 @@ func (a *PipedAPI) ReportStageLogs(ctx context.Context, req *pipedservice.Report
 
 // ReportStageLogsFromLastCheckpoint is used to save the full logs from the most recently saved point.
 func (a *PipedAPI) ReportStageLogsFromLastCheckpoint(ctx context.Context, req *pipedservice.ReportStageLogsFromLastCheckpointRequest) (*pipedservice.ReportStageLogsFromLastCheckpointResponse, error) {
-	_, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
+	projectID, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -526,6 +569,10 @@ func (a *PipedAPI) ReportStageLogsFromLastCheckpoint(ctx context.Context, req *p
 		return nil, err
 	}
 
+	if err := a.quotaChecker.CheckFilestoreBytes(ctx, projectID); err != nil {
+		return nil, checkProjectQuota(err, a.logger)
+	}
+
 	err = a.stageLogStore.AppendLogsFromLastCheckpoint(ctx, req.DeploymentId, req.StageId, req.RetriedCount, req.Blocks, req.Completed)
 	if errors.Is(err, stagelogstore.ErrAlreadyCompleted) {
 		return nil, status.Error(codes.FailedPrecondition, "could not append the logs because the stage was already completed")
@@ -534,6 +581,14 @@ func (a *PipedAPI) ReportStageLogsFromLastCheckpoint(ctx context.Context, req *p
 		a.logger.Error("failed to append logs", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to append logs")
 	}
+
+	var size int64
+	for _, b := range req.Blocks {
+		size += int64(len(b.Log))
+	}
+	if err := a.quotaChecker.AddFilestoreBytes(ctx, projectID, size); err != nil {
+		a.logger.Warn("failed to record filestore usage", zap.Error(err))
+	}
 	return &pipedservice.ReportStageLogsFromLastCheckpointResponse{}, nil
 }
 
@@ -924,6 +979,10 @@ func (a *PipedAPI) CreateDeploymentChain(ctx context.Context, req *pipedservice.
 		return nil, err
 	}
 
+	if err := a.quotaChecker.CheckDeployments(ctx, projectID); err != nil {
+		return nil, checkProjectQuota(err, a.logger)
+	}
+
 	buildChainNodes := func(matcher *pipedservice.CreateDeploymentChainRequest_ApplicationMatcher) ([]*model.ChainNode, []*model.Application, error) {
 		filters := []datastore.ListFilter{
 			{
@@ -1134,14 +1193,115 @@ func (a *PipedAPI) InChainDeploymentPlannable(ctx context.Context, req *pipedser
 	}, nil
 }
 
+// IssueDeploymentToken issues a short-lived token which can be used by piped
+// to call the deployment-scoped RPCs of the given deployment instead of its piped key.
+func (a *PipedAPI) IssueDeploymentToken(ctx context.Context, req *pipedservice.IssueDeploymentTokenRequest) (*pipedservice.IssueDeploymentTokenResponse, error) {
+	projectID, pipedID, pipedKey, err := rpcauth.ExtractPipedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.validateDeploymentBelongsToPiped(ctx, req.DeploymentId, pipedID); err != nil {
+		return nil, err
+	}
+
+	// The token is bound to the piped key so that it is invalidated once the key was deleted.
+	piped, err := getPiped(ctx, a.pipedStore, pipedID, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	key, err := piped.FindKey(pipedKey)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "the piped key was not found")
+	}
+
+	token, expiresAt, err := a.deploymentTokenManager.Issue(projectID, pipedID, key.ID(), req.DeploymentId)
+	if err != nil {
+		a.logger.Error("failed to issue deployment token",
+			zap.String("deployment-id", req.DeploymentId),
+			zap.Error(err),
+		)
+		return nil, status.Error(codes.Internal, "failed to issue deployment token")
+	}
+	return &pipedservice.IssueDeploymentTokenResponse{
+		Token:     token,
+		ExpiresAt: expiresAt.Unix(),
+	}, nil
+}
+
+// GetPromotionArtifact returns the artifact of the given deployment or the most recently successful deployment
+// of the application which has the given name in the same project.
+// The application can be managed by another piped since the artifact is promoted between environments.
+func (a *PipedAPI) GetPromotionArtifact(ctx context.Context, req *pipedservice.GetPromotionArtifactRequest) (*pipedservice.GetPromotionArtifactResponse, error) {
+	projectID, _, _, err := rpcauth.ExtractPipedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apps, _, err := a.applicationStore.List(ctx, datastore.ListOptions{
+		Filters: []datastore.ListFilter{
+			{
+				Field:    "ProjectId",
+				Operator: datastore.OperatorEqual,
+				Value:    projectID,
+			},
+			{
+				Field:    "Disabled",
+				Operator: datastore.OperatorEqual,
+				Value:    false,
+			},
+			{
+				Field:    "Name",
+				Operator: datastore.OperatorEqual,
+				Value:    req.ApplicationName,
+			},
+		},
+	})
+	if err != nil {
+		return nil, gRPCStoreError(err, fmt.Sprintf("list applications named %s", req.ApplicationName))
+	}
+	switch len(apps) {
+	case 0:
+		return nil, status.Errorf(codes.NotFound, "application %s is not found", req.ApplicationName)
+	case 1:
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "there are %d applications named %s", len(apps), req.ApplicationName)
+	}
+
+	deploymentID := req.DeploymentId
+	if deploymentID == "" {
+		ref := apps[0].MostRecentlySuccessfulDeployment
+		if ref == nil {
+			return nil, status.Errorf(codes.NotFound, "application %s has no successful deployment", req.ApplicationName)
+		}
+		deploymentID = ref.DeploymentId
+	}
+	deployment, err := getDeployment(ctx, a.deploymentStore, deploymentID, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.ApplicationId != apps[0].Id {
+		return nil, status.Errorf(codes.InvalidArgument, "deployment %s does not belong to application %s", deployment.Id, req.ApplicationName)
+	}
+	if deployment.Status != model.DeploymentStatus_DEPLOYMENT_SUCCESS {
+		return nil, status.Errorf(codes.FailedPrecondition, "deployment %s of application %s is not successful", deployment.Id, req.ApplicationName)
+	}
+	if deployment.Artifact == nil {
+		return nil, status.Errorf(codes.NotFound, "deployment %s of application %s has no artifact", deployment.Id, req.ApplicationName)
+	}
+
+	return &pipedservice.GetPromotionArtifactResponse{
+		DeploymentId: deployment.Id,
+		CommitHash:   deployment.CommitHash(),
+		Artifact:     deployment.Artifact,
+	}, nil
+}
+
 // validateAppBelongsToPiped checks if the given application belongs to the given piped.
 // It gives back an error unless the application belongs to the piped.
 func (a *PipedAPI) validateAppBelongsToPiped(ctx context.Context, appID, pipedID string) error {
-	pid, err := a.appPipedCache.Get(appID)
-	if err == nil {
-		if pid != pipedID {
-			return status.Error(codes.PermissionDenied, "requested application doesn't belong to the piped")
-		}
+	// The cached piped may be outdated since the application can be migrated to another piped,
+	// so the datastore is checked again before denying the request.
+	if pid, err := a.appPipedCache.Get(appID); err == nil && pid == pipedID {
 		return nil
 	}
 
@@ -1182,3 +1342,107 @@ func (a *PipedAPI) validateDeploymentBelongsToPiped(ctx context.Context, deploym
 
 	return nil
 }
+
+// ReportDeploymentDiff is used to save the rendered diff of a deployment.
+func (a *PipedAPI) ReportDeploymentDiff(ctx context.Context, req *pipedservice.ReportDeploymentDiffRequest) (*pipedservice.ReportDeploymentDiffResponse, error) {
+	_, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.validateDeploymentBelongsToPiped(ctx, req.DeploymentId, pipedID); err != nil {
+		return nil, err
+	}
+
+	if err := a.deploymentDiffStore.Put(ctx, req.DeploymentId, req.Diff); err != nil {
+		a.logger.Error("failed to save deployment diff", zap.String("deployment-id", req.DeploymentId), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to save deployment diff")
+	}
+	return &pipedservice.ReportDeploymentDiffResponse{}, nil
+}
+
+// GetDeploymentRisk returns the risk score of a deployment computed with the scoring of its project.
+func (a *PipedAPI) GetDeploymentRisk(ctx context.Context, req *pipedservice.GetDeploymentRiskRequest) (*pipedservice.GetDeploymentRiskResponse, error) {
+	_, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.PipedId != pipedID {
+		return nil, status.Error(codes.PermissionDenied, "requested deployment doesn't belong to the piped")
+	}
+
+	risk, err := a.riskScorer.Score(ctx, deployment)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to compute deployment risk")
+	}
+	return &pipedservice.GetDeploymentRiskResponse{
+		Risk: risk,
+	}, nil
+}
+
+// RegisterEvent registers an event produced while executing a deployment.
+func (a *PipedAPI) RegisterEvent(ctx context.Context, req *pipedservice.RegisterEventRequest) (*pipedservice.RegisterEventResponse, error) {
+	projectID, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.validateDeploymentBelongsToPiped(ctx, req.DeploymentId, pipedID); err != nil {
+		return nil, err
+	}
+
+	id := uuid.New().String()
+	event := model.Event{
+		Id:                id,
+		Name:              req.Name,
+		Data:              req.Data,
+		Labels:            req.Labels,
+		Contexts:          req.Contexts,
+		EventKey:          model.MakeEventKey(req.Name, req.Labels),
+		ProjectId:         projectID,
+		Status:            model.EventStatus_EVENT_NOT_HANDLED,
+		StatusDescription: fmt.Sprintf("It is going to be replaced by %s", req.Data),
+	}
+	if err := a.eventStore.Add(ctx, event); err != nil {
+		a.logger.Error("failed to add event",
+			zap.String("deployment-id", req.DeploymentId),
+			zap.String("event-name", req.Name),
+			zap.Error(err),
+		)
+		return nil, gRPCStoreError(err, fmt.Sprintf("add event %s", id))
+	}
+	return &pipedservice.RegisterEventResponse{EventId: id}, nil
+}
+
+// UploadStageArtifact is used to save a file produced while executing a stage.
+func (a *PipedAPI) UploadStageArtifact(ctx context.Context, req *pipedservice.UploadStageArtifactRequest) (*pipedservice.UploadStageArtifactResponse, error) {
+	_, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.PipedId != pipedID {
+		return nil, status.Error(codes.PermissionDenied, "requested deployment doesn't belong to the piped")
+	}
+
+	err = a.stageArtifactStore.Put(ctx, deployment, req.StageId, req.Name, req.Content)
+	if errors.Is(err, stageartifactstore.ErrInvalidName) || errors.Is(err, stageartifactstore.ErrInvalidStage) || errors.Is(err, stageartifactstore.ErrTooLarge) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		a.logger.Error("failed to save stage artifact",
+			zap.String("deployment-id", req.DeploymentId),
+			zap.String("stage-id", req.StageId),
+			zap.String("name", req.Name),
+			zap.Error(err),
+		)
+		return nil, status.Error(codes.Internal, "failed to save stage artifact")
+	}
+	return &pipedservice.UploadStageArtifactResponse{}, nil
+}
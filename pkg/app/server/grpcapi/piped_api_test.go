@@ -64,10 +64,38 @@ func TestValidateAppBelongsToPiped(t *testing.T) {
 				c := cachetest.NewMockCache(ctrl)
 				c.EXPECT().
 					Get("appID").Return("pipedID", nil)
+				c.EXPECT().
+					Put("appID", "pipedID").Return(nil)
 				return c
 			}(),
+			applicationStore: func() datastore.ApplicationStore {
+				s := datastoretest.NewMockApplicationStore(ctrl)
+				s.EXPECT().
+					Get(gomock.Any(), "appID").Return(&model.Application{PipedId: "pipedID"}, nil)
+				return s
+			}(),
 			wantErr: true,
 		},
+		{
+			name:    "valid with outdated cached value after migration",
+			appID:   "appID",
+			pipedID: "newPipedID",
+			appPipedCache: func() cache.Cache {
+				c := cachetest.NewMockCache(ctrl)
+				c.EXPECT().
+					Get("appID").Return("pipedID", nil)
+				c.EXPECT().
+					Put("appID", "newPipedID").Return(nil)
+				return c
+			}(),
+			applicationStore: func() datastore.ApplicationStore {
+				s := datastoretest.NewMockApplicationStore(ctrl)
+				s.EXPECT().
+					Get(gomock.Any(), "appID").Return(&model.Application{PipedId: "newPipedID"}, nil)
+				return s
+			}(),
+			wantErr: false,
+		},
 		{
 			name:    "valid with stored value",
 			appID:   "appID",
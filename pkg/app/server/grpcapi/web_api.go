@@ -34,7 +34,11 @@ import (
 
 	"github.com/pipe-cd/pipecd/pkg/app/server/applicationlivestatestore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/commandstore"
+	"github.com/pipe-cd/pipecd/pkg/app/server/deploymentdiffstore"
+	"github.com/pipe-cd/pipecd/pkg/app/server/projectquota"
+	"github.com/pipe-cd/pipecd/pkg/app/server/projectwebhook"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/webservice"
+	"github.com/pipe-cd/pipecd/pkg/app/server/stageartifactstore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/stagelogstore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/unregisteredappstore"
 	"github.com/pipe-cd/pipecd/pkg/cache"
@@ -72,6 +76,7 @@ type webAPIDeploymentChainStore interface {
 type webAPIDeploymentStore interface {
 	Get(ctx context.Context, id string) (*model.Deployment, error)
 	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Deployment, string, error)
+	AddComment(ctx context.Context, id string, comment *model.DeploymentComment) error
 }
 
 type webAPIPipedStore interface {
@@ -132,11 +137,15 @@ type WebAPI struct {
 	apiKeyLastUsedStore       webAPIAPIKeyLastUsedStore
 	eventStore                webAPIEventStore
 	stageLogStore             stagelogstore.Store
+	deploymentDiffStore       deploymentdiffstore.Store
+	stageArtifactStore        stageartifactstore.Store
 	applicationLiveStateStore applicationlivestatestore.Store
 	commandStore              commandstore.Store
 	insightProvider           insight.Provider
 	unregisteredAppStore      unregisteredappstore.Store
 	encrypter                 encrypter
+	quotaChecker              *projectquota.Checker
+	webhookNotifier           *projectwebhook.Notifier
 	githubCli                 *github.Client
 
 	appProjectCache        cache.Cache
@@ -154,6 +163,8 @@ func NewWebAPI(
 	ds datastore.DataStore,
 	sc cache.Cache,
 	sls stagelogstore.Store,
+	dds deploymentdiffstore.Store,
+	sas stageartifactstore.Store,
 	alss applicationlivestatestore.Store,
 	uas unregisteredappstore.Store,
 	akluc cache.Cache,
@@ -161,6 +172,8 @@ func NewWebAPI(
 	psc cache.Cache,
 	projs map[string]config.ControlPlaneProject,
 	encrypter encrypter,
+	qc *projectquota.Checker,
+	wn *projectwebhook.Notifier,
 	logger *zap.Logger,
 ) *WebAPI {
 	w := datastore.WebCommander
@@ -175,12 +188,16 @@ func NewWebAPI(
 		apiKeyLastUsedStore:       akluc,
 		eventStore:                datastore.NewEventStore(ds, w),
 		stageLogStore:             sls,
+		deploymentDiffStore:       dds,
+		stageArtifactStore:        sas,
 		applicationLiveStateStore: alss,
 		commandStore:              commandstore.NewStore(w, ds, sc, logger),
 		insightProvider:           ip,
 		unregisteredAppStore:      uas,
 		projectsInConfig:          projs,
 		encrypter:                 encrypter,
+		quotaChecker:              qc,
+		webhookNotifier:           wn,
 		githubCli:                 github.NewClient(nil),
 		appProjectCache:           memorycache.NewTTLCache(ctx, 24*time.Hour, 3*time.Hour),
 		deploymentProjectCache:    memorycache.NewTTLCache(ctx, 24*time.Hour, 3*time.Hour),
@@ -203,6 +220,10 @@ func (a *WebAPI) RegisterPiped(ctx context.Context, req *webservice.RegisterPipe
 		return nil, err
 	}
 
+	if err := a.quotaChecker.CheckPipeds(ctx, claims.Role.ProjectId); err != nil {
+		return nil, checkProjectQuota(err, a.logger)
+	}
+
 	key, keyHash, err := model.GeneratePipedKey()
 	if err != nil {
 		a.logger.Error("failed to generate piped key", zap.Error(err))
@@ -222,6 +243,12 @@ func (a *WebAPI) RegisterPiped(ctx context.Context, req *webservice.RegisterPipe
 	if err = a.pipedStore.Add(ctx, &piped); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("add piped %s", piped.Id))
 	}
+	a.webhookNotifier.Notify(projectwebhook.Event{
+		Type:      config.ProjectEventPipedRegistered,
+		ProjectID: piped.ProjectId,
+		Resource:  projectwebhook.Resource{ID: piped.Id, Name: piped.Name},
+		Actor:     claims.Subject,
+	})
 
 	return &webservice.RegisterPipedResponse{
 		Id:  piped.Id,
@@ -292,6 +319,14 @@ func (a *WebAPI) DisablePiped(ctx context.Context, req *webservice.DisablePipedR
 	if err := a.updatePiped(ctx, req.PipedId, a.pipedStore.DisablePiped); err != nil {
 		return nil, err
 	}
+	// The claims have already been verified by updatePiped.
+	claims, _ := rpcauth.ExtractClaims(ctx)
+	a.webhookNotifier.Notify(projectwebhook.Event{
+		Type:      config.ProjectEventPipedDisabled,
+		ProjectID: claims.Role.ProjectId,
+		Resource:  projectwebhook.Resource{ID: req.PipedId},
+		Actor:     claims.Subject,
+	})
 	return &webservice.DisablePipedResponse{}, nil
 }
 
@@ -506,6 +541,10 @@ func (a *WebAPI) AddApplication(ctx context.Context, req *webservice.AddApplicat
 		return nil, status.Error(codes.PermissionDenied, "Requested piped does not belong to your project")
 	}
 
+	if err := a.quotaChecker.CheckApplications(ctx, claims.Role.ProjectId); err != nil {
+		return nil, checkProjectQuota(err, a.logger)
+	}
+
 	gitpath, err := makeGitPath(
 		req.GitPath.Repo.Id,
 		req.GitPath.Path,
@@ -533,6 +572,7 @@ func (a *WebAPI) AddApplication(ctx context.Context, req *webservice.AddApplicat
 	if err = a.applicationStore.Add(ctx, &app); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("add application %s", app.Id))
 	}
+	a.webhookNotifier.Notify(applicationEvent(config.ProjectEventApplicationRegistered, &app, claims.Subject))
 
 	return &webservice.AddApplicationResponse{
 		ApplicationId: app.Id,
@@ -607,9 +647,21 @@ func (a *WebAPI) DeleteApplication(ctx context.Context, req *webservice.DeleteAp
 		return nil, err
 	}
 
+	app, err := getApplication(ctx, a.applicationStore, req.ApplicationId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := a.applicationStore.Delete(ctx, req.ApplicationId); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("delete application %s", req.ApplicationId))
 	}
+	a.webhookNotifier.Notify(applicationEvent(config.ProjectEventApplicationDeleted, app, claims.Subject))
+
+	// The application has already been deleted at this point,
+	// so failing to clean up its resources should not fail the request.
+	if err := addDeleteApplicationCommand(ctx, a.commandStore, app, claims.Subject, a.logger); err != nil {
+		a.logger.Warn("failed to request piped to clean up resources of the deleted application", zap.String("application-id", app.Id), zap.Error(err))
+	}
 
 	return &webservice.DeleteApplicationResponse{}, nil
 }
@@ -1079,6 +1131,83 @@ func (a *WebAPI) validateDeploymentBelongsToProject(ctx context.Context, deploym
 	return nil
 }
 
+func (a *WebAPI) GetDeploymentDiff(ctx context.Context, req *webservice.GetDeploymentDiffRequest) (*webservice.GetDeploymentDiffResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	if err := a.validateDeploymentBelongsToProject(ctx, req.DeploymentId, claims.Role.ProjectId); err != nil {
+		return nil, err
+	}
+
+	diff, err := a.deploymentDiffStore.Get(ctx, req.DeploymentId)
+	if err != nil {
+		return nil, gRPCStoreError(err, "get deployment diff")
+	}
+
+	return &webservice.GetDeploymentDiffResponse{
+		Diff: diff,
+	}, nil
+}
+
+func (a *WebAPI) ListStageArtifacts(ctx context.Context, req *webservice.ListStageArtifactsRequest) (*webservice.ListStageArtifactsResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.ProjectId != claims.Role.ProjectId {
+		return nil, status.Error(codes.PermissionDenied, "Requested deployment doesn't belong to the project you logged in")
+	}
+
+	artifacts, err := a.stageArtifactStore.List(ctx, deployment, req.StageId)
+	if err != nil {
+		return nil, gRPCStoreError(err, "list stage artifacts")
+	}
+
+	return &webservice.ListStageArtifactsResponse{
+		Artifacts: artifacts,
+	}, nil
+}
+
+func (a *WebAPI) GetStageArtifact(ctx context.Context, req *webservice.GetStageArtifactRequest) (*webservice.GetStageArtifactResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.ProjectId != claims.Role.ProjectId {
+		return nil, status.Error(codes.PermissionDenied, "Requested deployment doesn't belong to the project you logged in")
+	}
+
+	content, err := a.stageArtifactStore.Get(ctx, deployment, req.StageId, req.Name)
+	if err != nil {
+		return nil, gRPCStoreError(err, "get stage artifact")
+	}
+
+	return &webservice.GetStageArtifactResponse{
+		Artifact: &model.StageArtifact{
+			DeploymentId: req.DeploymentId,
+			StageId:      req.StageId,
+			Name:         req.Name,
+			Size:         int64(len(content)),
+		},
+		Content: content,
+	}, nil
+}
+
 func (a *WebAPI) GetStageLog(ctx context.Context, req *webservice.GetStageLogRequest) (*webservice.GetStageLogResponse, error) {
 	claims, err := rpcauth.ExtractClaims(ctx)
 	if err != nil {
@@ -1145,6 +1274,50 @@ func (a *WebAPI) CancelDeployment(ctx context.Context, req *webservice.CancelDep
 	}, nil
 }
 
+func (a *WebAPI) RetryDeployment(ctx context.Context, req *webservice.RetryDeploymentRequest) (*webservice.RetryDeploymentResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role.ProjectId != deployment.ProjectId {
+		return nil, status.Error(codes.PermissionDenied, "Requested deployment does not belong to your project")
+	}
+
+	app, err := getApplication(ctx, a.applicationStore, deployment.ApplicationId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRetryableDeployment(deployment, app); err != nil {
+		return nil, err
+	}
+
+	cmd := model.Command{
+		Id:            uuid.New().String(),
+		PipedId:       deployment.PipedId,
+		ApplicationId: deployment.ApplicationId,
+		ProjectId:     deployment.ProjectId,
+		DeploymentId:  req.DeploymentId,
+		Type:          model.Command_RETRY_DEPLOYMENT,
+		Commander:     claims.Subject,
+		RetryDeployment: &model.Command_RetryDeployment{
+			DeploymentId: req.DeploymentId,
+		},
+	}
+	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
+		return nil, err
+	}
+
+	return &webservice.RetryDeploymentResponse{
+		CommandId: cmd.Id,
+	}, nil
+}
+
 func (a *WebAPI) SkipStage(ctx context.Context, req *webservice.SkipStageRequest) (*webservice.SkipStageResponse, error) {
 	claims, err := rpcauth.ExtractClaims(ctx)
 	if err != nil {
@@ -1243,6 +1416,40 @@ func (a *WebAPI) ApproveStage(ctx context.Context, req *webservice.ApproveStageR
 	}, nil
 }
 
+func (a *WebAPI) AddDeploymentComment(ctx context.Context, req *webservice.AddDeploymentCommentRequest) (*webservice.AddDeploymentCommentResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role.ProjectId != deployment.ProjectId {
+		return nil, status.Error(codes.PermissionDenied, "Requested deployment does not belong to your project")
+	}
+	if deployment.Status.IsCompleted() {
+		return nil, status.Error(codes.FailedPrecondition, "Could not comment on the deployment because it was already completed")
+	}
+
+	comment := &model.DeploymentComment{
+		Id:        uuid.New().String(),
+		Commenter: claims.Subject,
+		Comment:   req.Comment,
+		CreatedAt: time.Now().Unix(),
+	}
+	if err := a.deploymentStore.AddComment(ctx, req.DeploymentId, comment); err != nil {
+		a.logger.Error("failed to add comment to deployment", zap.String("deployment", req.DeploymentId), zap.Error(err))
+		return nil, gRPCStoreError(err, "add comment to deployment")
+	}
+
+	return &webservice.AddDeploymentCommentResponse{
+		Comment: comment,
+	}, nil
+}
+
 // No error means that the given commander is valid.
 func validateApprover(stages []*model.PipelineStage, commander, stageID string) error {
 	var approvers []string
@@ -1585,6 +1792,16 @@ func (a *WebAPI) GenerateAPIKey(ctx context.Context, req *webservice.GenerateAPI
 	if err = a.apiKeyStore.Add(ctx, &apiKey); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("add API key %s", apiKey.Id))
 	}
+	a.webhookNotifier.Notify(projectwebhook.Event{
+		Type:      config.ProjectEventAPIKeyCreated,
+		ProjectID: apiKey.ProjectId,
+		Resource: projectwebhook.Resource{
+			ID:         apiKey.Id,
+			Name:       apiKey.Name,
+			Attributes: map[string]string{"role": apiKey.Role.String()},
+		},
+		Actor: claims.Subject,
+	})
 
 	return &webservice.GenerateAPIKeyResponse{
 		Key: key,
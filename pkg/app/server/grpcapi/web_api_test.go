@@ -18,15 +18,23 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/webservice"
 	"github.com/pipe-cd/pipecd/pkg/cache"
 	"github.com/pipe-cd/pipecd/pkg/cache/cachetest"
 	"github.com/pipe-cd/pipecd/pkg/datastore"
 	"github.com/pipe-cd/pipecd/pkg/datastore/datastoretest"
+	"github.com/pipe-cd/pipecd/pkg/jwt"
 	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/rpc/rpcauth"
 )
 
 func TestValidateAppBelongsToProject(t *testing.T) {
@@ -374,3 +382,82 @@ func TestValidateApprover(t *testing.T) {
 		})
 	}
 }
+
+func TestAddDeploymentComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	claims := jwt.NewClaims("user", "", time.Hour, model.Role{ProjectId: "projectID"})
+	ctx := rpcauth.ContextWithClaims(context.Background(), *claims)
+
+	tests := []struct {
+		name            string
+		deploymentStore datastore.DeploymentStore
+		wantCode        codes.Code
+	}{
+		{
+			name: "add a comment to the running deployment",
+			deploymentStore: func() datastore.DeploymentStore {
+				s := datastoretest.NewMockDeploymentStore(ctrl)
+				s.EXPECT().
+					Get(gomock.Any(), "deploymentID").Return(&model.Deployment{ProjectId: "projectID", Status: model.DeploymentStatus_DEPLOYMENT_RUNNING}, nil)
+				s.EXPECT().
+					AddComment(gomock.Any(), "deploymentID", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, c *model.DeploymentComment) error {
+						assert.NotEmpty(t, c.Id)
+						assert.Equal(t, "user", c.Commenter)
+						assert.Equal(t, "paused for DB check", c.Comment)
+						return nil
+					})
+				return s
+			}(),
+			wantCode: codes.OK,
+		},
+		{
+			name: "deployment not found",
+			deploymentStore: func() datastore.DeploymentStore {
+				s := datastoretest.NewMockDeploymentStore(ctrl)
+				s.EXPECT().
+					Get(gomock.Any(), "deploymentID").Return(nil, datastore.ErrNotFound)
+				return s
+			}(),
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "deployment in another project",
+			deploymentStore: func() datastore.DeploymentStore {
+				s := datastoretest.NewMockDeploymentStore(ctrl)
+				s.EXPECT().
+					Get(gomock.Any(), "deploymentID").Return(&model.Deployment{ProjectId: "wrong", Status: model.DeploymentStatus_DEPLOYMENT_RUNNING}, nil)
+				return s
+			}(),
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "deployment already completed",
+			deploymentStore: func() datastore.DeploymentStore {
+				s := datastoretest.NewMockDeploymentStore(ctrl)
+				s.EXPECT().
+					Get(gomock.Any(), "deploymentID").Return(&model.Deployment{ProjectId: "projectID", Status: model.DeploymentStatus_DEPLOYMENT_SUCCESS}, nil)
+				return s
+			}(),
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &WebAPI{
+				deploymentStore: tt.deploymentStore,
+				logger:          zap.NewNop(),
+			}
+			resp, err := api.AddDeploymentComment(ctx, &webservice.AddDeploymentCommentRequest{
+				DeploymentId: "deploymentID",
+				Comment:      "paused for DB check",
+			})
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, "paused for DB check", resp.Comment.Comment)
+			}
+		})
+	}
+}
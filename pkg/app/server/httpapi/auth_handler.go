@@ -58,6 +58,10 @@ type projectGetter interface {
 	Get(ctx context.Context, id string) (*model.Project, error)
 }
 
+type projectUserGroupAdder interface {
+	AddProjectUserGroup(ctx context.Context, id, sso, role string) error
+}
+
 type decrypter interface {
 	Decrypt(encryptedText string) (string, error)
 }
@@ -71,6 +75,8 @@ type authHandler struct {
 	projectsInConfig map[string]config.ControlPlaneProject
 	sharedSSOConfigs map[string]*model.ProjectSSOConfig
 	projectGetter    projectGetter
+	userGroupAdder   projectUserGroupAdder
+	ssoProvisioning  config.ControlPlaneSSOProvisioning
 	secureCookie     bool
 	logger           *zap.Logger
 }
@@ -84,6 +90,8 @@ func newAuthHandler(
 	projectsInConfig map[string]config.ControlPlaneProject,
 	sharedSSOConfigs map[string]*model.ProjectSSOConfig,
 	projectGetter projectGetter,
+	userGroupAdder projectUserGroupAdder,
+	ssoProvisioning config.ControlPlaneSSOProvisioning,
 	secureCookie bool,
 	logger *zap.Logger,
 ) *authHandler {
@@ -95,6 +103,8 @@ func newAuthHandler(
 		projectsInConfig: projectsInConfig,
 		sharedSSOConfigs: sharedSSOConfigs,
 		projectGetter:    projectGetter,
+		userGroupAdder:   userGroupAdder,
+		ssoProvisioning:  ssoProvisioning,
 		secureCookie:     secureCookie,
 		logger:           logger,
 	}
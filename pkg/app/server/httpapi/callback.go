@@ -64,6 +64,7 @@ func (h *authHandler) handleCallback(w http.ResponseWriter, r *http.Request) {
 		h.handleError(w, r, fmt.Sprintf("Unable to find project %s", projectID), err)
 		return
 	}
+	h.provisionUserGroups(ctx, proj)
 
 	if proj.UserGroups == nil {
 		h.handleError(w, r, "Missing User Group configuration", nil)
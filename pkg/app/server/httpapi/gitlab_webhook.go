@@ -0,0 +1,249 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpapi
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// gitlabWebhookPath is the path configured as the URL of GitLab project webhooks.
+	// The ID of the PipeCD project must be given by the "project" query parameter.
+	gitlabWebhookPath = "/webhooks/gitlab"
+
+	gitlabTokenHeader = "X-Gitlab-Token"
+	gitlabEventHeader = "X-Gitlab-Event"
+
+	gitlabMergeRequestEvent = "Merge Request Hook"
+)
+
+type deploymentLister interface {
+	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Deployment, string, error)
+}
+
+type commandAdder interface {
+	AddCommand(ctx context.Context, cmd *model.Command) error
+}
+
+type gitlabProject struct {
+	secret       []byte
+	approvalSync bool
+}
+
+// gitlabWebhookHandler handles the webhook events sent by GitLab.
+// Currently, it only approves the WAIT_APPROVAL stages of the deployments
+// triggered by the commits of a merge request when the merge request gets approved.
+type gitlabWebhookHandler struct {
+	projects        map[string]gitlabProject
+	deploymentStore deploymentLister
+	commandStore    commandAdder
+	logger          *zap.Logger
+}
+
+// NewGitLabWebhookHandler returns a handler for the webhook events sent by GitLab
+// to the projects configured in the given integrations.
+func NewGitLabWebhookHandler(
+	integrations []config.ControlPlaneGitLabIntegration,
+	deploymentStore deploymentLister,
+	commandStore commandAdder,
+	logger *zap.Logger,
+) (http.Handler, error) {
+	projects := make(map[string]gitlabProject, len(integrations))
+	for _, g := range integrations {
+		data, err := os.ReadFile(g.WebhookSecretFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the gitlab webhook secret of project %s: %w", g.ProjectID, err)
+		}
+		projects[g.ProjectID] = gitlabProject{
+			secret:       []byte(strings.TrimSpace(string(data))),
+			approvalSync: g.ApprovalSync,
+		}
+	}
+	return &gitlabWebhookHandler{
+		projects:        projects,
+		deploymentStore: deploymentStore,
+		commandStore:    commandStore,
+		logger:          logger.Named("gitlab-webhook"),
+	}, nil
+}
+
+type gitlabMergeRequestPayload struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	ObjectAttributes struct {
+		IID            int    `json:"iid"`
+		Action         string `json:"action"`
+		MergeCommitSHA string `json:"merge_commit_sha"`
+		LastCommit     struct {
+			ID string `json:"id"`
+		} `json:"last_commit"`
+	} `json:"object_attributes"`
+}
+
+func (h *gitlabWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	projectID := r.URL.Query().Get(projectFormKey)
+	p, ok := h.projects[projectID]
+	if !ok {
+		http.Error(w, "Unknown project", http.StatusNotFound)
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get(gitlabTokenHeader)), p.secret) != 1 {
+		http.Error(w, "Invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	// Acknowledge the events which are not handled yet
+	// so that GitLab doesn't consider this webhook as failing.
+	if r.Header.Get(gitlabEventHeader) != gitlabMergeRequestEvent {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	var payload gitlabMergeRequestPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, "Malformed payload", http.StatusBadRequest)
+		return
+	}
+
+	switch payload.ObjectAttributes.Action {
+	case "approval", "approved":
+		if !p.approvalSync {
+			break
+		}
+		if err := h.approveStages(r.Context(), projectID, &payload); err != nil {
+			h.logger.Error("failed to sync the approval of merge request",
+				zap.String("project-id", projectID),
+				zap.Int("merge-request-iid", payload.ObjectAttributes.IID),
+				zap.Error(err),
+			)
+			http.Error(w, "Failed to sync the approval", http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// approveStages approves the running WAIT_APPROVAL stages of the not completed deployments
+// triggered by either the last commit or the merge commit of the merge request.
+// The GitLab user approving the merge request is recorded as the approver,
+// so that it must be in the approver list of the stage if the list is set.
+func (h *gitlabWebhookHandler) approveStages(ctx context.Context, projectID string, payload *gitlabMergeRequestPayload) error {
+	commits := make(map[string]struct{}, 2)
+	if c := payload.ObjectAttributes.LastCommit.ID; c != "" {
+		commits[c] = struct{}{}
+	}
+	if c := payload.ObjectAttributes.MergeCommitSHA; c != "" {
+		commits[c] = struct{}{}
+	}
+	approver := payload.User.Username
+	if len(commits) == 0 || approver == "" {
+		return nil
+	}
+
+	deployments, _, err := h.deploymentStore.List(ctx, datastore.ListOptions{
+		Filters: []datastore.ListFilter{
+			{
+				Field:    "ProjectId",
+				Operator: datastore.OperatorEqual,
+				Value:    projectID,
+			},
+			{
+				Field:    "Status",
+				Operator: datastore.OperatorIn,
+				Value:    model.GetNotCompletedDeploymentStatuses(),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	for _, d := range deployments {
+		if _, ok := commits[d.CommitHash()]; !ok {
+			continue
+		}
+		for _, s := range d.Stages {
+			if s.Name != model.StageWaitApproval.String() || s.Status != model.StageStatus_STAGE_RUNNING {
+				continue
+			}
+			if !isStageApprover(s, approver) {
+				h.logger.Info("skipped approving stage because the merge request approver is not in its approver list",
+					zap.String("deployment-id", d.Id),
+					zap.String("stage-id", s.Id),
+					zap.String("approver", approver),
+				)
+				continue
+			}
+			cmd := model.Command{
+				Id:            uuid.New().String(),
+				PipedId:       d.PipedId,
+				ApplicationId: d.ApplicationId,
+				ProjectId:     d.ProjectId,
+				DeploymentId:  d.Id,
+				StageId:       s.Id,
+				Type:          model.Command_APPROVE_STAGE,
+				Commander:     approver,
+				ApproveStage: &model.Command_ApproveStage{
+					DeploymentId: d.Id,
+					StageId:      s.Id,
+				},
+			}
+			if err := h.commandStore.AddCommand(ctx, &cmd); err != nil {
+				return fmt.Errorf("failed to add command to approve stage %s of deployment %s: %w", s.Id, d.Id, err)
+			}
+			h.logger.Info("approved stage on behalf of the gitlab merge request approver",
+				zap.String("deployment-id", d.Id),
+				zap.String("stage-id", s.Id),
+				zap.String("approver", approver),
+				zap.Int("merge-request-iid", payload.ObjectAttributes.IID),
+			)
+		}
+	}
+	return nil
+}
+
+func isStageApprover(s *model.PipelineStage, approver string) bool {
+	as := s.Metadata["Approvers"]
+	if as == "" {
+		// Anyone can approve the stage.
+		return true
+	}
+	for _, a := range strings.Split(as, ",") {
+		if a == approver {
+			return true
+		}
+	}
+	return false
+}
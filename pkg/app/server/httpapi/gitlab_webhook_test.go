@@ -0,0 +1,175 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeDeploymentLister struct {
+	deployments []*model.Deployment
+}
+
+func (f *fakeDeploymentLister) List(_ context.Context, _ datastore.ListOptions) ([]*model.Deployment, string, error) {
+	return f.deployments, "", nil
+}
+
+type fakeCommandAdder struct {
+	commands []*model.Command
+}
+
+func (f *fakeCommandAdder) AddCommand(_ context.Context, cmd *model.Command) error {
+	f.commands = append(f.commands, cmd)
+	return nil
+}
+
+func TestGitLabWebhookHandler(t *testing.T) {
+	t.Parallel()
+
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("secret\n"), 0600))
+
+	newDeployment := func(id, commit, approvers string) *model.Deployment {
+		return &model.Deployment{
+			Id:            id,
+			ProjectId:     "project-1",
+			PipedId:       "piped-1",
+			ApplicationId: "app-1",
+			Trigger: &model.DeploymentTrigger{
+				Commit: &model.Commit{Hash: commit},
+			},
+			Stages: []*model.PipelineStage{
+				{Id: "sync", Name: model.StageK8sSync.String(), Status: model.StageStatus_STAGE_NOT_STARTED_YET},
+				{Id: "approval", Name: model.StageWaitApproval.String(), Status: model.StageStatus_STAGE_RUNNING, Metadata: map[string]string{"Approvers": approvers}},
+			},
+		}
+	}
+
+	const payload = `{
+  "object_kind": "merge_request",
+  "user": {"username": "alice"},
+  "object_attributes": {
+    "iid": 12,
+    "action": "approved",
+    "last_commit": {"id": "head-commit"}
+  }
+}`
+
+	testcases := []struct {
+		name         string
+		integration  config.ControlPlaneGitLabIntegration
+		project      string
+		token        string
+		event        string
+		deployments  []*model.Deployment
+		wantStatus   int
+		wantApproved []string
+	}{
+		{
+			name:        "unknown project",
+			integration: config.ControlPlaneGitLabIntegration{ProjectID: "project-1", WebhookSecretFile: secretFile, ApprovalSync: true},
+			project:     "project-2",
+			token:       "secret",
+			event:       gitlabMergeRequestEvent,
+			wantStatus:  http.StatusNotFound,
+		},
+		{
+			name:        "invalid token",
+			integration: config.ControlPlaneGitLabIntegration{ProjectID: "project-1", WebhookSecretFile: secretFile, ApprovalSync: true},
+			project:     "project-1",
+			token:       "wrong",
+			event:       gitlabMergeRequestEvent,
+			wantStatus:  http.StatusUnauthorized,
+		},
+		{
+			name:        "approval sync is disabled",
+			integration: config.ControlPlaneGitLabIntegration{ProjectID: "project-1", WebhookSecretFile: secretFile},
+			project:     "project-1",
+			token:       "secret",
+			event:       gitlabMergeRequestEvent,
+			deployments: []*model.Deployment{newDeployment("deployment-1", "head-commit", "")},
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:        "unhandled event",
+			integration: config.ControlPlaneGitLabIntegration{ProjectID: "project-1", WebhookSecretFile: secretFile, ApprovalSync: true},
+			project:     "project-1",
+			token:       "secret",
+			event:       "Push Hook",
+			deployments: []*model.Deployment{newDeployment("deployment-1", "head-commit", "")},
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:        "approve the deployments triggered by the merge request",
+			integration: config.ControlPlaneGitLabIntegration{ProjectID: "project-1", WebhookSecretFile: secretFile, ApprovalSync: true},
+			project:     "project-1",
+			token:       "secret",
+			event:       gitlabMergeRequestEvent,
+			deployments: []*model.Deployment{
+				newDeployment("deployment-1", "head-commit", ""),
+				newDeployment("deployment-2", "other-commit", ""),
+				newDeployment("deployment-3", "head-commit", "bob,alice"),
+				newDeployment("deployment-4", "head-commit", "bob"),
+			},
+			wantStatus:   http.StatusOK,
+			wantApproved: []string{"deployment-1", "deployment-3"},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			commands := &fakeCommandAdder{}
+			h, err := NewGitLabWebhookHandler(
+				[]config.ControlPlaneGitLabIntegration{tc.integration},
+				&fakeDeploymentLister{deployments: tc.deployments},
+				commands,
+				zap.NewNop(),
+			)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, gitlabWebhookPath+"?project="+tc.project, strings.NewReader(payload))
+			req.Header.Set(gitlabTokenHeader, tc.token)
+			req.Header.Set(gitlabEventHeader, tc.event)
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.wantStatus, rec.Code)
+			approved := make([]string, 0, len(commands.commands))
+			for _, c := range commands.commands {
+				assert.Equal(t, model.Command_APPROVE_STAGE, c.Type)
+				assert.Equal(t, "alice", c.Commander)
+				assert.Equal(t, "approval", c.StageId)
+				approved = append(approved, c.DeploymentId)
+			}
+			assert.ElementsMatch(t, tc.wantApproved, approved)
+		})
+	}
+}
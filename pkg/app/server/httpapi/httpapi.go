@@ -37,7 +37,10 @@ func NewHandler(
 	projectsInConfig map[string]config.ControlPlaneProject,
 	sharedSSOConfigs map[string]*model.ProjectSSOConfig,
 	projectGetter projectGetter,
+	userGroupAdder projectUserGroupAdder,
+	ssoProvisioning config.ControlPlaneSSOProvisioning,
 	secureCookie bool,
+	gitlabWebhook http.Handler,
 	logger *zap.Logger,
 ) http.Handler {
 	mux := http.NewServeMux()
@@ -49,6 +52,8 @@ func NewHandler(
 		projectsInConfig,
 		sharedSSOConfigs,
 		projectGetter,
+		userGroupAdder,
+		ssoProvisioning,
 		secureCookie,
 		logger,
 	)
@@ -75,6 +80,9 @@ func NewHandler(
 	register(staticLoginPath, http.HandlerFunc(a.handleStaticAdminLogin))
 	register(callbackPath, http.HandlerFunc(a.handleCallback))
 	register(logoutPath, http.HandlerFunc(a.handleLogout))
+	if gitlabWebhook != nil {
+		register(gitlabWebhookPath, gitlabWebhook)
+	}
 
 	return mux
 }
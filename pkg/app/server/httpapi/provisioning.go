@@ -0,0 +1,49 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpapi
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// provisionUserGroups adds the SSO group mappings defined by the provisioning rules
+// to the given project when it does not have them yet.
+// The mappings already configured in the project take precedence over the rules.
+func (h *authHandler) provisionUserGroups(ctx context.Context, proj *model.Project) {
+	for _, r := range h.ssoProvisioning.FindRules(proj.Id) {
+		if proj.HasUserGroup(r.SSOGroup) {
+			continue
+		}
+		logger := h.logger.With(
+			zap.String("project-id", proj.Id),
+			zap.String("sso-group", r.SSOGroup),
+			zap.String("role", r.Role),
+		)
+		if err := proj.AddUserGroup(r.SSOGroup, r.Role); err != nil {
+			logger.Warn("skipped provisioning the invalid SSO group mapping", zap.Error(err))
+			continue
+		}
+		// The mapping is still used for the current login even if it could not be saved.
+		if err := h.userGroupAdder.AddProjectUserGroup(ctx, proj.Id, r.SSOGroup, r.Role); err != nil {
+			logger.Error("failed to save the provisioned SSO group mapping", zap.Error(err))
+			continue
+		}
+		logger.Info("provisioned SSO group mapping")
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeUserGroupAdder struct {
+	added []string
+	err   error
+}
+
+func (f *fakeUserGroupAdder) AddProjectUserGroup(_ context.Context, id, sso, role string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.added = append(f.added, id+":"+sso+":"+role)
+	return nil
+}
+
+func TestProvisionUserGroups(t *testing.T) {
+	t.Parallel()
+
+	provisioning := config.ControlPlaneSSOProvisioning{
+		Rules: []config.SSOProvisioningRule{
+			{
+				SSOGroup: "my-org/platform-team",
+				Role:     model.BuiltinRBACRoleAdmin.String(),
+				Projects: []string{"project-1"},
+			},
+			{
+				SSOGroup: "my-org/all",
+				Role:     model.BuiltinRBACRoleViewer.String(),
+				Projects: []string{config.SSOProvisioningAllProjects},
+			},
+			{
+				SSOGroup: "my-org/sre",
+				Role:     "NotExist",
+				Projects: []string{"project-1"},
+			},
+		},
+	}
+	testcases := []struct {
+		name           string
+		project        *model.Project
+		addErr         error
+		expectedGroups []*model.ProjectUserGroup
+		expectedAdded  []string
+	}{
+		{
+			name:    "add all matching rules",
+			project: &model.Project{Id: "project-1"},
+			expectedGroups: []*model.ProjectUserGroup{
+				{SsoGroup: "my-org/platform-team", Role: "Admin"},
+				{SsoGroup: "my-org/all", Role: "Viewer"},
+			},
+			expectedAdded: []string{
+				"project-1:my-org/platform-team:Admin",
+				"project-1:my-org/all:Viewer",
+			},
+		},
+		{
+			name: "keep the mapping configured in the project",
+			project: &model.Project{
+				Id: "project-2",
+				UserGroups: []*model.ProjectUserGroup{
+					{SsoGroup: "my-org/all", Role: "Editor"},
+				},
+			},
+			expectedGroups: []*model.ProjectUserGroup{
+				{SsoGroup: "my-org/all", Role: "Editor"},
+			},
+		},
+		{
+			name:    "use the mapping even if it could not be saved",
+			project: &model.Project{Id: "project-2"},
+			addErr:  errors.New("datastore error"),
+			expectedGroups: []*model.ProjectUserGroup{
+				{SsoGroup: "my-org/all", Role: "Viewer"},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			adder := &fakeUserGroupAdder{err: tc.addErr}
+			h := &authHandler{
+				userGroupAdder:  adder,
+				ssoProvisioning: provisioning,
+				logger:          zap.NewNop(),
+			}
+			h.provisionUserGroups(context.Background(), tc.project)
+			assert.Equal(t, tc.expectedGroups, tc.project.UserGroups)
+			assert.Equal(t, tc.expectedAdded, adder.added)
+		})
+	}
+}
@@ -114,6 +114,33 @@ func (v *Verifier) Verify(ctx context.Context, projectID, pipedID, pipedKey stri
 	return nil
 }
 
+// VerifyKeyID checks that the piped is still enabled and has the key of the given ID.
+// It is used to verify the tokens issued by using a piped key, which must be invalidated
+// once the piped was disabled or the key was deleted.
+// Since the piped is cached for a while, such changes take effect when the cached data expired.
+func (v *Verifier) VerifyKeyID(ctx context.Context, projectID, pipedID, keyID string) error {
+	if err := v.verifyProject(ctx, projectID, pipedID); err != nil {
+		return err
+	}
+
+	item, err := v.pipedCache.Get(pipedID)
+	if err == nil {
+		if checkPipedKeyID(item.(*model.Piped), projectID, pipedID, keyID) == nil {
+			return nil
+		}
+	}
+
+	// The cache data was not found or stale.
+	piped, err := v.pipedStore.Get(ctx, pipedID)
+	if err != nil {
+		return fmt.Errorf("unable to find piped %s from datastore, %w", pipedID, err)
+	}
+	if err := v.pipedCache.Put(pipedID, piped); err != nil {
+		v.logger.Warn("unable to store piped in memory cache", zap.Error(err))
+	}
+	return checkPipedKeyID(piped, projectID, pipedID, keyID)
+}
+
 func (v *Verifier) verifyProject(ctx context.Context, projectID, pipedID string) error {
 	// Firstly, we check from the list specified in the Control Plane configuration.
 	if _, ok := v.config.FindProject(projectID); ok {
@@ -136,6 +163,19 @@ func (v *Verifier) verifyProject(ctx context.Context, projectID, pipedID string)
 	return nil
 }
 
+func checkPipedKeyID(piped *model.Piped, projectID, pipedID, keyID string) error {
+	if piped.ProjectId != projectID {
+		return fmt.Errorf("the project of piped %s is not matched, expected=%s, got=%s", pipedID, projectID, piped.ProjectId)
+	}
+	if piped.Disabled {
+		return fmt.Errorf("piped %s was already disabled", pipedID)
+	}
+	if !piped.HasKeyID(keyID) {
+		return fmt.Errorf("the key %s of piped %s was not found", keyID, pipedID)
+	}
+	return nil
+}
+
 func checkPiped(piped *model.Piped, projectID, pipedID, pipedKey string) (keyNotMatch bool, err error) {
 	if piped.ProjectId != projectID {
 		return false, fmt.Errorf("the project of piped %s is not matched, expected=%s, got=%s", pipedID, projectID, piped.ProjectId)
@@ -189,3 +189,58 @@ func TestVerify(t *testing.T) {
 	require.Equal(t, 2, projectGetter.calls)
 	require.Equal(t, 7, pipedGetter.calls)
 }
+
+func TestVerifyKeyID(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key := &model.PipedKey{Hash: "hash-1"}
+	pipedGetter := &fakePipedGetter{
+		pipeds: map[string]*model.Piped{
+			"piped-1": {
+				Id:        "piped-1",
+				ProjectId: "project-1",
+				Keys:      []*model.PipedKey{key},
+			},
+		},
+	}
+	v := NewVerifier(
+		ctx,
+		&config.ControlPlaneSpec{
+			Projects: []config.ControlPlaneProject{
+				{
+					ID: "project-1",
+				},
+			},
+		},
+		&fakeProjectGetter{},
+		pipedGetter,
+		zap.NewNop(),
+	)
+
+	require.NoError(t, v.VerifyKeyID(ctx, "project-1", "piped-1", key.ID()))
+	assert.Error(t, v.VerifyKeyID(ctx, "project-1", "piped-1", "unknown-key-id"))
+	assert.Error(t, v.VerifyKeyID(ctx, "project-2", "piped-1", key.ID()))
+
+	// The cached piped is used until it expires.
+	pipedGetter.pipeds["piped-1"] = &model.Piped{
+		Id:        "piped-1",
+		ProjectId: "project-1",
+		Keys:      []*model.PipedKey{{Hash: "hash-2"}},
+	}
+	require.NoError(t, v.VerifyKeyID(ctx, "project-1", "piped-1", key.ID()))
+
+	// The key was deleted.
+	require.NoError(t, v.pipedCache.Delete("piped-1"))
+	assert.Error(t, v.VerifyKeyID(ctx, "project-1", "piped-1", key.ID()))
+
+	// The piped was disabled.
+	pipedGetter.pipeds["piped-1"] = &model.Piped{
+		Id:        "piped-1",
+		ProjectId: "project-1",
+		Keys:      []*model.PipedKey{key},
+		Disabled:  true,
+	}
+	require.NoError(t, v.pipedCache.Delete("piped-1"))
+	assert.Error(t, v.VerifyKeyID(ctx, "project-1", "piped-1", key.ID()))
+}
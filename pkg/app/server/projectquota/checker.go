@@ -0,0 +1,270 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package projectquota provides a checker to ensure that
+// a project does not use more resources than its configured quota.
+package projectquota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/redis"
+)
+
+const (
+	filestoreUsageHashKey = "HASHKEY:PROJECT:FILESTORE_USAGE"
+	listPageSize          = 500
+)
+
+// ErrExceeded is returned when the project reaches its quota.
+var ErrExceeded = errors.New("project quota exceeded")
+
+type applicationLister interface {
+	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Application, string, error)
+}
+
+type pipedLister interface {
+	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Piped, error)
+}
+
+type deploymentLister interface {
+	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Deployment, string, error)
+}
+
+// Checker checks whether a project can create more resources.
+// All of the checks pass when the corresponding quota is zero.
+type Checker struct {
+	quotas           config.ControlPlaneProjectQuotas
+	applicationStore applicationLister
+	pipedStore       pipedLister
+	deploymentStore  deploymentLister
+	usageBackend     redis.Redis
+	nowFunc          func() time.Time
+	logger           *zap.Logger
+}
+
+// NewChecker creates a new Checker. The given redis is used to keep
+// the filestore usage of the projects.
+func NewChecker(quotas config.ControlPlaneProjectQuotas, ds datastore.DataStore, rd redis.Redis, logger *zap.Logger) *Checker {
+	// The checker never writes to the datastore so the commander does not matter.
+	c := datastore.WebCommander
+	return &Checker{
+		quotas:           quotas,
+		applicationStore: datastore.NewApplicationStore(ds, c),
+		pipedStore:       datastore.NewPipedStore(ds, c),
+		deploymentStore:  datastore.NewDeploymentStore(ds, c),
+		usageBackend:     rd,
+		nowFunc:          time.Now,
+		logger:           logger.Named("project-quota-checker"),
+	}
+}
+
+// CheckApplications returns ErrExceeded when the project cannot add more applications.
+func (c *Checker) CheckApplications(ctx context.Context, projectID string) error {
+	limit := c.quotas.Find(projectID).MaxApplications
+	if limit == 0 {
+		return nil
+	}
+	count, err := c.countApplications(ctx, projectID, limit)
+	if err != nil {
+		return err
+	}
+	if count >= limit {
+		return fmt.Errorf("%w: the project already has %d applications (maxApplications: %d)", ErrExceeded, count, limit)
+	}
+	return nil
+}
+
+// CheckPipeds returns ErrExceeded when the project cannot register more pipeds.
+// Disabled pipeds are not counted.
+func (c *Checker) CheckPipeds(ctx context.Context, projectID string) error {
+	limit := c.quotas.Find(projectID).MaxPipeds
+	if limit == 0 {
+		return nil
+	}
+	pipeds, err := c.pipedStore.List(ctx, datastore.ListOptions{
+		Filters: []datastore.ListFilter{
+			{
+				Field:    "ProjectId",
+				Operator: datastore.OperatorEqual,
+				Value:    projectID,
+			},
+		},
+	})
+	if err != nil {
+		c.logger.Error("failed to list pipeds", zap.Error(err))
+		return err
+	}
+	var count int
+	for _, p := range pipeds {
+		if !p.Disabled {
+			count++
+		}
+	}
+	if count >= limit {
+		return fmt.Errorf("%w: the project already has %d pipeds (maxPipeds: %d)", ErrExceeded, count, limit)
+	}
+	return nil
+}
+
+// CheckDeployments returns ErrExceeded when the project already
+// created the maximum number of deployments in the last 24 hours.
+func (c *Checker) CheckDeployments(ctx context.Context, projectID string) error {
+	limit := c.quotas.Find(projectID).MaxDeploymentsPerDay
+	if limit == 0 {
+		return nil
+	}
+	count, err := c.countRecentDeployments(ctx, projectID, limit)
+	if err != nil {
+		return err
+	}
+	if count >= limit {
+		return fmt.Errorf("%w: the project already created %d deployments in the last 24 hours (maxDeploymentsPerDay: %d)", ErrExceeded, count, limit)
+	}
+	return nil
+}
+
+// CheckFilestoreBytes returns ErrExceeded when the project already
+// stored the maximum size of data in the filestore.
+func (c *Checker) CheckFilestoreBytes(ctx context.Context, projectID string) error {
+	limit := c.quotas.Find(projectID).FilestoreBytes()
+	if limit == 0 {
+		return nil
+	}
+	conn := c.usageBackend.Get()
+	defer conn.Close()
+
+	used, err := redigo.Int64(conn.Do("HGET", filestoreUsageHashKey, projectID))
+	if errors.Is(err, redigo.ErrNil) {
+		return nil
+	}
+	if err != nil {
+		c.logger.Error("failed to get filestore usage", zap.String("project", projectID), zap.Error(err))
+		return err
+	}
+	if used >= limit {
+		return fmt.Errorf("%w: the project already stored %d bytes in the filestore (maxFilestoreBytes: %d)", ErrExceeded, used, limit)
+	}
+	return nil
+}
+
+// AddFilestoreBytes records that the project stored more data in the filestore.
+// The usage is recorded only when the project has the filestore quota.
+func (c *Checker) AddFilestoreBytes(ctx context.Context, projectID string, size int64) error {
+	if size == 0 || c.quotas.Find(projectID).FilestoreBytes() == 0 {
+		return nil
+	}
+	conn := c.usageBackend.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("HINCRBY", filestoreUsageHashKey, projectID, size)
+	return err
+}
+
+func (c *Checker) countApplications(ctx context.Context, projectID string, limit int) (int, error) {
+	opts := datastore.ListOptions{
+		Limit: listPageSize,
+		Filters: []datastore.ListFilter{
+			{
+				Field:    "ProjectId",
+				Operator: datastore.OperatorEqual,
+				Value:    projectID,
+			},
+		},
+		Orders: []datastore.Order{
+			{
+				Field:     "UpdatedAt",
+				Direction: datastore.Desc,
+			},
+			{
+				Field:     "Id",
+				Direction: datastore.Asc,
+			},
+		},
+	}
+	var count int
+	for {
+		apps, cursor, err := c.applicationStore.List(ctx, opts)
+		if err != nil {
+			c.logger.Error("failed to list applications", zap.Error(err))
+			return 0, err
+		}
+		for _, app := range apps {
+			if !app.Deleted {
+				count++
+			}
+		}
+		if count >= limit || cursor == "" || len(apps) == 0 {
+			return count, nil
+		}
+		opts.Cursor = cursor
+	}
+}
+
+func (c *Checker) countRecentDeployments(ctx context.Context, projectID string, limit int) (int, error) {
+	since := c.nowFunc().Add(-24 * time.Hour).Unix()
+	// Filter by UpdatedAt to use the same index as the deployment list page,
+	// deployments created before the period are excluded later.
+	opts := datastore.ListOptions{
+		Limit: listPageSize,
+		Filters: []datastore.ListFilter{
+			{
+				Field:    "ProjectId",
+				Operator: datastore.OperatorEqual,
+				Value:    projectID,
+			},
+			{
+				Field:    "UpdatedAt",
+				Operator: datastore.OperatorGreaterThanOrEqual,
+				Value:    since,
+			},
+		},
+		Orders: []datastore.Order{
+			{
+				Field:     "UpdatedAt",
+				Direction: datastore.Desc,
+			},
+			{
+				Field:     "Id",
+				Direction: datastore.Asc,
+			},
+		},
+	}
+	var count int
+	for {
+		deployments, cursor, err := c.deploymentStore.List(ctx, opts)
+		if err != nil {
+			c.logger.Error("failed to list deployments", zap.Error(err))
+			return 0, err
+		}
+		for _, d := range deployments {
+			if d.CreatedAt >= since {
+				count++
+			}
+		}
+		if count >= limit || cursor == "" || len(deployments) == 0 {
+			return count, nil
+		}
+		opts.Cursor = cursor
+	}
+}
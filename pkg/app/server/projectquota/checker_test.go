@@ -0,0 +1,163 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projectquota
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/datastore/datastoretest"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/redis/redistest"
+)
+
+type fakeConn struct {
+	redigo.Conn
+	usage map[string]int64
+}
+
+func (c *fakeConn) Do(cmd string, args ...interface{}) (interface{}, error) {
+	field := args[1].(string)
+	switch cmd {
+	case "HGET":
+		v, ok := c.usage[field]
+		if !ok {
+			return nil, nil
+		}
+		return v, nil
+	case "HINCRBY":
+		c.usage[field] += args[2].(int64)
+		return c.usage[field], nil
+	}
+	return nil, errors.New("unexpected command")
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func TestCheckApplications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := datastoretest.NewMockApplicationStore(ctrl)
+	c := &Checker{
+		quotas: config.ControlPlaneProjectQuotas{
+			Default: config.ProjectQuota{MaxApplications: 2},
+			Projects: []config.ProjectQuotaOverride{
+				{ProjectID: "large", ProjectQuota: config.ProjectQuota{MaxApplications: 3}},
+			},
+		},
+		applicationStore: store,
+		logger:           zap.NewNop(),
+	}
+
+	apps := []*model.Application{{Id: "1"}, {Id: "2", Deleted: true}, {Id: "3"}}
+	store.EXPECT().List(gomock.Any(), gomock.Any()).Return(apps[:2], "cursor", nil)
+	store.EXPECT().List(gomock.Any(), gomock.Any()).Return(apps[2:], "", nil)
+	err := c.CheckApplications(context.Background(), "project")
+	assert.ErrorIs(t, err, ErrExceeded)
+
+	store.EXPECT().List(gomock.Any(), gomock.Any()).Return(apps, "", nil)
+	err = c.CheckApplications(context.Background(), "large")
+	assert.NoError(t, err)
+}
+
+func TestCheckPipeds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := datastoretest.NewMockPipedStore(ctrl)
+	c := &Checker{
+		quotas: config.ControlPlaneProjectQuotas{
+			Default: config.ProjectQuota{MaxPipeds: 2},
+		},
+		pipedStore: store,
+		logger:     zap.NewNop(),
+	}
+
+	store.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*model.Piped{{Id: "1"}, {Id: "2", Disabled: true}}, nil)
+	assert.NoError(t, c.CheckPipeds(context.Background(), "project"))
+
+	store.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*model.Piped{{Id: "1"}, {Id: "2"}}, nil)
+	assert.ErrorIs(t, c.CheckPipeds(context.Background(), "project"), ErrExceeded)
+}
+
+func TestCheckDeployments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	store := datastoretest.NewMockDeploymentStore(ctrl)
+	c := &Checker{
+		quotas: config.ControlPlaneProjectQuotas{
+			Default: config.ProjectQuota{MaxDeploymentsPerDay: 2},
+		},
+		deploymentStore: store,
+		nowFunc:         func() time.Time { return now },
+		logger:          zap.NewNop(),
+	}
+
+	deployments := []*model.Deployment{
+		{Id: "1", CreatedAt: now.Add(-time.Hour).Unix()},
+		// Created before the period but updated recently.
+		{Id: "2", CreatedAt: now.Add(-25 * time.Hour).Unix()},
+	}
+	store.EXPECT().List(gomock.Any(), gomock.Any()).Return(deployments, "", nil)
+	assert.NoError(t, c.CheckDeployments(context.Background(), "project"))
+
+	deployments = append(deployments, &model.Deployment{Id: "3", CreatedAt: now.Unix()})
+	store.EXPECT().List(gomock.Any(), gomock.Any()).Return(deployments, "", nil)
+	assert.ErrorIs(t, c.CheckDeployments(context.Background(), "project"), ErrExceeded)
+}
+
+func TestFilestoreBytes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	conn := &fakeConn{usage: map[string]int64{}}
+	rd := redistest.NewMockRedis(ctrl)
+	rd.EXPECT().Get().Return(conn).AnyTimes()
+	c := &Checker{
+		quotas: config.ControlPlaneProjectQuotas{
+			Projects: []config.ProjectQuotaOverride{
+				{ProjectID: "project", ProjectQuota: config.ProjectQuota{MaxFilestoreBytes: "1Ki"}},
+			},
+		},
+		usageBackend: rd,
+		logger:       zap.NewNop(),
+	}
+	ctx := context.Background()
+
+	require.NoError(t, c.CheckFilestoreBytes(ctx, "project"))
+	require.NoError(t, c.AddFilestoreBytes(ctx, "project", 1000))
+	require.NoError(t, c.CheckFilestoreBytes(ctx, "project"))
+	require.NoError(t, c.AddFilestoreBytes(ctx, "project", 24))
+	assert.ErrorIs(t, c.CheckFilestoreBytes(ctx, "project"), ErrExceeded)
+
+	// The usage of the projects without quota is not recorded.
+	require.NoError(t, c.AddFilestoreBytes(ctx, "other", 2048))
+	assert.NoError(t, c.CheckFilestoreBytes(ctx, "other"))
+	assert.NotContains(t, conn.usage, "other")
+}
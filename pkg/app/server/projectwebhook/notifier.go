@@ -0,0 +1,191 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package projectwebhook provides a notifier to send the lifecycle events
+// of the administrative resources of a project to its configured webhooks.
+package projectwebhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/backoff"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	eventHeader     = "X-PipeCD-Event"
+	signatureHeader = "X-PipeCD-Signature"
+
+	queueSize      = 1000
+	maxRetries     = 3
+	requestTimeout = 10 * time.Second
+)
+
+// Resource represents the resource whose lifecycle event occurred.
+type Resource struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+	// Kind-specific information such as the platform provider of an application.
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Event is the payload sent to the webhooks.
+type Event struct {
+	// One of config.ProjectEvent* values.
+	Type      string   `json:"type"`
+	ProjectID string   `json:"projectId"`
+	Resource  Resource `json:"resource"`
+	// The user or API key which triggered the event.
+	Actor     string `json:"actor,omitempty"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+type webhook struct {
+	config.ControlPlaneProjectWebhook
+	signatureKey []byte
+}
+
+// Notifier delivers the events to the webhooks of their projects asynchronously.
+// A nil Notifier is valid and discards all events.
+type Notifier struct {
+	webhooks map[string][]webhook
+	eventCh  chan Event
+	client   *http.Client
+	nowFunc  func() time.Time
+	logger   *zap.Logger
+}
+
+// NewNotifier creates a new Notifier for the given webhooks.
+// The signature keys are loaded from their files here.
+func NewNotifier(cfgs []config.ControlPlaneProjectWebhook, logger *zap.Logger) (*Notifier, error) {
+	webhooks := make(map[string][]webhook, len(cfgs))
+	for _, cfg := range cfgs {
+		key, err := os.ReadFile(cfg.SignatureKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the signature key of webhook %s: %w", cfg.URL, err)
+		}
+		webhooks[cfg.ProjectID] = append(webhooks[cfg.ProjectID], webhook{
+			ControlPlaneProjectWebhook: cfg,
+			signatureKey:               []byte(strings.TrimSpace(string(key))),
+		})
+	}
+	return &Notifier{
+		webhooks: webhooks,
+		eventCh:  make(chan Event, queueSize),
+		client:   &http.Client{Timeout: requestTimeout},
+		nowFunc:  time.Now,
+		logger:   logger.Named("project-webhook-notifier"),
+	}, nil
+}
+
+// Notify queues the given event to be sent to the webhooks of its project.
+// It never blocks the caller, the event is dropped when the queue is full.
+func (n *Notifier) Notify(e Event) {
+	if n == nil || len(n.webhooks[e.ProjectID]) == 0 {
+		return
+	}
+	if e.Timestamp == 0 {
+		e.Timestamp = n.nowFunc().Unix()
+	}
+	select {
+	case n.eventCh <- e:
+	default:
+		n.logger.Warn("dropped a project event since the queue is full",
+			zap.String("project-id", e.ProjectID),
+			zap.String("event", e.Type),
+		)
+	}
+}
+
+// Run sends the queued events until the given context is done.
+func (n *Notifier) Run(ctx context.Context) error {
+	n.logger.Info("start running project webhook notifier")
+	for {
+		select {
+		case <-ctx.Done():
+			n.logger.Info("project webhook notifier has been stopped")
+			return nil
+		case e := <-n.eventCh:
+			n.send(ctx, e)
+		}
+	}
+}
+
+func (n *Notifier) send(ctx context.Context, e Event) {
+	body, err := json.Marshal(e)
+	if err != nil {
+		n.logger.Error("failed to marshal project event", zap.Error(err))
+		return
+	}
+	for _, w := range n.webhooks[e.ProjectID] {
+		if !w.Subscribes(e.Type) {
+			continue
+		}
+		retry := backoff.NewRetry(maxRetries, backoff.NewExponential(time.Second, 10*time.Second))
+		_, err := retry.Do(ctx, func() (interface{}, error) {
+			return nil, n.post(ctx, w, e.Type, body)
+		})
+		if err != nil {
+			n.logger.Error("failed to send project event to webhook",
+				zap.String("project-id", e.ProjectID),
+				zap.String("event", e.Type),
+				zap.String("url", w.URL),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func (n *Notifier) post(ctx context.Context, w webhook, event string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return backoff.NewError(err, false)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(eventHeader, event)
+	req.Header.Set(signatureHeader, "sha256="+sign(w.signatureKey, body))
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	err = fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	// Client errors are not going to be resolved by sending the same request again.
+	retriable := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+	return backoff.NewError(err, retriable)
+}
+
+// sign returns the hex encoded HMAC-SHA256 of the given body.
+func sign(key, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
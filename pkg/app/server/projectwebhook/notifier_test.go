@@ -0,0 +1,128 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projectwebhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+type received struct {
+	event     string
+	signature string
+	body      []byte
+}
+
+func TestNotifier(t *testing.T) {
+	t.Parallel()
+
+	ch := make(chan received, 10)
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Fail the first attempt to ensure that it is retried.
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		ch <- received{
+			event:     r.Header.Get(eventHeader),
+			signature: r.Header.Get(signatureHeader),
+			body:      body,
+		}
+	}))
+	defer srv.Close()
+
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(keyFile, []byte("secret\n"), 0600))
+
+	n, err := NewNotifier([]config.ControlPlaneProjectWebhook{
+		{
+			ProjectID:        "project-1",
+			URL:              srv.URL,
+			SignatureKeyFile: keyFile,
+			Events:           []string{config.ProjectEventApplicationRegistered},
+		},
+	}, zap.NewNop())
+	require.NoError(t, err)
+	n.nowFunc = func() time.Time { return time.Unix(100, 0) }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go n.Run(ctx)
+
+	// Neither unsubscribed events nor events of other projects are sent.
+	n.Notify(Event{Type: config.ProjectEventPipedDisabled, ProjectID: "project-1"})
+	n.Notify(Event{Type: config.ProjectEventApplicationRegistered, ProjectID: "project-2"})
+	n.Notify(Event{
+		Type:      config.ProjectEventApplicationRegistered,
+		ProjectID: "project-1",
+		Resource:  Resource{ID: "app-1", Name: "app"},
+		Actor:     "user",
+	})
+
+	var r received
+	select {
+	case r = <-ch:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the event")
+	}
+	assert.Equal(t, config.ProjectEventApplicationRegistered, r.event)
+	assert.Equal(t, "sha256="+sign([]byte("secret"), r.body), r.signature)
+
+	var e Event
+	require.NoError(t, json.Unmarshal(r.body, &e))
+	assert.Equal(t, Event{
+		Type:      config.ProjectEventApplicationRegistered,
+		ProjectID: "project-1",
+		Resource:  Resource{ID: "app-1", Name: "app"},
+		Actor:     "user",
+		Timestamp: 100,
+	}, e)
+	assert.Len(t, ch, 0)
+}
+
+func TestNilNotifier(t *testing.T) {
+	t.Parallel()
+
+	var n *Notifier
+	assert.NotPanics(t, func() {
+		n.Notify(Event{Type: config.ProjectEventAPIKeyCreated, ProjectID: "project-1"})
+	})
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	// echo -n '{"type":"PIPED_REGISTERED"}' | openssl dgst -sha256 -hmac key
+	assert.Equal(t,
+		"4cdcab15767a7753bd6184e7651d3dc250781672017bfe7bbb764f39e5b0fd08",
+		sign([]byte("key"), []byte(`{"type":"PIPED_REGISTERED"}`)),
+	)
+}
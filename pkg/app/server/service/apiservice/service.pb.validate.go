@@ -364,6 +364,10 @@ func (m *SyncApplicationRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for DryRun
+
+	// no validation rules for PromoteFromDeploymentId
+
 	if len(errors) > 0 {
 		return SyncApplicationRequestMultiError(errors)
 	}
@@ -2466,6 +2470,249 @@ var _ interface {
 	ErrorName() string
 } = UpdateApplicationResponseValidationError{}
 
+// Validate checks the field values on MigrateApplicationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *MigrateApplicationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MigrateApplicationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MigrateApplicationRequestMultiError, or nil if none found.
+func (m *MigrateApplicationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MigrateApplicationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
+		err := MigrateApplicationRequestValidationError{
+			field:  "ApplicationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPipedId()) < 1 {
+		err := MigrateApplicationRequestValidationError{
+			field:  "PipedId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PlatformProvider
+
+	if len(errors) > 0 {
+		return MigrateApplicationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MigrateApplicationRequestMultiError is an error wrapping multiple validation
+// errors returned by MigrateApplicationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type MigrateApplicationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MigrateApplicationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MigrateApplicationRequestMultiError) AllErrors() []error { return m }
+
+// MigrateApplicationRequestValidationError is the validation error returned by
+// MigrateApplicationRequest.Validate if the designated constraints aren't
+// met.
+type MigrateApplicationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MigrateApplicationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MigrateApplicationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MigrateApplicationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MigrateApplicationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MigrateApplicationRequestValidationError) ErrorName() string {
+	return "MigrateApplicationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MigrateApplicationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMigrateApplicationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MigrateApplicationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MigrateApplicationRequestValidationError{}
+
+// Validate checks the field values on MigrateApplicationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *MigrateApplicationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MigrateApplicationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MigrateApplicationResponseMultiError, or nil if none found.
+func (m *MigrateApplicationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MigrateApplicationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
+		err := MigrateApplicationResponseValidationError{
+			field:  "ApplicationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MigrateApplicationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MigrateApplicationResponseMultiError is an error wrapping multiple
+// validation errors returned by MigrateApplicationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type MigrateApplicationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MigrateApplicationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MigrateApplicationResponseMultiError) AllErrors() []error { return m }
+
+// MigrateApplicationResponseValidationError is the validation error returned
+// by MigrateApplicationResponse.Validate if the designated constraints aren't
+// met.
+type MigrateApplicationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MigrateApplicationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MigrateApplicationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MigrateApplicationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MigrateApplicationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MigrateApplicationResponseValidationError) ErrorName() string {
+	return "MigrateApplicationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MigrateApplicationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMigrateApplicationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MigrateApplicationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MigrateApplicationResponseValidationError{}
+
 // Validate checks the field values on DeleteApplicationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -3203,44 +3450,55 @@ var _ interface {
 	ErrorName() string
 } = UpdateApplicationDeployTargetsResponseValidationError{}
 
-// Validate checks the field values on GetDeploymentRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDeploymentRequest) Validate() error {
+// Validate checks the field values on UpdateApplicationLabelsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UpdateApplicationLabelsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDeploymentRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetDeploymentRequestMultiError, or nil if none found.
-func (m *GetDeploymentRequest) ValidateAll() error {
+// ValidateAll checks the field values on UpdateApplicationLabelsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateApplicationLabelsRequestMultiError, or nil if none found.
+func (m *UpdateApplicationLabelsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDeploymentRequest) validate(all bool) error {
+func (m *UpdateApplicationLabelsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DeploymentId
+	// no validation rules for ApplicationIds
+
+	// no validation rules for Selector
+
+	// no validation rules for AddLabels
+
+	// no validation rules for RemoveLabels
+
+	// no validation rules for PropagateToDeployments
+
+	// no validation rules for DryRun
 
 	if len(errors) > 0 {
-		return GetDeploymentRequestMultiError(errors)
+		return UpdateApplicationLabelsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDeploymentRequestMultiError is an error wrapping multiple validation
-// errors returned by GetDeploymentRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetDeploymentRequestMultiError []error
+// UpdateApplicationLabelsRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateApplicationLabelsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateApplicationLabelsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDeploymentRequestMultiError) Error() string {
+func (m UpdateApplicationLabelsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3249,11 +3507,12 @@ func (m GetDeploymentRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDeploymentRequestMultiError) AllErrors() []error { return m }
+func (m UpdateApplicationLabelsRequestMultiError) AllErrors() []error { return m }
 
-// GetDeploymentRequestValidationError is the validation error returned by
-// GetDeploymentRequest.Validate if the designated constraints aren't met.
-type GetDeploymentRequestValidationError struct {
+// UpdateApplicationLabelsRequestValidationError is the validation error
+// returned by UpdateApplicationLabelsRequest.Validate if the designated
+// constraints aren't met.
+type UpdateApplicationLabelsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3261,24 +3520,24 @@ type GetDeploymentRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDeploymentRequestValidationError) Field() string { return e.field }
+func (e UpdateApplicationLabelsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDeploymentRequestValidationError) Reason() string { return e.reason }
+func (e UpdateApplicationLabelsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDeploymentRequestValidationError) Cause() error { return e.cause }
+func (e UpdateApplicationLabelsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDeploymentRequestValidationError) Key() bool { return e.key }
+func (e UpdateApplicationLabelsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDeploymentRequestValidationError) ErrorName() string {
-	return "GetDeploymentRequestValidationError"
+func (e UpdateApplicationLabelsRequestValidationError) ErrorName() string {
+	return "UpdateApplicationLabelsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDeploymentRequestValidationError) Error() string {
+func (e UpdateApplicationLabelsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3290,14 +3549,14 @@ func (e GetDeploymentRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDeploymentRequest.%s: %s%s",
+		"invalid %sUpdateApplicationLabelsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDeploymentRequestValidationError{}
+var _ error = UpdateApplicationLabelsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3305,73 +3564,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDeploymentRequestValidationError{}
+} = UpdateApplicationLabelsRequestValidationError{}
 
-// Validate checks the field values on GetDeploymentResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDeploymentResponse) Validate() error {
+// Validate checks the field values on UpdateApplicationLabelsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UpdateApplicationLabelsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDeploymentResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetDeploymentResponseMultiError, or nil if none found.
-func (m *GetDeploymentResponse) ValidateAll() error {
+// ValidateAll checks the field values on UpdateApplicationLabelsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateApplicationLabelsResponseMultiError, or nil if none found.
+func (m *UpdateApplicationLabelsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDeploymentResponse) validate(all bool) error {
+func (m *UpdateApplicationLabelsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetDeployment()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetDeploymentResponseValidationError{
-					field:  "Deployment",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetDeploymentResponseValidationError{
-					field:  "Deployment",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetDeploymentResponseValidationError{
-				field:  "Deployment",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ApplicationIds
+
+	// no validation rules for UpdatedDeploymentCount
 
 	if len(errors) > 0 {
-		return GetDeploymentResponseMultiError(errors)
+		return UpdateApplicationLabelsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDeploymentResponseMultiError is an error wrapping multiple validation
-// errors returned by GetDeploymentResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetDeploymentResponseMultiError []error
+// UpdateApplicationLabelsResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateApplicationLabelsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateApplicationLabelsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDeploymentResponseMultiError) Error() string {
+func (m UpdateApplicationLabelsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3380,11 +3615,12 @@ func (m GetDeploymentResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDeploymentResponseMultiError) AllErrors() []error { return m }
+func (m UpdateApplicationLabelsResponseMultiError) AllErrors() []error { return m }
 
-// GetDeploymentResponseValidationError is the validation error returned by
-// GetDeploymentResponse.Validate if the designated constraints aren't met.
-type GetDeploymentResponseValidationError struct {
+// UpdateApplicationLabelsResponseValidationError is the validation error
+// returned by UpdateApplicationLabelsResponse.Validate if the designated
+// constraints aren't met.
+type UpdateApplicationLabelsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3392,24 +3628,24 @@ type GetDeploymentResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDeploymentResponseValidationError) Field() string { return e.field }
+func (e UpdateApplicationLabelsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDeploymentResponseValidationError) Reason() string { return e.reason }
+func (e UpdateApplicationLabelsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDeploymentResponseValidationError) Cause() error { return e.cause }
+func (e UpdateApplicationLabelsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDeploymentResponseValidationError) Key() bool { return e.key }
+func (e UpdateApplicationLabelsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDeploymentResponseValidationError) ErrorName() string {
-	return "GetDeploymentResponseValidationError"
+func (e UpdateApplicationLabelsResponseValidationError) ErrorName() string {
+	return "UpdateApplicationLabelsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDeploymentResponseValidationError) Error() string {
+func (e UpdateApplicationLabelsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3421,14 +3657,14 @@ func (e GetDeploymentResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDeploymentResponse.%s: %s%s",
+		"invalid %sUpdateApplicationLabelsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDeploymentResponseValidationError{}
+var _ error = UpdateApplicationLabelsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3436,52 +3672,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDeploymentResponseValidationError{}
+} = UpdateApplicationLabelsResponseValidationError{}
 
-// Validate checks the field values on ListDeploymentsRequest with the rules
+// Validate checks the field values on GetDeploymentRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListDeploymentsRequest) Validate() error {
+func (m *GetDeploymentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListDeploymentsRequest with the rules
+// ValidateAll checks the field values on GetDeploymentRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListDeploymentsRequestMultiError, or nil if none found.
-func (m *ListDeploymentsRequest) ValidateAll() error {
+// GetDeploymentRequestMultiError, or nil if none found.
+func (m *GetDeploymentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListDeploymentsRequest) validate(all bool) error {
+func (m *GetDeploymentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ApplicationName
-
-	// no validation rules for Labels
-
-	// no validation rules for Limit
-
-	// no validation rules for Cursor
+	// no validation rules for DeploymentId
 
 	if len(errors) > 0 {
-		return ListDeploymentsRequestMultiError(errors)
+		return GetDeploymentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListDeploymentsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListDeploymentsRequest.ValidateAll() if the designated
+// GetDeploymentRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDeploymentRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListDeploymentsRequestMultiError []error
+type GetDeploymentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListDeploymentsRequestMultiError) Error() string {
+func (m GetDeploymentRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3490,11 +3720,11 @@ func (m ListDeploymentsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListDeploymentsRequestMultiError) AllErrors() []error { return m }
+func (m GetDeploymentRequestMultiError) AllErrors() []error { return m }
 
-// ListDeploymentsRequestValidationError is the validation error returned by
-// ListDeploymentsRequest.Validate if the designated constraints aren't met.
-type ListDeploymentsRequestValidationError struct {
+// GetDeploymentRequestValidationError is the validation error returned by
+// GetDeploymentRequest.Validate if the designated constraints aren't met.
+type GetDeploymentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3502,24 +3732,24 @@ type ListDeploymentsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListDeploymentsRequestValidationError) Field() string { return e.field }
+func (e GetDeploymentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListDeploymentsRequestValidationError) Reason() string { return e.reason }
+func (e GetDeploymentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListDeploymentsRequestValidationError) Cause() error { return e.cause }
+func (e GetDeploymentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListDeploymentsRequestValidationError) Key() bool { return e.key }
+func (e GetDeploymentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListDeploymentsRequestValidationError) ErrorName() string {
-	return "ListDeploymentsRequestValidationError"
+func (e GetDeploymentRequestValidationError) ErrorName() string {
+	return "GetDeploymentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListDeploymentsRequestValidationError) Error() string {
+func (e GetDeploymentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3531,14 +3761,14 @@ func (e ListDeploymentsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListDeploymentsRequest.%s: %s%s",
+		"invalid %sGetDeploymentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListDeploymentsRequestValidationError{}
+var _ error = GetDeploymentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3546,80 +3776,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListDeploymentsRequestValidationError{}
+} = GetDeploymentRequestValidationError{}
 
-// Validate checks the field values on ListDeploymentsResponse with the rules
+// Validate checks the field values on GetDeploymentResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListDeploymentsResponse) Validate() error {
+func (m *GetDeploymentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListDeploymentsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetDeploymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListDeploymentsResponseMultiError, or nil if none found.
-func (m *ListDeploymentsResponse) ValidateAll() error {
+// GetDeploymentResponseMultiError, or nil if none found.
+func (m *GetDeploymentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListDeploymentsResponse) validate(all bool) error {
+func (m *GetDeploymentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDeployments() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListDeploymentsResponseValidationError{
-						field:  fmt.Sprintf("Deployments[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListDeploymentsResponseValidationError{
-						field:  fmt.Sprintf("Deployments[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetDeployment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDeploymentResponseValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListDeploymentsResponseValidationError{
-					field:  fmt.Sprintf("Deployments[%v]", idx),
+				errors = append(errors, GetDeploymentResponseValidationError{
+					field:  "Deployment",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDeploymentResponseValidationError{
+				field:  "Deployment",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for Cursor
-
 	if len(errors) > 0 {
-		return ListDeploymentsResponseMultiError(errors)
+		return GetDeploymentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListDeploymentsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListDeploymentsResponse.ValidateAll() if the designated
+// GetDeploymentResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDeploymentResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListDeploymentsResponseMultiError []error
+type GetDeploymentResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListDeploymentsResponseMultiError) Error() string {
+func (m GetDeploymentResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3628,11 +3851,11 @@ func (m ListDeploymentsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListDeploymentsResponseMultiError) AllErrors() []error { return m }
+func (m GetDeploymentResponseMultiError) AllErrors() []error { return m }
 
-// ListDeploymentsResponseValidationError is the validation error returned by
-// ListDeploymentsResponse.Validate if the designated constraints aren't met.
-type ListDeploymentsResponseValidationError struct {
+// GetDeploymentResponseValidationError is the validation error returned by
+// GetDeploymentResponse.Validate if the designated constraints aren't met.
+type GetDeploymentResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3640,24 +3863,24 @@ type ListDeploymentsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListDeploymentsResponseValidationError) Field() string { return e.field }
+func (e GetDeploymentResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListDeploymentsResponseValidationError) Reason() string { return e.reason }
+func (e GetDeploymentResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListDeploymentsResponseValidationError) Cause() error { return e.cause }
+func (e GetDeploymentResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListDeploymentsResponseValidationError) Key() bool { return e.key }
+func (e GetDeploymentResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListDeploymentsResponseValidationError) ErrorName() string {
-	return "ListDeploymentsResponseValidationError"
+func (e GetDeploymentResponseValidationError) ErrorName() string {
+	return "GetDeploymentResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListDeploymentsResponseValidationError) Error() string {
+func (e GetDeploymentResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3669,14 +3892,14 @@ func (e ListDeploymentsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListDeploymentsResponse.%s: %s%s",
+		"invalid %sGetDeploymentResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListDeploymentsResponseValidationError{}
+var _ error = GetDeploymentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3684,33 +3907,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListDeploymentsResponseValidationError{}
+} = GetDeploymentResponseValidationError{}
 
-// Validate checks the field values on GetCommandRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetCommandRequest) Validate() error {
+// Validate checks the field values on GetDeploymentDiffRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetDeploymentDiffRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCommandRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetDeploymentDiffRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCommandRequestMultiError, or nil if none found.
-func (m *GetCommandRequest) ValidateAll() error {
+// GetDeploymentDiffRequestMultiError, or nil if none found.
+func (m *GetDeploymentDiffRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCommandRequest) validate(all bool) error {
+func (m *GetDeploymentDiffRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetCommandId()) < 1 {
-		err := GetCommandRequestValidationError{
-			field:  "CommandId",
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := GetDeploymentDiffRequestValidationError{
+			field:  "DeploymentId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -3720,19 +3944,19 @@ func (m *GetCommandRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetCommandRequestMultiError(errors)
+		return GetDeploymentDiffRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCommandRequestMultiError is an error wrapping multiple validation errors
-// returned by GetCommandRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetCommandRequestMultiError []error
+// GetDeploymentDiffRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDeploymentDiffRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDeploymentDiffRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCommandRequestMultiError) Error() string {
+func (m GetDeploymentDiffRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3741,11 +3965,11 @@ func (m GetCommandRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCommandRequestMultiError) AllErrors() []error { return m }
+func (m GetDeploymentDiffRequestMultiError) AllErrors() []error { return m }
 
-// GetCommandRequestValidationError is the validation error returned by
-// GetCommandRequest.Validate if the designated constraints aren't met.
-type GetCommandRequestValidationError struct {
+// GetDeploymentDiffRequestValidationError is the validation error returned by
+// GetDeploymentDiffRequest.Validate if the designated constraints aren't met.
+type GetDeploymentDiffRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3753,24 +3977,24 @@ type GetCommandRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCommandRequestValidationError) Field() string { return e.field }
+func (e GetDeploymentDiffRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCommandRequestValidationError) Reason() string { return e.reason }
+func (e GetDeploymentDiffRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCommandRequestValidationError) Cause() error { return e.cause }
+func (e GetDeploymentDiffRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCommandRequestValidationError) Key() bool { return e.key }
+func (e GetDeploymentDiffRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCommandRequestValidationError) ErrorName() string {
-	return "GetCommandRequestValidationError"
+func (e GetDeploymentDiffRequestValidationError) ErrorName() string {
+	return "GetDeploymentDiffRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCommandRequestValidationError) Error() string {
+func (e GetDeploymentDiffRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3782,14 +4006,14 @@ func (e GetCommandRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCommandRequest.%s: %s%s",
+		"invalid %sGetDeploymentDiffRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCommandRequestValidationError{}
+var _ error = GetDeploymentDiffRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3797,24 +4021,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCommandRequestValidationError{}
+} = GetDeploymentDiffRequestValidationError{}
 
-// Validate checks the field values on GetCommandResponse with the rules
+// Validate checks the field values on GetDeploymentDiffResponse with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCommandResponse) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetDeploymentDiffResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCommandResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetDeploymentDiffResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCommandResponseMultiError, or nil if none found.
-func (m *GetCommandResponse) ValidateAll() error {
+// GetDeploymentDiffResponseMultiError, or nil if none found.
+func (m *GetDeploymentDiffResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCommandResponse) validate(all bool) error {
+func (m *GetDeploymentDiffResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3822,28 +4047,28 @@ func (m *GetCommandResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetCommand()).(type) {
+		switch v := interface{}(m.GetDiff()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCommandResponseValidationError{
-					field:  "Command",
+				errors = append(errors, GetDeploymentDiffResponseValidationError{
+					field:  "Diff",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCommandResponseValidationError{
-					field:  "Command",
+				errors = append(errors, GetDeploymentDiffResponseValidationError{
+					field:  "Diff",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCommand()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDiff()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetCommandResponseValidationError{
-				field:  "Command",
+			return GetDeploymentDiffResponseValidationError{
+				field:  "Diff",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3851,19 +4076,19 @@ func (m *GetCommandResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetCommandResponseMultiError(errors)
+		return GetDeploymentDiffResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCommandResponseMultiError is an error wrapping multiple validation errors
-// returned by GetCommandResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetCommandResponseMultiError []error
+// GetDeploymentDiffResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDeploymentDiffResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetDeploymentDiffResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCommandResponseMultiError) Error() string {
+func (m GetDeploymentDiffResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3872,7 +4097,2634 @@ func (m GetCommandResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCommandResponseMultiError) AllErrors() []error { return m }
+func (m GetDeploymentDiffResponseMultiError) AllErrors() []error { return m }
+
+// GetDeploymentDiffResponseValidationError is the validation error returned by
+// GetDeploymentDiffResponse.Validate if the designated constraints aren't
+// met.
+type GetDeploymentDiffResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeploymentDiffResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeploymentDiffResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeploymentDiffResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeploymentDiffResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeploymentDiffResponseValidationError) ErrorName() string {
+	return "GetDeploymentDiffResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeploymentDiffResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeploymentDiffResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeploymentDiffResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeploymentDiffResponseValidationError{}
+
+// Validate checks the field values on ListStageArtifactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListStageArtifactsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStageArtifactsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStageArtifactsRequestMultiError, or nil if none found.
+func (m *ListStageArtifactsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStageArtifactsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := ListStageArtifactsRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := ListStageArtifactsRequestValidationError{
+			field:  "StageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListStageArtifactsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStageArtifactsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListStageArtifactsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListStageArtifactsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStageArtifactsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStageArtifactsRequestMultiError) AllErrors() []error { return m }
+
+// ListStageArtifactsRequestValidationError is the validation error returned by
+// ListStageArtifactsRequest.Validate if the designated constraints aren't
+// met.
+type ListStageArtifactsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStageArtifactsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStageArtifactsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStageArtifactsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStageArtifactsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStageArtifactsRequestValidationError) ErrorName() string {
+	return "ListStageArtifactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStageArtifactsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStageArtifactsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStageArtifactsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStageArtifactsRequestValidationError{}
+
+// Validate checks the field values on ListStageArtifactsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListStageArtifactsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStageArtifactsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStageArtifactsResponseMultiError, or nil if none found.
+func (m *ListStageArtifactsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStageArtifactsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetArtifacts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStageArtifactsResponseValidationError{
+						field:  fmt.Sprintf("Artifacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStageArtifactsResponseValidationError{
+						field:  fmt.Sprintf("Artifacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStageArtifactsResponseValidationError{
+					field:  fmt.Sprintf("Artifacts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListStageArtifactsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStageArtifactsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListStageArtifactsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListStageArtifactsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStageArtifactsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStageArtifactsResponseMultiError) AllErrors() []error { return m }
+
+// ListStageArtifactsResponseValidationError is the validation error returned
+// by ListStageArtifactsResponse.Validate if the designated constraints aren't
+// met.
+type ListStageArtifactsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStageArtifactsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStageArtifactsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStageArtifactsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStageArtifactsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStageArtifactsResponseValidationError) ErrorName() string {
+	return "ListStageArtifactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStageArtifactsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStageArtifactsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStageArtifactsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStageArtifactsResponseValidationError{}
+
+// Validate checks the field values on GetStageArtifactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetStageArtifactRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStageArtifactRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStageArtifactRequestMultiError, or nil if none found.
+func (m *GetStageArtifactRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStageArtifactRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := GetStageArtifactRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := GetStageArtifactRequestValidationError{
+			field:  "StageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := GetStageArtifactRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetStageArtifactRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStageArtifactRequestMultiError is an error wrapping multiple validation
+// errors returned by GetStageArtifactRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetStageArtifactRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStageArtifactRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStageArtifactRequestMultiError) AllErrors() []error { return m }
+
+// GetStageArtifactRequestValidationError is the validation error returned by
+// GetStageArtifactRequest.Validate if the designated constraints aren't met.
+type GetStageArtifactRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStageArtifactRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStageArtifactRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStageArtifactRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStageArtifactRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStageArtifactRequestValidationError) ErrorName() string {
+	return "GetStageArtifactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStageArtifactRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStageArtifactRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStageArtifactRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStageArtifactRequestValidationError{}
+
+// Validate checks the field values on GetStageArtifactResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetStageArtifactResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStageArtifactResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStageArtifactResponseMultiError, or nil if none found.
+func (m *GetStageArtifactResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStageArtifactResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArtifact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetStageArtifactResponseValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetStageArtifactResponseValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetStageArtifactResponseValidationError{
+				field:  "Artifact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return GetStageArtifactResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStageArtifactResponseMultiError is an error wrapping multiple validation
+// errors returned by GetStageArtifactResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetStageArtifactResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStageArtifactResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStageArtifactResponseMultiError) AllErrors() []error { return m }
+
+// GetStageArtifactResponseValidationError is the validation error returned by
+// GetStageArtifactResponse.Validate if the designated constraints aren't met.
+type GetStageArtifactResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStageArtifactResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStageArtifactResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStageArtifactResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStageArtifactResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStageArtifactResponseValidationError) ErrorName() string {
+	return "GetStageArtifactResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStageArtifactResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStageArtifactResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStageArtifactResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStageArtifactResponseValidationError{}
+
+// Validate checks the field values on ListDeploymentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeploymentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeploymentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeploymentsRequestMultiError, or nil if none found.
+func (m *ListDeploymentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeploymentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApplicationName
+
+	// no validation rules for Labels
+
+	// no validation rules for Limit
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return ListDeploymentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeploymentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeploymentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeploymentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeploymentsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeploymentsRequestMultiError) AllErrors() []error { return m }
+
+// ListDeploymentsRequestValidationError is the validation error returned by
+// ListDeploymentsRequest.Validate if the designated constraints aren't met.
+type ListDeploymentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeploymentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeploymentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeploymentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeploymentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeploymentsRequestValidationError) ErrorName() string {
+	return "ListDeploymentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeploymentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeploymentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeploymentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeploymentsRequestValidationError{}
+
+// Validate checks the field values on ListDeploymentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeploymentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeploymentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeploymentsResponseMultiError, or nil if none found.
+func (m *ListDeploymentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeploymentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeployments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeploymentsResponseValidationError{
+						field:  fmt.Sprintf("Deployments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeploymentsResponseValidationError{
+						field:  fmt.Sprintf("Deployments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeploymentsResponseValidationError{
+					field:  fmt.Sprintf("Deployments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return ListDeploymentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeploymentsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListDeploymentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeploymentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeploymentsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeploymentsResponseMultiError) AllErrors() []error { return m }
+
+// ListDeploymentsResponseValidationError is the validation error returned by
+// ListDeploymentsResponse.Validate if the designated constraints aren't met.
+type ListDeploymentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeploymentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeploymentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeploymentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeploymentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeploymentsResponseValidationError) ErrorName() string {
+	return "ListDeploymentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeploymentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeploymentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeploymentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeploymentsResponseValidationError{}
+
+// Validate checks the field values on RetryDeploymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RetryDeploymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryDeploymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetryDeploymentRequestMultiError, or nil if none found.
+func (m *RetryDeploymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryDeploymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := RetryDeploymentRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RetryDeploymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetryDeploymentRequestMultiError is an error wrapping multiple validation
+// errors returned by RetryDeploymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RetryDeploymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryDeploymentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryDeploymentRequestMultiError) AllErrors() []error { return m }
+
+// RetryDeploymentRequestValidationError is the validation error returned by
+// RetryDeploymentRequest.Validate if the designated constraints aren't met.
+type RetryDeploymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryDeploymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryDeploymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryDeploymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryDeploymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryDeploymentRequestValidationError) ErrorName() string {
+	return "RetryDeploymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetryDeploymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryDeploymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryDeploymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryDeploymentRequestValidationError{}
+
+// Validate checks the field values on RetryDeploymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RetryDeploymentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryDeploymentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetryDeploymentResponseMultiError, or nil if none found.
+func (m *RetryDeploymentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryDeploymentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CommandId
+
+	if len(errors) > 0 {
+		return RetryDeploymentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetryDeploymentResponseMultiError is an error wrapping multiple validation
+// errors returned by RetryDeploymentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RetryDeploymentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryDeploymentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryDeploymentResponseMultiError) AllErrors() []error { return m }
+
+// RetryDeploymentResponseValidationError is the validation error returned by
+// RetryDeploymentResponse.Validate if the designated constraints aren't met.
+type RetryDeploymentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryDeploymentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryDeploymentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryDeploymentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryDeploymentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryDeploymentResponseValidationError) ErrorName() string {
+	return "RetryDeploymentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetryDeploymentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryDeploymentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryDeploymentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryDeploymentResponseValidationError{}
+
+// Validate checks the field values on ReportStageResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ReportStageResultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportStageResultRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportStageResultRequestMultiError, or nil if none found.
+func (m *ReportStageResultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportStageResultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := ReportStageResultRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := ReportStageResultRequestValidationError{
+			field:  "StageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := model.Command_ReportStageResult_Result_name[int32(m.GetResult())]; !ok {
+		err := ReportStageResultRequestValidationError{
+			field:  "Result",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ReportStageResultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportStageResultRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportStageResultRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReportStageResultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportStageResultRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportStageResultRequestMultiError) AllErrors() []error { return m }
+
+// ReportStageResultRequestValidationError is the validation error returned by
+// ReportStageResultRequest.Validate if the designated constraints aren't met.
+type ReportStageResultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportStageResultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportStageResultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportStageResultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportStageResultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportStageResultRequestValidationError) ErrorName() string {
+	return "ReportStageResultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportStageResultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportStageResultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportStageResultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportStageResultRequestValidationError{}
+
+// Validate checks the field values on ReportStageResultResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ReportStageResultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportStageResultResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportStageResultResponseMultiError, or nil if none found.
+func (m *ReportStageResultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportStageResultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CommandId
+
+	if len(errors) > 0 {
+		return ReportStageResultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportStageResultResponseMultiError is an error wrapping multiple validation
+// errors returned by ReportStageResultResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ReportStageResultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportStageResultResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportStageResultResponseMultiError) AllErrors() []error { return m }
+
+// ReportStageResultResponseValidationError is the validation error returned by
+// ReportStageResultResponse.Validate if the designated constraints aren't
+// met.
+type ReportStageResultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportStageResultResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportStageResultResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportStageResultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportStageResultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportStageResultResponseValidationError) ErrorName() string {
+	return "ReportStageResultResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportStageResultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportStageResultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportStageResultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportStageResultResponseValidationError{}
+
+// Validate checks the field values on ApproveStageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ApproveStageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveStageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveStageRequestMultiError, or nil if none found.
+func (m *ApproveStageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveStageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := ApproveStageRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := ApproveStageRequestValidationError{
+			field:  "StageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetApproverToken()) < 1 {
+		err := ApproveStageRequestValidationError{
+			field:  "ApproverToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ApproveStageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveStageRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveStageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveStageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveStageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveStageRequestMultiError) AllErrors() []error { return m }
+
+// ApproveStageRequestValidationError is the validation error returned by
+// ApproveStageRequest.Validate if the designated constraints aren't met.
+type ApproveStageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveStageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveStageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveStageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveStageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveStageRequestValidationError) ErrorName() string {
+	return "ApproveStageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveStageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveStageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveStageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveStageRequestValidationError{}
+
+// Validate checks the field values on ApproveStageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ApproveStageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveStageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveStageResponseMultiError, or nil if none found.
+func (m *ApproveStageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveStageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CommandId
+
+	if len(errors) > 0 {
+		return ApproveStageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveStageResponseMultiError is an error wrapping multiple validation
+// errors returned by ApproveStageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveStageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveStageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveStageResponseMultiError) AllErrors() []error { return m }
+
+// ApproveStageResponseValidationError is the validation error returned by
+// ApproveStageResponse.Validate if the designated constraints aren't met.
+type ApproveStageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveStageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveStageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveStageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveStageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveStageResponseValidationError) ErrorName() string {
+	return "ApproveStageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveStageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveStageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveStageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveStageResponseValidationError{}
+
+// Validate checks the field values on ApprovalSelector with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ApprovalSelector) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApprovalSelector with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApprovalSelectorMultiError, or nil if none found.
+func (m *ApprovalSelector) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApprovalSelector) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApplicationName
+
+	// no validation rules for Labels
+
+	if len(errors) > 0 {
+		return ApprovalSelectorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApprovalSelectorMultiError is an error wrapping multiple validation errors
+// returned by ApprovalSelector.ValidateAll() if the designated constraints
+// aren't met.
+type ApprovalSelectorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApprovalSelectorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApprovalSelectorMultiError) AllErrors() []error { return m }
+
+// ApprovalSelectorValidationError is the validation error returned by
+// ApprovalSelector.Validate if the designated constraints aren't met.
+type ApprovalSelectorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApprovalSelectorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApprovalSelectorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApprovalSelectorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApprovalSelectorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApprovalSelectorValidationError) ErrorName() string { return "ApprovalSelectorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ApprovalSelectorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApprovalSelector.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApprovalSelectorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApprovalSelectorValidationError{}
+
+// Validate checks the field values on PendingApproval with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PendingApproval) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PendingApproval with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PendingApprovalMultiError, or nil if none found.
+func (m *PendingApproval) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PendingApproval) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeploymentId
+
+	// no validation rules for StageId
+
+	// no validation rules for ApplicationId
+
+	// no validation rules for ApplicationName
+
+	// no validation rules for Kind
+
+	// no validation rules for Labels
+
+	// no validation rules for Summary
+
+	if len(errors) > 0 {
+		return PendingApprovalMultiError(errors)
+	}
+
+	return nil
+}
+
+// PendingApprovalMultiError is an error wrapping multiple validation errors
+// returned by PendingApproval.ValidateAll() if the designated constraints
+// aren't met.
+type PendingApprovalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PendingApprovalMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PendingApprovalMultiError) AllErrors() []error { return m }
+
+// PendingApprovalValidationError is the validation error returned by
+// PendingApproval.Validate if the designated constraints aren't met.
+type PendingApprovalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PendingApprovalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PendingApprovalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PendingApprovalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PendingApprovalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PendingApprovalValidationError) ErrorName() string { return "PendingApprovalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PendingApprovalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPendingApproval.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PendingApprovalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PendingApprovalValidationError{}
+
+// Validate checks the field values on ListPendingApprovalsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListPendingApprovalsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingApprovalsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingApprovalsRequestMultiError, or nil if none found.
+func (m *ListPendingApprovalsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingApprovalsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSelector()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListPendingApprovalsRequestValidationError{
+					field:  "Selector",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListPendingApprovalsRequestValidationError{
+					field:  "Selector",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSelector()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListPendingApprovalsRequestValidationError{
+				field:  "Selector",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListPendingApprovalsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingApprovalsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListPendingApprovalsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListPendingApprovalsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingApprovalsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingApprovalsRequestMultiError) AllErrors() []error { return m }
+
+// ListPendingApprovalsRequestValidationError is the validation error returned
+// by ListPendingApprovalsRequest.Validate if the designated constraints
+// aren't met.
+type ListPendingApprovalsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingApprovalsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingApprovalsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingApprovalsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingApprovalsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingApprovalsRequestValidationError) ErrorName() string {
+	return "ListPendingApprovalsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingApprovalsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingApprovalsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingApprovalsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingApprovalsRequestValidationError{}
+
+// Validate checks the field values on ListPendingApprovalsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListPendingApprovalsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingApprovalsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPendingApprovalsResponseMultiError, or nil if none found.
+func (m *ListPendingApprovalsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingApprovalsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetApprovals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPendingApprovalsResponseValidationError{
+						field:  fmt.Sprintf("Approvals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPendingApprovalsResponseValidationError{
+						field:  fmt.Sprintf("Approvals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPendingApprovalsResponseValidationError{
+					field:  fmt.Sprintf("Approvals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPendingApprovalsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingApprovalsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPendingApprovalsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListPendingApprovalsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingApprovalsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingApprovalsResponseMultiError) AllErrors() []error { return m }
+
+// ListPendingApprovalsResponseValidationError is the validation error returned
+// by ListPendingApprovalsResponse.Validate if the designated constraints
+// aren't met.
+type ListPendingApprovalsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingApprovalsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingApprovalsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingApprovalsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingApprovalsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingApprovalsResponseValidationError) ErrorName() string {
+	return "ListPendingApprovalsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingApprovalsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingApprovalsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingApprovalsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingApprovalsResponseValidationError{}
+
+// Validate checks the field values on ApproveStagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ApproveStagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveStagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveStagesRequestMultiError, or nil if none found.
+func (m *ApproveStagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveStagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSelector() == nil {
+		err := ApproveStagesRequestValidationError{
+			field:  "Selector",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSelector()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveStagesRequestValidationError{
+					field:  "Selector",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveStagesRequestValidationError{
+					field:  "Selector",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSelector()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveStagesRequestValidationError{
+				field:  "Selector",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetApproverToken()) < 1 {
+		err := ApproveStagesRequestValidationError{
+			field:  "ApproverToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Reject
+
+	// no validation rules for Comment
+
+	if len(errors) > 0 {
+		return ApproveStagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveStagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveStagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveStagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveStagesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveStagesRequestMultiError) AllErrors() []error { return m }
+
+// ApproveStagesRequestValidationError is the validation error returned by
+// ApproveStagesRequest.Validate if the designated constraints aren't met.
+type ApproveStagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveStagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveStagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveStagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveStagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveStagesRequestValidationError) ErrorName() string {
+	return "ApproveStagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveStagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveStagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveStagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveStagesRequestValidationError{}
+
+// Validate checks the field values on ApproveStagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ApproveStagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveStagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveStagesResponseMultiError, or nil if none found.
+func (m *ApproveStagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveStagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApproveStagesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApproveStagesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ApproveStagesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ApproveStagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveStagesResponseMultiError is an error wrapping multiple validation
+// errors returned by ApproveStagesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveStagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveStagesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveStagesResponseMultiError) AllErrors() []error { return m }
+
+// ApproveStagesResponseValidationError is the validation error returned by
+// ApproveStagesResponse.Validate if the designated constraints aren't met.
+type ApproveStagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveStagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveStagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveStagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveStagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveStagesResponseValidationError) ErrorName() string {
+	return "ApproveStagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveStagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveStagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveStagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveStagesResponseValidationError{}
+
+// Validate checks the field values on ApproveStagesResponse.Result with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ApproveStagesResponse_Result) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveStagesResponse.Result with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveStagesResponse_ResultMultiError, or nil if none found.
+func (m *ApproveStagesResponse_Result) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveStagesResponse_Result) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeploymentId
+
+	// no validation rules for StageId
+
+	// no validation rules for CommandId
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return ApproveStagesResponse_ResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveStagesResponse_ResultMultiError is an error wrapping multiple
+// validation errors returned by ApproveStagesResponse.Result.ValidateAll() if
+// the designated constraints aren't met.
+type ApproveStagesResponse_ResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveStagesResponse_ResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveStagesResponse_ResultMultiError) AllErrors() []error { return m }
+
+// ApproveStagesResponse_ResultValidationError is the validation error returned
+// by ApproveStagesResponse.Result.Validate if the designated constraints
+// aren't met.
+type ApproveStagesResponse_ResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveStagesResponse_ResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveStagesResponse_ResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveStagesResponse_ResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveStagesResponse_ResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveStagesResponse_ResultValidationError) ErrorName() string {
+	return "ApproveStagesResponse_ResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveStagesResponse_ResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveStagesResponse.Result.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveStagesResponse_ResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveStagesResponse_ResultValidationError{}
+
+// Validate checks the field values on GetCommandRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetCommandRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCommandRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCommandRequestMultiError, or nil if none found.
+func (m *GetCommandRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCommandRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCommandId()) < 1 {
+		err := GetCommandRequestValidationError{
+			field:  "CommandId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCommandRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCommandRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCommandRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCommandRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCommandRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCommandRequestMultiError) AllErrors() []error { return m }
+
+// GetCommandRequestValidationError is the validation error returned by
+// GetCommandRequest.Validate if the designated constraints aren't met.
+type GetCommandRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCommandRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCommandRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCommandRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCommandRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCommandRequestValidationError) ErrorName() string {
+	return "GetCommandRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCommandRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCommandRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCommandRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCommandRequestValidationError{}
+
+// Validate checks the field values on GetCommandResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCommandResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCommandResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCommandResponseMultiError, or nil if none found.
+func (m *GetCommandResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCommandResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCommand()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCommandResponseValidationError{
+					field:  "Command",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCommandResponseValidationError{
+					field:  "Command",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommand()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCommandResponseValidationError{
+				field:  "Command",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCommandResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCommandResponseMultiError is an error wrapping multiple validation errors
+// returned by GetCommandResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetCommandResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCommandResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCommandResponseMultiError) AllErrors() []error { return m }
 
 // GetCommandResponseValidationError is the validation error returned by
 // GetCommandResponse.Validate if the designated constraints aren't met.
@@ -3884,24 +6736,454 @@ type GetCommandResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCommandResponseValidationError) Field() string { return e.field }
+func (e GetCommandResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCommandResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCommandResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCommandResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCommandResponseValidationError) ErrorName() string {
+	return "GetCommandResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCommandResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCommandResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCommandResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCommandResponseValidationError{}
+
+// Validate checks the field values on EnablePipedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnablePipedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnablePipedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnablePipedRequestMultiError, or nil if none found.
+func (m *EnablePipedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnablePipedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPipedId()) < 1 {
+		err := EnablePipedRequestValidationError{
+			field:  "PipedId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EnablePipedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnablePipedRequestMultiError is an error wrapping multiple validation errors
+// returned by EnablePipedRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EnablePipedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnablePipedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnablePipedRequestMultiError) AllErrors() []error { return m }
+
+// EnablePipedRequestValidationError is the validation error returned by
+// EnablePipedRequest.Validate if the designated constraints aren't met.
+type EnablePipedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnablePipedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnablePipedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnablePipedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnablePipedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnablePipedRequestValidationError) ErrorName() string {
+	return "EnablePipedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnablePipedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnablePipedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnablePipedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnablePipedRequestValidationError{}
+
+// Validate checks the field values on EnablePipedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnablePipedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnablePipedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnablePipedResponseMultiError, or nil if none found.
+func (m *EnablePipedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnablePipedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return EnablePipedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnablePipedResponseMultiError is an error wrapping multiple validation
+// errors returned by EnablePipedResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EnablePipedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnablePipedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnablePipedResponseMultiError) AllErrors() []error { return m }
+
+// EnablePipedResponseValidationError is the validation error returned by
+// EnablePipedResponse.Validate if the designated constraints aren't met.
+type EnablePipedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnablePipedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnablePipedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnablePipedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnablePipedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnablePipedResponseValidationError) ErrorName() string {
+	return "EnablePipedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnablePipedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnablePipedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnablePipedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnablePipedResponseValidationError{}
+
+// Validate checks the field values on DisablePipedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisablePipedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisablePipedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisablePipedRequestMultiError, or nil if none found.
+func (m *DisablePipedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisablePipedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPipedId()) < 1 {
+		err := DisablePipedRequestValidationError{
+			field:  "PipedId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DisablePipedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisablePipedRequestMultiError is an error wrapping multiple validation
+// errors returned by DisablePipedRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DisablePipedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisablePipedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisablePipedRequestMultiError) AllErrors() []error { return m }
+
+// DisablePipedRequestValidationError is the validation error returned by
+// DisablePipedRequest.Validate if the designated constraints aren't met.
+type DisablePipedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisablePipedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisablePipedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisablePipedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisablePipedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisablePipedRequestValidationError) ErrorName() string {
+	return "DisablePipedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisablePipedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisablePipedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisablePipedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisablePipedRequestValidationError{}
+
+// Validate checks the field values on DisablePipedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisablePipedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisablePipedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisablePipedResponseMultiError, or nil if none found.
+func (m *DisablePipedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisablePipedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DisablePipedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisablePipedResponseMultiError is an error wrapping multiple validation
+// errors returned by DisablePipedResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DisablePipedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisablePipedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisablePipedResponseMultiError) AllErrors() []error { return m }
+
+// DisablePipedResponseValidationError is the validation error returned by
+// DisablePipedResponse.Validate if the designated constraints aren't met.
+type DisablePipedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisablePipedResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCommandResponseValidationError) Reason() string { return e.reason }
+func (e DisablePipedResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCommandResponseValidationError) Cause() error { return e.cause }
+func (e DisablePipedResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCommandResponseValidationError) Key() bool { return e.key }
+func (e DisablePipedResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCommandResponseValidationError) ErrorName() string {
-	return "GetCommandResponseValidationError"
+func (e DisablePipedResponseValidationError) ErrorName() string {
+	return "DisablePipedResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCommandResponseValidationError) Error() string {
+func (e DisablePipedResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3913,14 +7195,14 @@ func (e GetCommandResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCommandResponse.%s: %s%s",
+		"invalid %sDisablePipedResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCommandResponseValidationError{}
+var _ error = DisablePipedResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3928,34 +7210,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCommandResponseValidationError{}
+} = DisablePipedResponseValidationError{}
 
-// Validate checks the field values on EnablePipedRequest with the rules
+// Validate checks the field values on UpgradePipedsRequest with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EnablePipedRequest) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UpgradePipedsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EnablePipedRequest with the rules
+// ValidateAll checks the field values on UpgradePipedsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EnablePipedRequestMultiError, or nil if none found.
-func (m *EnablePipedRequest) ValidateAll() error {
+// UpgradePipedsRequestMultiError, or nil if none found.
+func (m *UpgradePipedsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EnablePipedRequest) validate(all bool) error {
+func (m *UpgradePipedsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetPipedId()) < 1 {
-		err := EnablePipedRequestValidationError{
-			field:  "PipedId",
-			reason: "value length must be at least 1 runes",
+	if !strings.HasPrefix(m.GetVersion(), "v") {
+		err := UpgradePipedsRequestValidationError{
+			field:  "Version",
+			reason: "value does not have prefix \"v\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PipedIds
+
+	// no validation rules for Selector
+
+	if val := m.GetPercentage(); val < 1 || val > 100 {
+		err := UpgradePipedsRequestValidationError{
+			field:  "Percentage",
+			reason: "value must be inside range [1, 100]",
 		}
 		if !all {
 			return err
@@ -3963,20 +7261,22 @@ func (m *EnablePipedRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for DryRun
+
 	if len(errors) > 0 {
-		return EnablePipedRequestMultiError(errors)
+		return UpgradePipedsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// EnablePipedRequestMultiError is an error wrapping multiple validation errors
-// returned by EnablePipedRequest.ValidateAll() if the designated constraints
-// aren't met.
-type EnablePipedRequestMultiError []error
+// UpgradePipedsRequestMultiError is an error wrapping multiple validation
+// errors returned by UpgradePipedsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpgradePipedsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EnablePipedRequestMultiError) Error() string {
+func (m UpgradePipedsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3985,11 +7285,11 @@ func (m EnablePipedRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EnablePipedRequestMultiError) AllErrors() []error { return m }
+func (m UpgradePipedsRequestMultiError) AllErrors() []error { return m }
 
-// EnablePipedRequestValidationError is the validation error returned by
-// EnablePipedRequest.Validate if the designated constraints aren't met.
-type EnablePipedRequestValidationError struct {
+// UpgradePipedsRequestValidationError is the validation error returned by
+// UpgradePipedsRequest.Validate if the designated constraints aren't met.
+type UpgradePipedsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3997,24 +7297,24 @@ type EnablePipedRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e EnablePipedRequestValidationError) Field() string { return e.field }
+func (e UpgradePipedsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EnablePipedRequestValidationError) Reason() string { return e.reason }
+func (e UpgradePipedsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EnablePipedRequestValidationError) Cause() error { return e.cause }
+func (e UpgradePipedsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EnablePipedRequestValidationError) Key() bool { return e.key }
+func (e UpgradePipedsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EnablePipedRequestValidationError) ErrorName() string {
-	return "EnablePipedRequestValidationError"
+func (e UpgradePipedsRequestValidationError) ErrorName() string {
+	return "UpgradePipedsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EnablePipedRequestValidationError) Error() string {
+func (e UpgradePipedsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4026,14 +7326,14 @@ func (e EnablePipedRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEnablePipedRequest.%s: %s%s",
+		"invalid %sUpgradePipedsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EnablePipedRequestValidationError{}
+var _ error = UpgradePipedsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4041,44 +7341,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EnablePipedRequestValidationError{}
+} = UpgradePipedsRequestValidationError{}
 
-// Validate checks the field values on EnablePipedResponse with the rules
+// Validate checks the field values on UpgradePipedsResponse with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EnablePipedResponse) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UpgradePipedsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EnablePipedResponse with the rules
+// ValidateAll checks the field values on UpgradePipedsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EnablePipedResponseMultiError, or nil if none found.
-func (m *EnablePipedResponse) ValidateAll() error {
+// UpgradePipedsResponseMultiError, or nil if none found.
+func (m *UpgradePipedsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EnablePipedResponse) validate(all bool) error {
+func (m *UpgradePipedsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PipedIds
+
 	if len(errors) > 0 {
-		return EnablePipedResponseMultiError(errors)
+		return UpgradePipedsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// EnablePipedResponseMultiError is an error wrapping multiple validation
-// errors returned by EnablePipedResponse.ValidateAll() if the designated
+// UpgradePipedsResponseMultiError is an error wrapping multiple validation
+// errors returned by UpgradePipedsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type EnablePipedResponseMultiError []error
+type UpgradePipedsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EnablePipedResponseMultiError) Error() string {
+func (m UpgradePipedsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4087,11 +7390,11 @@ func (m EnablePipedResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EnablePipedResponseMultiError) AllErrors() []error { return m }
+func (m UpgradePipedsResponseMultiError) AllErrors() []error { return m }
 
-// EnablePipedResponseValidationError is the validation error returned by
-// EnablePipedResponse.Validate if the designated constraints aren't met.
-type EnablePipedResponseValidationError struct {
+// UpgradePipedsResponseValidationError is the validation error returned by
+// UpgradePipedsResponse.Validate if the designated constraints aren't met.
+type UpgradePipedsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4099,24 +7402,24 @@ type EnablePipedResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e EnablePipedResponseValidationError) Field() string { return e.field }
+func (e UpgradePipedsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EnablePipedResponseValidationError) Reason() string { return e.reason }
+func (e UpgradePipedsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EnablePipedResponseValidationError) Cause() error { return e.cause }
+func (e UpgradePipedsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EnablePipedResponseValidationError) Key() bool { return e.key }
+func (e UpgradePipedsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EnablePipedResponseValidationError) ErrorName() string {
-	return "EnablePipedResponseValidationError"
+func (e UpgradePipedsResponseValidationError) ErrorName() string {
+	return "UpgradePipedsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EnablePipedResponseValidationError) Error() string {
+func (e UpgradePipedsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4128,14 +7431,14 @@ func (e EnablePipedResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEnablePipedResponse.%s: %s%s",
+		"invalid %sUpgradePipedsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EnablePipedResponseValidationError{}
+var _ error = UpgradePipedsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4143,34 +7446,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EnablePipedResponseValidationError{}
+} = UpgradePipedsResponseValidationError{}
 
-// Validate checks the field values on DisablePipedRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DisablePipedRequest) Validate() error {
+// Validate checks the field values on ListPipedUpgradeStatusesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListPipedUpgradeStatusesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DisablePipedRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DisablePipedRequestMultiError, or nil if none found.
-func (m *DisablePipedRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListPipedUpgradeStatusesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListPipedUpgradeStatusesRequestMultiError, or nil if none found.
+func (m *ListPipedUpgradeStatusesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DisablePipedRequest) validate(all bool) error {
+func (m *ListPipedUpgradeStatusesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetPipedId()) < 1 {
-		err := DisablePipedRequestValidationError{
-			field:  "PipedId",
-			reason: "value length must be at least 1 runes",
+	if !strings.HasPrefix(m.GetVersion(), "v") {
+		err := ListPipedUpgradeStatusesRequestValidationError{
+			field:  "Version",
+			reason: "value does not have prefix \"v\"",
 		}
 		if !all {
 			return err
@@ -4178,20 +7482,163 @@ func (m *DisablePipedRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for PipedIds
+
+	// no validation rules for Selector
+
 	if len(errors) > 0 {
-		return DisablePipedRequestMultiError(errors)
+		return ListPipedUpgradeStatusesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DisablePipedRequestMultiError is an error wrapping multiple validation
-// errors returned by DisablePipedRequest.ValidateAll() if the designated
+// ListPipedUpgradeStatusesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListPipedUpgradeStatusesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListPipedUpgradeStatusesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPipedUpgradeStatusesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPipedUpgradeStatusesRequestMultiError) AllErrors() []error { return m }
+
+// ListPipedUpgradeStatusesRequestValidationError is the validation error
+// returned by ListPipedUpgradeStatusesRequest.Validate if the designated
 // constraints aren't met.
-type DisablePipedRequestMultiError []error
+type ListPipedUpgradeStatusesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPipedUpgradeStatusesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPipedUpgradeStatusesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPipedUpgradeStatusesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPipedUpgradeStatusesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPipedUpgradeStatusesRequestValidationError) ErrorName() string {
+	return "ListPipedUpgradeStatusesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPipedUpgradeStatusesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPipedUpgradeStatusesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPipedUpgradeStatusesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPipedUpgradeStatusesRequestValidationError{}
+
+// Validate checks the field values on ListPipedUpgradeStatusesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListPipedUpgradeStatusesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPipedUpgradeStatusesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListPipedUpgradeStatusesResponseMultiError, or nil if none found.
+func (m *ListPipedUpgradeStatusesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPipedUpgradeStatusesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPipedUpgradeStatusesResponseValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPipedUpgradeStatusesResponseValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPipedUpgradeStatusesResponseValidationError{
+					field:  fmt.Sprintf("Statuses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPipedUpgradeStatusesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPipedUpgradeStatusesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListPipedUpgradeStatusesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPipedUpgradeStatusesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DisablePipedRequestMultiError) Error() string {
+func (m ListPipedUpgradeStatusesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4200,11 +7647,12 @@ func (m DisablePipedRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DisablePipedRequestMultiError) AllErrors() []error { return m }
+func (m ListPipedUpgradeStatusesResponseMultiError) AllErrors() []error { return m }
 
-// DisablePipedRequestValidationError is the validation error returned by
-// DisablePipedRequest.Validate if the designated constraints aren't met.
-type DisablePipedRequestValidationError struct {
+// ListPipedUpgradeStatusesResponseValidationError is the validation error
+// returned by ListPipedUpgradeStatusesResponse.Validate if the designated
+// constraints aren't met.
+type ListPipedUpgradeStatusesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4212,24 +7660,24 @@ type DisablePipedRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DisablePipedRequestValidationError) Field() string { return e.field }
+func (e ListPipedUpgradeStatusesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DisablePipedRequestValidationError) Reason() string { return e.reason }
+func (e ListPipedUpgradeStatusesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DisablePipedRequestValidationError) Cause() error { return e.cause }
+func (e ListPipedUpgradeStatusesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DisablePipedRequestValidationError) Key() bool { return e.key }
+func (e ListPipedUpgradeStatusesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DisablePipedRequestValidationError) ErrorName() string {
-	return "DisablePipedRequestValidationError"
+func (e ListPipedUpgradeStatusesResponseValidationError) ErrorName() string {
+	return "ListPipedUpgradeStatusesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DisablePipedRequestValidationError) Error() string {
+func (e ListPipedUpgradeStatusesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4241,14 +7689,14 @@ func (e DisablePipedRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDisablePipedRequest.%s: %s%s",
+		"invalid %sListPipedUpgradeStatusesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DisablePipedRequestValidationError{}
+var _ error = ListPipedUpgradeStatusesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4256,44 +7704,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DisablePipedRequestValidationError{}
+} = ListPipedUpgradeStatusesResponseValidationError{}
 
-// Validate checks the field values on DisablePipedResponse with the rules
+// Validate checks the field values on PipedUpgradeStatus with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DisablePipedResponse) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *PipedUpgradeStatus) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DisablePipedResponse with the rules
+// ValidateAll checks the field values on PipedUpgradeStatus with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DisablePipedResponseMultiError, or nil if none found.
-func (m *DisablePipedResponse) ValidateAll() error {
+// PipedUpgradeStatusMultiError, or nil if none found.
+func (m *PipedUpgradeStatus) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DisablePipedResponse) validate(all bool) error {
+func (m *PipedUpgradeStatus) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PipedId
+
+	// no validation rules for PipedName
+
+	// no validation rules for Version
+
+	// no validation rules for DesiredVersion
+
+	// no validation rules for Status
+
+	// no validation rules for StartedAt
+
+	// no validation rules for CompletedDeployments
+
+	// no validation rules for FailedDeployments
+
 	if len(errors) > 0 {
-		return DisablePipedResponseMultiError(errors)
+		return PipedUpgradeStatusMultiError(errors)
 	}
 
 	return nil
 }
 
-// DisablePipedResponseMultiError is an error wrapping multiple validation
-// errors returned by DisablePipedResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DisablePipedResponseMultiError []error
+// PipedUpgradeStatusMultiError is an error wrapping multiple validation errors
+// returned by PipedUpgradeStatus.ValidateAll() if the designated constraints
+// aren't met.
+type PipedUpgradeStatusMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DisablePipedResponseMultiError) Error() string {
+func (m PipedUpgradeStatusMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4302,11 +7767,11 @@ func (m DisablePipedResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DisablePipedResponseMultiError) AllErrors() []error { return m }
+func (m PipedUpgradeStatusMultiError) AllErrors() []error { return m }
 
-// DisablePipedResponseValidationError is the validation error returned by
-// DisablePipedResponse.Validate if the designated constraints aren't met.
-type DisablePipedResponseValidationError struct {
+// PipedUpgradeStatusValidationError is the validation error returned by
+// PipedUpgradeStatus.Validate if the designated constraints aren't met.
+type PipedUpgradeStatusValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4314,24 +7779,24 @@ type DisablePipedResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DisablePipedResponseValidationError) Field() string { return e.field }
+func (e PipedUpgradeStatusValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DisablePipedResponseValidationError) Reason() string { return e.reason }
+func (e PipedUpgradeStatusValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DisablePipedResponseValidationError) Cause() error { return e.cause }
+func (e PipedUpgradeStatusValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DisablePipedResponseValidationError) Key() bool { return e.key }
+func (e PipedUpgradeStatusValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DisablePipedResponseValidationError) ErrorName() string {
-	return "DisablePipedResponseValidationError"
+func (e PipedUpgradeStatusValidationError) ErrorName() string {
+	return "PipedUpgradeStatusValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DisablePipedResponseValidationError) Error() string {
+func (e PipedUpgradeStatusValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4343,14 +7808,14 @@ func (e DisablePipedResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDisablePipedResponse.%s: %s%s",
+		"invalid %sPipedUpgradeStatus.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DisablePipedResponseValidationError{}
+var _ error = PipedUpgradeStatusValidationError{}
 
 var _ interface {
 	Field() string
@@ -4358,7 +7823,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DisablePipedResponseValidationError{}
+} = PipedUpgradeStatusValidationError{}
 
 // Validate checks the field values on RegisterEventRequest with the rules
 // defined in the proto definition for this message. If any rules are
@@ -4501,6 +7966,8 @@ func (m *RegisterEventRequest) validate(all bool) error {
 
 	// no validation rules for CommitTimestamp
 
+	// no validation rules for RunUrl
+
 	if len(errors) > 0 {
 		return RegisterEventRequestMultiError(errors)
 	}
@@ -5827,3 +9294,280 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListStageLogsResponseValidationError{}
+
+// Validate checks the field values on StreamStageLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *StreamStageLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamStageLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamStageLogsRequestMultiError, or nil if none found.
+func (m *StreamStageLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamStageLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := StreamStageLogsRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for StageName
+
+	if len(errors) > 0 {
+		return StreamStageLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamStageLogsRequestMultiError is an error wrapping multiple validation
+// errors returned by StreamStageLogsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StreamStageLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamStageLogsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamStageLogsRequestMultiError) AllErrors() []error { return m }
+
+// StreamStageLogsRequestValidationError is the validation error returned by
+// StreamStageLogsRequest.Validate if the designated constraints aren't met.
+type StreamStageLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamStageLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamStageLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamStageLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamStageLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamStageLogsRequestValidationError) ErrorName() string {
+	return "StreamStageLogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamStageLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamStageLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamStageLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamStageLogsRequestValidationError{}
+
+// Validate checks the field values on StreamStageLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *StreamStageLogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamStageLogsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamStageLogsResponseMultiError, or nil if none found.
+func (m *StreamStageLogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamStageLogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := StreamStageLogsResponseValidationError{
+			field:  "StageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageName()) < 1 {
+		err := StreamStageLogsResponseValidationError{
+			field:  "StageName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetBlocks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StreamStageLogsResponseValidationError{
+						field:  fmt.Sprintf("Blocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StreamStageLogsResponseValidationError{
+						field:  fmt.Sprintf("Blocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StreamStageLogsResponseValidationError{
+					field:  fmt.Sprintf("Blocks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Completed
+
+	if len(errors) > 0 {
+		return StreamStageLogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamStageLogsResponseMultiError is an error wrapping multiple validation
+// errors returned by StreamStageLogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StreamStageLogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamStageLogsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamStageLogsResponseMultiError) AllErrors() []error { return m }
+
+// StreamStageLogsResponseValidationError is the validation error returned by
+// StreamStageLogsResponse.Validate if the designated constraints aren't met.
+type StreamStageLogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamStageLogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamStageLogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamStageLogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamStageLogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamStageLogsResponseValidationError) ErrorName() string {
+	return "StreamStageLogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamStageLogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamStageLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamStageLogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamStageLogsResponseValidationError{}
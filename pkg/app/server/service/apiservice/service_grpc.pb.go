@@ -32,19 +32,32 @@ type APIServiceClient interface {
 	DisableApplication(ctx context.Context, in *DisableApplicationRequest, opts ...grpc.CallOption) (*DisableApplicationResponse, error)
 	RenameApplicationConfigFile(ctx context.Context, in *RenameApplicationConfigFileRequest, opts ...grpc.CallOption) (*RenameApplicationConfigFileResponse, error)
 	UpdateApplicationDeployTargets(ctx context.Context, in *UpdateApplicationDeployTargetsRequest, opts ...grpc.CallOption) (*UpdateApplicationDeployTargetsResponse, error)
+	UpdateApplicationLabels(ctx context.Context, in *UpdateApplicationLabelsRequest, opts ...grpc.CallOption) (*UpdateApplicationLabelsResponse, error)
+	MigrateApplication(ctx context.Context, in *MigrateApplicationRequest, opts ...grpc.CallOption) (*MigrateApplicationResponse, error)
 	GetDeployment(ctx context.Context, in *GetDeploymentRequest, opts ...grpc.CallOption) (*GetDeploymentResponse, error)
+	GetDeploymentDiff(ctx context.Context, in *GetDeploymentDiffRequest, opts ...grpc.CallOption) (*GetDeploymentDiffResponse, error)
+	ListStageArtifacts(ctx context.Context, in *ListStageArtifactsRequest, opts ...grpc.CallOption) (*ListStageArtifactsResponse, error)
+	GetStageArtifact(ctx context.Context, in *GetStageArtifactRequest, opts ...grpc.CallOption) (*GetStageArtifactResponse, error)
 	ListDeployments(ctx context.Context, in *ListDeploymentsRequest, opts ...grpc.CallOption) (*ListDeploymentsResponse, error)
+	RetryDeployment(ctx context.Context, in *RetryDeploymentRequest, opts ...grpc.CallOption) (*RetryDeploymentResponse, error)
+	ReportStageResult(ctx context.Context, in *ReportStageResultRequest, opts ...grpc.CallOption) (*ReportStageResultResponse, error)
+	ApproveStage(ctx context.Context, in *ApproveStageRequest, opts ...grpc.CallOption) (*ApproveStageResponse, error)
+	ListPendingApprovals(ctx context.Context, in *ListPendingApprovalsRequest, opts ...grpc.CallOption) (*ListPendingApprovalsResponse, error)
+	ApproveStages(ctx context.Context, in *ApproveStagesRequest, opts ...grpc.CallOption) (*ApproveStagesResponse, error)
 	GetCommand(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*GetCommandResponse, error)
 	GetPiped(ctx context.Context, in *GetPipedRequest, opts ...grpc.CallOption) (*GetPipedResponse, error)
 	RegisterPiped(ctx context.Context, in *RegisterPipedRequest, opts ...grpc.CallOption) (*RegisterPipedResponse, error)
 	UpdatePiped(ctx context.Context, in *UpdatePipedRequest, opts ...grpc.CallOption) (*UpdatePipedResponse, error)
 	EnablePiped(ctx context.Context, in *EnablePipedRequest, opts ...grpc.CallOption) (*EnablePipedResponse, error)
 	DisablePiped(ctx context.Context, in *DisablePipedRequest, opts ...grpc.CallOption) (*DisablePipedResponse, error)
+	UpgradePipeds(ctx context.Context, in *UpgradePipedsRequest, opts ...grpc.CallOption) (*UpgradePipedsResponse, error)
+	ListPipedUpgradeStatuses(ctx context.Context, in *ListPipedUpgradeStatusesRequest, opts ...grpc.CallOption) (*ListPipedUpgradeStatusesResponse, error)
 	RegisterEvent(ctx context.Context, in *RegisterEventRequest, opts ...grpc.CallOption) (*RegisterEventResponse, error)
 	RequestPlanPreview(ctx context.Context, in *RequestPlanPreviewRequest, opts ...grpc.CallOption) (*RequestPlanPreviewResponse, error)
 	GetPlanPreviewResults(ctx context.Context, in *GetPlanPreviewResultsRequest, opts ...grpc.CallOption) (*GetPlanPreviewResultsResponse, error)
 	Encrypt(ctx context.Context, in *EncryptRequest, opts ...grpc.CallOption) (*EncryptResponse, error)
 	ListStageLogs(ctx context.Context, in *ListStageLogsRequest, opts ...grpc.CallOption) (*ListStageLogsResponse, error)
+	StreamStageLogs(ctx context.Context, in *StreamStageLogsRequest, opts ...grpc.CallOption) (APIService_StreamStageLogsClient, error)
 }
 
 type aPIServiceClient struct {
@@ -145,6 +158,24 @@ func (c *aPIServiceClient) UpdateApplicationDeployTargets(ctx context.Context, i
 	return out, nil
 }
 
+func (c *aPIServiceClient) UpdateApplicationLabels(ctx context.Context, in *UpdateApplicationLabelsRequest, opts ...grpc.CallOption) (*UpdateApplicationLabelsResponse, error) {
+	out := new(UpdateApplicationLabelsResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/UpdateApplicationLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) MigrateApplication(ctx context.Context, in *MigrateApplicationRequest, opts ...grpc.CallOption) (*MigrateApplicationResponse, error) {
+	out := new(MigrateApplicationResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/MigrateApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServiceClient) GetDeployment(ctx context.Context, in *GetDeploymentRequest, opts ...grpc.CallOption) (*GetDeploymentResponse, error) {
 	out := new(GetDeploymentResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/GetDeployment", in, out, opts...)
@@ -154,6 +185,33 @@ func (c *aPIServiceClient) GetDeployment(ctx context.Context, in *GetDeploymentR
 	return out, nil
 }
 
+func (c *aPIServiceClient) GetDeploymentDiff(ctx context.Context, in *GetDeploymentDiffRequest, opts ...grpc.CallOption) (*GetDeploymentDiffResponse, error) {
+	out := new(GetDeploymentDiffResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/GetDeploymentDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListStageArtifacts(ctx context.Context, in *ListStageArtifactsRequest, opts ...grpc.CallOption) (*ListStageArtifactsResponse, error) {
+	out := new(ListStageArtifactsResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/ListStageArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetStageArtifact(ctx context.Context, in *GetStageArtifactRequest, opts ...grpc.CallOption) (*GetStageArtifactResponse, error) {
+	out := new(GetStageArtifactResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/GetStageArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServiceClient) ListDeployments(ctx context.Context, in *ListDeploymentsRequest, opts ...grpc.CallOption) (*ListDeploymentsResponse, error) {
 	out := new(ListDeploymentsResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/ListDeployments", in, out, opts...)
@@ -163,6 +221,51 @@ func (c *aPIServiceClient) ListDeployments(ctx context.Context, in *ListDeployme
 	return out, nil
 }
 
+func (c *aPIServiceClient) RetryDeployment(ctx context.Context, in *RetryDeploymentRequest, opts ...grpc.CallOption) (*RetryDeploymentResponse, error) {
+	out := new(RetryDeploymentResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/RetryDeployment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ReportStageResult(ctx context.Context, in *ReportStageResultRequest, opts ...grpc.CallOption) (*ReportStageResultResponse, error) {
+	out := new(ReportStageResultResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/ReportStageResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ApproveStage(ctx context.Context, in *ApproveStageRequest, opts ...grpc.CallOption) (*ApproveStageResponse, error) {
+	out := new(ApproveStageResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/ApproveStage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListPendingApprovals(ctx context.Context, in *ListPendingApprovalsRequest, opts ...grpc.CallOption) (*ListPendingApprovalsResponse, error) {
+	out := new(ListPendingApprovalsResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/ListPendingApprovals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ApproveStages(ctx context.Context, in *ApproveStagesRequest, opts ...grpc.CallOption) (*ApproveStagesResponse, error) {
+	out := new(ApproveStagesResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/ApproveStages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServiceClient) GetCommand(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*GetCommandResponse, error) {
 	out := new(GetCommandResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/GetCommand", in, out, opts...)
@@ -217,6 +320,24 @@ func (c *aPIServiceClient) DisablePiped(ctx context.Context, in *DisablePipedReq
 	return out, nil
 }
 
+func (c *aPIServiceClient) UpgradePipeds(ctx context.Context, in *UpgradePipedsRequest, opts ...grpc.CallOption) (*UpgradePipedsResponse, error) {
+	out := new(UpgradePipedsResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/UpgradePipeds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListPipedUpgradeStatuses(ctx context.Context, in *ListPipedUpgradeStatusesRequest, opts ...grpc.CallOption) (*ListPipedUpgradeStatusesResponse, error) {
+	out := new(ListPipedUpgradeStatusesResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/ListPipedUpgradeStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServiceClient) RegisterEvent(ctx context.Context, in *RegisterEventRequest, opts ...grpc.CallOption) (*RegisterEventResponse, error) {
 	out := new(RegisterEventResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/RegisterEvent", in, out, opts...)
@@ -262,6 +383,38 @@ func (c *aPIServiceClient) ListStageLogs(ctx context.Context, in *ListStageLogsR
 	return out, nil
 }
 
+func (c *aPIServiceClient) StreamStageLogs(ctx context.Context, in *StreamStageLogsRequest, opts ...grpc.CallOption) (APIService_StreamStageLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &APIService_ServiceDesc.Streams[0], "/grpc.service.apiservice.APIService/StreamStageLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIServiceStreamStageLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type APIService_StreamStageLogsClient interface {
+	Recv() (*StreamStageLogsResponse, error)
+	grpc.ClientStream
+}
+
+type aPIServiceStreamStageLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIServiceStreamStageLogsClient) Recv() (*StreamStageLogsResponse, error) {
+	m := new(StreamStageLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // APIServiceServer is the server API for APIService service.
 // All implementations must embed UnimplementedAPIServiceServer
 // for forward compatibility
@@ -276,19 +429,32 @@ type APIServiceServer interface {
 	DisableApplication(context.Context, *DisableApplicationRequest) (*DisableApplicationResponse, error)
 	RenameApplicationConfigFile(context.Context, *RenameApplicationConfigFileRequest) (*RenameApplicationConfigFileResponse, error)
 	UpdateApplicationDeployTargets(context.Context, *UpdateApplicationDeployTargetsRequest) (*UpdateApplicationDeployTargetsResponse, error)
+	UpdateApplicationLabels(context.Context, *UpdateApplicationLabelsRequest) (*UpdateApplicationLabelsResponse, error)
+	MigrateApplication(context.Context, *MigrateApplicationRequest) (*MigrateApplicationResponse, error)
 	GetDeployment(context.Context, *GetDeploymentRequest) (*GetDeploymentResponse, error)
+	GetDeploymentDiff(context.Context, *GetDeploymentDiffRequest) (*GetDeploymentDiffResponse, error)
+	ListStageArtifacts(context.Context, *ListStageArtifactsRequest) (*ListStageArtifactsResponse, error)
+	GetStageArtifact(context.Context, *GetStageArtifactRequest) (*GetStageArtifactResponse, error)
 	ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error)
+	RetryDeployment(context.Context, *RetryDeploymentRequest) (*RetryDeploymentResponse, error)
+	ReportStageResult(context.Context, *ReportStageResultRequest) (*ReportStageResultResponse, error)
+	ApproveStage(context.Context, *ApproveStageRequest) (*ApproveStageResponse, error)
+	ListPendingApprovals(context.Context, *ListPendingApprovalsRequest) (*ListPendingApprovalsResponse, error)
+	ApproveStages(context.Context, *ApproveStagesRequest) (*ApproveStagesResponse, error)
 	GetCommand(context.Context, *GetCommandRequest) (*GetCommandResponse, error)
 	GetPiped(context.Context, *GetPipedRequest) (*GetPipedResponse, error)
 	RegisterPiped(context.Context, *RegisterPipedRequest) (*RegisterPipedResponse, error)
 	UpdatePiped(context.Context, *UpdatePipedRequest) (*UpdatePipedResponse, error)
 	EnablePiped(context.Context, *EnablePipedRequest) (*EnablePipedResponse, error)
 	DisablePiped(context.Context, *DisablePipedRequest) (*DisablePipedResponse, error)
+	UpgradePipeds(context.Context, *UpgradePipedsRequest) (*UpgradePipedsResponse, error)
+	ListPipedUpgradeStatuses(context.Context, *ListPipedUpgradeStatusesRequest) (*ListPipedUpgradeStatusesResponse, error)
 	RegisterEvent(context.Context, *RegisterEventRequest) (*RegisterEventResponse, error)
 	RequestPlanPreview(context.Context, *RequestPlanPreviewRequest) (*RequestPlanPreviewResponse, error)
 	GetPlanPreviewResults(context.Context, *GetPlanPreviewResultsRequest) (*GetPlanPreviewResultsResponse, error)
 	Encrypt(context.Context, *EncryptRequest) (*EncryptResponse, error)
 	ListStageLogs(context.Context, *ListStageLogsRequest) (*ListStageLogsResponse, error)
+	StreamStageLogs(*StreamStageLogsRequest, APIService_StreamStageLogsServer) error
 	mustEmbedUnimplementedAPIServiceServer()
 }
 
@@ -326,12 +492,42 @@ func (UnimplementedAPIServiceServer) RenameApplicationConfigFile(context.Context
 func (UnimplementedAPIServiceServer) UpdateApplicationDeployTargets(context.Context, *UpdateApplicationDeployTargetsRequest) (*UpdateApplicationDeployTargetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateApplicationDeployTargets not implemented")
 }
+func (UnimplementedAPIServiceServer) UpdateApplicationLabels(context.Context, *UpdateApplicationLabelsRequest) (*UpdateApplicationLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApplicationLabels not implemented")
+}
+func (UnimplementedAPIServiceServer) MigrateApplication(context.Context, *MigrateApplicationRequest) (*MigrateApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateApplication not implemented")
+}
 func (UnimplementedAPIServiceServer) GetDeployment(context.Context, *GetDeploymentRequest) (*GetDeploymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeployment not implemented")
 }
+func (UnimplementedAPIServiceServer) GetDeploymentDiff(context.Context, *GetDeploymentDiffRequest) (*GetDeploymentDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeploymentDiff not implemented")
+}
+func (UnimplementedAPIServiceServer) ListStageArtifacts(context.Context, *ListStageArtifactsRequest) (*ListStageArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStageArtifacts not implemented")
+}
+func (UnimplementedAPIServiceServer) GetStageArtifact(context.Context, *GetStageArtifactRequest) (*GetStageArtifactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStageArtifact not implemented")
+}
 func (UnimplementedAPIServiceServer) ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDeployments not implemented")
 }
+func (UnimplementedAPIServiceServer) RetryDeployment(context.Context, *RetryDeploymentRequest) (*RetryDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryDeployment not implemented")
+}
+func (UnimplementedAPIServiceServer) ReportStageResult(context.Context, *ReportStageResultRequest) (*ReportStageResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportStageResult not implemented")
+}
+func (UnimplementedAPIServiceServer) ApproveStage(context.Context, *ApproveStageRequest) (*ApproveStageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveStage not implemented")
+}
+func (UnimplementedAPIServiceServer) ListPendingApprovals(context.Context, *ListPendingApprovalsRequest) (*ListPendingApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingApprovals not implemented")
+}
+func (UnimplementedAPIServiceServer) ApproveStages(context.Context, *ApproveStagesRequest) (*ApproveStagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveStages not implemented")
+}
 func (UnimplementedAPIServiceServer) GetCommand(context.Context, *GetCommandRequest) (*GetCommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommand not implemented")
 }
@@ -350,6 +546,12 @@ func (UnimplementedAPIServiceServer) EnablePiped(context.Context, *EnablePipedRe
 func (UnimplementedAPIServiceServer) DisablePiped(context.Context, *DisablePipedRequest) (*DisablePipedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisablePiped not implemented")
 }
+func (UnimplementedAPIServiceServer) UpgradePipeds(context.Context, *UpgradePipedsRequest) (*UpgradePipedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradePipeds not implemented")
+}
+func (UnimplementedAPIServiceServer) ListPipedUpgradeStatuses(context.Context, *ListPipedUpgradeStatusesRequest) (*ListPipedUpgradeStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPipedUpgradeStatuses not implemented")
+}
 func (UnimplementedAPIServiceServer) RegisterEvent(context.Context, *RegisterEventRequest) (*RegisterEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterEvent not implemented")
 }
@@ -365,6 +567,9 @@ func (UnimplementedAPIServiceServer) Encrypt(context.Context, *EncryptRequest) (
 func (UnimplementedAPIServiceServer) ListStageLogs(context.Context, *ListStageLogsRequest) (*ListStageLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStageLogs not implemented")
 }
+func (UnimplementedAPIServiceServer) StreamStageLogs(*StreamStageLogsRequest, APIService_StreamStageLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamStageLogs not implemented")
+}
 func (UnimplementedAPIServiceServer) mustEmbedUnimplementedAPIServiceServer() {}
 
 // UnsafeAPIServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -558,6 +763,42 @@ func _APIService_UpdateApplicationDeployTargets_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_UpdateApplicationLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApplicationLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).UpdateApplicationLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/UpdateApplicationLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).UpdateApplicationLabels(ctx, req.(*UpdateApplicationLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_MigrateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).MigrateApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/MigrateApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).MigrateApplication(ctx, req.(*MigrateApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_GetDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDeploymentRequest)
 	if err := dec(in); err != nil {
@@ -576,6 +817,60 @@ func _APIService_GetDeployment_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_GetDeploymentDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeploymentDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetDeploymentDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/GetDeploymentDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetDeploymentDiff(ctx, req.(*GetDeploymentDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ListStageArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStageArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ListStageArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/ListStageArtifacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ListStageArtifacts(ctx, req.(*ListStageArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetStageArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStageArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetStageArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/GetStageArtifact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetStageArtifact(ctx, req.(*GetStageArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_ListDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListDeploymentsRequest)
 	if err := dec(in); err != nil {
@@ -594,6 +889,96 @@ func _APIService_ListDeployments_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_RetryDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).RetryDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/RetryDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).RetryDeployment(ctx, req.(*RetryDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ReportStageResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportStageResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ReportStageResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/ReportStageResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ReportStageResult(ctx, req.(*ReportStageResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ApproveStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveStageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ApproveStage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/ApproveStage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ApproveStage(ctx, req.(*ApproveStageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ListPendingApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ListPendingApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/ListPendingApprovals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ListPendingApprovals(ctx, req.(*ListPendingApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ApproveStages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveStagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ApproveStages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/ApproveStages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ApproveStages(ctx, req.(*ApproveStagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_GetCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCommandRequest)
 	if err := dec(in); err != nil {
@@ -702,6 +1087,42 @@ func _APIService_DisablePiped_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_UpgradePipeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradePipedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).UpgradePipeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/UpgradePipeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).UpgradePipeds(ctx, req.(*UpgradePipedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ListPipedUpgradeStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPipedUpgradeStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ListPipedUpgradeStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/ListPipedUpgradeStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ListPipedUpgradeStatuses(ctx, req.(*ListPipedUpgradeStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_RegisterEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterEventRequest)
 	if err := dec(in); err != nil {
@@ -792,6 +1213,27 @@ func _APIService_ListStageLogs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_StreamStageLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamStageLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServiceServer).StreamStageLogs(m, &aPIServiceStreamStageLogsServer{stream})
+}
+
+type APIService_StreamStageLogsServer interface {
+	Send(*StreamStageLogsResponse) error
+	grpc.ServerStream
+}
+
+type aPIServiceStreamStageLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIServiceStreamStageLogsServer) Send(m *StreamStageLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // APIService_ServiceDesc is the grpc.ServiceDesc for APIService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -839,14 +1281,54 @@ var APIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateApplicationDeployTargets",
 			Handler:    _APIService_UpdateApplicationDeployTargets_Handler,
 		},
+		{
+			MethodName: "UpdateApplicationLabels",
+			Handler:    _APIService_UpdateApplicationLabels_Handler,
+		},
+		{
+			MethodName: "MigrateApplication",
+			Handler:    _APIService_MigrateApplication_Handler,
+		},
 		{
 			MethodName: "GetDeployment",
 			Handler:    _APIService_GetDeployment_Handler,
 		},
+		{
+			MethodName: "GetDeploymentDiff",
+			Handler:    _APIService_GetDeploymentDiff_Handler,
+		},
+		{
+			MethodName: "ListStageArtifacts",
+			Handler:    _APIService_ListStageArtifacts_Handler,
+		},
+		{
+			MethodName: "GetStageArtifact",
+			Handler:    _APIService_GetStageArtifact_Handler,
+		},
 		{
 			MethodName: "ListDeployments",
 			Handler:    _APIService_ListDeployments_Handler,
 		},
+		{
+			MethodName: "RetryDeployment",
+			Handler:    _APIService_RetryDeployment_Handler,
+		},
+		{
+			MethodName: "ReportStageResult",
+			Handler:    _APIService_ReportStageResult_Handler,
+		},
+		{
+			MethodName: "ApproveStage",
+			Handler:    _APIService_ApproveStage_Handler,
+		},
+		{
+			MethodName: "ListPendingApprovals",
+			Handler:    _APIService_ListPendingApprovals_Handler,
+		},
+		{
+			MethodName: "ApproveStages",
+			Handler:    _APIService_ApproveStages_Handler,
+		},
 		{
 			MethodName: "GetCommand",
 			Handler:    _APIService_GetCommand_Handler,
@@ -871,6 +1353,14 @@ var APIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisablePiped",
 			Handler:    _APIService_DisablePiped_Handler,
 		},
+		{
+			MethodName: "UpgradePipeds",
+			Handler:    _APIService_UpgradePipeds_Handler,
+		},
+		{
+			MethodName: "ListPipedUpgradeStatuses",
+			Handler:    _APIService_ListPipedUpgradeStatuses_Handler,
+		},
 		{
 			MethodName: "RegisterEvent",
 			Handler:    _APIService_RegisterEvent_Handler,
@@ -892,6 +1382,12 @@ var APIService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _APIService_ListStageLogs_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamStageLogs",
+			Handler:       _APIService_StreamStageLogs_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "pkg/app/server/service/apiservice/service.proto",
 }
@@ -0,0 +1,159 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipedservice
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/rpc/rpcauth"
+	"github.com/pipe-cd/pipecd/pkg/rpc/rpcclient"
+)
+
+const (
+	// deploymentTokenRefreshMargin is how long before its expiration a token will be renewed.
+	deploymentTokenRefreshMargin = 5 * time.Minute
+)
+
+type deploymentTokenIssuer interface {
+	IssueDeploymentToken(ctx context.Context, in *IssueDeploymentTokenRequest, opts ...grpc.CallOption) (*IssueDeploymentTokenResponse, error)
+}
+
+type deploymentToken struct {
+	value     string
+	expiresAt time.Time
+}
+
+type deploymentTokenCache struct {
+	tokens    map[string]deploymentToken
+	mu        sync.Mutex
+	issuing   singleflight.Group
+	newIssuer func(cc *grpc.ClientConn) deploymentTokenIssuer
+	nowFunc   func() time.Time
+	logger    *zap.Logger
+}
+
+// DeploymentTokenUnaryClientInterceptor returns an interceptor which makes the calls of DeploymentScopedMethods
+// authenticated by short-lived tokens issued by the control plane for their deployments instead of the piped key.
+// The calls fail when no token can be issued since the control plane does not accept the piped key for those methods.
+func DeploymentTokenUnaryClientInterceptor(logger *zap.Logger) grpc.UnaryClientInterceptor {
+	c := &deploymentTokenCache{
+		tokens: make(map[string]deploymentToken),
+		newIssuer: func(cc *grpc.ClientConn) deploymentTokenIssuer {
+			return NewPipedServiceClient(cc)
+		},
+		nowFunc: time.Now,
+		logger:  logger.Named("deployment-token"),
+	}
+	return c.intercept
+}
+
+func (c *deploymentTokenCache) intercept(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if _, ok := DeploymentScopedMethods[method]; !ok {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	r, ok := req.(interface{ GetDeploymentId() string })
+	if !ok || r.GetDeploymentId() == "" {
+		return status.Errorf(codes.InvalidArgument, "deployment-scoped method %s requires the deployment ID", method)
+	}
+
+	deploymentID := r.GetDeploymentId()
+	token, err := c.get(ctx, c.newIssuer(cc), deploymentID)
+	if err != nil {
+		c.logger.Warn("failed to issue deployment token",
+			zap.String("deployment-id", deploymentID),
+			zap.String("method", method),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	err = invoker(rpcclient.ContextWithCredentials(ctx, rpcauth.DeploymentTokenCredentials, token), method, req, reply, cc, opts...)
+	if code := status.Code(err); code == codes.Unauthenticated || code == codes.PermissionDenied {
+		// The token was probably revoked, e.g. because the deployment has just been completed.
+		// It is dropped to issue a new one at the next call.
+		c.logger.Info("deployment token was rejected",
+			zap.String("deployment-id", deploymentID),
+			zap.String("method", method),
+			zap.Error(err),
+		)
+		c.invalidate(deploymentID, token)
+	}
+	return err
+}
+
+// get returns a valid token for the given deployment, issuing a new one when needed.
+func (c *deploymentTokenCache) get(ctx context.Context, issuer deploymentTokenIssuer, deploymentID string) (string, error) {
+	if token, ok := c.load(deploymentID); ok {
+		return token, nil
+	}
+
+	// The lock is not held while issuing, the concurrent calls for the same deployment share the result instead.
+	v, err, _ := c.issuing.Do(deploymentID, func() (interface{}, error) {
+		resp, err := issuer.IssueDeploymentToken(ctx, &IssueDeploymentTokenRequest{
+			DeploymentId: deploymentID,
+		})
+		if err != nil {
+			return "", err
+		}
+		c.mu.Lock()
+		c.tokens[deploymentID] = deploymentToken{
+			value:     resp.Token,
+			expiresAt: time.Unix(resp.ExpiresAt, 0),
+		}
+		c.mu.Unlock()
+		return resp.Token, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return v.(string), nil
+}
+
+// load returns the cached token for the given deployment if it is not about to expire.
+func (c *deploymentTokenCache) load(deploymentID string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.nowFunc()
+	// Remove the tokens which are no longer used.
+	for id, t := range c.tokens {
+		if now.After(t.expiresAt) {
+			delete(c.tokens, id)
+		}
+	}
+
+	t, ok := c.tokens[deploymentID]
+	if !ok || !now.Add(deploymentTokenRefreshMargin).Before(t.expiresAt) {
+		return "", false
+	}
+	return t.value, true
+}
+
+// invalidate removes the given token of the deployment unless it was already replaced by a new one.
+func (c *deploymentTokenCache) invalidate(deploymentID, token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if t, ok := c.tokens[deploymentID]; ok && t.value == token {
+		delete(c.tokens, deploymentID)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipedservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pipe-cd/pipecd/pkg/rpc/rpcauth"
+	"github.com/pipe-cd/pipecd/pkg/rpc/rpcclient"
+)
+
+const saveDeploymentMetadataMethod = "/grpc.service.pipedservice.PipedService/SaveDeploymentMetadata"
+
+type fakeDeploymentTokenIssuer struct {
+	calls int
+	ttl   time.Duration
+	err   error
+}
+
+func (f *fakeDeploymentTokenIssuer) IssueDeploymentToken(_ context.Context, in *IssueDeploymentTokenRequest, _ ...grpc.CallOption) (*IssueDeploymentTokenResponse, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &IssueDeploymentTokenResponse{
+		Token:     in.DeploymentId + "-token",
+		ExpiresAt: time.Now().Add(f.ttl).Unix(),
+	}, nil
+}
+
+// fakeInvoker records the authorization header of each call
+// and rejects the calls made with the rejected tokens.
+type fakeInvoker struct {
+	authorizations []string
+	rejectedTokens map[string]bool
+}
+
+func (f *fakeInvoker) invoke(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+	creds := rpcclient.NewPerRPCCredentials("piped-key", rpcauth.PipedTokenCredentials, false)
+	md, err := creds.GetRequestMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	authorization := md["authorization"]
+	f.authorizations = append(f.authorizations, authorization)
+	if f.rejectedTokens[authorization] {
+		return status.Error(codes.Unauthenticated, "Unauthenticated")
+	}
+	return nil
+}
+
+func newTestDeploymentTokenCache(issuer *fakeDeploymentTokenIssuer) *deploymentTokenCache {
+	return &deploymentTokenCache{
+		tokens:    make(map[string]deploymentToken),
+		newIssuer: func(*grpc.ClientConn) deploymentTokenIssuer { return issuer },
+		nowFunc:   time.Now,
+		logger:    zap.NewNop(),
+	}
+}
+
+func TestDeploymentTokenUnaryClientInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := &SaveDeploymentMetadataRequest{DeploymentId: "deployment-1"}
+
+	t.Run("use piped key for the methods which are not deployment-scoped", func(t *testing.T) {
+		issuer := &fakeDeploymentTokenIssuer{ttl: time.Hour}
+		invoker := &fakeInvoker{}
+		c := newTestDeploymentTokenCache(issuer)
+
+		err := c.intercept(ctx, "/grpc.service.pipedservice.PipedService/ReportDeploymentCompleted", &ReportDeploymentCompletedRequest{DeploymentId: "deployment-1"}, nil, nil, invoker.invoke)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"PIPED-TOKEN piped-key"}, invoker.authorizations)
+		assert.Equal(t, 0, issuer.calls)
+	})
+
+	t.Run("reuse the issued token until it is about to expire", func(t *testing.T) {
+		issuer := &fakeDeploymentTokenIssuer{ttl: time.Hour}
+		invoker := &fakeInvoker{}
+		c := newTestDeploymentTokenCache(issuer)
+
+		require.NoError(t, c.intercept(ctx, saveDeploymentMetadataMethod, req, nil, nil, invoker.invoke))
+		require.NoError(t, c.intercept(ctx, saveDeploymentMetadataMethod, req, nil, nil, invoker.invoke))
+		assert.Equal(t, 1, issuer.calls)
+
+		c.nowFunc = func() time.Time { return time.Now().Add(time.Hour - time.Minute) }
+		require.NoError(t, c.intercept(ctx, saveDeploymentMetadataMethod, req, nil, nil, invoker.invoke))
+		assert.Equal(t, 2, issuer.calls)
+		assert.Equal(t, []string{
+			"DEPLOYMENT-TOKEN deployment-1-token",
+			"DEPLOYMENT-TOKEN deployment-1-token",
+			"DEPLOYMENT-TOKEN deployment-1-token",
+		}, invoker.authorizations)
+	})
+
+	t.Run("fail without using piped key when the token could not be issued", func(t *testing.T) {
+		issuer := &fakeDeploymentTokenIssuer{err: status.Error(codes.Unimplemented, "unimplemented")}
+		invoker := &fakeInvoker{}
+		c := newTestDeploymentTokenCache(issuer)
+
+		err := c.intercept(ctx, saveDeploymentMetadataMethod, req, nil, nil, invoker.invoke)
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+		assert.Empty(t, invoker.authorizations)
+
+		// The token is issued again at the next call.
+		issuer.err = nil
+		require.NoError(t, c.intercept(ctx, saveDeploymentMetadataMethod, req, nil, nil, invoker.invoke))
+		assert.Equal(t, 2, issuer.calls)
+		assert.Equal(t, []string{"DEPLOYMENT-TOKEN deployment-1-token"}, invoker.authorizations)
+	})
+
+	t.Run("fail without using piped key when the token was rejected", func(t *testing.T) {
+		issuer := &fakeDeploymentTokenIssuer{ttl: time.Hour}
+		invoker := &fakeInvoker{
+			rejectedTokens: map[string]bool{"DEPLOYMENT-TOKEN deployment-1-token": true},
+		}
+		c := newTestDeploymentTokenCache(issuer)
+
+		err := c.intercept(ctx, saveDeploymentMetadataMethod, req, nil, nil, invoker.invoke)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		err = c.intercept(ctx, saveDeploymentMetadataMethod, req, nil, nil, invoker.invoke)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		// The rejected token is not reused.
+		assert.Equal(t, 2, issuer.calls)
+		assert.Equal(t, []string{
+			"DEPLOYMENT-TOKEN deployment-1-token",
+			"DEPLOYMENT-TOKEN deployment-1-token",
+		}, invoker.authorizations)
+	})
+
+	t.Run("fail when the deployment ID is missing", func(t *testing.T) {
+		issuer := &fakeDeploymentTokenIssuer{ttl: time.Hour}
+		invoker := &fakeInvoker{}
+		c := newTestDeploymentTokenCache(issuer)
+
+		err := c.intercept(ctx, saveDeploymentMetadataMethod, &SaveDeploymentMetadataRequest{}, nil, nil, invoker.invoke)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Empty(t, invoker.authorizations)
+		assert.Equal(t, 0, issuer.calls)
+	})
+
+	t.Run("return the error which is not related to authentication", func(t *testing.T) {
+		issuer := &fakeDeploymentTokenIssuer{ttl: time.Hour}
+		c := newTestDeploymentTokenCache(issuer)
+		invoke := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+			return errors.New("unavailable")
+		}
+		err := c.intercept(ctx, saveDeploymentMetadataMethod, req, nil, nil, invoke)
+		assert.Error(t, err)
+	})
+}
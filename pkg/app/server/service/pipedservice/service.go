@@ -59,3 +59,17 @@ func NewRetry(maxRetries int) backoff.Retry {
 	bo := backoff.NewExponential(2*time.Second, time.Minute)
 	return backoff.NewRetry(maxRetries, bo)
 }
+
+// DeploymentScopedMethods is the list of RPCs which can also be called
+// by using a deployment token issued by IssueDeploymentToken instead of PIPED_TOKEN.
+// All of their requests must contain the ID of the deployment they are working on.
+var DeploymentScopedMethods = map[string]struct{}{
+	"/grpc.service.pipedservice.PipedService/SaveDeploymentMetadata":            {},
+	"/grpc.service.pipedservice.PipedService/SaveDeploymentSharedMetadata":      {},
+	"/grpc.service.pipedservice.PipedService/SaveDeploymentPluginMetadata":      {},
+	"/grpc.service.pipedservice.PipedService/SaveStageMetadata":                 {},
+	"/grpc.service.pipedservice.PipedService/ReportStageLogs":                   {},
+	"/grpc.service.pipedservice.PipedService/ReportStageLogsFromLastCheckpoint": {},
+	"/grpc.service.pipedservice.PipedService/ReportDeploymentDiff":              {},
+	"/grpc.service.pipedservice.PipedService/UploadStageArtifact":               {},
+}
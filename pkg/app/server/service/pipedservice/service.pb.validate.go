@@ -438,6 +438,8 @@ func (m *ReportPipedMetaRequest) validate(all bool) error {
 
 	// no validation rules for Config
 
+	// no validation rules for Labels
+
 	if len(errors) > 0 {
 		return ReportPipedMetaRequestMultiError(errors)
 	}
@@ -2769,6 +2771,35 @@ func (m *ReportDeploymentPlannedRequest) validate(all bool) error {
 
 	// no validation rules for DeploymentChainBlockIndex
 
+	if all {
+		switch v := interface{}(m.GetArtifact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportDeploymentPlannedRequestValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportDeploymentPlannedRequestValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportDeploymentPlannedRequestValidationError{
+				field:  "Artifact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ReportDeploymentPlannedRequestMultiError(errors)
 	}
@@ -8768,6 +8799,229 @@ var _ interface {
 	ErrorName() string
 } = ReportEventStatusesRequest_EventValidationError{}
 
+// Validate checks the field values on IssueDeploymentTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *IssueDeploymentTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueDeploymentTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueDeploymentTokenRequestMultiError, or nil if none found.
+func (m *IssueDeploymentTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueDeploymentTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := IssueDeploymentTokenRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return IssueDeploymentTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueDeploymentTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by IssueDeploymentTokenRequest.ValidateAll() if
+// the designated constraints aren't met.
+type IssueDeploymentTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueDeploymentTokenRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueDeploymentTokenRequestMultiError) AllErrors() []error { return m }
+
+// IssueDeploymentTokenRequestValidationError is the validation error returned
+// by IssueDeploymentTokenRequest.Validate if the designated constraints
+// aren't met.
+type IssueDeploymentTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueDeploymentTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueDeploymentTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueDeploymentTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueDeploymentTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueDeploymentTokenRequestValidationError) ErrorName() string {
+	return "IssueDeploymentTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssueDeploymentTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueDeploymentTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueDeploymentTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueDeploymentTokenRequestValidationError{}
+
+// Validate checks the field values on IssueDeploymentTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *IssueDeploymentTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueDeploymentTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueDeploymentTokenResponseMultiError, or nil if none found.
+func (m *IssueDeploymentTokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueDeploymentTokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for ExpiresAt
+
+	if len(errors) > 0 {
+		return IssueDeploymentTokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueDeploymentTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by IssueDeploymentTokenResponse.ValidateAll() if
+// the designated constraints aren't met.
+type IssueDeploymentTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueDeploymentTokenResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueDeploymentTokenResponseMultiError) AllErrors() []error { return m }
+
+// IssueDeploymentTokenResponseValidationError is the validation error returned
+// by IssueDeploymentTokenResponse.Validate if the designated constraints
+// aren't met.
+type IssueDeploymentTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueDeploymentTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueDeploymentTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueDeploymentTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueDeploymentTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueDeploymentTokenResponseValidationError) ErrorName() string {
+	return "IssueDeploymentTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssueDeploymentTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueDeploymentTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueDeploymentTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueDeploymentTokenResponseValidationError{}
+
 // Validate checks the field values on
 // CreateDeploymentChainRequest_ApplicationMatcher with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -8884,3 +9138,1341 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CreateDeploymentChainRequest_ApplicationMatcherValidationError{}
+
+// Validate checks the field values on GetPromotionArtifactRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetPromotionArtifactRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPromotionArtifactRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPromotionArtifactRequestMultiError, or nil if none found.
+func (m *GetPromotionArtifactRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPromotionArtifactRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetApplicationName()) < 1 {
+		err := GetPromotionArtifactRequestValidationError{
+			field:  "ApplicationName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DeploymentId
+
+	if len(errors) > 0 {
+		return GetPromotionArtifactRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPromotionArtifactRequestMultiError is an error wrapping multiple
+// validation errors returned by GetPromotionArtifactRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetPromotionArtifactRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPromotionArtifactRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPromotionArtifactRequestMultiError) AllErrors() []error { return m }
+
+// GetPromotionArtifactRequestValidationError is the validation error returned
+// by GetPromotionArtifactRequest.Validate if the designated constraints
+// aren't met.
+type GetPromotionArtifactRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPromotionArtifactRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPromotionArtifactRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPromotionArtifactRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPromotionArtifactRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPromotionArtifactRequestValidationError) ErrorName() string {
+	return "GetPromotionArtifactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPromotionArtifactRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPromotionArtifactRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPromotionArtifactRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPromotionArtifactRequestValidationError{}
+
+// Validate checks the field values on GetPromotionArtifactResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetPromotionArtifactResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPromotionArtifactResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPromotionArtifactResponseMultiError, or nil if none found.
+func (m *GetPromotionArtifactResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPromotionArtifactResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeploymentId
+
+	// no validation rules for CommitHash
+
+	if all {
+		switch v := interface{}(m.GetArtifact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPromotionArtifactResponseValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPromotionArtifactResponseValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPromotionArtifactResponseValidationError{
+				field:  "Artifact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPromotionArtifactResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPromotionArtifactResponseMultiError is an error wrapping multiple
+// validation errors returned by GetPromotionArtifactResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetPromotionArtifactResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPromotionArtifactResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPromotionArtifactResponseMultiError) AllErrors() []error { return m }
+
+// GetPromotionArtifactResponseValidationError is the validation error returned
+// by GetPromotionArtifactResponse.Validate if the designated constraints
+// aren't met.
+type GetPromotionArtifactResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPromotionArtifactResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPromotionArtifactResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPromotionArtifactResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPromotionArtifactResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPromotionArtifactResponseValidationError) ErrorName() string {
+	return "GetPromotionArtifactResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPromotionArtifactResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPromotionArtifactResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPromotionArtifactResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPromotionArtifactResponseValidationError{}
+
+// Validate checks the field values on ReportDeploymentDiffRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ReportDeploymentDiffRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportDeploymentDiffRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportDeploymentDiffRequestMultiError, or nil if none found.
+func (m *ReportDeploymentDiffRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportDeploymentDiffRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := ReportDeploymentDiffRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDiff() == nil {
+		err := ReportDeploymentDiffRequestValidationError{
+			field:  "Diff",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDiff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportDeploymentDiffRequestValidationError{
+					field:  "Diff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportDeploymentDiffRequestValidationError{
+					field:  "Diff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportDeploymentDiffRequestValidationError{
+				field:  "Diff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReportDeploymentDiffRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportDeploymentDiffRequestMultiError is an error wrapping multiple
+// validation errors returned by ReportDeploymentDiffRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ReportDeploymentDiffRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportDeploymentDiffRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportDeploymentDiffRequestMultiError) AllErrors() []error { return m }
+
+// ReportDeploymentDiffRequestValidationError is the validation error returned
+// by ReportDeploymentDiffRequest.Validate if the designated constraints
+// aren't met.
+type ReportDeploymentDiffRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportDeploymentDiffRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportDeploymentDiffRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportDeploymentDiffRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportDeploymentDiffRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportDeploymentDiffRequestValidationError) ErrorName() string {
+	return "ReportDeploymentDiffRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportDeploymentDiffRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportDeploymentDiffRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportDeploymentDiffRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportDeploymentDiffRequestValidationError{}
+
+// Validate checks the field values on ReportDeploymentDiffResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ReportDeploymentDiffResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportDeploymentDiffResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportDeploymentDiffResponseMultiError, or nil if none found.
+func (m *ReportDeploymentDiffResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportDeploymentDiffResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReportDeploymentDiffResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportDeploymentDiffResponseMultiError is an error wrapping multiple
+// validation errors returned by ReportDeploymentDiffResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ReportDeploymentDiffResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportDeploymentDiffResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportDeploymentDiffResponseMultiError) AllErrors() []error { return m }
+
+// ReportDeploymentDiffResponseValidationError is the validation error returned
+// by ReportDeploymentDiffResponse.Validate if the designated constraints
+// aren't met.
+type ReportDeploymentDiffResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportDeploymentDiffResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportDeploymentDiffResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportDeploymentDiffResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportDeploymentDiffResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportDeploymentDiffResponseValidationError) ErrorName() string {
+	return "ReportDeploymentDiffResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportDeploymentDiffResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportDeploymentDiffResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportDeploymentDiffResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportDeploymentDiffResponseValidationError{}
+
+// Validate checks the field values on UploadStageArtifactRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UploadStageArtifactRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadStageArtifactRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadStageArtifactRequestMultiError, or nil if none found.
+func (m *UploadStageArtifactRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadStageArtifactRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := UploadStageArtifactRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := UploadStageArtifactRequestValidationError{
+			field:  "StageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := UploadStageArtifactRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return UploadStageArtifactRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadStageArtifactRequestMultiError is an error wrapping multiple
+// validation errors returned by UploadStageArtifactRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UploadStageArtifactRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadStageArtifactRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadStageArtifactRequestMultiError) AllErrors() []error { return m }
+
+// UploadStageArtifactRequestValidationError is the validation error returned
+// by UploadStageArtifactRequest.Validate if the designated constraints aren't
+// met.
+type UploadStageArtifactRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadStageArtifactRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadStageArtifactRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadStageArtifactRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadStageArtifactRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadStageArtifactRequestValidationError) ErrorName() string {
+	return "UploadStageArtifactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadStageArtifactRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadStageArtifactRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadStageArtifactRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadStageArtifactRequestValidationError{}
+
+// Validate checks the field values on UploadStageArtifactResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UploadStageArtifactResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadStageArtifactResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadStageArtifactResponseMultiError, or nil if none found.
+func (m *UploadStageArtifactResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadStageArtifactResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UploadStageArtifactResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadStageArtifactResponseMultiError is an error wrapping multiple
+// validation errors returned by UploadStageArtifactResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UploadStageArtifactResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadStageArtifactResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadStageArtifactResponseMultiError) AllErrors() []error { return m }
+
+// UploadStageArtifactResponseValidationError is the validation error returned
+// by UploadStageArtifactResponse.Validate if the designated constraints
+// aren't met.
+type UploadStageArtifactResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadStageArtifactResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadStageArtifactResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadStageArtifactResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadStageArtifactResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadStageArtifactResponseValidationError) ErrorName() string {
+	return "UploadStageArtifactResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadStageArtifactResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadStageArtifactResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadStageArtifactResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadStageArtifactResponseValidationError{}
+
+// Validate checks the field values on GetDeploymentRiskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetDeploymentRiskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeploymentRiskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeploymentRiskRequestMultiError, or nil if none found.
+func (m *GetDeploymentRiskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeploymentRiskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := GetDeploymentRiskRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetDeploymentRiskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeploymentRiskRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDeploymentRiskRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDeploymentRiskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeploymentRiskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeploymentRiskRequestMultiError) AllErrors() []error { return m }
+
+// GetDeploymentRiskRequestValidationError is the validation error returned by
+// GetDeploymentRiskRequest.Validate if the designated constraints aren't met.
+type GetDeploymentRiskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeploymentRiskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeploymentRiskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeploymentRiskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeploymentRiskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeploymentRiskRequestValidationError) ErrorName() string {
+	return "GetDeploymentRiskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeploymentRiskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeploymentRiskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeploymentRiskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeploymentRiskRequestValidationError{}
+
+// Validate checks the field values on GetDeploymentRiskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetDeploymentRiskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeploymentRiskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeploymentRiskResponseMultiError, or nil if none found.
+func (m *GetDeploymentRiskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeploymentRiskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRisk()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDeploymentRiskResponseValidationError{
+					field:  "Risk",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDeploymentRiskResponseValidationError{
+					field:  "Risk",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRisk()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDeploymentRiskResponseValidationError{
+				field:  "Risk",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDeploymentRiskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeploymentRiskResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDeploymentRiskResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetDeploymentRiskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeploymentRiskResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeploymentRiskResponseMultiError) AllErrors() []error { return m }
+
+// GetDeploymentRiskResponseValidationError is the validation error returned by
+// GetDeploymentRiskResponse.Validate if the designated constraints aren't
+// met.
+type GetDeploymentRiskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeploymentRiskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeploymentRiskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeploymentRiskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeploymentRiskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeploymentRiskResponseValidationError) ErrorName() string {
+	return "GetDeploymentRiskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeploymentRiskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeploymentRiskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeploymentRiskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeploymentRiskResponseValidationError{}
+
+// Validate checks the field values on RegisterEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegisterEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterEventRequestMultiError, or nil if none found.
+func (m *RegisterEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := RegisterEventRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := RegisterEventRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetData()) < 1 {
+		err := RegisterEventRequestValidationError{
+			field:  "Data",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if utf8.RuneCountInString(key) < 1 {
+				err := RegisterEventRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at least 1 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) < 1 {
+				err := RegisterEventRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at least 1 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetContexts()))
+		i := 0
+		for key := range m.GetContexts() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetContexts()[key]
+			_ = val
+
+			if utf8.RuneCountInString(key) < 1 {
+				err := RegisterEventRequestValidationError{
+					field:  fmt.Sprintf("Contexts[%v]", key),
+					reason: "value length must be at least 1 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if !_RegisterEventRequest_Contexts_Pattern.MatchString(key) {
+				err := RegisterEventRequestValidationError{
+					field:  fmt.Sprintf("Contexts[%v]", key),
+					reason: "value does not match regex pattern \"^[a-zA-Z0-9]+(-[a-zA-Z0-9]+)*$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) < 1 {
+				err := RegisterEventRequestValidationError{
+					field:  fmt.Sprintf("Contexts[%v]", key),
+					reason: "value length must be at least 1 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return RegisterEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterEventRequestMultiError is an error wrapping multiple validation
+// errors returned by RegisterEventRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RegisterEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterEventRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterEventRequestMultiError) AllErrors() []error { return m }
+
+// RegisterEventRequestValidationError is the validation error returned by
+// RegisterEventRequest.Validate if the designated constraints aren't met.
+type RegisterEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterEventRequestValidationError) ErrorName() string {
+	return "RegisterEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegisterEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterEventRequestValidationError{}
+
+var _RegisterEventRequest_Contexts_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+(-[a-zA-Z0-9]+)*$")
+
+// Validate checks the field values on RegisterEventResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegisterEventResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterEventResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterEventResponseMultiError, or nil if none found.
+func (m *RegisterEventResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterEventResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetEventId()) < 1 {
+		err := RegisterEventResponseValidationError{
+			field:  "EventId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RegisterEventResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterEventResponseMultiError is an error wrapping multiple validation
+// errors returned by RegisterEventResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RegisterEventResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterEventResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterEventResponseMultiError) AllErrors() []error { return m }
+
+// RegisterEventResponseValidationError is the validation error returned by
+// RegisterEventResponse.Validate if the designated constraints aren't met.
+type RegisterEventResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterEventResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterEventResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterEventResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterEventResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterEventResponseValidationError) ErrorName() string {
+	return "RegisterEventResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegisterEventResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterEventResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterEventResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterEventResponseValidationError{}
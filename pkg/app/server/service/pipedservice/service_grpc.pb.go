@@ -139,6 +139,28 @@ type PipedServiceClient interface {
 	// In case the previous block of this deployment is finished with FAILURE | CANCELLED status,
 	// `cancel` flag will be returned to aware piped to stop this deployment.
 	InChainDeploymentPlannable(ctx context.Context, in *InChainDeploymentPlannableRequest, opts ...grpc.CallOption) (*InChainDeploymentPlannableResponse, error)
+	// IssueDeploymentToken issues a short-lived token scoped to the given deployment.
+	// The issued token can be used instead of PIPED_TOKEN while calling the per-deployment RPCs
+	// such as saving deployment metadata or reporting stage logs of that deployment.
+	// This must be called by using PIPED_TOKEN.
+	IssueDeploymentToken(ctx context.Context, in *IssueDeploymentTokenRequest, opts ...grpc.CallOption) (*IssueDeploymentTokenResponse, error)
+	// GetPromotionArtifact returns the artifact of the most recently successful deployment
+	// of the given application in the same project.
+	// It is used while planning a deployment that promotes the artifact of another application.
+	GetPromotionArtifact(ctx context.Context, in *GetPromotionArtifactRequest, opts ...grpc.CallOption) (*GetPromotionArtifactResponse, error)
+	// ReportDeploymentDiff is used to save the rendered diff of a deployment
+	// so that it can be shown after the deployment was planned.
+	// The previously reported diff of the same deployment will be overwritten.
+	ReportDeploymentDiff(ctx context.Context, in *ReportDeploymentDiffRequest, opts ...grpc.CallOption) (*ReportDeploymentDiffResponse, error)
+	// UploadStageArtifact is used to save a file produced while executing a stage.
+	// The previously uploaded artifact with the same name in the same stage will be overwritten.
+	UploadStageArtifact(ctx context.Context, in *UploadStageArtifactRequest, opts ...grpc.CallOption) (*UploadStageArtifactResponse, error)
+	// GetDeploymentRisk returns the heuristic risk score of a deployment
+	// computed with the scoring weights configured for its project.
+	GetDeploymentRisk(ctx context.Context, in *GetDeploymentRiskRequest, opts ...grpc.CallOption) (*GetDeploymentRiskResponse, error)
+	// RegisterEvent registers an event produced while executing a deployment,
+	// so that the applications watching it by EventWatcher are updated.
+	RegisterEvent(ctx context.Context, in *RegisterEventRequest, opts ...grpc.CallOption) (*RegisterEventResponse, error)
 }
 
 type pipedServiceClient struct {
@@ -456,6 +478,60 @@ func (c *pipedServiceClient) InChainDeploymentPlannable(ctx context.Context, in
 	return out, nil
 }
 
+func (c *pipedServiceClient) IssueDeploymentToken(ctx context.Context, in *IssueDeploymentTokenRequest, opts ...grpc.CallOption) (*IssueDeploymentTokenResponse, error) {
+	out := new(IssueDeploymentTokenResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/IssueDeploymentToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipedServiceClient) GetPromotionArtifact(ctx context.Context, in *GetPromotionArtifactRequest, opts ...grpc.CallOption) (*GetPromotionArtifactResponse, error) {
+	out := new(GetPromotionArtifactResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/GetPromotionArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipedServiceClient) ReportDeploymentDiff(ctx context.Context, in *ReportDeploymentDiffRequest, opts ...grpc.CallOption) (*ReportDeploymentDiffResponse, error) {
+	out := new(ReportDeploymentDiffResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/ReportDeploymentDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipedServiceClient) UploadStageArtifact(ctx context.Context, in *UploadStageArtifactRequest, opts ...grpc.CallOption) (*UploadStageArtifactResponse, error) {
+	out := new(UploadStageArtifactResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/UploadStageArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipedServiceClient) GetDeploymentRisk(ctx context.Context, in *GetDeploymentRiskRequest, opts ...grpc.CallOption) (*GetDeploymentRiskResponse, error) {
+	out := new(GetDeploymentRiskResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/GetDeploymentRisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipedServiceClient) RegisterEvent(ctx context.Context, in *RegisterEventRequest, opts ...grpc.CallOption) (*RegisterEventResponse, error) {
+	out := new(RegisterEventResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/RegisterEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PipedServiceServer is the server API for PipedService service.
 // All implementations must embed UnimplementedPipedServiceServer
 // for forward compatibility
@@ -577,6 +653,28 @@ type PipedServiceServer interface {
 	// In case the previous block of this deployment is finished with FAILURE | CANCELLED status,
 	// `cancel` flag will be returned to aware piped to stop this deployment.
 	InChainDeploymentPlannable(context.Context, *InChainDeploymentPlannableRequest) (*InChainDeploymentPlannableResponse, error)
+	// IssueDeploymentToken issues a short-lived token scoped to the given deployment.
+	// The issued token can be used instead of PIPED_TOKEN while calling the per-deployment RPCs
+	// such as saving deployment metadata or reporting stage logs of that deployment.
+	// This must be called by using PIPED_TOKEN.
+	IssueDeploymentToken(context.Context, *IssueDeploymentTokenRequest) (*IssueDeploymentTokenResponse, error)
+	// GetPromotionArtifact returns the artifact of the most recently successful deployment
+	// of the given application in the same project.
+	// It is used while planning a deployment that promotes the artifact of another application.
+	GetPromotionArtifact(context.Context, *GetPromotionArtifactRequest) (*GetPromotionArtifactResponse, error)
+	// ReportDeploymentDiff is used to save the rendered diff of a deployment
+	// so that it can be shown after the deployment was planned.
+	// The previously reported diff of the same deployment will be overwritten.
+	ReportDeploymentDiff(context.Context, *ReportDeploymentDiffRequest) (*ReportDeploymentDiffResponse, error)
+	// UploadStageArtifact is used to save a file produced while executing a stage.
+	// The previously uploaded artifact with the same name in the same stage will be overwritten.
+	UploadStageArtifact(context.Context, *UploadStageArtifactRequest) (*UploadStageArtifactResponse, error)
+	// GetDeploymentRisk returns the heuristic risk score of a deployment
+	// computed with the scoring weights configured for its project.
+	GetDeploymentRisk(context.Context, *GetDeploymentRiskRequest) (*GetDeploymentRiskResponse, error)
+	// RegisterEvent registers an event produced while executing a deployment,
+	// so that the applications watching it by EventWatcher are updated.
+	RegisterEvent(context.Context, *RegisterEventRequest) (*RegisterEventResponse, error)
 	mustEmbedUnimplementedPipedServiceServer()
 }
 
@@ -686,6 +784,24 @@ func (UnimplementedPipedServiceServer) CreateDeploymentChain(context.Context, *C
 func (UnimplementedPipedServiceServer) InChainDeploymentPlannable(context.Context, *InChainDeploymentPlannableRequest) (*InChainDeploymentPlannableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InChainDeploymentPlannable not implemented")
 }
+func (UnimplementedPipedServiceServer) IssueDeploymentToken(context.Context, *IssueDeploymentTokenRequest) (*IssueDeploymentTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueDeploymentToken not implemented")
+}
+func (UnimplementedPipedServiceServer) GetPromotionArtifact(context.Context, *GetPromotionArtifactRequest) (*GetPromotionArtifactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromotionArtifact not implemented")
+}
+func (UnimplementedPipedServiceServer) ReportDeploymentDiff(context.Context, *ReportDeploymentDiffRequest) (*ReportDeploymentDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportDeploymentDiff not implemented")
+}
+func (UnimplementedPipedServiceServer) UploadStageArtifact(context.Context, *UploadStageArtifactRequest) (*UploadStageArtifactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadStageArtifact not implemented")
+}
+func (UnimplementedPipedServiceServer) GetDeploymentRisk(context.Context, *GetDeploymentRiskRequest) (*GetDeploymentRiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeploymentRisk not implemented")
+}
+func (UnimplementedPipedServiceServer) RegisterEvent(context.Context, *RegisterEventRequest) (*RegisterEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterEvent not implemented")
+}
 func (UnimplementedPipedServiceServer) mustEmbedUnimplementedPipedServiceServer() {}
 
 // UnsafePipedServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1311,6 +1427,114 @@ func _PipedService_InChainDeploymentPlannable_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PipedService_IssueDeploymentToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueDeploymentTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipedServiceServer).IssueDeploymentToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.pipedservice.PipedService/IssueDeploymentToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipedServiceServer).IssueDeploymentToken(ctx, req.(*IssueDeploymentTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipedService_GetPromotionArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPromotionArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipedServiceServer).GetPromotionArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.pipedservice.PipedService/GetPromotionArtifact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipedServiceServer).GetPromotionArtifact(ctx, req.(*GetPromotionArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipedService_ReportDeploymentDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportDeploymentDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipedServiceServer).ReportDeploymentDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.pipedservice.PipedService/ReportDeploymentDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipedServiceServer).ReportDeploymentDiff(ctx, req.(*ReportDeploymentDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipedService_UploadStageArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadStageArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipedServiceServer).UploadStageArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.pipedservice.PipedService/UploadStageArtifact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipedServiceServer).UploadStageArtifact(ctx, req.(*UploadStageArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipedService_GetDeploymentRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeploymentRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipedServiceServer).GetDeploymentRisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.pipedservice.PipedService/GetDeploymentRisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipedServiceServer).GetDeploymentRisk(ctx, req.(*GetDeploymentRiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PipedService_RegisterEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipedServiceServer).RegisterEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.pipedservice.PipedService/RegisterEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipedServiceServer).RegisterEvent(ctx, req.(*RegisterEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PipedService_ServiceDesc is the grpc.ServiceDesc for PipedService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1454,6 +1678,30 @@ var PipedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InChainDeploymentPlannable",
 			Handler:    _PipedService_InChainDeploymentPlannable_Handler,
 		},
+		{
+			MethodName: "IssueDeploymentToken",
+			Handler:    _PipedService_IssueDeploymentToken_Handler,
+		},
+		{
+			MethodName: "GetPromotionArtifact",
+			Handler:    _PipedService_GetPromotionArtifact_Handler,
+		},
+		{
+			MethodName: "ReportDeploymentDiff",
+			Handler:    _PipedService_ReportDeploymentDiff_Handler,
+		},
+		{
+			MethodName: "UploadStageArtifact",
+			Handler:    _PipedService_UploadStageArtifact_Handler,
+		},
+		{
+			MethodName: "GetDeploymentRisk",
+			Handler:    _PipedService_GetDeploymentRisk_Handler,
+		},
+		{
+			MethodName: "RegisterEvent",
+			Handler:    _PipedService_RegisterEvent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/app/server/service/pipedservice/service.proto",
@@ -155,14 +155,24 @@ func (a *authorizer) Authorize(ctx context.Context, method string, r model.Role)
 		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_LIST)
 	case "/grpc.service.webservice.WebService/GetDeployment":
 		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_GET)
+	case "/grpc.service.webservice.WebService/GetDeploymentDiff":
+		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_GET)
 	case "/grpc.service.webservice.WebService/GetStageLog":
 		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_GET)
+	case "/grpc.service.webservice.WebService/ListStageArtifacts":
+		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_GET)
+	case "/grpc.service.webservice.WebService/GetStageArtifact":
+		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_GET)
 	case "/grpc.service.webservice.WebService/CancelDeployment":
 		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_UPDATE)
+	case "/grpc.service.webservice.WebService/RetryDeployment":
+		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_UPDATE)
 	case "/grpc.service.webservice.WebService/SkipStage":
 		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_UPDATE)
 	case "/grpc.service.webservice.WebService/ApproveStage":
 		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_UPDATE)
+	case "/grpc.service.webservice.WebService/AddDeploymentComment":
+		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_UPDATE)
 	case "/grpc.service.webservice.WebService/ListDeploymentTraces":
 		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_LIST)
 	case "/grpc.service.webservice.WebService/ListEvents":
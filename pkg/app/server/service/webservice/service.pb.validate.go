@@ -5737,6 +5737,253 @@ var _ interface {
 	ErrorName() string
 } = GetDeploymentResponseValidationError{}
 
+// Validate checks the field values on GetDeploymentDiffRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetDeploymentDiffRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeploymentDiffRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeploymentDiffRequestMultiError, or nil if none found.
+func (m *GetDeploymentDiffRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeploymentDiffRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := GetDeploymentDiffRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetDeploymentDiffRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeploymentDiffRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDeploymentDiffRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDeploymentDiffRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeploymentDiffRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeploymentDiffRequestMultiError) AllErrors() []error { return m }
+
+// GetDeploymentDiffRequestValidationError is the validation error returned by
+// GetDeploymentDiffRequest.Validate if the designated constraints aren't met.
+type GetDeploymentDiffRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeploymentDiffRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeploymentDiffRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeploymentDiffRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeploymentDiffRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeploymentDiffRequestValidationError) ErrorName() string {
+	return "GetDeploymentDiffRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeploymentDiffRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeploymentDiffRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeploymentDiffRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeploymentDiffRequestValidationError{}
+
+// Validate checks the field values on GetDeploymentDiffResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetDeploymentDiffResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeploymentDiffResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeploymentDiffResponseMultiError, or nil if none found.
+func (m *GetDeploymentDiffResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeploymentDiffResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDiff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDeploymentDiffResponseValidationError{
+					field:  "Diff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDeploymentDiffResponseValidationError{
+					field:  "Diff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDeploymentDiffResponseValidationError{
+				field:  "Diff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDeploymentDiffResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeploymentDiffResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDeploymentDiffResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetDeploymentDiffResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeploymentDiffResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeploymentDiffResponseMultiError) AllErrors() []error { return m }
+
+// GetDeploymentDiffResponseValidationError is the validation error returned by
+// GetDeploymentDiffResponse.Validate if the designated constraints aren't
+// met.
+type GetDeploymentDiffResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeploymentDiffResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeploymentDiffResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeploymentDiffResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeploymentDiffResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeploymentDiffResponseValidationError) ErrorName() string {
+	return "GetDeploymentDiffResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeploymentDiffResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeploymentDiffResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeploymentDiffResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeploymentDiffResponseValidationError{}
+
 // Validate checks the field values on GetStageLogRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -5993,7 +6240,762 @@ func (e GetStageLogResponseValidationError) Error() string {
 		cause)
 }
 
-var _ error = GetStageLogResponseValidationError{}
+var _ error = GetStageLogResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStageLogResponseValidationError{}
+
+// Validate checks the field values on ListStageArtifactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListStageArtifactsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStageArtifactsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStageArtifactsRequestMultiError, or nil if none found.
+func (m *ListStageArtifactsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStageArtifactsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := ListStageArtifactsRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := ListStageArtifactsRequestValidationError{
+			field:  "StageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListStageArtifactsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStageArtifactsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListStageArtifactsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListStageArtifactsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStageArtifactsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStageArtifactsRequestMultiError) AllErrors() []error { return m }
+
+// ListStageArtifactsRequestValidationError is the validation error returned by
+// ListStageArtifactsRequest.Validate if the designated constraints aren't
+// met.
+type ListStageArtifactsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStageArtifactsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStageArtifactsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStageArtifactsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStageArtifactsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStageArtifactsRequestValidationError) ErrorName() string {
+	return "ListStageArtifactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStageArtifactsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStageArtifactsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStageArtifactsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStageArtifactsRequestValidationError{}
+
+// Validate checks the field values on ListStageArtifactsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListStageArtifactsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStageArtifactsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStageArtifactsResponseMultiError, or nil if none found.
+func (m *ListStageArtifactsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStageArtifactsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetArtifacts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStageArtifactsResponseValidationError{
+						field:  fmt.Sprintf("Artifacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStageArtifactsResponseValidationError{
+						field:  fmt.Sprintf("Artifacts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStageArtifactsResponseValidationError{
+					field:  fmt.Sprintf("Artifacts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListStageArtifactsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStageArtifactsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListStageArtifactsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListStageArtifactsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStageArtifactsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStageArtifactsResponseMultiError) AllErrors() []error { return m }
+
+// ListStageArtifactsResponseValidationError is the validation error returned
+// by ListStageArtifactsResponse.Validate if the designated constraints aren't
+// met.
+type ListStageArtifactsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStageArtifactsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStageArtifactsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStageArtifactsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStageArtifactsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStageArtifactsResponseValidationError) ErrorName() string {
+	return "ListStageArtifactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStageArtifactsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStageArtifactsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStageArtifactsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStageArtifactsResponseValidationError{}
+
+// Validate checks the field values on GetStageArtifactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetStageArtifactRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStageArtifactRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStageArtifactRequestMultiError, or nil if none found.
+func (m *GetStageArtifactRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStageArtifactRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := GetStageArtifactRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := GetStageArtifactRequestValidationError{
+			field:  "StageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := GetStageArtifactRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetStageArtifactRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStageArtifactRequestMultiError is an error wrapping multiple validation
+// errors returned by GetStageArtifactRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetStageArtifactRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStageArtifactRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStageArtifactRequestMultiError) AllErrors() []error { return m }
+
+// GetStageArtifactRequestValidationError is the validation error returned by
+// GetStageArtifactRequest.Validate if the designated constraints aren't met.
+type GetStageArtifactRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStageArtifactRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStageArtifactRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStageArtifactRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStageArtifactRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStageArtifactRequestValidationError) ErrorName() string {
+	return "GetStageArtifactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStageArtifactRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStageArtifactRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStageArtifactRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStageArtifactRequestValidationError{}
+
+// Validate checks the field values on GetStageArtifactResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetStageArtifactResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStageArtifactResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStageArtifactResponseMultiError, or nil if none found.
+func (m *GetStageArtifactResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStageArtifactResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArtifact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetStageArtifactResponseValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetStageArtifactResponseValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetStageArtifactResponseValidationError{
+				field:  "Artifact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return GetStageArtifactResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStageArtifactResponseMultiError is an error wrapping multiple validation
+// errors returned by GetStageArtifactResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetStageArtifactResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStageArtifactResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStageArtifactResponseMultiError) AllErrors() []error { return m }
+
+// GetStageArtifactResponseValidationError is the validation error returned by
+// GetStageArtifactResponse.Validate if the designated constraints aren't met.
+type GetStageArtifactResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStageArtifactResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStageArtifactResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStageArtifactResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStageArtifactResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStageArtifactResponseValidationError) ErrorName() string {
+	return "GetStageArtifactResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStageArtifactResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStageArtifactResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStageArtifactResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStageArtifactResponseValidationError{}
+
+// Validate checks the field values on CancelDeploymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelDeploymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelDeploymentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelDeploymentRequestMultiError, or nil if none found.
+func (m *CancelDeploymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelDeploymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := CancelDeploymentRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ForceRollback
+
+	// no validation rules for ForceNoRollback
+
+	if len(errors) > 0 {
+		return CancelDeploymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelDeploymentRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelDeploymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelDeploymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelDeploymentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelDeploymentRequestMultiError) AllErrors() []error { return m }
+
+// CancelDeploymentRequestValidationError is the validation error returned by
+// CancelDeploymentRequest.Validate if the designated constraints aren't met.
+type CancelDeploymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelDeploymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelDeploymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelDeploymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelDeploymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelDeploymentRequestValidationError) ErrorName() string {
+	return "CancelDeploymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelDeploymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelDeploymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelDeploymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelDeploymentRequestValidationError{}
+
+// Validate checks the field values on CancelDeploymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelDeploymentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelDeploymentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelDeploymentResponseMultiError, or nil if none found.
+func (m *CancelDeploymentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelDeploymentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CommandId
+
+	if len(errors) > 0 {
+		return CancelDeploymentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelDeploymentResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelDeploymentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelDeploymentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelDeploymentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelDeploymentResponseMultiError) AllErrors() []error { return m }
+
+// CancelDeploymentResponseValidationError is the validation error returned by
+// CancelDeploymentResponse.Validate if the designated constraints aren't met.
+type CancelDeploymentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelDeploymentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelDeploymentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelDeploymentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelDeploymentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelDeploymentResponseValidationError) ErrorName() string {
+	return "CancelDeploymentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelDeploymentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelDeploymentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelDeploymentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6001,24 +7003,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetStageLogResponseValidationError{}
+} = CancelDeploymentResponseValidationError{}
 
-// Validate checks the field values on CancelDeploymentRequest with the rules
+// Validate checks the field values on RetryDeploymentRequest with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelDeploymentRequest) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RetryDeploymentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelDeploymentRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RetryDeploymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelDeploymentRequestMultiError, or nil if none found.
-func (m *CancelDeploymentRequest) ValidateAll() error {
+// RetryDeploymentRequestMultiError, or nil if none found.
+func (m *RetryDeploymentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelDeploymentRequest) validate(all bool) error {
+func (m *RetryDeploymentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6026,7 +7029,7 @@ func (m *CancelDeploymentRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
-		err := CancelDeploymentRequestValidationError{
+		err := RetryDeploymentRequestValidationError{
 			field:  "DeploymentId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -6036,24 +7039,20 @@ func (m *CancelDeploymentRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for ForceRollback
-
-	// no validation rules for ForceNoRollback
-
 	if len(errors) > 0 {
-		return CancelDeploymentRequestMultiError(errors)
+		return RetryDeploymentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelDeploymentRequestMultiError is an error wrapping multiple validation
-// errors returned by CancelDeploymentRequest.ValidateAll() if the designated
+// RetryDeploymentRequestMultiError is an error wrapping multiple validation
+// errors returned by RetryDeploymentRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CancelDeploymentRequestMultiError []error
+type RetryDeploymentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelDeploymentRequestMultiError) Error() string {
+func (m RetryDeploymentRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6062,11 +7061,11 @@ func (m CancelDeploymentRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelDeploymentRequestMultiError) AllErrors() []error { return m }
+func (m RetryDeploymentRequestMultiError) AllErrors() []error { return m }
 
-// CancelDeploymentRequestValidationError is the validation error returned by
-// CancelDeploymentRequest.Validate if the designated constraints aren't met.
-type CancelDeploymentRequestValidationError struct {
+// RetryDeploymentRequestValidationError is the validation error returned by
+// RetryDeploymentRequest.Validate if the designated constraints aren't met.
+type RetryDeploymentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6074,24 +7073,24 @@ type CancelDeploymentRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelDeploymentRequestValidationError) Field() string { return e.field }
+func (e RetryDeploymentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelDeploymentRequestValidationError) Reason() string { return e.reason }
+func (e RetryDeploymentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelDeploymentRequestValidationError) Cause() error { return e.cause }
+func (e RetryDeploymentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelDeploymentRequestValidationError) Key() bool { return e.key }
+func (e RetryDeploymentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelDeploymentRequestValidationError) ErrorName() string {
-	return "CancelDeploymentRequestValidationError"
+func (e RetryDeploymentRequestValidationError) ErrorName() string {
+	return "RetryDeploymentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelDeploymentRequestValidationError) Error() string {
+func (e RetryDeploymentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6103,14 +7102,14 @@ func (e CancelDeploymentRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelDeploymentRequest.%s: %s%s",
+		"invalid %sRetryDeploymentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelDeploymentRequestValidationError{}
+var _ error = RetryDeploymentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6118,24 +7117,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelDeploymentRequestValidationError{}
+} = RetryDeploymentRequestValidationError{}
 
-// Validate checks the field values on CancelDeploymentResponse with the rules
+// Validate checks the field values on RetryDeploymentResponse with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelDeploymentResponse) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RetryDeploymentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelDeploymentResponse with the
+// ValidateAll checks the field values on RetryDeploymentResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelDeploymentResponseMultiError, or nil if none found.
-func (m *CancelDeploymentResponse) ValidateAll() error {
+// RetryDeploymentResponseMultiError, or nil if none found.
+func (m *RetryDeploymentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelDeploymentResponse) validate(all bool) error {
+func (m *RetryDeploymentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6145,19 +7145,19 @@ func (m *CancelDeploymentResponse) validate(all bool) error {
 	// no validation rules for CommandId
 
 	if len(errors) > 0 {
-		return CancelDeploymentResponseMultiError(errors)
+		return RetryDeploymentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelDeploymentResponseMultiError is an error wrapping multiple validation
-// errors returned by CancelDeploymentResponse.ValidateAll() if the designated
+// RetryDeploymentResponseMultiError is an error wrapping multiple validation
+// errors returned by RetryDeploymentResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CancelDeploymentResponseMultiError []error
+type RetryDeploymentResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelDeploymentResponseMultiError) Error() string {
+func (m RetryDeploymentResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6166,11 +7166,11 @@ func (m CancelDeploymentResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelDeploymentResponseMultiError) AllErrors() []error { return m }
+func (m RetryDeploymentResponseMultiError) AllErrors() []error { return m }
 
-// CancelDeploymentResponseValidationError is the validation error returned by
-// CancelDeploymentResponse.Validate if the designated constraints aren't met.
-type CancelDeploymentResponseValidationError struct {
+// RetryDeploymentResponseValidationError is the validation error returned by
+// RetryDeploymentResponse.Validate if the designated constraints aren't met.
+type RetryDeploymentResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6178,24 +7178,24 @@ type CancelDeploymentResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelDeploymentResponseValidationError) Field() string { return e.field }
+func (e RetryDeploymentResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelDeploymentResponseValidationError) Reason() string { return e.reason }
+func (e RetryDeploymentResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelDeploymentResponseValidationError) Cause() error { return e.cause }
+func (e RetryDeploymentResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelDeploymentResponseValidationError) Key() bool { return e.key }
+func (e RetryDeploymentResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelDeploymentResponseValidationError) ErrorName() string {
-	return "CancelDeploymentResponseValidationError"
+func (e RetryDeploymentResponseValidationError) ErrorName() string {
+	return "RetryDeploymentResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelDeploymentResponseValidationError) Error() string {
+func (e RetryDeploymentResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6207,14 +7207,14 @@ func (e CancelDeploymentResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelDeploymentResponse.%s: %s%s",
+		"invalid %sRetryDeploymentResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelDeploymentResponseValidationError{}
+var _ error = RetryDeploymentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6222,7 +7222,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelDeploymentResponseValidationError{}
+} = RetryDeploymentResponseValidationError{}
 
 // Validate checks the field values on SkipStageRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -6678,6 +7678,265 @@ var _ interface {
 	ErrorName() string
 } = ApproveStageResponseValidationError{}
 
+// Validate checks the field values on AddDeploymentCommentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AddDeploymentCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddDeploymentCommentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddDeploymentCommentRequestMultiError, or nil if none found.
+func (m *AddDeploymentCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddDeploymentCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := AddDeploymentCommentRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) < 1 {
+		err := AddDeploymentCommentRequestValidationError{
+			field:  "Comment",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddDeploymentCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddDeploymentCommentRequestMultiError is an error wrapping multiple
+// validation errors returned by AddDeploymentCommentRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AddDeploymentCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddDeploymentCommentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddDeploymentCommentRequestMultiError) AllErrors() []error { return m }
+
+// AddDeploymentCommentRequestValidationError is the validation error returned
+// by AddDeploymentCommentRequest.Validate if the designated constraints
+// aren't met.
+type AddDeploymentCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddDeploymentCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddDeploymentCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddDeploymentCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddDeploymentCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddDeploymentCommentRequestValidationError) ErrorName() string {
+	return "AddDeploymentCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddDeploymentCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddDeploymentCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddDeploymentCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddDeploymentCommentRequestValidationError{}
+
+// Validate checks the field values on AddDeploymentCommentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AddDeploymentCommentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddDeploymentCommentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddDeploymentCommentResponseMultiError, or nil if none found.
+func (m *AddDeploymentCommentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddDeploymentCommentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetComment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddDeploymentCommentResponseValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddDeploymentCommentResponseValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddDeploymentCommentResponseValidationError{
+				field:  "Comment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddDeploymentCommentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddDeploymentCommentResponseMultiError is an error wrapping multiple
+// validation errors returned by AddDeploymentCommentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AddDeploymentCommentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddDeploymentCommentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddDeploymentCommentResponseMultiError) AllErrors() []error { return m }
+
+// AddDeploymentCommentResponseValidationError is the validation error returned
+// by AddDeploymentCommentResponse.Validate if the designated constraints
+// aren't met.
+type AddDeploymentCommentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddDeploymentCommentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddDeploymentCommentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddDeploymentCommentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddDeploymentCommentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddDeploymentCommentResponseValidationError) ErrorName() string {
+	return "AddDeploymentCommentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddDeploymentCommentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddDeploymentCommentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddDeploymentCommentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddDeploymentCommentResponseValidationError{}
+
 // Validate checks the field values on ListDeploymentTracesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -49,10 +49,15 @@ type WebServiceClient interface {
 	// Deployment
 	ListDeployments(ctx context.Context, in *ListDeploymentsRequest, opts ...grpc.CallOption) (*ListDeploymentsResponse, error)
 	GetDeployment(ctx context.Context, in *GetDeploymentRequest, opts ...grpc.CallOption) (*GetDeploymentResponse, error)
+	GetDeploymentDiff(ctx context.Context, in *GetDeploymentDiffRequest, opts ...grpc.CallOption) (*GetDeploymentDiffResponse, error)
 	GetStageLog(ctx context.Context, in *GetStageLogRequest, opts ...grpc.CallOption) (*GetStageLogResponse, error)
+	ListStageArtifacts(ctx context.Context, in *ListStageArtifactsRequest, opts ...grpc.CallOption) (*ListStageArtifactsResponse, error)
+	GetStageArtifact(ctx context.Context, in *GetStageArtifactRequest, opts ...grpc.CallOption) (*GetStageArtifactResponse, error)
 	CancelDeployment(ctx context.Context, in *CancelDeploymentRequest, opts ...grpc.CallOption) (*CancelDeploymentResponse, error)
+	RetryDeployment(ctx context.Context, in *RetryDeploymentRequest, opts ...grpc.CallOption) (*RetryDeploymentResponse, error)
 	SkipStage(ctx context.Context, in *SkipStageRequest, opts ...grpc.CallOption) (*SkipStageResponse, error)
 	ApproveStage(ctx context.Context, in *ApproveStageRequest, opts ...grpc.CallOption) (*ApproveStageResponse, error)
+	AddDeploymentComment(ctx context.Context, in *AddDeploymentCommentRequest, opts ...grpc.CallOption) (*AddDeploymentCommentResponse, error)
 	// Deployment tracing
 	ListDeploymentTraces(ctx context.Context, in *ListDeploymentTracesRequest, opts ...grpc.CallOption) (*ListDeploymentTracesResponse, error)
 	// ApplicationLiveState
@@ -310,6 +315,15 @@ func (c *webServiceClient) GetDeployment(ctx context.Context, in *GetDeploymentR
 	return out, nil
 }
 
+func (c *webServiceClient) GetDeploymentDiff(ctx context.Context, in *GetDeploymentDiffRequest, opts ...grpc.CallOption) (*GetDeploymentDiffResponse, error) {
+	out := new(GetDeploymentDiffResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/GetDeploymentDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webServiceClient) GetStageLog(ctx context.Context, in *GetStageLogRequest, opts ...grpc.CallOption) (*GetStageLogResponse, error) {
 	out := new(GetStageLogResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/GetStageLog", in, out, opts...)
@@ -319,6 +333,24 @@ func (c *webServiceClient) GetStageLog(ctx context.Context, in *GetStageLogReque
 	return out, nil
 }
 
+func (c *webServiceClient) ListStageArtifacts(ctx context.Context, in *ListStageArtifactsRequest, opts ...grpc.CallOption) (*ListStageArtifactsResponse, error) {
+	out := new(ListStageArtifactsResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/ListStageArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webServiceClient) GetStageArtifact(ctx context.Context, in *GetStageArtifactRequest, opts ...grpc.CallOption) (*GetStageArtifactResponse, error) {
+	out := new(GetStageArtifactResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/GetStageArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webServiceClient) CancelDeployment(ctx context.Context, in *CancelDeploymentRequest, opts ...grpc.CallOption) (*CancelDeploymentResponse, error) {
 	out := new(CancelDeploymentResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/CancelDeployment", in, out, opts...)
@@ -328,6 +360,15 @@ func (c *webServiceClient) CancelDeployment(ctx context.Context, in *CancelDeplo
 	return out, nil
 }
 
+func (c *webServiceClient) RetryDeployment(ctx context.Context, in *RetryDeploymentRequest, opts ...grpc.CallOption) (*RetryDeploymentResponse, error) {
+	out := new(RetryDeploymentResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/RetryDeployment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webServiceClient) SkipStage(ctx context.Context, in *SkipStageRequest, opts ...grpc.CallOption) (*SkipStageResponse, error) {
 	out := new(SkipStageResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/SkipStage", in, out, opts...)
@@ -346,6 +387,15 @@ func (c *webServiceClient) ApproveStage(ctx context.Context, in *ApproveStageReq
 	return out, nil
 }
 
+func (c *webServiceClient) AddDeploymentComment(ctx context.Context, in *AddDeploymentCommentRequest, opts ...grpc.CallOption) (*AddDeploymentCommentResponse, error) {
+	out := new(AddDeploymentCommentResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/AddDeploymentComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webServiceClient) ListDeploymentTraces(ctx context.Context, in *ListDeploymentTracesRequest, opts ...grpc.CallOption) (*ListDeploymentTracesResponse, error) {
 	out := new(ListDeploymentTracesResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/ListDeploymentTraces", in, out, opts...)
@@ -584,10 +634,15 @@ type WebServiceServer interface {
 	// Deployment
 	ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error)
 	GetDeployment(context.Context, *GetDeploymentRequest) (*GetDeploymentResponse, error)
+	GetDeploymentDiff(context.Context, *GetDeploymentDiffRequest) (*GetDeploymentDiffResponse, error)
 	GetStageLog(context.Context, *GetStageLogRequest) (*GetStageLogResponse, error)
+	ListStageArtifacts(context.Context, *ListStageArtifactsRequest) (*ListStageArtifactsResponse, error)
+	GetStageArtifact(context.Context, *GetStageArtifactRequest) (*GetStageArtifactResponse, error)
 	CancelDeployment(context.Context, *CancelDeploymentRequest) (*CancelDeploymentResponse, error)
+	RetryDeployment(context.Context, *RetryDeploymentRequest) (*RetryDeploymentResponse, error)
 	SkipStage(context.Context, *SkipStageRequest) (*SkipStageResponse, error)
 	ApproveStage(context.Context, *ApproveStageRequest) (*ApproveStageResponse, error)
+	AddDeploymentComment(context.Context, *AddDeploymentCommentRequest) (*AddDeploymentCommentResponse, error)
 	// Deployment tracing
 	ListDeploymentTraces(context.Context, *ListDeploymentTracesRequest) (*ListDeploymentTracesResponse, error)
 	// ApplicationLiveState
@@ -698,18 +753,33 @@ func (UnimplementedWebServiceServer) ListDeployments(context.Context, *ListDeplo
 func (UnimplementedWebServiceServer) GetDeployment(context.Context, *GetDeploymentRequest) (*GetDeploymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeployment not implemented")
 }
+func (UnimplementedWebServiceServer) GetDeploymentDiff(context.Context, *GetDeploymentDiffRequest) (*GetDeploymentDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeploymentDiff not implemented")
+}
 func (UnimplementedWebServiceServer) GetStageLog(context.Context, *GetStageLogRequest) (*GetStageLogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStageLog not implemented")
 }
+func (UnimplementedWebServiceServer) ListStageArtifacts(context.Context, *ListStageArtifactsRequest) (*ListStageArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStageArtifacts not implemented")
+}
+func (UnimplementedWebServiceServer) GetStageArtifact(context.Context, *GetStageArtifactRequest) (*GetStageArtifactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStageArtifact not implemented")
+}
 func (UnimplementedWebServiceServer) CancelDeployment(context.Context, *CancelDeploymentRequest) (*CancelDeploymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelDeployment not implemented")
 }
+func (UnimplementedWebServiceServer) RetryDeployment(context.Context, *RetryDeploymentRequest) (*RetryDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryDeployment not implemented")
+}
 func (UnimplementedWebServiceServer) SkipStage(context.Context, *SkipStageRequest) (*SkipStageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SkipStage not implemented")
 }
 func (UnimplementedWebServiceServer) ApproveStage(context.Context, *ApproveStageRequest) (*ApproveStageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApproveStage not implemented")
 }
+func (UnimplementedWebServiceServer) AddDeploymentComment(context.Context, *AddDeploymentCommentRequest) (*AddDeploymentCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDeploymentComment not implemented")
+}
 func (UnimplementedWebServiceServer) ListDeploymentTraces(context.Context, *ListDeploymentTracesRequest) (*ListDeploymentTracesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDeploymentTraces not implemented")
 }
@@ -1224,6 +1294,24 @@ func _WebService_GetDeployment_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebService_GetDeploymentDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeploymentDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).GetDeploymentDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/GetDeploymentDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).GetDeploymentDiff(ctx, req.(*GetDeploymentDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WebService_GetStageLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetStageLogRequest)
 	if err := dec(in); err != nil {
@@ -1242,6 +1330,42 @@ func _WebService_GetStageLog_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebService_ListStageArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStageArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).ListStageArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/ListStageArtifacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).ListStageArtifacts(ctx, req.(*ListStageArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebService_GetStageArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStageArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).GetStageArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/GetStageArtifact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).GetStageArtifact(ctx, req.(*GetStageArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WebService_CancelDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelDeploymentRequest)
 	if err := dec(in); err != nil {
@@ -1260,6 +1384,24 @@ func _WebService_CancelDeployment_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebService_RetryDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).RetryDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/RetryDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).RetryDeployment(ctx, req.(*RetryDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WebService_SkipStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SkipStageRequest)
 	if err := dec(in); err != nil {
@@ -1296,6 +1438,24 @@ func _WebService_ApproveStage_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebService_AddDeploymentComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDeploymentCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).AddDeploymentComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/AddDeploymentComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).AddDeploymentComment(ctx, req.(*AddDeploymentCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WebService_ListDeploymentTraces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListDeploymentTracesRequest)
 	if err := dec(in); err != nil {
@@ -1813,14 +1973,30 @@ var WebService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDeployment",
 			Handler:    _WebService_GetDeployment_Handler,
 		},
+		{
+			MethodName: "GetDeploymentDiff",
+			Handler:    _WebService_GetDeploymentDiff_Handler,
+		},
 		{
 			MethodName: "GetStageLog",
 			Handler:    _WebService_GetStageLog_Handler,
 		},
+		{
+			MethodName: "ListStageArtifacts",
+			Handler:    _WebService_ListStageArtifacts_Handler,
+		},
+		{
+			MethodName: "GetStageArtifact",
+			Handler:    _WebService_GetStageArtifact_Handler,
+		},
 		{
 			MethodName: "CancelDeployment",
 			Handler:    _WebService_CancelDeployment_Handler,
 		},
+		{
+			MethodName: "RetryDeployment",
+			Handler:    _WebService_RetryDeployment_Handler,
+		},
 		{
 			MethodName: "SkipStage",
 			Handler:    _WebService_SkipStage_Handler,
@@ -1829,6 +2005,10 @@ var WebService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApproveStage",
 			Handler:    _WebService_ApproveStage_Handler,
 		},
+		{
+			MethodName: "AddDeploymentComment",
+			Handler:    _WebService_AddDeploymentComment_Handler,
+		},
 		{
 			MethodName: "ListDeploymentTraces",
 			Handler:    _WebService_ListDeploymentTraces_Handler,
@@ -0,0 +1,166 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stageartifactstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/filestore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	prefix = "stage-artifacts/"
+	// MaxContentSize is the maximum size of an artifact content in bytes.
+	MaxContentSize = 3 * 1024 * 1024
+)
+
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrInvalidName  = errors.New("artifact name must consist of alphanumeric characters, '-', '_' or '.'")
+	ErrInvalidStage = errors.New("stage must be one of the deployment and its ID must consist of alphanumeric characters, '-', '_' or '.'")
+	ErrTooLarge     = fmt.Errorf("artifact content must not be larger than %d bytes", MaxContentSize)
+
+	nameRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+)
+
+type Store interface {
+	// Get returns the content of the specified artifact.
+	Get(ctx context.Context, deployment *model.Deployment, stageID, name string) ([]byte, error)
+	// Put saves the content of an artifact produced while executing the specified stage.
+	Put(ctx context.Context, deployment *model.Deployment, stageID, name string, content []byte) error
+	// List returns all artifacts of the specified stage.
+	List(ctx context.Context, deployment *model.Deployment, stageID string) ([]*model.StageArtifact, error)
+}
+
+type store struct {
+	backend filestore.Store
+	logger  *zap.Logger
+}
+
+func NewStore(fs filestore.Store, logger *zap.Logger) Store {
+	return &store{
+		backend: fs,
+		logger:  logger.Named("stage-artifact-store"),
+	}
+}
+
+func (s *store) Get(ctx context.Context, deployment *model.Deployment, stageID, name string) ([]byte, error) {
+	if err := validateStage(deployment, stageID); err != nil {
+		return nil, err
+	}
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	content, err := s.backend.Get(ctx, dataPath(deployment.Id, stageID, name))
+	if err != nil {
+		if err == filestore.ErrNotFound {
+			return nil, ErrNotFound
+		}
+		s.logger.Error("failed to get stage artifact from filestore",
+			zap.String("deployment", deployment.Id),
+			zap.String("stage", stageID),
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return content, nil
+}
+
+func (s *store) Put(ctx context.Context, deployment *model.Deployment, stageID, name string, content []byte) error {
+	if err := validateStage(deployment, stageID); err != nil {
+		return err
+	}
+	if err := validateName(name); err != nil {
+		return err
+	}
+	if len(content) > MaxContentSize {
+		return ErrTooLarge
+	}
+
+	if err := s.backend.Put(ctx, dataPath(deployment.Id, stageID, name), content); err != nil {
+		s.logger.Error("failed to put stage artifact to filestore",
+			zap.String("deployment", deployment.Id),
+			zap.String("stage", stageID),
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+func (s *store) List(ctx context.Context, deployment *model.Deployment, stageID string) ([]*model.StageArtifact, error) {
+	if err := validateStage(deployment, stageID); err != nil {
+		return nil, err
+	}
+
+	objects, err := s.backend.List(ctx, stagePath(deployment.Id, stageID))
+	if err != nil {
+		s.logger.Error("failed to list stage artifacts from filestore",
+			zap.String("deployment", deployment.Id),
+			zap.String("stage", stageID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	artifacts := make([]*model.StageArtifact, 0, len(objects))
+	for _, obj := range objects {
+		artifacts = append(artifacts, &model.StageArtifact{
+			DeploymentId: deployment.Id,
+			StageId:      stageID,
+			Name:         path.Base(obj.Path),
+			Size:         obj.Size,
+			CreatedAt:    obj.UpdatedAt,
+		})
+	}
+	return artifacts, nil
+}
+
+func validateName(name string) error {
+	if !nameRegex.MatchString(name) || name == "." || name == ".." {
+		return ErrInvalidName
+	}
+	return nil
+}
+
+// validateStage checks whether the given stage is one of the given deployment
+// since the stage ID is used as a part of the object path.
+func validateStage(deployment *model.Deployment, stageID string) error {
+	if !nameRegex.MatchString(stageID) || stageID == "." || stageID == ".." {
+		return ErrInvalidStage
+	}
+	if _, ok := deployment.Stage(stageID); !ok {
+		return ErrInvalidStage
+	}
+	return nil
+}
+
+func stagePath(deploymentID, stageID string) string {
+	return fmt.Sprintf("%s%s/%s/", prefix, deploymentID, stageID)
+}
+
+func dataPath(deploymentID, stageID, name string) string {
+	return stagePath(deploymentID, stageID) + name
+}
@@ -0,0 +1,176 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stageartifactstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/filestore"
+	"github.com/pipe-cd/pipecd/pkg/filestore/filestoretest"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+var testDeployment = &model.Deployment{
+	Id: "deployment-id",
+	Stages: []*model.PipelineStage{
+		{Id: "stage-id"},
+	},
+}
+
+func TestGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testcases := []struct {
+		name        string
+		readerErr   error
+		expected    []byte
+		expectedErr error
+	}{
+		{
+			name:        "file not found in filestore",
+			readerErr:   filestore.ErrNotFound,
+			expectedErr: ErrNotFound,
+		},
+		{
+			name:        "unexpected filestore error",
+			readerErr:   errors.New("unexpected"),
+			expectedErr: errors.New("unexpected"),
+		},
+		{
+			name:     "found file in filestore",
+			expected: []byte("Plan: 1 to add, 0 to change, 0 to destroy."),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := filestoretest.NewMockStore(ctrl)
+			fs.EXPECT().Get(context.TODO(), "stage-artifacts/deployment-id/stage-id/plan.txt").Return(tc.expected, tc.readerErr)
+
+			s := NewStore(fs, zap.NewNop())
+			content, err := s.Get(context.TODO(), testDeployment, "stage-id", "plan.txt")
+			require.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expected, content)
+		})
+	}
+}
+
+func TestPut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testcases := []struct {
+		name        string
+		stage       string
+		artifact    string
+		content     []byte
+		expectPut   bool
+		expectedErr error
+	}{
+		{
+			name:      "valid artifact",
+			stage:     "stage-id",
+			artifact:  "plan.txt",
+			content:   []byte("plan"),
+			expectPut: true,
+		},
+		{
+			name:        "name containing a path separator",
+			stage:       "stage-id",
+			artifact:    "../plan.txt",
+			content:     []byte("plan"),
+			expectedErr: ErrInvalidName,
+		},
+		{
+			name:        "dot name",
+			stage:       "stage-id",
+			artifact:    "..",
+			content:     []byte("plan"),
+			expectedErr: ErrInvalidName,
+		},
+		{
+			name:        "too large content",
+			stage:       "stage-id",
+			artifact:    "plan.txt",
+			content:     make([]byte, MaxContentSize+1),
+			expectedErr: ErrTooLarge,
+		},
+		{
+			name:        "stage ID containing a path separator",
+			stage:       "../other-deployment-id/stage-id",
+			artifact:    "plan.txt",
+			content:     []byte("plan"),
+			expectedErr: ErrInvalidStage,
+		},
+		{
+			name:        "stage not belonging to the deployment",
+			stage:       "other-stage-id",
+			artifact:    "plan.txt",
+			content:     []byte("plan"),
+			expectedErr: ErrInvalidStage,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := filestoretest.NewMockStore(ctrl)
+			if tc.expectPut {
+				fs.EXPECT().Put(context.TODO(), "stage-artifacts/deployment-id/stage-id/"+tc.artifact, tc.content).Return(nil)
+			}
+
+			s := NewStore(fs, zap.NewNop())
+			err := s.Put(context.TODO(), testDeployment, tc.stage, tc.artifact, tc.content)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fs := filestoretest.NewMockStore(ctrl)
+	fs.EXPECT().List(context.TODO(), "stage-artifacts/deployment-id/stage-id/").Return([]filestore.ObjectAttrs{
+		{Path: "stage-artifacts/deployment-id/stage-id/plan.txt", Size: 10, UpdatedAt: 1590000000},
+		{Path: "stage-artifacts/deployment-id/stage-id/report.xml", Size: 20, UpdatedAt: 1590000001},
+	}, nil)
+
+	s := NewStore(fs, zap.NewNop())
+	artifacts, err := s.List(context.TODO(), testDeployment, "stage-id")
+	require.NoError(t, err)
+	assert.Equal(t, []*model.StageArtifact{
+		{DeploymentId: "deployment-id", StageId: "stage-id", Name: "plan.txt", Size: 10, CreatedAt: 1590000000},
+		{DeploymentId: "deployment-id", StageId: "stage-id", Name: "report.xml", Size: 20, CreatedAt: 1590000001},
+	}, artifacts)
+}
+
+func TestListInvalidStage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := NewStore(filestoretest.NewMockStore(ctrl), zap.NewNop())
+	_, err := s.List(context.TODO(), testDeployment, "..")
+	assert.Equal(t, ErrInvalidStage, err)
+	_, err = s.Get(context.TODO(), testDeployment, "other-stage-id", "plan.txt")
+	assert.Equal(t, ErrInvalidStage, err)
+}
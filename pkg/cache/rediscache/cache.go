@@ -90,6 +90,25 @@ func (c *RedisCache) Put(k string, v interface{}) error {
 	return err
 }
 
+// PutIfNotExists writes the given value only when the key does not exist yet.
+// It returns false when the key already exists.
+func (c *RedisCache) PutIfNotExists(k string, v interface{}) (bool, error) {
+	conn := c.redis.Get()
+	defer conn.Close()
+	args := []interface{}{k, v, "NX"}
+	if c.ttl != 0 {
+		args = append(args, "EX", c.ttl)
+	}
+	reply, err := conn.Do("SET", args...)
+	if err != nil {
+		if err == redigo.ErrNil {
+			return false, nil
+		}
+		return false, err
+	}
+	return reply != nil, nil
+}
+
 func (c *RedisCache) Delete(k string) error {
 	conn := c.redis.Get()
 	defer conn.Close()
@@ -0,0 +1,93 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+type ABTestingMatchType string
+
+const (
+	ABTestingMatchTypeExact  ABTestingMatchType = "exact"
+	ABTestingMatchTypePrefix ABTestingMatchType = "prefix"
+	ABTestingMatchTypeRegex  ABTestingMatchType = "regex"
+)
+
+// ABTestingRouting represents the rules to route requests to CANARY variant
+// based on their headers, cookies or source workloads. Requests matching any of the rules are routed to CANARY variant
+// and everything else is routed by the percentages of the stage, or to PRIMARY variant if no percentage is specified.
+type ABTestingRouting struct {
+	// List of rules to route requests to CANARY variant.
+	Rules []ABTestingRule `json:"rules"`
+}
+
+// ABTestingRule represents a condition a request must match to be routed to CANARY variant.
+// Exactly one of header, cookie or sourceLabels must be specified.
+type ABTestingRule struct {
+	// The name of the request header to be matched.
+	Header string `json:"header,omitempty"`
+	// The name of the cookie to be matched.
+	Cookie string `json:"cookie,omitempty"`
+	// The labels of the workload sending the request, e.g. {"team": "qa"}.
+	// The request matches when the workload has all of them.
+	SourceLabels map[string]string `json:"sourceLabels,omitempty"`
+	// The value to be matched.
+	// Not used for sourceLabels.
+	Value string `json:"value"`
+	// How to match the value. One of exact, prefix or regex.
+	// Default is exact.
+	MatchType ABTestingMatchType `json:"matchType,omitempty" default:"exact"`
+}
+
+func (r *ABTestingRouting) Validate() error {
+	if len(r.Rules) == 0 {
+		return errors.New("at least one rule must be specified for A/B testing routing")
+	}
+	for i := range r.Rules {
+		if err := r.Rules[i].Validate(); err != nil {
+			return fmt.Errorf("invalid A/B testing rule at index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (r *ABTestingRule) Validate() error {
+	specified := 0
+	for _, ok := range []bool{r.Header != "", r.Cookie != "", len(r.SourceLabels) > 0} {
+		if ok {
+			specified++
+		}
+	}
+	if specified != 1 {
+		return errors.New("exactly one of header, cookie or sourceLabels must be specified")
+	}
+	if len(r.SourceLabels) > 0 {
+		return nil
+	}
+	if r.Value == "" {
+		return errors.New("value must not be empty")
+	}
+	switch r.MatchType {
+	case "", ABTestingMatchTypeExact, ABTestingMatchTypePrefix, ABTestingMatchTypeRegex:
+	default:
+		return fmt.Errorf("unsupported matchType %q", r.MatchType)
+	}
+	if r.Cookie != "" && r.MatchType == ABTestingMatchTypeRegex {
+		return errors.New("regex matchType is not supported for cookie")
+	}
+	return nil
+}
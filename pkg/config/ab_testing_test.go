@@ -0,0 +1,83 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestABTestingRoutingValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		routing ABTestingRouting
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			routing: ABTestingRouting{Rules: []ABTestingRule{
+				{Header: "X-Canary", Value: "true"},
+				{Cookie: "beta", Value: "1", MatchType: ABTestingMatchTypePrefix},
+			}},
+		},
+		{
+			name:    "no rules",
+			wantErr: true,
+		},
+		{
+			name:    "both header and cookie",
+			routing: ABTestingRouting{Rules: []ABTestingRule{{Header: "X-Canary", Cookie: "beta", Value: "1"}}},
+			wantErr: true,
+		},
+		{
+			name: "source labels",
+			routing: ABTestingRouting{Rules: []ABTestingRule{
+				{SourceLabels: map[string]string{"team": "qa"}},
+			}},
+		},
+		{
+			name:    "both header and source labels",
+			routing: ABTestingRouting{Rules: []ABTestingRule{{Header: "X-Canary", Value: "1", SourceLabels: map[string]string{"team": "qa"}}}},
+			wantErr: true,
+		},
+		{
+			name:    "neither header nor cookie",
+			routing: ABTestingRouting{Rules: []ABTestingRule{{Value: "1"}}},
+			wantErr: true,
+		},
+		{
+			name:    "empty value",
+			routing: ABTestingRouting{Rules: []ABTestingRule{{Header: "X-Canary"}}},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported match type",
+			routing: ABTestingRouting{Rules: []ABTestingRule{{Header: "X-Canary", Value: "1", MatchType: "suffix"}}},
+			wantErr: true,
+		},
+		{
+			name:    "regex for cookie",
+			routing: ABTestingRouting{Rules: []ABTestingRule{{Cookie: "beta", Value: "1", MatchType: ABTestingMatchTypeRegex}}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.routing.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
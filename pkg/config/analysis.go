@@ -15,9 +15,11 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -176,3 +178,121 @@ type AnalysisHTTPHeader struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
 }
+
+// AnalysisSchedule represents the time window in which the analysis results are counted.
+type AnalysisSchedule struct {
+	// The IANA time zone name used to interpret the window. e.g. Asia/Tokyo
+	// Default is UTC.
+	Timezone string `json:"timezone,omitempty" default:"UTC"`
+	// The days of the week on which the analysis is evaluated. e.g. [Mon, Tue, Wed, Thu, Fri]
+	// Empty means every day.
+	Days []string `json:"days,omitempty"`
+	// The time of the day the window starts, formatted as HH:MM. e.g. 09:00
+	// Empty means the beginning of the day.
+	StartTime string `json:"startTime,omitempty"`
+	// The time of the day the window ends, formatted as HH:MM. e.g. 18:00
+	// Empty means the end of the day.
+	// The window spans midnight when it is earlier than startTime.
+	EndTime string `json:"endTime,omitempty"`
+	// Whether to extend the analysis until the configured duration has been spent within the window.
+	// Otherwise the analysis ends after the duration regardless of the window.
+	// Default is false.
+	ExtendDuration bool `json:"extendDuration,omitempty"`
+}
+
+func (s *AnalysisSchedule) Validate() error {
+	_, err := s.Window()
+	return err
+}
+
+// AnalysisWindow is the parsed form of AnalysisSchedule.
+type AnalysisWindow struct {
+	location *time.Location
+	days     map[time.Weekday]struct{}
+	// Minutes of the day.
+	start, end int
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Window parses the schedule and returns the window to check whether a time is included.
+func (s *AnalysisSchedule) Window() (*AnalysisWindow, error) {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", s.Timezone, err)
+	}
+	w := &AnalysisWindow{
+		location: loc,
+		start:    0,
+		end:      24 * 60,
+	}
+	if len(s.Days) > 0 {
+		w.days = make(map[time.Weekday]struct{}, len(s.Days))
+		for _, d := range s.Days {
+			day, ok := weekdays[strings.ToLower(d)]
+			if !ok {
+				return nil, fmt.Errorf("invalid day %q: must be one of Sun, Mon, Tue, Wed, Thu, Fri, Sat", d)
+			}
+			w.days[day] = struct{}{}
+		}
+	}
+	if s.StartTime != "" {
+		if w.start, err = parseClock(s.StartTime); err != nil {
+			return nil, err
+		}
+	}
+	if s.EndTime != "" {
+		if w.end, err = parseClock(s.EndTime); err != nil {
+			return nil, err
+		}
+	}
+	if w.start == w.end {
+		return nil, errors.New("startTime and endTime must be different")
+	}
+	return w, nil
+}
+
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q: must be formatted as HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Contains reports whether the given time is within the window.
+func (w *AnalysisWindow) Contains(t time.Time) bool {
+	t = t.In(w.location)
+	minutes := t.Hour()*60 + t.Minute()
+
+	day := t.Weekday()
+	var inTime bool
+	if w.start < w.end {
+		inTime = w.start <= minutes && minutes < w.end
+	} else {
+		// The window spans midnight so the part after midnight belongs to the previous day.
+		switch {
+		case minutes >= w.start:
+			inTime = true
+		case minutes < w.end:
+			inTime = true
+			day = (day + 6) % 7
+		}
+	}
+	if !inTime {
+		return false
+	}
+	if w.days == nil {
+		return true
+	}
+	_, ok := w.days[day]
+	return ok
+}
@@ -16,8 +16,10 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func floatPointer(v float64) *float64 {
@@ -63,3 +65,101 @@ func TestAnalysisExpectedString(t *testing.T) {
 		})
 	}
 }
+
+func TestAnalysisScheduleValidate(t *testing.T) {
+	testcases := []struct {
+		name     string
+		schedule AnalysisSchedule
+		wantErr  bool
+	}{
+		{
+			name:     "valid",
+			schedule: AnalysisSchedule{Timezone: "Asia/Tokyo", Days: []string{"Mon", "fri"}, StartTime: "09:00", EndTime: "18:00"},
+		},
+		{
+			name:     "invalid timezone",
+			schedule: AnalysisSchedule{Timezone: "Unknown/Zone"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid day",
+			schedule: AnalysisSchedule{Timezone: "UTC", Days: []string{"Monday"}},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid time",
+			schedule: AnalysisSchedule{Timezone: "UTC", StartTime: "9am"},
+			wantErr:  true,
+		},
+		{
+			name:     "same start and end",
+			schedule: AnalysisSchedule{Timezone: "UTC", StartTime: "09:00", EndTime: "09:00"},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestAnalysisWindowContains(t *testing.T) {
+	testcases := []struct {
+		name     string
+		schedule AnalysisSchedule
+		time     time.Time
+		want     bool
+	}{
+		{
+			name:     "business hours in the window",
+			schedule: AnalysisSchedule{Timezone: "UTC", Days: []string{"Mon", "Tue", "Wed", "Thu", "Fri"}, StartTime: "09:00", EndTime: "18:00"},
+			time:     time.Date(2024, 1, 8, 10, 0, 0, 0, time.UTC), // Monday
+			want:     true,
+		},
+		{
+			name:     "business hours after the end",
+			schedule: AnalysisSchedule{Timezone: "UTC", Days: []string{"Mon", "Tue", "Wed", "Thu", "Fri"}, StartTime: "09:00", EndTime: "18:00"},
+			time:     time.Date(2024, 1, 8, 18, 0, 0, 0, time.UTC),
+			want:     false,
+		},
+		{
+			name:     "business hours on weekend",
+			schedule: AnalysisSchedule{Timezone: "UTC", Days: []string{"Mon", "Tue", "Wed", "Thu", "Fri"}, StartTime: "09:00", EndTime: "18:00"},
+			time:     time.Date(2024, 1, 6, 10, 0, 0, 0, time.UTC), // Saturday
+			want:     false,
+		},
+		{
+			name:     "timezone is applied",
+			schedule: AnalysisSchedule{Timezone: "Asia/Tokyo", StartTime: "09:00", EndTime: "18:00"},
+			time:     time.Date(2024, 1, 8, 1, 0, 0, 0, time.UTC), // 10:00 in Tokyo
+			want:     true,
+		},
+		{
+			name:     "window spanning midnight belongs to the previous day",
+			schedule: AnalysisSchedule{Timezone: "UTC", Days: []string{"Fri"}, StartTime: "22:00", EndTime: "02:00"},
+			time:     time.Date(2024, 1, 6, 1, 0, 0, 0, time.UTC), // Saturday 01:00
+			want:     true,
+		},
+		{
+			name:     "window spanning midnight on an excluded day",
+			schedule: AnalysisSchedule{Timezone: "UTC", Days: []string{"Fri"}, StartTime: "22:00", EndTime: "02:00"},
+			time:     time.Date(2024, 1, 5, 1, 0, 0, 0, time.UTC), // Friday 01:00
+			want:     false,
+		},
+		{
+			name:     "only days given",
+			schedule: AnalysisSchedule{Timezone: "UTC", Days: []string{"Sun"}},
+			time:     time.Date(2024, 1, 7, 23, 59, 0, 0, time.UTC),
+			want:     true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := tc.schedule.Window()
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, w.Contains(tc.time))
+		})
+	}
+}
@@ -21,6 +21,8 @@ import (
 	"path/filepath"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -52,6 +54,12 @@ type GenericApplicationSpec struct {
 	Encryption *SecretEncryption `json:"encryption"`
 	// List of files that should be attached to application manifests before using.
 	Attachment *Attachment `json:"attachment"`
+	// List of typed parameters users can supply while triggering a sync.
+	Parameters *DeploymentParameters `json:"parameters"`
+	// Arbitrary values referred as ${{ .env.<key> }} from the application configuration
+	// and as {{ .Env.<key> }} from the definition templates.
+	// The piped-level defaults are used for the keys not defined here.
+	Env map[string]string `json:"env"`
 	// Additional configuration used while sending notification to external services.
 	DeploymentNotification *DeploymentNotification `json:"notification"`
 	// List of the configuration for event watcher.
@@ -67,6 +75,16 @@ type DeploymentPlanner struct {
 	// Automatically reverts all deployment changes on failure.
 	// Default is true.
 	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
+	// Completes the deployment as a no-op without running any stage
+	// when the resolved artifacts are identical to the running ones.
+	// Deployments whose sync strategy was forced are never skipped.
+	SkipIfUnchanged bool `json:"skipIfUnchanged"`
+	// Completes the deployment as a config-only sync without running any stage
+	// when only the application configuration, such as the pipeline or the notification,
+	// was changed and the resolved artifacts are identical to the running ones.
+	// The configuration is recorded as the running one by the deployment.
+	// Deployments whose sync strategy was forced are never skipped.
+	ConfigOnlySync bool `json:"configOnlySync"`
 }
 
 type Trigger struct {
@@ -123,6 +141,9 @@ type OnChain struct {
 
 func (s *GenericApplicationSpec) Validate() error {
 	if s.Pipeline != nil {
+		if s.Pipeline.Timeout < 0 {
+			return fmt.Errorf("pipeline timeout must not be negative")
+		}
 		for _, stage := range s.Pipeline.Stages {
 			if stage.AnalysisStageOptions != nil {
 				if err := stage.AnalysisStageOptions.Validate(); err != nil {
@@ -139,6 +160,61 @@ func (s *GenericApplicationSpec) Validate() error {
 					return err
 				}
 			}
+			if o := stage.LoadTestStageOptions; o != nil {
+				if err := o.Validate(); err != nil {
+					return err
+				}
+			}
+			if o := stage.K8sTrafficRoutingStageOptions; o != nil {
+				if err := o.Validate(); err != nil {
+					return err
+				}
+			}
+			if o := stage.CloudRunPromoteStageOptions; o != nil {
+				if err := o.Validate(); err != nil {
+					return err
+				}
+			}
+			if o := stage.K8sPrimaryRolloutStageOptions; o != nil {
+				if err := o.Validate(); err != nil {
+					return err
+				}
+			}
+			if o := stage.K8sMaintenanceOnStageOptions; o != nil {
+				if err := o.Validate(); err != nil {
+					return err
+				}
+			}
+			if o := stage.K8sFaultInjectionStageOptions; o != nil {
+				if err := o.Validate(); err != nil {
+					return err
+				}
+			}
+			if o := stage.ECSCanaryRolloutStageOptions; o != nil {
+				if err := o.Validate(); err != nil {
+					return err
+				}
+			}
+			if o := stage.ECSTrafficRoutingStageOptions; o != nil {
+				if err := o.Validate(); err != nil {
+					return err
+				}
+			}
+			if o := stage.ECSMaintenanceOnStageOptions; o != nil {
+				if err := o.Validate(); err != nil {
+					return err
+				}
+			}
+			if o := stage.ECSTargetGroupHealthCheckStageOptions; o != nil {
+				if err := o.Validate(); err != nil {
+					return err
+				}
+			}
+			if o := stage.TerraformApplyStageOptions; o != nil {
+				if err := o.Validate(); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -160,12 +236,23 @@ func (s *GenericApplicationSpec) Validate() error {
 		}
 	}
 
+	if ps := s.Parameters; ps != nil {
+		if err := ps.Validate(); err != nil {
+			return err
+		}
+	}
+
 	if s.DeploymentNotification != nil {
 		for _, m := range s.DeploymentNotification.Mentions {
 			if err := m.Validate(); err != nil {
 				return err
 			}
 		}
+		if co := s.DeploymentNotification.CodeOwners; co != nil {
+			if err := co.Validate(); err != nil {
+				return err
+			}
+		}
 	}
 
 	if dd := s.DriftDetection; dd != nil {
@@ -214,6 +301,10 @@ type DeploymentCommitMatcher struct {
 // - ConfigMaps, Secrets that are mounted as volumes or envs in the deployment.
 type DeploymentPipeline struct {
 	Stages []PipelineStage `json:"stages"`
+	// The maximum length of time to execute the deployment with this pipeline before giving up.
+	// This overrides the application-level timeout for the deployments running this pipeline.
+	// Empty means the application-level timeout is used.
+	Timeout Duration `json:"timeout,omitempty"`
 }
 
 // PipelineStage represents a single stage of a pipeline.
@@ -228,8 +319,10 @@ type PipelineStage struct {
 	CustomSyncOptions        *CustomSyncOptions
 	WaitStageOptions         *WaitStageOptions
 	WaitApprovalStageOptions *WaitApprovalStageOptions
+	ExternalWaitStageOptions *ExternalWaitStageOptions
 	AnalysisStageOptions     *AnalysisStageOptions
 	ScriptRunStageOptions    *ScriptRunStageOptions
+	LoadTestStageOptions     *LoadTestStageOptions
 
 	K8sPrimaryRolloutStageOptions  *K8sPrimaryRolloutStageOptions
 	K8sCanaryRolloutStageOptions   *K8sCanaryRolloutStageOptions
@@ -237,6 +330,10 @@ type PipelineStage struct {
 	K8sBaselineRolloutStageOptions *K8sBaselineRolloutStageOptions
 	K8sBaselineCleanStageOptions   *K8sBaselineCleanStageOptions
 	K8sTrafficRoutingStageOptions  *K8sTrafficRoutingStageOptions
+	K8sMaintenanceOnStageOptions   *K8sMaintenanceOnStageOptions
+	K8sMaintenanceOffStageOptions  *K8sMaintenanceOffStageOptions
+	K8sWaitRolloutStageOptions     *K8sWaitRolloutStageOptions
+	K8sFaultInjectionStageOptions  *K8sFaultInjectionStageOptions
 
 	TerraformSyncStageOptions  *TerraformSyncStageOptions
 	TerraformPlanStageOptions  *TerraformPlanStageOptions
@@ -254,6 +351,10 @@ type PipelineStage struct {
 	ECSPrimaryRolloutStageOptions *ECSPrimaryRolloutStageOptions
 	ECSCanaryCleanStageOptions    *ECSCanaryCleanStageOptions
 	ECSTrafficRoutingStageOptions *ECSTrafficRoutingStageOptions
+	ECSMaintenanceOnStageOptions  *ECSMaintenanceOnStageOptions
+	ECSMaintenanceOffStageOptions *ECSMaintenanceOffStageOptions
+
+	ECSTargetGroupHealthCheckStageOptions *ECSTargetGroupHealthCheckStageOptions
 }
 
 type genericPipelineStage struct {
@@ -292,6 +393,11 @@ func (s *PipelineStage) UnmarshalJSON(data []byte) error {
 		if len(gs.With) > 0 {
 			err = json.Unmarshal(gs.With, s.WaitApprovalStageOptions)
 		}
+	case model.StageExternalWait:
+		s.ExternalWaitStageOptions = &ExternalWaitStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.ExternalWaitStageOptions)
+		}
 	case model.StageAnalysis:
 		s.AnalysisStageOptions = &AnalysisStageOptions{}
 		if len(gs.With) > 0 {
@@ -302,6 +408,11 @@ func (s *PipelineStage) UnmarshalJSON(data []byte) error {
 		if len(gs.With) > 0 {
 			err = json.Unmarshal(gs.With, s.ScriptRunStageOptions)
 		}
+	case model.StageLoadTest:
+		s.LoadTestStageOptions = &LoadTestStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.LoadTestStageOptions)
+		}
 
 	case model.StageK8sPrimaryRollout:
 		s.K8sPrimaryRolloutStageOptions = &K8sPrimaryRolloutStageOptions{}
@@ -333,6 +444,26 @@ func (s *PipelineStage) UnmarshalJSON(data []byte) error {
 		if len(gs.With) > 0 {
 			err = json.Unmarshal(gs.With, s.K8sTrafficRoutingStageOptions)
 		}
+	case model.StageK8sMaintenanceOn:
+		s.K8sMaintenanceOnStageOptions = &K8sMaintenanceOnStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.K8sMaintenanceOnStageOptions)
+		}
+	case model.StageK8sMaintenanceOff:
+		s.K8sMaintenanceOffStageOptions = &K8sMaintenanceOffStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.K8sMaintenanceOffStageOptions)
+		}
+	case model.StageK8sWaitRollout:
+		s.K8sWaitRolloutStageOptions = &K8sWaitRolloutStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.K8sWaitRolloutStageOptions)
+		}
+	case model.StageK8sFaultInjection:
+		s.K8sFaultInjectionStageOptions = &K8sFaultInjectionStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.K8sFaultInjectionStageOptions)
+		}
 
 	case model.StageTerraformSync:
 		s.TerraformSyncStageOptions = &TerraformSyncStageOptions{}
@@ -402,6 +533,21 @@ func (s *PipelineStage) UnmarshalJSON(data []byte) error {
 		if len(gs.With) > 0 {
 			err = json.Unmarshal(gs.With, s.ECSTrafficRoutingStageOptions)
 		}
+	case model.StageECSMaintenanceOn:
+		s.ECSMaintenanceOnStageOptions = &ECSMaintenanceOnStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.ECSMaintenanceOnStageOptions)
+		}
+	case model.StageECSMaintenanceOff:
+		s.ECSMaintenanceOffStageOptions = &ECSMaintenanceOffStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.ECSMaintenanceOffStageOptions)
+		}
+	case model.StageECSTargetGroupHealthCheck:
+		s.ECSTargetGroupHealthCheckStageOptions = &ECSTargetGroupHealthCheckStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.ECSTargetGroupHealthCheckStageOptions)
+		}
 
 	default:
 		err = fmt.Errorf("unsupported stage name: %s", s.Name)
@@ -438,16 +584,28 @@ func (w *WaitApprovalStageOptions) Validate() error {
 	return nil
 }
 
+// ExternalWaitStageOptions contains all configurable values for an EXTERNAL_WAIT stage.
+type ExternalWaitStageOptions struct {
+	// The maximum length of time to wait for the result
+	// reported by the external system before giving up.
+	// Defaults to 6h.
+	Timeout Duration `json:"timeout" default:"6h"`
+}
+
 type CustomSyncOptions struct {
-	Timeout Duration          `json:"timeout" default:"6h"`
-	Envs    map[string]string `json:"envs"`
-	Run     string            `json:"run"`
+	Timeout   Duration          `json:"timeout" default:"6h"`
+	Envs      map[string]string `json:"envs"`
+	Run       string            `json:"run"`
+	Resources ScriptResources   `json:"resources,omitempty"`
 }
 
 func (c *CustomSyncOptions) Validate() error {
 	if c.Run == "" {
 		return fmt.Errorf("the CUSTOM_SYNC stage requires run field")
 	}
+	if err := c.Resources.Validate(); err != nil {
+		return fmt.Errorf("invalid resources of CUSTOM_SYNC stage: %w", err)
+	}
 	return nil
 }
 
@@ -462,6 +620,9 @@ type AnalysisStageOptions struct {
 	Logs             []TemplatableAnalysisLog     `json:"logs,omitempty"`
 	HTTPS            []TemplatableAnalysisHTTP    `json:"https,omitempty"`
 	SkipOn           SkipOptions                  `json:"skipOn,omitempty"`
+	// The time window in which the analysis results are counted.
+	// Empty means the results are always counted.
+	Schedule *AnalysisSchedule `json:"schedule,omitempty"`
 }
 
 func (a *AnalysisStageOptions) Validate() error {
@@ -503,6 +664,11 @@ func (a *AnalysisStageOptions) Validate() error {
 			return fmt.Errorf("one of http configurations of ANALYSIS stage is invalid: %w", err)
 		}
 	}
+	if a.Schedule != nil {
+		if err := a.Schedule.Validate(); err != nil {
+			return fmt.Errorf("schedule of ANALYSIS stage is invalid: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -513,6 +679,7 @@ type ScriptRunStageOptions struct {
 	Timeout    Duration          `json:"timeout" default:"6h"`
 	OnRollback string            `json:"onRollback"`
 	SkipOn     SkipOptions       `json:"skipOn,omitempty"`
+	Resources  ScriptResources   `json:"resources,omitempty"`
 }
 
 // Validate checks the required fields of ScriptRunStageOptions.
@@ -520,9 +687,135 @@ func (s *ScriptRunStageOptions) Validate() error {
 	if s.Run == "" {
 		return fmt.Errorf("SCRIPT_RUN stage requires run field")
 	}
+	if err := s.Resources.Validate(); err != nil {
+		return fmt.Errorf("invalid resources of SCRIPT_RUN stage: %w", err)
+	}
 	return nil
 }
 
+type LoadTestTool string
+
+const (
+	LoadTestToolK6     LoadTestTool = "k6"
+	LoadTestToolVegeta LoadTestTool = "vegeta"
+)
+
+// LoadTestStageOptions contains all configurable values for a LOAD_TEST stage.
+type LoadTestStageOptions struct {
+	// The tool used to generate the load. Either k6 or vegeta.
+	Tool LoadTestTool `json:"tool"`
+	// The path to the k6 script or the vegeta targets file
+	// relative to the application directory.
+	Script string `json:"script"`
+	// The endpoint to send the load to, typically the one of the canary variant.
+	// It is passed to the k6 script as the TARGET environment variable.
+	// For vegeta, "GET <target>" is used as the only target when script is not specified.
+	Target string `json:"target"`
+	// How long the load is sent.
+	// Default is 1m.
+	Duration Duration `json:"duration" default:"1m"`
+	// The number of virtual users of k6.
+	// Default is 1.
+	VUs int `json:"vus" default:"1"`
+	// The number of requests per second sent by vegeta.
+	// Default is 10.
+	Rate int `json:"rate" default:"10"`
+	// Additional environment variables passed to the tool.
+	Env map[string]string `json:"env"`
+	// The thresholds to decide whether the stage is successful or not.
+	Thresholds LoadTestThresholds `json:"thresholds"`
+	// The maximum length of time to run the tool before giving up.
+	// Default is 30m.
+	Timeout Duration `json:"timeout" default:"30m"`
+}
+
+// LoadTestThresholds represents the thresholds of the load test results.
+// Empty value means no threshold.
+type LoadTestThresholds struct {
+	// The maximum acceptable 95th percentile latency. e.g. 500ms
+	P95Latency Duration `json:"p95Latency,omitempty"`
+	// The maximum acceptable 99th percentile latency. e.g. 1s
+	P99Latency Duration `json:"p99Latency,omitempty"`
+	// The maximum acceptable error rate in percentage. e.g. 1 means 1%.
+	ErrorRate *float64 `json:"errorRate,omitempty"`
+}
+
+// Validate checks the required fields of LoadTestStageOptions.
+func (l *LoadTestStageOptions) Validate() error {
+	switch l.Tool {
+	case LoadTestToolK6:
+		if l.Script == "" {
+			return fmt.Errorf("LOAD_TEST stage using k6 requires script field")
+		}
+	case LoadTestToolVegeta:
+		if l.Script == "" && l.Target == "" {
+			return fmt.Errorf("LOAD_TEST stage using vegeta requires either script or target field")
+		}
+	default:
+		return fmt.Errorf("unsupported tool %q for LOAD_TEST stage, must be %s or %s", l.Tool, LoadTestToolK6, LoadTestToolVegeta)
+	}
+	if l.Duration <= 0 {
+		return fmt.Errorf("duration of LOAD_TEST stage must be positive")
+	}
+	if l.Timeout < l.Duration {
+		return fmt.Errorf("timeout of LOAD_TEST stage must not be shorter than its duration")
+	}
+	if l.VUs <= 0 || l.Rate <= 0 {
+		return fmt.Errorf("vus and rate of LOAD_TEST stage must be positive")
+	}
+	if r := l.Thresholds.ErrorRate; r != nil && (*r < 0 || *r > 100) {
+		return fmt.Errorf("errorRate threshold of LOAD_TEST stage must be between 0 and 100")
+	}
+	return nil
+}
+
+// ScriptResources represents the resource limits applied to the user-provided commands.
+// Empty value means no limit.
+type ScriptResources struct {
+	// The maximum CPU time each process can consume. e.g. 10m
+	CPUTime Duration `json:"cpuTime,omitempty"`
+	// The maximum size of the virtual memory of each process. e.g. 512Mi, 2Gi
+	Memory string `json:"memory,omitempty"`
+	// The maximum number of processes the commands can create in addition to the ones
+	// already running as the same user as piped. Since the OS applies this limit per user,
+	// the processes created by piped or the other scripts at the same time are counted as well.
+	// Threads are counted as processes on Linux.
+	MaxProcesses int `json:"maxProcesses,omitempty"`
+	// The maximum number of files each process can open.
+	MaxOpenFiles int `json:"maxOpenFiles,omitempty"`
+}
+
+func (r ScriptResources) Validate() error {
+	if r.CPUTime < 0 {
+		return fmt.Errorf("cpuTime must not be negative")
+	}
+	if _, err := r.MemoryBytes(); err != nil {
+		return err
+	}
+	if r.MaxProcesses < 0 {
+		return fmt.Errorf("maxProcesses must not be negative")
+	}
+	if r.MaxOpenFiles < 0 {
+		return fmt.Errorf("maxOpenFiles must not be negative")
+	}
+	return nil
+}
+
+// MemoryBytes returns the memory limit in bytes. Zero means no limit.
+func (r ScriptResources) MemoryBytes() (int64, error) {
+	if r.Memory == "" {
+		return 0, nil
+	}
+	q, err := resource.ParseQuantity(r.Memory)
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory %q: %w", r.Memory, err)
+	}
+	if q.Sign() <= 0 {
+		return 0, fmt.Errorf("memory must be positive: %s", r.Memory)
+	}
+	return q.Value(), nil
+}
+
 type AnalysisTemplateRef struct {
 	Name    string            `json:"name"`
 	AppArgs map[string]string `json:"appArgs"`
@@ -601,6 +894,44 @@ func (a *Attachment) Validate() error {
 type DeploymentNotification struct {
 	// List of users to be notified for each event.
 	Mentions []NotificationMention `json:"mentions"`
+	// Mention the owners of the application directory defined in the CODEOWNERS file of the repository.
+	// They are resolved when the deployment is triggered.
+	CodeOwners *NotificationCodeOwners `json:"codeOwners,omitempty"`
+}
+
+// NotificationCodeOwners represents the events to mention the code owners of the application.
+type NotificationCodeOwners struct {
+	// The events to mention the code owners for.
+	// Default is all events.
+	Events []string `json:"events,omitempty"`
+}
+
+func (n *NotificationCodeOwners) Validate() error {
+	for _, e := range n.Events {
+		m := NotificationMention{Event: e}
+		if err := m.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MentionCodeOwners adds the mentions of the given Slack users and groups for the events configured in CodeOwners.
+func (n *DeploymentNotification) MentionCodeOwners(slackUsers, slackGroups []string) {
+	if n.CodeOwners == nil || (len(slackUsers) == 0 && len(slackGroups) == 0) {
+		return
+	}
+	events := n.CodeOwners.Events
+	if len(events) == 0 {
+		events = []string{allEventsSymbol}
+	}
+	for _, e := range events {
+		n.Mentions = append(n.Mentions, NotificationMention{
+			Event:       e,
+			SlackUsers:  slackUsers,
+			SlackGroups: slackGroups,
+		})
+	}
 }
 
 // FindSlackGroups returns a list of slack group IDs to be mentioned for the given event.
@@ -763,6 +1094,9 @@ func (c *DeploymentChainTriggerCondition) Validate() error {
 type DriftDetection struct {
 	// IgnoreFields are a list of 'apiVersion:kind:namespace:name#fieldPath'
 	IgnoreFields []string `json:"ignoreFields"`
+	// NormalizationRules are applied to both the live and the desired manifests before comparing them.
+	// Currently, this is only supported by Kubernetes applications.
+	NormalizationRules []DiffNormalizationRule `json:"normalizationRules,omitempty"`
 }
 
 func (dd *DriftDetection) Validate() error {
@@ -772,6 +1106,53 @@ func (dd *DriftDetection) Validate() error {
 			return fmt.Errorf("ignoreFields must be in the form of 'apiVersion:kind:namespace:name#fieldPath'")
 		}
 	}
+	for _, r := range dd.NormalizationRules {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DiffNormalizationRule describes how to normalize the fields of manifests
+// to avoid reporting the differences which have no effect.
+type DiffNormalizationRule struct {
+	// The resources to apply this rule in the form of 'apiVersion:kind:namespace:name'.
+	// Each part can be '*' to match any value. Empty means all resources.
+	Resource string `json:"resource,omitempty"`
+	// The dot-separated path to the target fields.
+	// A '*' part matches any map key or list index.
+	// e.g. spec.template.spec.containers.*.imagePullPolicy
+	Path string `json:"path"`
+	// Removes the target fields when their values equal this value,
+	// so that it makes no difference whether the default value is specified or not.
+	Default interface{} `json:"default,omitempty"`
+	// Compares the target fields as resource quantities, e.g. 100m == 0.1.
+	Quantity bool `json:"quantity,omitempty"`
+	// Sorts the target lists by the value of this key of their items.
+	SortByKey string `json:"sortByKey,omitempty"`
+}
+
+func (r DiffNormalizationRule) Validate() error {
+	if r.Resource != "" && len(strings.Split(r.Resource, ":")) != 4 {
+		return fmt.Errorf("normalizationRules.resource must be in the form of 'apiVersion:kind:namespace:name'")
+	}
+	if r.Path == "" {
+		return fmt.Errorf("normalizationRules.path must be set")
+	}
+	var n int
+	if r.Default != nil {
+		n++
+	}
+	if r.Quantity {
+		n++
+	}
+	if r.SortByKey != "" {
+		n++
+	}
+	if n != 1 {
+		return fmt.Errorf("exactly one of default, quantity or sortByKey must be set in normalizationRules")
+	}
 	return nil
 }
 
@@ -50,4 +50,10 @@ type CloudRunSyncStageOptions struct {
 type CloudRunPromoteStageOptions struct {
 	// Percentage of traffic should be routed to the new version.
 	Percent Percentage `json:"percent"`
+	// The traffic is shifted to the new version step by step when steps are specified.
+	TrafficRoutingSteps `json:",inline"`
+}
+
+func (opts *CloudRunPromoteStageOptions) Validate() error {
+	return opts.TrafficRoutingSteps.validate()
 }
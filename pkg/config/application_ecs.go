@@ -15,12 +15,18 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 const (
 	AccessTypeELB              string = "ELB"
 	AccessTypeServiceDiscovery string = "SERVICE_DISCOVERY"
+	AccessTypeAppMesh          string = "APP_MESH"
 )
 
 // ECSApplicationSpec represents an application configuration for ECS application.
@@ -42,6 +48,25 @@ func (s *ECSApplicationSpec) Validate() error {
 		return err
 	}
 
+	// The task sets of the service managed by CodeDeploy or the ECS deployment controller can not be manipulated directly.
+	if mode := s.Input.deploymentControllerMode(); mode != "" && s.Pipeline != nil {
+		for _, stage := range s.Pipeline.Stages {
+			switch stage.Name {
+			case model.StageECSCanaryRollout, model.StageECSPrimaryRollout, model.StageECSCanaryClean, model.StageECSTrafficRouting:
+				return fmt.Errorf("stage %s can not be used with %s, use %s instead", stage.Name, mode, model.StageECSSync)
+			}
+		}
+	}
+
+	// The traffic is routed by the weights of the route in App Mesh.
+	if s.Input.IsAccessedViaAppMesh() && s.Pipeline != nil {
+		for _, stage := range s.Pipeline.Stages {
+			if o := stage.ECSTrafficRoutingStageOptions; o != nil && (o.ABTesting != nil || o.Stickiness != nil) {
+				return fmt.Errorf("abTesting and stickiness of stage %s can not be used with accessType %s", stage.Name, AccessTypeAppMesh)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -73,8 +98,263 @@ type ECSDeploymentInput struct {
 	// Possible values are:
 	//  - ELB -  The service is accessed via ELB and target groups.
 	//  - SERVICE_DISCOVERY -  The service is accessed via ECS Service Discovery.
+	//  - APP_MESH -  The service is accessed via an AWS App Mesh virtual router.
 	// Default is ELB.
 	AccessType string `json:"accessType,omitempty" default:"ELB"`
+	// Configuration for routing the traffic through an AWS App Mesh virtual router.
+	// Required when accessType is APP_MESH.
+	AppMesh *ECSAppMesh `json:"appMesh,omitempty"`
+	// Configuration for rendering the task and service definition files as templates.
+	DefinitionTemplate ECSDefinitionTemplate `json:"definitionTemplate,omitempty"`
+	// Whether to roll back to the task definition used by the PRIMARY task set before the deployment
+	// instead of registering a new revision of the last deployed task definition.
+	// This avoids creating redundant revisions on every rollback.
+	// Default is false.
+	ReusePrimaryTaskDefinitionOnRollback bool `json:"reusePrimaryTaskDefinitionOnRollback,omitempty"`
+	// Configuration for deploying the service by AWS CodeDeploy blue/green deployments.
+	// When specified, the ECS_SYNC stage and the rollback create CodeDeploy deployments
+	// instead of managing task sets by PipeCD, so the deployment controller of the service must be CODE_DEPLOY.
+	CodeDeploy *ECSCodeDeployInput `json:"codeDeploy,omitempty"`
+	// Configuration for deploying the service by rolling updates of the ECS deployment controller.
+	// When specified, the ECS_SYNC stage and the rollback update the task definition of the service
+	// instead of managing task sets by PipeCD, so the deployment controller of the service must be ECS.
+	RollingUpdate *ECSRollingUpdateInput `json:"rollingUpdate,omitempty"`
+	// Configuration for the EventBridge rule running the standalone task on a schedule.
+	// When specified, the ECS targets of the rule are updated to run the registered task definition.
+	ScheduledTask *ECSScheduledTask `json:"scheduledTask,omitempty"`
+	// The Application Auto Scaling settings of the service applied by the ECS_SYNC stage.
+	// When specified, the scaling policies of the service which are not specified here are removed.
+	AutoScaling *ECSAutoScaling `json:"autoScaling,omitempty"`
+}
+
+// ECSAppMesh represents the AWS App Mesh route forwarding the traffic to PRIMARY/CANARY variants.
+// Each variant has its own virtual node whose service discovery is the AWS Cloud Map service
+// the tasks of the variant are registered to.
+type ECSAppMesh struct {
+	// The name of the service mesh.
+	MeshName string `json:"meshName"`
+	// The AWS account ID of the mesh owner when the mesh is shared with the account.
+	MeshOwner string `json:"meshOwner,omitempty"`
+	// The name of the virtual router of the service.
+	VirtualRouterName string `json:"virtualRouterName"`
+	// The name of the route whose weighted targets are modified to route the traffic.
+	RouteName string `json:"routeName"`
+	// The virtual node of PRIMARY variant.
+	Primary ECSAppMeshVirtualNode `json:"primary"`
+	// The virtual node of CANARY variant.
+	Canary ECSAppMeshVirtualNode `json:"canary"`
+}
+
+// ECSAppMeshVirtualNode represents the virtual node of a variant.
+type ECSAppMeshVirtualNode struct {
+	// The name of the virtual node.
+	VirtualNodeName string `json:"virtualNodeName"`
+	// The ARN of the AWS Cloud Map service used as the service discovery of the virtual node.
+	// The task sets of the variant are registered to it.
+	RegistryArn string `json:"registryArn"`
+}
+
+func (m *ECSAppMesh) validate() error {
+	if m.MeshName == "" {
+		return fmt.Errorf("appMesh.meshName must be specified")
+	}
+	if m.VirtualRouterName == "" {
+		return fmt.Errorf("appMesh.virtualRouterName must be specified")
+	}
+	if m.RouteName == "" {
+		return fmt.Errorf("appMesh.routeName must be specified")
+	}
+	if m.Primary.VirtualNodeName == "" || m.Primary.RegistryArn == "" {
+		return fmt.Errorf("appMesh.primary requires both virtualNodeName and registryArn")
+	}
+	if m.Canary.VirtualNodeName == "" || m.Canary.RegistryArn == "" {
+		return fmt.Errorf("appMesh.canary requires both virtualNodeName and registryArn")
+	}
+	if m.Primary.VirtualNodeName == m.Canary.VirtualNodeName {
+		return fmt.Errorf("appMesh.primary and appMesh.canary must be different virtual nodes")
+	}
+	if m.Primary.RegistryArn == m.Canary.RegistryArn {
+		return fmt.Errorf("appMesh.primary and appMesh.canary must use different registries")
+	}
+	return nil
+}
+
+// ECSScheduledTask represents the EventBridge rule running a standalone task on a schedule.
+type ECSScheduledTask struct {
+	// The name of the EventBridge rule.
+	RuleName string `json:"ruleName"`
+	// The name or ARN of the event bus the rule belongs to.
+	// Default is default.
+	EventBusName string `json:"eventBusName,omitempty" default:"default"`
+	// The ID of the target of the rule to be updated.
+	// When empty, all ECS targets of the rule are updated.
+	TargetID string `json:"targetId,omitempty"`
+}
+
+// ECSAutoScaling represents the scalable target and the scaling policies of the desired count of an ECS service.
+type ECSAutoScaling struct {
+	// The minimum and maximum number of tasks of the service.
+	MinCapacity int32 `json:"minCapacity"`
+	MaxCapacity int32 `json:"maxCapacity"`
+	// The target tracking scaling policies.
+	TargetTrackingPolicies []ECSTargetTrackingScalingPolicy `json:"targetTrackingPolicies,omitempty"`
+	// The step scaling policies.
+	// They have to be associated with CloudWatch alarms outside of PipeCD.
+	StepScalingPolicies []ECSStepScalingPolicy `json:"stepScalingPolicies,omitempty"`
+}
+
+// ECSTargetTrackingScalingPolicy represents a target tracking scaling policy using a predefined metric.
+type ECSTargetTrackingScalingPolicy struct {
+	// The name of the scaling policy.
+	Name string `json:"name"`
+	// The predefined metric to track.
+	// One of ECSServiceAverageCPUUtilization, ECSServiceAverageMemoryUtilization and ALBRequestCountPerTarget.
+	PredefinedMetric string `json:"predefinedMetric"`
+	// The identifier of the target group for ALBRequestCountPerTarget,
+	// in the form of app/<load-balancer-name>/<load-balancer-id>/targetgroup/<target-group-name>/<target-group-id>.
+	ResourceLabel string `json:"resourceLabel,omitempty"`
+	// The target value of the metric.
+	TargetValue float64 `json:"targetValue"`
+	// How long to wait after a scale-in activity before another one can start.
+	ScaleInCooldown Duration `json:"scaleInCooldown,omitempty"`
+	// How long to wait after a scale-out activity before another one can start.
+	ScaleOutCooldown Duration `json:"scaleOutCooldown,omitempty"`
+	// Whether the policy only scales out.
+	DisableScaleIn bool `json:"disableScaleIn,omitempty"`
+}
+
+// ECSStepScalingPolicy represents a step scaling policy.
+type ECSStepScalingPolicy struct {
+	// The name of the scaling policy.
+	Name string `json:"name"`
+	// How the scaling adjustments are interpreted.
+	// One of ChangeInCapacity, ExactCapacity and PercentChangeInCapacity.
+	// Default is ChangeInCapacity.
+	AdjustmentType string `json:"adjustmentType,omitempty" default:"ChangeInCapacity"`
+	// How the metric of the alarm is aggregated. One of Average, Minimum and Maximum.
+	// Default is Average.
+	MetricAggregationType string `json:"metricAggregationType,omitempty" default:"Average"`
+	// How long to wait after a scaling activity before another one can start.
+	Cooldown Duration `json:"cooldown,omitempty"`
+	// The minimum number of tasks to scale by when adjustmentType is PercentChangeInCapacity.
+	MinAdjustmentMagnitude int32 `json:"minAdjustmentMagnitude,omitempty"`
+	// The adjustments applied depending on the breach size of the alarm.
+	Steps []ECSStepAdjustment `json:"steps"`
+}
+
+// ECSStepAdjustment represents an adjustment of a step scaling policy.
+// The bounds are relative to the alarm threshold, and the missing one means infinity.
+type ECSStepAdjustment struct {
+	LowerBound        *float64 `json:"lowerBound,omitempty"`
+	UpperBound        *float64 `json:"upperBound,omitempty"`
+	ScalingAdjustment int32    `json:"scalingAdjustment"`
+}
+
+func (a *ECSAutoScaling) validate() error {
+	if a.MinCapacity < 0 || a.MaxCapacity < a.MinCapacity {
+		return fmt.Errorf("autoScaling.maxCapacity must be greater than or equal to autoScaling.minCapacity which must not be negative")
+	}
+	names := make(map[string]struct{}, len(a.TargetTrackingPolicies)+len(a.StepScalingPolicies))
+	checkName := func(name string) error {
+		if name == "" {
+			return fmt.Errorf("the name of autoScaling policies must be specified")
+		}
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("autoScaling policy %s is duplicated", name)
+		}
+		names[name] = struct{}{}
+		return nil
+	}
+	for _, p := range a.TargetTrackingPolicies {
+		if err := checkName(p.Name); err != nil {
+			return err
+		}
+		switch p.PredefinedMetric {
+		case "ECSServiceAverageCPUUtilization", "ECSServiceAverageMemoryUtilization":
+		case "ALBRequestCountPerTarget":
+			if p.ResourceLabel == "" {
+				return fmt.Errorf("resourceLabel of autoScaling policy %s must be specified for %s", p.Name, p.PredefinedMetric)
+			}
+		default:
+			return fmt.Errorf("invalid predefinedMetric of autoScaling policy %s: %s", p.Name, p.PredefinedMetric)
+		}
+		if p.TargetValue <= 0 {
+			return fmt.Errorf("targetValue of autoScaling policy %s must be positive", p.Name)
+		}
+	}
+	for _, p := range a.StepScalingPolicies {
+		if err := checkName(p.Name); err != nil {
+			return err
+		}
+		switch p.AdjustmentType {
+		case "ChangeInCapacity", "ExactCapacity", "PercentChangeInCapacity":
+		default:
+			return fmt.Errorf("invalid adjustmentType of autoScaling policy %s: %s", p.Name, p.AdjustmentType)
+		}
+		switch p.MetricAggregationType {
+		case "Average", "Minimum", "Maximum":
+		default:
+			return fmt.Errorf("invalid metricAggregationType of autoScaling policy %s: %s", p.Name, p.MetricAggregationType)
+		}
+		if len(p.Steps) == 0 {
+			return fmt.Errorf("steps of autoScaling policy %s must be specified", p.Name)
+		}
+	}
+	return nil
+}
+
+// ECSCodeDeployInput represents the configuration for deploying an ECS service by AWS CodeDeploy.
+type ECSCodeDeployInput struct {
+	// The name of the CodeDeploy application.
+	ApplicationName string `json:"applicationName"`
+	// The name of the deployment group of the service.
+	// When empty, the deployment group deploying the service is discovered from the CodeDeploy application.
+	DeploymentGroupName string `json:"deploymentGroupName,omitempty"`
+	// The name of the deployment configuration, e.g. CodeDeployDefault.ECSLinear10PercentEvery1Minutes.
+	// When empty, the one configured in the deployment group is used.
+	DeploymentConfigName string `json:"deploymentConfigName,omitempty"`
+	// The container receiving the traffic from the load balancer.
+	// When empty, the ones of the primary target group in targetGroups are used.
+	ContainerName string `json:"containerName,omitempty"`
+	ContainerPort int    `json:"containerPort,omitempty"`
+	// The Lambda functions to run as the lifecycle hooks of the deployment.
+	Hooks ECSCodeDeployHooks `json:"hooks,omitempty"`
+	// How long to wait for the deployment to be completed.
+	// The deployment is stopped and rolled back by CodeDeploy after the timeout.
+	// Default is 1h.
+	Timeout Duration `json:"timeout,omitempty" default:"1h"`
+}
+
+// ECSCodeDeployHooks represents the names or ARNs of the Lambda functions
+// to run at each lifecycle event of the CodeDeploy deployment.
+type ECSCodeDeployHooks struct {
+	BeforeInstall         string `json:"beforeInstall,omitempty"`
+	AfterInstall          string `json:"afterInstall,omitempty"`
+	AfterAllowTestTraffic string `json:"afterAllowTestTraffic,omitempty"`
+	BeforeAllowTraffic    string `json:"beforeAllowTraffic,omitempty"`
+	AfterAllowTraffic     string `json:"afterAllowTraffic,omitempty"`
+}
+
+func (c *ECSCodeDeployInput) validate() error {
+	if c.ApplicationName == "" {
+		return fmt.Errorf("codeDeploy.applicationName must be specified")
+	}
+	if (c.ContainerName == "") != (c.ContainerPort == 0) {
+		return fmt.Errorf("codeDeploy.containerName and codeDeploy.containerPort must be specified together")
+	}
+	if c.Timeout < 0 {
+		return fmt.Errorf("codeDeploy.timeout must not be negative")
+	}
+	return nil
+}
+
+// ECSRollingUpdateInput represents the configuration for deploying an ECS service
+// by rolling updates of the ECS deployment controller.
+type ECSRollingUpdateInput struct {
+	// How long to wait for the rolling update to be completed.
+	// The deployment is failed after the timeout while ECS keeps the rolling update.
+	// Default is 30m.
+	Timeout Duration `json:"timeout,omitempty" default:"30m"`
 }
 
 func (in *ECSDeploymentInput) IsStandaloneTask() bool {
@@ -85,6 +365,33 @@ func (in *ECSDeploymentInput) IsAccessedViaELB() bool {
 	return in.AccessType == AccessTypeELB
 }
 
+func (in *ECSDeploymentInput) IsAccessedViaAppMesh() bool {
+	return in.AccessType == AccessTypeAppMesh
+}
+
+// deploymentControllerMode returns the name of the field configuring the deployment of the service
+// by the deployment controller other than the EXTERNAL one, or empty when the task sets are managed by PipeCD.
+func (in *ECSDeploymentInput) deploymentControllerMode() string {
+	switch {
+	case in.CodeDeploy != nil:
+		return "codeDeploy"
+	case in.RollingUpdate != nil:
+		return "rollingUpdate"
+	}
+	return ""
+}
+
+// ECSDefinitionTemplate configures rendering of the task and service definition files.
+// When enabled, the files are treated as Go templates and can refer to
+// {{ .AppName }}, {{ .AppID }}, {{ .PipedID }}, {{ .CommitHash }}, {{ .Labels.<key> }}, {{ .Params.<key> }} and {{ .Env.<key> }}.
+type ECSDefinitionTemplate struct {
+	// Whether to render the definition files as templates.
+	// Default is false.
+	Enabled bool `json:"enabled,omitempty"`
+	// Arbitrary values accessible as {{ .Params.<key> }} from the templates.
+	Params map[string]string `json:"params,omitempty"`
+}
+
 type ECSVpcConfiguration struct {
 	Subnets        []string `json:"subnets,omitempty"`
 	AssignPublicIP string   `json:"assignPublicIp,omitempty"`
@@ -94,6 +401,58 @@ type ECSVpcConfiguration struct {
 type ECSTargetGroups struct {
 	Primary *ECSTargetGroup `json:"primary,omitempty"`
 	Canary  *ECSTargetGroup `json:"canary,omitempty"`
+	// The listener rules whose forward actions are modified to route the traffic to PRIMARY/CANARY variants.
+	// A rule is modified only when it forwards to the target groups and matches any of these selectors.
+	// All rules forwarding to the target groups are modified when nothing is specified.
+	ListenerRules []ECSListenerRuleSelector `json:"listenerRules,omitempty"`
+	// The ARNs of the listeners whose rules can be modified to route the traffic or to turn on maintenance mode.
+	// Routing fails when any of them is not a listener of the load balancer of PRIMARY target group.
+	// All listeners of the load balancer are used when nothing is specified.
+	Listeners []string `json:"listeners,omitempty"`
+	// Whether to refuse routing the traffic when any listener rule to be modified is not owned by the application,
+	// that is, not tagged with "pipecd-dev-application: <application ID>".
+	// The tags of the listener are checked for its default rule.
+	// Default is true when listenerRules or listeners is specified, otherwise false.
+	RequireRuleOwnership *bool `json:"requireRuleOwnership,omitempty"`
+}
+
+// RuleOwnershipRequired returns whether the listener rules to be modified must be owned by the application.
+// Since the scoped rules are usually on a load balancer shared with other applications,
+// the ownership is required by default when the rules or the listeners are scoped.
+func (t ECSTargetGroups) RuleOwnershipRequired() bool {
+	if t.RequireRuleOwnership != nil {
+		return *t.RequireRuleOwnership
+	}
+	return len(t.ListenerRules) > 0 || len(t.Listeners) > 0
+}
+
+// ECSListenerRuleSelector selects the ELB listener rules.
+// A rule is selected when it matches all of the specified fields.
+type ECSListenerRuleSelector struct {
+	// The ARN of the rule.
+	RuleArn string `json:"ruleArn,omitempty"`
+	// The priority of the rule. Use "default" to select the default rule of the listener.
+	Priority string `json:"priority,omitempty"`
+	// One of the values of the host-header condition of the rule.
+	HostHeader string `json:"hostHeader,omitempty"`
+	// One of the values of the path-pattern condition of the rule.
+	PathPattern string `json:"pathPattern,omitempty"`
+	// The tags which the rule must have.
+	// The tags of the listener are used for its default rule.
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+func (s ECSListenerRuleSelector) validate() error {
+	if s.RuleArn == "" && s.Priority == "" && s.HostHeader == "" && s.PathPattern == "" && len(s.Tags) == 0 {
+		return fmt.Errorf("at least one of ruleArn, priority, hostHeader, pathPattern and tags must be specified")
+	}
+	if s.Priority == "" || s.Priority == "default" {
+		return nil
+	}
+	if p, err := strconv.Atoi(s.Priority); err != nil || p < 1 || p > 50000 {
+		return fmt.Errorf("priority must be \"default\" or a number between 1 and 50000: %s", s.Priority)
+	}
+	return nil
 }
 
 type ECSTargetGroup struct {
@@ -109,12 +468,74 @@ type ECSSyncStageOptions struct {
 	// If this is set, the application may be unavailable for a short of time during the deployment.
 	// Default is false.
 	Recreate bool `json:"recreate"`
+	// Whether to skip updating the service when the rendered task and service definitions
+	// are identical to the ones at the last deployed commit.
+	// Not applied to standalone tasks.
+	SkipApplyIfUnchanged bool `json:"skipApplyIfUnchanged,omitempty"`
 }
 
 // ECSCanaryRolloutStageOptions contains all configurable values for a ECS_CANARY_ROLLOUT stage.
+// Multiple ECS_CANARY_ROLLOUT stages can be used in a pipeline to gradually
+// scale the CANARY task set, e.g. 10%, 30% and then 100% with WAIT stages between them.
 type ECSCanaryRolloutStageOptions struct {
 	// Scale represents the amount of desired task that should be rolled out as CANARY variant workload.
 	Scale Percentage `json:"scale"`
+	// The capacity provider strategy used to launch the CANARY task set, e.g. FARGATE_SPOT.
+	// When specified, the task set is launched by it instead of the launch type of the service.
+	CapacityProviderStrategy []ECSCapacityProviderStrategyItem `json:"capacityProviderStrategy,omitempty"`
+}
+
+// ECSCapacityProviderStrategyItem represents how the tasks are spread over a capacity provider.
+type ECSCapacityProviderStrategyItem struct {
+	// The short name of the capacity provider, e.g. FARGATE or FARGATE_SPOT.
+	CapacityProvider string `json:"capacityProvider"`
+	// The relative percentage of the tasks launched by the capacity provider.
+	Weight int `json:"weight,omitempty"`
+	// The minimum number of tasks launched by the capacity provider.
+	// Only one capacity provider in a strategy can have a base.
+	Base int `json:"base,omitempty"`
+}
+
+func (o *ECSCanaryRolloutStageOptions) Validate() error {
+	if o.Scale.Int() <= 0 || o.Scale.Int() > 100 {
+		return fmt.Errorf("scale of %s stage must be in range (0, 100], got %s", model.StageECSCanaryRollout, o.Scale)
+	}
+	if len(o.CapacityProviderStrategy) == 0 {
+		return nil
+	}
+
+	var (
+		providers   = make(map[string]struct{}, len(o.CapacityProviderStrategy))
+		totalWeight int
+		hasBase     bool
+	)
+	for _, item := range o.CapacityProviderStrategy {
+		if item.CapacityProvider == "" {
+			return fmt.Errorf("capacityProvider of %s stage must not be empty", model.StageECSCanaryRollout)
+		}
+		if _, ok := providers[item.CapacityProvider]; ok {
+			return fmt.Errorf("capacity provider %s is specified more than once", item.CapacityProvider)
+		}
+		providers[item.CapacityProvider] = struct{}{}
+
+		if item.Weight < 0 || item.Weight > 1000 {
+			return fmt.Errorf("weight of capacity provider %s must be in range [0, 1000], got %d", item.CapacityProvider, item.Weight)
+		}
+		if item.Base < 0 || item.Base > 100000 {
+			return fmt.Errorf("base of capacity provider %s must be in range [0, 100000], got %d", item.CapacityProvider, item.Base)
+		}
+		if item.Base > 0 {
+			if hasBase {
+				return fmt.Errorf("only one capacity provider can have a base")
+			}
+			hasBase = true
+		}
+		totalWeight += item.Weight
+	}
+	if totalWeight == 0 {
+		return fmt.Errorf("at least one capacity provider must have a weight greater than 0")
+	}
+	return nil
 }
 
 // ECSPrimaryRolloutStageOptions contains all configurable values for a ECS_PRIMARY_ROLLOUT stage.
@@ -123,14 +544,151 @@ type ECSPrimaryRolloutStageOptions struct {
 
 // ECSCanaryCleanStageOptions contains all configurable values for a ECS_CANARY_CLEAN stage.
 type ECSCanaryCleanStageOptions struct {
+	// Whether to delete the CANARY task set even if the ELB listeners
+	// are still forwarding traffic to its target group.
+	// By default, the stage fails in that case to prevent deleting a variant promoted manually.
+	Force bool `json:"force"`
+}
+
+// ECSMaintenanceOnStageOptions contains all configurable values for a ECS_MAINTENANCE_ON stage.
+// The ELB listener rules answering all requests to the application with the fixed response are added
+// until a ECS_MAINTENANCE_OFF stage or the rollback is executed.
+type ECSMaintenanceOnStageOptions struct {
+	// The HTTP status code of the maintenance response.
+	// Default is 503.
+	StatusCode int `json:"statusCode,omitempty" default:"503"`
+	// The content type of the maintenance response.
+	// Possible values are text/plain, text/css, text/html, application/javascript and application/json.
+	// Default is text/html.
+	ContentType string `json:"contentType,omitempty" default:"text/html"`
+	// The body of the maintenance response. Up to 1024 characters.
+	MessageBody string `json:"messageBody,omitempty"`
+}
+
+func (o *ECSMaintenanceOnStageOptions) Validate() error {
+	if o.StatusCode < 200 || o.StatusCode >= 600 || (o.StatusCode >= 300 && o.StatusCode < 400) {
+		return fmt.Errorf("statusCode of %s stage must be 2XX, 4XX or 5XX, got %d", model.StageECSMaintenanceOn, o.StatusCode)
+	}
+	switch o.ContentType {
+	case "text/plain", "text/css", "text/html", "application/javascript", "application/json":
+	default:
+		return fmt.Errorf("unsupported contentType %q of %s stage", o.ContentType, model.StageECSMaintenanceOn)
+	}
+	if len(o.MessageBody) > 1024 {
+		return fmt.Errorf("messageBody of %s stage must be up to 1024 characters", model.StageECSMaintenanceOn)
+	}
+	return nil
+}
+
+// ECSMaintenanceOffStageOptions contains all configurable values for a ECS_MAINTENANCE_OFF stage.
+type ECSMaintenanceOffStageOptions struct {
 }
 
+// ECSTargetGroupHealthCheckStageOptions contains all configurable values for a ECS_TARGET_GROUP_HEALTH_CHECK stage.
+// The stage waits until enough targets registered to the target groups become healthy in ELB.
+type ECSTargetGroupHealthCheckStageOptions struct {
+	// The target groups to be checked. Possible values are primary and canary.
+	// Empty means all of the target groups configured in the application.
+	TargetGroups []string `json:"targetGroups,omitempty"`
+	// The minimum percentage of the healthy targets among the registered ones in each target group.
+	// Default is 100.
+	MinHealthyPercentage int `json:"minHealthyPercentage,omitempty" default:"100"`
+	// The minimum number of the healthy targets in each target group.
+	// Default is 1.
+	MinHealthyCount int `json:"minHealthyCount,omitempty" default:"1"`
+	// How long to wait for the targets to become healthy.
+	// Default is 10m.
+	Timeout Duration `json:"timeout,omitempty" default:"10m"`
+	// How often to check the health of the targets.
+	// Default is 15s.
+	Interval Duration `json:"interval,omitempty" default:"15s"`
+}
+
+func (o *ECSTargetGroupHealthCheckStageOptions) Validate() error {
+	for _, tg := range o.TargetGroups {
+		if tg != ECSTargetGroupPrimary && tg != ECSTargetGroupCanary {
+			return fmt.Errorf("unsupported target group %q of %s stage, must be %s or %s", tg, model.StageECSTargetGroupHealthCheck, ECSTargetGroupPrimary, ECSTargetGroupCanary)
+		}
+	}
+	if o.MinHealthyPercentage < 0 || o.MinHealthyPercentage > 100 {
+		return fmt.Errorf("minHealthyPercentage of %s stage must be between 0 and 100, got %d", model.StageECSTargetGroupHealthCheck, o.MinHealthyPercentage)
+	}
+	if o.MinHealthyCount < 0 {
+		return fmt.Errorf("minHealthyCount of %s stage must not be negative, got %d", model.StageECSTargetGroupHealthCheck, o.MinHealthyCount)
+	}
+	if o.Timeout <= 0 || o.Interval <= 0 {
+		return fmt.Errorf("timeout and interval of %s stage must be positive", model.StageECSTargetGroupHealthCheck)
+	}
+	return nil
+}
+
+const (
+	ECSTargetGroupPrimary = "primary"
+	ECSTargetGroupCanary  = "canary"
+)
+
 // ECSTrafficRoutingStageOptions contains all configurable values for ECS_TRAFFIC_ROUTING stage.
 type ECSTrafficRoutingStageOptions struct {
 	// Canary represents the amount of traffic that the rolled out CANARY variant will serve.
 	Canary Percentage `json:"canary,omitempty"`
 	// Primary represents the amount of traffic that the rolled out CANARY variant will serve.
 	Primary Percentage `json:"primary,omitempty"`
+	// Route requests matching the rules to CANARY variant and everything else to PRIMARY variant.
+	// When specified, the percentage fields are ignored.
+	// The rules are added to the ELB listeners and removed when the traffic is routed back or rolled back.
+	ABTesting *ABTestingRouting `json:"abTesting,omitempty"`
+	// Configuration for the target group stickiness of the ELB listener rules.
+	// When specified, the sticky sessions on the variant whose traffic is being stopped
+	// are drained before its weight becomes 0.
+	Stickiness *ECSTrafficRoutingStickiness `json:"stickiness,omitempty"`
+	// The weights of the listeners are modified step by step when steps are specified.
+	TrafficRoutingSteps `json:",inline"`
+}
+
+func (opts *ECSTrafficRoutingStageOptions) Validate() error {
+	if opts.ABTesting != nil {
+		if len(opts.Steps) > 0 {
+			return errors.New("steps can not be used with abTesting")
+		}
+		if err := opts.ABTesting.Validate(); err != nil {
+			return err
+		}
+		for _, r := range opts.ABTesting.Rules {
+			if len(r.SourceLabels) > 0 {
+				return errors.New("sourceLabels of abTesting is not supported for ECS applications")
+			}
+		}
+	}
+	if opts.Stickiness != nil {
+		if err := opts.Stickiness.Validate(); err != nil {
+			return err
+		}
+	}
+	return opts.TrafficRoutingSteps.validate()
+}
+
+// ECSTrafficRoutingStickiness represents the target group stickiness used while routing traffic.
+type ECSTrafficRoutingStickiness struct {
+	// The time period during which requests from a client are routed to the same target group.
+	// Must be between 1s and 7d.
+	// Default is 1h.
+	Duration Duration `json:"duration,omitempty" default:"1h"`
+	// How long to keep a small amount of traffic on the variant whose weight is being changed to 0,
+	// so that the sticky sessions on it can drain before it stops receiving traffic.
+	// Zero means the weight is changed immediately.
+	// Default is 5m.
+	DrainDuration Duration `json:"drainDuration,omitempty" default:"5m"`
+}
+
+func (s *ECSTrafficRoutingStickiness) Validate() error {
+	const maxDuration = 7 * 24 * time.Hour
+	if d := s.Duration.Duration(); d < time.Second || d > maxDuration {
+		return fmt.Errorf("stickiness duration must be between 1s and %v", maxDuration)
+	}
+	if s.DrainDuration < 0 {
+		return errors.New("stickiness drainDuration must not be negative")
+	}
+	return nil
 }
 
 func (opts ECSTrafficRoutingStageOptions) Percentage() (primary, canary int) {
@@ -153,10 +711,77 @@ func (opts ECSTrafficRoutingStageOptions) Percentage() (primary, canary int) {
 
 func (in *ECSDeploymentInput) validate() error {
 	switch in.AccessType {
-	case AccessTypeELB, AccessTypeServiceDiscovery:
+	case AccessTypeELB, AccessTypeServiceDiscovery, AccessTypeAppMesh:
 		break
 	default:
 		return fmt.Errorf("invalid accessType: %s", in.AccessType)
 	}
+	if in.IsAccessedViaAppMesh() {
+		if in.AppMesh == nil {
+			return fmt.Errorf("accessType %s requires appMesh", AccessTypeAppMesh)
+		}
+		if in.IsStandaloneTask() {
+			return fmt.Errorf("accessType %s can not be used with standalone tasks", AccessTypeAppMesh)
+		}
+		if err := in.AppMesh.validate(); err != nil {
+			return err
+		}
+	} else if in.AppMesh != nil {
+		return fmt.Errorf("appMesh requires accessType %s", AccessTypeAppMesh)
+	}
+	if in.CodeDeploy != nil {
+		if in.IsStandaloneTask() {
+			return fmt.Errorf("codeDeploy can not be used with standalone tasks")
+		}
+		if !in.IsAccessedViaELB() {
+			return fmt.Errorf("codeDeploy requires accessType %s", AccessTypeELB)
+		}
+		if err := in.CodeDeploy.validate(); err != nil {
+			return err
+		}
+	}
+	if in.RollingUpdate != nil {
+		if in.IsStandaloneTask() {
+			return fmt.Errorf("rollingUpdate can not be used with standalone tasks")
+		}
+		if in.CodeDeploy != nil {
+			return fmt.Errorf("rollingUpdate and codeDeploy can not be used together")
+		}
+		if in.IsAccessedViaAppMesh() {
+			return fmt.Errorf("rollingUpdate can not be used with accessType %s", AccessTypeAppMesh)
+		}
+		if in.ReusePrimaryTaskDefinitionOnRollback {
+			return fmt.Errorf("reusePrimaryTaskDefinitionOnRollback can not be used with rollingUpdate")
+		}
+		if in.RollingUpdate.Timeout < 0 {
+			return fmt.Errorf("rollingUpdate.timeout must not be negative")
+		}
+	}
+	if in.ScheduledTask != nil {
+		if !in.IsStandaloneTask() {
+			return fmt.Errorf("scheduledTask can be used only with standalone tasks")
+		}
+		if in.ScheduledTask.RuleName == "" {
+			return fmt.Errorf("scheduledTask.ruleName must be specified")
+		}
+	}
+	for i, s := range in.TargetGroups.ListenerRules {
+		if err := s.validate(); err != nil {
+			return fmt.Errorf("invalid targetGroups.listenerRules[%d]: %w", i, err)
+		}
+	}
+	for i, l := range in.TargetGroups.Listeners {
+		if l == "" {
+			return fmt.Errorf("targetGroups.listeners[%d] must not be empty", i)
+		}
+	}
+	if in.AutoScaling != nil {
+		if in.IsStandaloneTask() {
+			return fmt.Errorf("autoScaling can not be used with standalone tasks")
+		}
+		if err := in.AutoScaling.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -16,11 +16,14 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 func TestECSApplicationConfig(t *testing.T) {
@@ -113,6 +116,164 @@ func TestECSApplicationConfig(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			fileName:           "testdata/application/ecs-app-definition-template.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &ECSApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+					Planner: DeploymentPlanner{
+						AutoRollback: newBoolPointer(true),
+					},
+				},
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "/path/to/servicedef.yaml",
+					TaskDefinitionFile:    "/path/to/taskdef.yaml",
+					LaunchType:            "FARGATE",
+					AutoRollback:          newBoolPointer(true),
+					RunStandaloneTask:     newBoolPointer(true),
+					AccessType:            "ELB",
+					DefinitionTemplate: ECSDefinitionTemplate{
+						Enabled: true,
+						Params: map[string]string{
+							"cpu":    "256",
+							"memory": "512",
+						},
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/ecs-app-codedeploy.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &ECSApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+					Planner: DeploymentPlanner{
+						AutoRollback: newBoolPointer(true),
+					},
+				},
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "/path/to/servicedef.yaml",
+					TaskDefinitionFile:    "/path/to/taskdef.yaml",
+					TargetGroups: ECSTargetGroups{
+						Primary: &ECSTargetGroup{
+							TargetGroupArn: "arn:aws:elasticloadbalancing:xyz",
+							ContainerName:  "web",
+							ContainerPort:  80,
+						},
+					},
+					LaunchType:        "FARGATE",
+					AutoRollback:      newBoolPointer(true),
+					RunStandaloneTask: newBoolPointer(true),
+					AccessType:        "ELB",
+					CodeDeploy: &ECSCodeDeployInput{
+						ApplicationName:      "simple",
+						DeploymentConfigName: "CodeDeployDefault.ECSLinear10PercentEvery1Minutes",
+						Hooks: ECSCodeDeployHooks{
+							AfterAllowTestTraffic: "simple-smoke-test",
+						},
+						Timeout: Duration(time.Hour),
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/ecs-app-autoscaling.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &ECSApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+					Planner: DeploymentPlanner{
+						AutoRollback: newBoolPointer(true),
+					},
+				},
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "/path/to/servicedef.yaml",
+					TaskDefinitionFile:    "/path/to/taskdef.yaml",
+					LaunchType:            "FARGATE",
+					AutoRollback:          newBoolPointer(true),
+					RunStandaloneTask:     newBoolPointer(true),
+					AccessType:            "ELB",
+					AutoScaling: &ECSAutoScaling{
+						MinCapacity: 2,
+						MaxCapacity: 10,
+						TargetTrackingPolicies: []ECSTargetTrackingScalingPolicy{
+							{
+								Name:             "cpu",
+								PredefinedMetric: "ECSServiceAverageCPUUtilization",
+								TargetValue:      60,
+								ScaleInCooldown:  Duration(5 * time.Minute),
+							},
+						},
+						StepScalingPolicies: []ECSStepScalingPolicy{
+							{
+								Name:                  "queue-depth",
+								AdjustmentType:        "ChangeInCapacity",
+								MetricAggregationType: "Average",
+								Steps: []ECSStepAdjustment{
+									{
+										LowerBound:        func(v float64) *float64 { return &v }(0),
+										ScalingAdjustment: 2,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: nil,
+		},
 		{
 			fileName:           "testdata/application/ecs-app-invalid-access-type.yaml",
 			expectedKind:       KindECSApp,
@@ -163,3 +324,797 @@ func TestECSApplicationConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestECSTrafficRoutingStickinessValidate(t *testing.T) {
+	testcases := []struct {
+		name       string
+		stickiness ECSTrafficRoutingStickiness
+		wantErr    bool
+	}{
+		{
+			name:       "valid",
+			stickiness: ECSTrafficRoutingStickiness{Duration: Duration(time.Hour), DrainDuration: Duration(5 * time.Minute)},
+		},
+		{
+			name:       "too short duration",
+			stickiness: ECSTrafficRoutingStickiness{Duration: Duration(time.Millisecond)},
+			wantErr:    true,
+		},
+		{
+			name:       "too long duration",
+			stickiness: ECSTrafficRoutingStickiness{Duration: Duration(8 * 24 * time.Hour)},
+			wantErr:    true,
+		},
+		{
+			name:       "negative drain duration",
+			stickiness: ECSTrafficRoutingStickiness{Duration: Duration(time.Hour), DrainDuration: Duration(-time.Minute)},
+			wantErr:    true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.stickiness.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestECSTrafficRoutingStageOptionsValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		opts    ECSTrafficRoutingStageOptions
+		wantErr bool
+	}{
+		{
+			name: "percentage only",
+			opts: ECSTrafficRoutingStageOptions{Canary: Percentage{Number: 20}},
+		},
+		{
+			name: "valid steps",
+			opts: ECSTrafficRoutingStageOptions{
+				TrafficRoutingSteps: TrafficRoutingSteps{
+					StepInterval: Duration(5 * time.Minute),
+					Steps: []TrafficRoutingStep{
+						{Canary: Percentage{Number: 10}},
+						{Canary: Percentage{Number: 30}, Analysis: &AnalysisStageOptions{Duration: Duration(10 * time.Minute)}},
+						{Canary: Percentage{Number: 100}},
+					},
+				},
+			},
+		},
+		{
+			name: "steps with abTesting",
+			opts: ECSTrafficRoutingStageOptions{
+				ABTesting: &ABTestingRouting{Rules: []ABTestingRule{{Header: "X-Canary", Value: "1"}}},
+				TrafficRoutingSteps: TrafficRoutingSteps{
+					Steps: []TrafficRoutingStep{{Canary: Percentage{Number: 10}}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "abTesting with source labels",
+			opts: ECSTrafficRoutingStageOptions{
+				ABTesting: &ABTestingRouting{Rules: []ABTestingRule{{SourceLabels: map[string]string{"team": "qa"}}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid steps",
+			opts: ECSTrafficRoutingStageOptions{
+				TrafficRoutingSteps: TrafficRoutingSteps{
+					Steps: []TrafficRoutingStep{{Canary: Percentage{Number: 150}}},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestECSCanaryRolloutStageOptionsValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		scale   int
+		wantErr bool
+	}{
+		{
+			name:  "partial scale",
+			scale: 10,
+		},
+		{
+			name:  "full scale",
+			scale: 100,
+		},
+		{
+			name:    "zero scale",
+			scale:   0,
+			wantErr: true,
+		},
+		{
+			name:    "over full scale",
+			scale:   150,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := ECSCanaryRolloutStageOptions{Scale: Percentage{Number: tc.scale, HasSuffix: true}}
+			err := o.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestECSCanaryRolloutStageOptionsValidateCapacityProviderStrategy(t *testing.T) {
+	testcases := []struct {
+		name     string
+		strategy []ECSCapacityProviderStrategyItem
+		wantErr  bool
+	}{
+		{
+			name: "spot only",
+			strategy: []ECSCapacityProviderStrategyItem{
+				{CapacityProvider: "FARGATE_SPOT", Weight: 1},
+			},
+		},
+		{
+			name: "on-demand base with spot",
+			strategy: []ECSCapacityProviderStrategyItem{
+				{CapacityProvider: "FARGATE", Base: 1, Weight: 1},
+				{CapacityProvider: "FARGATE_SPOT", Weight: 3},
+			},
+		},
+		{
+			name: "empty capacity provider",
+			strategy: []ECSCapacityProviderStrategyItem{
+				{Weight: 1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicated capacity provider",
+			strategy: []ECSCapacityProviderStrategyItem{
+				{CapacityProvider: "FARGATE_SPOT", Weight: 1},
+				{CapacityProvider: "FARGATE_SPOT", Weight: 2},
+			},
+			wantErr: true,
+		},
+		{
+			name: "multiple bases",
+			strategy: []ECSCapacityProviderStrategyItem{
+				{CapacityProvider: "FARGATE", Base: 1, Weight: 1},
+				{CapacityProvider: "FARGATE_SPOT", Base: 1, Weight: 1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no weight",
+			strategy: []ECSCapacityProviderStrategyItem{
+				{CapacityProvider: "FARGATE_SPOT", Base: 1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "too large weight",
+			strategy: []ECSCapacityProviderStrategyItem{
+				{CapacityProvider: "FARGATE_SPOT", Weight: 1001},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := ECSCanaryRolloutStageOptions{
+				Scale:                    Percentage{Number: 10, HasSuffix: true},
+				CapacityProviderStrategy: tc.strategy,
+			}
+			err := o.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestECSMaintenanceOnStageOptionsValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		opts    ECSMaintenanceOnStageOptions
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			opts: ECSMaintenanceOnStageOptions{StatusCode: 503, ContentType: "text/html", MessageBody: "<h1>Under maintenance</h1>"},
+		},
+		{
+			name: "2XX status code",
+			opts: ECSMaintenanceOnStageOptions{StatusCode: 200, ContentType: "application/json"},
+		},
+		{
+			name:    "3XX status code",
+			opts:    ECSMaintenanceOnStageOptions{StatusCode: 302, ContentType: "text/html"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported content type",
+			opts:    ECSMaintenanceOnStageOptions{StatusCode: 503, ContentType: "image/png"},
+			wantErr: true,
+		},
+		{
+			name:    "too long message body",
+			opts:    ECSMaintenanceOnStageOptions{StatusCode: 503, ContentType: "text/plain", MessageBody: strings.Repeat("a", 1025)},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestECSApplicationSpecValidateCodeDeploy(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		spec    ECSApplicationSpec
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "servicedef.yaml",
+					AccessType:            AccessTypeELB,
+					CodeDeploy:            &ECSCodeDeployInput{ApplicationName: "simple"},
+				},
+			},
+		},
+		{
+			name: "missing application name",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "servicedef.yaml",
+					AccessType:            AccessTypeELB,
+					CodeDeploy:            &ECSCodeDeployInput{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "container name without port",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "servicedef.yaml",
+					AccessType:            AccessTypeELB,
+					CodeDeploy:            &ECSCodeDeployInput{ApplicationName: "simple", ContainerName: "web"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "standalone task",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					AccessType: AccessTypeELB,
+					CodeDeploy: &ECSCodeDeployInput{ApplicationName: "simple"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "service discovery",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "servicedef.yaml",
+					AccessType:            AccessTypeServiceDiscovery,
+					CodeDeploy:            &ECSCodeDeployInput{ApplicationName: "simple"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "canary stage in pipeline",
+			spec: ECSApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{Name: model.StageECSCanaryRollout},
+						},
+					},
+				},
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "servicedef.yaml",
+					AccessType:            AccessTypeELB,
+					CodeDeploy:            &ECSCodeDeployInput{ApplicationName: "simple"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.spec.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestECSApplicationSpecValidateRollingUpdate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		spec    ECSApplicationSpec
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "servicedef.yaml",
+					AccessType:            AccessTypeServiceDiscovery,
+					RollingUpdate:         &ECSRollingUpdateInput{},
+				},
+			},
+		},
+		{
+			name: "standalone task",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					AccessType:    AccessTypeELB,
+					RollingUpdate: &ECSRollingUpdateInput{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "with codeDeploy",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "servicedef.yaml",
+					AccessType:            AccessTypeELB,
+					CodeDeploy:            &ECSCodeDeployInput{ApplicationName: "simple"},
+					RollingUpdate:         &ECSRollingUpdateInput{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "reuse primary task definition on rollback",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile:                "servicedef.yaml",
+					AccessType:                           AccessTypeELB,
+					ReusePrimaryTaskDefinitionOnRollback: true,
+					RollingUpdate:                        &ECSRollingUpdateInput{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative timeout",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "servicedef.yaml",
+					AccessType:            AccessTypeELB,
+					RollingUpdate:         &ECSRollingUpdateInput{Timeout: Duration(-time.Minute)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "primary stage in pipeline",
+			spec: ECSApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{Name: model.StageECSPrimaryRollout},
+						},
+					},
+				},
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "servicedef.yaml",
+					AccessType:            AccessTypeELB,
+					RollingUpdate:         &ECSRollingUpdateInput{},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.spec.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestECSApplicationSpecValidateScheduledTask(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		spec    ECSApplicationSpec
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					AccessType:    AccessTypeELB,
+					ScheduledTask: &ECSScheduledTask{RuleName: "nightly-batch"},
+				},
+			},
+		},
+		{
+			name: "missing rule name",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					AccessType:    AccessTypeELB,
+					ScheduledTask: &ECSScheduledTask{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "service",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "servicedef.yaml",
+					AccessType:            AccessTypeELB,
+					ScheduledTask:         &ECSScheduledTask{RuleName: "nightly-batch"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.spec.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestECSApplicationSpecValidateAppMesh(t *testing.T) {
+	t.Parallel()
+
+	validMesh := func() *ECSAppMesh {
+		return &ECSAppMesh{
+			MeshName:          "mesh",
+			VirtualRouterName: "simple-router",
+			RouteName:         "simple-route",
+			Primary:           ECSAppMeshVirtualNode{VirtualNodeName: "simple-blue", RegistryArn: "arn:aws:servicediscovery:us-east-1:123:service/srv-blue"},
+			Canary:            ECSAppMeshVirtualNode{VirtualNodeName: "simple-green", RegistryArn: "arn:aws:servicediscovery:us-east-1:123:service/srv-green"},
+		}
+	}
+	testcases := []struct {
+		name    string
+		spec    ECSApplicationSpec
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "servicedef.yaml",
+					AccessType:            AccessTypeAppMesh,
+					AppMesh:               validMesh(),
+				},
+			},
+		},
+		{
+			name: "missing appMesh",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "servicedef.yaml",
+					AccessType:            AccessTypeAppMesh,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "appMesh with ELB",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "servicedef.yaml",
+					AccessType:            AccessTypeELB,
+					AppMesh:               validMesh(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "same virtual node",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "servicedef.yaml",
+					AccessType:            AccessTypeAppMesh,
+					AppMesh: func() *ECSAppMesh {
+						m := validMesh()
+						m.Canary.VirtualNodeName = m.Primary.VirtualNodeName
+						return m
+					}(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing registry",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "servicedef.yaml",
+					AccessType:            AccessTypeAppMesh,
+					AppMesh: func() *ECSAppMesh {
+						m := validMesh()
+						m.Canary.RegistryArn = ""
+						return m
+					}(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "standalone task",
+			spec: ECSApplicationSpec{
+				Input: ECSDeploymentInput{
+					AccessType: AccessTypeAppMesh,
+					AppMesh:    validMesh(),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.spec.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestECSAutoScalingValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		autoScaling ECSAutoScaling
+		wantErr     bool
+	}{
+		{
+			name: "valid",
+			autoScaling: ECSAutoScaling{
+				MinCapacity: 1,
+				MaxCapacity: 4,
+				TargetTrackingPolicies: []ECSTargetTrackingScalingPolicy{
+					{Name: "cpu", PredefinedMetric: "ECSServiceAverageCPUUtilization", TargetValue: 60},
+				},
+				StepScalingPolicies: []ECSStepScalingPolicy{
+					{Name: "queue", AdjustmentType: "ChangeInCapacity", MetricAggregationType: "Average", Steps: []ECSStepAdjustment{{ScalingAdjustment: 1}}},
+				},
+			},
+		},
+		{
+			name:        "max capacity less than min capacity",
+			autoScaling: ECSAutoScaling{MinCapacity: 4, MaxCapacity: 1},
+			wantErr:     true,
+		},
+		{
+			name: "duplicated policy name",
+			autoScaling: ECSAutoScaling{
+				MinCapacity: 1,
+				MaxCapacity: 4,
+				TargetTrackingPolicies: []ECSTargetTrackingScalingPolicy{
+					{Name: "cpu", PredefinedMetric: "ECSServiceAverageCPUUtilization", TargetValue: 60},
+				},
+				StepScalingPolicies: []ECSStepScalingPolicy{
+					{Name: "cpu", AdjustmentType: "ChangeInCapacity", MetricAggregationType: "Average", Steps: []ECSStepAdjustment{{ScalingAdjustment: 1}}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "request count without resource label",
+			autoScaling: ECSAutoScaling{
+				MinCapacity: 1,
+				MaxCapacity: 4,
+				TargetTrackingPolicies: []ECSTargetTrackingScalingPolicy{
+					{Name: "requests", PredefinedMetric: "ALBRequestCountPerTarget", TargetValue: 100},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "step scaling without steps",
+			autoScaling: ECSAutoScaling{
+				MinCapacity: 1,
+				MaxCapacity: 4,
+				StepScalingPolicies: []ECSStepScalingPolicy{
+					{Name: "queue", AdjustmentType: "ChangeInCapacity", MetricAggregationType: "Average"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.autoScaling.validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestECSTargetGroupHealthCheckStageOptionsValidate(t *testing.T) {
+	valid := func() ECSTargetGroupHealthCheckStageOptions {
+		return ECSTargetGroupHealthCheckStageOptions{
+			MinHealthyPercentage: 100,
+			MinHealthyCount:      1,
+			Timeout:              Duration(10 * time.Minute),
+			Interval:             Duration(15 * time.Second),
+		}
+	}
+	testcases := []struct {
+		name    string
+		opts    func(o *ECSTargetGroupHealthCheckStageOptions)
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			opts: func(o *ECSTargetGroupHealthCheckStageOptions) {},
+		},
+		{
+			name: "specific target groups",
+			opts: func(o *ECSTargetGroupHealthCheckStageOptions) {
+				o.TargetGroups = []string{"primary", "canary"}
+			},
+		},
+		{
+			name: "unsupported target group",
+			opts: func(o *ECSTargetGroupHealthCheckStageOptions) {
+				o.TargetGroups = []string{"baseline"}
+			},
+			wantErr: true,
+		},
+		{
+			name: "too large percentage",
+			opts: func(o *ECSTargetGroupHealthCheckStageOptions) {
+				o.MinHealthyPercentage = 101
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative count",
+			opts: func(o *ECSTargetGroupHealthCheckStageOptions) {
+				o.MinHealthyCount = -1
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero interval",
+			opts: func(o *ECSTargetGroupHealthCheckStageOptions) {
+				o.Interval = 0
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := valid()
+			tc.opts(&opts)
+			err := opts.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestECSListenerRuleSelectorValidate(t *testing.T) {
+	testcases := []struct {
+		name     string
+		selector ECSListenerRuleSelector
+		wantErr  bool
+	}{
+		{
+			name:     "by rule arn",
+			selector: ECSListenerRuleSelector{RuleArn: "arn:aws:elasticloadbalancing:rule"},
+		},
+		{
+			name:     "by default priority",
+			selector: ECSListenerRuleSelector{Priority: "default"},
+		},
+		{
+			name:     "by conditions",
+			selector: ECSListenerRuleSelector{Priority: "10", HostHeader: "app.example.com", PathPattern: "/api/*"},
+		},
+		{
+			name:     "by tags",
+			selector: ECSListenerRuleSelector{Tags: map[string]string{"team": "payment"}},
+		},
+		{
+			name:     "empty",
+			selector: ECSListenerRuleSelector{},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid priority",
+			selector: ECSListenerRuleSelector{Priority: "first"},
+			wantErr:  true,
+		},
+		{
+			name:     "out of range priority",
+			selector: ECSListenerRuleSelector{Priority: "50001"},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.selector.validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestECSTargetGroupsRuleOwnershipRequired(t *testing.T) {
+	t.Parallel()
+
+	boolPtr := func(v bool) *bool { return &v }
+	testcases := []struct {
+		name         string
+		targetGroups ECSTargetGroups
+		want         bool
+	}{
+		{
+			name: "not scoped",
+		},
+		{
+			name: "scoped by listener rules",
+			targetGroups: ECSTargetGroups{
+				ListenerRules: []ECSListenerRuleSelector{{HostHeader: "app.example.com"}},
+			},
+			want: true,
+		},
+		{
+			name: "scoped by listeners",
+			targetGroups: ECSTargetGroups{
+				Listeners: []string{"arn:aws:elasticloadbalancing:ap-northeast-1:123456789012:listener/app/xxx/xxx/xxx"},
+			},
+			want: true,
+		},
+		{
+			name: "explicitly disabled",
+			targetGroups: ECSTargetGroups{
+				ListenerRules:        []ECSListenerRuleSelector{{HostHeader: "app.example.com"}},
+				RequireRuleOwnership: boolPtr(false),
+			},
+		},
+		{
+			name: "explicitly enabled",
+			targetGroups: ECSTargetGroups{
+				RequireRuleOwnership: boolPtr(true),
+			},
+			want: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, tc.targetGroups.RuleOwnershipRequired())
+		})
+	}
+}
@@ -14,6 +14,17 @@
 
 package config
 
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
 // KubernetesApplicationSpec represents an application configuration for Kubernetes application.
 type KubernetesApplicationSpec struct {
 	GenericApplicationSpec
@@ -43,6 +54,13 @@ type KubernetesApplicationSpec struct {
 	// Any resource which does not match any specified route will be applied
 	// to the default platform provider which had been specified while registering the application.
 	ResourceRoutes []KubernetesResourceRoute `json:"resourceRoutes"`
+	// Configuration to deploy exactly the same artifact as
+	// the most recent successful deployment of another application.
+	Promotion *KubernetesPromotion `json:"promotion,omitempty"`
+	// Configuration to deploy the manifests to multiple clusters.
+	// When specified, every stage is executed for each of the clusters
+	// instead of the default platform provider of the application.
+	MultiCluster *KubernetesMultiCluster `json:"multiCluster,omitempty"`
 }
 
 // Validate returns an error if any wrong configuration value was found.
@@ -50,6 +68,134 @@ func (s *KubernetesApplicationSpec) Validate() error {
 	if err := s.GenericApplicationSpec.Validate(); err != nil {
 		return err
 	}
+	if s.Input.HelmOptions != nil {
+		for _, f := range s.Input.HelmOptions.ExternalValueFiles {
+			if err := f.Validate(); err != nil {
+				return err
+			}
+		}
+		if r := s.Input.HelmOptions.PostRenderer; r != nil {
+			if err := r.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	if s.Input.ManagedNamespace != nil && s.Input.Namespace == "" {
+		return fmt.Errorf("input.namespace must be specified when input.managedNamespace is configured")
+	}
+	if s.Input.Impersonation != nil {
+		if err := s.Input.Impersonation.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.Promotion != nil {
+		if err := s.Promotion.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.TrafficRouting != nil && s.TrafficRouting.Nginx != nil {
+		if err := s.TrafficRouting.Nginx.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.TrafficRouting != nil && s.TrafficRouting.GatewayAPI != nil {
+		if err := s.TrafficRouting.GatewayAPI.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.TrafficRouting != nil && s.TrafficRouting.SMI != nil {
+		if err := s.TrafficRouting.SMI.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.MultiCluster != nil {
+		if len(s.ResourceRoutes) > 0 {
+			return fmt.Errorf("multiCluster can not be used with resourceRoutes")
+		}
+		if err := s.MultiCluster.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// KubernetesMultiCluster represents the clusters the manifests are deployed to.
+type KubernetesMultiCluster struct {
+	// List of the clusters to deploy.
+	Targets []KubernetesClusterTarget `json:"targets"`
+}
+
+// KubernetesClusterTarget represents a cluster the manifests are deployed to.
+type KubernetesClusterTarget struct {
+	// The name of the platform provider of the cluster.
+	Provider string `json:"provider"`
+	// The order to deploy to the cluster.
+	// The clusters having a smaller order are deployed first,
+	// and the ones having the same order are deployed in parallel.
+	// Default is 0.
+	Order int `json:"order,omitempty"`
+}
+
+func (m *KubernetesMultiCluster) Validate() error {
+	if len(m.Targets) == 0 {
+		return fmt.Errorf("multiCluster.targets must not be empty")
+	}
+	providers := make(map[string]struct{}, len(m.Targets))
+	for _, t := range m.Targets {
+		if t.Provider == "" {
+			return fmt.Errorf("provider of multiCluster.targets must not be empty")
+		}
+		if _, ok := providers[t.Provider]; ok {
+			return fmt.Errorf("provider %s is specified more than once in multiCluster.targets", t.Provider)
+		}
+		providers[t.Provider] = struct{}{}
+		if t.Order < 0 {
+			return fmt.Errorf("order of multiCluster target %s must not be negative", t.Provider)
+		}
+	}
+	return nil
+}
+
+// Waves returns the targets grouped by their order.
+// The groups are sorted in the order to deploy and the targets in a group keep the specified order.
+func (m *KubernetesMultiCluster) Waves() [][]KubernetesClusterTarget {
+	orders := make([]int, 0, len(m.Targets))
+	groups := make(map[int][]KubernetesClusterTarget, len(m.Targets))
+	for _, t := range m.Targets {
+		if _, ok := groups[t.Order]; !ok {
+			orders = append(orders, t.Order)
+		}
+		groups[t.Order] = append(groups[t.Order], t)
+	}
+	sort.Ints(orders)
+
+	waves := make([][]KubernetesClusterTarget, 0, len(orders))
+	for _, o := range orders {
+		waves = append(waves, groups[o])
+	}
+	return waves
+}
+
+// KubernetesPromotion represents the configuration for promoting
+// the artifact deployed by another application, e.g. the staging one.
+// While planning, the rendered artifact is verified against the source artifact
+// and the deployment fails if they are not identical.
+type KubernetesPromotion struct {
+	// The name of the application whose most recent successful deployment is the source artifact.
+	From string `json:"from"`
+	// Whether all images of the source artifact must be pinned by digest.
+	// Default is false.
+	RequireDigest bool `json:"requireDigest"`
+	// Whether the hash of rendered manifests must also be identical to the source one.
+	// Enable this only when both applications render exactly the same manifests.
+	// Default is false.
+	VerifyManifests bool `json:"verifyManifests"`
+}
+
+func (p *KubernetesPromotion) Validate() error {
+	if p.From == "" {
+		return fmt.Errorf("promotion.from must be specified")
+	}
 	return nil
 }
 
@@ -100,6 +246,110 @@ type KubernetesDeploymentInput struct {
 	// Automatically create a new namespace if it does not exist.
 	// Default is false.
 	AutoCreateNamespace bool `json:"autoCreateNamespace,omitempty"`
+
+	// Configuration for managing the namespace specified in the namespace field.
+	// When specified, the namespace is created or updated with the given labels and annotations
+	// before applying manifests.
+	ManagedNamespace *KubernetesManagedNamespace `json:"managedNamespace,omitempty"`
+
+	// How long to wait for the applied CustomResourceDefinitions to be established
+	// before applying the rest of manifests.
+	// Default is 2m.
+	CRDReadyTimeout Duration `json:"crdReadyTimeout,omitempty"`
+
+	// The identity to impersonate while applying manifests of this application.
+	// When specified, kubectl commands run with the --as and --as-group flags
+	// so that the RBAC rules bound to that identity are enforced.
+	// Empty means the credential of the platform provider is used as is.
+	Impersonation *KubernetesImpersonation `json:"impersonation,omitempty"`
+
+	// The options to apply manifests of this application by server-side apply.
+	// When specified, it overrides the one configured in the platform provider.
+	ServerSideApply *KubernetesServerSideApply `json:"serverSideApply,omitempty"`
+
+	// Whether to annotate the pod templates of the workloads with the hash of the ConfigMaps and Secrets
+	// they reference while rendering the manifests, so that changing only those configs rolls out the pods.
+	// The annotation is also shown in the diffs of the deployments.
+	// Default is false.
+	ConfigHash bool `json:"configHash,omitempty"`
+
+	// Whether all mutating requests are sent with server-side dry-run.
+	// This is automatically set while executing a dry-run deployment.
+	DryRun bool `json:"-"`
+}
+
+// KubernetesServerSideApply represents the options to apply manifests by server-side apply
+// instead of client-side apply, which stores the whole manifest in the
+// kubectl.kubernetes.io/last-applied-configuration annotation.
+type KubernetesServerSideApply struct {
+	// Whether to apply all manifests by server-side apply.
+	// Regardless of this, the manifests annotated with "pipecd.dev/server-side-apply: true"
+	// are always applied by server-side apply.
+	Enabled bool `json:"enabled"`
+	// The name of the field manager owning the applied fields.
+	// Empty means the default of kubectl is used.
+	FieldManager string `json:"fieldManager,omitempty"`
+	// Whether to take over the fields owned by other field managers when they conflict.
+	// Default is false, so applying fails on conflicts.
+	ForceConflicts bool `json:"forceConflicts,omitempty"`
+}
+
+// KubernetesImpersonation represents the Kubernetes identity to act as.
+// Exactly one of serviceAccount and user must be specified.
+type KubernetesImpersonation struct {
+	// The ServiceAccount to impersonate.
+	ServiceAccount *KubernetesImpersonationServiceAccount `json:"serviceAccount,omitempty"`
+	// The name of the user to impersonate.
+	User string `json:"user,omitempty"`
+	// The list of groups to impersonate.
+	Groups []string `json:"groups,omitempty"`
+}
+
+type KubernetesImpersonationServiceAccount struct {
+	// The namespace of the ServiceAccount.
+	Namespace string `json:"namespace"`
+	// The name of the ServiceAccount.
+	Name string `json:"name"`
+}
+
+func (i *KubernetesImpersonation) Validate() error {
+	if i.ServiceAccount != nil && i.User != "" {
+		return fmt.Errorf("only one of impersonation.serviceAccount and impersonation.user can be specified")
+	}
+	if i.ServiceAccount == nil && i.User == "" {
+		return fmt.Errorf("one of impersonation.serviceAccount and impersonation.user must be specified")
+	}
+	if sa := i.ServiceAccount; sa != nil && (sa.Namespace == "" || sa.Name == "") {
+		return fmt.Errorf("both impersonation.serviceAccount.namespace and impersonation.serviceAccount.name must be specified")
+	}
+	for _, g := range i.Groups {
+		if g == "" {
+			return fmt.Errorf("impersonation.groups must not contain an empty group")
+		}
+	}
+	return nil
+}
+
+// UserName returns the user name passed to the --as flag.
+// A ServiceAccount is represented as system:serviceaccount:<namespace>:<name>.
+func (i *KubernetesImpersonation) UserName() string {
+	if sa := i.ServiceAccount; sa != nil {
+		return fmt.Sprintf("system:serviceaccount:%s:%s", sa.Namespace, sa.Name)
+	}
+	return i.User
+}
+
+// KubernetesManagedNamespace represents the namespace whose metadata and lifecycle
+// are managed by the application.
+type KubernetesManagedNamespace struct {
+	// Labels to be set to the namespace. e.g. istio-injection, pod-security.kubernetes.io/enforce
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations to be set to the namespace.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Whether to delete the namespace together with all resources in it
+	// when the application is deleted.
+	// Default is false.
+	DeleteOnAppDeletion bool `json:"deleteOnAppDeletion,omitempty"`
 }
 
 type InputHelmChart struct {
@@ -134,6 +384,89 @@ type InputHelmOptions struct {
 	APIVersions []string `json:"apiVersions,omitempty"`
 	// Kubernetes version used for Capabilities.KubeVersion
 	KubeVersion string `json:"kubeVersion,omitempty"`
+	// List of value files located outside of the application directory.
+	// They are fetched while loading manifests and applied after the valueFiles.
+	ExternalValueFiles []InputHelmExternalValueFile `json:"externalValueFiles,omitempty"`
+	// Configuration for post-rendering the output of helm template.
+	PostRenderer *InputHelmPostRenderer `json:"postRenderer,omitempty"`
+}
+
+// InputHelmPostRenderer represents a kustomization applied over the rendered chart.
+type InputHelmPostRenderer struct {
+	// Relative path from the application directory to the kustomization directory.
+	// Its kustomization file must list the renderedFile as a resource.
+	KustomizeDir string `json:"kustomizeDir"`
+	// The name of the file the rendered chart is written into
+	// inside the kustomization directory.
+	// Default is helm-output.yaml.
+	RenderedFile string `json:"renderedFile" default:"helm-output.yaml"`
+}
+
+func (r *InputHelmPostRenderer) Validate() error {
+	if r.KustomizeDir == "" {
+		return errors.New("kustomizeDir is required for helm post renderer")
+	}
+	if !filepath.IsLocal(r.KustomizeDir) {
+		return fmt.Errorf("kustomizeDir of helm post renderer must be a relative path inside the application directory: %s", r.KustomizeDir)
+	}
+	if r.RenderedFile == "" || filepath.Base(r.RenderedFile) != r.RenderedFile {
+		return fmt.Errorf("renderedFile of helm post renderer must be a file name: %s", r.RenderedFile)
+	}
+	return nil
+}
+
+// InputHelmExternalValueFile represents a Helm values file stored in an external source.
+// Exactly one of git, s3, ssmParameter and secretsManagerSecret must be specified.
+type InputHelmExternalValueFile struct {
+	// The values file stored in another Git repository.
+	Git *InputHelmGitValueFile `json:"git,omitempty"`
+	// The URI of the S3 object, e.g. s3://bucket/path/to/values.yaml.
+	S3 string `json:"s3,omitempty"`
+	// The name of the AWS Systems Manager Parameter Store parameter.
+	SSMParameter string `json:"ssmParameter,omitempty"`
+	// The name or ARN of the AWS Secrets Manager secret.
+	SecretsManagerSecret string `json:"secretsManagerSecret,omitempty"`
+	// The AWS region used to fetch the AWS sources.
+	// Empty means the default region of the environment.
+	Region string `json:"region,omitempty"`
+	// The expected SHA256 checksum of the file content.
+	// Empty means the checksum will not be verified.
+	Checksum string `json:"checksum,omitempty"`
+}
+
+type InputHelmGitValueFile struct {
+	// Git remote address where the values file is placing.
+	GitRemote string `json:"gitRemote"`
+	// The commit SHA or tag for remote git.
+	Ref string `json:"ref,omitempty"`
+	// Relative path from the repository root directory to the values file.
+	Path string `json:"path"`
+}
+
+func (f *InputHelmExternalValueFile) Validate() error {
+	sources := 0
+	if f.Git != nil {
+		if f.Git.GitRemote == "" || f.Git.Path == "" {
+			return errors.New("both gitRemote and path are required for git external value file")
+		}
+		sources++
+	}
+	if f.S3 != "" {
+		if !strings.HasPrefix(f.S3, "s3://") {
+			return fmt.Errorf("s3 external value file must be formatted as s3://bucket/key: %s", f.S3)
+		}
+		sources++
+	}
+	if f.SSMParameter != "" {
+		sources++
+	}
+	if f.SecretsManagerSecret != "" {
+		sources++
+	}
+	if sources != 1 {
+		return errors.New("exactly one of git, s3, ssmParameter and secretsManagerSecret must be specified for external value file")
+	}
+	return nil
 }
 
 type KubernetesTrafficRoutingMethod string
@@ -142,11 +475,16 @@ const (
 	KubernetesTrafficRoutingMethodPodSelector KubernetesTrafficRoutingMethod = "podselector"
 	KubernetesTrafficRoutingMethodIstio       KubernetesTrafficRoutingMethod = "istio"
 	KubernetesTrafficRoutingMethodSMI         KubernetesTrafficRoutingMethod = "smi"
+	KubernetesTrafficRoutingMethodNginx       KubernetesTrafficRoutingMethod = "nginx"
+	KubernetesTrafficRoutingMethodGatewayAPI  KubernetesTrafficRoutingMethod = "gatewayapi"
 )
 
 type KubernetesTrafficRouting struct {
-	Method KubernetesTrafficRoutingMethod `json:"method"`
-	Istio  *IstioTrafficRouting           `json:"istio"`
+	Method     KubernetesTrafficRoutingMethod `json:"method"`
+	Istio      *IstioTrafficRouting           `json:"istio"`
+	Nginx      *NginxTrafficRouting           `json:"nginx"`
+	GatewayAPI *GatewayAPITrafficRouting      `json:"gatewayAPI"`
+	SMI        *SMITrafficRouting             `json:"smi"`
 }
 
 // DetermineKubernetesTrafficRoutingMethod determines the routing method should be used based on the TrafficRouting config.
@@ -173,6 +511,66 @@ type IstioTrafficRouting struct {
 	VirtualService K8sResourceReference `json:"virtualService"`
 }
 
+// NginxTrafficRouting represents the way to route traffic to CANARY variant
+// through a canary Ingress handled by NGINX Ingress Controller.
+// The canary Ingress is generated from the PRIMARY one and removed
+// once all traffic is routed back to PRIMARY variant.
+type NginxTrafficRouting struct {
+	// The reference to the Ingress manifest routing traffic to PRIMARY variant.
+	// Empty means the first Ingress resource will be used.
+	Ingress K8sResourceReference `json:"ingress"`
+	// The name of the header used to route the requests to CANARY variant regardless of the weight.
+	CanaryByHeader string `json:"canaryByHeader"`
+	// The value of the header to route the requests to CANARY variant.
+	// Empty means the requests having "always" as the header value are routed.
+	CanaryByHeaderValue string `json:"canaryByHeaderValue"`
+	// The name of the cookie used to route the requests having "always" as its value to CANARY variant.
+	CanaryByCookie string `json:"canaryByCookie"`
+}
+
+func (n *NginxTrafficRouting) Validate() error {
+	if n.Ingress.Kind != "" && n.Ingress.Kind != "Ingress" {
+		return fmt.Errorf("support only %q kind for nginx Ingress reference", "Ingress")
+	}
+	if n.CanaryByHeaderValue != "" && n.CanaryByHeader == "" {
+		return fmt.Errorf("canaryByHeader must be set when canaryByHeaderValue is specified")
+	}
+	return nil
+}
+
+// GatewayAPITrafficRouting represents the way to route traffic to CANARY and BASELINE variants
+// by updating the weights of the backends in an HTTPRoute of Kubernetes Gateway API.
+// The backends referencing the Service of application are replaced by the Services
+// of the variants, which are suffixed with their variant names.
+type GatewayAPITrafficRouting struct {
+	// The reference to the HTTPRoute manifest.
+	// Empty means the first HTTPRoute resource will be used.
+	HTTPRoute K8sResourceReference `json:"httpRoute"`
+}
+
+func (g *GatewayAPITrafficRouting) Validate() error {
+	if g.HTTPRoute.Kind != "" && g.HTTPRoute.Kind != "HTTPRoute" {
+		return fmt.Errorf("support only %q kind for gatewayAPI HTTPRoute reference", "HTTPRoute")
+	}
+	return nil
+}
+
+// SMITrafficRouting represents the way to route traffic to CANARY and BASELINE variants
+// by updating the weights of the backends in an SMI TrafficSplit.
+// It is handled in the same way as GatewayAPITrafficRouting.
+type SMITrafficRouting struct {
+	// The reference to the TrafficSplit manifest.
+	// Empty means the first TrafficSplit resource will be used.
+	TrafficSplit K8sResourceReference `json:"trafficSplit"`
+}
+
+func (s *SMITrafficRouting) Validate() error {
+	if s.TrafficSplit.Kind != "" && s.TrafficSplit.Kind != "TrafficSplit" {
+		return fmt.Errorf("support only %q kind for smi TrafficSplit reference", "TrafficSplit")
+	}
+	return nil
+}
+
 type K8sResourceReference struct {
 	Kind string `json:"kind"`
 	Name string `json:"name"`
@@ -183,7 +581,25 @@ type K8sSyncStageOptions struct {
 	// Whether the PRIMARY variant label should be added to manifests if they were missing.
 	AddVariantLabelToSelector bool `json:"addVariantLabelToSelector"`
 	// Whether the resources that are no longer defined in Git should be removed or not.
+	// Only the resources applied by PipeCD for this application are removed.
 	Prune bool `json:"prune"`
+	// Whether to only report the resources to be removed by prune in the stage log
+	// without deleting them. Useful for checking the result before enabling prune.
+	PruneDryRun bool `json:"pruneDryRun,omitempty"`
+	// Whether to wait until all applied Crossplane claims and composite resources become Synced and Ready.
+	WaitForCrossplaneResources bool `json:"waitForCrossplaneResources"`
+	// How long to wait for the Crossplane resources to become ready.
+	// Default is 10m.
+	CrossplaneResourcesTimeout Duration `json:"crossplaneResourcesTimeout,omitempty"`
+	// Whether to skip applying the manifests when the rendered ones are identical
+	// to the ones at the last deployed commit.
+	// The following K8S_WAIT_ROLLOUT stages are skipped as well.
+	SkipApplyIfUnchanged bool `json:"skipApplyIfUnchanged,omitempty"`
+	// Whether to adopt the live resources managed by Helm, Flux or Argo CD
+	// for migrating them to PipeCD without deleting and recreating them.
+	// The adopted resources are annotated so that the previous tool keeps them
+	// when its release or application is removed after the migration.
+	AdoptResources bool `json:"adoptResources,omitempty"`
 }
 
 // K8sPrimaryRolloutStageOptions contains all configurable values for a K8S_PRIMARY_ROLLOUT stage.
@@ -197,6 +613,48 @@ type K8sPrimaryRolloutStageOptions struct {
 	AddVariantLabelToSelector bool `json:"addVariantLabelToSelector"`
 	// Whether the resources that are no longer defined in Git should be removed or not.
 	Prune bool `json:"prune"`
+	// The maxSurge of the rolling update strategy of the PRIMARY Deployments in this stage.
+	// An integer value or a string suffixed by "%" can be specified.
+	// Overrides the value defined in the manifests when specified.
+	MaxSurge *Replicas `json:"maxSurge,omitempty"`
+	// The maxUnavailable of the rolling update strategy of the PRIMARY Deployments in this stage.
+	// An integer value or a string suffixed by "%" can be specified.
+	// Overrides the value defined in the manifests when specified.
+	MaxUnavailable *Replicas `json:"maxUnavailable,omitempty"`
+	// Configuration for checking the PodDisruptionBudgets of the PRIMARY workloads before rolling out.
+	PodDisruptionBudget K8sPodDisruptionBudgetCheck `json:"podDisruptionBudget"`
+}
+
+func (o *K8sPrimaryRolloutStageOptions) Validate() error {
+	if o.MaxSurge != nil && o.MaxUnavailable != nil && o.MaxSurge.Number == 0 && o.MaxUnavailable.Number == 0 {
+		return fmt.Errorf("maxSurge and maxUnavailable of %s stage must not be both zero", model.StageK8sPrimaryRollout)
+	}
+	for _, r := range []*Replicas{o.MaxSurge, o.MaxUnavailable} {
+		if r != nil && r.Number < 0 {
+			return fmt.Errorf("maxSurge and maxUnavailable of %s stage must not be negative", model.StageK8sPrimaryRollout)
+		}
+	}
+	if o.PodDisruptionBudget.Enabled && o.PodDisruptionBudget.Timeout < 0 {
+		return fmt.Errorf("podDisruptionBudget.timeout of %s stage must not be negative", model.StageK8sPrimaryRollout)
+	}
+	return nil
+}
+
+// K8sPodDisruptionBudgetCheck represents the configuration for checking PodDisruptionBudgets
+// before rolling out the PRIMARY workloads.
+// The rollout is paused while the PodDisruptionBudgets selecting the workloads would be violated
+// by the pods made unavailable during the rolling update, and the stage fails after the timeout.
+type K8sPodDisruptionBudgetCheck struct {
+	// Whether to check the PodDisruptionBudgets.
+	// The ones defined in the application manifests and selecting the workloads are checked.
+	// Default is false.
+	Enabled bool `json:"enabled"`
+	// The names of additional PodDisruptionBudgets not defined in the application manifests to check.
+	// They are looked up in the namespace of the application.
+	Names []string `json:"names,omitempty"`
+	// How long to wait for the PodDisruptionBudgets to allow enough disruptions.
+	// Default is 10m.
+	Timeout Duration `json:"timeout" default:"10m"`
 }
 
 // K8sCanaryRolloutStageOptions contains all configurable values for a K8S_CANARY_ROLLOUT stage.
@@ -249,6 +707,10 @@ type K8sResourcePatchOp struct {
 
 // K8sCanaryCleanStageOptions contains all configurable values for a K8S_CANARY_CLEAN stage.
 type K8sCanaryCleanStageOptions struct {
+	// Whether to delete the CANARY resources even if the live traffic routing resource
+	// shows that CANARY variant is still receiving traffic.
+	// By default, the stage fails in that case to prevent deleting a variant promoted manually.
+	Force bool `json:"force"`
 }
 
 // K8sBaselineRolloutStageOptions contains all configurable values for a K8S_BASELINE_ROLLOUT stage.
@@ -280,6 +742,25 @@ type K8sTrafficRoutingStageOptions struct {
 	Canary Percentage `json:"canary"`
 	// The percentage of traffic should be routed to BASELINE variant.
 	Baseline Percentage `json:"baseline"`
+	// Route requests matching the rules to CANARY variant.
+	// Everything else is routed by the percentage fields, or to PRIMARY variant when none of them is specified.
+	// Only available for Istio traffic routing.
+	ABTesting *ABTestingRouting `json:"abTesting,omitempty"`
+	// The traffic is routed between PRIMARY and CANARY variants step by step when steps are specified.
+	// Not available for PodSelector traffic routing.
+	TrafficRoutingSteps `json:",inline"`
+}
+
+func (opts *K8sTrafficRoutingStageOptions) Validate() error {
+	if opts.ABTesting != nil {
+		if len(opts.Steps) > 0 {
+			return errors.New("steps can not be used with abTesting")
+		}
+		if err := opts.ABTesting.Validate(); err != nil {
+			return err
+		}
+	}
+	return opts.TrafficRoutingSteps.validate()
 }
 
 func (opts K8sTrafficRoutingStageOptions) Percentages() (primary, canary, baseline int) {
@@ -297,6 +778,113 @@ func (opts K8sTrafficRoutingStageOptions) Percentages() (primary, canary, baseli
 	return opts.Primary.Int(), opts.Canary.Int(), opts.Baseline.Int()
 }
 
+// K8sMaintenanceOnStageOptions contains all configurable values for a K8S_MAINTENANCE_ON stage.
+// The selector of the application Service is replaced to route all traffic to the maintenance pods
+// until a K8S_MAINTENANCE_OFF stage or the rollback is executed.
+type K8sMaintenanceOnStageOptions struct {
+	// The labels selecting the pods serving the maintenance page.
+	Selector map[string]string `json:"selector"`
+}
+
+func (o *K8sMaintenanceOnStageOptions) Validate() error {
+	if len(o.Selector) == 0 {
+		return fmt.Errorf("selector of %s stage must not be empty", model.StageK8sMaintenanceOn)
+	}
+	return nil
+}
+
+// K8sMaintenanceOffStageOptions contains all configurable values for a K8S_MAINTENANCE_OFF stage.
+type K8sMaintenanceOffStageOptions struct {
+}
+
+// K8sWaitRolloutStageOptions contains all configurable values for a K8S_WAIT_ROLLOUT stage.
+// Each workload is given its own timeout counted from the beginning of the stage.
+type K8sWaitRolloutStageOptions struct {
+	// How long to wait for each Deployment to be rolled out.
+	// Default is 10m.
+	DeploymentTimeout Duration `json:"deploymentTimeout" default:"10m"`
+	// How long to wait for each StatefulSet to be rolled out.
+	// Default is 30m since its pods are updated one by one.
+	StatefulSetTimeout Duration `json:"statefulSetTimeout" default:"30m"`
+	// How long to wait for each DaemonSet to be rolled out.
+	// Default is 15m.
+	DaemonSetTimeout Duration `json:"daemonSetTimeout" default:"15m"`
+}
+
+// Timeout returns the timeout for the workload of the given kind.
+func (o *K8sWaitRolloutStageOptions) Timeout(kind string) time.Duration {
+	switch kind {
+	case "StatefulSet":
+		return o.StatefulSetTimeout.Duration()
+	case "DaemonSet":
+		return o.DaemonSetTimeout.Duration()
+	default:
+		return o.DeploymentTimeout.Duration()
+	}
+}
+
+// K8sFaultInjectionStageOptions contains all configurable values for a K8S_FAULT_INJECTION stage.
+// The faults are injected into CANARY variant, and removed after the analysis verifying its recovery.
+type K8sFaultInjectionStageOptions struct {
+	// The fault killing some pods of CANARY variant at the beginning of the stage.
+	PodKill *K8sPodKillFault `json:"podKill,omitempty"`
+	// The fault injected through Istio into the HTTP routes sending traffic to CANARY variant.
+	// It also affects the requests of those routes sent to the other variants.
+	HTTP *K8sHTTPFault `json:"http,omitempty"`
+	// The analysis run while the faults are injected.
+	Analysis *AnalysisStageOptions `json:"analysis"`
+}
+
+func (o *K8sFaultInjectionStageOptions) Validate() error {
+	if o.PodKill == nil && o.HTTP == nil {
+		return fmt.Errorf("at least one of podKill and http must be specified for %s stage", model.StageK8sFaultInjection)
+	}
+	if o.PodKill != nil {
+		if p := o.PodKill.Percentage.Int(); p <= 0 || p > 100 {
+			return fmt.Errorf("percentage of podKill must be in range (0, 100]: %d", p)
+		}
+	}
+	if o.HTTP != nil {
+		if err := o.HTTP.Validate(); err != nil {
+			return err
+		}
+	}
+	if o.Analysis == nil {
+		return fmt.Errorf("analysis must be specified for %s stage to verify the recovery", model.StageK8sFaultInjection)
+	}
+	return o.Analysis.Validate()
+}
+
+// K8sPodKillFault represents the fault killing some pods of CANARY variant.
+type K8sPodKillFault struct {
+	// The percentage of the pods to kill. At least one pod is killed.
+	Percentage Percentage `json:"percentage"`
+}
+
+// K8sHTTPFault represents the fault injected into the HTTP requests by Istio.
+// At least one of delay and abortHttpStatus must be specified.
+type K8sHTTPFault struct {
+	// The percentage of the requests to inject the fault.
+	Percentage Percentage `json:"percentage"`
+	// How long to delay the requests before forwarding them.
+	Delay Duration `json:"delay,omitempty"`
+	// The HTTP status code returned to the requests instead of forwarding them.
+	AbortHTTPStatus int `json:"abortHttpStatus,omitempty"`
+}
+
+func (f *K8sHTTPFault) Validate() error {
+	if p := f.Percentage.Int(); p <= 0 || p > 100 {
+		return fmt.Errorf("percentage of http fault must be in range (0, 100]: %d", p)
+	}
+	if f.Delay <= 0 && f.AbortHTTPStatus == 0 {
+		return errors.New("at least one of delay and abortHttpStatus must be specified for http fault")
+	}
+	if f.AbortHTTPStatus != 0 && (f.AbortHTTPStatus < 200 || f.AbortHTTPStatus > 599) {
+		return fmt.Errorf("abortHttpStatus of http fault must be a valid HTTP status code: %d", f.AbortHTTPStatus)
+	}
+	return nil
+}
+
 type KubernetesResourceRoute struct {
 	Provider KubernetesProviderMatcher       `json:"provider"`
 	Match    *KubernetesResourceRouteMatcher `json:"match"`
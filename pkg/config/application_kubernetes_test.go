@@ -62,12 +62,19 @@ func TestKubernetesApplicationConfig(t *testing.T) {
 									Canary: Percentage{
 										Number: 100,
 									},
+									TrafficRoutingSteps: TrafficRoutingSteps{
+										StepInterval: Duration(5 * time.Minute),
+									},
 								},
 								With: json.RawMessage(`{"canary":100}`),
 							},
 							{
-								Name:                          model.StageK8sPrimaryRollout,
-								K8sPrimaryRolloutStageOptions: &K8sPrimaryRolloutStageOptions{},
+								Name: model.StageK8sPrimaryRollout,
+								K8sPrimaryRolloutStageOptions: &K8sPrimaryRolloutStageOptions{
+									PodDisruptionBudget: K8sPodDisruptionBudgetCheck{
+										Timeout: Duration(10 * time.Minute),
+									},
+								},
 							},
 							{
 								Name: model.StageK8sTrafficRouting,
@@ -75,6 +82,9 @@ func TestKubernetesApplicationConfig(t *testing.T) {
 									Primary: Percentage{
 										Number: 100,
 									},
+									TrafficRoutingSteps: TrafficRoutingSteps{
+										StepInterval: Duration(5 * time.Minute),
+									},
 								},
 								With: json.RawMessage(`{"primary":100}`),
 							},
@@ -193,3 +203,641 @@ func TestKubernetesApplicationConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestInputHelmExternalValueFileValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		file    InputHelmExternalValueFile
+		wantErr bool
+	}{
+		{
+			name: "git",
+			file: InputHelmExternalValueFile{
+				Git: &InputHelmGitValueFile{GitRemote: "git@github.com:org/config.git", Path: "values.yaml"},
+			},
+		},
+		{
+			name: "git without path",
+			file: InputHelmExternalValueFile{
+				Git: &InputHelmGitValueFile{GitRemote: "git@github.com:org/config.git"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "s3",
+			file: InputHelmExternalValueFile{S3: "s3://bucket/values.yaml"},
+		},
+		{
+			name:    "malformed s3 uri",
+			file:    InputHelmExternalValueFile{S3: "bucket/values.yaml"},
+			wantErr: true,
+		},
+		{
+			name:    "no source",
+			file:    InputHelmExternalValueFile{Region: "us-west-2"},
+			wantErr: true,
+		},
+		{
+			name: "multiple sources",
+			file: InputHelmExternalValueFile{
+				SSMParameter:         "/foo/values",
+				SecretsManagerSecret: "foo-values",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.file.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestInputHelmPostRendererValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		renderer InputHelmPostRenderer
+		wantErr  bool
+	}{
+		{
+			name:     "valid",
+			renderer: InputHelmPostRenderer{KustomizeDir: "overlays/prod", RenderedFile: "helm-output.yaml"},
+		},
+		{
+			name:     "missing kustomizeDir",
+			renderer: InputHelmPostRenderer{RenderedFile: "helm-output.yaml"},
+			wantErr:  true,
+		},
+		{
+			name:     "kustomizeDir outside the application directory",
+			renderer: InputHelmPostRenderer{KustomizeDir: "../overlays", RenderedFile: "helm-output.yaml"},
+			wantErr:  true,
+		},
+		{
+			name:     "absolute kustomizeDir",
+			renderer: InputHelmPostRenderer{KustomizeDir: "/overlays", RenderedFile: "helm-output.yaml"},
+			wantErr:  true,
+		},
+		{
+			name:     "renderedFile with directory",
+			renderer: InputHelmPostRenderer{KustomizeDir: "overlays", RenderedFile: "base/helm-output.yaml"},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.renderer.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestKubernetesApplicationSpecValidateManagedNamespace(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		input   KubernetesDeploymentInput
+		wantErr bool
+	}{
+		{
+			name:  "no managed namespace",
+			input: KubernetesDeploymentInput{},
+		},
+		{
+			name: "managed namespace with namespace",
+			input: KubernetesDeploymentInput{
+				Namespace: "demo",
+				ManagedNamespace: &KubernetesManagedNamespace{
+					Labels: map[string]string{"istio-injection": "enabled"},
+				},
+			},
+		},
+		{
+			name: "managed namespace without namespace",
+			input: KubernetesDeploymentInput{
+				ManagedNamespace: &KubernetesManagedNamespace{
+					DeleteOnAppDeletion: true,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			s := &KubernetesApplicationSpec{Input: tc.input}
+			err := s.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestKubernetesApplicationSpecValidatePromotion(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		promotion *KubernetesPromotion
+		wantErr   bool
+	}{
+		{
+			name: "no promotion",
+		},
+		{
+			name: "valid promotion",
+			promotion: &KubernetesPromotion{
+				From:          "demo-staging",
+				RequireDigest: true,
+			},
+		},
+		{
+			name: "missing source application",
+			promotion: &KubernetesPromotion{
+				VerifyManifests: true,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			s := &KubernetesApplicationSpec{Promotion: tc.promotion}
+			err := s.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestKubernetesApplicationSpecValidateImpersonation(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name          string
+		impersonation *KubernetesImpersonation
+		wantUserName  string
+		wantErr       bool
+	}{
+		{
+			name: "no impersonation",
+		},
+		{
+			name: "service account",
+			impersonation: &KubernetesImpersonation{
+				ServiceAccount: &KubernetesImpersonationServiceAccount{
+					Namespace: "team-a",
+					Name:      "deployer",
+				},
+			},
+			wantUserName: "system:serviceaccount:team-a:deployer",
+		},
+		{
+			name: "user with groups",
+			impersonation: &KubernetesImpersonation{
+				User:   "team-a-deployer",
+				Groups: []string{"team-a"},
+			},
+			wantUserName: "team-a-deployer",
+		},
+		{
+			name:          "neither service account nor user",
+			impersonation: &KubernetesImpersonation{Groups: []string{"team-a"}},
+			wantErr:       true,
+		},
+		{
+			name: "both service account and user",
+			impersonation: &KubernetesImpersonation{
+				ServiceAccount: &KubernetesImpersonationServiceAccount{
+					Namespace: "team-a",
+					Name:      "deployer",
+				},
+				User: "team-a-deployer",
+			},
+			wantErr: true,
+		},
+		{
+			name: "service account without namespace",
+			impersonation: &KubernetesImpersonation{
+				ServiceAccount: &KubernetesImpersonationServiceAccount{
+					Name: "deployer",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			s := &KubernetesApplicationSpec{Input: KubernetesDeploymentInput{Impersonation: tc.impersonation}}
+			err := s.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+			if tc.impersonation != nil && !tc.wantErr {
+				assert.Equal(t, tc.wantUserName, tc.impersonation.UserName())
+			}
+		})
+	}
+}
+
+func TestKubernetesApplicationSpecValidateMultiCluster(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name           string
+		multiCluster   *KubernetesMultiCluster
+		resourceRoutes []KubernetesResourceRoute
+		wantErr        bool
+	}{
+		{
+			name: "no multi-cluster",
+		},
+		{
+			name: "valid targets",
+			multiCluster: &KubernetesMultiCluster{
+				Targets: []KubernetesClusterTarget{
+					{Provider: "cluster-1"},
+					{Provider: "cluster-2", Order: 1},
+				},
+			},
+		},
+		{
+			name:         "no target",
+			multiCluster: &KubernetesMultiCluster{},
+			wantErr:      true,
+		},
+		{
+			name: "missing provider",
+			multiCluster: &KubernetesMultiCluster{
+				Targets: []KubernetesClusterTarget{{Order: 1}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicated provider",
+			multiCluster: &KubernetesMultiCluster{
+				Targets: []KubernetesClusterTarget{
+					{Provider: "cluster-1"},
+					{Provider: "cluster-1", Order: 1},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative order",
+			multiCluster: &KubernetesMultiCluster{
+				Targets: []KubernetesClusterTarget{{Provider: "cluster-1", Order: -1}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "used with resource routes",
+			multiCluster: &KubernetesMultiCluster{
+				Targets: []KubernetesClusterTarget{{Provider: "cluster-1"}},
+			},
+			resourceRoutes: []KubernetesResourceRoute{{Provider: KubernetesProviderMatcher{Name: "cluster-1"}}},
+			wantErr:        true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			s := &KubernetesApplicationSpec{MultiCluster: tc.multiCluster, ResourceRoutes: tc.resourceRoutes}
+			err := s.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestKubernetesApplicationSpecValidateNginxTrafficRouting(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		nginx   *NginxTrafficRouting
+		wantErr bool
+	}{
+		{
+			name: "no nginx config",
+		},
+		{
+			name: "valid config",
+			nginx: &NginxTrafficRouting{
+				Ingress:             K8sResourceReference{Kind: "Ingress", Name: "helloworld"},
+				CanaryByHeader:      "X-Canary",
+				CanaryByHeaderValue: "enabled",
+				CanaryByCookie:      "canary",
+			},
+		},
+		{
+			name: "wrong ingress kind",
+			nginx: &NginxTrafficRouting{
+				Ingress: K8sResourceReference{Kind: "Service", Name: "helloworld"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "header value without header",
+			nginx: &NginxTrafficRouting{
+				CanaryByHeaderValue: "enabled",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			s := &KubernetesApplicationSpec{
+				TrafficRouting: &KubernetesTrafficRouting{
+					Method: KubernetesTrafficRoutingMethodNginx,
+					Nginx:  tc.nginx,
+				},
+			}
+			err := s.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestKubernetesApplicationSpecValidateWeightedBackendsTrafficRouting(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name           string
+		trafficRouting *KubernetesTrafficRouting
+		wantErr        bool
+	}{
+		{
+			name: "valid gatewayAPI config",
+			trafficRouting: &KubernetesTrafficRouting{
+				Method:     KubernetesTrafficRoutingMethodGatewayAPI,
+				GatewayAPI: &GatewayAPITrafficRouting{HTTPRoute: K8sResourceReference{Kind: "HTTPRoute", Name: "helloworld"}},
+			},
+		},
+		{
+			name: "wrong HTTPRoute kind",
+			trafficRouting: &KubernetesTrafficRouting{
+				Method:     KubernetesTrafficRoutingMethodGatewayAPI,
+				GatewayAPI: &GatewayAPITrafficRouting{HTTPRoute: K8sResourceReference{Kind: "GRPCRoute", Name: "helloworld"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid smi config",
+			trafficRouting: &KubernetesTrafficRouting{
+				Method: KubernetesTrafficRoutingMethodSMI,
+				SMI:    &SMITrafficRouting{TrafficSplit: K8sResourceReference{Name: "helloworld"}},
+			},
+		},
+		{
+			name: "wrong TrafficSplit kind",
+			trafficRouting: &KubernetesTrafficRouting{
+				Method: KubernetesTrafficRoutingMethodSMI,
+				SMI:    &SMITrafficRouting{TrafficSplit: K8sResourceReference{Kind: "Service", Name: "helloworld"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			s := &KubernetesApplicationSpec{TrafficRouting: tc.trafficRouting}
+			err := s.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestKubernetesMultiClusterWaves(t *testing.T) {
+	t.Parallel()
+
+	m := &KubernetesMultiCluster{
+		Targets: []KubernetesClusterTarget{
+			{Provider: "cluster-3", Order: 2},
+			{Provider: "cluster-1"},
+			{Provider: "cluster-4", Order: 2},
+			{Provider: "cluster-2", Order: 1},
+		},
+	}
+	want := [][]KubernetesClusterTarget{
+		{{Provider: "cluster-1"}},
+		{{Provider: "cluster-2", Order: 1}},
+		{{Provider: "cluster-3", Order: 2}, {Provider: "cluster-4", Order: 2}},
+	}
+	assert.Equal(t, want, m.Waves())
+}
+
+func TestK8sPrimaryRolloutStageOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		opts    K8sPrimaryRolloutStageOptions
+		wantErr bool
+	}{
+		{
+			name: "no override",
+		},
+		{
+			name: "valid override",
+			opts: K8sPrimaryRolloutStageOptions{
+				MaxSurge:       &Replicas{Number: 25, IsPercentage: true},
+				MaxUnavailable: &Replicas{Number: 0},
+			},
+		},
+		{
+			name: "both zero",
+			opts: K8sPrimaryRolloutStageOptions{
+				MaxSurge:       &Replicas{Number: 0},
+				MaxUnavailable: &Replicas{Number: 0, IsPercentage: true},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative value",
+			opts: K8sPrimaryRolloutStageOptions{
+				MaxUnavailable: &Replicas{Number: -1},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative timeout of pod disruption budget check",
+			opts: K8sPrimaryRolloutStageOptions{
+				PodDisruptionBudget: K8sPodDisruptionBudgetCheck{
+					Enabled: true,
+					Timeout: Duration(-1),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.opts.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestK8sTrafficRoutingStageOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		opts    K8sTrafficRoutingStageOptions
+		wantErr bool
+	}{
+		{
+			name: "percentage only",
+			opts: K8sTrafficRoutingStageOptions{Canary: Percentage{Number: 20}},
+		},
+		{
+			name: "valid steps",
+			opts: K8sTrafficRoutingStageOptions{
+				TrafficRoutingSteps: TrafficRoutingSteps{
+					Steps: []TrafficRoutingStep{
+						{Canary: Percentage{Number: 10}, Analysis: &AnalysisStageOptions{Duration: Duration(10 * time.Minute)}},
+						{Canary: Percentage{Number: 100}},
+					},
+				},
+			},
+		},
+		{
+			name: "steps with abTesting",
+			opts: K8sTrafficRoutingStageOptions{
+				ABTesting: &ABTestingRouting{Rules: []ABTestingRule{{Header: "X-Canary", Value: "1"}}},
+				TrafficRoutingSteps: TrafficRoutingSteps{
+					Steps: []TrafficRoutingStep{{Canary: Percentage{Number: 10}}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid steps",
+			opts: K8sTrafficRoutingStageOptions{
+				TrafficRoutingSteps: TrafficRoutingSteps{
+					Steps: []TrafficRoutingStep{{Canary: Percentage{Number: 10}, Analysis: &AnalysisStageOptions{}}},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.opts.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestK8sWaitRolloutStageOptions(t *testing.T) {
+	t.Parallel()
+
+	const data = `
+apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  pipeline:
+    stages:
+      - name: K8S_PRIMARY_ROLLOUT
+      - name: K8S_WAIT_ROLLOUT
+        with:
+          statefulSetTimeout: 1h
+`
+	cfg, err := DecodeYAML([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, cfg.Validate())
+
+	opts := cfg.KubernetesApplicationSpec.Pipeline.Stages[1].K8sWaitRolloutStageOptions
+	require.NotNil(t, opts)
+	assert.Equal(t, 10*time.Minute, opts.Timeout("Deployment"))
+	assert.Equal(t, time.Hour, opts.Timeout("StatefulSet"))
+	assert.Equal(t, 15*time.Minute, opts.Timeout("DaemonSet"))
+}
+
+func TestK8sFaultInjectionStageOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	analysis := &AnalysisStageOptions{Duration: Duration(10 * time.Minute)}
+	testcases := []struct {
+		name    string
+		opts    K8sFaultInjectionStageOptions
+		wantErr bool
+	}{
+		{
+			name: "pod kill",
+			opts: K8sFaultInjectionStageOptions{
+				PodKill:  &K8sPodKillFault{Percentage: Percentage{Number: 50}},
+				Analysis: analysis,
+			},
+		},
+		{
+			name: "http delay and abort",
+			opts: K8sFaultInjectionStageOptions{
+				HTTP:     &K8sHTTPFault{Percentage: Percentage{Number: 10}, Delay: Duration(time.Second), AbortHTTPStatus: 503},
+				Analysis: analysis,
+			},
+		},
+		{
+			name:    "no fault",
+			opts:    K8sFaultInjectionStageOptions{Analysis: analysis},
+			wantErr: true,
+		},
+		{
+			name: "no analysis",
+			opts: K8sFaultInjectionStageOptions{
+				PodKill: &K8sPodKillFault{Percentage: Percentage{Number: 50}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero pod kill percentage",
+			opts: K8sFaultInjectionStageOptions{
+				PodKill:  &K8sPodKillFault{},
+				Analysis: analysis,
+			},
+			wantErr: true,
+		},
+		{
+			name: "http fault without delay and abort",
+			opts: K8sFaultInjectionStageOptions{
+				HTTP:     &K8sHTTPFault{Percentage: Percentage{Number: 10}},
+				Analysis: analysis,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid abort status",
+			opts: K8sFaultInjectionStageOptions{
+				HTTP:     &K8sHTTPFault{Percentage: Percentage{Number: 10}, AbortHTTPStatus: 1000},
+				Analysis: analysis,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.opts.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
@@ -14,6 +14,8 @@
 
 package config
 
+import "fmt"
+
 // LambdaApplicationSpec represents an application configuration for Lambda application.
 type LambdaApplicationSpec struct {
 	GenericApplicationSpec
@@ -28,6 +30,9 @@ func (s *LambdaApplicationSpec) Validate() error {
 	if err := s.GenericApplicationSpec.Validate(); err != nil {
 		return err
 	}
+	if r := s.Input.VersionRetention; r != nil && r.Keep <= 0 {
+		return fmt.Errorf("versionRetention.keep must be greater than 0")
+	}
 	return nil
 }
 
@@ -40,6 +45,17 @@ type LambdaDeploymentInput struct {
 	//
 	// Deprecated: Use Planner.AutoRollback instead.
 	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
+	// Configuration for pruning the old versions of the function
+	// after the new version received all traffic.
+	// Empty means no version is pruned.
+	VersionRetention *LambdaVersionRetention `json:"versionRetention,omitempty"`
+}
+
+// LambdaVersionRetention represents how many published versions of the function are kept.
+type LambdaVersionRetention struct {
+	// The number of the latest published versions to keep.
+	// The versions referenced by any alias are always kept in addition to them.
+	Keep int `json:"keep"`
 }
 
 // LambdaSyncStageOptions contains all configurable values for a LAMBDA_SYNC stage.
@@ -14,6 +14,13 @@
 
 package config
 
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+)
+
 // TerraformApplicationSpec represents an application configuration for Terraform application.
 type TerraformApplicationSpec struct {
 	GenericApplicationSpec
@@ -28,12 +35,84 @@ func (s *TerraformApplicationSpec) Validate() error {
 	if err := s.GenericApplicationSpec.Validate(); err != nil {
 		return err
 	}
+	if err := s.QuickSync.Validate(); err != nil {
+		return fmt.Errorf("invalid quickSync: %w", err)
+	}
+	names := make(map[string]struct{}, len(s.Input.ExternalVars))
+	for _, v := range s.Input.ExternalVars {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+		if _, ok := names[v.Name]; ok {
+			return fmt.Errorf("external variable %q is specified more than once", v.Name)
+		}
+		names[v.Name] = struct{}{}
+	}
+	if s.Input.OpenTofu != nil && s.Input.TerraformVersion != "" {
+		return errors.New("terraformVersion can not be used with openTofu, use openTofu.version instead")
+	}
+	if s.Input.OpenTofu != nil {
+		if err := validateToolVersion(s.Input.OpenTofu.Version); err != nil {
+			return fmt.Errorf("invalid openTofu.version: %w", err)
+		}
+	}
+	if tg := s.Input.Terragrunt; tg != nil {
+		if err := validateToolVersion(tg.Version); err != nil {
+			return fmt.Errorf("invalid terragrunt.version: %w", err)
+		}
+		if tg.RunAll {
+			if err := s.validateTerragruntRunAll(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateToolVersion returns an error if the given version is neither
+// an exact semantic version such as "1.8.5" nor a version constraint such as "~> 1.8".
+func validateToolVersion(version string) error {
+	if version == "" {
+		return nil
+	}
+	if _, err := semver.StrictNewVersion(version); err == nil {
+		return nil
+	}
+	if _, err := semver.NewConstraint(version); err != nil {
+		return fmt.Errorf("%q is neither a version nor a version constraint", version)
+	}
+	return nil
+}
+
+// validateTerragruntRunAll returns an error for the options requiring the single plan and state
+// which are not available when all modules are run by terragrunt run-all.
+func (s *TerraformApplicationSpec) validateTerragruntRunAll() error {
+	if s.Input.Workspace != "" {
+		return errors.New("workspace can not be used with terragrunt runAll")
+	}
+	applyOptions := []*TerraformApplyStageOptions{&s.QuickSync}
+	if s.Pipeline != nil {
+		for _, stage := range s.Pipeline.Stages {
+			if stage.TerraformApplyStageOptions != nil {
+				applyOptions = append(applyOptions, stage.TerraformApplyStageOptions)
+			}
+		}
+	}
+	for _, o := range applyOptions {
+		if len(o.PreventDestroy) > 0 {
+			return errors.New("preventDestroy can not be used with terragrunt runAll")
+		}
+		if len(o.CaptureOutputs) > 0 {
+			return errors.New("captureOutputs can not be used with terragrunt runAll")
+		}
+	}
 	return nil
 }
 
 type TerraformDeploymentInput struct {
 	// The terraform workspace name.
 	// Empty means "default" workpsace.
+	// The workspace is created by the deployment when it does not exist yet.
 	Workspace string `json:"workspace,omitempty"`
 	// The version of terraform should be used.
 	// Empty means the pre-installed version will be used.
@@ -46,6 +125,16 @@ type TerraformDeploymentInput struct {
 	Vars []string `json:"vars,omitempty"`
 	// List of variable files that will be set on terraform commands with "-var-file" flag.
 	VarFiles []string `json:"varFiles,omitempty"`
+	// List of variables whose values are fetched from AWS services at deploy time
+	// and set on terraform commands with "-var" flag.
+	// The fetched values are masked in the command logs.
+	ExternalVars []TerraformExternalVar `json:"externalVars,omitempty"`
+	// Configuration to run the terraform commands through terragrunt.
+	// Empty means terraform is run directly.
+	Terragrunt *TerraformTerragrunt `json:"terragrunt,omitempty"`
+	// Configuration to run the terraform commands by OpenTofu instead of terraform.
+	// Empty means terraform is used.
+	OpenTofu *TerraformOpenTofu `json:"openTofu,omitempty"`
 	// Automatically reverts all changes from all stages when one of them failed.
 	// Default is false.
 	//
@@ -57,6 +146,58 @@ type TerraformDeploymentInput struct {
 	CommandEnvs TerraformCommandEnvs `json:"commandEnvs"`
 }
 
+// TerraformTerragrunt represents the configuration to run the terraform commands through terragrunt.
+type TerraformTerragrunt struct {
+	// The version of terragrunt should be used.
+	// It can be an exact version such as "0.67.16" or a version constraint such as "~> 0.67",
+	// which is resolved to the latest release satisfying it.
+	// Empty means the pre-installed version will be used.
+	Version string `json:"version,omitempty"`
+	// Whether to run the commands against all modules under the application directory by "terragrunt run-all".
+	// The workspace, preventDestroy and captureOutputs can not be used then.
+	// Default is false.
+	RunAll bool `json:"runAll,omitempty"`
+}
+
+// TerraformOpenTofu represents the configuration to run the terraform commands by OpenTofu.
+type TerraformOpenTofu struct {
+	// The version of OpenTofu should be used.
+	// It can be an exact version such as "1.8.5" or a version constraint such as "~> 1.8",
+	// which is resolved to the latest release satisfying it.
+	// Empty means the pre-installed version will be used.
+	Version string `json:"version,omitempty"`
+}
+
+// TerraformExternalVar represents a terraform variable whose value is stored in an external source.
+// Exactly one of ssmParameter and secretsManagerSecret must be specified.
+type TerraformExternalVar struct {
+	// The name of the terraform variable.
+	Name string `json:"name"`
+	// The name of the AWS Systems Manager Parameter Store parameter.
+	SSMParameter string `json:"ssmParameter,omitempty"`
+	// The name or ARN of the AWS Secrets Manager secret.
+	SecretsManagerSecret string `json:"secretsManagerSecret,omitempty"`
+	// The key of the value to use when the secret is a JSON object.
+	// Empty means the whole secret string is used.
+	SecretKey string `json:"secretKey,omitempty"`
+	// The AWS region used to fetch the value.
+	// Empty means the default region of the environment.
+	Region string `json:"region,omitempty"`
+}
+
+func (v *TerraformExternalVar) Validate() error {
+	if v.Name == "" {
+		return errors.New("name of external variable is required")
+	}
+	if (v.SSMParameter == "") == (v.SecretsManagerSecret == "") {
+		return fmt.Errorf("exactly one of ssmParameter and secretsManagerSecret must be specified for external variable %q", v.Name)
+	}
+	if v.SecretKey != "" && v.SecretsManagerSecret == "" {
+		return fmt.Errorf("secretKey of external variable %q can only be used with secretsManagerSecret", v.Name)
+	}
+	return nil
+}
+
 // TerraformSyncStageOptions contains all configurable values for a TERRAFORM_SYNC stage.
 type TerraformSyncStageOptions struct {
 	// How many times to retry applying terraform changes.
@@ -73,6 +214,41 @@ type TerraformPlanStageOptions struct {
 type TerraformApplyStageOptions struct {
 	// How many times to retry applying terraform changes.
 	Retries int `json:"retries"`
+	// List of resource address patterns to protect from being destroyed or replaced, e.g. "aws_db_instance.*".
+	// "*" matches any sequence of characters.
+	// The stage fails without applying anything when the plan destroys or replaces any matching resource.
+	PreventDestroy []string `json:"preventDestroy,omitempty"`
+	// List of terraform outputs to capture into the deployment metadata after applying.
+	CaptureOutputs []TerraformOutputCapture `json:"captureOutputs,omitempty"`
+}
+
+func (o *TerraformApplyStageOptions) Validate() error {
+	names := make(map[string]struct{}, len(o.CaptureOutputs))
+	for _, c := range o.CaptureOutputs {
+		if c.Name == "" {
+			return errors.New("name of captureOutputs is required")
+		}
+		if _, ok := names[c.Name]; ok {
+			return fmt.Errorf("output %q is captured more than once", c.Name)
+		}
+		names[c.Name] = struct{}{}
+		if c.EventName == "" && len(c.EventLabels) > 0 {
+			return fmt.Errorf("eventLabels of output %q can not be used without eventName", c.Name)
+		}
+	}
+	return nil
+}
+
+// TerraformOutputCapture represents a terraform output captured after applying.
+type TerraformOutputCapture struct {
+	// The name of the terraform output.
+	Name string `json:"name"`
+	// The name of the event to register with the output value as its data,
+	// so that the applications watching the event by EventWatcher are updated with the value.
+	// Empty means no event is registered.
+	EventName string `json:"eventName,omitempty"`
+	// The labels of the event to register.
+	EventLabels map[string]string `json:"eventLabels,omitempty"`
 }
 
 // TerraformCommandFlags contains all additional flags will be used while executing terraform commands.
@@ -261,3 +261,210 @@ func TestTerraformApplicationtConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestTerraformApplyStageOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		opts    TerraformApplyStageOptions
+		wantErr bool
+	}{
+		{
+			name: "no output",
+		},
+		{
+			name: "valid outputs",
+			opts: TerraformApplyStageOptions{
+				CaptureOutputs: []TerraformOutputCapture{
+					{Name: "endpoint", EventName: "db-endpoint", EventLabels: map[string]string{"env": "prod"}},
+					{Name: "port"},
+				},
+			},
+		},
+		{
+			name: "missing name",
+			opts: TerraformApplyStageOptions{
+				CaptureOutputs: []TerraformOutputCapture{{EventName: "db-endpoint"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicated name",
+			opts: TerraformApplyStageOptions{
+				CaptureOutputs: []TerraformOutputCapture{{Name: "endpoint"}, {Name: "endpoint"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "event labels without event name",
+			opts: TerraformApplyStageOptions{
+				CaptureOutputs: []TerraformOutputCapture{{Name: "endpoint", EventLabels: map[string]string{"env": "prod"}}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.opts.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestTerraformExternalVarValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		v       TerraformExternalVar
+		wantErr bool
+	}{
+		{
+			name: "ssm parameter",
+			v:    TerraformExternalVar{Name: "db_password", SSMParameter: "/app/db-password", Region: "ap-northeast-1"},
+		},
+		{
+			name: "secrets manager secret with key",
+			v:    TerraformExternalVar{Name: "db_password", SecretsManagerSecret: "app/db", SecretKey: "password"},
+		},
+		{
+			name:    "missing name",
+			v:       TerraformExternalVar{SSMParameter: "/app/db-password"},
+			wantErr: true,
+		},
+		{
+			name:    "no source",
+			v:       TerraformExternalVar{Name: "db_password"},
+			wantErr: true,
+		},
+		{
+			name:    "multiple sources",
+			v:       TerraformExternalVar{Name: "db_password", SSMParameter: "/app/db-password", SecretsManagerSecret: "app/db"},
+			wantErr: true,
+		},
+		{
+			name:    "secret key without secrets manager secret",
+			v:       TerraformExternalVar{Name: "db_password", SSMParameter: "/app/db-password", SecretKey: "password"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.v.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestTerraformApplicationSpecValidateTerragruntRunAll(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		spec    TerraformApplicationSpec
+		wantErr bool
+	}{
+		{
+			name: "run all",
+			spec: TerraformApplicationSpec{
+				Input: TerraformDeploymentInput{Terragrunt: &TerraformTerragrunt{RunAll: true}},
+			},
+		},
+		{
+			name: "workspace without run all",
+			spec: TerraformApplicationSpec{
+				Input: TerraformDeploymentInput{Workspace: "prod", Terragrunt: &TerraformTerragrunt{}},
+			},
+		},
+		{
+			name: "workspace with run all",
+			spec: TerraformApplicationSpec{
+				Input: TerraformDeploymentInput{Workspace: "prod", Terragrunt: &TerraformTerragrunt{RunAll: true}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "preventDestroy of quick sync with run all",
+			spec: TerraformApplicationSpec{
+				Input:     TerraformDeploymentInput{Terragrunt: &TerraformTerragrunt{RunAll: true}},
+				QuickSync: TerraformApplyStageOptions{PreventDestroy: []string{"aws_db_instance.*"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "captureOutputs of apply stage with run all",
+			spec: TerraformApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name: model.StageTerraformApply,
+								TerraformApplyStageOptions: &TerraformApplyStageOptions{
+									CaptureOutputs: []TerraformOutputCapture{{Name: "endpoint"}},
+								},
+							},
+						},
+					},
+				},
+				Input: TerraformDeploymentInput{Terragrunt: &TerraformTerragrunt{RunAll: true}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.spec.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestTerraformApplicationSpecValidateOpenTofu(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		input   TerraformDeploymentInput
+		wantErr bool
+	}{
+		{
+			name:  "opentofu with version",
+			input: TerraformDeploymentInput{OpenTofu: &TerraformOpenTofu{Version: "1.8.5"}},
+		},
+		{
+			name:  "opentofu through terragrunt",
+			input: TerraformDeploymentInput{OpenTofu: &TerraformOpenTofu{}, Terragrunt: &TerraformTerragrunt{}},
+		},
+		{
+			name:    "opentofu with terraformVersion",
+			input:   TerraformDeploymentInput{TerraformVersion: "1.5.7", OpenTofu: &TerraformOpenTofu{}},
+			wantErr: true,
+		},
+		{
+			name:  "version constraints",
+			input: TerraformDeploymentInput{OpenTofu: &TerraformOpenTofu{Version: "~> 1.8"}, Terragrunt: &TerraformTerragrunt{Version: ">= 0.67, < 0.70"}},
+		},
+		{
+			name:    "invalid opentofu version",
+			input:   TerraformDeploymentInput{OpenTofu: &TerraformOpenTofu{Version: "1.8.5; rm -rf /"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid terragrunt version",
+			input:   TerraformDeploymentInput{Terragrunt: &TerraformTerragrunt{Version: "$(id)"}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			spec := TerraformApplicationSpec{Input: tc.input}
+			err := spec.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
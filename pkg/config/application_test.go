@@ -104,6 +104,40 @@ func TestValidateWaitApprovalStageOptions(t *testing.T) {
 	}
 }
 
+func TestValidatePipelineTimeout(t *testing.T) {
+	testcases := []struct {
+		name    string
+		timeout Duration
+		wantErr bool
+	}{
+		{
+			name:    "not specified",
+			wantErr: false,
+		},
+		{
+			name:    "valid",
+			timeout: Duration(30 * time.Minute),
+			wantErr: false,
+		},
+		{
+			name:    "negative",
+			timeout: Duration(-time.Minute),
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &GenericApplicationSpec{
+				Pipeline: &DeploymentPipeline{
+					Timeout: tc.timeout,
+				},
+			}
+			err := s.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
 func TestFindSlackUsersAndGroups(t *testing.T) {
 	testcases := []struct {
 		name       string
@@ -227,7 +261,7 @@ func TestFindSlackUsersAndGroups(t *testing.T) {
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
 			n := &DeploymentNotification{
-				tc.mentions,
+				Mentions: tc.mentions,
 			}
 			as := n.FindSlackUsers(tc.event)
 			ag := n.FindSlackGroups(tc.event)
@@ -237,6 +271,28 @@ func TestFindSlackUsersAndGroups(t *testing.T) {
 	}
 }
 
+func TestDeploymentNotificationMentionCodeOwners(t *testing.T) {
+	n := &DeploymentNotification{
+		Mentions: []NotificationMention{
+			{Event: "DEPLOYMENT_TRIGGERED", SlackUsers: []string{"user-1"}},
+		},
+		CodeOwners: &NotificationCodeOwners{
+			Events: []string{"DEPLOYMENT_FAILED"},
+		},
+	}
+	n.MentionCodeOwners([]string{"user-2"}, []string{"group-1"})
+
+	assert.ElementsMatch(t, []string{"user-1"}, n.FindSlackUsers(model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED))
+	assert.Empty(t, n.FindSlackGroups(model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED))
+	assert.ElementsMatch(t, []string{"user-2"}, n.FindSlackUsers(model.NotificationEventType_EVENT_DEPLOYMENT_FAILED))
+	assert.ElementsMatch(t, []string{"group-1"}, n.FindSlackGroups(model.NotificationEventType_EVENT_DEPLOYMENT_FAILED))
+
+	// All events are mentioned when no event is specified.
+	n = &DeploymentNotification{CodeOwners: &NotificationCodeOwners{}}
+	n.MentionCodeOwners([]string{"user-2"}, nil)
+	assert.ElementsMatch(t, []string{"user-2"}, n.FindSlackUsers(model.NotificationEventType_EVENT_DEPLOYMENT_SUCCEEDED))
+}
+
 func TestValidateAnalysisTemplateRef(t *testing.T) {
 	testcases := []struct {
 		name    string
@@ -870,3 +926,180 @@ func TestScriptSycConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadTestStageConfiguration(t *testing.T) {
+	cfg, err := LoadFromYAML("testdata/application/generic-load-test.yaml")
+	require.NoError(t, err)
+
+	spec, ok := cfg.GetGenericApplication()
+	require.True(t, ok)
+	require.Len(t, spec.Pipeline.Stages, 3)
+
+	errorRate := 1.0
+	expected := &LoadTestStageOptions{
+		Tool:     LoadTestToolK6,
+		Script:   "loadtest/script.js",
+		Target:   "http://canary.example.svc:8080",
+		Duration: Duration(5 * time.Minute),
+		VUs:      20,
+		Rate:     10,
+		Thresholds: LoadTestThresholds{
+			P95Latency: Duration(500 * time.Millisecond),
+			ErrorRate:  &errorRate,
+		},
+		Timeout: Duration(30 * time.Minute),
+	}
+	assert.Equal(t, expected, spec.Pipeline.Stages[1].LoadTestStageOptions)
+}
+
+func TestValidateLoadTestStageOptions(t *testing.T) {
+	errorRate := func(v float64) *float64 { return &v }
+	testcases := []struct {
+		name    string
+		opts    LoadTestStageOptions
+		wantErr bool
+	}{
+		{
+			name: "valid k6",
+			opts: LoadTestStageOptions{
+				Tool:     LoadTestToolK6,
+				Script:   "script.js",
+				Duration: Duration(time.Minute),
+				VUs:      1,
+				Rate:     10,
+				Timeout:  Duration(30 * time.Minute),
+			},
+		},
+		{
+			name: "valid vegeta with target only",
+			opts: LoadTestStageOptions{
+				Tool:       LoadTestToolVegeta,
+				Target:     "http://canary.example.svc",
+				Duration:   Duration(time.Minute),
+				VUs:        1,
+				Rate:       10,
+				Thresholds: LoadTestThresholds{ErrorRate: errorRate(0)},
+				Timeout:    Duration(30 * time.Minute),
+			},
+		},
+		{
+			name: "unsupported tool",
+			opts: LoadTestStageOptions{
+				Tool:     "jmeter",
+				Script:   "plan.jmx",
+				Duration: Duration(time.Minute),
+				VUs:      1,
+				Rate:     10,
+				Timeout:  Duration(30 * time.Minute),
+			},
+			wantErr: true,
+		},
+		{
+			name: "k6 without script",
+			opts: LoadTestStageOptions{
+				Tool:     LoadTestToolK6,
+				Target:   "http://canary.example.svc",
+				Duration: Duration(time.Minute),
+				VUs:      1,
+				Rate:     10,
+				Timeout:  Duration(30 * time.Minute),
+			},
+			wantErr: true,
+		},
+		{
+			name: "timeout shorter than duration",
+			opts: LoadTestStageOptions{
+				Tool:     LoadTestToolK6,
+				Script:   "script.js",
+				Duration: Duration(time.Hour),
+				VUs:      1,
+				Rate:     10,
+				Timeout:  Duration(30 * time.Minute),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid error rate",
+			opts: LoadTestStageOptions{
+				Tool:       LoadTestToolK6,
+				Script:     "script.js",
+				Duration:   Duration(time.Minute),
+				VUs:        1,
+				Rate:       10,
+				Thresholds: LoadTestThresholds{ErrorRate: errorRate(120)},
+				Timeout:    Duration(30 * time.Minute),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestValidateDiffNormalizationRule(t *testing.T) {
+	testcases := []struct {
+		name    string
+		rule    DiffNormalizationRule
+		wantErr bool
+	}{
+		{
+			name: "valid default rule",
+			rule: DiffNormalizationRule{
+				Resource: "apps/v1:Deployment:*:*",
+				Path:     "spec.template.spec.containers.*.imagePullPolicy",
+				Default:  "IfNotPresent",
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid quantity rule without resource",
+			rule: DiffNormalizationRule{
+				Path:     "spec.resources.requests.*",
+				Quantity: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid resource",
+			rule: DiffNormalizationRule{
+				Resource:  "Deployment:*",
+				Path:      "spec.template.spec.volumes",
+				SortByKey: "name",
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing path",
+			rule: DiffNormalizationRule{
+				Quantity: true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "no normalization",
+			rule: DiffNormalizationRule{
+				Path: "spec.replicas",
+			},
+			wantErr: true,
+		},
+		{
+			name: "multiple normalizations",
+			rule: DiffNormalizationRule{
+				Path:      "spec.template.spec.volumes",
+				Quantity:  true,
+				SortByKey: "name",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rule.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
@@ -194,20 +194,53 @@ func (c *Config) Validate() error {
 
 // LoadFromYAML reads and decodes a yaml file to construct the Config.
 func LoadFromYAML(file string) (*Config, error) {
+	return LoadFromYAMLWithParameters(file, nil)
+}
+
+// LoadFromYAMLWithParameters is the same as LoadFromYAML
+// but the given values are used to render the deployment parameters instead of their defaults.
+func LoadFromYAMLWithParameters(file string, params map[string]string) (*Config, error) {
+	return LoadFromYAMLWithValues(file, RenderValues{Parameters: params})
+}
+
+// LoadFromYAMLWithValues is the same as LoadFromYAML
+// but the given values are used to render the references in application configuration.
+func LoadFromYAMLWithValues(file string, values RenderValues) (*Config, error) {
 	data, err := os.ReadFile(file)
 	if err != nil {
 		return nil, err
 	}
-	return DecodeYAML(data)
+	return DecodeYAMLWithValues(data, values)
 }
 
 // DecodeYAML unmarshals config YAML data to config struct.
+// The sealed values in piped configuration are decrypted while decoding.
+// The deployment parameters referred in application configuration are rendered with their defaults.
 // It also validates the configuration after decoding.
 func DecodeYAML(data []byte) (*Config, error) {
+	return DecodeYAMLWithParameters(data, nil)
+}
+
+// DecodeYAMLWithParameters is the same as DecodeYAML
+// but the given values are used to render the deployment parameters instead of their defaults.
+func DecodeYAMLWithParameters(data []byte, params map[string]string) (*Config, error) {
+	return DecodeYAMLWithValues(data, RenderValues{Parameters: params})
+}
+
+// DecodeYAMLWithValues is the same as DecodeYAML
+// but the given values are used to render the references in application configuration.
+func DecodeYAMLWithValues(data []byte, values RenderValues) (*Config, error) {
+	data, err := renderParameters(data, values)
+	if err != nil {
+		return nil, err
+	}
 	js, err := yaml.YAMLToJSON(data)
 	if err != nil {
 		return nil, err
 	}
+	if js, err = unsealPipedConfig(js); err != nil {
+		return nil, err
+	}
 	c := &Config{}
 	if err := json.Unmarshal(js, c); err != nil {
 		return nil, err
@@ -215,6 +248,9 @@ func DecodeYAML(data []byte) (*Config, error) {
 	if err := defaults.Set(c); err != nil {
 		return nil, err
 	}
+	if spec := c.genericApplicationSpec(); spec != nil {
+		spec.Env = mergeEnv(spec.Env, values.Env)
+	}
 	if err := c.Validate(); err != nil {
 		return nil, err
 	}
@@ -238,6 +274,24 @@ func (k Kind) ToApplicationKind() (model.ApplicationKind, bool) {
 	return model.ApplicationKind_KUBERNETES, false
 }
 
+// genericApplicationSpec returns the pointer to the generic part of the application spec.
+// Nil is returned when the config is not an application one.
+func (c *Config) genericApplicationSpec() *GenericApplicationSpec {
+	switch {
+	case c.Kind == KindKubernetesApp && c.KubernetesApplicationSpec != nil:
+		return &c.KubernetesApplicationSpec.GenericApplicationSpec
+	case c.Kind == KindTerraformApp && c.TerraformApplicationSpec != nil:
+		return &c.TerraformApplicationSpec.GenericApplicationSpec
+	case c.Kind == KindCloudRunApp && c.CloudRunApplicationSpec != nil:
+		return &c.CloudRunApplicationSpec.GenericApplicationSpec
+	case c.Kind == KindLambdaApp && c.LambdaApplicationSpec != nil:
+		return &c.LambdaApplicationSpec.GenericApplicationSpec
+	case c.Kind == KindECSApp && c.ECSApplicationSpec != nil:
+		return &c.ECSApplicationSpec.GenericApplicationSpec
+	}
+	return nil
+}
+
 func (c *Config) GetGenericApplication() (GenericApplicationSpec, bool) {
 	switch c.Kind {
 	case KindKubernetesApp:
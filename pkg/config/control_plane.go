@@ -16,7 +16,9 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/golang/protobuf/jsonpb"
@@ -44,9 +46,62 @@ type ControlPlaneSpec struct {
 	Projects []ControlPlaneProject `json:"projects"`
 	// List of shared SSO configurations that can be used by any projects.
 	SharedSSOConfigs []SharedSSOConfig `json:"sharedSSOConfigs"`
+	// The resource quotas of projects.
+	// Nothing is limited by default.
+	ProjectQuotas ControlPlaneProjectQuotas `json:"projectQuotas"`
+	// The rules to provision the mappings between SSO groups and RBAC roles of projects.
+	// They are added to the project when a user logs in to it via SSO.
+	SSOProvisioning ControlPlaneSSOProvisioning `json:"ssoProvisioning"`
+	// List of the external systems trusted to approve WAIT_APPROVAL stages
+	// on behalf of the approver identified by the token they signed.
+	ApprovalIssuers []ControlPlaneApprovalIssuer `json:"approvalIssuers"`
+	// The configuration of the artifacts uploaded while executing stages.
+	StageArtifacts ControlPlaneStageArtifacts `json:"stageArtifacts"`
+	// List of the integrations with GitLab, configured per project.
+	GitLabIntegrations []ControlPlaneGitLabIntegration `json:"gitlabIntegrations"`
+	// List of the webhooks notified when the administrative resources
+	// of a project such as applications, pipeds and API keys are changed.
+	ProjectWebhooks []ControlPlaneProjectWebhook `json:"projectWebhooks"`
+	// How to compute the risk scores of deployments
+	// shown while waiting for approval.
+	DeploymentRisk ControlPlaneDeploymentRisk `json:"deploymentRisk"`
 }
 
 func (s *ControlPlaneSpec) Validate() error {
+	if err := s.ProjectQuotas.Validate(); err != nil {
+		return fmt.Errorf("invalid projectQuotas: %w", err)
+	}
+	if err := s.SSOProvisioning.Validate(); err != nil {
+		return fmt.Errorf("invalid ssoProvisioning: %w", err)
+	}
+	issuers := make(map[string]struct{}, len(s.ApprovalIssuers))
+	for i, iss := range s.ApprovalIssuers {
+		if err := iss.Validate(); err != nil {
+			return fmt.Errorf("invalid approvalIssuers[%d]: %w", i, err)
+		}
+		if _, ok := issuers[iss.Issuer]; ok {
+			return fmt.Errorf("invalid approvalIssuers[%d]: issuer %s is configured more than once", i, iss.Issuer)
+		}
+		issuers[iss.Issuer] = struct{}{}
+	}
+	projects := make(map[string]struct{}, len(s.GitLabIntegrations))
+	for i, g := range s.GitLabIntegrations {
+		if err := g.Validate(); err != nil {
+			return fmt.Errorf("invalid gitlabIntegrations[%d]: %w", i, err)
+		}
+		if _, ok := projects[g.ProjectID]; ok {
+			return fmt.Errorf("invalid gitlabIntegrations[%d]: project %s is configured more than once", i, g.ProjectID)
+		}
+		projects[g.ProjectID] = struct{}{}
+	}
+	for i, w := range s.ProjectWebhooks {
+		if err := w.Validate(); err != nil {
+			return fmt.Errorf("invalid projectWebhooks[%d]: %w", i, err)
+		}
+	}
+	if err := s.DeploymentRisk.Validate(); err != nil {
+		return fmt.Errorf("invalid deploymentRisk: %w", err)
+	}
 	return nil
 }
 
@@ -59,6 +114,382 @@ type ControlPlaneProject struct {
 	StaticAdmin ProjectStaticUser `json:"staticAdmin"`
 }
 
+type ControlPlaneProjectQuotas struct {
+	// The quota applied to all projects.
+	Default ProjectQuota `json:"default"`
+	// List of quotas for specific projects.
+	// Their non-zero fields override the ones of the default quota.
+	Projects []ProjectQuotaOverride `json:"projects"`
+}
+
+// ProjectQuota represents the limits of resources a project can use.
+// Zero means unlimited.
+type ProjectQuota struct {
+	// The maximum number of applications.
+	MaxApplications int `json:"maxApplications"`
+	// The maximum number of pipeds.
+	MaxPipeds int `json:"maxPipeds"`
+	// The maximum number of deployments created in the last 24 hours.
+	MaxDeploymentsPerDay int `json:"maxDeploymentsPerDay"`
+	// The maximum size of the stage logs stored in the filestore, e.g. "10Gi".
+	MaxFilestoreBytes string `json:"maxFilestoreBytes"`
+}
+
+type ProjectQuotaOverride struct {
+	// The ID of the project.
+	ProjectID string `json:"projectId"`
+	ProjectQuota
+}
+
+func (q *ControlPlaneProjectQuotas) Validate() error {
+	if err := q.Default.Validate(); err != nil {
+		return fmt.Errorf("default: %w", err)
+	}
+	for _, p := range q.Projects {
+		if p.ProjectID == "" {
+			return errors.New("projectId must be set")
+		}
+		if err := p.ProjectQuota.Validate(); err != nil {
+			return fmt.Errorf("project %s: %w", p.ProjectID, err)
+		}
+	}
+	return nil
+}
+
+// Find returns the quota of the given project.
+func (q *ControlPlaneProjectQuotas) Find(projectID string) ProjectQuota {
+	quota := q.Default
+	for _, p := range q.Projects {
+		if p.ProjectID != projectID {
+			continue
+		}
+		if p.MaxApplications != 0 {
+			quota.MaxApplications = p.MaxApplications
+		}
+		if p.MaxPipeds != 0 {
+			quota.MaxPipeds = p.MaxPipeds
+		}
+		if p.MaxDeploymentsPerDay != 0 {
+			quota.MaxDeploymentsPerDay = p.MaxDeploymentsPerDay
+		}
+		if p.MaxFilestoreBytes != "" {
+			quota.MaxFilestoreBytes = p.MaxFilestoreBytes
+		}
+		break
+	}
+	return quota
+}
+
+func (q *ProjectQuota) Validate() error {
+	if q.MaxApplications < 0 {
+		return errors.New("maxApplications must not be negative")
+	}
+	if q.MaxPipeds < 0 {
+		return errors.New("maxPipeds must not be negative")
+	}
+	if q.MaxDeploymentsPerDay < 0 {
+		return errors.New("maxDeploymentsPerDay must not be negative")
+	}
+	if _, err := parseQuota(q.MaxFilestoreBytes); err != nil {
+		return fmt.Errorf("invalid maxFilestoreBytes: %w", err)
+	}
+	return nil
+}
+
+// FilestoreBytes returns the maximum size of the stage logs in bytes.
+// Zero means unlimited.
+func (q ProjectQuota) FilestoreBytes() int64 {
+	v, _ := parseQuota(q.MaxFilestoreBytes)
+	return v
+}
+
+type ControlPlaneDeploymentRisk struct {
+	// The scoring applied to all projects.
+	// DefaultDeploymentRiskScoring is used when nothing is specified.
+	Default *DeploymentRiskScoring `json:"default"`
+	// List of the scorings for specific projects.
+	// They replace the default one entirely.
+	Projects []DeploymentRiskScoringOverride `json:"projects"`
+}
+
+// DeploymentRiskScoring represents how much each factor contributes to the risk score of a deployment.
+// Each factor scores between zero and its weight, and the risk score is their sum capped at 100.
+type DeploymentRiskScoring struct {
+	// The weight of the number of the changed resources.
+	// The full weight is scored when 20 or more resources are changed.
+	DiffSize int `json:"diffSize"`
+	// The weight of the number of the deleted resources.
+	// The full weight is scored when 5 or more resources are deleted.
+	DestroyedResources int `json:"destroyedResources"`
+	// The weight of the time since the last successful deployment of the application.
+	// The full weight is scored when it is 30 days or longer, or the application was never deployed.
+	TimeSinceLastDeploy int `json:"timeSinceLastDeploy"`
+	// The weight of the rate of the failed ones in the recent deployments of the application.
+	FailureRate int `json:"failureRate"`
+	// The weight of deploying outside the working hours.
+	OffHours int `json:"offHours"`
+	// The IANA time zone name used to determine the working hours, e.g. "Asia/Tokyo".
+	// Default is UTC.
+	Timezone string `json:"timezone"`
+	// The hour of the day the working hours start.
+	// The working hours are from 9 to 18 when both are zero.
+	WorkingHoursStart int `json:"workingHoursStart"`
+	// The hour of the day the working hours end.
+	WorkingHoursEnd int `json:"workingHoursEnd"`
+}
+
+// DefaultDeploymentRiskScoring is used when the default scoring is not configured.
+var DefaultDeploymentRiskScoring = DeploymentRiskScoring{
+	DiffSize:            25,
+	DestroyedResources:  25,
+	TimeSinceLastDeploy: 15,
+	FailureRate:         20,
+	OffHours:            15,
+}
+
+type DeploymentRiskScoringOverride struct {
+	// The ID of the project.
+	ProjectID string `json:"projectId"`
+	DeploymentRiskScoring
+}
+
+func (r *ControlPlaneDeploymentRisk) Validate() error {
+	if r.Default != nil {
+		if err := r.Default.Validate(); err != nil {
+			return fmt.Errorf("default: %w", err)
+		}
+	}
+	for _, p := range r.Projects {
+		if p.ProjectID == "" {
+			return errors.New("projectId must be set")
+		}
+		if err := p.DeploymentRiskScoring.Validate(); err != nil {
+			return fmt.Errorf("project %s: %w", p.ProjectID, err)
+		}
+	}
+	return nil
+}
+
+// Find returns the scoring of the given project.
+func (r *ControlPlaneDeploymentRisk) Find(projectID string) DeploymentRiskScoring {
+	for _, p := range r.Projects {
+		if p.ProjectID == projectID {
+			return p.DeploymentRiskScoring
+		}
+	}
+	if r.Default != nil {
+		return *r.Default
+	}
+	return DefaultDeploymentRiskScoring
+}
+
+func (s *DeploymentRiskScoring) Validate() error {
+	if s.DiffSize < 0 || s.DestroyedResources < 0 || s.TimeSinceLastDeploy < 0 || s.FailureRate < 0 || s.OffHours < 0 {
+		return errors.New("weights must not be negative")
+	}
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone: %w", err)
+	}
+	if s.WorkingHoursStart < 0 || s.WorkingHoursStart > 23 || s.WorkingHoursEnd < 0 || s.WorkingHoursEnd > 24 {
+		return errors.New("working hours must be between 0 and 24")
+	}
+	if s.WorkingHoursStart > s.WorkingHoursEnd {
+		return errors.New("workingHoursStart must not be later than workingHoursEnd")
+	}
+	return nil
+}
+
+// WorkingHours returns the start and end hours of the working hours.
+func (s DeploymentRiskScoring) WorkingHours() (start, end int) {
+	if s.WorkingHoursStart == 0 && s.WorkingHoursEnd == 0 {
+		return 9, 18
+	}
+	return s.WorkingHoursStart, s.WorkingHoursEnd
+}
+
+// SSOProvisioningAllProjects is used in SSOProvisioningRule to match all projects.
+const SSOProvisioningAllProjects = "*"
+
+type ControlPlaneSSOProvisioning struct {
+	// List of the rules to provision the SSO group mappings.
+	Rules []SSOProvisioningRule `json:"rules"`
+}
+
+// SSOProvisioningRule maps an SSO group to an RBAC role of the projects.
+type SSOProvisioningRule struct {
+	// The SSO group given by the identity provider.
+	// e.g. "my-org/my-team" for GitHub, the value of the groups claim for OIDC.
+	SSOGroup string `json:"ssoGroup"`
+	// The RBAC role given to the members of the SSO group.
+	// It must be one of the built-in roles or the custom roles of the projects.
+	Role string `json:"role"`
+	// The IDs of the projects this rule is applied to.
+	// "*" can be used to apply to all projects.
+	Projects []string `json:"projects"`
+}
+
+func (p *ControlPlaneSSOProvisioning) Validate() error {
+	for i, r := range p.Rules {
+		if r.SSOGroup == "" {
+			return fmt.Errorf("rules[%d]: ssoGroup must be set", i)
+		}
+		if r.Role == "" {
+			return fmt.Errorf("rules[%d]: role must be set", i)
+		}
+		if len(r.Projects) == 0 {
+			return fmt.Errorf("rules[%d]: projects must contain at least one project", i)
+		}
+	}
+	return nil
+}
+
+// FindRules returns the rules applied to the given project.
+func (p *ControlPlaneSSOProvisioning) FindRules(projectID string) []SSOProvisioningRule {
+	rules := make([]SSOProvisioningRule, 0, len(p.Rules))
+	for _, r := range p.Rules {
+		for _, id := range r.Projects {
+			if id == projectID || id == SSOProvisioningAllProjects {
+				rules = append(rules, r)
+				break
+			}
+		}
+	}
+	return rules
+}
+
+// ControlPlaneApprovalIssuer represents an external system, e.g. a ChatOps bot or an ITSM tool,
+// allowed to approve WAIT_APPROVAL stages by sending a JWT carrying the approver identity.
+type ControlPlaneApprovalIssuer struct {
+	// The value of the "iss" claim of the tokens signed by the system.
+	Issuer string `json:"issuer"`
+	// The value required to be in the "aud" claim of the tokens.
+	// Empty means the audience is not verified.
+	Audience string `json:"audience"`
+	// The algorithm used to sign the tokens.
+	// One of HS256, HS384, HS512, RS256, RS384 and RS512.
+	SigningMethod string `json:"signingMethod"`
+	// The path to the file containing the shared secret for HS* methods
+	// or the PEM encoded public key for RS* methods.
+	KeyFile string `json:"keyFile"`
+	// The claim holding the identity of the approver.
+	// It is compared with the approvers of the stage and recorded as the approver.
+	// Default is "sub".
+	IdentityClaim string `json:"identityClaim" default:"sub"`
+	// The longest lifetime of the tokens, from the "iat" claim to the "exp" claim.
+	// It must not be longer than an hour.
+	// Default is 5m.
+	MaxTokenTTL Duration `json:"maxTokenTTL" default:"5m"`
+}
+
+func (i *ControlPlaneApprovalIssuer) Validate() error {
+	if i.Issuer == "" {
+		return fmt.Errorf("issuer must be set")
+	}
+	switch i.SigningMethod {
+	case "HS256", "HS384", "HS512", "RS256", "RS384", "RS512":
+	default:
+		return fmt.Errorf("unsupported signingMethod %q", i.SigningMethod)
+	}
+	if i.KeyFile == "" {
+		return fmt.Errorf("keyFile must be set")
+	}
+	if ttl := i.MaxTokenTTL.Duration(); ttl < 0 || ttl > time.Hour {
+		return fmt.Errorf("maxTokenTTL must be between 0 and 1h")
+	}
+	return nil
+}
+
+// ControlPlaneGitLabIntegration configures how the webhook events sent by GitLab
+// for the merge requests of a project are handled.
+type ControlPlaneGitLabIntegration struct {
+	// The ID of the project.
+	ProjectID string `json:"projectId"`
+	// The path to the file containing the secret token configured on the GitLab webhook.
+	// It is compared with the X-Gitlab-Token header of the incoming events.
+	WebhookSecretFile string `json:"webhookSecretFile"`
+	// Whether to approve the running WAIT_APPROVAL stages of the deployments
+	// triggered by the commits of a merge request when it is approved on GitLab.
+	ApprovalSync bool `json:"approvalSync"`
+}
+
+func (g *ControlPlaneGitLabIntegration) Validate() error {
+	if g.ProjectID == "" {
+		return fmt.Errorf("projectId must be set")
+	}
+	if g.WebhookSecretFile == "" {
+		return fmt.Errorf("webhookSecretFile must be set")
+	}
+	return nil
+}
+
+// The lifecycle events which can be notified to the project webhooks.
+const (
+	ProjectEventApplicationRegistered = "APPLICATION_REGISTERED"
+	ProjectEventApplicationDeleted    = "APPLICATION_DELETED"
+	ProjectEventPipedRegistered       = "PIPED_REGISTERED"
+	ProjectEventPipedDisabled         = "PIPED_DISABLED"
+	ProjectEventAPIKeyCreated         = "API_KEY_CREATED"
+)
+
+var projectEvents = map[string]struct{}{
+	ProjectEventApplicationRegistered: {},
+	ProjectEventApplicationDeleted:    {},
+	ProjectEventPipedRegistered:       {},
+	ProjectEventPipedDisabled:         {},
+	ProjectEventAPIKeyCreated:         {},
+}
+
+type ControlPlaneProjectWebhook struct {
+	// The ID of the project.
+	ProjectID string `json:"projectId"`
+	// The URL to which the events are sent as HTTP POST requests.
+	URL string `json:"url"`
+	// The path to the file containing the key used to sign the request body
+	// with HMAC-SHA256. The signature is set to the X-PipeCD-Signature header.
+	SignatureKeyFile string `json:"signatureKeyFile"`
+	// List of the events to be sent.
+	// All events are sent if nothing is specified.
+	Events []string `json:"events"`
+}
+
+func (w *ControlPlaneProjectWebhook) Validate() error {
+	if w.ProjectID == "" {
+		return fmt.Errorf("projectId must be set")
+	}
+	if w.URL == "" {
+		return fmt.Errorf("url must be set")
+	}
+	u, err := url.Parse(w.URL)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url must be an http or https URL")
+	}
+	if w.SignatureKeyFile == "" {
+		return fmt.Errorf("signatureKeyFile must be set")
+	}
+	for _, e := range w.Events {
+		if _, ok := projectEvents[e]; !ok {
+			return fmt.Errorf("unknown event %s", e)
+		}
+	}
+	return nil
+}
+
+// Subscribes reports whether the given event should be sent to this webhook.
+func (w *ControlPlaneProjectWebhook) Subscribes(event string) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
 type ProjectStaticUser struct {
 	// The username string.
 	Username string `json:"username"`
@@ -182,6 +613,21 @@ type ControlPlaneCache struct {
 	TTL Duration `json:"ttl"`
 }
 
+type ControlPlaneStageArtifacts struct {
+	// How long the artifacts are retained after they were uploaded.
+	// Default is 720h (30 days).
+	TTL Duration `json:"ttl"`
+}
+
+func (a ControlPlaneStageArtifacts) TTLDuration() time.Duration {
+	const defaultTTL = 30 * 24 * time.Hour
+
+	if a.TTL == 0 {
+		return defaultTTL
+	}
+	return a.TTL.Duration()
+}
+
 type ControlPlaneInsightCollector struct {
 	Application InsightCollectorApplication `json:"application"`
 	Deployment  InsightCollectorDeployment  `json:"deployment"`
@@ -91,6 +91,63 @@ func TestControlPlaneConfig(t *testing.T) {
 						ChunkMaxCount: 1000,
 					},
 				},
+				ProjectQuotas: ControlPlaneProjectQuotas{
+					Default: ProjectQuota{
+						MaxApplications:      100,
+						MaxDeploymentsPerDay: 500,
+					},
+					Projects: []ProjectQuotaOverride{
+						{
+							ProjectID: "abc",
+							ProjectQuota: ProjectQuota{
+								MaxApplications:   300,
+								MaxFilestoreBytes: "10Gi",
+							},
+						},
+					},
+				},
+				SSOProvisioning: ControlPlaneSSOProvisioning{
+					Rules: []SSOProvisioningRule{
+						{
+							SSOGroup: "my-org/platform-team",
+							Role:     "Admin",
+							Projects: []string{"abc"},
+						},
+						{
+							SSOGroup: "my-org/all",
+							Role:     "Viewer",
+							Projects: []string{"*"},
+						},
+					},
+				},
+				ApprovalIssuers: []ControlPlaneApprovalIssuer{
+					{
+						Issuer:        "https://chatops.example.com",
+						Audience:      "pipecd",
+						SigningMethod: "RS256",
+						KeyFile:       "/etc/pipecd-secret/chatops.pub",
+						IdentityClaim: "sub",
+						MaxTokenTTL:   Duration(5 * time.Minute),
+					},
+				},
+				GitLabIntegrations: []ControlPlaneGitLabIntegration{
+					{
+						ProjectID:         "abc",
+						WebhookSecretFile: "/etc/pipecd-secret/gitlab-webhook-secret",
+						ApprovalSync:      true,
+					},
+				},
+				ProjectWebhooks: []ControlPlaneProjectWebhook{
+					{
+						ProjectID:        "abc",
+						URL:              "https://inventory.example.com/pipecd",
+						SignatureKeyFile: "/etc/pipecd-secret/project-webhook-key",
+						Events: []string{
+							ProjectEventApplicationRegistered,
+							ProjectEventApplicationDeleted,
+						},
+					},
+				},
 			},
 		},
 	}
@@ -114,3 +171,193 @@ func TestControlPlaneConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestControlPlaneProjectQuotas(t *testing.T) {
+	quotas := ControlPlaneProjectQuotas{
+		Default: ProjectQuota{
+			MaxApplications:      100,
+			MaxPipeds:            10,
+			MaxDeploymentsPerDay: 500,
+		},
+		Projects: []ProjectQuotaOverride{
+			{
+				ProjectID: "abc",
+				ProjectQuota: ProjectQuota{
+					MaxApplications:   300,
+					MaxFilestoreBytes: "1Ki",
+				},
+			},
+		},
+	}
+	require.NoError(t, quotas.Validate())
+
+	abc := quotas.Find("abc")
+	assert.Equal(t, 300, abc.MaxApplications)
+	assert.Equal(t, 10, abc.MaxPipeds)
+	assert.Equal(t, 500, abc.MaxDeploymentsPerDay)
+	assert.Equal(t, int64(1024), abc.FilestoreBytes())
+
+	other := quotas.Find("other")
+	assert.Equal(t, quotas.Default, other)
+	assert.Equal(t, int64(0), other.FilestoreBytes())
+
+	invalid := []ControlPlaneProjectQuotas{
+		{Default: ProjectQuota{MaxPipeds: -1}},
+		{Projects: []ProjectQuotaOverride{{ProjectQuota: ProjectQuota{MaxApplications: 1}}}},
+		{Projects: []ProjectQuotaOverride{{ProjectID: "abc", ProjectQuota: ProjectQuota{MaxFilestoreBytes: "abc"}}}},
+	}
+	for _, q := range invalid {
+		assert.Error(t, q.Validate())
+	}
+}
+
+func TestControlPlaneSSOProvisioning(t *testing.T) {
+	provisioning := ControlPlaneSSOProvisioning{
+		Rules: []SSOProvisioningRule{
+			{
+				SSOGroup: "my-org/platform-team",
+				Role:     "Admin",
+				Projects: []string{"abc", "def"},
+			},
+			{
+				SSOGroup: "my-org/all",
+				Role:     "Viewer",
+				Projects: []string{SSOProvisioningAllProjects},
+			},
+		},
+	}
+	require.NoError(t, provisioning.Validate())
+
+	assert.Equal(t, provisioning.Rules, provisioning.FindRules("abc"))
+	assert.Equal(t, provisioning.Rules[1:], provisioning.FindRules("other"))
+
+	invalid := []ControlPlaneSSOProvisioning{
+		{Rules: []SSOProvisioningRule{{Role: "Admin", Projects: []string{"abc"}}}},
+		{Rules: []SSOProvisioningRule{{SSOGroup: "my-org/team", Projects: []string{"abc"}}}},
+		{Rules: []SSOProvisioningRule{{SSOGroup: "my-org/team", Role: "Admin"}}},
+	}
+	for _, p := range invalid {
+		assert.Error(t, p.Validate())
+	}
+}
+
+func TestControlPlaneApprovalIssuers(t *testing.T) {
+	valid := ControlPlaneApprovalIssuer{
+		Issuer:        "https://chatops.example.com",
+		SigningMethod: "HS256",
+		KeyFile:       "secret",
+	}
+	spec := ControlPlaneSpec{ApprovalIssuers: []ControlPlaneApprovalIssuer{valid}}
+	require.NoError(t, spec.Validate())
+
+	invalid := []ControlPlaneApprovalIssuer{
+		{SigningMethod: "HS256", KeyFile: "secret"},
+		{Issuer: "https://chatops.example.com", SigningMethod: "none", KeyFile: "secret"},
+		{Issuer: "https://chatops.example.com", SigningMethod: "RS256"},
+		{Issuer: "https://chatops.example.com", SigningMethod: "HS256", KeyFile: "secret", MaxTokenTTL: Duration(2 * time.Hour)},
+	}
+	for _, iss := range invalid {
+		assert.Error(t, iss.Validate())
+	}
+
+	duplicated := ControlPlaneSpec{ApprovalIssuers: []ControlPlaneApprovalIssuer{valid, valid}}
+	assert.Error(t, duplicated.Validate())
+}
+
+func TestControlPlaneGitLabIntegrations(t *testing.T) {
+	valid := ControlPlaneGitLabIntegration{
+		ProjectID:         "abc",
+		WebhookSecretFile: "secret",
+	}
+	spec := ControlPlaneSpec{GitLabIntegrations: []ControlPlaneGitLabIntegration{valid}}
+	require.NoError(t, spec.Validate())
+
+	invalid := []ControlPlaneGitLabIntegration{
+		{WebhookSecretFile: "secret"},
+		{ProjectID: "abc"},
+	}
+	for _, g := range invalid {
+		assert.Error(t, g.Validate())
+	}
+
+	duplicated := ControlPlaneSpec{GitLabIntegrations: []ControlPlaneGitLabIntegration{valid, valid}}
+	assert.Error(t, duplicated.Validate())
+}
+
+func TestControlPlaneDeploymentRisk(t *testing.T) {
+	var empty ControlPlaneDeploymentRisk
+	require.NoError(t, empty.Validate())
+	assert.Equal(t, DefaultDeploymentRiskScoring, empty.Find("abc"))
+
+	r := ControlPlaneDeploymentRisk{
+		Default: &DeploymentRiskScoring{DiffSize: 50, DestroyedResources: 50},
+		Projects: []DeploymentRiskScoringOverride{
+			{
+				ProjectID: "abc",
+				DeploymentRiskScoring: DeploymentRiskScoring{
+					OffHours:          100,
+					Timezone:          "Asia/Tokyo",
+					WorkingHoursStart: 10,
+					WorkingHoursEnd:   19,
+				},
+			},
+		},
+	}
+	require.NoError(t, r.Validate())
+	assert.Equal(t, DeploymentRiskScoring{DiffSize: 50, DestroyedResources: 50}, r.Find("xyz"))
+
+	s := r.Find("abc")
+	assert.Equal(t, 100, s.OffHours)
+	assert.Equal(t, 0, s.DiffSize)
+	start, end := s.WorkingHours()
+	assert.Equal(t, 10, start)
+	assert.Equal(t, 19, end)
+
+	start, end = DefaultDeploymentRiskScoring.WorkingHours()
+	assert.Equal(t, 9, start)
+	assert.Equal(t, 18, end)
+
+	invalid := []DeploymentRiskScoring{
+		{DiffSize: -1},
+		{Timezone: "Unknown/Zone"},
+		{WorkingHoursStart: 18, WorkingHoursEnd: 9},
+		{WorkingHoursEnd: 25},
+	}
+	for _, s := range invalid {
+		assert.Error(t, s.Validate())
+	}
+	noProjectID := ControlPlaneDeploymentRisk{Projects: []DeploymentRiskScoringOverride{{}}}
+	assert.Error(t, noProjectID.Validate())
+}
+
+func TestControlPlaneProjectWebhooks(t *testing.T) {
+	valid := ControlPlaneProjectWebhook{
+		ProjectID:        "abc",
+		URL:              "https://example.com/hook",
+		SignatureKeyFile: "key",
+	}
+	require.NoError(t, valid.Validate())
+	assert.True(t, valid.Subscribes(ProjectEventPipedDisabled))
+
+	filtered := valid
+	filtered.Events = []string{ProjectEventPipedRegistered}
+	require.NoError(t, filtered.Validate())
+	assert.True(t, filtered.Subscribes(ProjectEventPipedRegistered))
+	assert.False(t, filtered.Subscribes(ProjectEventPipedDisabled))
+
+	invalid := []ControlPlaneProjectWebhook{
+		{URL: "https://example.com/hook", SignatureKeyFile: "key"},
+		{ProjectID: "abc", SignatureKeyFile: "key"},
+		{ProjectID: "abc", URL: "ftp://example.com/hook", SignatureKeyFile: "key"},
+		{ProjectID: "abc", URL: "https://example.com/hook"},
+		{ProjectID: "abc", URL: "https://example.com/hook", SignatureKeyFile: "key", Events: []string{"UNKNOWN"}},
+	}
+	for _, w := range invalid {
+		assert.Error(t, w.Validate())
+	}
+}
+
+func TestControlPlaneStageArtifactsTTLDuration(t *testing.T) {
+	assert.Equal(t, 30*24*time.Hour, ControlPlaneStageArtifacts{}.TTLDuration())
+	assert.Equal(t, 72*time.Hour, ControlPlaneStageArtifacts{TTL: Duration(72 * time.Hour)}.TTLDuration())
+}
@@ -0,0 +1,388 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/Masterminds/sprig/v3"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// parameterLeftDelim and parameterRightDelim are the delimiters used to refer the deployment
+	// parameters from the application configuration file.
+	// They are different from the default ones to keep the templates of analysis queries as is.
+	parameterLeftDelim  = "${{"
+	parameterRightDelim = "}}"
+)
+
+type ParameterType string
+
+const (
+	ParameterTypeString ParameterType = "string"
+	ParameterTypeInt    ParameterType = "int"
+	ParameterTypeEnum   ParameterType = "enum"
+)
+
+// DeploymentParameters represents the typed parameters users can supply while triggering a sync.
+type DeploymentParameters struct {
+	// List of the parameters can be supplied while triggering a sync.
+	Definitions []DeploymentParameter `json:"definitions"`
+	// List of files to be templated with the parameter values before using.
+	Targets []string `json:"targets"`
+}
+
+type DeploymentParameter struct {
+	// The name to refer the parameter.
+	Name string `json:"name"`
+	// Notes on the parameter.
+	Description string `json:"description"`
+	// The type of the parameter.
+	// Available values are string, int and enum.
+	// Default is string.
+	Type ParameterType `json:"type" default:"string"`
+	// The value used when the parameter was not supplied.
+	Default *ParameterValue `json:"default"`
+	// List of the allowed values of an enum parameter.
+	Values []ParameterValue `json:"values"`
+	// The regular expression a string parameter must match.
+	Pattern string `json:"pattern"`
+	// The minimum value of an int parameter.
+	Min *int `json:"min"`
+	// The maximum value of an int parameter.
+	Max *int `json:"max"`
+}
+
+// ParameterValue is a string value which can be also specified as a number or a boolean in YAML.
+type ParameterValue string
+
+func (v *ParameterValue) UnmarshalJSON(b []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	switch r := raw.(type) {
+	case string:
+		*v = ParameterValue(r)
+	case float64:
+		*v = ParameterValue(strconv.FormatFloat(r, 'f', -1, 64))
+	case bool:
+		*v = ParameterValue(strconv.FormatBool(r))
+	default:
+		return fmt.Errorf("invalid parameter value: %s", string(b))
+	}
+	return nil
+}
+
+func (p *DeploymentParameters) Validate() error {
+	names := make(map[string]struct{}, len(p.Definitions))
+	for _, d := range p.Definitions {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+		if _, ok := names[d.Name]; ok {
+			return fmt.Errorf("parameter %s is defined more than once", d.Name)
+		}
+		names[d.Name] = struct{}{}
+	}
+	for _, t := range p.Targets {
+		if t == "" {
+			return fmt.Errorf("parameter targets must not contain an empty path")
+		}
+	}
+	return nil
+}
+
+func (p *DeploymentParameter) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("parameter name must not be empty")
+	}
+	switch p.Type {
+	case ParameterTypeString:
+		if p.Pattern != "" {
+			if _, err := regexp.Compile(p.Pattern); err != nil {
+				return fmt.Errorf("invalid pattern of parameter %s: %w", p.Name, err)
+			}
+		}
+	case ParameterTypeInt:
+		if p.Min != nil && p.Max != nil && *p.Min > *p.Max {
+			return fmt.Errorf("min of parameter %s must not be greater than max", p.Name)
+		}
+	case ParameterTypeEnum:
+		if len(p.Values) == 0 {
+			return fmt.Errorf("values of enum parameter %s must not be empty", p.Name)
+		}
+	default:
+		return fmt.Errorf("unsupported type %s of parameter %s", p.Type, p.Name)
+	}
+	// The default is required because some deployments are triggered without
+	// supplying the parameters, e.g. by a new commit.
+	if p.Default == nil {
+		return fmt.Errorf("default of parameter %s must be set", p.Name)
+	}
+	if err := p.check(string(*p.Default)); err != nil {
+		return fmt.Errorf("invalid default of parameter %s: %w", p.Name, err)
+	}
+	return nil
+}
+
+// check returns an error when the given value does not satisfy the definition.
+func (p *DeploymentParameter) check(value string) error {
+	switch p.Type {
+	// The type can be empty while rendering since the defaults are not set yet.
+	case ParameterTypeString, "":
+		// The control characters are rejected because the value is pasted into the parameter targets as is
+		// where a newline could inject arbitrary fields.
+		if strings.IndexFunc(value, unicode.IsControl) >= 0 {
+			return fmt.Errorf("%q must not contain control characters", value)
+		}
+		if p.Pattern != "" {
+			matched, err := regexp.MatchString(p.Pattern, value)
+			if err != nil {
+				return err
+			}
+			if !matched {
+				return fmt.Errorf("%q does not match pattern %s", value, p.Pattern)
+			}
+		}
+	case ParameterTypeInt:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+		if p.Min != nil && n < *p.Min {
+			return fmt.Errorf("%d is less than min %d", n, *p.Min)
+		}
+		if p.Max != nil && n > *p.Max {
+			return fmt.Errorf("%d is greater than max %d", n, *p.Max)
+		}
+	case ParameterTypeEnum:
+		for _, v := range p.Values {
+			if string(v) == value {
+				return nil
+			}
+		}
+		values := make([]string, 0, len(p.Values))
+		for _, v := range p.Values {
+			values = append(values, string(v))
+		}
+		return fmt.Errorf("%q is not one of [%s]", value, strings.Join(values, ", "))
+	}
+	return nil
+}
+
+// Resolve returns the values of all defined parameters, using the defaults for the ones not in the given values.
+// An error is returned when an undefined parameter was given or a value does not satisfy its definition.
+func (p *DeploymentParameters) Resolve(values map[string]string) (map[string]string, error) {
+	var defs []DeploymentParameter
+	if p != nil {
+		defs = p.Definitions
+	}
+
+	defined := make(map[string]struct{}, len(defs))
+	for _, d := range defs {
+		defined[d.Name] = struct{}{}
+	}
+	undefined := make([]string, 0)
+	for k := range values {
+		if _, ok := defined[k]; !ok {
+			undefined = append(undefined, k)
+		}
+	}
+	if len(undefined) > 0 {
+		sort.Strings(undefined)
+		return nil, fmt.Errorf("undefined parameters were given: %s", strings.Join(undefined, ", "))
+	}
+
+	resolved := make(map[string]string, len(defs))
+	for _, d := range defs {
+		v, ok := values[d.Name]
+		if !ok {
+			if d.Default != nil {
+				resolved[d.Name] = string(*d.Default)
+			}
+			continue
+		}
+		if err := d.check(v); err != nil {
+			return nil, fmt.Errorf("invalid value of parameter %s: %w", d.Name, err)
+		}
+		resolved[d.Name] = v
+	}
+	return resolved, nil
+}
+
+// Defined returns the given values of the parameters defined in p.
+// It is used to render with the values of a past deployment whose definitions might have been changed since.
+func (p *DeploymentParameters) Defined(values map[string]string) map[string]string {
+	if p == nil || len(values) == 0 {
+		return nil
+	}
+	defined := make(map[string]string, len(values))
+	for _, d := range p.Definitions {
+		if v, ok := values[d.Name]; ok {
+			defined[d.Name] = v
+		}
+	}
+	return defined
+}
+
+// RenderValues holds the values used to render the references in application configuration.
+type RenderValues struct {
+	// The values of the deployment parameters.
+	// The defaults are used for the parameters not in these values.
+	Parameters map[string]string
+	// Whether the values of the parameters no longer defined are ignored instead of being an error.
+	IgnoreUndefinedParameters bool
+	// The piped-level defaults of the env.
+	// The ones defined in the application configuration take precedence.
+	Env map[string]string
+}
+
+// renderParameters renders the references to the deployment parameters and the env in the given application configuration data.
+// The defaults are used for the parameters not in the given values.
+// The references are rendered in each string of the parsed data instead of the raw text,
+// so that a value can not inject any field into the configuration. The returned data is JSON.
+func renderParameters(data []byte, values RenderValues) ([]byte, error) {
+	if !bytes.Contains(data, []byte(parameterLeftDelim)) {
+		return data, nil
+	}
+
+	// The references are plain YAML strings, so the definitions can be read before rendering.
+	var cfg struct {
+		Spec struct {
+			Parameters *DeploymentParameters `json:"parameters"`
+			Env        map[string]string     `json:"env"`
+		} `json:"spec"`
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	params := values.Parameters
+	if values.IgnoreUndefinedParameters {
+		params = cfg.Spec.Parameters.Defined(params)
+	}
+	resolved, err := cfg.Spec.Parameters.Resolve(params)
+	if err != nil {
+		return nil, err
+	}
+
+	js, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(js))
+	dec.UseNumber()
+	var tree interface{}
+	if err := dec.Decode(&tree); err != nil {
+		return nil, err
+	}
+
+	refs := map[string]map[string]string{
+		"parameters": resolved,
+		"env":        mergeEnv(cfg.Spec.Env, values.Env),
+	}
+	if tree, err = renderReferences(tree, refs); err != nil {
+		return nil, err
+	}
+	return json.Marshal(tree)
+}
+
+// renderReferences renders the references in every string of the given parsed data.
+func renderReferences(v interface{}, refs map[string]map[string]string) (interface{}, error) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			r, err := renderReferences(e, refs)
+			if err != nil {
+				return nil, err
+			}
+			t[k] = r
+		}
+	case []interface{}:
+		for i, e := range t {
+			r, err := renderReferences(e, refs)
+			if err != nil {
+				return nil, err
+			}
+			t[i] = r
+		}
+	case string:
+		if !strings.Contains(t, parameterLeftDelim) {
+			return t, nil
+		}
+		return renderReference(t, refs)
+	}
+	return v, nil
+}
+
+// renderReference renders the references in the given string.
+// The result is typed as a number or a boolean when it is, in the same way as a plain YAML scalar.
+// Otherwise it is kept as a string even if it looks like a mapping or a sequence.
+func renderReference(s string, refs map[string]map[string]string) (interface{}, error) {
+	tmpl, err := template.New("config").
+		Delims(parameterLeftDelim, parameterRightDelim).
+		Funcs(sprig.TxtFuncMap()).
+		Option("missingkey=error").
+		Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the references to deployment parameters (%w)", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, refs); err != nil {
+		return nil, fmt.Errorf("failed to render the deployment parameters and env (%w)", err)
+	}
+	rendered := buf.String()
+
+	js, err := yaml.YAMLToJSON(buf.Bytes())
+	if err != nil {
+		return rendered, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(js))
+	dec.UseNumber()
+	var scalar interface{}
+	if err := dec.Decode(&scalar); err != nil {
+		return rendered, nil
+	}
+	switch scalar.(type) {
+	case json.Number, bool:
+		return scalar, nil
+	}
+	return rendered, nil
+}
+
+// mergeEnv returns the env whose keys not in the given one are filled with the given defaults.
+func mergeEnv(env, defaults map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return env
+	}
+	merged := make(map[string]string, len(env)+len(defaults))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range env {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,313 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentParametersResolve(t *testing.T) {
+	t.Parallel()
+
+	value := func(v string) *ParameterValue {
+		pv := ParameterValue(v)
+		return &pv
+	}
+	intPtr := func(v int) *int {
+		return &v
+	}
+	params := &DeploymentParameters{
+		Definitions: []DeploymentParameter{
+			{Name: "imageTag", Type: ParameterTypeString, Default: value("v1.0.0"), Pattern: `^v\d+\.\d+\.\d+$`},
+			{Name: "replicas", Type: ParameterTypeInt, Default: value("3"), Min: intPtr(1), Max: intPtr(10)},
+			{Name: "logLevel", Type: ParameterTypeEnum, Default: value("info"), Values: []ParameterValue{"debug", "info"}},
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		params   *DeploymentParameters
+		values   map[string]string
+		expected map[string]string
+		wantErr  bool
+	}{
+		{
+			name:     "no parameters defined",
+			expected: map[string]string{},
+		},
+		{
+			name:    "undefined parameter given",
+			values:  map[string]string{"imageTag": "v1.1.0"},
+			wantErr: true,
+		},
+		{
+			name:   "defaults are used",
+			params: params,
+			expected: map[string]string{
+				"imageTag": "v1.0.0",
+				"replicas": "3",
+				"logLevel": "info",
+			},
+		},
+		{
+			name:   "given values are used",
+			params: params,
+			values: map[string]string{"imageTag": "v1.1.0", "replicas": "5", "logLevel": "debug"},
+			expected: map[string]string{
+				"imageTag": "v1.1.0",
+				"replicas": "5",
+				"logLevel": "debug",
+			},
+		},
+		{
+			name:    "string not matching pattern",
+			params:  params,
+			values:  map[string]string{"imageTag": "latest"},
+			wantErr: true,
+		},
+		{
+			name:    "not an integer",
+			params:  params,
+			values:  map[string]string{"replicas": "five"},
+			wantErr: true,
+		},
+		{
+			name:    "integer out of range",
+			params:  params,
+			values:  map[string]string{"replicas": "11"},
+			wantErr: true,
+		},
+		{
+			name:    "not allowed enum value",
+			params:  params,
+			values:  map[string]string{"logLevel": "trace"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown parameter given",
+			params:  params,
+			values:  map[string]string{"unknown": "value"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tc.params.Resolve(tc.values)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestDeploymentParameterValidate(t *testing.T) {
+	t.Parallel()
+
+	value := func(v string) *ParameterValue {
+		pv := ParameterValue(v)
+		return &pv
+	}
+	testcases := []struct {
+		name    string
+		param   DeploymentParameter
+		wantErr bool
+	}{
+		{
+			name:  "valid string",
+			param: DeploymentParameter{Name: "tag", Type: ParameterTypeString, Default: value("")},
+		},
+		{
+			name:    "missing name",
+			param:   DeploymentParameter{Type: ParameterTypeString, Default: value("v1")},
+			wantErr: true,
+		},
+		{
+			name:    "missing default",
+			param:   DeploymentParameter{Name: "tag", Type: ParameterTypeString},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported type",
+			param:   DeploymentParameter{Name: "tag", Type: "bool", Default: value("true")},
+			wantErr: true,
+		},
+		{
+			name:    "invalid default of int",
+			param:   DeploymentParameter{Name: "replicas", Type: ParameterTypeInt, Default: value("three")},
+			wantErr: true,
+		},
+		{
+			name:    "control characters in default of string",
+			param:   DeploymentParameter{Name: "tag", Type: ParameterTypeString, Default: value("v1\nkind: Namespace")},
+			wantErr: true,
+		},
+		{
+			name:    "enum without values",
+			param:   DeploymentParameter{Name: "level", Type: ParameterTypeEnum, Default: value("info")},
+			wantErr: true,
+		},
+		{
+			name:    "default not in enum values",
+			param:   DeploymentParameter{Name: "level", Type: ParameterTypeEnum, Default: value("trace"), Values: []ParameterValue{"info"}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.param.Validate()
+			assert.Equal(t, tc.wantErr, err != nil, err)
+		})
+	}
+}
+
+func TestDecodeYAMLWithParameters(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`
+apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  parameters:
+    definitions:
+      - name: replicas
+        type: int
+        default: 2
+      - name: canary
+        type: enum
+        values: [10%, 50%]
+        default: 10%
+  pipeline:
+    stages:
+      - name: K8S_CANARY_ROLLOUT
+        with:
+          replicas: ${{ .parameters.canary }}
+      - name: ANALYSIS
+        with:
+          duration: 10m
+          metrics:
+            - provider: prometheus
+              query: up{job="{{ .App.Name }}"}
+              expected:
+                min: ${{ .parameters.replicas }}
+              interval: 1m
+`)
+
+	cfg, err := DecodeYAML(data)
+	require.NoError(t, err)
+	stages := cfg.KubernetesApplicationSpec.Pipeline.Stages
+	assert.Equal(t, Replicas{Number: 10, IsPercentage: true}, stages[0].K8sCanaryRolloutStageOptions.Replicas)
+	assert.Equal(t, 2.0, *stages[1].AnalysisStageOptions.Metrics[0].Expected.Min)
+	assert.Equal(t, `up{job="{{ .App.Name }}"}`, stages[1].AnalysisStageOptions.Metrics[0].Query)
+
+	cfg, err = DecodeYAMLWithParameters(data, map[string]string{"replicas": "5", "canary": "50%"})
+	require.NoError(t, err)
+	stages = cfg.KubernetesApplicationSpec.Pipeline.Stages
+	assert.Equal(t, Replicas{Number: 50, IsPercentage: true}, stages[0].K8sCanaryRolloutStageOptions.Replicas)
+	assert.Equal(t, 5.0, *stages[1].AnalysisStageOptions.Metrics[0].Expected.Min)
+
+	_, err = DecodeYAMLWithParameters(data, map[string]string{"canary": "100%"})
+	assert.Error(t, err)
+
+	// The values of the parameters no longer defined are ignored only when requested.
+	_, err = DecodeYAMLWithParameters(data, map[string]string{"replicas": "5", "removed": "x"})
+	assert.Error(t, err)
+	cfg, err = DecodeYAMLWithValues(data, RenderValues{
+		Parameters:                map[string]string{"replicas": "5", "removed": "x"},
+		IgnoreUndefinedParameters: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 5.0, *cfg.KubernetesApplicationSpec.Pipeline.Stages[1].AnalysisStageOptions.Metrics[0].Expected.Min)
+}
+
+func TestDecodeYAMLWithParametersInjection(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`
+apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  parameters:
+    definitions:
+      - name: endpoint
+        default: https://example.com
+  pipeline:
+    stages:
+      - name: SCRIPT_RUN
+        with:
+          run: ./smoke-test.sh ${{ .parameters.endpoint }}
+`)
+
+	// A value looking like a mapping stays in the string it is referred from.
+	cfg, err := DecodeYAMLWithParameters(data, map[string]string{"endpoint": "x\", env: {A: b}, \"y"})
+	require.NoError(t, err)
+	stage := cfg.KubernetesApplicationSpec.Pipeline.Stages[0]
+	assert.Equal(t, `./smoke-test.sh x", env: {A: b}, "y`, stage.ScriptRunStageOptions.Run)
+	assert.Empty(t, stage.ScriptRunStageOptions.Env)
+
+	_, err = DecodeYAMLWithParameters(data, map[string]string{"endpoint": "x\n        env:\n          A: b"})
+	assert.Error(t, err)
+}
+
+func TestDecodeYAMLWithValuesEnv(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`
+apiVersion: pipecd.dev/v1beta1
+kind: KubernetesApp
+spec:
+  env:
+    waitDuration: 5m
+  pipeline:
+    stages:
+      - name: WAIT
+        with:
+          duration: ${{ .env.waitDuration }}
+      - name: SCRIPT_RUN
+        with:
+          run: ./smoke-test.sh ${{ .env.endpoint }}
+`)
+
+	cfg, err := DecodeYAMLWithValues(data, RenderValues{
+		Env: map[string]string{"waitDuration": "1m", "endpoint": "https://dev.example.com"},
+	})
+	require.NoError(t, err)
+	stages := cfg.KubernetesApplicationSpec.Pipeline.Stages
+	assert.Equal(t, Duration(5*time.Minute), stages[0].WaitStageOptions.Duration)
+	assert.Equal(t, "./smoke-test.sh https://dev.example.com", stages[1].ScriptRunStageOptions.Run)
+	assert.Equal(t, map[string]string{"waitDuration": "5m", "endpoint": "https://dev.example.com"}, cfg.KubernetesApplicationSpec.Env)
+
+	// The env not defined by both of the application and piped can not be referred.
+	_, err = DecodeYAML(data)
+	assert.Error(t, err)
+}
+
+func TestMergeEnv(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, mergeEnv(nil, nil))
+	assert.Equal(t, map[string]string{"a": "1"}, mergeEnv(map[string]string{"a": "1"}, nil))
+	assert.Equal(t, map[string]string{"a": "1", "b": "3"}, mergeEnv(map[string]string{"a": "1"}, map[string]string{"a": "2", "b": "3"}))
+}
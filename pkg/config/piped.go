@@ -22,6 +22,9 @@ import (
 	"os"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/util/jsonpath"
+
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -47,6 +50,9 @@ type PipedSpec struct {
 	PipedKeyData string `json:"pipedKeyData,omitempty"`
 	// The name of this piped.
 	Name string `json:"name,omitempty"`
+	// Labels of this piped reported to the control-plane.
+	// They can be used to select a subset of pipeds, e.g. while upgrading them gradually.
+	Labels map[string]string `json:"labels,omitempty"`
 	// The address used to connect to the control-plane's API.
 	APIAddress string `json:"apiAddress"`
 	// The address to the control-plane's Web.
@@ -80,6 +86,11 @@ type PipedSpec struct {
 	EventWatcher PipedEventWatcher `json:"eventWatcher"`
 	// List of labels to filter all applications this piped will handle.
 	AppSelector map[string]string `json:"appSelector,omitempty"`
+	// The default values of the env of all applications handled by this piped.
+	// The ones defined in the application configuration take precedence.
+	AppEnv map[string]string `json:"appEnv,omitempty"`
+	// Optional settings to keep the disk usage of the piped workspace under quotas.
+	DiskUsage *PipedDiskUsage `json:"diskUsage,omitempty"`
 }
 
 func (s *PipedSpec) UnmarshalJSON(data []byte) error {
@@ -140,6 +151,11 @@ func (s *PipedSpec) Validate() error {
 	if err := s.EventWatcher.Validate(); err != nil {
 		return err
 	}
+	if s.DiskUsage != nil {
+		if err := s.DiskUsage.Validate(); err != nil {
+			return err
+		}
+	}
 	for _, n := range s.Notifications.Receivers {
 		if n.Slack != nil {
 			if err := n.Slack.Validate(); err != nil {
@@ -147,6 +163,11 @@ func (s *PipedSpec) Validate() error {
 			}
 		}
 	}
+	for _, p := range s.PlatformProviders {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+	}
 	for _, p := range s.AnalysisProviders {
 		if err := p.Validate(); err != nil {
 			return err
@@ -293,6 +314,11 @@ func (s *PipedSpec) IsInsecureChartRepository(name string) bool {
 			return cr.Insecure
 		}
 	}
+	for _, cr := range s.ChartRegistries {
+		if cr.MatchChartRepository(name) {
+			return cr.Insecure
+		}
+	}
 	return false
 }
 
@@ -332,6 +358,11 @@ type PipedGit struct {
 	// Base64 encoded string of password.
 	// This will be used to clone the source repo with https basic auth.
 	Password string `json:"password,omitempty"`
+	// The maximum number of repositories cloned or fetched from remote at the same time.
+	// Every repository is fetched once into a local cache shared by all applications,
+	// and each deployment uses its own worktree checked out from it.
+	// Default is 0, which means no limit.
+	MaxConcurrentFetches int `json:"maxConcurrentFetches,omitempty"`
 }
 
 func (g PipedGit) ShouldConfigureSSHConfig() bool {
@@ -363,6 +394,9 @@ func (g *PipedGit) Validate() error {
 	if isPassword && (g.Username == "" || g.Password == "") {
 		return errors.New("both username and password must be set")
 	}
+	if g.MaxConcurrentFetches < 0 {
+		return errors.New("maxConcurrentFetches must be greater than or equal to 0")
+	}
 	return nil
 }
 
@@ -500,6 +534,9 @@ const (
 	OCIHelmChartRegistry HelmChartRegistryType = "OCI"
 )
 
+// OCIChartRepositoryPrefix is the prefix of the chart repositories hosted by OCI registries.
+const OCIChartRepositoryPrefix = "oci://"
+
 type HelmChartRegistry struct {
 	// The registry type. Currently, only OCI is supported.
 	Type HelmChartRegistryType `json:"type" default:"OCI"`
@@ -510,6 +547,14 @@ type HelmChartRegistry struct {
 	Username string `json:"username,omitempty"`
 	// Password used for the registry authentication.
 	Password string `json:"password,omitempty"`
+	// Path to the file containing the username used for the registry authentication.
+	UsernameFile string `json:"usernameFile,omitempty"`
+	// Path to the file containing the password or the access token used for the registry authentication.
+	// The file is read again on every login so that a token rotated by an external process
+	// (e.g. a mounted secret holding an ECR token) is used.
+	PasswordFile string `json:"passwordFile,omitempty"`
+	// Whether to allow the insecure connection to the registry or not.
+	Insecure bool `json:"insecure,omitempty"`
 }
 
 func (r *HelmChartRegistry) IsOCI() bool {
@@ -521,16 +566,62 @@ func (r *HelmChartRegistry) Validate() error {
 		if r.Address == "" {
 			return errors.New("address must be set")
 		}
+		if r.Username != "" && r.UsernameFile != "" {
+			return errors.New("only one of username and usernameFile can be set")
+		}
+		if r.Password != "" && r.PasswordFile != "" {
+			return errors.New("only one of password and passwordFile can be set")
+		}
 		return nil
 	}
 
 	return fmt.Errorf("%s registry must be configured", OCIHelmChartRegistry)
 }
 
+// HasCredentials returns true if both the username and the password are configured.
+func (r *HelmChartRegistry) HasCredentials() bool {
+	return (r.Username != "" || r.UsernameFile != "") && (r.Password != "" || r.PasswordFile != "")
+}
+
+// LoadCredentials returns the username and the password used for the registry authentication.
+// They are read from the configured files if specified.
+func (r *HelmChartRegistry) LoadCredentials() (username, password string, err error) {
+	username, password = r.Username, r.Password
+	if r.UsernameFile != "" {
+		data, err := os.ReadFile(r.UsernameFile)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to read username file %s: %w", r.UsernameFile, err)
+		}
+		username = strings.TrimSpace(string(data))
+	}
+	if r.PasswordFile != "" {
+		data, err := os.ReadFile(r.PasswordFile)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to read password file %s: %w", r.PasswordFile, err)
+		}
+		password = strings.TrimSpace(string(data))
+	}
+	return username, password, nil
+}
+
+// MatchChartRepository returns true if the given OCI chart repository,
+// e.g. oci://registry.example.com/charts, is hosted by this registry.
+func (r *HelmChartRegistry) MatchChartRepository(repository string) bool {
+	if !r.IsOCI() || !strings.HasPrefix(repository, OCIChartRepositoryPrefix) {
+		return false
+	}
+	host := strings.TrimPrefix(repository, OCIChartRepositoryPrefix)
+	address := strings.TrimSuffix(strings.TrimPrefix(r.Address, OCIChartRepositoryPrefix), "/")
+	return host == address || strings.HasPrefix(host, address+"/")
+}
+
 func (r *HelmChartRegistry) Mask() {
 	if len(r.Password) != 0 {
 		r.Password = maskString
 	}
+	if len(r.PasswordFile) != 0 {
+		r.PasswordFile = maskString
+	}
 }
 
 type PipedPlatformProvider struct {
@@ -627,6 +718,34 @@ func (p *PipedPlatformProvider) UnmarshalJSON(data []byte) error {
 	return err
 }
 
+func (p *PipedPlatformProvider) Validate() error {
+	var awsAPIClient *AWSAPIClientConfig
+	switch {
+	case p.LambdaConfig != nil:
+		awsAPIClient = p.LambdaConfig.AwsAPIClient
+	case p.ECSConfig != nil:
+		awsAPIClient = p.ECSConfig.AwsAPIClient
+	}
+	if awsAPIClient != nil {
+		if err := awsAPIClient.Validate(); err != nil {
+			return fmt.Errorf("platform provider %s: %w", p.Name, err)
+		}
+	}
+	if p.KubernetesConfig != nil {
+		for _, r := range p.KubernetesConfig.AppStateInformer.HealthRules {
+			if err := r.Validate(); err != nil {
+				return fmt.Errorf("platform provider %s: invalid health rule: %w", p.Name, err)
+			}
+		}
+	}
+	if p.TerraformConfig != nil {
+		if err := p.TerraformConfig.Validate(); err != nil {
+			return fmt.Errorf("platform provider %s: %w", p.Name, err)
+		}
+	}
+	return nil
+}
+
 func (p *PipedPlatformProvider) Mask() {
 	if p.CloudRunConfig != nil {
 		p.CloudRunConfig.Mask()
@@ -650,6 +769,9 @@ type PlatformProviderKubernetesConfig struct {
 	AppStateInformer KubernetesAppStateInformer `json:"appStateInformer"`
 	// Version of kubectl will be used.
 	KubectlVersion string `json:"kubectlVersion"`
+	// The options to apply manifests by server-side apply.
+	// This can be overridden by the application configuration.
+	ServerSideApply *KubernetesServerSideApply `json:"serverSideApply,omitempty"`
 }
 
 type KubernetesAppStateInformer struct {
@@ -660,6 +782,72 @@ type KubernetesAppStateInformer struct {
 	IncludeResources []KubernetesResourceMatcher `json:"includeResources,omitempty"`
 	// List of resources that should be ignored from the watching targets.
 	ExcludeResources []KubernetesResourceMatcher `json:"excludeResources,omitempty"`
+	// List of rules to determine the health status of the custom resources.
+	HealthRules []KubernetesHealthRule `json:"healthRules,omitempty"`
+}
+
+const (
+	KubernetesHealthy   = "HEALTHY"
+	KubernetesUnhealthy = "UNHEALTHY"
+)
+
+// KubernetesHealthRule represents how to determine the health status
+// of the resources of a custom resource kind in the live state.
+type KubernetesHealthRule struct {
+	// The APIVersion of the custom resource. e.g. argoproj.io/v1alpha1
+	APIVersion string `json:"apiVersion"`
+	// The kind name of the custom resource. e.g. Rollout
+	Kind string `json:"kind"`
+	// List of conditions evaluated in order.
+	// The first matched one determines the health status.
+	// The health status is unknown when no condition matched.
+	Conditions []KubernetesHealthCondition `json:"conditions"`
+}
+
+// KubernetesHealthCondition matches a resource when the value found by its JSONPath
+// is one of the specified values.
+type KubernetesHealthCondition struct {
+	// The JSONPath template to extract the value from the resource.
+	// e.g. {.status.phase} or {.status.conditions[?(@.type=="Ready")].status}
+	JSONPath string `json:"jsonPath"`
+	// List of the values matching this condition.
+	Values []string `json:"values"`
+	// The health status of the matched resource. HEALTHY or UNHEALTHY.
+	Health string `json:"health"`
+	// The description shown along with the health status.
+	// Empty means the extracted value is shown.
+	Message string `json:"message,omitempty"`
+}
+
+func (r *KubernetesHealthRule) Validate() error {
+	if r.APIVersion == "" || r.Kind == "" {
+		return errors.New("both apiVersion and kind must be set")
+	}
+	if len(r.Conditions) == 0 {
+		return fmt.Errorf("at least one condition must be set for %s/%s", r.APIVersion, r.Kind)
+	}
+	for i, c := range r.Conditions {
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("invalid condition %d for %s/%s: %w", i, r.APIVersion, r.Kind, err)
+		}
+	}
+	return nil
+}
+
+func (c *KubernetesHealthCondition) Validate() error {
+	if c.JSONPath == "" {
+		return errors.New("jsonPath must be set")
+	}
+	if err := jsonpath.New("").Parse(c.JSONPath); err != nil {
+		return fmt.Errorf("invalid jsonPath %q: %w", c.JSONPath, err)
+	}
+	if len(c.Values) == 0 {
+		return errors.New("at least one value must be set")
+	}
+	if c.Health != KubernetesHealthy && c.Health != KubernetesUnhealthy {
+		return fmt.Errorf("health must be either %s or %s", KubernetesHealthy, KubernetesUnhealthy)
+	}
+	return nil
 }
 
 type KubernetesResourceMatcher struct {
@@ -680,8 +868,59 @@ type PlatformProviderTerraformConfig struct {
 	// Enable drift detection.
 	// TODO: This is a temporary option because Terraform drift detection is buggy and has performance issues. This will be possibly removed in the future release.
 	DriftDetectionEnabled *bool `json:"driftDetectionEnabled" default:"true"`
+	// Configuration for sharing the downloaded provider plugins across deployments.
+	PluginCache *TerraformPluginCache `json:"pluginCache,omitempty"`
+	// The tags that should be attached to all resources managed by the applications.
+	// They are passed as a map to the variable named by DefaultTagsVar,
+	// e.g. to be used in the default_tags block of the AWS provider.
+	// See ResourceTags for the available placeholders.
+	DefaultTags ResourceTags `json:"defaultTags,omitempty"`
+	// The name of the terraform variable receiving DefaultTags.
+	// The variable must be declared by every application using this platform provider.
+	DefaultTagsVar string `json:"defaultTagsVar,omitempty"`
+}
+
+func (c *PlatformProviderTerraformConfig) Validate() error {
+	if len(c.DefaultTags) > 0 && c.DefaultTagsVar == "" {
+		return errors.New("defaultTagsVar must be set to pass defaultTags")
+	}
+	if c.PluginCache != nil {
+		return c.PluginCache.Validate()
+	}
+	return nil
 }
 
+type TerraformPluginCache struct {
+	// Whether to reuse the provider plugins downloaded by terraform init of the previous deployments.
+	Enabled bool `json:"enabled"`
+	// The directory to store the provider plugins.
+	// Default is ~/.piped/terraform-plugin-cache.
+	Dir string `json:"dir,omitempty"`
+	// The maximum total size of the cached provider plugins, e.g. "2Gi".
+	// The least recently used plugins are removed when it is exceeded.
+	// Default is 2Gi.
+	MaxSize string `json:"maxSize,omitempty" default:"2Gi"`
+}
+
+func (c *TerraformPluginCache) Validate() error {
+	if _, err := parseQuota(c.MaxSize); err != nil {
+		return fmt.Errorf("invalid pluginCache.maxSize: %w", err)
+	}
+	return nil
+}
+
+// MaxSizeBytes returns the maximum total size of the cached provider plugins in bytes.
+func (c *TerraformPluginCache) MaxSizeBytes() int64 {
+	v, _ := parseQuota(c.MaxSize)
+	return v
+}
+
+// ResourceTags is a set of tags (or labels) attached to the cloud resources
+// created by piped, mainly for cost allocation and traceability.
+// The values can contain the following placeholders which are replaced at deployment time:
+// ${APP_ID}, ${APP_NAME}, ${PIPED_ID} and ${COMMIT_HASH}.
+type ResourceTags map[string]string
+
 type PlatformProviderCloudRunConfig struct {
 	// The GCP project hosting the CloudRun service.
 	Project string `json:"project"`
@@ -689,6 +928,10 @@ type PlatformProviderCloudRunConfig struct {
 	Region string `json:"region"`
 	// The path to the service account file for accessing CloudRun service.
 	CredentialsFile string `json:"credentialsFile,omitempty"`
+	// The labels that should be attached to all services and revisions deployed by piped.
+	// Labels defined in the service manifest take precedence over these.
+	// See ResourceTags for the available placeholders.
+	DefaultLabels ResourceTags `json:"defaultLabels,omitempty"`
 }
 
 func (c *PlatformProviderCloudRunConfig) Mask() {
@@ -718,6 +961,13 @@ type PlatformProviderLambdaConfig struct {
 	// Default is 15s.
 	// To reduce AWS API calls, this interval should be larger.
 	AwsAPIPollingInterval Duration `json:"awsAPIPollingInterval,omitempty" default:"15s"`
+	// Configuration for retrying and rate limiting the calls of AWS APIs.
+	// If empty, the default retry policy of AWS SDK is used without rate limiting.
+	AwsAPIClient *AWSAPIClientConfig `json:"awsAPIClient,omitempty"`
+	// The tags that should be attached to all functions deployed by piped.
+	// Tags defined in the function manifest take precedence over these.
+	// See ResourceTags for the available placeholders.
+	DefaultTags ResourceTags `json:"defaultTags,omitempty"`
 }
 
 func (c *PlatformProviderLambdaConfig) Mask() {
@@ -747,6 +997,13 @@ type PlatformProviderECSConfig struct {
 	// If empty, the environment variable "AWS_PROFILE" is used.
 	// "default" is populated if the environment variable is also not set.
 	Profile string `json:"profile,omitempty"`
+	// Configuration for retrying and rate limiting the calls of AWS APIs.
+	// If empty, the default retry policy of AWS SDK is used without rate limiting.
+	AwsAPIClient *AWSAPIClientConfig `json:"awsAPIClient,omitempty"`
+	// The tags that should be attached to all services, task sets and tasks created by piped.
+	// Tags defined in the service definition take precedence over these.
+	// See ResourceTags for the available placeholders.
+	DefaultTags ResourceTags `json:"defaultTags,omitempty"`
 }
 
 func (c *PlatformProviderECSConfig) Mask() {
@@ -761,6 +1018,49 @@ func (c *PlatformProviderECSConfig) Mask() {
 	}
 }
 
+// AWSAPIClientConfig represents the retry policy and the client-side rate limiting
+// applied to all AWS API calls sent by a platform provider.
+type AWSAPIClientConfig struct {
+	// The maximum number of attempts for each API call, including the first one.
+	// Default is 3.
+	MaxAttempts int `json:"maxAttempts,omitempty" default:"3"`
+	// The maximum backoff duration between two attempts.
+	// The actual duration is randomized by exponential backoff with full jitter.
+	// Default is 20s.
+	MaxBackoff Duration `json:"maxBackoff,omitempty" default:"20s"`
+	// The capacity of the retry quota shared by all API calls of the platform provider.
+	// Each retry consumes 5 tokens (10 for timeouts) and each call succeeded without retrying
+	// gives back 1 token. Once the quota is exhausted, calls fail fast without retrying
+	// until enough calls succeed again.
+	// Default is 500.
+	RetryQuota int `json:"retryQuota,omitempty" default:"500"`
+	// The maximum number of API calls per second.
+	// Zero means no limit.
+	RateLimit float64 `json:"rateLimit,omitempty"`
+	// The maximum number of API calls that can be sent at once when the rate limit is enabled.
+	// Default is 10.
+	RateLimitBurst int `json:"rateLimitBurst,omitempty" default:"10"`
+}
+
+func (c *AWSAPIClientConfig) Validate() error {
+	if c.MaxAttempts <= 0 {
+		return errors.New("awsAPIClient.maxAttempts must be greater than 0")
+	}
+	if c.MaxBackoff < 0 {
+		return errors.New("awsAPIClient.maxBackoff must be greater than or equal to 0")
+	}
+	if c.RetryQuota <= 0 {
+		return errors.New("awsAPIClient.retryQuota must be greater than 0")
+	}
+	if c.RateLimit < 0 {
+		return errors.New("awsAPIClient.rateLimit must be greater than or equal to 0")
+	}
+	if c.RateLimit > 0 && c.RateLimitBurst <= 0 {
+		return errors.New("awsAPIClient.rateLimitBurst must be greater than 0 when rateLimit is set")
+	}
+	return nil
+}
+
 type PipedAnalysisProvider struct {
 	Name string                     `json:"name"`
 	Type model.AnalysisProviderType `json:"type"`
@@ -949,6 +1249,34 @@ type Notifications struct {
 	Routes []NotificationRoute `json:"routes,omitempty"`
 	// List of notification receivers.
 	Receivers []NotificationReceiver `json:"receivers,omitempty"`
+	// The Slack IDs of the code owners mentioned by the applications enabling notification.codeOwners.
+	CodeOwners NotificationCodeOwnerAccounts `json:"codeOwners,omitempty"`
+}
+
+// NotificationCodeOwnerAccounts maps the owners written in CODEOWNERS files,
+// such as @user, @org/team and user@example.com, to their Slack IDs.
+type NotificationCodeOwnerAccounts struct {
+	// Map from owner to Slack user ID.
+	SlackUsers map[string]string `json:"slackUsers,omitempty"`
+	// Map from owner to Slack group ID.
+	SlackGroups map[string]string `json:"slackGroups,omitempty"`
+}
+
+// FindSlackAccounts returns the Slack user and group IDs of the given owners.
+// The owners not having any Slack ID are returned as unknown.
+func (a NotificationCodeOwnerAccounts) FindSlackAccounts(owners []string) (users, groups, unknown []string) {
+	for _, o := range owners {
+		if u, ok := a.SlackUsers[o]; ok {
+			users = append(users, u)
+			continue
+		}
+		if g, ok := a.SlackGroups[o]; ok {
+			groups = append(groups, g)
+			continue
+		}
+		unknown = append(unknown, o)
+	}
+	return
 }
 
 func (n *Notifications) Mask() {
@@ -1255,6 +1583,73 @@ func (s *SecretManagementGCPKMS) Mask() {
 	}
 }
 
+type PipedDiskUsage struct {
+	// How often to check the disk usage.
+	// Default is 10m.
+	CheckInterval Duration `json:"checkInterval,omitempty" default:"10m"`
+	// The minimum time since an entry was last used before it can be evicted.
+	// This prevents evicting the entries being used by running deployments.
+	// Default is 1h.
+	MinEntryAge Duration `json:"minEntryAge,omitempty" default:"1h"`
+	// The maximum total size of the cached git repositories. e.g. 5Gi
+	// The repositories used by the existing git worktrees are never evicted.
+	// Empty means no limit.
+	GitCacheQuota string `json:"gitCacheQuota,omitempty"`
+	// The maximum total size of the cached Helm chart repositories. e.g. 1Gi
+	// Empty means no limit.
+	HelmCacheQuota string `json:"helmCacheQuota,omitempty"`
+	// The maximum total size of the temporary working directories
+	// such as the git worktrees, plan-preview and drift detection artifacts. e.g. 5Gi
+	// The workspaces of the running deployments are not counted.
+	// Empty means no limit.
+	WorkspaceQuota string `json:"workspaceQuota,omitempty"`
+}
+
+func (d *PipedDiskUsage) Validate() error {
+	if d.CheckInterval <= 0 {
+		return errors.New("diskUsage.checkInterval must be greater than 0")
+	}
+	if d.MinEntryAge < 0 {
+		return errors.New("diskUsage.minEntryAge must be greater than or equal to 0")
+	}
+	for name, q := range map[string]string{
+		"gitCacheQuota":  d.GitCacheQuota,
+		"helmCacheQuota": d.HelmCacheQuota,
+		"workspaceQuota": d.WorkspaceQuota,
+	} {
+		if _, err := parseQuota(q); err != nil {
+			return fmt.Errorf("invalid diskUsage.%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Quotas returns the quotas in bytes. Zero means no limit.
+func (d *PipedDiskUsage) Quotas() (gitCache, helmCache, workspace int64, err error) {
+	if gitCache, err = parseQuota(d.GitCacheQuota); err != nil {
+		return
+	}
+	if helmCache, err = parseQuota(d.HelmCacheQuota); err != nil {
+		return
+	}
+	workspace, err = parseQuota(d.WorkspaceQuota)
+	return
+}
+
+func parseQuota(q string) (int64, error) {
+	if q == "" {
+		return 0, nil
+	}
+	v, err := resource.ParseQuantity(q)
+	if err != nil {
+		return 0, err
+	}
+	if v.Sign() <= 0 {
+		return 0, fmt.Errorf("quota must be greater than 0: %s", q)
+	}
+	return v.Value(), nil
+}
+
 type PipedEventWatcher struct {
 	// Interval to fetch the latest event and compare it with one defined in EventWatcher config files
 	CheckInterval Duration `json:"checkInterval,omitempty"`
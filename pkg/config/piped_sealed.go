@@ -0,0 +1,148 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/pipe-cd/pipecd/pkg/crypto"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// sealedValueKey is the key of the object used to specify a sealed value
+// instead of a plaintext string in the piped configuration. e.g.
+//
+//	password:
+//	  sealedValue: AgBQm...
+const sealedValueKey = "sealedValue"
+
+// unsealPipedConfig replaces all sealed values in the given piped configuration
+// with the plaintexts decrypted by using the key pair configured in its secretManagement.
+// The given data is returned as is when it is not a piped configuration or has no sealed value.
+func unsealPipedConfig(js []byte) ([]byte, error) {
+	// Decode numbers as json.Number to re-encode them without changing their formats.
+	var raw map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(js))
+	d.UseNumber()
+	if err := d.Decode(&raw); err != nil {
+		return nil, err
+	}
+	spec, ok := raw["spec"].(map[string]interface{})
+	if !ok || raw["kind"] != string(KindPiped) || !hasSealedValue(spec) {
+		return js, nil
+	}
+
+	if hasSealedValue(spec["secretManagement"]) {
+		return nil, errors.New("secretManagement cannot contain sealed values")
+	}
+	decrypter, err := newSealedValueDecrypter(spec["secretManagement"])
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range spec {
+		unsealed, err := unsealValue(v, decrypter, "spec."+k)
+		if err != nil {
+			return nil, err
+		}
+		spec[k] = unsealed
+	}
+	return json.Marshal(raw)
+}
+
+func newSealedValueDecrypter(sm interface{}) (crypto.Decrypter, error) {
+	if sm == nil {
+		return nil, errors.New("secretManagement must be configured to use sealed values")
+	}
+	data, err := json.Marshal(sm)
+	if err != nil {
+		return nil, err
+	}
+	s := &SecretManagement{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("invalid secretManagement: %w", err)
+	}
+	if s.Type != model.SecretManagementTypeKeyPair {
+		return nil, fmt.Errorf("sealed values are only available with secretManagement of type %s", model.SecretManagementTypeKeyPair)
+	}
+	key, err := s.KeyPair.LoadPrivateKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the private key to decrypt sealed values: %w", err)
+	}
+	return crypto.NewHybridDecrypter(key)
+}
+
+// unsealValue returns the given value with all sealed values inside it decrypted.
+// The path is used to point out the invalid sealed value in the error message.
+func unsealValue(v interface{}, decrypter crypto.Decrypter, path string) (interface{}, error) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if sealed, ok := t[sealedValueKey]; ok && len(t) == 1 {
+			s, ok := sealed.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s.%s must be a string", path, sealedValueKey)
+			}
+			plain, err := decrypter.Decrypt(s)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decrypt the sealed value of %s: %w", path, err)
+			}
+			return plain, nil
+		}
+		for k, e := range t {
+			unsealed, err := unsealValue(e, decrypter, path+"."+k)
+			if err != nil {
+				return nil, err
+			}
+			t[k] = unsealed
+		}
+		return t, nil
+
+	case []interface{}:
+		for i, e := range t {
+			unsealed, err := unsealValue(e, decrypter, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			t[i] = unsealed
+		}
+		return t, nil
+
+	default:
+		return v, nil
+	}
+}
+
+func hasSealedValue(v interface{}) bool {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if _, ok := t[sealedValueKey]; ok && len(t) == 1 {
+			return true
+		}
+		for _, e := range t {
+			if hasSealedValue(e) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, e := range t {
+			if hasSealedValue(e) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/crypto"
+)
+
+func TestDecodeYAMLWithSealedValues(t *testing.T) {
+	t.Parallel()
+
+	private, public, err := crypto.GenerateRSAPems(crypto.DefauleRSAKeySize)
+	require.NoError(t, err)
+	encrypter, err := crypto.NewHybridEncrypter(public)
+	require.NoError(t, err)
+
+	seal := func(v string) string {
+		sealed, err := encrypter.Encrypt(v)
+		require.NoError(t, err)
+		return sealed
+	}
+	secretManagement := fmt.Sprintf(`
+  secretManagement:
+    type: KEY_PAIR
+    config:
+      privateKeyData: %s
+      publicKeyData: %s
+`, base64.StdEncoding.EncodeToString(private), base64.StdEncoding.EncodeToString(public))
+
+	testcases := []struct {
+		name    string
+		data    string
+		check   func(t *testing.T, s *PipedSpec)
+		wantErr bool
+	}{
+		{
+			name: "sealed values are decrypted",
+			data: fmt.Sprintf(`
+apiVersion: pipecd.dev/v1beta1
+kind: Piped
+spec:
+  projectID: test-project
+  pipedID: test-piped
+  pipedKeyData: %s
+  apiAddress: localhost:9091
+  syncInterval: 1m
+  git:
+    username: pipecd
+    password:
+      sealedValue: %s
+  analysisProviders:
+    - name: datadog
+      type: DATADOG
+      config:
+        address: https://your-datadog.dev
+        apiKeyData:
+          sealedValue: %s
+        applicationKeyData: plain-application-key
+%s`, base64.StdEncoding.EncodeToString([]byte("piped-key")), seal("git-password"), seal("datadog-api-key"), secretManagement),
+			check: func(t *testing.T, s *PipedSpec) {
+				assert.Equal(t, "git-password", s.Git.Password)
+				require.Len(t, s.AnalysisProviders, 1)
+				assert.Equal(t, "datadog-api-key", s.AnalysisProviders[0].DatadogConfig.APIKeyData)
+				assert.Equal(t, "plain-application-key", s.AnalysisProviders[0].DatadogConfig.ApplicationKeyData)
+				assert.Equal(t, Duration(60_000_000_000), s.SyncInterval)
+			},
+		},
+		{
+			name: "no secret management",
+			data: fmt.Sprintf(`
+apiVersion: pipecd.dev/v1beta1
+kind: Piped
+spec:
+  projectID: test-project
+  pipedID: test-piped
+  pipedKeyData:
+    sealedValue: %s
+  apiAddress: localhost:9091
+`, seal("piped-key")),
+			wantErr: true,
+		},
+		{
+			name: "invalid sealed value",
+			data: fmt.Sprintf(`
+apiVersion: pipecd.dev/v1beta1
+kind: Piped
+spec:
+  projectID: test-project
+  pipedID: test-piped
+  pipedKeyData:
+    sealedValue: invalid
+  apiAddress: localhost:9091
+%s`, secretManagement),
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := DecodeYAML([]byte(tc.data))
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			tc.check(t, cfg.PipedSpec)
+		})
+	}
+}
@@ -16,6 +16,8 @@ package config
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -112,6 +114,10 @@ func TestPipedConfig(t *testing.T) {
 									},
 								},
 							},
+							ServerSideApply: &KubernetesServerSideApply{
+								Enabled:      true,
+								FieldManager: "pipecd",
+							},
 						},
 					},
 					{
@@ -1519,3 +1525,294 @@ func TestPipeGitValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestPipedDiskUsageValidate(t *testing.T) {
+	testcases := []struct {
+		name      string
+		diskUsage PipedDiskUsage
+		wantErr   bool
+	}{
+		{
+			name: "valid",
+			diskUsage: PipedDiskUsage{
+				CheckInterval:  Duration(10 * time.Minute),
+				MinEntryAge:    Duration(time.Hour),
+				GitCacheQuota:  "5Gi",
+				WorkspaceQuota: "500Mi",
+			},
+		},
+		{
+			name:      "invalid check interval",
+			diskUsage: PipedDiskUsage{},
+			wantErr:   true,
+		},
+		{
+			name: "invalid quota",
+			diskUsage: PipedDiskUsage{
+				CheckInterval:  Duration(10 * time.Minute),
+				HelmCacheQuota: "1Gb",
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative quota",
+			diskUsage: PipedDiskUsage{
+				CheckInterval: Duration(10 * time.Minute),
+				GitCacheQuota: "-1Gi",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.diskUsage.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestPipedDiskUsageQuotas(t *testing.T) {
+	d := PipedDiskUsage{
+		GitCacheQuota:  "1Gi",
+		WorkspaceQuota: "100M",
+	}
+	git, helm, workspace, err := d.Quotas()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1<<30), git)
+	assert.Equal(t, int64(0), helm)
+	assert.Equal(t, int64(100_000_000), workspace)
+}
+
+func TestAWSAPIClientConfigValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		cfg     AWSAPIClientConfig
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			cfg: AWSAPIClientConfig{
+				MaxAttempts:    3,
+				MaxBackoff:     Duration(20 * time.Second),
+				RetryQuota:     500,
+				RateLimit:      5,
+				RateLimitBurst: 10,
+			},
+		},
+		{
+			name: "valid without rate limit",
+			cfg: AWSAPIClientConfig{
+				MaxAttempts: 3,
+				RetryQuota:  500,
+			},
+		},
+		{
+			name: "invalid max attempts",
+			cfg: AWSAPIClientConfig{
+				RetryQuota: 500,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid retry quota",
+			cfg: AWSAPIClientConfig{
+				MaxAttempts: 3,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing burst",
+			cfg: AWSAPIClientConfig{
+				MaxAttempts: 3,
+				RetryQuota:  500,
+				RateLimit:   5,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestHelmChartRegistryValidate(t *testing.T) {
+	testcases := []struct {
+		name     string
+		registry HelmChartRegistry
+		wantErr  bool
+	}{
+		{
+			name:     "valid with inline credentials",
+			registry: HelmChartRegistry{Type: OCIHelmChartRegistry, Address: "registry.example.com", Username: "user", Password: "pass"},
+		},
+		{
+			name:     "valid with credential files",
+			registry: HelmChartRegistry{Type: OCIHelmChartRegistry, Address: "registry.example.com", Username: "AWS", PasswordFile: "/etc/ecr/token"},
+		},
+		{
+			name:     "missing address",
+			registry: HelmChartRegistry{Type: OCIHelmChartRegistry},
+			wantErr:  true,
+		},
+		{
+			name:     "both password and passwordFile",
+			registry: HelmChartRegistry{Type: OCIHelmChartRegistry, Address: "registry.example.com", Password: "pass", PasswordFile: "/etc/ecr/token"},
+			wantErr:  true,
+		},
+		{
+			name:     "unsupported type",
+			registry: HelmChartRegistry{Type: "foo", Address: "registry.example.com"},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.registry.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestHelmChartRegistryLoadCredentials(t *testing.T) {
+	dir := t.TempDir()
+	usernameFile := filepath.Join(dir, "username")
+	passwordFile := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(usernameFile, []byte("oauth2accesstoken\n"), 0600))
+	require.NoError(t, os.WriteFile(passwordFile, []byte("token\n"), 0600))
+
+	r := HelmChartRegistry{Type: OCIHelmChartRegistry, Address: "asia-docker.pkg.dev", UsernameFile: usernameFile, PasswordFile: passwordFile}
+	require.True(t, r.HasCredentials())
+	username, password, err := r.LoadCredentials()
+	require.NoError(t, err)
+	assert.Equal(t, "oauth2accesstoken", username)
+	assert.Equal(t, "token", password)
+
+	r = HelmChartRegistry{Type: OCIHelmChartRegistry, Address: "asia-docker.pkg.dev", Username: "user", PasswordFile: filepath.Join(dir, "missing")}
+	_, _, err = r.LoadCredentials()
+	assert.Error(t, err)
+
+	r = HelmChartRegistry{Type: OCIHelmChartRegistry, Address: "asia-docker.pkg.dev", Username: "user"}
+	assert.False(t, r.HasCredentials())
+}
+
+func TestIsInsecureChartRepository(t *testing.T) {
+	s := PipedSpec{
+		ChartRepositories: []HelmChartRepository{
+			{Type: HTTPHelmChartRepository, Name: "insecure", Insecure: true},
+			{Type: HTTPHelmChartRepository, Name: "secure"},
+		},
+		ChartRegistries: []HelmChartRegistry{
+			{Type: OCIHelmChartRegistry, Address: "harbor.internal", Insecure: true},
+			{Type: OCIHelmChartRegistry, Address: "registry.example.com"},
+		},
+	}
+
+	assert.True(t, s.IsInsecureChartRepository("insecure"))
+	assert.False(t, s.IsInsecureChartRepository("secure"))
+	assert.True(t, s.IsInsecureChartRepository("oci://harbor.internal/charts"))
+	assert.True(t, s.IsInsecureChartRepository("oci://harbor.internal"))
+	assert.False(t, s.IsInsecureChartRepository("oci://harbor.internal.example.com/charts"))
+	assert.False(t, s.IsInsecureChartRepository("oci://registry.example.com/charts"))
+	assert.False(t, s.IsInsecureChartRepository("harbor.internal"))
+}
+
+func TestKubernetesHealthRuleValidate(t *testing.T) {
+	condition := KubernetesHealthCondition{
+		JSONPath: "{.status.phase}",
+		Values:   []string{"Healthy"},
+		Health:   KubernetesHealthy,
+	}
+	testcases := []struct {
+		name    string
+		rule    KubernetesHealthRule
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			rule:    KubernetesHealthRule{APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout", Conditions: []KubernetesHealthCondition{condition}},
+			wantErr: false,
+		},
+		{
+			name:    "missing kind",
+			rule:    KubernetesHealthRule{APIVersion: "argoproj.io/v1alpha1", Conditions: []KubernetesHealthCondition{condition}},
+			wantErr: true,
+		},
+		{
+			name:    "missing conditions",
+			rule:    KubernetesHealthRule{APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout"},
+			wantErr: true,
+		},
+		{
+			name: "invalid jsonPath",
+			rule: KubernetesHealthRule{APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout", Conditions: []KubernetesHealthCondition{
+				{JSONPath: "{.status.phase", Values: []string{"Healthy"}, Health: KubernetesHealthy},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "missing values",
+			rule: KubernetesHealthRule{APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout", Conditions: []KubernetesHealthCondition{
+				{JSONPath: "{.status.phase}", Health: KubernetesHealthy},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "unknown health",
+			rule: KubernetesHealthRule{APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout", Conditions: []KubernetesHealthCondition{
+				{JSONPath: "{.status.phase}", Values: []string{"Healthy"}, Health: "PROGRESSING"},
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rule.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestPlatformProviderTerraformConfigValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		cfg     PlatformProviderTerraformConfig
+		wantErr bool
+	}{
+		{
+			name:    "no default tags",
+			cfg:     PlatformProviderTerraformConfig{},
+			wantErr: false,
+		},
+		{
+			name: "default tags with variable",
+			cfg: PlatformProviderTerraformConfig{
+				DefaultTags:    ResourceTags{"owner": "platform"},
+				DefaultTagsVar: "default_tags",
+			},
+			wantErr: false,
+		},
+		{
+			name: "default tags without variable",
+			cfg: PlatformProviderTerraformConfig{
+				DefaultTags: ResourceTags{"owner": "platform"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid plugin cache size",
+			cfg: PlatformProviderTerraformConfig{
+				PluginCache: &TerraformPluginCache{Enabled: true, MaxSize: "abc"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// TrafficRoutingSteps configures a traffic routing stage to shift the traffic to CANARY variant
+// progressively. Each step advances to the next one after its interval elapses,
+// or only when its analysis passes if it has one.
+type TrafficRoutingSteps struct {
+	// The list of steps to shift the traffic to CANARY variant progressively within this stage.
+	// When specified, the percentage fields of the stage are ignored.
+	Steps []TrafficRoutingStep `json:"steps,omitempty"`
+	// How long to wait after each step before proceeding to the next one.
+	// This is not applied after the last step.
+	// Default is 5m.
+	StepInterval Duration `json:"stepInterval,omitempty" default:"5m"`
+}
+
+// TrafficRoutingStep represents a step of the progressive traffic shifting.
+type TrafficRoutingStep struct {
+	// The amount of traffic that CANARY variant will serve at this step.
+	Canary Percentage `json:"canary"`
+	// How long to wait after this step before proceeding to the next one.
+	// Empty means stepInterval is used.
+	Interval Duration `json:"interval,omitempty"`
+	// The analysis to run after this step instead of waiting for the interval.
+	// When it fails, the stage fails and the deployment is rolled back.
+	Analysis *AnalysisStageOptions `json:"analysis,omitempty"`
+}
+
+func (s *TrafficRoutingSteps) validate() error {
+	if s.StepInterval < 0 {
+		return errors.New("stepInterval must not be negative")
+	}
+	for i, step := range s.Steps {
+		if c := step.Canary.Int(); c < 0 || c > 100 {
+			return fmt.Errorf("canary of step %d must be between 0 and 100", i+1)
+		}
+		if step.Interval < 0 {
+			return fmt.Errorf("interval of step %d must not be negative", i+1)
+		}
+		if step.Analysis != nil {
+			if err := step.Analysis.Validate(); err != nil {
+				return fmt.Errorf("analysis of step %d is invalid: %w", i+1, err)
+			}
+		}
+	}
+	return nil
+}
+
+// StepIntervalAt returns how long to wait after the step at the given index.
+func (s *TrafficRoutingSteps) StepIntervalAt(i int) time.Duration {
+	if d := s.Steps[i].Interval; d > 0 {
+		return d.Duration()
+	}
+	return s.StepInterval.Duration()
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrafficRoutingStepsValidate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		steps   TrafficRoutingSteps
+		wantErr bool
+	}{
+		{
+			name: "no steps",
+		},
+		{
+			name: "valid steps",
+			steps: TrafficRoutingSteps{
+				StepInterval: Duration(5 * time.Minute),
+				Steps: []TrafficRoutingStep{
+					{Canary: Percentage{Number: 10}},
+					{Canary: Percentage{Number: 30}, Analysis: &AnalysisStageOptions{Duration: Duration(10 * time.Minute)}},
+					{Canary: Percentage{Number: 100}},
+				},
+			},
+		},
+		{
+			name: "out of range canary",
+			steps: TrafficRoutingSteps{
+				Steps: []TrafficRoutingStep{{Canary: Percentage{Number: 150}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative step interval",
+			steps: TrafficRoutingSteps{
+				StepInterval: Duration(-time.Minute),
+				Steps:        []TrafficRoutingStep{{Canary: Percentage{Number: 10}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative interval of step",
+			steps: TrafficRoutingSteps{
+				Steps: []TrafficRoutingStep{{Canary: Percentage{Number: 10}, Interval: Duration(-time.Minute)}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "analysis without duration",
+			steps: TrafficRoutingSteps{
+				Steps: []TrafficRoutingStep{{Canary: Percentage{Number: 10}, Analysis: &AnalysisStageOptions{}}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.steps.validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestTrafficRoutingStepsStepIntervalAt(t *testing.T) {
+	steps := TrafficRoutingSteps{
+		StepInterval: Duration(5 * time.Minute),
+		Steps: []TrafficRoutingStep{
+			{Canary: Percentage{Number: 10}},
+			{Canary: Percentage{Number: 30}, Interval: Duration(time.Minute)},
+		},
+	}
+	assert.Equal(t, 5*time.Minute, steps.StepIntervalAt(0))
+	assert.Equal(t, time.Minute, steps.StepIntervalAt(1))
+}
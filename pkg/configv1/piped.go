@@ -42,6 +42,9 @@ type PipedSpec struct {
 	PipedKeyData string `json:"pipedKeyData,omitempty"`
 	// The name of this piped.
 	Name string `json:"name,omitempty"`
+	// Labels of this piped reported to the control-plane.
+	// They can be used to select a subset of pipeds, e.g. while upgrading them gradually.
+	Labels map[string]string `json:"labels,omitempty"`
 	// The address used to connect to the control-plane's API.
 	APIAddress string `json:"apiAddress"`
 	// The address to the control-plane's Web.
@@ -196,8 +196,10 @@ type ApplicationStore interface {
 	UpdateConfigFilename(ctx context.Context, id, configFilename string) error
 	UpdateDeployingStatus(ctx context.Context, id string, deploying bool) error
 	UpdateBasicInfo(ctx context.Context, id, name, description string, labels map[string]string) error
+	UpdateLabels(ctx context.Context, id string, add map[string]string, remove []string) error
 	UpdateConfiguration(ctx context.Context, id, pipedID, platformProvider, configFilename string, deployTargetsByPlugin map[string]*model.DeployTargets) error
 	UpdatePlatformProvider(ctx context.Context, id string, provider string) error
+	UpdatePiped(ctx context.Context, id, pipedID, platformProvider string) error
 	UpdateDeployTargets(ctx context.Context, id string, dp map[string]*model.DeployTargets) error
 }
 
@@ -362,6 +364,13 @@ func (s *applicationStore) UpdateBasicInfo(ctx context.Context, id, name, descri
 	})
 }
 
+func (s *applicationStore) UpdateLabels(ctx context.Context, id string, add map[string]string, remove []string) error {
+	return s.update(ctx, id, func(app *model.Application) error {
+		app.Labels, _ = model.ApplyLabelChanges(app.Labels, add, remove)
+		return nil
+	})
+}
+
 func (s *applicationStore) UpdateConfiguration(ctx context.Context, id, pipedID, platformProvider, configFilename string, deployTargetsByPlugin map[string]*model.DeployTargets) error {
 	return s.update(ctx, id, func(app *model.Application) error {
 		app.PipedId = pipedID
@@ -380,6 +389,24 @@ func (s *applicationStore) UpdatePlatformProvider(ctx context.Context, id string
 	})
 }
 
+// UpdatePiped moves the application to the given piped.
+// The references to its most recent deployments are kept as is,
+// so the new piped continues from the commit deployed by the previous one.
+// An empty platformProvider keeps the current one.
+func (s *applicationStore) UpdatePiped(ctx context.Context, id, pipedID, platformProvider string) error {
+	return s.update(ctx, id, func(app *model.Application) error {
+		if app.Deploying {
+			return fmt.Errorf("cannot move a deploying application to another piped: %w", ErrInvalidArgument)
+		}
+		app.PipedId = pipedID
+		if platformProvider != "" {
+			app.PlatformProvider = platformProvider
+			app.CloudProvider = platformProvider
+		}
+		return nil
+	})
+}
+
 func (s *applicationStore) UpdateDeployTargets(ctx context.Context, id string, dp map[string]*model.DeployTargets) error {
 	return s.update(ctx, id, func(app *model.Application) error {
 		app.DeployTargetsByPlugin = dp
@@ -380,17 +380,17 @@ func (mr *MockPipedStoreMockRecorder) UpdateInfo(ctx, id, name, desc any) *gomoc
 }
 
 // UpdateMetadata mocks base method.
-func (m *MockPipedStore) UpdateMetadata(ctx context.Context, id, version, config string, pps []*model.Piped_PlatformProvider, pls []*model.Piped_Plugin, repos []*model.ApplicationGitRepository, se *model.Piped_SecretEncryption, startedAt int64) error {
+func (m *MockPipedStore) UpdateMetadata(ctx context.Context, id, version, config string, pps []*model.Piped_PlatformProvider, pls []*model.Piped_Plugin, repos []*model.ApplicationGitRepository, se *model.Piped_SecretEncryption, labels map[string]string, startedAt int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateMetadata", ctx, id, version, config, pps, pls, repos, se, startedAt)
+	ret := m.ctrl.Call(m, "UpdateMetadata", ctx, id, version, config, pps, pls, repos, se, labels, startedAt)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateMetadata indicates an expected call of UpdateMetadata.
-func (mr *MockPipedStoreMockRecorder) UpdateMetadata(ctx, id, version, config, pps, pls, repos, se, startedAt any) *gomock.Call {
+func (mr *MockPipedStoreMockRecorder) UpdateMetadata(ctx, id, version, config, pps, pls, repos, se, labels, startedAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMetadata", reflect.TypeOf((*MockPipedStore)(nil).UpdateMetadata), ctx, id, version, config, pps, pls, repos, se, startedAt)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMetadata", reflect.TypeOf((*MockPipedStore)(nil).UpdateMetadata), ctx, id, version, config, pps, pls, repos, se, labels, startedAt)
 }
 
 // MockApplicationStore is a mock of ApplicationStore interface.
@@ -574,6 +574,20 @@ func (mr *MockApplicationStoreMockRecorder) UpdateDeployingStatus(ctx, id, deplo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeployingStatus", reflect.TypeOf((*MockApplicationStore)(nil).UpdateDeployingStatus), ctx, id, deploying)
 }
 
+// UpdateLabels mocks base method.
+func (m *MockApplicationStore) UpdateLabels(ctx context.Context, id string, add map[string]string, remove []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLabels", ctx, id, add, remove)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLabels indicates an expected call of UpdateLabels.
+func (mr *MockApplicationStoreMockRecorder) UpdateLabels(ctx, id, add, remove any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLabels", reflect.TypeOf((*MockApplicationStore)(nil).UpdateLabels), ctx, id, add, remove)
+}
+
 // UpdateMostRecentDeployment mocks base method.
 func (m *MockApplicationStore) UpdateMostRecentDeployment(ctx context.Context, id string, status model.DeploymentStatus, deployment *model.ApplicationDeploymentReference) error {
 	m.ctrl.T.Helper()
@@ -588,6 +602,20 @@ func (mr *MockApplicationStoreMockRecorder) UpdateMostRecentDeployment(ctx, id,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMostRecentDeployment", reflect.TypeOf((*MockApplicationStore)(nil).UpdateMostRecentDeployment), ctx, id, status, deployment)
 }
 
+// UpdatePiped mocks base method.
+func (m *MockApplicationStore) UpdatePiped(ctx context.Context, id, pipedID, platformProvider string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePiped", ctx, id, pipedID, platformProvider)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePiped indicates an expected call of UpdatePiped.
+func (mr *MockApplicationStoreMockRecorder) UpdatePiped(ctx, id, pipedID, platformProvider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePiped", reflect.TypeOf((*MockApplicationStore)(nil).UpdatePiped), ctx, id, pipedID, platformProvider)
+}
+
 // UpdatePlatformProvider mocks base method.
 func (m *MockApplicationStore) UpdatePlatformProvider(ctx context.Context, id, provider string) error {
 	m.ctrl.T.Helper()
@@ -654,6 +682,20 @@ func (mr *MockDeploymentStoreMockRecorder) Add(ctx, d any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockDeploymentStore)(nil).Add), ctx, d)
 }
 
+// AddComment mocks base method.
+func (m *MockDeploymentStore) AddComment(ctx context.Context, id string, comment *model.DeploymentComment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddComment", ctx, id, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddComment indicates an expected call of AddComment.
+func (mr *MockDeploymentStoreMockRecorder) AddComment(ctx, id, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockDeploymentStore)(nil).AddComment), ctx, id, comment)
+}
+
 // Get mocks base method.
 func (m *MockDeploymentStore) Get(ctx context.Context, id string) (*model.Deployment, error) {
 	m.ctrl.T.Helper()
@@ -685,6 +727,34 @@ func (mr *MockDeploymentStoreMockRecorder) List(ctx, opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDeploymentStore)(nil).List), ctx, opts)
 }
 
+// UpdateArtifact mocks base method.
+func (m *MockDeploymentStore) UpdateArtifact(ctx context.Context, id string, artifact *model.DeploymentArtifact) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArtifact", ctx, id, artifact)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateArtifact indicates an expected call of UpdateArtifact.
+func (mr *MockDeploymentStoreMockRecorder) UpdateArtifact(ctx, id, artifact any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArtifact", reflect.TypeOf((*MockDeploymentStore)(nil).UpdateArtifact), ctx, id, artifact)
+}
+
+// UpdateLabels mocks base method.
+func (m *MockDeploymentStore) UpdateLabels(ctx context.Context, id string, add map[string]string, remove []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLabels", ctx, id, add, remove)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLabels indicates an expected call of UpdateLabels.
+func (mr *MockDeploymentStoreMockRecorder) UpdateLabels(ctx, id, add, remove any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLabels", reflect.TypeOf((*MockDeploymentStore)(nil).UpdateLabels), ctx, id, add, remove)
+}
+
 // UpdateMetadata mocks base method.
 func (m *MockDeploymentStore) UpdateMetadata(ctx context.Context, id string, metadata map[string]string) error {
 	m.ctrl.T.Helper()
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -129,6 +131,18 @@ var (
 			return fmt.Errorf("stage id %s not found: %w", stageID, ErrInvalidArgument)
 		}
 	}
+
+	addCommentUpdateFunc = func(comment *model.DeploymentComment) func(*model.Deployment) error {
+		return func(d *model.Deployment) error {
+			for _, c := range d.Comments {
+				if c.Id == comment.Id {
+					return fmt.Errorf("comment %s already exists: %w", comment.Id, ErrAlreadyExists)
+				}
+			}
+			d.Comments = append(d.Comments, comment)
+			return nil
+		}
+	}
 )
 
 type DeploymentStore interface {
@@ -137,10 +151,13 @@ type DeploymentStore interface {
 	List(ctx context.Context, opts ListOptions) ([]*model.Deployment, string, error)
 	UpdateToPlanned(ctx context.Context, id, summary, reason, runningCommitHash, runningConfigFilename, version string, versions []*model.ArtifactVersion, stages []*model.PipelineStage) error
 	UpdateToCompleted(ctx context.Context, id string, status model.DeploymentStatus, stageStatuses map[string]model.StageStatus, reason string, completedAt int64) error
+	UpdateArtifact(ctx context.Context, id string, artifact *model.DeploymentArtifact) error
 	UpdateStatus(ctx context.Context, id string, status model.DeploymentStatus, reason string) error
 	UpdateStageStatus(ctx context.Context, id, stageID string, status model.StageStatus, reason string, requires []string, visible bool, retriedCount int32, completedAt int64) error
 	UpdateMetadata(ctx context.Context, id string, metadata map[string]string) error
 	UpdateStageMetadata(ctx context.Context, deploymentID, stageID string, metadata map[string]string) error
+	AddComment(ctx context.Context, id string, comment *model.DeploymentComment) error
+	UpdateLabels(ctx context.Context, id string, add map[string]string, remove []string) error
 }
 
 type deploymentStore struct {
@@ -233,6 +250,21 @@ func (s *deploymentStore) UpdateToCompleted(ctx context.Context, id string, stat
 	return s.update(ctx, id, updater)
 }
 
+// UpdateArtifact records the artifact of the given deployment.
+// The artifact is immutable, so recording a different one again returns ErrAlreadyExists.
+func (s *deploymentStore) UpdateArtifact(ctx context.Context, id string, artifact *model.DeploymentArtifact) error {
+	return s.update(ctx, id, func(d *model.Deployment) error {
+		if d.Artifact != nil {
+			if proto.Equal(d.Artifact, artifact) {
+				return nil
+			}
+			return fmt.Errorf("artifact of deployment %s was already recorded: %w", id, ErrAlreadyExists)
+		}
+		d.Artifact = artifact
+		return nil
+	})
+}
+
 func (s *deploymentStore) UpdateStatus(ctx context.Context, id string, status model.DeploymentStatus, reason string) error {
 	updater := statusUpdateFunc(status, reason)
 	return s.update(ctx, id, updater)
@@ -262,6 +294,21 @@ func (s *deploymentStore) UpdateStageMetadata(ctx context.Context, deploymentID,
 	})
 }
 
+func (s *deploymentStore) AddComment(ctx context.Context, id string, comment *model.DeploymentComment) error {
+	if err := comment.Validate(); err != nil {
+		return err
+	}
+	updater := addCommentUpdateFunc(comment)
+	return s.update(ctx, id, updater)
+}
+
+func (s *deploymentStore) UpdateLabels(ctx context.Context, id string, add map[string]string, remove []string) error {
+	return s.update(ctx, id, func(d *model.Deployment) error {
+		d.Labels, _ = model.ApplyLabelChanges(d.Labels, add, remove)
+		return nil
+	})
+}
+
 func mergeMetadata(ori map[string]string, new map[string]string) map[string]string {
 	out := make(map[string]string, len(ori)+len(new))
 	for k, v := range ori {
@@ -308,6 +308,66 @@ func TestStageStatusChangedUpdater(t *testing.T) {
 	}
 }
 
+func TestAddCommentUpdater(t *testing.T) {
+	testcases := []struct {
+		name       string
+		deployment *model.Deployment
+		comment    *model.DeploymentComment
+
+		expectedComments []*model.DeploymentComment
+		expectedErr      error
+	}{
+		{
+			name: "add the first comment",
+			deployment: &model.Deployment{
+				Id: "deployment-id",
+			},
+			comment: &model.DeploymentComment{Id: "comment-1", Commenter: "user", Comment: "paused for DB check", CreatedAt: 1},
+			expectedComments: []*model.DeploymentComment{
+				{Id: "comment-1", Commenter: "user", Comment: "paused for DB check", CreatedAt: 1},
+			},
+		},
+		{
+			name: "append to the existing comments",
+			deployment: &model.Deployment{
+				Id: "deployment-id",
+				Comments: []*model.DeploymentComment{
+					{Id: "comment-1", Commenter: "user", Comment: "paused for DB check", CreatedAt: 1},
+				},
+			},
+			comment: &model.DeploymentComment{Id: "comment-2", Commenter: "user", Comment: "DB is fine", CreatedAt: 2},
+			expectedComments: []*model.DeploymentComment{
+				{Id: "comment-1", Commenter: "user", Comment: "paused for DB check", CreatedAt: 1},
+				{Id: "comment-2", Commenter: "user", Comment: "DB is fine", CreatedAt: 2},
+			},
+		},
+		{
+			name: "duplicated comment id",
+			deployment: &model.Deployment{
+				Id: "deployment-id",
+				Comments: []*model.DeploymentComment{
+					{Id: "comment-1", Commenter: "user", Comment: "paused for DB check", CreatedAt: 1},
+				},
+			},
+			comment:     &model.DeploymentComment{Id: "comment-1", Commenter: "user", Comment: "DB is fine", CreatedAt: 2},
+			expectedErr: ErrAlreadyExists,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			updater := addCommentUpdateFunc(tc.comment)
+			err := updater(tc.deployment)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedComments, tc.deployment.Comments)
+		})
+	}
+}
+
 func TestAddDeployment(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -94,6 +94,7 @@ func (p *pipedCollection) Encode(e interface{}) (map[Shard][]byte, error) {
 		StartedAt:         me.StartedAt,
 		Version:           me.Version,
 		SecretEncryption:  me.SecretEncryption,
+		Labels:            me.Labels,
 	}
 	adata, err := json.Marshal(&agentShardStruct)
 	if err != nil {
@@ -114,7 +115,7 @@ type PipedStore interface {
 	EnablePiped(ctx context.Context, id string) error
 	DisablePiped(ctx context.Context, id string) error
 	UpdateDesiredVersion(ctx context.Context, id, version string) error
-	UpdateMetadata(ctx context.Context, id, version, config string, pps []*model.Piped_PlatformProvider, pls []*model.Piped_Plugin, repos []*model.ApplicationGitRepository, se *model.Piped_SecretEncryption, startedAt int64) error
+	UpdateMetadata(ctx context.Context, id, version, config string, pps []*model.Piped_PlatformProvider, pls []*model.Piped_Plugin, repos []*model.ApplicationGitRepository, se *model.Piped_SecretEncryption, labels map[string]string, startedAt int64) error
 	AddKey(ctx context.Context, id, keyHash, creator string, createdAt time.Time) error
 	DeleteOldKeys(ctx context.Context, id string) error
 }
@@ -221,13 +222,14 @@ func (s *pipedStore) UpdateDesiredVersion(ctx context.Context, id, version strin
 	})
 }
 
-func (s *pipedStore) UpdateMetadata(ctx context.Context, id, version, config string, pps []*model.Piped_PlatformProvider, pls []*model.Piped_Plugin, repos []*model.ApplicationGitRepository, se *model.Piped_SecretEncryption, startedAt int64) error {
+func (s *pipedStore) UpdateMetadata(ctx context.Context, id, version, config string, pps []*model.Piped_PlatformProvider, pls []*model.Piped_Plugin, repos []*model.ApplicationGitRepository, se *model.Piped_SecretEncryption, labels map[string]string, startedAt int64) error {
 	return s.update(ctx, id, func(piped *model.Piped) error {
 		piped.CloudProviders = nil
 		piped.PlatformProviders = pps
 		piped.Plugins = pls
 		piped.Repositories = repos
 		piped.SecretEncryption = se
+		piped.Labels = labels
 		piped.Version = version
 		piped.Config = config
 		piped.StartedAt = startedAt
@@ -21,6 +21,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -40,6 +41,10 @@ type Client interface {
 	Clone(ctx context.Context, repoID, remote, branch, destination string) (Repo, error)
 	// Clean removes all cache data.
 	Clean() error
+	// RemoveCache removes the cached repository of the given ID
+	// unless it is being cloned or has worktrees still existing.
+	// It returns false when the cache was kept because it is in use.
+	RemoveCache(repoID string) (bool, error)
 }
 
 type client struct {
@@ -51,7 +56,12 @@ type client struct {
 	mu                sync.Mutex
 	repoSingleFlights *singleflight.Group
 	repoLocks         map[string]*sync.Mutex
-	password          string
+	// The number of running clones of each repository, guarded by mu.
+	repoClones map[string]int
+	password   string
+	// Limits the number of repositories cloned or fetched from remote at the same time.
+	// Nil means no limit.
+	fetchSemaphore chan struct{}
 
 	gitEnvs       []string
 	gitEnvsByRepo map[string][]string
@@ -72,6 +82,24 @@ func WithGitEnvForRepo(remote string, env string) Option {
 	}
 }
 
+// WithMaxConcurrentFetches limits the number of repositories cloned or fetched from remote at the same time.
+// Zero or a negative value means no limit.
+func WithMaxConcurrentFetches(n int) Option {
+	return func(c *client) {
+		if n > 0 {
+			c.fetchSemaphore = make(chan struct{}, n)
+		}
+	}
+}
+
+// WithCacheDir sets the directory where the cloned repositories are cached.
+// A temporary directory is created when it is not specified.
+func WithCacheDir(dir string) Option {
+	return func(c *client) {
+		c.cacheDir = dir
+	}
+}
+
 func WithLogger(logger *zap.Logger) Option {
 	return func(c *client) {
 		c.logger = logger
@@ -110,19 +138,14 @@ func NewClient(opts ...Option) (Client, error) {
 		return nil, fmt.Errorf("unable to find the path of git: %v", err)
 	}
 
-	cacheDir, err := os.MkdirTemp("", "gitcache")
-	if err != nil {
-		return nil, fmt.Errorf("unable to create a temporary directory for git cache: %v", err)
-	}
-
 	c := &client{
 		username:          defaultUsername,
 		email:             defaultEmail,
 		gcAutoDetach:      false, // Disable this by default. See issue #4760, discussion #4758.
 		gitPath:           gitPath,
-		cacheDir:          cacheDir,
 		repoSingleFlights: new(singleflight.Group),
 		repoLocks:         make(map[string]*sync.Mutex),
+		repoClones:        make(map[string]int),
 		gitEnvsByRepo:     make(map[string][]string, 0),
 		logger:            zap.NewNop(),
 	}
@@ -131,6 +154,14 @@ func NewClient(opts ...Option) (Client, error) {
 		opt(c)
 	}
 
+	if c.cacheDir == "" {
+		cacheDir, err := os.MkdirTemp("", "gitcache")
+		if err != nil {
+			return nil, fmt.Errorf("unable to create a temporary directory for git cache: %v", err)
+		}
+		c.cacheDir = cacheDir
+	}
+
 	return c, nil
 }
 
@@ -145,6 +176,15 @@ func (c *client) Clone(ctx context.Context, repoID, remote, branch, destination
 		)
 	)
 
+	c.mu.Lock()
+	c.repoClones[repoID]++
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.repoClones[repoID]--
+		c.mu.Unlock()
+	}()
+
 	_, err, _ := c.repoSingleFlights.Do(repoID, func() (interface{}, error) {
 		authArgs := []string{}
 		if c.username != "" && c.password != "" {
@@ -154,6 +194,11 @@ func (c *client) Clone(ctx context.Context, repoID, remote, branch, destination
 			authArgs = append(authArgs, "-c", fmt.Sprintf("http.extraHeader=%s", header))
 		}
 
+		if err := c.acquireFetch(ctx); err != nil {
+			return nil, err
+		}
+		defer c.releaseFetch()
+
 		_, err := os.Stat(repoCachePath)
 		if err != nil && !os.IsNotExist(err) {
 			return nil, err
@@ -214,6 +259,10 @@ func (c *client) Clone(ctx context.Context, repoID, remote, branch, destination
 		}
 	}
 
+	// Remove the worktree information of the destinations which were already deleted
+	// so that the cached repository can be shared by many worktrees without leaking them.
+	_, _ = runGitCommand(ctx, c.gitPath, "", c.envsForRepo(remote), "-C", repoCachePath, "worktree", "prune") // ignore the error
+
 	// git worktree add [-f] [--detach] [--checkout] [--lock [--reason <string>]]
 	//                   [--orphan] [(-b | -B) <new-branch>] <path> [<commit-ish>]
 	args := []string{"-C", repoCachePath, "worktree", "add", "--detach", destination}
@@ -262,6 +311,55 @@ func (c *client) Clean() error {
 	return os.RemoveAll(c.cacheDir)
 }
 
+// RemoveCache removes the cached repository of the given ID
+// unless it is being cloned or has worktrees still existing.
+// It returns false when the cache was kept because it is in use.
+func (c *client) RemoveCache(repoID string) (bool, error) {
+	// Holding mu prevents new clones of the repository from starting while removing.
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.repoClones[repoID] > 0 {
+		return false, nil
+	}
+	repoCachePath := filepath.Join(c.cacheDir, repoID)
+	inUse, err := hasLiveWorktrees(repoCachePath)
+	if err != nil {
+		return false, err
+	}
+	if inUse {
+		return false, nil
+	}
+	if err := os.RemoveAll(repoCachePath); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// hasLiveWorktrees reports whether any worktree of the given cached repository still exists.
+// The worktrees whose directories were already deleted are ignored since they are pruned at the next clone.
+func hasLiveWorktrees(repoCachePath string) (bool, error) {
+	des, err := os.ReadDir(filepath.Join(repoCachePath, "worktrees"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, de := range des {
+		// The gitdir file holds the path to the .git file of the worktree.
+		gitdir, err := os.ReadFile(filepath.Join(repoCachePath, "worktrees", de.Name(), "gitdir"))
+		if err != nil {
+			// Regard the worktree being added as a live one.
+			return true, nil
+		}
+		if _, err := os.Stat(strings.TrimSpace(string(gitdir))); err == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (c *client) lockRepo(repoID string) {
 	c.mu.Lock()
 	if _, ok := c.repoLocks[repoID]; !ok {
@@ -279,6 +377,25 @@ func (c *client) unlockRepo(repoID string) {
 	c.mu.Unlock()
 }
 
+func (c *client) acquireFetch(ctx context.Context) error {
+	if c.fetchSemaphore == nil {
+		return nil
+	}
+	select {
+	case c.fetchSemaphore <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *client) releaseFetch() {
+	if c.fetchSemaphore == nil {
+		return
+	}
+	<-c.fetchSemaphore
+}
+
 func (c *client) envsForRepo(remote string) []string {
 	envs := c.gitEnvsByRepo[remote]
 	return append(envs, c.gitEnvs...)
@@ -20,6 +20,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -92,6 +93,102 @@ func TestClone(t *testing.T) {
 	assert.Equal(t, "Added note.txt", commits12[0].Message)
 }
 
+func TestCloneSharesCachedRepository(t *testing.T) {
+	faker, err := newFaker()
+	require.NoError(t, err)
+	defer faker.clean()
+
+	c, err := NewClient(WithCacheDir(t.TempDir()), WithMaxConcurrentFetches(1))
+	require.NoError(t, err)
+	defer c.Clean()
+
+	err = faker.makeRepo("test-clone-org", "repo-1")
+	require.NoError(t, err)
+	remote := filepath.Join(faker.dir, "test-clone-org/repo-1")
+
+	ctx := context.Background()
+	var (
+		wg    sync.WaitGroup
+		repos = make([]Repo, 3)
+		errs  = make([]error, 3)
+	)
+	for i := range repos {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			repos[i], errs[i] = c.Clone(ctx, "repo-1", remote, "", filepath.Join(t.TempDir(), "repo"))
+		}(i)
+	}
+	wg.Wait()
+	for i := range repos {
+		require.NoError(t, errs[i])
+	}
+
+	// All worktrees share the same cached repository.
+	cacheDir := filepath.Join(c.(*client).cacheDir, "repo-1")
+	worktrees, err := os.ReadDir(filepath.Join(cacheDir, "worktrees"))
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(worktrees))
+
+	// The worktree information of the deleted ones is pruned at the next clone.
+	for _, r := range repos {
+		require.NoError(t, r.Clean())
+	}
+	repo, err := c.Clone(ctx, "repo-1", remote, "", filepath.Join(t.TempDir(), "repo"))
+	require.NoError(t, err)
+	defer repo.Clean()
+
+	worktrees, err = os.ReadDir(filepath.Join(cacheDir, "worktrees"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(worktrees))
+}
+
+func TestRemoveCache(t *testing.T) {
+	faker, err := newFaker()
+	require.NoError(t, err)
+	defer faker.clean()
+
+	c, err := NewClient(WithCacheDir(t.TempDir()))
+	require.NoError(t, err)
+	defer c.Clean()
+
+	err = faker.makeRepo("test-clone-org", "repo-1")
+	require.NoError(t, err)
+	remote := filepath.Join(faker.dir, "test-clone-org/repo-1")
+
+	repo, err := c.Clone(context.Background(), "repo-1", remote, "", filepath.Join(t.TempDir(), "repo"))
+	require.NoError(t, err)
+	cacheDir := filepath.Join(c.(*client).cacheDir, "repo-1")
+
+	// The cache is kept while its worktree exists.
+	removed, err := c.RemoveCache("repo-1")
+	require.NoError(t, err)
+	assert.False(t, removed)
+	assert.DirExists(t, cacheDir)
+
+	require.NoError(t, repo.Clean())
+	removed, err = c.RemoveCache("repo-1")
+	require.NoError(t, err)
+	assert.True(t, removed)
+	assert.NoDirExists(t, cacheDir)
+}
+
+func TestAcquireFetch(t *testing.T) {
+	c := &client{}
+	require.NoError(t, c.acquireFetch(context.Background()))
+	c.releaseFetch()
+
+	WithMaxConcurrentFetches(1)(c)
+	require.NoError(t, c.acquireFetch(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, c.acquireFetch(ctx), context.Canceled)
+
+	c.releaseFetch()
+	assert.NoError(t, c.acquireFetch(context.Background()))
+}
+
 type faker struct {
 	dir     string
 	gitPath string
@@ -0,0 +1,191 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwt
+
+import (
+	"fmt"
+	"time"
+
+	jwtgo "github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// defaultIdentityClaim is the claim holding the identity when it is not specified.
+	defaultIdentityClaim = "sub"
+	// DefaultExternalTokenMaxTTL is the longest lifetime of the tokens when it is not specified.
+	DefaultExternalTokenMaxTTL = 5 * time.Minute
+
+	// The claims binding a token to a stage of a deployment.
+	deploymentIDClaim = "deployment_id"
+	stageIDClaim      = "stage_id"
+)
+
+// ExternalIssuer represents an external system trusted to sign tokens carrying an identity.
+type ExternalIssuer struct {
+	// The value of the "iss" claim of the tokens.
+	Issuer string
+	// The value required to be in the "aud" claim of the tokens.
+	// Empty means the audience is not verified.
+	Audience string
+	// The name of the method used to sign the tokens, e.g. "RS256".
+	SigningMethod string
+	// The path to the file containing the key to verify the signature.
+	KeyFile string
+	// The claim holding the identity. Default is "sub".
+	IdentityClaim string
+	// The longest lifetime of the tokens, from "iat" to "exp".
+	// Default is DefaultExternalTokenMaxTTL.
+	MaxTTL time.Duration
+}
+
+// ExternalIdentity is the identity carried by a token signed by an external issuer.
+// Each token is either bound to a stage of a deployment, or carries an ID to be used only once.
+type ExternalIdentity struct {
+	Issuer string
+	// The identity prefixed with the issuer, e.g. "chatops:alice",
+	// so that no issuer can impersonate the users of PipeCD or of another issuer.
+	Name string
+	// The deployment and the stage the token is bound to.
+	// Both are empty when the token is not bound to any stage.
+	DeploymentID string
+	StageID      string
+	// The value of the "jti" claim.
+	ID        string
+	ExpiresAt time.Time
+}
+
+// IsBoundTo reports whether the token is bound to the given stage of the given deployment.
+func (i *ExternalIdentity) IsBoundTo(deploymentID, stageID string) bool {
+	return i.DeploymentID == deploymentID && i.StageID == stageID
+}
+
+// IsBound reports whether the token is bound to a stage of a deployment.
+func (i *ExternalIdentity) IsBound() bool {
+	return i.DeploymentID != "" && i.StageID != ""
+}
+
+type ExternalVerifier interface {
+	Verify(token string) (*ExternalIdentity, error)
+}
+
+type externalIssuer struct {
+	ExternalIssuer
+	method jwtgo.SigningMethod
+	key    interface{}
+}
+
+type externalVerifier struct {
+	issuers map[string]externalIssuer
+}
+
+// NewExternalVerifier returns a new verifier accepting the tokens signed by the given issuers.
+func NewExternalVerifier(issuers []ExternalIssuer) (ExternalVerifier, error) {
+	v := &externalVerifier{
+		issuers: make(map[string]externalIssuer, len(issuers)),
+	}
+	for _, iss := range issuers {
+		method := jwtgo.GetSigningMethod(iss.SigningMethod)
+		if method == nil {
+			return nil, fmt.Errorf("unsupported signing method %q of issuer %s", iss.SigningMethod, iss.Issuer)
+		}
+		key, err := readKeyFile(method, iss.KeyFile, false)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read key file of issuer %s: %v", iss.Issuer, err)
+		}
+		if iss.IdentityClaim == "" {
+			iss.IdentityClaim = defaultIdentityClaim
+		}
+		if iss.MaxTTL <= 0 {
+			iss.MaxTTL = DefaultExternalTokenMaxTTL
+		}
+		v.issuers[iss.Issuer] = externalIssuer{
+			ExternalIssuer: iss,
+			method:         method,
+			key:            key,
+		}
+	}
+	return v, nil
+}
+
+func (v *externalVerifier) Verify(tokenString string) (*ExternalIdentity, error) {
+	// The issuer must be known before verifying the token
+	// because it decides the key and the rules used to verify.
+	unverified, _, err := jwtgo.NewParser().ParseUnverified(tokenString, jwtgo.MapClaims{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse token: %v", err)
+	}
+	issuer, err := unverified.Claims.GetIssuer()
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse token: %v", err)
+	}
+	iss, ok := v.issuers[issuer]
+	if !ok {
+		return nil, fmt.Errorf("untrusted issuer %q", issuer)
+	}
+
+	opts := []jwtgo.ParserOption{
+		jwtgo.WithIssuer(iss.Issuer),
+		jwtgo.WithIssuedAt(),
+		jwtgo.WithExpirationRequired(),
+		jwtgo.WithValidMethods([]string{iss.method.Alg()}),
+	}
+	if iss.Audience != "" {
+		opts = append(opts, jwtgo.WithAudience(iss.Audience))
+	}
+	claims := jwtgo.MapClaims{}
+	token, err := jwtgo.NewParser(opts...).ParseWithClaims(tokenString, claims, func(*jwtgo.Token) (interface{}, error) {
+		return iss.key, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse token: %v", err)
+	}
+	if !token.Valid {
+		return nil, fmt.Errorf("token is not valid")
+	}
+
+	// The tokens must be short-lived to limit the damage when they are leaked.
+	iat, err := claims.GetIssuedAt()
+	if err != nil || iat == nil {
+		return nil, fmt.Errorf("token is missing the iat claim")
+	}
+	exp, err := claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		return nil, fmt.Errorf("token is missing the exp claim")
+	}
+	if ttl := exp.Sub(iat.Time); ttl > iss.MaxTTL {
+		return nil, fmt.Errorf("token lifetime %v exceeds the limit %v of issuer %s", ttl, iss.MaxTTL, iss.Issuer)
+	}
+
+	name, _ := claims[iss.IdentityClaim].(string)
+	if name == "" {
+		return nil, fmt.Errorf("token is missing the identity claim %q", iss.IdentityClaim)
+	}
+	id := &ExternalIdentity{
+		Issuer:    iss.Issuer,
+		Name:      iss.Issuer + ":" + name,
+		ExpiresAt: exp.Time,
+	}
+	id.DeploymentID, _ = claims[deploymentIDClaim].(string)
+	id.StageID, _ = claims[stageIDClaim].(string)
+	id.ID, _ = claims["jti"].(string)
+
+	if (id.DeploymentID == "") != (id.StageID == "") {
+		return nil, fmt.Errorf("token must have both of the %s and %s claims", deploymentIDClaim, stageIDClaim)
+	}
+	if !id.IsBound() && id.ID == "" {
+		return nil, fmt.Errorf("token must be bound to a stage by the %s and %s claims, or have the jti claim to be used only once", deploymentIDClaim, stageIDClaim)
+	}
+	return id, nil
+}
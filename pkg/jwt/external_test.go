@@ -0,0 +1,238 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwt
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jwtgo "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalVerifier(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("chatops-secret"), 0600))
+
+	privateKeyData, err := os.ReadFile("testdata/private.key")
+	require.NoError(t, err)
+	privateKey, err := jwtgo.ParseRSAPrivateKeyFromPEM(privateKeyData)
+	require.NoError(t, err)
+
+	v, err := NewExternalVerifier([]ExternalIssuer{
+		{
+			Issuer:        "chatops",
+			SigningMethod: "HS256",
+			KeyFile:       secretFile,
+		},
+		{
+			Issuer:        "itsm",
+			Audience:      "pipecd",
+			SigningMethod: "RS256",
+			KeyFile:       "testdata/public.key",
+			IdentityClaim: "email",
+		},
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	iat := now.Unix()
+	exp := now.Add(time.Minute).Unix()
+	signHS := func(claims jwtgo.MapClaims) string {
+		token, err := jwtgo.NewWithClaims(jwtgo.SigningMethodHS256, claims).SignedString([]byte("chatops-secret"))
+		require.NoError(t, err)
+		return token
+	}
+	signRS := func(claims jwtgo.MapClaims) string {
+		token, err := jwtgo.NewWithClaims(jwtgo.SigningMethodRS256, claims).SignedString(privateKey)
+		require.NoError(t, err)
+		return token
+	}
+
+	testcases := []struct {
+		name     string
+		token    string
+		expected *ExternalIdentity
+		wantErr  bool
+	}{
+		{
+			name: "shared secret",
+			token: signHS(jwtgo.MapClaims{
+				"iss":           "chatops",
+				"sub":           "alice",
+				"iat":           iat,
+				"exp":           exp,
+				"deployment_id": "deployment-1",
+				"stage_id":      "stage-1",
+			}),
+			expected: &ExternalIdentity{
+				Issuer:       "chatops",
+				Name:         "chatops:alice",
+				DeploymentID: "deployment-1",
+				StageID:      "stage-1",
+				ExpiresAt:    time.Unix(exp, 0),
+			},
+		},
+		{
+			name: "public key with custom identity claim",
+			token: signRS(jwtgo.MapClaims{
+				"iss":   "itsm",
+				"aud":   "pipecd",
+				"sub":   "12345",
+				"email": "bob@example.com",
+				"iat":   iat,
+				"exp":   exp,
+				"jti":   "token-1",
+			}),
+			expected: &ExternalIdentity{
+				Issuer:    "itsm",
+				Name:      "itsm:bob@example.com",
+				ID:        "token-1",
+				ExpiresAt: time.Unix(exp, 0),
+			},
+		},
+		{
+			name: "untrusted issuer",
+			token: signHS(jwtgo.MapClaims{
+				"iss": "unknown",
+				"sub": "alice",
+				"iat": iat,
+				"exp": exp,
+			}),
+			wantErr: true,
+		},
+		{
+			name: "signed by the key of another issuer",
+			token: signHS(jwtgo.MapClaims{
+				"iss":   "itsm",
+				"aud":   "pipecd",
+				"email": "bob@example.com",
+				"iat":   iat,
+				"exp":   exp,
+			}),
+			wantErr: true,
+		},
+		{
+			name: "wrong audience",
+			token: signRS(jwtgo.MapClaims{
+				"iss":   "itsm",
+				"aud":   "other",
+				"email": "bob@example.com",
+				"iat":   iat,
+				"exp":   exp,
+			}),
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			token: signHS(jwtgo.MapClaims{
+				"iss": "chatops",
+				"sub": "alice",
+				"iat": now.Add(-2 * time.Minute).Unix(),
+				"exp": now.Add(-time.Minute).Unix(),
+			}),
+			wantErr: true,
+		},
+		{
+			name: "missing expiration",
+			token: signHS(jwtgo.MapClaims{
+				"iss": "chatops",
+				"sub": "alice",
+			}),
+			wantErr: true,
+		},
+		{
+			name: "missing identity",
+			token: signRS(jwtgo.MapClaims{
+				"iss": "itsm",
+				"aud": "pipecd",
+				"sub": "12345",
+				"iat": iat,
+				"exp": exp,
+			}),
+			wantErr: true,
+		},
+		{
+			name: "missing issued at",
+			token: signHS(jwtgo.MapClaims{
+				"iss": "chatops",
+				"sub": "alice",
+				"exp": exp,
+				"jti": "token-1",
+			}),
+			wantErr: true,
+		},
+		{
+			name: "lifetime exceeding the limit",
+			token: signHS(jwtgo.MapClaims{
+				"iss": "chatops",
+				"sub": "alice",
+				"iat": iat,
+				"exp": now.Add(time.Hour).Unix(),
+				"jti": "token-1",
+			}),
+			wantErr: true,
+		},
+		{
+			name: "neither bound to a stage nor having an ID",
+			token: signHS(jwtgo.MapClaims{
+				"iss": "chatops",
+				"sub": "alice",
+				"iat": iat,
+				"exp": exp,
+			}),
+			wantErr: true,
+		},
+		{
+			name: "bound to a deployment only",
+			token: signHS(jwtgo.MapClaims{
+				"iss":           "chatops",
+				"sub":           "alice",
+				"iat":           iat,
+				"exp":           exp,
+				"deployment_id": "deployment-1",
+				"jti":           "token-1",
+			}),
+			wantErr: true,
+		},
+		{
+			name:    "malformed token",
+			token:   "malformed",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := v.Verify(tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestNewExternalVerifier(t *testing.T) {
+	_, err := NewExternalVerifier([]ExternalIssuer{
+		{Issuer: "chatops", SigningMethod: "none", KeyFile: "testdata/public.key"},
+	})
+	assert.Error(t, err)
+
+	_, err = NewExternalVerifier([]ExternalIssuer{
+		{Issuer: "chatops", SigningMethod: "RS256", KeyFile: "testdata/not-found.key"},
+	})
+	assert.Error(t, err)
+}
@@ -94,6 +94,11 @@ func (a *Application) GetDeployTargets() []string {
 	return deployTargets
 }
 
+// DeployedParameters returns the values of the deployment parameters used by the most recently successful deployment.
+func (a *Application) DeployedParameters() map[string]string {
+	return a.GetMostRecentlySuccessfulDeployment().GetTrigger().GetParameters()
+}
+
 func (a *Application) SetUpdatedAt(t int64) {
 	a.UpdatedAt = t
 }
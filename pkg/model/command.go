@@ -18,6 +18,7 @@ import "context"
 
 const (
 	MetadataKeyTriggeredDeploymentID = "TriggeredDeploymentID"
+	MetadataKeyComment               = "Comment"
 )
 
 type ReportableCommand struct {
@@ -41,6 +42,18 @@ func (c *Command) IsRestartPipedCmd() bool {
 	return c.GetRestartPiped() != nil
 }
 
+func (c *Command) IsRetryDeploymentCmd() bool {
+	return c.GetRetryDeployment() != nil
+}
+
+func (c *Command) IsReportStageResultCmd() bool {
+	return c.GetReportStageResult() != nil
+}
+
+func (c *Command) IsDeleteApplicationCmd() bool {
+	return c.GetDeleteApplication() != nil
+}
+
 func (c *Command) SetUpdatedAt(t int64) {
 	c.UpdatedAt = t
 }
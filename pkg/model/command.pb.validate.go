@@ -346,6 +346,93 @@ func (m *Command) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetRetryDeployment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommandValidationError{
+					field:  "RetryDeployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommandValidationError{
+					field:  "RetryDeployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryDeployment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommandValidationError{
+				field:  "RetryDeployment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReportStageResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommandValidationError{
+					field:  "ReportStageResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommandValidationError{
+					field:  "ReportStageResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReportStageResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommandValidationError{
+				field:  "ReportStageResult",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeleteApplication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommandValidationError{
+					field:  "DeleteApplication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommandValidationError{
+					field:  "DeleteApplication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeleteApplication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommandValidationError{
+				field:  "DeleteApplication",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.GetCreatedAt() <= 0 {
 		err := CommandValidationError{
 			field:  "CreatedAt",
@@ -480,6 +567,10 @@ func (m *Command_SyncApplication) validate(all bool) error {
 
 	// no validation rules for SyncStrategy
 
+	// no validation rules for DryRun
+
+	// no validation rules for PromoteFromDeploymentId
+
 	if len(errors) > 0 {
 		return Command_SyncApplicationMultiError(errors)
 	}
@@ -1450,3 +1541,373 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = Command_RestartPipedValidationError{}
+
+// Validate checks the field values on Command.RetryDeployment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *Command_RetryDeployment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Command.RetryDeployment with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Command_RetryDeploymentMultiError, or nil if none found.
+func (m *Command_RetryDeployment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Command_RetryDeployment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := Command_RetryDeploymentValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return Command_RetryDeploymentMultiError(errors)
+	}
+
+	return nil
+}
+
+// Command_RetryDeploymentMultiError is an error wrapping multiple validation
+// errors returned by Command.RetryDeployment.ValidateAll() if the designated
+// constraints aren't met.
+type Command_RetryDeploymentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Command_RetryDeploymentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Command_RetryDeploymentMultiError) AllErrors() []error { return m }
+
+// Command_RetryDeploymentValidationError is the validation error returned by
+// Command.RetryDeployment.Validate if the designated constraints aren't met.
+type Command_RetryDeploymentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Command_RetryDeploymentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Command_RetryDeploymentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Command_RetryDeploymentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Command_RetryDeploymentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Command_RetryDeploymentValidationError) ErrorName() string {
+	return "Command_RetryDeploymentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Command_RetryDeploymentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommand.RetryDeployment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Command_RetryDeploymentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Command_RetryDeploymentValidationError{}
+
+// Validate checks the field values on Command.ReportStageResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *Command_ReportStageResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Command.ReportStageResult with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Command_ReportStageResultMultiError, or nil if none found.
+func (m *Command_ReportStageResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Command_ReportStageResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := Command_ReportStageResultValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := Command_ReportStageResultValidationError{
+			field:  "StageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Command_ReportStageResult_Result_name[int32(m.GetResult())]; !ok {
+		err := Command_ReportStageResultValidationError{
+			field:  "Result",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return Command_ReportStageResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// Command_ReportStageResultMultiError is an error wrapping multiple validation
+// errors returned by Command.ReportStageResult.ValidateAll() if the
+// designated constraints aren't met.
+type Command_ReportStageResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Command_ReportStageResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Command_ReportStageResultMultiError) AllErrors() []error { return m }
+
+// Command_ReportStageResultValidationError is the validation error returned by
+// Command.ReportStageResult.Validate if the designated constraints aren't
+// met.
+type Command_ReportStageResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Command_ReportStageResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Command_ReportStageResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Command_ReportStageResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Command_ReportStageResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Command_ReportStageResultValidationError) ErrorName() string {
+	return "Command_ReportStageResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Command_ReportStageResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommand.ReportStageResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Command_ReportStageResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Command_ReportStageResultValidationError{}
+
+// Validate checks the field values on Command.DeleteApplication with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *Command_DeleteApplication) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Command.DeleteApplication with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Command_DeleteApplicationMultiError, or nil if none found.
+func (m *Command_DeleteApplication) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Command_DeleteApplication) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
+		err := Command_DeleteApplicationValidationError{
+			field:  "ApplicationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PlatformProvider
+
+	if len(errors) > 0 {
+		return Command_DeleteApplicationMultiError(errors)
+	}
+
+	return nil
+}
+
+// Command_DeleteApplicationMultiError is an error wrapping multiple validation
+// errors returned by Command.DeleteApplication.ValidateAll() if the
+// designated constraints aren't met.
+type Command_DeleteApplicationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Command_DeleteApplicationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Command_DeleteApplicationMultiError) AllErrors() []error { return m }
+
+// Command_DeleteApplicationValidationError is the validation error returned by
+// Command.DeleteApplication.Validate if the designated constraints aren't
+// met.
+type Command_DeleteApplicationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Command_DeleteApplicationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Command_DeleteApplicationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Command_DeleteApplicationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Command_DeleteApplicationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Command_DeleteApplicationValidationError) ErrorName() string {
+	return "Command_DeleteApplicationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Command_DeleteApplicationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommand.DeleteApplication.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Command_DeleteApplicationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Command_DeleteApplicationValidationError{}
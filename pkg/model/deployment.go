@@ -22,6 +22,12 @@ import (
 
 const (
 	MetadataKeyDeploymentNotification = "DeploymentNotification"
+	// The key of the shared metadata recording that applying the manifests
+	// was skipped because they are identical to the running ones.
+	MetadataKeyDeploymentNoOpSync = "DeploymentNoOpSync"
+	// The key of the stage metadata recording the ID of the deployment
+	// whose successful result of the stage was reused by a retry deployment.
+	MetadataKeyStageReusedFrom = "ReusedFrom"
 )
 
 var notCompletedDeploymentStatuses = []DeploymentStatus{
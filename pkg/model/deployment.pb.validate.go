@@ -293,6 +293,35 @@ func (m *Deployment) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetArtifact()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeploymentValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeploymentValidationError{
+					field:  "Artifact",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArtifact()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeploymentValidationError{
+				field:  "Artifact",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	// no validation rules for RunningCommitHash
 
 	// no validation rules for RunningConfigFilename
@@ -375,6 +404,40 @@ func (m *Deployment) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeploymentValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeploymentValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeploymentValidationError{
+					field:  fmt.Sprintf("Comments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	// no validation rules for DeploymentChainId
 
 	// no validation rules for DeploymentChainBlockIndex
@@ -491,6 +554,152 @@ var _ interface {
 	ErrorName() string
 } = DeploymentValidationError{}
 
+// Validate checks the field values on DeploymentComment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeploymentComment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeploymentComment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeploymentCommentMultiError, or nil if none found.
+func (m *DeploymentComment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeploymentComment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := DeploymentCommentValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCommenter()) < 1 {
+		err := DeploymentCommentValidationError{
+			field:  "Commenter",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) < 1 {
+		err := DeploymentCommentValidationError{
+			field:  "Comment",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCreatedAt() <= 0 {
+		err := DeploymentCommentValidationError{
+			field:  "CreatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeploymentCommentMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeploymentCommentMultiError is an error wrapping multiple validation errors
+// returned by DeploymentComment.ValidateAll() if the designated constraints
+// aren't met.
+type DeploymentCommentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeploymentCommentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeploymentCommentMultiError) AllErrors() []error { return m }
+
+// DeploymentCommentValidationError is the validation error returned by
+// DeploymentComment.Validate if the designated constraints aren't met.
+type DeploymentCommentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeploymentCommentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeploymentCommentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeploymentCommentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeploymentCommentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeploymentCommentValidationError) ErrorName() string {
+	return "DeploymentCommentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeploymentCommentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeploymentComment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeploymentCommentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeploymentCommentValidationError{}
+
 // Validate checks the field values on DeployTargets with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -670,6 +879,68 @@ func (m *DeploymentTrigger) validate(all bool) error {
 
 	// no validation rules for StrategySummary
 
+	if all {
+		switch v := interface{}(m.GetTrace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeploymentTriggerValidationError{
+					field:  "Trace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeploymentTriggerValidationError{
+					field:  "Trace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTrace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeploymentTriggerValidationError{
+				field:  "Trace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeploymentTriggerValidationError{
+					field:  "Retry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeploymentTriggerValidationError{
+					field:  "Retry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeploymentTriggerValidationError{
+				field:  "Retry",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DryRun
+
+	// no validation rules for PromoteFromDeploymentId
+
 	if len(errors) > 0 {
 		return DeploymentTriggerMultiError(errors)
 	}
@@ -750,42 +1021,32 @@ var _ interface {
 	ErrorName() string
 } = DeploymentTriggerValidationError{}
 
-// Validate checks the field values on PipelineStage with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PipelineStage) Validate() error {
+// Validate checks the field values on DeploymentTriggerRetry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *DeploymentTriggerRetry) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PipelineStage with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PipelineStageMultiError, or
-// nil if none found.
-func (m *PipelineStage) ValidateAll() error {
+// ValidateAll checks the field values on DeploymentTriggerRetry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeploymentTriggerRetryMultiError, or nil if none found.
+func (m *DeploymentTriggerRetry) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PipelineStage) validate(all bool) error {
+func (m *DeploymentTriggerRetry) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := PipelineStageValidationError{
-			field:  "Id",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := PipelineStageValidationError{
-			field:  "Name",
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := DeploymentTriggerRetryValidationError{
+			field:  "DeploymentId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -794,58 +1055,43 @@ func (m *PipelineStage) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Desc
-
-	// no validation rules for Index
-
-	// no validation rules for Predefined
-
-	// no validation rules for Visible
+	for idx, item := range m.GetSucceededStages() {
+		_, _ = idx, item
 
-	if _, ok := StageStatus_name[int32(m.GetStatus())]; !ok {
-		err := PipelineStageValidationError{
-			field:  "Status",
-			reason: "value must be one of the defined enum values",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for StatusReason
-
-	// no validation rules for Metadata
-
-	// no validation rules for RetriedCount
-
-	// no validation rules for Rollback
-
-	if m.GetCompletedAt() < 0 {
-		err := PipelineStageValidationError{
-			field:  "CompletedAt",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeploymentTriggerRetryValidationError{
+						field:  fmt.Sprintf("SucceededStages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeploymentTriggerRetryValidationError{
+						field:  fmt.Sprintf("SucceededStages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeploymentTriggerRetryValidationError{
+					field:  fmt.Sprintf("SucceededStages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if m.GetCreatedAt() <= 0 {
-		err := PipelineStageValidationError{
-			field:  "CreatedAt",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	if m.GetUpdatedAt() <= 0 {
-		err := PipelineStageValidationError{
-			field:  "UpdatedAt",
+	if m.GetAttempt() <= 0 {
+		err := DeploymentTriggerRetryValidationError{
+			field:  "Attempt",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -854,31 +1100,20 @@ func (m *PipelineStage) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := ManualOperation_name[int32(m.GetAvailableOperation())]; !ok {
-		err := PipelineStageValidationError{
-			field:  "AvailableOperation",
-			reason: "value must be one of the defined enum values",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return PipelineStageMultiError(errors)
+		return DeploymentTriggerRetryMultiError(errors)
 	}
 
 	return nil
 }
 
-// PipelineStageMultiError is an error wrapping multiple validation errors
-// returned by PipelineStage.ValidateAll() if the designated constraints
-// aren't met.
-type PipelineStageMultiError []error
+// DeploymentTriggerRetryMultiError is an error wrapping multiple validation
+// errors returned by DeploymentTriggerRetry.ValidateAll() if the designated
+// constraints aren't met.
+type DeploymentTriggerRetryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PipelineStageMultiError) Error() string {
+func (m DeploymentTriggerRetryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -887,11 +1122,11 @@ func (m PipelineStageMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PipelineStageMultiError) AllErrors() []error { return m }
+func (m DeploymentTriggerRetryMultiError) AllErrors() []error { return m }
 
-// PipelineStageValidationError is the validation error returned by
-// PipelineStage.Validate if the designated constraints aren't met.
-type PipelineStageValidationError struct {
+// DeploymentTriggerRetryValidationError is the validation error returned by
+// DeploymentTriggerRetry.Validate if the designated constraints aren't met.
+type DeploymentTriggerRetryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -899,22 +1134,24 @@ type PipelineStageValidationError struct {
 }
 
 // Field function returns field value.
-func (e PipelineStageValidationError) Field() string { return e.field }
+func (e DeploymentTriggerRetryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PipelineStageValidationError) Reason() string { return e.reason }
+func (e DeploymentTriggerRetryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PipelineStageValidationError) Cause() error { return e.cause }
+func (e DeploymentTriggerRetryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PipelineStageValidationError) Key() bool { return e.key }
+func (e DeploymentTriggerRetryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PipelineStageValidationError) ErrorName() string { return "PipelineStageValidationError" }
+func (e DeploymentTriggerRetryValidationError) ErrorName() string {
+	return "DeploymentTriggerRetryValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PipelineStageValidationError) Error() string {
+func (e DeploymentTriggerRetryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -926,14 +1163,14 @@ func (e PipelineStageValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPipelineStage.%s: %s%s",
+		"invalid %sDeploymentTriggerRetry.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PipelineStageValidationError{}
+var _ error = DeploymentTriggerRetryValidationError{}
 
 var _ interface {
 	Field() string
@@ -941,32 +1178,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PipelineStageValidationError{}
+} = DeploymentTriggerRetryValidationError{}
 
-// Validate checks the field values on Commit with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Commit) Validate() error {
+// Validate checks the field values on DeploymentTriggerRetry.SucceededStage
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *DeploymentTriggerRetry_SucceededStage) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Commit with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in CommitMultiError, or nil if none found.
-func (m *Commit) ValidateAll() error {
+// ValidateAll checks the field values on DeploymentTriggerRetry.SucceededStage
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DeploymentTriggerRetry_SucceededStageMultiError, or nil if none found.
+func (m *DeploymentTriggerRetry_SucceededStage) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Commit) validate(all bool) error {
+func (m *DeploymentTriggerRetry_SucceededStage) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetHash()) < 1 {
-		err := CommitValidationError{
-			field:  "Hash",
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := DeploymentTriggerRetry_SucceededStageValidationError{
+			field:  "Id",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -975,9 +1214,9 @@ func (m *Commit) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetMessage()) < 1 {
-		err := CommitValidationError{
-			field:  "Message",
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeploymentTriggerRetry_SucceededStageValidationError{
+			field:  "Name",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -986,20 +1225,11 @@ func (m *Commit) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetAuthor()) < 1 {
-		err := CommitValidationError{
-			field:  "Author",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Index
 
-	if utf8.RuneCountInString(m.GetBranch()) < 1 {
-		err := CommitValidationError{
-			field:  "Branch",
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := DeploymentTriggerRetry_SucceededStageValidationError{
+			field:  "DeploymentId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -1008,34 +1238,23 @@ func (m *Commit) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for PullRequest
-
-	// no validation rules for Url
-
-	if m.GetCreatedAt() <= 0 {
-		err := CommitValidationError{
-			field:  "CreatedAt",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Attempt
 
 	if len(errors) > 0 {
-		return CommitMultiError(errors)
+		return DeploymentTriggerRetry_SucceededStageMultiError(errors)
 	}
 
 	return nil
 }
 
-// CommitMultiError is an error wrapping multiple validation errors returned by
-// Commit.ValidateAll() if the designated constraints aren't met.
-type CommitMultiError []error
+// DeploymentTriggerRetry_SucceededStageMultiError is an error wrapping
+// multiple validation errors returned by
+// DeploymentTriggerRetry.SucceededStage.ValidateAll() if the designated
+// constraints aren't met.
+type DeploymentTriggerRetry_SucceededStageMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CommitMultiError) Error() string {
+func (m DeploymentTriggerRetry_SucceededStageMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1044,11 +1263,12 @@ func (m CommitMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CommitMultiError) AllErrors() []error { return m }
+func (m DeploymentTriggerRetry_SucceededStageMultiError) AllErrors() []error { return m }
 
-// CommitValidationError is the validation error returned by Commit.Validate if
-// the designated constraints aren't met.
-type CommitValidationError struct {
+// DeploymentTriggerRetry_SucceededStageValidationError is the validation error
+// returned by DeploymentTriggerRetry.SucceededStage.Validate if the
+// designated constraints aren't met.
+type DeploymentTriggerRetry_SucceededStageValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1056,22 +1276,24 @@ type CommitValidationError struct {
 }
 
 // Field function returns field value.
-func (e CommitValidationError) Field() string { return e.field }
+func (e DeploymentTriggerRetry_SucceededStageValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CommitValidationError) Reason() string { return e.reason }
+func (e DeploymentTriggerRetry_SucceededStageValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CommitValidationError) Cause() error { return e.cause }
+func (e DeploymentTriggerRetry_SucceededStageValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CommitValidationError) Key() bool { return e.key }
+func (e DeploymentTriggerRetry_SucceededStageValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CommitValidationError) ErrorName() string { return "CommitValidationError" }
+func (e DeploymentTriggerRetry_SucceededStageValidationError) ErrorName() string {
+	return "DeploymentTriggerRetry_SucceededStageValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CommitValidationError) Error() string {
+func (e DeploymentTriggerRetry_SucceededStageValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1083,14 +1305,14 @@ func (e CommitValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCommit.%s: %s%s",
+		"invalid %sDeploymentTriggerRetry.SucceededStage.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CommitValidationError{}
+var _ error = DeploymentTriggerRetry_SucceededStageValidationError{}
 
 var _ interface {
 	Field() string
@@ -1098,119 +1320,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CommitValidationError{}
+} = DeploymentTriggerRetry_SucceededStageValidationError{}
 
-// Validate checks the field values on DeploymentMetadata with the rules
+// Validate checks the field values on DeploymentTriggerTrace with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeploymentMetadata) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *DeploymentTriggerTrace) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeploymentMetadata with the rules
+// ValidateAll checks the field values on DeploymentTriggerTrace with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeploymentMetadataMultiError, or nil if none found.
-func (m *DeploymentMetadata) ValidateAll() error {
+// DeploymentTriggerTraceMultiError, or nil if none found.
+func (m *DeploymentTriggerTrace) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeploymentMetadata) validate(all bool) error {
+func (m *DeploymentTriggerTrace) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetShared()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeploymentMetadataValidationError{
-					field:  "Shared",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DeploymentMetadataValidationError{
-					field:  "Shared",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetShared()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DeploymentMetadataValidationError{
-				field:  "Shared",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for EventName
 
-	{
-		sorted_keys := make([]string, len(m.GetPlugins()))
-		i := 0
-		for key := range m.GetPlugins() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetPlugins()[key]
-			_ = val
+	// no validation rules for EventLabels
 
-			// no validation rules for Plugins[key]
+	// no validation rules for CommitHash
 
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, DeploymentMetadataValidationError{
-							field:  fmt.Sprintf("Plugins[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, DeploymentMetadataValidationError{
-							field:  fmt.Sprintf("Plugins[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return DeploymentMetadataValidationError{
-						field:  fmt.Sprintf("Plugins[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
+	// no validation rules for CommitAuthor
 
-		}
-	}
+	// no validation rules for CommitUrl
+
+	// no validation rules for RunUrl
 
 	if len(errors) > 0 {
-		return DeploymentMetadataMultiError(errors)
+		return DeploymentTriggerTraceMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeploymentMetadataMultiError is an error wrapping multiple validation errors
-// returned by DeploymentMetadata.ValidateAll() if the designated constraints
-// aren't met.
-type DeploymentMetadataMultiError []error
+// DeploymentTriggerTraceMultiError is an error wrapping multiple validation
+// errors returned by DeploymentTriggerTrace.ValidateAll() if the designated
+// constraints aren't met.
+type DeploymentTriggerTraceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeploymentMetadataMultiError) Error() string {
+func (m DeploymentTriggerTraceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1219,11 +1379,11 @@ func (m DeploymentMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeploymentMetadataMultiError) AllErrors() []error { return m }
+func (m DeploymentTriggerTraceMultiError) AllErrors() []error { return m }
 
-// DeploymentMetadataValidationError is the validation error returned by
-// DeploymentMetadata.Validate if the designated constraints aren't met.
-type DeploymentMetadataValidationError struct {
+// DeploymentTriggerTraceValidationError is the validation error returned by
+// DeploymentTriggerTrace.Validate if the designated constraints aren't met.
+type DeploymentTriggerTraceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1231,24 +1391,24 @@ type DeploymentMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeploymentMetadataValidationError) Field() string { return e.field }
+func (e DeploymentTriggerTraceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeploymentMetadataValidationError) Reason() string { return e.reason }
+func (e DeploymentTriggerTraceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeploymentMetadataValidationError) Cause() error { return e.cause }
+func (e DeploymentTriggerTraceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeploymentMetadataValidationError) Key() bool { return e.key }
+func (e DeploymentTriggerTraceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeploymentMetadataValidationError) ErrorName() string {
-	return "DeploymentMetadataValidationError"
+func (e DeploymentTriggerTraceValidationError) ErrorName() string {
+	return "DeploymentTriggerTraceValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeploymentMetadataValidationError) Error() string {
+func (e DeploymentTriggerTraceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1260,14 +1420,14 @@ func (e DeploymentMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeploymentMetadata.%s: %s%s",
+		"invalid %sDeploymentTriggerTrace.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeploymentMetadataValidationError{}
+var _ error = DeploymentTriggerTraceValidationError{}
 
 var _ interface {
 	Field() string
@@ -1275,46 +1435,1222 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeploymentMetadataValidationError{}
+} = DeploymentTriggerTraceValidationError{}
 
-// Validate checks the field values on DeploymentMetadata_KeyValues with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeploymentMetadata_KeyValues) Validate() error {
+// Validate checks the field values on PipelineStage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PipelineStage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PipelineStage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PipelineStageMultiError, or
+// nil if none found.
+func (m *PipelineStage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PipelineStage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := PipelineStageValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := PipelineStageValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Desc
+
+	// no validation rules for Index
+
+	// no validation rules for Predefined
+
+	// no validation rules for Visible
+
+	if _, ok := StageStatus_name[int32(m.GetStatus())]; !ok {
+		err := PipelineStageValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for StatusReason
+
+	// no validation rules for Metadata
+
+	// no validation rules for RetriedCount
+
+	// no validation rules for Rollback
+
+	if m.GetCompletedAt() < 0 {
+		err := PipelineStageValidationError{
+			field:  "CompletedAt",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCreatedAt() <= 0 {
+		err := PipelineStageValidationError{
+			field:  "CreatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUpdatedAt() <= 0 {
+		err := PipelineStageValidationError{
+			field:  "UpdatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ManualOperation_name[int32(m.GetAvailableOperation())]; !ok {
+		err := PipelineStageValidationError{
+			field:  "AvailableOperation",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PipelineStageMultiError(errors)
+	}
+
+	return nil
+}
+
+// PipelineStageMultiError is an error wrapping multiple validation errors
+// returned by PipelineStage.ValidateAll() if the designated constraints
+// aren't met.
+type PipelineStageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PipelineStageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PipelineStageMultiError) AllErrors() []error { return m }
+
+// PipelineStageValidationError is the validation error returned by
+// PipelineStage.Validate if the designated constraints aren't met.
+type PipelineStageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PipelineStageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PipelineStageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PipelineStageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PipelineStageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PipelineStageValidationError) ErrorName() string { return "PipelineStageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PipelineStageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPipelineStage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PipelineStageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PipelineStageValidationError{}
+
+// Validate checks the field values on Commit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Commit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Commit with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CommitMultiError, or nil if none found.
+func (m *Commit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Commit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetHash()) < 1 {
+		err := CommitValidationError{
+			field:  "Hash",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMessage()) < 1 {
+		err := CommitValidationError{
+			field:  "Message",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAuthor()) < 1 {
+		err := CommitValidationError{
+			field:  "Author",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBranch()) < 1 {
+		err := CommitValidationError{
+			field:  "Branch",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PullRequest
+
+	// no validation rules for Url
+
+	if m.GetCreatedAt() <= 0 {
+		err := CommitValidationError{
+			field:  "CreatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CommitMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommitMultiError is an error wrapping multiple validation errors returned by
+// Commit.ValidateAll() if the designated constraints aren't met.
+type CommitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommitMultiError) AllErrors() []error { return m }
+
+// CommitValidationError is the validation error returned by Commit.Validate if
+// the designated constraints aren't met.
+type CommitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommitValidationError) ErrorName() string { return "CommitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommitValidationError{}
+
+// Validate checks the field values on DeploymentMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeploymentMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeploymentMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeploymentMetadataMultiError, or nil if none found.
+func (m *DeploymentMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeploymentMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetShared()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeploymentMetadataValidationError{
+					field:  "Shared",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeploymentMetadataValidationError{
+					field:  "Shared",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShared()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeploymentMetadataValidationError{
+				field:  "Shared",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetPlugins()))
+		i := 0
+		for key := range m.GetPlugins() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetPlugins()[key]
+			_ = val
+
+			// no validation rules for Plugins[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, DeploymentMetadataValidationError{
+							field:  fmt.Sprintf("Plugins[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, DeploymentMetadataValidationError{
+							field:  fmt.Sprintf("Plugins[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return DeploymentMetadataValidationError{
+						field:  fmt.Sprintf("Plugins[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeploymentMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeploymentMetadataMultiError is an error wrapping multiple validation errors
+// returned by DeploymentMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type DeploymentMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeploymentMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeploymentMetadataMultiError) AllErrors() []error { return m }
+
+// DeploymentMetadataValidationError is the validation error returned by
+// DeploymentMetadata.Validate if the designated constraints aren't met.
+type DeploymentMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeploymentMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeploymentMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeploymentMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeploymentMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeploymentMetadataValidationError) ErrorName() string {
+	return "DeploymentMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeploymentMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeploymentMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeploymentMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeploymentMetadataValidationError{}
+
+// Validate checks the field values on DeploymentMetadata_KeyValues with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeploymentMetadata_KeyValues) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeploymentMetadata_KeyValues with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeploymentMetadata_KeyValuesMultiError, or nil if none found.
+func (m *DeploymentMetadata_KeyValues) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeploymentMetadata_KeyValues) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KeyValues
+
+	if len(errors) > 0 {
+		return DeploymentMetadata_KeyValuesMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeploymentMetadata_KeyValuesMultiError is an error wrapping multiple
+// validation errors returned by DeploymentMetadata_KeyValues.ValidateAll() if
+// the designated constraints aren't met.
+type DeploymentMetadata_KeyValuesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeploymentMetadata_KeyValuesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeploymentMetadata_KeyValuesMultiError) AllErrors() []error { return m }
+
+// DeploymentMetadata_KeyValuesValidationError is the validation error returned
+// by DeploymentMetadata_KeyValues.Validate if the designated constraints
+// aren't met.
+type DeploymentMetadata_KeyValuesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeploymentMetadata_KeyValuesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeploymentMetadata_KeyValuesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeploymentMetadata_KeyValuesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeploymentMetadata_KeyValuesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeploymentMetadata_KeyValuesValidationError) ErrorName() string {
+	return "DeploymentMetadata_KeyValuesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeploymentMetadata_KeyValuesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeploymentMetadata_KeyValues.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeploymentMetadata_KeyValuesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeploymentMetadata_KeyValuesValidationError{}
+
+// Validate checks the field values on DeploymentArtifact with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *DeploymentArtifact) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeploymentArtifact with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeploymentArtifactMultiError, or nil if none found.
+func (m *DeploymentArtifact) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeploymentArtifact) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetImages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeploymentArtifactValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeploymentArtifactValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeploymentArtifactValidationError{
+					field:  fmt.Sprintf("Images[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ChartVersion
+
+	// no validation rules for ManifestsHash
+
+	// no validation rules for PromotedFromDeploymentId
+
+	if len(errors) > 0 {
+		return DeploymentArtifactMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeploymentArtifactMultiError is an error wrapping multiple validation errors
+// returned by DeploymentArtifact.ValidateAll() if the designated constraints
+// aren't met.
+type DeploymentArtifactMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeploymentArtifactMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeploymentArtifactMultiError) AllErrors() []error { return m }
+
+// DeploymentArtifactValidationError is the validation error returned by
+// DeploymentArtifact.Validate if the designated constraints aren't met.
+type DeploymentArtifactValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeploymentArtifactValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeploymentArtifactValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeploymentArtifactValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeploymentArtifactValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeploymentArtifactValidationError) ErrorName() string {
+	return "DeploymentArtifactValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeploymentArtifactValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeploymentArtifact.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeploymentArtifactValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeploymentArtifactValidationError{}
+
+// Validate checks the field values on ArtifactImage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ArtifactImage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArtifactImage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ArtifactImageMultiError, or
+// nil if none found.
+func (m *ArtifactImage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArtifactImage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetReference()) < 1 {
+		err := ArtifactImageValidationError{
+			field:  "Reference",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := ArtifactImageValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Tag
+
+	// no validation rules for Digest
+
+	if len(errors) > 0 {
+		return ArtifactImageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArtifactImageMultiError is an error wrapping multiple validation errors
+// returned by ArtifactImage.ValidateAll() if the designated constraints
+// aren't met.
+type ArtifactImageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArtifactImageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArtifactImageMultiError) AllErrors() []error { return m }
+
+// ArtifactImageValidationError is the validation error returned by
+// ArtifactImage.Validate if the designated constraints aren't met.
+type ArtifactImageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArtifactImageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArtifactImageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArtifactImageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArtifactImageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArtifactImageValidationError) ErrorName() string { return "ArtifactImageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ArtifactImageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArtifactImage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArtifactImageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArtifactImageValidationError{}
+
+// Validate checks the field values on DeploymentDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeploymentDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeploymentDiff with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeploymentDiffMultiError,
+// or nil if none found.
+func (m *DeploymentDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeploymentDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RunningCommitHash
+
+	if utf8.RuneCountInString(m.GetTargetCommitHash()) < 1 {
+		err := DeploymentDiffValidationError{
+			field:  "TargetCommitHash",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for NoChange
+
+	// no validation rules for Summary
+
+	// no validation rules for Details
+
+	if m.GetCreatedAt() <= 0 {
+		err := DeploymentDiffValidationError{
+			field:  "CreatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ChangedResources
+
+	// no validation rules for DeletedResources
+
+	if len(errors) > 0 {
+		return DeploymentDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeploymentDiffMultiError is an error wrapping multiple validation errors
+// returned by DeploymentDiff.ValidateAll() if the designated constraints
+// aren't met.
+type DeploymentDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeploymentDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeploymentDiffMultiError) AllErrors() []error { return m }
+
+// DeploymentDiffValidationError is the validation error returned by
+// DeploymentDiff.Validate if the designated constraints aren't met.
+type DeploymentDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeploymentDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeploymentDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeploymentDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeploymentDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeploymentDiffValidationError) ErrorName() string { return "DeploymentDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeploymentDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeploymentDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeploymentDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeploymentDiffValidationError{}
+
+// Validate checks the field values on DeploymentRisk with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeploymentRisk) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeploymentMetadata_KeyValues with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeploymentRisk with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeploymentRiskMultiError,
+// or nil if none found.
+func (m *DeploymentRisk) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeploymentRisk) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Score
+
+	for idx, item := range m.GetFactors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeploymentRiskValidationError{
+						field:  fmt.Sprintf("Factors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeploymentRiskValidationError{
+						field:  fmt.Sprintf("Factors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeploymentRiskValidationError{
+					field:  fmt.Sprintf("Factors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DeploymentRiskMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeploymentRiskMultiError is an error wrapping multiple validation errors
+// returned by DeploymentRisk.ValidateAll() if the designated constraints
+// aren't met.
+type DeploymentRiskMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeploymentRiskMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeploymentRiskMultiError) AllErrors() []error { return m }
+
+// DeploymentRiskValidationError is the validation error returned by
+// DeploymentRisk.Validate if the designated constraints aren't met.
+type DeploymentRiskValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeploymentRiskValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeploymentRiskValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeploymentRiskValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeploymentRiskValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeploymentRiskValidationError) ErrorName() string { return "DeploymentRiskValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeploymentRiskValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeploymentRisk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeploymentRiskValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeploymentRiskValidationError{}
+
+// Validate checks the field values on DeploymentRiskFactor with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *DeploymentRiskFactor) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeploymentRiskFactor with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeploymentMetadata_KeyValuesMultiError, or nil if none found.
-func (m *DeploymentMetadata_KeyValues) ValidateAll() error {
+// DeploymentRiskFactorMultiError, or nil if none found.
+func (m *DeploymentRiskFactor) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeploymentMetadata_KeyValues) validate(all bool) error {
+func (m *DeploymentRiskFactor) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for KeyValues
+	// no validation rules for Name
+
+	// no validation rules for Score
+
+	// no validation rules for Description
 
 	if len(errors) > 0 {
-		return DeploymentMetadata_KeyValuesMultiError(errors)
+		return DeploymentRiskFactorMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeploymentMetadata_KeyValuesMultiError is an error wrapping multiple
-// validation errors returned by DeploymentMetadata_KeyValues.ValidateAll() if
-// the designated constraints aren't met.
-type DeploymentMetadata_KeyValuesMultiError []error
+// DeploymentRiskFactorMultiError is an error wrapping multiple validation
+// errors returned by DeploymentRiskFactor.ValidateAll() if the designated
+// constraints aren't met.
+type DeploymentRiskFactorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeploymentMetadata_KeyValuesMultiError) Error() string {
+func (m DeploymentRiskFactorMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1323,12 +2659,11 @@ func (m DeploymentMetadata_KeyValuesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeploymentMetadata_KeyValuesMultiError) AllErrors() []error { return m }
+func (m DeploymentRiskFactorMultiError) AllErrors() []error { return m }
 
-// DeploymentMetadata_KeyValuesValidationError is the validation error returned
-// by DeploymentMetadata_KeyValues.Validate if the designated constraints
-// aren't met.
-type DeploymentMetadata_KeyValuesValidationError struct {
+// DeploymentRiskFactorValidationError is the validation error returned by
+// DeploymentRiskFactor.Validate if the designated constraints aren't met.
+type DeploymentRiskFactorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1336,24 +2671,24 @@ type DeploymentMetadata_KeyValuesValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeploymentMetadata_KeyValuesValidationError) Field() string { return e.field }
+func (e DeploymentRiskFactorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeploymentMetadata_KeyValuesValidationError) Reason() string { return e.reason }
+func (e DeploymentRiskFactorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeploymentMetadata_KeyValuesValidationError) Cause() error { return e.cause }
+func (e DeploymentRiskFactorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeploymentMetadata_KeyValuesValidationError) Key() bool { return e.key }
+func (e DeploymentRiskFactorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeploymentMetadata_KeyValuesValidationError) ErrorName() string {
-	return "DeploymentMetadata_KeyValuesValidationError"
+func (e DeploymentRiskFactorValidationError) ErrorName() string {
+	return "DeploymentRiskFactorValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeploymentMetadata_KeyValuesValidationError) Error() string {
+func (e DeploymentRiskFactorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1365,14 +2700,14 @@ func (e DeploymentMetadata_KeyValuesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeploymentMetadata_KeyValues.%s: %s%s",
+		"invalid %sDeploymentRiskFactor.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeploymentMetadata_KeyValuesValidationError{}
+var _ error = DeploymentRiskFactorValidationError{}
 
 var _ interface {
 	Field() string
@@ -1380,4 +2715,150 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeploymentMetadata_KeyValuesValidationError{}
+} = DeploymentRiskFactorValidationError{}
+
+// Validate checks the field values on StageArtifact with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StageArtifact) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StageArtifact with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StageArtifactMultiError, or
+// nil if none found.
+func (m *StageArtifact) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StageArtifact) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := StageArtifactValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := StageArtifactValidationError{
+			field:  "StageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := StageArtifactValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Size
+
+	if m.GetCreatedAt() <= 0 {
+		err := StageArtifactValidationError{
+			field:  "CreatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StageArtifactMultiError(errors)
+	}
+
+	return nil
+}
+
+// StageArtifactMultiError is an error wrapping multiple validation errors
+// returned by StageArtifact.ValidateAll() if the designated constraints
+// aren't met.
+type StageArtifactMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StageArtifactMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StageArtifactMultiError) AllErrors() []error { return m }
+
+// StageArtifactValidationError is the validation error returned by
+// StageArtifact.Validate if the designated constraints aren't met.
+type StageArtifactValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StageArtifactValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StageArtifactValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StageArtifactValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StageArtifactValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StageArtifactValidationError) ErrorName() string { return "StageArtifactValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StageArtifactValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStageArtifact.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StageArtifactValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StageArtifactValidationError{}
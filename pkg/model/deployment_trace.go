@@ -14,11 +14,97 @@
 
 package model
 
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
 const (
 	// The key to store the commit hash that triggers the event (in EventWatcher flow) as metadata in the commit body.
 	TraceTriggerCommitHashKey = "Pipecd-Dev-Trace-Trigger-Commit-Hash"
+	// The key to store the author of the commit that triggers the event as metadata in the commit body.
+	TraceTriggerCommitAuthorKey = "Pipecd-Dev-Trace-Trigger-Commit-Author"
+	// The key to store the URL of the commit that triggers the event as metadata in the commit body.
+	TraceTriggerCommitURLKey = "Pipecd-Dev-Trace-Trigger-Commit-Url"
+	// The key to store the URL of the upstream CI run that sent the event as metadata in the commit body.
+	TraceTriggerRunURLKey = "Pipecd-Dev-Trace-Trigger-Run-Url"
+	// The key to store the name of the event as metadata in the commit body.
+	TraceEventNameKey = "Pipecd-Dev-Trace-Event-Name"
+	// The key to store the labels of the event as metadata in the commit body.
+	// The labels are formatted as "key1=value1,key2=value2" with their keys and values escaped
+	// in the same way as URL query parameters since they can contain ',' and '='.
+	TraceEventLabelsKey = "Pipecd-Dev-Trace-Event-Labels"
 )
 
 func (d *DeploymentTrace) SetUpdatedAt(t int64) {
 	d.UpdatedAt = t
 }
+
+// MakeTraceTrailers returns the commit trailers used to trace back
+// from the commit made for this event to what caused it.
+func (e *Event) MakeTraceTrailers() map[string]string {
+	trailers := make(map[string]string)
+	add := func(key, value string) {
+		if value != "" {
+			trailers[key] = value
+		}
+	}
+	add(TraceEventNameKey, e.Name)
+	add(TraceEventLabelsKey, encodeTraceLabels(e.Labels))
+	add(TraceTriggerCommitHashKey, e.TriggerCommitHash)
+	add(TraceTriggerCommitAuthorKey, e.TriggerCommitAuthor)
+	add(TraceTriggerCommitURLKey, e.TriggerCommitUrl)
+	add(TraceTriggerRunURLKey, e.TriggerRunUrl)
+	return trailers
+}
+
+// MakeDeploymentTriggerTrace builds the trigger trace from the trailers of the commit being deployed.
+// Nil is returned when the commit was not made for an event.
+func MakeDeploymentTriggerTrace(trailer func(key string) string) *DeploymentTriggerTrace {
+	trace := &DeploymentTriggerTrace{
+		EventName:    trailer(TraceEventNameKey),
+		EventLabels:  decodeTraceLabels(trailer(TraceEventLabelsKey)),
+		CommitHash:   trailer(TraceTriggerCommitHashKey),
+		CommitAuthor: trailer(TraceTriggerCommitAuthorKey),
+		CommitUrl:    trailer(TraceTriggerCommitURLKey),
+		RunUrl:       trailer(TraceTriggerRunURLKey),
+	}
+	if trace.EventName == "" && trace.CommitHash == "" && trace.RunUrl == "" {
+		return nil
+	}
+	return trace
+}
+
+func encodeTraceLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, url.QueryEscape(k)+"="+url.QueryEscape(v))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func decodeTraceLabels(s string) map[string]string {
+	if s == "" {
+		return nil
+	}
+	labels := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		labels[unescapeTraceLabel(k)] = unescapeTraceLabel(v)
+	}
+	return labels
+}
+
+// unescapeTraceLabel returns the given key or value of a label as is
+// when it was not escaped such as the ones encoded by older versions.
+func unescapeTraceLabel(s string) string {
+	if u, err := url.QueryUnescape(s); err == nil {
+		return u
+	}
+	return s
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeploymentTriggerTraceRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	event := &Event{
+		Name: "image-update",
+		Labels: map[string]string{
+			"env": "prod",
+			"app": "foo",
+		},
+		TriggerCommitHash:   "abcdef",
+		TriggerCommitAuthor: "alice",
+		TriggerCommitUrl:    "https://github.com/org/app/commit/abcdef",
+		TriggerRunUrl:       "https://github.com/org/app/actions/runs/1",
+	}
+	trailers := event.MakeTraceTrailers()
+	assert.Equal(t, "app=foo,env=prod", trailers[TraceEventLabelsKey])
+
+	got := MakeDeploymentTriggerTrace(func(key string) string { return trailers[key] })
+	assert.Equal(t, &DeploymentTriggerTrace{
+		EventName:    "image-update",
+		EventLabels:  map[string]string{"env": "prod", "app": "foo"},
+		CommitHash:   "abcdef",
+		CommitAuthor: "alice",
+		CommitUrl:    "https://github.com/org/app/commit/abcdef",
+		RunUrl:       "https://github.com/org/app/actions/runs/1",
+	}, got)
+}
+
+func TestTraceLabelsWithSeparators(t *testing.T) {
+	t.Parallel()
+
+	labels := map[string]string{
+		"team":       "a,b",
+		"selector":   "env=prod",
+		"a=b,c":      "d",
+		"with space": "100%",
+	}
+	encoded := encodeTraceLabels(labels)
+	assert.Equal(t, "a%3Db%2Cc=d,selector=env%3Dprod,team=a%2Cb,with+space=100%25", encoded)
+	assert.Equal(t, labels, decodeTraceLabels(encoded))
+
+	// The labels encoded without escaping are still decoded.
+	assert.Equal(t, map[string]string{"env": "prod", "app": "foo"}, decodeTraceLabels("app=foo,env=prod"))
+}
+
+func TestMakeDeploymentTriggerTraceWithoutTrailers(t *testing.T) {
+	t.Parallel()
+
+	got := MakeDeploymentTriggerTrace(func(string) string { return "" })
+	assert.Nil(t, got)
+}
@@ -155,6 +155,12 @@ func (m *Event) validate(all bool) error {
 
 	// no validation rules for TriggerCommitHash
 
+	// no validation rules for TriggerCommitAuthor
+
+	// no validation rules for TriggerCommitUrl
+
+	// no validation rules for TriggerRunUrl
+
 	// no validation rules for HandledAt
 
 	if m.GetCreatedAt() <= 0 {
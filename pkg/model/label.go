@@ -0,0 +1,84 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	maxLabelKeyNameLength   = 63
+	maxLabelKeyPrefixLength = 253
+	maxLabelValueLength     = 63
+)
+
+var (
+	labelNameRegex   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	labelPrefixRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// ValidateLabelKey checks whether the given label key is valid.
+// Same as Kubernetes, a key consists of an optional DNS subdomain prefix
+// and a name separated by a slash, e.g. "pipecd.dev/team".
+func ValidateLabelKey(key string) error {
+	name := key
+	if i := strings.LastIndex(key, "/"); i >= 0 {
+		prefix := key[:i]
+		if prefix == "" || len(prefix) > maxLabelKeyPrefixLength || !labelPrefixRegex.MatchString(prefix) {
+			return fmt.Errorf("invalid label key %q: prefix must be a DNS subdomain", key)
+		}
+		name = key[i+1:]
+	}
+	if name == "" || len(name) > maxLabelKeyNameLength || !labelNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid label key %q: name must consist of at most %d alphanumeric characters, '-', '_' or '.', and start and end with an alphanumeric character", key, maxLabelKeyNameLength)
+	}
+	return nil
+}
+
+// ValidateLabelValue checks whether the given label value is valid.
+func ValidateLabelValue(value string) error {
+	if value == "" {
+		return nil
+	}
+	if len(value) > maxLabelValueLength || !labelNameRegex.MatchString(value) {
+		return fmt.Errorf("invalid label value %q: value must consist of at most %d alphanumeric characters, '-', '_' or '.', and start and end with an alphanumeric character", value, maxLabelValueLength)
+	}
+	return nil
+}
+
+// ApplyLabelChanges returns a copy of the given labels with the changes applied.
+// The second returned value is false when nothing was changed.
+func ApplyLabelChanges(labels, add map[string]string, remove []string) (map[string]string, bool) {
+	out := make(map[string]string, len(labels)+len(add))
+	for k, v := range labels {
+		out[k] = v
+	}
+	changed := false
+	for _, k := range remove {
+		if _, ok := out[k]; ok {
+			delete(out, k)
+			changed = true
+		}
+	}
+	for k, v := range add {
+		if cur, ok := out[k]; !ok || cur != v {
+			out[k] = v
+			changed = true
+		}
+	}
+	return out, changed
+}
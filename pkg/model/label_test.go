@@ -0,0 +1,58 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateLabelKey(t *testing.T) {
+	valid := []string{"team", "app.kubernetes.io/name", "pipecd.dev/Team_1", "a"}
+	for _, k := range valid {
+		assert.NoError(t, ValidateLabelKey(k), k)
+	}
+	invalid := []string{"", "/team", "-team", "team-", "Pipecd.dev/team", "pipecd.dev/", "a b", strings.Repeat("a", 64)}
+	for _, k := range invalid {
+		assert.Error(t, ValidateLabelKey(k), k)
+	}
+}
+
+func TestValidateLabelValue(t *testing.T) {
+	assert.NoError(t, ValidateLabelValue(""))
+	assert.NoError(t, ValidateLabelValue("v1.2.3"))
+	assert.Error(t, ValidateLabelValue("v1 "))
+	assert.Error(t, ValidateLabelValue(strings.Repeat("a", 64)))
+}
+
+func TestApplyLabelChanges(t *testing.T) {
+	labels := map[string]string{"team": "a", "env": "dev"}
+
+	got, changed := ApplyLabelChanges(labels, map[string]string{"team": "b"}, []string{"env", "missing"})
+	assert.True(t, changed)
+	assert.Equal(t, map[string]string{"team": "b"}, got)
+	// The given labels must not be modified.
+	assert.Equal(t, map[string]string{"team": "a", "env": "dev"}, labels)
+
+	got, changed = ApplyLabelChanges(labels, map[string]string{"team": "a"}, []string{"missing"})
+	assert.False(t, changed)
+	assert.Equal(t, labels, got)
+
+	got, changed = ApplyLabelChanges(nil, map[string]string{"team": "a"}, nil)
+	assert.True(t, changed)
+	assert.Equal(t, map[string]string{"team": "a"}, got)
+}
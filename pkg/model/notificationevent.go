@@ -76,6 +76,14 @@ func (e *NotificationEventDeploymentSucceeded) GetLabels() map[string]string {
 	return e.Deployment.Labels
 }
 
+func (e *NotificationEventDeploymentTimedOut) GetAppName() string {
+	return e.Deployment.ApplicationName
+}
+
+func (e *NotificationEventDeploymentTimedOut) GetLabels() map[string]string {
+	return e.Deployment.Labels
+}
+
 func (e *NotificationEventDeploymentFailed) GetAppName() string {
 	return e.Deployment.ApplicationName
 }
@@ -100,6 +108,14 @@ func (e *NotificationEventDeploymentWaitApproval) GetLabels() map[string]string
 	return e.Deployment.Labels
 }
 
+func (e *NotificationEventDeploymentCommented) GetAppName() string {
+	return e.Deployment.ApplicationName
+}
+
+func (e *NotificationEventDeploymentCommented) GetLabels() map[string]string {
+	return e.Deployment.Labels
+}
+
 func (e *NotificationEventDeploymentTriggerFailed) GetAppName() string {
 	return e.Application.Name
 }
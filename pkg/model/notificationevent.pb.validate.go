@@ -1204,6 +1204,153 @@ var _ interface {
 	ErrorName() string
 } = NotificationEventDeploymentCancelledValidationError{}
 
+// Validate checks the field values on NotificationEventDeploymentTimedOut with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *NotificationEventDeploymentTimedOut) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationEventDeploymentTimedOut
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// NotificationEventDeploymentTimedOutMultiError, or nil if none found.
+func (m *NotificationEventDeploymentTimedOut) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationEventDeploymentTimedOut) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDeployment() == nil {
+		err := NotificationEventDeploymentTimedOutValidationError{
+			field:  "Deployment",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeployment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationEventDeploymentTimedOutValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationEventDeploymentTimedOutValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationEventDeploymentTimedOutValidationError{
+				field:  "Deployment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timeout
+
+	if len(errors) > 0 {
+		return NotificationEventDeploymentTimedOutMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationEventDeploymentTimedOutMultiError is an error wrapping multiple
+// validation errors returned by
+// NotificationEventDeploymentTimedOut.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationEventDeploymentTimedOutMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationEventDeploymentTimedOutMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationEventDeploymentTimedOutMultiError) AllErrors() []error { return m }
+
+// NotificationEventDeploymentTimedOutValidationError is the validation error
+// returned by NotificationEventDeploymentTimedOut.Validate if the designated
+// constraints aren't met.
+type NotificationEventDeploymentTimedOutValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationEventDeploymentTimedOutValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationEventDeploymentTimedOutValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationEventDeploymentTimedOutValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationEventDeploymentTimedOutValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationEventDeploymentTimedOutValidationError) ErrorName() string {
+	return "NotificationEventDeploymentTimedOutValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationEventDeploymentTimedOutValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationEventDeploymentTimedOut.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationEventDeploymentTimedOutValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationEventDeploymentTimedOutValidationError{}
+
 // Validate checks the field values on NotificationEventDeploymentWaitApproval
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
@@ -1268,6 +1415,35 @@ func (m *NotificationEventDeploymentWaitApproval) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetRisk()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationEventDeploymentWaitApprovalValidationError{
+					field:  "Risk",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationEventDeploymentWaitApprovalValidationError{
+					field:  "Risk",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRisk()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationEventDeploymentWaitApprovalValidationError{
+				field:  "Risk",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return NotificationEventDeploymentWaitApprovalMultiError(errors)
 	}
@@ -1350,6 +1526,195 @@ var _ interface {
 	ErrorName() string
 } = NotificationEventDeploymentWaitApprovalValidationError{}
 
+// Validate checks the field values on NotificationEventDeploymentCommented
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *NotificationEventDeploymentCommented) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationEventDeploymentCommented
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// NotificationEventDeploymentCommentedMultiError, or nil if none found.
+func (m *NotificationEventDeploymentCommented) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationEventDeploymentCommented) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDeployment() == nil {
+		err := NotificationEventDeploymentCommentedValidationError{
+			field:  "Deployment",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeployment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationEventDeploymentCommentedValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationEventDeploymentCommentedValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationEventDeploymentCommentedValidationError{
+				field:  "Deployment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetComment() == nil {
+		err := NotificationEventDeploymentCommentedValidationError{
+			field:  "Comment",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetComment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationEventDeploymentCommentedValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationEventDeploymentCommentedValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationEventDeploymentCommentedValidationError{
+				field:  "Comment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MentionedAccounts
+
+	// no validation rules for MentionedGroups
+
+	if len(errors) > 0 {
+		return NotificationEventDeploymentCommentedMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationEventDeploymentCommentedMultiError is an error wrapping multiple
+// validation errors returned by
+// NotificationEventDeploymentCommented.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationEventDeploymentCommentedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationEventDeploymentCommentedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationEventDeploymentCommentedMultiError) AllErrors() []error { return m }
+
+// NotificationEventDeploymentCommentedValidationError is the validation error
+// returned by NotificationEventDeploymentCommented.Validate if the designated
+// constraints aren't met.
+type NotificationEventDeploymentCommentedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationEventDeploymentCommentedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationEventDeploymentCommentedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationEventDeploymentCommentedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationEventDeploymentCommentedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationEventDeploymentCommentedValidationError) ErrorName() string {
+	return "NotificationEventDeploymentCommentedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationEventDeploymentCommentedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationEventDeploymentCommented.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationEventDeploymentCommentedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationEventDeploymentCommentedValidationError{}
+
 // Validate checks the field values on NotificationEventDeploymentTriggerFailed
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
@@ -1817,6 +2182,10 @@ func (m *NotificationEventApplicationOutOfSync) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Diff
+
+	// no validation rules for DiffTruncated
+
 	if len(errors) > 0 {
 		return NotificationEventApplicationOutOfSyncMultiError(errors)
 	}
@@ -15,8 +15,11 @@
 package model
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -58,18 +61,41 @@ func GeneratePipedKey() (key, hash string, err error) {
 
 // CheckKey checks if the give key is one of the stored keys.
 func (p *Piped) CheckKey(key string) (err error) {
+	_, err = p.FindKey(key)
+	return
+}
+
+// FindKey returns the stored key matching the given one.
+func (p *Piped) FindKey(key string) (*PipedKey, error) {
 	if len(p.Keys) == 0 {
-		return errors.New("piped does not contain any key")
+		return nil, errors.New("piped does not contain any key")
 	}
 
+	var err error
 	for _, k := range p.Keys {
 		err = bcrypt.CompareHashAndPassword([]byte(k.Hash), []byte(key))
 		if err == nil {
-			return nil
+			return k, nil
 		}
 	}
 
-	return
+	return nil, err
+}
+
+// HasKeyID checks if the key of the given ID is one of the stored keys.
+func (p *Piped) HasKeyID(id string) bool {
+	for _, k := range p.Keys {
+		if k.ID() == id {
+			return true
+		}
+	}
+	return false
+}
+
+// ID returns the identifier of the key, which can be shared without exposing its hash.
+func (k *PipedKey) ID() string {
+	sum := sha256.Sum256([]byte(k.Hash))
+	return hex.EncodeToString(sum[:8])
 }
 
 // AddKey adds a new key to the list.
@@ -118,6 +144,45 @@ func (p *Piped) SetUpdatedAt(t int64) {
 	p.UpdatedAt = t
 }
 
+// ContainLabels checks if it has all the given labels.
+func (p *Piped) ContainLabels(labels map[string]string) bool {
+	if len(p.Labels) < len(labels) {
+		return false
+	}
+
+	for k, v := range labels {
+		value, ok := p.Labels[k]
+		if !ok {
+			return false
+		}
+		if value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// PickPipeds returns the given percentage of the pipeds, rounded up.
+// The pipeds are picked in the order of their IDs, so that the pipeds picked
+// with a percentage are always included in the ones picked with a larger one.
+// No piped is picked with zero or a negative percentage.
+func PickPipeds(pipeds []*Piped, percentage int) []*Piped {
+	sorted := make([]*Piped, len(pipeds))
+	copy(sorted, pipeds)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Id < sorted[j].Id
+	})
+
+	if percentage <= 0 {
+		return nil
+	}
+	if percentage >= 100 {
+		return sorted
+	}
+	n := (len(sorted)*percentage + 99) / 100
+	return sorted[:n]
+}
+
 func MakePipedURL(baseURL, pipedID string) string {
 	return fmt.Sprintf("%s/settings/piped", strings.TrimSuffix(baseURL, "/"))
 }
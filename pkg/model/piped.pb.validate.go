@@ -244,6 +244,8 @@ func (m *Piped) validate(all bool) error {
 
 	}
 
+	// no validation rules for Labels
+
 	if all {
 		switch v := interface{}(m.GetSecretEncryption()).(type) {
 		case interface{ ValidateAll() error }:
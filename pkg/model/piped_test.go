@@ -256,3 +256,59 @@ func TestMakePipedURL(t *testing.T) {
 		})
 	}
 }
+
+func TestPipedContainLabels(t *testing.T) {
+	piped := &Piped{
+		Labels: map[string]string{"env": "dev", "region": "asia"},
+	}
+	assert.True(t, piped.ContainLabels(nil))
+	assert.True(t, piped.ContainLabels(map[string]string{"env": "dev"}))
+	assert.True(t, piped.ContainLabels(map[string]string{"env": "dev", "region": "asia"}))
+	assert.False(t, piped.ContainLabels(map[string]string{"env": "prd"}))
+	assert.False(t, piped.ContainLabels(map[string]string{"team": "a"}))
+}
+
+func TestPickPipeds(t *testing.T) {
+	pipeds := []*Piped{{Id: "piped-3"}, {Id: "piped-1"}, {Id: "piped-4"}, {Id: "piped-2"}}
+	ids := func(ps []*Piped) []string {
+		out := make([]string, 0, len(ps))
+		for _, p := range ps {
+			out = append(out, p.Id)
+		}
+		return out
+	}
+
+	testcases := []struct {
+		name       string
+		percentage int
+		expected   []string
+	}{
+		{
+			name:       "zero means none",
+			percentage: 0,
+			expected:   []string{},
+		},
+		{
+			name:       "rounded up",
+			percentage: 10,
+			expected:   []string{"piped-1"},
+		},
+		{
+			name:       "half",
+			percentage: 50,
+			expected:   []string{"piped-1", "piped-2"},
+		},
+		{
+			name:       "all",
+			percentage: 100,
+			expected:   []string{"piped-1", "piped-2", "piped-3", "piped-4"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := PickPipeds(pipeds, tc.percentage)
+			assert.Equal(t, tc.expected, ids(got))
+		})
+	}
+	assert.Equal(t, "piped-3", pipeds[0].Id, "the given slice must not be reordered")
+}
@@ -35,6 +35,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PlanPreviewResourceChange_Action int32
+
+const (
+	PlanPreviewResourceChange_UNKNOWN PlanPreviewResourceChange_Action = 0
+	PlanPreviewResourceChange_ADD     PlanPreviewResourceChange_Action = 1
+	PlanPreviewResourceChange_CHANGE  PlanPreviewResourceChange_Action = 2
+	PlanPreviewResourceChange_DELETE  PlanPreviewResourceChange_Action = 3
+)
+
+// Enum value maps for PlanPreviewResourceChange_Action.
+var (
+	PlanPreviewResourceChange_Action_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "ADD",
+		2: "CHANGE",
+		3: "DELETE",
+	}
+	PlanPreviewResourceChange_Action_value = map[string]int32{
+		"UNKNOWN": 0,
+		"ADD":     1,
+		"CHANGE":  2,
+		"DELETE":  3,
+	}
+)
+
+func (x PlanPreviewResourceChange_Action) Enum() *PlanPreviewResourceChange_Action {
+	p := new(PlanPreviewResourceChange_Action)
+	*p = x
+	return p
+}
+
+func (x PlanPreviewResourceChange_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanPreviewResourceChange_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_model_planpreview_proto_enumTypes[0].Descriptor()
+}
+
+func (PlanPreviewResourceChange_Action) Type() protoreflect.EnumType {
+	return &file_pkg_model_planpreview_proto_enumTypes[0]
+}
+
+func (x PlanPreviewResourceChange_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanPreviewResourceChange_Action.Descriptor instead.
+func (PlanPreviewResourceChange_Action) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_model_planpreview_proto_rawDescGZIP(), []int{2, 0}
+}
+
 type PlanPreviewCommandResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -151,6 +203,15 @@ type ApplicationPlanPreviewResult struct {
 	PlanDetails  []byte       `protobuf:"bytes,32,opt,name=plan_details,json=planDetails,proto3" json:"plan_details,omitempty"`
 	// Mark if no change were detected.
 	NoChange bool `protobuf:"varint,33,opt,name=no_change,json=noChange,proto3" json:"no_change,omitempty"`
+	// The syntax of plan_details, used as a hint for highlighting it.
+	// e.g. "diff" for the manifest based kinds and "hcl" for Terraform.
+	PlanDetailsSyntax string `protobuf:"bytes,34,opt,name=plan_details_syntax,json=planDetailsSyntax,proto3" json:"plan_details_syntax,omitempty"`
+	// Mark if plan_details was truncated because it exceeded the size limit.
+	PlanDetailsTruncated bool `protobuf:"varint,35,opt,name=plan_details_truncated,json=planDetailsTruncated,proto3" json:"plan_details_truncated,omitempty"`
+	// The list of resources that will be changed by this plan.
+	ResourceChanges []*PlanPreviewResourceChange `protobuf:"bytes,36,rep,name=resource_changes,json=resourceChanges,proto3" json:"resource_changes,omitempty"`
+	// The list of stages that will be run by the deployment triggered by this plan.
+	Stages []*PlanPreviewStage `protobuf:"bytes,37,rep,name=stages,proto3" json:"stages,omitempty"`
 	// Error while building planpreview result.
 	Error     string `protobuf:"bytes,40,opt,name=error,proto3" json:"error,omitempty"`
 	CreatedAt int64  `protobuf:"varint,90,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
@@ -286,6 +347,34 @@ func (x *ApplicationPlanPreviewResult) GetNoChange() bool {
 	return false
 }
 
+func (x *ApplicationPlanPreviewResult) GetPlanDetailsSyntax() string {
+	if x != nil {
+		return x.PlanDetailsSyntax
+	}
+	return ""
+}
+
+func (x *ApplicationPlanPreviewResult) GetPlanDetailsTruncated() bool {
+	if x != nil {
+		return x.PlanDetailsTruncated
+	}
+	return false
+}
+
+func (x *ApplicationPlanPreviewResult) GetResourceChanges() []*PlanPreviewResourceChange {
+	if x != nil {
+		return x.ResourceChanges
+	}
+	return nil
+}
+
+func (x *ApplicationPlanPreviewResult) GetStages() []*PlanPreviewStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
 func (x *ApplicationPlanPreviewResult) GetError() string {
 	if x != nil {
 		return x.Error
@@ -300,6 +389,130 @@ func (x *ApplicationPlanPreviewResult) GetCreatedAt() int64 {
 	return 0
 }
 
+type PlanPreviewResourceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Kind-specific readable identifier of the resource.
+	// e.g. "apps/v1:Deployment:default:simple" for Kubernetes, "aws_instance.web" for Terraform.
+	Resource string                           `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Action   PlanPreviewResourceChange_Action `protobuf:"varint,2,opt,name=action,proto3,enum=model.PlanPreviewResourceChange_Action" json:"action,omitempty"`
+}
+
+func (x *PlanPreviewResourceChange) Reset() {
+	*x = PlanPreviewResourceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_planpreview_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanPreviewResourceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPreviewResourceChange) ProtoMessage() {}
+
+func (x *PlanPreviewResourceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_planpreview_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPreviewResourceChange.ProtoReflect.Descriptor instead.
+func (*PlanPreviewResourceChange) Descriptor() ([]byte, []int) {
+	return file_pkg_model_planpreview_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlanPreviewResourceChange) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *PlanPreviewResourceChange) GetAction() PlanPreviewResourceChange_Action {
+	if x != nil {
+		return x.Action
+	}
+	return PlanPreviewResourceChange_UNKNOWN
+}
+
+type PlanPreviewStage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the stage. e.g. K8S_CANARY_ROLLOUT, ANALYSIS.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The human-readable description of the stage.
+	Desc string `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	// The key parameters of the stage in "key: value" format.
+	// e.g. "duration: 10m0s" for ANALYSIS stage, "minApproverNum: 2" for WAIT_APPROVAL stage.
+	Parameters []string `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty"`
+}
+
+func (x *PlanPreviewStage) Reset() {
+	*x = PlanPreviewStage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_planpreview_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanPreviewStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPreviewStage) ProtoMessage() {}
+
+func (x *PlanPreviewStage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_planpreview_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPreviewStage.ProtoReflect.Descriptor instead.
+func (*PlanPreviewStage) Descriptor() ([]byte, []int) {
+	return file_pkg_model_planpreview_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PlanPreviewStage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlanPreviewStage) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *PlanPreviewStage) GetParameters() []string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
 var File_pkg_model_planpreview_proto protoreflect.FileDescriptor
 
 var file_pkg_model_planpreview_proto_rawDesc = []byte{
@@ -324,7 +537,7 @@ var file_pkg_model_planpreview_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
 	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x70, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x69, 0x70, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0xbb, 0x06, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x9f, 0x08, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
 	0x6c, 0x61, 0x6e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
 	0x12, 0x2e, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
@@ -367,18 +580,51 @@ var file_pkg_model_planpreview_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x20,
 	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
 	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x21,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20,
-	0x00, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x39, 0x0a, 0x0b,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x09, 0x42, 0x25, 0x5a,
-	0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x69, 0x70, 0x65,
-	0x2d, 0x63, 0x64, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x63, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2e,
+	0x0a, 0x13, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x73,
+	0x79, 0x6e, 0x74, 0x61, 0x78, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x6c, 0x61,
+	0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x53, 0x79, 0x6e, 0x74, 0x61, 0x78, 0x12, 0x34,
+	0x0a, 0x16, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x5f, 0x74,
+	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x23, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x70, 0x6c, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x54, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x24, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x25, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x28, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x06, 0x10,
+	0x09, 0x22, 0xc3, 0x01, 0x0a, 0x19, 0x50, 0x6c, 0x61, 0x6e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x23, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x6c, 0x61,
+	0x6e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x36, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44, 0x44, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x22, 0x63, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x6e, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x42, 0x25, 0x5a, 0x23,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x2d,
+	0x63, 0x64, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x63, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -393,24 +639,31 @@ func file_pkg_model_planpreview_proto_rawDescGZIP() []byte {
 	return file_pkg_model_planpreview_proto_rawDescData
 }
 
-var file_pkg_model_planpreview_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_model_planpreview_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pkg_model_planpreview_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_pkg_model_planpreview_proto_goTypes = []interface{}{
-	(*PlanPreviewCommandResult)(nil),     // 0: model.PlanPreviewCommandResult
-	(*ApplicationPlanPreviewResult)(nil), // 1: model.ApplicationPlanPreviewResult
-	nil,                                  // 2: model.ApplicationPlanPreviewResult.LabelsEntry
-	(ApplicationKind)(0),                 // 3: model.ApplicationKind
-	(SyncStrategy)(0),                    // 4: model.SyncStrategy
+	(PlanPreviewResourceChange_Action)(0), // 0: model.PlanPreviewResourceChange.Action
+	(*PlanPreviewCommandResult)(nil),      // 1: model.PlanPreviewCommandResult
+	(*ApplicationPlanPreviewResult)(nil),  // 2: model.ApplicationPlanPreviewResult
+	(*PlanPreviewResourceChange)(nil),     // 3: model.PlanPreviewResourceChange
+	(*PlanPreviewStage)(nil),              // 4: model.PlanPreviewStage
+	nil,                                   // 5: model.ApplicationPlanPreviewResult.LabelsEntry
+	(ApplicationKind)(0),                  // 6: model.ApplicationKind
+	(SyncStrategy)(0),                     // 7: model.SyncStrategy
 }
 var file_pkg_model_planpreview_proto_depIdxs = []int32{
-	1, // 0: model.PlanPreviewCommandResult.results:type_name -> model.ApplicationPlanPreviewResult
-	3, // 1: model.ApplicationPlanPreviewResult.application_kind:type_name -> model.ApplicationKind
-	2, // 2: model.ApplicationPlanPreviewResult.labels:type_name -> model.ApplicationPlanPreviewResult.LabelsEntry
-	4, // 3: model.ApplicationPlanPreviewResult.sync_strategy:type_name -> model.SyncStrategy
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2, // 0: model.PlanPreviewCommandResult.results:type_name -> model.ApplicationPlanPreviewResult
+	6, // 1: model.ApplicationPlanPreviewResult.application_kind:type_name -> model.ApplicationKind
+	5, // 2: model.ApplicationPlanPreviewResult.labels:type_name -> model.ApplicationPlanPreviewResult.LabelsEntry
+	7, // 3: model.ApplicationPlanPreviewResult.sync_strategy:type_name -> model.SyncStrategy
+	3, // 4: model.ApplicationPlanPreviewResult.resource_changes:type_name -> model.PlanPreviewResourceChange
+	4, // 5: model.ApplicationPlanPreviewResult.stages:type_name -> model.PlanPreviewStage
+	0, // 6: model.PlanPreviewResourceChange.action:type_name -> model.PlanPreviewResourceChange.Action
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_pkg_model_planpreview_proto_init() }
@@ -444,19 +697,44 @@ func file_pkg_model_planpreview_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_model_planpreview_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanPreviewResourceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_model_planpreview_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanPreviewStage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_model_planpreview_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_pkg_model_planpreview_proto_goTypes,
 		DependencyIndexes: file_pkg_model_planpreview_proto_depIdxs,
+		EnumInfos:         file_pkg_model_planpreview_proto_enumTypes,
 		MessageInfos:      file_pkg_model_planpreview_proto_msgTypes,
 	}.Build()
 	File_pkg_model_planpreview_proto = out.File
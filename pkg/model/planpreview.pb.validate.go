@@ -321,6 +321,78 @@ func (m *ApplicationPlanPreviewResult) validate(all bool) error {
 
 	// no validation rules for NoChange
 
+	// no validation rules for PlanDetailsSyntax
+
+	// no validation rules for PlanDetailsTruncated
+
+	for idx, item := range m.GetResourceChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApplicationPlanPreviewResultValidationError{
+						field:  fmt.Sprintf("ResourceChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApplicationPlanPreviewResultValidationError{
+						field:  fmt.Sprintf("ResourceChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ApplicationPlanPreviewResultValidationError{
+					field:  fmt.Sprintf("ResourceChanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetStages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApplicationPlanPreviewResultValidationError{
+						field:  fmt.Sprintf("Stages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApplicationPlanPreviewResultValidationError{
+						field:  fmt.Sprintf("Stages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ApplicationPlanPreviewResultValidationError{
+					field:  fmt.Sprintf("Stages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	// no validation rules for Error
 
 	if m.GetCreatedAt() <= 0 {
@@ -414,3 +486,242 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ApplicationPlanPreviewResultValidationError{}
+
+// Validate checks the field values on PlanPreviewResourceChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *PlanPreviewResourceChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanPreviewResourceChange with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanPreviewResourceChangeMultiError, or nil if none found.
+func (m *PlanPreviewResourceChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanPreviewResourceChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetResource()) < 1 {
+		err := PlanPreviewResourceChangeValidationError{
+			field:  "Resource",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := PlanPreviewResourceChange_Action_name[int32(m.GetAction())]; !ok {
+		err := PlanPreviewResourceChangeValidationError{
+			field:  "Action",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PlanPreviewResourceChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanPreviewResourceChangeMultiError is an error wrapping multiple validation
+// errors returned by PlanPreviewResourceChange.ValidateAll() if the
+// designated constraints aren't met.
+type PlanPreviewResourceChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanPreviewResourceChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanPreviewResourceChangeMultiError) AllErrors() []error { return m }
+
+// PlanPreviewResourceChangeValidationError is the validation error returned by
+// PlanPreviewResourceChange.Validate if the designated constraints aren't
+// met.
+type PlanPreviewResourceChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanPreviewResourceChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanPreviewResourceChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanPreviewResourceChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanPreviewResourceChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanPreviewResourceChangeValidationError) ErrorName() string {
+	return "PlanPreviewResourceChangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanPreviewResourceChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanPreviewResourceChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanPreviewResourceChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanPreviewResourceChangeValidationError{}
+
+// Validate checks the field values on PlanPreviewStage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PlanPreviewStage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanPreviewStage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanPreviewStageMultiError, or nil if none found.
+func (m *PlanPreviewStage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanPreviewStage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := PlanPreviewStageValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Desc
+
+	if len(errors) > 0 {
+		return PlanPreviewStageMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanPreviewStageMultiError is an error wrapping multiple validation errors
+// returned by PlanPreviewStage.ValidateAll() if the designated constraints
+// aren't met.
+type PlanPreviewStageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanPreviewStageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanPreviewStageMultiError) AllErrors() []error { return m }
+
+// PlanPreviewStageValidationError is the validation error returned by
+// PlanPreviewStage.Validate if the designated constraints aren't met.
+type PlanPreviewStageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanPreviewStageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanPreviewStageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanPreviewStageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanPreviewStageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanPreviewStageValidationError) ErrorName() string { return "PlanPreviewStageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlanPreviewStageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanPreviewStage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanPreviewStageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanPreviewStageValidationError{}
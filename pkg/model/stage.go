@@ -24,12 +24,18 @@ const (
 	// StageWaitApproval represents the waiting state until getting an approval
 	// from one of the specified approvers.
 	StageWaitApproval Stage = "WAIT_APPROVAL"
+	// StageExternalWait represents the waiting state until an external system
+	// reports the result of its job through the API.
+	StageExternalWait Stage = "EXTERNAL_WAIT"
 	// StageAnalysis represents the waiting state for analysing
 	// the application status based on metrics, log, http request...
 	StageAnalysis Stage = "ANALYSIS"
 	// StageScriptRun represents a state where
 	// the specified script will be executed.
 	StageScriptRun Stage = "SCRIPT_RUN"
+	// StageLoadTest represents a state where a load test is run against
+	// the specified endpoint to check its latency and error rate.
+	StageLoadTest Stage = "LOAD_TEST"
 
 	// StageK8sSync represents the state where
 	// all resources should be synced with the Git state.
@@ -52,6 +58,18 @@ const (
 	// StageK8sTrafficRouting represents the state where the traffic to application
 	// should be splitted as the specified percentage to PRIMARY, CANARY, BASELINE variants.
 	StageK8sTrafficRouting Stage = "K8S_TRAFFIC_ROUTING"
+	// StageK8sMaintenanceOn represents the state where all traffic to application
+	// is routed to the pods serving a maintenance page.
+	StageK8sMaintenanceOn Stage = "K8S_MAINTENANCE_ON"
+	// StageK8sMaintenanceOff represents the state where the traffic to application
+	// is routed back from the maintenance page.
+	StageK8sMaintenanceOff Stage = "K8S_MAINTENANCE_OFF"
+	// StageK8sWaitRollout represents the waiting state until the workloads
+	// defined at the target commit are rolled out completely.
+	StageK8sWaitRollout Stage = "K8S_WAIT_ROLLOUT"
+	// StageK8sFaultInjection represents the state where a fault is injected
+	// into CANARY variant while verifying its recovery by analysis.
+	StageK8sFaultInjection Stage = "K8S_FAULT_INJECTION"
 
 	// StageTerraformSync synced infrastructure with all the tf defined in Git.
 	// Firstly, it does plan and if there are any changes detected it applies those changes automatically.
@@ -94,6 +112,15 @@ const (
 	// StageECSCanaryClean represents the stage where
 	// the CANARY variant resources has been cleaned.
 	StageECSCanaryClean Stage = "ECS_CANARY_CLEAN"
+	// StageECSMaintenanceOn represents the state where all requests to application
+	// are answered by a fixed maintenance response of the ELB listeners.
+	StageECSMaintenanceOn Stage = "ECS_MAINTENANCE_ON"
+	// StageECSMaintenanceOff represents the state where the requests to application
+	// are forwarded to the target groups again.
+	StageECSMaintenanceOff Stage = "ECS_MAINTENANCE_OFF"
+	// StageECSTargetGroupHealthCheck represents the state where the targets
+	// registered to the target groups are waited to become healthy in ELB.
+	StageECSTargetGroupHealthCheck Stage = "ECS_TARGET_GROUP_HEALTH_CHECK"
 	// StageCustomSync represents the stage where users can use their
 	// defined scripts to sync the application's state instead of the KIND_SYNC stage.
 	StageCustomSync Stage = "CUSTOM_SYNC"
@@ -34,6 +34,9 @@ const (
 	// PipedTokenCredentials represents a generated token for
 	// authenticating between Piped and control-plane.
 	PipedTokenCredentials CredentialsType = "PIPED-TOKEN"
+	// DeploymentTokenCredentials represents a short-lived token issued
+	// by control-plane for calling the RPCs of a specific deployment.
+	DeploymentTokenCredentials CredentialsType = "DEPLOYMENT-TOKEN"
 	// APIKeyCredentials represents a generated key for
 	// authenticating between pipectl/external-service and control-plane.
 	APIKeyCredentials CredentialsType = "API-KEY"
@@ -110,6 +113,10 @@ func extractCredentials(ctx context.Context) (creds Credentials, err error) {
 		creds.Data = subs[1]
 		creds.Type = PipedTokenCredentials
 
+	case DeploymentTokenCredentials:
+		creds.Data = subs[1]
+		creds.Type = DeploymentTokenCredentials
+
 	case APIKeyCredentials:
 		creds.Data = subs[1]
 		creds.Type = APIKeyCredentials
@@ -42,6 +42,32 @@ type PipedTokenVerifier interface {
 	Verify(ctx context.Context, projectID, pipedID, pipedKey string) error
 }
 
+// PipedKeyIDVerifier verifies that the given piped is enabled and still has the key of the given ID.
+type PipedKeyIDVerifier interface {
+	VerifyKeyID(ctx context.Context, projectID, pipedID, keyID string) error
+}
+
+// PipedVerifier verifies both of the piped tokens and the piped keys the deployment tokens were issued by.
+type PipedVerifier interface {
+	PipedTokenVerifier
+	PipedKeyIDVerifier
+}
+
+// DeploymentTokenClaims represents what a deployment token was issued for.
+type DeploymentTokenClaims struct {
+	ProjectID string
+	PipedID   string
+	// The ID of the piped key used to issue the token.
+	PipedKeyID   string
+	DeploymentID string
+}
+
+// DeploymentTokenVerifier verifies the given deployment token
+// and returns the claims of what it was issued for.
+type DeploymentTokenVerifier interface {
+	Verify(ctx context.Context, token string) (*DeploymentTokenClaims, error)
+}
+
 // APIKeyVerifier verifies the given API key.
 type APIKeyVerifier interface {
 	Verify(ctx context.Context, key string) (*model.APIKey, error)
@@ -54,6 +80,8 @@ type (
 		ProjectID string
 		PipedID   string
 		PipedKey  string
+		// DeploymentID is only set when the request was authenticated by a deployment token.
+		DeploymentID string
 	}
 	apiKeyContextKey struct{}
 )
@@ -95,6 +123,56 @@ func PipedTokenUnaryServerInterceptor(verifier PipedTokenVerifier, logger *zap.L
 	}
 }
 
+// PipedOrDeploymentTokenUnaryServerInterceptor works as same as PipedTokenUnaryServerInterceptor
+// but requires deployment tokens instead of piped tokens while calling the given deployment-scoped methods.
+// A deployment token is accepted only when the request targets the same deployment the token was issued for
+// and the piped is still enabled and has the key used to issue the token.
+// In that case, the parsed ProjectID, PipedID and DeploymentID will be set to the context.
+func PipedOrDeploymentTokenUnaryServerInterceptor(pipedVerifier PipedVerifier, deploymentVerifier DeploymentTokenVerifier, deploymentMethods map[string]struct{}, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	pipedTokenInterceptor := PipedTokenUnaryServerInterceptor(pipedVerifier, logger)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		creds, err := extractCredentials(ctx)
+		if err != nil {
+			return nil, err
+		}
+		_, deploymentScoped := deploymentMethods[info.FullMethod]
+		if creds.Type != DeploymentTokenCredentials {
+			if deploymentScoped {
+				logger.Warn(fmt.Sprintf("piped token is not allowed for deployment-scoped method: %s", info.FullMethod))
+				return nil, errPermissionDenied
+			}
+			return pipedTokenInterceptor(ctx, req, info, handler)
+		}
+		if !deploymentScoped {
+			logger.Warn(fmt.Sprintf("deployment token is not allowed for method: %s", info.FullMethod))
+			return nil, errPermissionDenied
+		}
+		claims, err := deploymentVerifier.Verify(ctx, creds.Data)
+		if err != nil {
+			logger.Warn("unable to verify deployment token", zap.Error(err))
+			return nil, errUnauthenticated
+		}
+		if err := pipedVerifier.VerifyKeyID(ctx, claims.ProjectID, claims.PipedID, claims.PipedKeyID); err != nil {
+			logger.Warn("unable to verify the piped of deployment token", zap.Error(err))
+			return nil, errUnauthenticated
+		}
+		r, ok := req.(interface{ GetDeploymentId() string })
+		if !ok || r.GetDeploymentId() != claims.DeploymentID {
+			logger.Warn(fmt.Sprintf("deployment token for %s was used to call %s for another deployment", claims.DeploymentID, info.FullMethod),
+				zap.String("project-id", claims.ProjectID),
+				zap.String("piped-id", claims.PipedID),
+			)
+			return nil, errPermissionDenied
+		}
+		ctx = context.WithValue(ctx, pipedTokenKey, pipedTokenContextValue{
+			ProjectID:    claims.ProjectID,
+			PipedID:      claims.PipedID,
+			DeploymentID: claims.DeploymentID,
+		})
+		return handler(ctx, req)
+	}
+}
+
 // PipedTokenStreamServerInterceptor extracts credentials from gRPC metadata
 // and set the extracted credentials to the context with a fixed key.
 // This interceptor will returns a gRPC error when the credentials
@@ -145,6 +223,17 @@ func ExtractPipedToken(ctx context.Context) (projectID, pipedID, pipedKey string
 	return
 }
 
+// ExtractDeploymentToken returns the ID of the deployment whose token was used
+// to authenticate the request inside a given context.
+// The returned boolean is false when the request was not authenticated by a deployment token.
+func ExtractDeploymentToken(ctx context.Context) (deploymentID string, ok bool) {
+	v, ok := ctx.Value(pipedTokenKey).(pipedTokenContextValue)
+	if !ok || v.DeploymentID == "" {
+		return "", false
+	}
+	return v.DeploymentID, true
+}
+
 // APIKeyUnaryServerInterceptor extracts credentials from gRPC metadata
 // and validates it by the specified Verifier.
 // The valid API key will be set to the context.
@@ -168,6 +257,32 @@ func APIKeyUnaryServerInterceptor(verifier APIKeyVerifier, logger *zap.Logger) g
 	}
 }
 
+// APIKeyStreamServerInterceptor ensures that the API key credentials included in the stream context
+// must be verified by verifier.
+func APIKeyStreamServerInterceptor(verifier APIKeyVerifier, logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := stream.Context()
+		creds, err := extractCredentials(ctx)
+		if err != nil {
+			return err
+		}
+		if creds.Type != APIKeyCredentials {
+			logger.Warn("wrong credentials type for APIKeyCredentials", zap.Any("credentials", creds))
+			return errUnauthenticated
+		}
+		apiKey, err := verifier.Verify(ctx, creds.Data)
+		if err != nil {
+			logger.Warn("unable to verify api key", zap.Error(err))
+			return errUnauthenticated
+		}
+		wrappedStream := &wrappedServerStream{
+			ServerStream: stream,
+			ctx:          ContextWithAPIKey(ctx, apiKey),
+		}
+		return handler(srv, wrappedStream)
+	}
+}
+
 // ContextWithAPIKey returns a new context in which the given API key was attached.
 func ContextWithAPIKey(ctx context.Context, k *model.APIKey) context.Context {
 	return context.WithValue(ctx, apiKeyKey, k)
@@ -207,11 +322,15 @@ func JWTUnaryServerInterceptor(verifier jwt.Verifier, authorizer RBACAuthorizer,
 			)
 			return nil, errPermissionDenied
 		}
-		ctx = context.WithValue(ctx, claimsKey, *claims)
-		return handler(ctx, req)
+		return handler(ContextWithClaims(ctx, *claims), req)
 	}
 }
 
+// ContextWithClaims returns a new context in which the given claims was attached.
+func ContextWithClaims(ctx context.Context, claims jwt.Claims) context.Context {
+	return context.WithValue(ctx, claimsKey, claims)
+}
+
 // ExtractClaims returns the claims inside a given context.
 func ExtractClaims(ctx context.Context) (jwt.Claims, error) {
 	claims, ok := ctx.Value(claimsKey).(jwt.Claims)
@@ -49,6 +49,13 @@ func (v testPipedTokenVerifier) Verify(ctx context.Context, projectID, pipedID,
 	return nil
 }
 
+func (v testPipedTokenVerifier) VerifyKeyID(ctx context.Context, projectID, pipedID, keyID string) error {
+	if keyID != "test-piped-key-id" {
+		return fmt.Errorf("piped key %s was not found", keyID)
+	}
+	return nil
+}
+
 func TestPipedTokenUnaryServerInterceptor(t *testing.T) {
 	verifier := testPipedTokenVerifier{"test-piped-key"}
 	in := PipedTokenUnaryServerInterceptor(verifier, zap.NewNop())
@@ -106,6 +113,147 @@ func TestPipedTokenUnaryServerInterceptor(t *testing.T) {
 	}
 }
 
+type testDeploymentTokenVerifier struct {
+	token string
+}
+
+func (v testDeploymentTokenVerifier) Verify(ctx context.Context, token string) (*DeploymentTokenClaims, error) {
+	switch token {
+	case v.token:
+		return &DeploymentTokenClaims{
+			ProjectID:    "test-project-id",
+			PipedID:      "test-piped-id",
+			PipedKeyID:   "test-piped-key-id",
+			DeploymentID: "test-deployment-id",
+		}, nil
+	case "deleted-key-token":
+		return &DeploymentTokenClaims{
+			ProjectID:    "test-project-id",
+			PipedID:      "test-piped-id",
+			PipedKeyID:   "deleted-piped-key-id",
+			DeploymentID: "test-deployment-id",
+		}, nil
+	}
+	return nil, fmt.Errorf("invalid deployment token, want: %s, got: %s", v.token, token)
+}
+
+type testDeploymentRequest struct {
+	deploymentID string
+}
+
+func (r testDeploymentRequest) GetDeploymentId() string {
+	return r.deploymentID
+}
+
+func TestPipedOrDeploymentTokenUnaryServerInterceptor(t *testing.T) {
+	in := PipedOrDeploymentTokenUnaryServerInterceptor(
+		testPipedTokenVerifier{"test-piped-key"},
+		testDeploymentTokenVerifier{"test-deployment-token"},
+		map[string]struct{}{"/test/DeploymentScoped": {}},
+		zap.NewNop(),
+	)
+	var (
+		pipedTokenCtx = metadata.NewIncomingContext(context.Background(), metadata.MD{
+			"authorization": []string{"PIPED-TOKEN test-project-id,test-piped-id,test-piped-key"},
+		})
+		deploymentTokenCtx = metadata.NewIncomingContext(context.Background(), metadata.MD{
+			"authorization": []string{"DEPLOYMENT-TOKEN test-deployment-token"},
+		})
+		invalidDeploymentTokenCtx = metadata.NewIncomingContext(context.Background(), metadata.MD{
+			"authorization": []string{"DEPLOYMENT-TOKEN invalid-token"},
+		})
+		deletedKeyDeploymentTokenCtx = metadata.NewIncomingContext(context.Background(), metadata.MD{
+			"authorization": []string{"DEPLOYMENT-TOKEN deleted-key-token"},
+		})
+	)
+	testcases := []struct {
+		name                 string
+		ctx                  context.Context
+		method               string
+		req                  interface{}
+		expectedDeploymentID string
+		failed               bool
+	}{
+		{
+			name:   "should be ok with PipedToken",
+			ctx:    pipedTokenCtx,
+			method: "/test/NotDeploymentScoped",
+			req:    testDeploymentRequest{"another-deployment-id"},
+			failed: false,
+		},
+		{
+			name:                 "should be ok with DeploymentToken",
+			ctx:                  deploymentTokenCtx,
+			method:               "/test/DeploymentScoped",
+			req:                  testDeploymentRequest{"test-deployment-id"},
+			expectedDeploymentID: "test-deployment-id",
+			failed:               false,
+		},
+		{
+			name:   "piped token is not allowed for deployment-scoped method",
+			ctx:    pipedTokenCtx,
+			method: "/test/DeploymentScoped",
+			req:    testDeploymentRequest{"test-deployment-id"},
+			failed: true,
+		},
+		{
+			name:   "piped key used to issue deployment token was deleted",
+			ctx:    deletedKeyDeploymentTokenCtx,
+			method: "/test/DeploymentScoped",
+			req:    testDeploymentRequest{"test-deployment-id"},
+			failed: true,
+		},
+		{
+			name:   "invalid deployment token",
+			ctx:    invalidDeploymentTokenCtx,
+			method: "/test/DeploymentScoped",
+			req:    testDeploymentRequest{"test-deployment-id"},
+			failed: true,
+		},
+		{
+			name:   "deployment token is not allowed for the method",
+			ctx:    deploymentTokenCtx,
+			method: "/test/NotDeploymentScoped",
+			req:    testDeploymentRequest{"test-deployment-id"},
+			failed: true,
+		},
+		{
+			name:   "deployment token is used for another deployment",
+			ctx:    deploymentTokenCtx,
+			method: "/test/DeploymentScoped",
+			req:    testDeploymentRequest{"another-deployment-id"},
+			failed: true,
+		},
+		{
+			name:   "request does not contain deployment id",
+			ctx:    deploymentTokenCtx,
+			method: "/test/DeploymentScoped",
+			req:    nil,
+			failed: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			info := &grpc.UnaryServerInfo{FullMethod: tc.method}
+			_, err := in(tc.ctx, tc.req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				projectID, pipedID, _, err := ExtractPipedToken(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if projectID != "test-project-id" || pipedID != "test-piped-id" {
+					return nil, errors.New("invalid piped token")
+				}
+				deploymentID, _ := ExtractDeploymentToken(ctx)
+				if deploymentID != tc.expectedDeploymentID {
+					return nil, errors.New("invalid deployment id")
+				}
+				return nil, nil
+			})
+			assert.Equal(t, tc.failed, err != nil)
+		})
+	}
+}
+
 func TestPipedTokenStreamServerInterceptor(t *testing.T) {
 	verifier := testPipedTokenVerifier{"test-piped-key"}
 	in := PipedTokenStreamServerInterceptor(verifier, zap.NewNop())
@@ -237,3 +385,67 @@ func TestAPIKeyUnaryServerInterceptor(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIKeyStreamServerInterceptor(t *testing.T) {
+	verifier := testAPIKeyVerifier{
+		keyString: "test-api-key",
+		key: &model.APIKey{
+			Id: "test-api-key",
+		},
+	}
+	in := APIKeyStreamServerInterceptor(verifier, zap.NewNop())
+	testcases := []struct {
+		name      string
+		ctx       context.Context
+		errString string
+	}{
+		{
+			name:      "missing credentials",
+			ctx:       context.TODO(),
+			errString: "rpc error: code = Unauthenticated desc = missing credentials",
+		},
+		{
+			name: "wrong credentials type",
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.MD{
+				"authorization": []string{"PIPED-TOKEN test-project-id,test-piped-id,test-piped-key"},
+			}),
+			errString: "rpc error: code = Unauthenticated desc = Unauthenticated",
+		},
+		{
+			name: "invalid api key",
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.MD{
+				"authorization": []string{"API-KEY invalid-api-key"},
+			}),
+			errString: "rpc error: code = Unauthenticated desc = Unauthenticated",
+		},
+		{
+			name: "ok",
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.MD{
+				"authorization": []string{"API-KEY test-api-key"},
+			}),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			stream := &fakeServerStream{
+				ctx: tc.ctx,
+			}
+			err := in(nil, stream, nil, func(srv interface{}, stream grpc.ServerStream) error {
+				apiKey, err := ExtractAPIKey(stream.Context())
+				if err != nil {
+					return err
+				}
+				if apiKey.Id != "test-api-key" {
+					return errors.New("invalid api key")
+				}
+				return nil
+			})
+			if tc.errString != "" {
+				require.NotNil(t, err)
+				assert.Equal(t, tc.errString, err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
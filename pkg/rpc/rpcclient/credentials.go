@@ -25,6 +25,24 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/rpc/rpcauth"
 )
 
+type credentialsContextKey struct{}
+
+type credentialsContextValue struct {
+	credentials     string
+	credentialsType rpcauth.CredentialsType
+}
+
+var credentialsKey = credentialsContextKey{}
+
+// ContextWithCredentials returns a new context which makes the PerRPCCredentials
+// send the given credentials instead of their own ones for the calls made with it.
+func ContextWithCredentials(ctx context.Context, t rpcauth.CredentialsType, credentials string) context.Context {
+	return context.WithValue(ctx, credentialsKey, credentialsContextValue{
+		credentials:     credentials,
+		credentialsType: t,
+	})
+}
+
 type perRPCCredentials struct {
 	credentials              string
 	credentialsType          rpcauth.CredentialsType
@@ -48,6 +66,11 @@ func NewPerRPCCredentialsFromFile(credentialsFile string, t rpcauth.CredentialsT
 }
 
 func (c perRPCCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	if v, ok := ctx.Value(credentialsKey).(credentialsContextValue); ok {
+		return map[string]string{
+			"authorization": fmt.Sprintf("%s %s", string(v.credentialsType), v.credentials),
+		}, nil
+	}
 	return map[string]string{
 		"authorization": fmt.Sprintf("%s %s", string(c.credentialsType), c.credentials),
 	}, nil
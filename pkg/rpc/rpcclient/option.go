@@ -68,6 +68,12 @@ func WithPerRPCCredentials(creds credentials.PerRPCCredentials) DialOption {
 	}
 }
 
+func WithUnaryInterceptor(interceptor grpc.UnaryClientInterceptor) DialOption {
+	return func(o *option) {
+		o.options = append(o.options, grpc.WithChainUnaryInterceptor(interceptor))
+	}
+}
+
 func WithMaxRecvMsgSize(m int) DialOption {
 	return func(o *option) {
 		o.options = append(o.options, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(m)))
@@ -51,6 +51,7 @@ type Server struct {
 	pipedKeyAuthUnaryInterceptor      grpc.UnaryServerInterceptor
 	pipedKeyAuthStreamInterceptor     grpc.StreamServerInterceptor
 	apiKeyAuthUnaryInterceptor        grpc.UnaryServerInterceptor
+	apiKeyAuthStreamInterceptor       grpc.StreamServerInterceptor
 	jwtAuthUnaryInterceptor           grpc.UnaryServerInterceptor
 	requestValidationUnaryInterceptor grpc.UnaryServerInterceptor
 	logUnaryInterceptor               grpc.UnaryServerInterceptor
@@ -75,6 +76,14 @@ func WithPipedTokenAuthUnaryInterceptor(verifier rpcauth.PipedTokenVerifier, log
 	}
 }
 
+// WithPipedOrDeploymentTokenAuthUnaryInterceptor sets an interceptor for validating piped key,
+// or deployment token for the given deployment-scoped methods.
+func WithPipedOrDeploymentTokenAuthUnaryInterceptor(pipedVerifier rpcauth.PipedVerifier, deploymentVerifier rpcauth.DeploymentTokenVerifier, deploymentMethods map[string]struct{}, logger *zap.Logger) Option {
+	return func(s *Server) {
+		s.pipedKeyAuthUnaryInterceptor = rpcauth.PipedOrDeploymentTokenUnaryServerInterceptor(pipedVerifier, deploymentVerifier, deploymentMethods, logger)
+	}
+}
+
 // WithPipedTokenAuthStreamInterceptor sets an interceptor for validating piped key.
 func WithPipedTokenAuthStreamInterceptor(verifier rpcauth.PipedTokenVerifier, logger *zap.Logger) Option {
 	return func(s *Server) {
@@ -89,6 +98,13 @@ func WithAPIKeyAuthUnaryInterceptor(verifier rpcauth.APIKeyVerifier, logger *zap
 	}
 }
 
+// WithAPIKeyAuthStreamInterceptor sets an interceptor for validating API key.
+func WithAPIKeyAuthStreamInterceptor(verifier rpcauth.APIKeyVerifier, logger *zap.Logger) Option {
+	return func(s *Server) {
+		s.apiKeyAuthStreamInterceptor = rpcauth.APIKeyStreamServerInterceptor(verifier, logger)
+	}
+}
+
 // WithJWTAuthUnaryInterceptor sets an interceprot for checking JWT token.
 func WithJWTAuthUnaryInterceptor(verifier jwt.Verifier, authorizer rpcauth.RBACAuthorizer, logger *zap.Logger) Option {
 	return func(s *Server) {
@@ -235,8 +251,15 @@ func (s *Server) init() error {
 		c := ChainUnaryServerInterceptors(unaryInterceptors...)
 		opts = append(opts, grpc.UnaryInterceptor(c))
 	}
+	var streamInterceptors []grpc.StreamServerInterceptor
 	if s.pipedKeyAuthStreamInterceptor != nil {
-		opts = append(opts, grpc.StreamInterceptor(s.pipedKeyAuthStreamInterceptor))
+		streamInterceptors = append(streamInterceptors, s.pipedKeyAuthStreamInterceptor)
+	}
+	if s.apiKeyAuthStreamInterceptor != nil {
+		streamInterceptors = append(streamInterceptors, s.apiKeyAuthStreamInterceptor)
+	}
+	if len(streamInterceptors) > 0 {
+		opts = append(opts, grpc.ChainStreamInterceptor(streamInterceptors...))
 	}
 	if s.maxRecvMsgSize != 0 {
 		opts = append(opts, grpc.MaxRecvMsgSize(s.maxRecvMsgSize))
@@ -45,10 +45,18 @@ func (r *PlanPreviewResult) NoChange() bool {
 
 type ApplicationResult struct {
 	ApplicationInfo
-	SyncStrategy string // QUICK_SYNC, PIPELINE
-	PlanSummary  string
-	PlanDetails  string
-	NoChange     bool
+	SyncStrategy      string // QUICK_SYNC, PIPELINE
+	PlanSummary       string
+	PlanDetails       string
+	PlanDetailsSyntax string // diff, hcl
+	Stages            []Stage
+	NoChange          bool
+}
+
+type Stage struct {
+	Name        string
+	Description string
+	Parameters  []string // e.g. "duration: 10m0s"
 }
 
 type FailurePiped struct {
@@ -58,8 +66,9 @@ type FailurePiped struct {
 
 type FailureApplication struct {
 	ApplicationInfo
-	Reason      string
-	PlanDetails string
+	Reason            string
+	PlanDetails       string
+	PlanDetailsSyntax string // diff, hcl
 }
 
 type PipedInfo struct {
@@ -192,13 +201,23 @@ func makeCommentBody(event *githubEvent, r *PlanPreviewResult) string {
 		fmt.Fprintf(&b, "### %s\n", makeTitleText(&app.ApplicationInfo))
 		fmt.Fprintf(&b, "Sync strategy: %s\n", app.SyncStrategy)
 		fmt.Fprintf(&b, "Summary: %s\n\n", app.PlanSummary)
+		if len(app.Stages) > 0 {
+			b.WriteString("Pipeline:\n")
+			for i, s := range app.Stages {
+				fmt.Fprintf(&b, "%d. `%s`", i+1, s.Name)
+				if len(s.Parameters) > 0 {
+					fmt.Fprintf(&b, " (%s)", strings.Join(s.Parameters, ", "))
+				}
+				b.WriteString("\n")
+			}
+			b.WriteString("\n")
+		}
 
 		var (
-			lang    = "diff"
+			lang    = detailsSyntax(app.ApplicationKind, app.PlanDetailsSyntax)
 			details = app.PlanDetails
 		)
 		if app.ApplicationKind == "TERRAFORM" {
-			lang = "hcl"
 			if shortened, err := generateTerraformShortPlanDetails(details); err == nil {
 				details = shortened
 			}
@@ -248,11 +267,7 @@ func makeCommentBody(event *githubEvent, r *PlanPreviewResult) string {
 			fmt.Fprintf(&b, "\n### %s\n", makeTitleText(&app.ApplicationInfo))
 			fmt.Fprintf(&b, "Reason: %s\n\n", app.Reason)
 
-			var lang = "diff"
-			if app.ApplicationKind == "TERRAFORM" {
-				lang = "hcl"
-			}
-
+			lang := detailsSyntax(app.ApplicationKind, app.PlanDetailsSyntax)
 			if len(app.PlanDetails) > 0 {
 				fmt.Fprintf(&b, detailsFormat, lang, app.PlanDetails)
 			}
@@ -312,6 +327,19 @@ func makeTitleText(app *ApplicationInfo) string {
 	return fmt.Sprintf(appInfoWithEnvFormat, app.ApplicationName, app.ApplicationURL, app.Env, strings.ToLower(app.ApplicationKind))
 }
 
+// detailsSyntax returns the language used to highlight the plan details.
+// The syntax reported by Piped is preferred, and the one decided from the application kind
+// is used for the results reported by older Pipeds.
+func detailsSyntax(kind, syntax string) string {
+	if syntax != "" {
+		return syntax
+	}
+	if kind == "TERRAFORM" {
+		return "hcl"
+	}
+	return "diff"
+}
+
 func generateTerraformShortPlanDetails(details string) (string, error) {
 	r := strings.NewReader(details)
 	scanner := bufio.NewScanner(r)
@@ -67,6 +67,37 @@ func TestMakeCommentBody(t *testing.T) {
 			},
 			expected: "testdata/comment-only-changed-app.txt",
 		},
+		{
+			name: "changed app with pipeline",
+			event: githubEvent{
+				HeadCommit: "abc",
+			},
+			result: PlanPreviewResult{
+				Applications: []ApplicationResult{
+					{
+						ApplicationInfo: ApplicationInfo{
+							ApplicationID:        "app-id-1",
+							ApplicationName:      "app-name-1",
+							ApplicationURL:       "app-url-1",
+							Env:                  "env-1",
+							ApplicationKind:      "app-kind-1",
+							ApplicationDirectory: "app-dir-1",
+						},
+						SyncStrategy: "PIPELINE",
+						PlanSummary:  "plan-summary-1",
+						PlanDetails:  "plan-details-1",
+						Stages: []Stage{
+							{Name: "K8S_CANARY_ROLLOUT"},
+							{Name: "ANALYSIS", Parameters: []string{"duration: 10m0s", "metrics: 2"}},
+							{Name: "WAIT_APPROVAL", Parameters: []string{"minApproverNum: 1", "timeout: 6h0m0s"}},
+							{Name: "K8S_PRIMARY_ROLLOUT"},
+						},
+						NoChange: false,
+					},
+				},
+			},
+			expected: "testdata/comment-changed-app-with-pipeline.txt",
+		},
 		{
 			name: "has no diff apps",
 			event: githubEvent{
@@ -708,3 +739,35 @@ guarantee to take exactly these actions if you run "terraform apply" now.`,
 		})
 	}
 }
+
+func TestDetailsSyntax(t *testing.T) {
+	testcases := []struct {
+		name   string
+		kind   string
+		syntax string
+		want   string
+	}{
+		{
+			name: "kubernetes without reported syntax",
+			kind: "KUBERNETES",
+			want: "diff",
+		},
+		{
+			name: "terraform without reported syntax",
+			kind: "TERRAFORM",
+			want: "hcl",
+		},
+		{
+			name:   "reported syntax is preferred",
+			kind:   "ECS",
+			syntax: "json",
+			want:   "json",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := detailsSyntax(tc.kind, tc.syntax)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -204,6 +204,13 @@ export class WebServiceClient {
                response: pkg_app_server_service_webservice_service_pb.ApproveStageResponse) => void
   ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.ApproveStageResponse>;
 
+  addDeploymentComment(
+    request: pkg_app_server_service_webservice_service_pb.AddDeploymentCommentRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.AddDeploymentCommentResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.AddDeploymentCommentResponse>;
+
   listDeploymentTraces(
     request: pkg_app_server_service_webservice_service_pb.ListDeploymentTracesRequest,
     metadata: grpcWeb.Metadata | undefined,
@@ -512,6 +519,11 @@ export class WebServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<pkg_app_server_service_webservice_service_pb.ApproveStageResponse>;
 
+  addDeploymentComment(
+    request: pkg_app_server_service_webservice_service_pb.AddDeploymentCommentRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.AddDeploymentCommentResponse>;
+
   listDeploymentTraces(
     request: pkg_app_server_service_webservice_service_pb.ListDeploymentTracesRequest,
     metadata?: grpcWeb.Metadata
@@ -1814,6 +1814,67 @@ proto.grpc.service.webservice.WebServicePromiseClient.prototype.approveStage =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.AddDeploymentCommentRequest,
+ *   !proto.grpc.service.webservice.AddDeploymentCommentResponse>}
+ */
+const methodDescriptor_WebService_AddDeploymentComment = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/AddDeploymentComment',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.AddDeploymentCommentRequest,
+  proto.grpc.service.webservice.AddDeploymentCommentResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.AddDeploymentCommentRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.AddDeploymentCommentResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.AddDeploymentCommentRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.AddDeploymentCommentResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.AddDeploymentCommentResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.addDeploymentComment =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/AddDeploymentComment',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_AddDeploymentComment,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.AddDeploymentCommentRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.AddDeploymentCommentResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.addDeploymentComment =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/AddDeploymentComment',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_AddDeploymentComment);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -1254,6 +1254,48 @@ export namespace ApproveStageResponse {
   }
 }
 
+export class AddDeploymentCommentRequest extends jspb.Message {
+  getDeploymentId(): string;
+  setDeploymentId(value: string): AddDeploymentCommentRequest;
+
+  getComment(): string;
+  setComment(value: string): AddDeploymentCommentRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AddDeploymentCommentRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: AddDeploymentCommentRequest): AddDeploymentCommentRequest.AsObject;
+  static serializeBinaryToWriter(message: AddDeploymentCommentRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AddDeploymentCommentRequest;
+  static deserializeBinaryFromReader(message: AddDeploymentCommentRequest, reader: jspb.BinaryReader): AddDeploymentCommentRequest;
+}
+
+export namespace AddDeploymentCommentRequest {
+  export type AsObject = {
+    deploymentId: string,
+    comment: string,
+  }
+}
+
+export class AddDeploymentCommentResponse extends jspb.Message {
+  getComment(): pkg_model_deployment_pb.DeploymentComment | undefined;
+  setComment(value?: pkg_model_deployment_pb.DeploymentComment): AddDeploymentCommentResponse;
+  hasComment(): boolean;
+  clearComment(): AddDeploymentCommentResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AddDeploymentCommentResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: AddDeploymentCommentResponse): AddDeploymentCommentResponse.AsObject;
+  static serializeBinaryToWriter(message: AddDeploymentCommentResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AddDeploymentCommentResponse;
+  static deserializeBinaryFromReader(message: AddDeploymentCommentResponse, reader: jspb.BinaryReader): AddDeploymentCommentResponse;
+}
+
+export namespace AddDeploymentCommentResponse {
+  export type AsObject = {
+    comment?: pkg_model_deployment_pb.DeploymentComment.AsObject,
+  }
+}
+
 export class ListDeploymentTracesRequest extends jspb.Message {
   getOptions(): ListDeploymentTracesRequest.Options | undefined;
   setOptions(value?: ListDeploymentTracesRequest.Options): ListDeploymentTracesRequest;
@@ -57,6 +57,8 @@ var google_protobuf_descriptor_pb = require('google-protobuf/google/protobuf/des
 goog.object.extend(proto, google_protobuf_descriptor_pb);
 goog.exportSymbol('proto.grpc.service.webservice.AddApplicationRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.AddApplicationResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.AddDeploymentCommentRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.AddDeploymentCommentResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.AddProjectRBACRoleRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.AddProjectRBACRoleResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.AddProjectUserGroupRequest', null, global);
@@ -1404,6 +1406,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.grpc.service.webservice.ApproveStageResponse.displayName = 'proto.grpc.service.webservice.ApproveStageResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.AddDeploymentCommentRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.AddDeploymentCommentRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.AddDeploymentCommentRequest.displayName = 'proto.grpc.service.webservice.AddDeploymentCommentRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.AddDeploymentCommentResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.AddDeploymentCommentResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.AddDeploymentCommentResponse.displayName = 'proto.grpc.service.webservice.AddDeploymentCommentResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -11647,6 +11691,317 @@ proto.grpc.service.webservice.ApproveStageResponse.prototype.setCommandId = func
 
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.AddDeploymentCommentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.AddDeploymentCommentRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.AddDeploymentCommentRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.AddDeploymentCommentRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    deploymentId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    comment: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.AddDeploymentCommentRequest}
+ */
+proto.grpc.service.webservice.AddDeploymentCommentRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.AddDeploymentCommentRequest;
+  return proto.grpc.service.webservice.AddDeploymentCommentRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.AddDeploymentCommentRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.AddDeploymentCommentRequest}
+ */
+proto.grpc.service.webservice.AddDeploymentCommentRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDeploymentId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setComment(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.AddDeploymentCommentRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.AddDeploymentCommentRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.AddDeploymentCommentRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.AddDeploymentCommentRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDeploymentId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getComment();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string deployment_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.AddDeploymentCommentRequest.prototype.getDeploymentId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.AddDeploymentCommentRequest} returns this
+ */
+proto.grpc.service.webservice.AddDeploymentCommentRequest.prototype.setDeploymentId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string comment = 2;
+ * @return {string}
+ */
+proto.grpc.service.webservice.AddDeploymentCommentRequest.prototype.getComment = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.AddDeploymentCommentRequest} returns this
+ */
+proto.grpc.service.webservice.AddDeploymentCommentRequest.prototype.setComment = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.AddDeploymentCommentResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.AddDeploymentCommentResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.AddDeploymentCommentResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.AddDeploymentCommentResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    comment: (f = msg.getComment()) && pkg_model_deployment_pb.DeploymentComment.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.AddDeploymentCommentResponse}
+ */
+proto.grpc.service.webservice.AddDeploymentCommentResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.AddDeploymentCommentResponse;
+  return proto.grpc.service.webservice.AddDeploymentCommentResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.AddDeploymentCommentResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.AddDeploymentCommentResponse}
+ */
+proto.grpc.service.webservice.AddDeploymentCommentResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new pkg_model_deployment_pb.DeploymentComment;
+      reader.readMessage(value,pkg_model_deployment_pb.DeploymentComment.deserializeBinaryFromReader);
+      msg.setComment(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.AddDeploymentCommentResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.AddDeploymentCommentResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.AddDeploymentCommentResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.AddDeploymentCommentResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getComment();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      pkg_model_deployment_pb.DeploymentComment.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional model.DeploymentComment comment = 1;
+ * @return {?proto.model.DeploymentComment}
+ */
+proto.grpc.service.webservice.AddDeploymentCommentResponse.prototype.getComment = function() {
+  return /** @type{?proto.model.DeploymentComment} */ (
+    jspb.Message.getWrapperField(this, pkg_model_deployment_pb.DeploymentComment, 1));
+};
+
+
+/**
+ * @param {?proto.model.DeploymentComment|undefined} value
+ * @return {!proto.grpc.service.webservice.AddDeploymentCommentResponse} returns this
+*/
+proto.grpc.service.webservice.AddDeploymentCommentResponse.prototype.setComment = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.AddDeploymentCommentResponse} returns this
+ */
+proto.grpc.service.webservice.AddDeploymentCommentResponse.prototype.clearComment = function() {
+  return this.setComment(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.AddDeploymentCommentResponse.prototype.hasComment = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -81,6 +81,11 @@ export class Deployment extends jspb.Message {
   hasMetadataV2(): boolean;
   clearMetadataV2(): Deployment;
 
+  getCommentsList(): Array<DeploymentComment>;
+  setCommentsList(value: Array<DeploymentComment>): Deployment;
+  clearCommentsList(): Deployment;
+  addComments(value?: DeploymentComment, index?: number): DeploymentComment;
+
   getDeploymentChainId(): string;
   setDeploymentChainId(value: string): Deployment;
 
@@ -131,6 +136,7 @@ export namespace Deployment {
     stagesList: Array<PipelineStage.AsObject>,
     metadataMap: Array<[string, string]>,
     metadataV2?: DeploymentMetadata.AsObject,
+    commentsList: Array<DeploymentComment.AsObject>,
     deploymentChainId: string,
     deploymentChainBlockIndex: number,
     deploymentTraceCommitHash: string,
@@ -140,6 +146,36 @@ export namespace Deployment {
   }
 }
 
+export class DeploymentComment extends jspb.Message {
+  getId(): string;
+  setId(value: string): DeploymentComment;
+
+  getCommenter(): string;
+  setCommenter(value: string): DeploymentComment;
+
+  getComment(): string;
+  setComment(value: string): DeploymentComment;
+
+  getCreatedAt(): number;
+  setCreatedAt(value: number): DeploymentComment;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DeploymentComment.AsObject;
+  static toObject(includeInstance: boolean, msg: DeploymentComment): DeploymentComment.AsObject;
+  static serializeBinaryToWriter(message: DeploymentComment, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DeploymentComment;
+  static deserializeBinaryFromReader(message: DeploymentComment, reader: jspb.BinaryReader): DeploymentComment;
+}
+
+export namespace DeploymentComment {
+  export type AsObject = {
+    id: string,
+    commenter: string,
+    comment: string,
+    createdAt: number,
+  }
+}
+
 export class DeployTargets extends jspb.Message {
   getDeployTargetsList(): Array<string>;
   setDeployTargetsList(value: Array<string>): DeployTargets;
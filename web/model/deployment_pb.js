@@ -28,6 +28,7 @@ goog.object.extend(proto, pkg_model_common_pb);
 goog.exportSymbol('proto.model.Commit', null, global);
 goog.exportSymbol('proto.model.DeployTargets', null, global);
 goog.exportSymbol('proto.model.Deployment', null, global);
+goog.exportSymbol('proto.model.DeploymentComment', null, global);
 goog.exportSymbol('proto.model.DeploymentMetadata', null, global);
 goog.exportSymbol('proto.model.DeploymentMetadata.KeyValues', null, global);
 goog.exportSymbol('proto.model.DeploymentStatus', null, global);
@@ -57,6 +58,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.model.Deployment.displayName = 'proto.model.Deployment';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.DeploymentComment = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.DeploymentComment, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.DeploymentComment.displayName = 'proto.model.DeploymentComment';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -189,7 +211,7 @@ if (goog.DEBUG && !COMPILED) {
  * @private {!Array<number>}
  * @const
  */
-proto.model.Deployment.repeatedFields_ = [24,32];
+proto.model.Deployment.repeatedFields_ = [24,32,35];
 
 
 
@@ -246,6 +268,8 @@ proto.model.Deployment.toObject = function(includeInstance, msg) {
     proto.model.PipelineStage.toObject, includeInstance),
     metadataMap: (f = msg.getMetadataMap()) ? f.toObject(includeInstance, undefined) : [],
     metadataV2: (f = msg.getMetadataV2()) && proto.model.DeploymentMetadata.toObject(includeInstance, f),
+    commentsList: jspb.Message.toObjectList(msg.getCommentsList(),
+    proto.model.DeploymentComment.toObject, includeInstance),
     deploymentChainId: jspb.Message.getFieldWithDefault(msg, 40, ""),
     deploymentChainBlockIndex: jspb.Message.getFieldWithDefault(msg, 41, 0),
     deploymentTraceCommitHash: jspb.Message.getFieldWithDefault(msg, 50, ""),
@@ -387,6 +411,11 @@ proto.model.Deployment.deserializeBinaryFromReader = function(msg, reader) {
       reader.readMessage(value,proto.model.DeploymentMetadata.deserializeBinaryFromReader);
       msg.setMetadataV2(value);
       break;
+    case 35:
+      var value = new proto.model.DeploymentComment;
+      reader.readMessage(value,proto.model.DeploymentComment.deserializeBinaryFromReader);
+      msg.addComments(value);
+      break;
     case 40:
       var value = /** @type {string} */ (reader.readString());
       msg.setDeploymentChainId(value);
@@ -590,6 +619,14 @@ proto.model.Deployment.serializeBinaryToWriter = function(message, writer) {
       proto.model.DeploymentMetadata.serializeBinaryToWriter
     );
   }
+  f = message.getCommentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      35,
+      f,
+      proto.model.DeploymentComment.serializeBinaryToWriter
+    );
+  }
   f = message.getDeploymentChainId();
   if (f.length > 0) {
     writer.writeString(
@@ -1143,6 +1180,44 @@ proto.model.Deployment.prototype.hasMetadataV2 = function() {
 };
 
 
+/**
+ * repeated DeploymentComment comments = 35;
+ * @return {!Array<!proto.model.DeploymentComment>}
+ */
+proto.model.Deployment.prototype.getCommentsList = function() {
+  return /** @type{!Array<!proto.model.DeploymentComment>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.DeploymentComment, 35));
+};
+
+
+/**
+ * @param {!Array<!proto.model.DeploymentComment>} value
+ * @return {!proto.model.Deployment} returns this
+*/
+proto.model.Deployment.prototype.setCommentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 35, value);
+};
+
+
+/**
+ * @param {!proto.model.DeploymentComment=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.DeploymentComment}
+ */
+proto.model.Deployment.prototype.addComments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 35, opt_value, proto.model.DeploymentComment, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.Deployment} returns this
+ */
+proto.model.Deployment.prototype.clearCommentsList = function() {
+  return this.setCommentsList([]);
+};
+
+
 /**
  * optional string deployment_chain_id = 40;
  * @return {string}
@@ -1252,6 +1327,226 @@ proto.model.Deployment.prototype.setUpdatedAt = function(value) {
 
 
 
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.DeploymentComment.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.DeploymentComment.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.DeploymentComment} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.DeploymentComment.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    commenter: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    comment: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    createdAt: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.DeploymentComment}
+ */
+proto.model.DeploymentComment.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.DeploymentComment;
+  return proto.model.DeploymentComment.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.DeploymentComment} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.DeploymentComment}
+ */
+proto.model.DeploymentComment.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommenter(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setComment(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCreatedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.DeploymentComment.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.DeploymentComment.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.DeploymentComment} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.DeploymentComment.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getCommenter();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getComment();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.model.DeploymentComment.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.DeploymentComment} returns this
+ */
+proto.model.DeploymentComment.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string commenter = 2;
+ * @return {string}
+ */
+proto.model.DeploymentComment.prototype.getCommenter = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.DeploymentComment} returns this
+ */
+proto.model.DeploymentComment.prototype.setCommenter = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string comment = 3;
+ * @return {string}
+ */
+proto.model.DeploymentComment.prototype.getComment = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.DeploymentComment} returns this
+ */
+proto.model.DeploymentComment.prototype.setComment = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional int64 created_at = 4;
+ * @return {number}
+ */
+proto.model.DeploymentComment.prototype.getCreatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.DeploymentComment} returns this
+ */
+proto.model.DeploymentComment.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
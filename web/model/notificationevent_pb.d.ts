@@ -297,6 +297,44 @@ export namespace NotificationEventDeploymentWaitApproval {
   }
 }
 
+export class NotificationEventDeploymentCommented extends jspb.Message {
+  getDeployment(): pkg_model_deployment_pb.Deployment | undefined;
+  setDeployment(value?: pkg_model_deployment_pb.Deployment): NotificationEventDeploymentCommented;
+  hasDeployment(): boolean;
+  clearDeployment(): NotificationEventDeploymentCommented;
+
+  getComment(): pkg_model_deployment_pb.DeploymentComment | undefined;
+  setComment(value?: pkg_model_deployment_pb.DeploymentComment): NotificationEventDeploymentCommented;
+  hasComment(): boolean;
+  clearComment(): NotificationEventDeploymentCommented;
+
+  getMentionedAccountsList(): Array<string>;
+  setMentionedAccountsList(value: Array<string>): NotificationEventDeploymentCommented;
+  clearMentionedAccountsList(): NotificationEventDeploymentCommented;
+  addMentionedAccounts(value: string, index?: number): NotificationEventDeploymentCommented;
+
+  getMentionedGroupsList(): Array<string>;
+  setMentionedGroupsList(value: Array<string>): NotificationEventDeploymentCommented;
+  clearMentionedGroupsList(): NotificationEventDeploymentCommented;
+  addMentionedGroups(value: string, index?: number): NotificationEventDeploymentCommented;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): NotificationEventDeploymentCommented.AsObject;
+  static toObject(includeInstance: boolean, msg: NotificationEventDeploymentCommented): NotificationEventDeploymentCommented.AsObject;
+  static serializeBinaryToWriter(message: NotificationEventDeploymentCommented, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): NotificationEventDeploymentCommented;
+  static deserializeBinaryFromReader(message: NotificationEventDeploymentCommented, reader: jspb.BinaryReader): NotificationEventDeploymentCommented;
+}
+
+export namespace NotificationEventDeploymentCommented {
+  export type AsObject = {
+    deployment?: pkg_model_deployment_pb.Deployment.AsObject,
+    comment?: pkg_model_deployment_pb.DeploymentComment.AsObject,
+    mentionedAccountsList: Array<string>,
+    mentionedGroupsList: Array<string>,
+  }
+}
+
 export class NotificationEventDeploymentTriggerFailed extends jspb.Message {
   getApplication(): pkg_model_application_pb.Application | undefined;
   setApplication(value?: pkg_model_application_pb.Application): NotificationEventDeploymentTriggerFailed;
@@ -594,6 +632,7 @@ export enum NotificationEventType {
   EVENT_DEPLOYMENT_WAIT_APPROVAL = 7,
   EVENT_DEPLOYMENT_TRIGGER_FAILED = 8,
   EVENT_DEPLOYMENT_STARTED = 9,
+  EVENT_DEPLOYMENT_COMMENTED = 10,
   EVENT_APPLICATION_SYNCED = 100,
   EVENT_APPLICATION_OUT_OF_SYNC = 101,
   EVENT_APPLICATION_HEALTHY = 200,
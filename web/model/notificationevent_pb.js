@@ -31,6 +31,7 @@ goog.exportSymbol('proto.model.NotificationEventApplicationOutOfSync', null, glo
 goog.exportSymbol('proto.model.NotificationEventApplicationSynced', null, global);
 goog.exportSymbol('proto.model.NotificationEventDeploymentApproved', null, global);
 goog.exportSymbol('proto.model.NotificationEventDeploymentCancelled', null, global);
+goog.exportSymbol('proto.model.NotificationEventDeploymentCommented', null, global);
 goog.exportSymbol('proto.model.NotificationEventDeploymentFailed', null, global);
 goog.exportSymbol('proto.model.NotificationEventDeploymentPlanned', null, global);
 goog.exportSymbol('proto.model.NotificationEventDeploymentRollingBack', null, global);
@@ -237,6 +238,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.model.NotificationEventDeploymentWaitApproval.displayName = 'proto.model.NotificationEventDeploymentWaitApproval';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.NotificationEventDeploymentCommented = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.NotificationEventDeploymentCommented.repeatedFields_, null);
+};
+goog.inherits(proto.model.NotificationEventDeploymentCommented, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.NotificationEventDeploymentCommented.displayName = 'proto.model.NotificationEventDeploymentCommented';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -2767,6 +2789,313 @@ proto.model.NotificationEventDeploymentWaitApproval.prototype.clearMentionedGrou
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.NotificationEventDeploymentCommented.repeatedFields_ = [3,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.NotificationEventDeploymentCommented.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.NotificationEventDeploymentCommented.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.NotificationEventDeploymentCommented} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NotificationEventDeploymentCommented.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f),
+    comment: (f = msg.getComment()) && pkg_model_deployment_pb.DeploymentComment.toObject(includeInstance, f),
+    mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.NotificationEventDeploymentCommented}
+ */
+proto.model.NotificationEventDeploymentCommented.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.NotificationEventDeploymentCommented;
+  return proto.model.NotificationEventDeploymentCommented.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.NotificationEventDeploymentCommented} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.NotificationEventDeploymentCommented}
+ */
+proto.model.NotificationEventDeploymentCommented.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new pkg_model_deployment_pb.Deployment;
+      reader.readMessage(value,pkg_model_deployment_pb.Deployment.deserializeBinaryFromReader);
+      msg.setDeployment(value);
+      break;
+    case 2:
+      var value = new pkg_model_deployment_pb.DeploymentComment;
+      reader.readMessage(value,pkg_model_deployment_pb.DeploymentComment.deserializeBinaryFromReader);
+      msg.setComment(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedAccounts(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedGroups(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.NotificationEventDeploymentCommented.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.NotificationEventDeploymentCommented.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.NotificationEventDeploymentCommented} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NotificationEventDeploymentCommented.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDeployment();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      pkg_model_deployment_pb.Deployment.serializeBinaryToWriter
+    );
+  }
+  f = message.getComment();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      pkg_model_deployment_pb.DeploymentComment.serializeBinaryToWriter
+    );
+  }
+  f = message.getMentionedAccountsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getMentionedGroupsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional Deployment deployment = 1;
+ * @return {?proto.model.Deployment}
+ */
+proto.model.NotificationEventDeploymentCommented.prototype.getDeployment = function() {
+  return /** @type{?proto.model.Deployment} */ (
+    jspb.Message.getWrapperField(this, pkg_model_deployment_pb.Deployment, 1));
+};
+
+
+/**
+ * @param {?proto.model.Deployment|undefined} value
+ * @return {!proto.model.NotificationEventDeploymentCommented} returns this
+*/
+proto.model.NotificationEventDeploymentCommented.prototype.setDeployment = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.NotificationEventDeploymentCommented} returns this
+ */
+proto.model.NotificationEventDeploymentCommented.prototype.clearDeployment = function() {
+  return this.setDeployment(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.NotificationEventDeploymentCommented.prototype.hasDeployment = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional DeploymentComment comment = 2;
+ * @return {?proto.model.DeploymentComment}
+ */
+proto.model.NotificationEventDeploymentCommented.prototype.getComment = function() {
+  return /** @type{?proto.model.DeploymentComment} */ (
+    jspb.Message.getWrapperField(this, pkg_model_deployment_pb.DeploymentComment, 2));
+};
+
+
+/**
+ * @param {?proto.model.DeploymentComment|undefined} value
+ * @return {!proto.model.NotificationEventDeploymentCommented} returns this
+*/
+proto.model.NotificationEventDeploymentCommented.prototype.setComment = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.NotificationEventDeploymentCommented} returns this
+ */
+proto.model.NotificationEventDeploymentCommented.prototype.clearComment = function() {
+  return this.setComment(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.NotificationEventDeploymentCommented.prototype.hasComment = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * repeated string mentioned_accounts = 3;
+ * @return {!Array<string>}
+ */
+proto.model.NotificationEventDeploymentCommented.prototype.getMentionedAccountsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.NotificationEventDeploymentCommented} returns this
+ */
+proto.model.NotificationEventDeploymentCommented.prototype.setMentionedAccountsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NotificationEventDeploymentCommented} returns this
+ */
+proto.model.NotificationEventDeploymentCommented.prototype.addMentionedAccounts = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentCommented} returns this
+ */
+proto.model.NotificationEventDeploymentCommented.prototype.clearMentionedAccountsList = function() {
+  return this.setMentionedAccountsList([]);
+};
+
+
+/**
+ * repeated string mentioned_groups = 4;
+ * @return {!Array<string>}
+ */
+proto.model.NotificationEventDeploymentCommented.prototype.getMentionedGroupsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.NotificationEventDeploymentCommented} returns this
+ */
+proto.model.NotificationEventDeploymentCommented.prototype.setMentionedGroupsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NotificationEventDeploymentCommented} returns this
+ */
+proto.model.NotificationEventDeploymentCommented.prototype.addMentionedGroups = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentCommented} returns this
+ */
+proto.model.NotificationEventDeploymentCommented.prototype.clearMentionedGroupsList = function() {
+  return this.setMentionedGroupsList([]);
+};
+
+
+
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
@@ -4980,6 +5309,7 @@ proto.model.NotificationEventType = {
   EVENT_DEPLOYMENT_WAIT_APPROVAL: 7,
   EVENT_DEPLOYMENT_TRIGGER_FAILED: 8,
   EVENT_DEPLOYMENT_STARTED: 9,
+  EVENT_DEPLOYMENT_COMMENTED: 10,
   EVENT_APPLICATION_SYNCED: 100,
   EVENT_APPLICATION_OUT_OF_SYNC: 101,
   EVENT_APPLICATION_HEALTHY: 200,
@@ -45,6 +45,7 @@ export const dummyDeployment: Deployment.AsObject = {
   },
   kind: ApplicationKind.KUBERNETES,
   metadataMap: [],
+  commentsList: [],
   deploymentChainId: "",
   deploymentChainBlockIndex: 0,
 };
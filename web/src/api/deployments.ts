@@ -10,6 +10,8 @@ import {
   ApproveStageResponse,
   SkipStageRequest,
   SkipStageResponse,
+  AddDeploymentCommentRequest,
+  AddDeploymentCommentResponse,
 } from "pipecd/web/api_client/service_pb";
 
 export const getDeployment = ({
@@ -79,3 +81,15 @@ export const skipStage = ({
   req.setStageId(stageId);
   return apiRequest(req, apiClient.skipStage);
 };
+
+export const addDeploymentComment = ({
+  deploymentId,
+  comment,
+}: AddDeploymentCommentRequest.AsObject): Promise<
+  AddDeploymentCommentResponse.AsObject
+> => {
+  const req = new AddDeploymentCommentRequest();
+  req.setDeploymentId(deploymentId);
+  req.setComment(comment);
+  return apiRequest(req, apiClient.addDeploymentComment);
+};
@@ -0,0 +1,74 @@
+import userEvent from "@testing-library/user-event";
+import { addDeploymentComment, DeploymentStatus } from "~/modules/deployments";
+import { dummyDeployment } from "~/__fixtures__/dummy-deployment";
+import { createStore, render, screen, act } from "~~/test-utils";
+import { DeploymentComments } from ".";
+
+const comment = {
+  id: "comment-1",
+  commenter: "user",
+  comment: "paused for DB check",
+  createdAt: dummyDeployment.createdAt,
+};
+
+describe("DeploymentComments", () => {
+  it("shows the comments left on the deployment", () => {
+    const store = createStore({
+      deployments: {
+        entities: {
+          [dummyDeployment.id]: {
+            ...dummyDeployment,
+            commentsList: [comment],
+          },
+        },
+        ids: [dummyDeployment.id],
+      },
+    });
+    render(<DeploymentComments deploymentId={dummyDeployment.id} />, {
+      store,
+    });
+
+    expect(screen.getByText(comment.commenter)).toBeInTheDocument();
+    expect(screen.getByText(comment.comment)).toBeInTheDocument();
+    expect(
+      screen.queryByRole("button", { name: "Comment" })
+    ).not.toBeInTheDocument();
+  });
+
+  it("dispatch addDeploymentComment action if click comment button", async () => {
+    const store = createStore({
+      deployments: {
+        entities: {
+          [dummyDeployment.id]: {
+            ...dummyDeployment,
+            status: DeploymentStatus.DEPLOYMENT_RUNNING,
+          },
+        },
+        ids: [dummyDeployment.id],
+      },
+    });
+    render(<DeploymentComments deploymentId={dummyDeployment.id} />, {
+      store,
+    });
+
+    userEvent.type(
+      screen.getByRole("textbox", { name: "Leave a comment" }),
+      comment.comment
+    );
+    await act(async () => {
+      userEvent.click(screen.getByRole("button", { name: "Comment" }));
+    });
+
+    expect(store.getActions()).toMatchObject([
+      {
+        type: addDeploymentComment.pending.type,
+        meta: {
+          arg: {
+            deploymentId: dummyDeployment.id,
+            comment: comment.comment,
+          },
+        },
+      },
+    ]);
+  });
+});
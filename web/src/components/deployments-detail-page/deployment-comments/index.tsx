@@ -0,0 +1,94 @@
+import { Box, Button, Paper, TextField, Typography } from "@mui/material";
+import dayjs from "dayjs";
+import { FC, memo, useState } from "react";
+import { unwrapResult, useAppDispatch, useAppSelector } from "~/hooks/redux";
+import {
+  addDeploymentComment,
+  Deployment,
+  isDeploymentRunning,
+  selectById as selectDeploymentById,
+} from "~/modules/deployments";
+
+export interface DeploymentCommentsProps {
+  deploymentId: string;
+}
+
+export const DeploymentComments: FC<DeploymentCommentsProps> = memo(
+  function DeploymentComments({ deploymentId }) {
+    const dispatch = useAppDispatch();
+    const [comment, setComment] = useState("");
+    const [isAdding, setIsAdding] = useState(false);
+    const deployment = useAppSelector<Deployment.AsObject | undefined>(
+      (state) => selectDeploymentById(state.deployments, deploymentId)
+    );
+
+    if (!deployment) {
+      return null;
+    }
+
+    const isRunning = isDeploymentRunning(deployment.status);
+    if (deployment.commentsList.length === 0 && !isRunning) {
+      return null;
+    }
+
+    const handleAdd = (): void => {
+      setIsAdding(true);
+      dispatch(addDeploymentComment({ deploymentId, comment }))
+        .then(unwrapResult)
+        .then(() => setComment(""))
+        .catch(() => undefined)
+        .finally(() => setIsAdding(false));
+    };
+
+    return (
+      <Paper square elevation={1} sx={{ padding: 2, mt: 1 }}>
+        <Typography variant="subtitle2" color="textSecondary">
+          Comments
+        </Typography>
+        {deployment.commentsList.map((c) => (
+          <Box key={c.id} sx={{ mt: 1 }}>
+            <Typography variant="body2">
+              <Box component="span" sx={{ fontWeight: "bold" }}>
+                {c.commenter}
+              </Box>
+              <Typography
+                component="span"
+                variant="body2"
+                color="textSecondary"
+                sx={{ ml: 1 }}
+              >
+                {dayjs(c.createdAt * 1000).fromNow()}
+              </Typography>
+            </Typography>
+            <Typography variant="body2" sx={{ whiteSpace: "pre-wrap" }}>
+              {c.comment}
+            </Typography>
+          </Box>
+        ))}
+        {isRunning && (
+          <Box sx={{ display: "flex", alignItems: "flex-start", mt: 1 }}>
+            <TextField
+              id="deployment-comment"
+              label="Leave a comment"
+              variant="outlined"
+              size="small"
+              fullWidth
+              multiline
+              value={comment}
+              onChange={(e) => setComment(e.target.value)}
+            />
+            <Button
+              color="primary"
+              variant="contained"
+              sx={{ ml: 1 }}
+              disabled={comment.trim() === "" || isAdding}
+              onClick={handleAdd}
+            >
+              Comment
+            </Button>
+          </Box>
+        )}
+      </Paper>
+    );
+  }
+);
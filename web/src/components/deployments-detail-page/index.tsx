@@ -10,6 +10,7 @@ import {
   isDeploymentRunning,
   selectById as selectDeploymentById,
 } from "~/modules/deployments";
+import { DeploymentComments } from "./deployment-comments";
 import { DeploymentDetail } from "./deployment-detail";
 import { LogViewer } from "./log-viewer";
 import { Pipeline } from "./pipeline";
@@ -61,6 +62,7 @@ export const DeploymentDetailPage: FC = memo(function DeploymentDetailPage() {
         }}
       >
         <DeploymentDetail deploymentId={deploymentId ?? ""} />
+        <DeploymentComments deploymentId={deploymentId ?? ""} />
         <Pipeline deploymentId={deploymentId ?? ""} />
       </Box>
       <LogViewer />
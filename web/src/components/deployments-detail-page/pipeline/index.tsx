@@ -6,10 +6,13 @@ import {
   DialogContent,
   DialogContentText,
   DialogTitle,
+  Typography,
 } from "@mui/material";
 import { FC, memo, useCallback, useEffect, useState } from "react";
 import {
   METADATA_APPROVED_BY,
+  METADATA_RISK_FACTORS,
+  METADATA_RISK_SCORE,
   METADATA_SKIPPED_BY,
 } from "~/constants/metadata-keys";
 import { useAppDispatch, useAppSelector } from "~/hooks/redux";
@@ -77,7 +80,11 @@ const createStagesForRendering = (
   return stages;
 };
 
-const LARGE_STAGE_NAMES = ["WAIT_APPROVAL", "K8S_TRAFFIC_ROUTING"];
+const LARGE_STAGE_NAMES = [
+  "WAIT_APPROVAL",
+  "K8S_TRAFFIC_ROUTING",
+  "TERRAFORM_PLAN",
+];
 
 export interface PipelineProps {
   deploymentId: string;
@@ -105,6 +112,32 @@ const findSkipper = (metadata: Array<[string, string]>): string | undefined => {
   return undefined;
 };
 
+interface DeploymentRisk {
+  score: string;
+  factors: Array<{ name: string; score?: number; description?: string }>;
+}
+
+const findRisk = (
+  metadata: Array<[string, string]>
+): DeploymentRisk | undefined => {
+  const score = metadata.find(([key]) => key === METADATA_RISK_SCORE);
+  if (!score) {
+    return undefined;
+  }
+
+  const factors = metadata.find(([key]) => key === METADATA_RISK_FACTORS);
+  let parsed: DeploymentRisk["factors"] = [];
+  if (factors) {
+    try {
+      parsed = JSON.parse(factors[1]);
+    } catch {
+      parsed = [];
+    }
+  }
+
+  return { score: score[1], factors: parsed };
+};
+
 export const Pipeline: FC<PipelineProps> = memo(function Pipeline({
   deploymentId,
 }) {
@@ -114,6 +147,12 @@ export const Pipeline: FC<PipelineProps> = memo(function Pipeline({
   );
   const [approveTargetId, setApproveTargetId] = useState<string | null>(null);
   const isOpenApproveDialog = Boolean(approveTargetId);
+  const approveTargetStage = deployment?.stagesList.find(
+    (stage) => stage.id === approveTargetId
+  );
+  const risk = approveTargetStage
+    ? findRisk(approveTargetStage.metadataMap)
+    : undefined;
 
   const defaultActiveStage = findDefaultActiveStage(deployment);
   const stages = createStagesForRendering(deployment);
@@ -285,6 +324,24 @@ export const Pipeline: FC<PipelineProps> = memo(function Pipeline({
             <DialogContentText>
               {`To continue deploying, click "APPROVE".`}
             </DialogContentText>
+            {risk && (
+              <Box mt={2}>
+                <Typography variant="subtitle2">
+                  {`Risk score: ${risk.score}/100`}
+                </Typography>
+                {risk.factors.map((factor) => (
+                  <Typography
+                    key={factor.name}
+                    variant="body2"
+                    color="textSecondary"
+                  >
+                    {`${factor.name}: ${factor.score ?? 0} (${
+                      factor.description ?? ""
+                    })`}
+                  </Typography>
+                ))}
+              </Box>
+            )}
           </DialogContent>
           <DialogActions>
             <Button onClick={() => setApproveTargetId(null)}>CANCEL</Button>
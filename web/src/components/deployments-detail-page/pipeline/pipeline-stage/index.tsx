@@ -1,5 +1,11 @@
 import { Box, Paper, Typography } from "@mui/material";
 import { FC, memo } from "react";
+import {
+  METADATA_TERRAFORM_PLAN_CREATES,
+  METADATA_TERRAFORM_PLAN_DESTROYS,
+  METADATA_TERRAFORM_PLAN_REPLACEMENTS,
+  METADATA_TERRAFORM_PLAN_UPDATES,
+} from "~/constants/metadata-keys";
 import { StageStatus } from "~/modules/deployments";
 import { StageStatusIcon } from "./stage-status-icon";
 
@@ -61,6 +67,21 @@ const createTrafficPercentageText = (meta: [string, string][]): string => {
   return detail;
 };
 
+const terraformPlanMetaKey: [string, string][] = [
+  [METADATA_TERRAFORM_PLAN_CREATES, "to create"],
+  [METADATA_TERRAFORM_PLAN_UPDATES, "to update"],
+  [METADATA_TERRAFORM_PLAN_DESTROYS, "to destroy"],
+  [METADATA_TERRAFORM_PLAN_REPLACEMENTS, "to replace"],
+];
+
+const createTerraformPlanText = (meta: [string, string][]): string => {
+  const map = Object.fromEntries(meta);
+  return terraformPlanMetaKey
+    .filter(([key]) => map[key] !== undefined && map[key] !== "0")
+    .map(([key, label]) => `${map[key]} ${label}`)
+    .join(", ");
+};
+
 export const PipelineStage: FC<PipelineStageProps> = memo(
   function PipelineStage({
     id,
@@ -85,6 +106,7 @@ export const PipelineStage: FC<PipelineStageProps> = memo(
     }
 
     const trafficPercentage = createTrafficPercentageText(metadata);
+    const terraformPlan = createTerraformPlanText(metadata);
 
     return (
       <Paper
@@ -179,6 +201,19 @@ export const PipelineStage: FC<PipelineStageProps> = memo(
             </Typography>
           </Box>
         )}
+        {terraformPlan && (
+          <Box
+            sx={{
+              color: "text.secondary",
+              marginLeft: 4,
+              textAlign: "left",
+            }}
+          >
+            <Typography variant="body2" color="inherit">
+              {terraformPlan}
+            </Typography>
+          </Box>
+        )}
       </Paper>
     );
   }
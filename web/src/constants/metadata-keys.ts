@@ -1,2 +1,8 @@
 export const METADATA_APPROVED_BY = "ApprovedBy";
 export const METADATA_SKIPPED_BY = "SkippedBy";
+export const METADATA_RISK_SCORE = "RiskScore";
+export const METADATA_RISK_FACTORS = "RiskFactors";
+export const METADATA_TERRAFORM_PLAN_CREATES = "TerraformPlanCreates";
+export const METADATA_TERRAFORM_PLAN_UPDATES = "TerraformPlanUpdates";
+export const METADATA_TERRAFORM_PLAN_DESTROYS = "TerraformPlanDestroys";
+export const METADATA_TERRAFORM_PLAN_REPLACEMENTS = "TerraformPlanReplacements";
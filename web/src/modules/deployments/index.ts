@@ -6,6 +6,7 @@ import {
 } from "@reduxjs/toolkit";
 import {
   Deployment,
+  DeploymentComment,
   PipelineStage,
   DeploymentStatus,
   StageStatus,
@@ -182,6 +183,14 @@ export const skipStage = createAsyncThunk<
   await thunkAPI.dispatch(fetchCommand(commandId));
 });
 
+export const addDeploymentComment = createAsyncThunk<
+  DeploymentComment.AsObject | undefined,
+  { deploymentId: string; comment: string }
+>("deployments/addComment", async (props) => {
+  const { comment } = await deploymentsApi.addDeploymentComment(props);
+  return comment;
+});
+
 export const updateSkippableState = createAsyncThunk<
   void,
   { stageId: string; skippable: boolean }
@@ -266,6 +275,12 @@ export const deploymentsSlice = createSlice({
       .addCase(cancelDeployment.pending, (state, action) => {
         state.canceling[action.meta.arg.deploymentId] = true;
       })
+      .addCase(addDeploymentComment.fulfilled, (state, action) => {
+        const deployment = state.entities[action.meta.arg.deploymentId];
+        if (deployment && action.payload) {
+          deployment.commentsList.push(action.payload);
+        }
+      })
       .addCase(fetchCommand.fulfilled, (state, action) => {
         if (
           action.payload.type === Command.Type.CANCEL_DEPLOYMENT &&
@@ -302,6 +317,7 @@ export { SyncStrategy } from "pipecd/web/model/common_pb";
 
 export {
   Deployment,
+  DeploymentComment,
   DeploymentStatus,
   StageStatus,
   PipelineStage,